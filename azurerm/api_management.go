@@ -0,0 +1,107 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-01-01/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func validateAzureRMApiManagementName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must begin with a letter, end with a letter or number and may only contain letters, numbers and hyphens: %q", k, value))
+	}
+
+	if len(value) > 50 {
+		errors = append(errors, fmt.Errorf("%q must be no longer than 50 characters: %q", k, value))
+	}
+
+	return
+}
+
+// validateAzureRMApiManagementChildName validates the identifiers of Products, Users and Subscriptions
+func validateAzureRMApiManagementChildName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`(^[\w]+$)|(^[\w][\w\-]+[\w]$)`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q may only contain letters, numbers, underscores and hyphens, and cannot begin or end with a hyphen: %q", k, value))
+	}
+
+	if len(value) > 80 {
+		errors = append(errors, fmt.Errorf("%q must be no longer than 80 characters: %q", k, value))
+	}
+
+	return
+}
+
+func validateAzureRMApiManagementApiName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[^*#&+:<>?]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q cannot contain the characters *#&+:<>?: %q", k, value))
+	}
+
+	if len(value) > 256 {
+		errors = append(errors, fmt.Errorf("%q must be no longer than 256 characters: %q", k, value))
+	}
+
+	return
+}
+
+func apiManagementPolicyXmlContentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ConflictsWith:    []string{"xml_link"},
+		DiffSuppressFunc: suppressApiManagementPolicyXmlDiff,
+	}
+}
+
+func apiManagementPolicyXmlLinkSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"xml_content"},
+		ValidateFunc:  validate.UrlIsHttpOrHttps(),
+	}
+}
+
+func expandApiManagementPolicy(d *schema.ResourceData) (*apimanagement.PolicyContract, error) {
+	xmlContent := d.Get("xml_content").(string)
+	xmlLink := d.Get("xml_link").(string)
+
+	if xmlContent != "" {
+		return &apimanagement.PolicyContract{
+			PolicyContractProperties: &apimanagement.PolicyContractProperties{
+				ContentFormat: apimanagement.Rawxml,
+				PolicyContent: &xmlContent,
+			},
+		}, nil
+	}
+
+	if xmlLink != "" {
+		return &apimanagement.PolicyContract{
+			PolicyContractProperties: &apimanagement.PolicyContractProperties{
+				ContentFormat: apimanagement.RawxmlLink,
+				PolicyContent: &xmlLink,
+			},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("Either `xml_content` or `xml_link` must be set")
+}
+
+// suppressApiManagementPolicyXmlDiff ignores whitespace-only differences, since the API
+// returns the Policy XML reformatted
+func suppressApiManagementPolicyXmlDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeApiManagementPolicyXml(old) == normalizeApiManagementPolicyXml(new)
+}
+
+func normalizeApiManagementPolicyXml(input string) string {
+	output := regexp.MustCompile(`>\s+<`).ReplaceAllString(input, "><")
+	output = strings.Replace(output, "\r\n", "\n", -1)
+	return strings.TrimSpace(output)
+}
@@ -0,0 +1,147 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/appconfiguration"
+)
+
+// The App Configuration Resource Provider isn't available in the version of the Azure SDK used by this Provider,
+// as such these resources are managed using the `resourcemanager` client - these are the models for the API
+// Version `2019-10-01`. The Key Values within a Configuration Store are managed using the data plane of the
+// Configuration Store, via the `appconfiguration` client.
+
+type appConfigurationStore struct {
+	ID         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Location   *string                          `json:"location,omitempty"`
+	Tags       map[string]*string               `json:"tags"`
+	Sku        *appConfigurationStoreSku        `json:"sku,omitempty"`
+	Identity   *appConfigurationStoreIdentity   `json:"identity,omitempty"`
+	Properties *appConfigurationStoreProperties `json:"properties,omitempty"`
+}
+
+type appConfigurationStoreSku struct {
+	Name string `json:"name"`
+}
+
+type appConfigurationStoreIdentity struct {
+	Type        string  `json:"type"`
+	PrincipalID *string `json:"principalId,omitempty"`
+	TenantID    *string `json:"tenantId,omitempty"`
+}
+
+type appConfigurationStoreProperties struct {
+	Endpoint          *string `json:"endpoint,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	CreationDate      *string `json:"creationDate,omitempty"`
+}
+
+type appConfigurationStoreAccessKeys struct {
+	Value []appConfigurationStoreAccessKey `json:"value"`
+}
+
+type appConfigurationStoreAccessKey struct {
+	ID               *string `json:"id,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	Value            *string `json:"value,omitempty"`
+	ConnectionString *string `json:"connectionString,omitempty"`
+	ReadOnly         *bool   `json:"readOnly,omitempty"`
+}
+
+func appConfigurationStoreResourceID(subscriptionId, resourceGroup, name string) string {
+	return azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.AppConfiguration", "configurationStores", name)
+}
+
+// appConfigurationKeyID identifies a Key Value within a Configuration Store - which isn't an Azure Resource
+type appConfigurationKeyID struct {
+	ConfigurationStoreID string
+	Key                  string
+	Label                string
+}
+
+func (id appConfigurationKeyID) String() string {
+	return fmt.Sprintf("%s/AppConfigurationKey/%s/Label/%s", id.ConfigurationStoreID, url.PathEscape(id.Key), url.PathEscape(id.Label))
+}
+
+func parseAppConfigurationKeyID(input string) (*appConfigurationKeyID, error) {
+	segments := strings.SplitN(input, "/AppConfigurationKey/", 2)
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("Expected the App Configuration Key ID %q to contain `/AppConfigurationKey/`", input)
+	}
+
+	if _, err := parseAzureResourceID(segments[0]); err != nil {
+		return nil, fmt.Errorf("Error parsing the Configuration Store ID %q: %+v", segments[0], err)
+	}
+
+	// the Key and Label are escaped, so can't contain a `/`
+	keyAndLabel := strings.Split(segments[1], "/")
+	if len(keyAndLabel) != 3 || keyAndLabel[1] != "Label" || keyAndLabel[0] == "" {
+		return nil, fmt.Errorf("Expected the App Configuration Key ID %q to be in the format `{storeId}/AppConfigurationKey/{key}/Label/{label}`", input)
+	}
+
+	key, err := url.PathUnescape(keyAndLabel[0])
+	if err != nil {
+		return nil, fmt.Errorf("Error unescaping the Key %q: %+v", keyAndLabel[0], err)
+	}
+
+	label, err := url.PathUnescape(keyAndLabel[2])
+	if err != nil {
+		return nil, fmt.Errorf("Error unescaping the Label %q: %+v", keyAndLabel[2], err)
+	}
+
+	return &appConfigurationKeyID{
+		ConfigurationStoreID: segments[0],
+		Key:                  key,
+		Label:                label,
+	}, nil
+}
+
+// appConfigurationKeyValuesClient builds a client for the data plane of the specified Configuration Store,
+// authenticated using its Primary (read-write) Access Key
+func appConfigurationKeyValuesClient(ctx context.Context, meta interface{}, configurationStoreId string) (*appconfiguration.KeyValuesClient, error) {
+	armClient := meta.(*ArmClient)
+	client := armClient.appConfigurationClient
+
+	var store appConfigurationStore
+	if _, err := client.Get(ctx, configurationStoreId, &store); err != nil {
+		return nil, fmt.Errorf("Error retrieving Configuration Store %q: %+v", configurationStoreId, err)
+	}
+	if store.Properties == nil || store.Properties.Endpoint == nil {
+		return nil, fmt.Errorf("Error retrieving Configuration Store %q: `endpoint` was nil", configurationStoreId)
+	}
+
+	var keys appConfigurationStoreAccessKeys
+	if _, err := client.Post(ctx, configurationStoreId, "ListKeys", nil, &keys); err != nil {
+		return nil, fmt.Errorf("Error listing the Access Keys for Configuration Store %q: %+v", configurationStoreId, err)
+	}
+
+	for _, key := range keys.Value {
+		if key.ReadOnly != nil && *key.ReadOnly {
+			continue
+		}
+		if key.ID == nil || key.Value == nil {
+			continue
+		}
+
+		keyValuesClient := appconfiguration.NewKeyValuesClient(*store.Properties.Endpoint, *key.ID, *key.Value)
+		armClient.configureClient(&keyValuesClient.Client, keyValuesClient.Authorizer)
+		return &keyValuesClient, nil
+	}
+
+	return nil, fmt.Errorf("Error listing the Access Keys for Configuration Store %q: no read-write Access Key was found", configurationStoreId)
+}
+
+func validateAppConfigurationStoreName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9-]{5,50}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 5 and 50 characters and can only contain alphanumeric characters and hyphens: %q", k, value))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,86 @@
+package azurerm
+
+import "testing"
+
+func TestParseAppConfigurationKeyID(t *testing.T) {
+	storeId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.AppConfiguration/configurationStores/store1"
+
+	cases := []struct {
+		Input    string
+		Expected *appConfigurationKeyID
+	}{
+		{
+			Input: storeId,
+		},
+		{
+			Input: storeId + "/AppConfigurationKey/",
+		},
+		{
+			Input: storeId + "/AppConfigurationKey/setting",
+		},
+		{
+			Input: "/AppConfigurationKey/setting/Label/",
+		},
+		{
+			Input: storeId + "/AppConfigurationKey/setting/Label/",
+			Expected: &appConfigurationKeyID{
+				ConfigurationStoreID: storeId,
+				Key:                  "setting",
+				Label:                "",
+			},
+		},
+		{
+			Input: storeId + "/AppConfigurationKey/app%2Fsetting/Label/prod%2Fwest",
+			Expected: &appConfigurationKeyID{
+				ConfigurationStoreID: storeId,
+				Key:                  "app/setting",
+				Label:                "prod/west",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := parseAppConfigurationKeyID(tc.Input)
+		if tc.Expected == nil {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but got none", tc.Input)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Error parsing %q: %+v", tc.Input, err)
+		}
+
+		if *actual != *tc.Expected {
+			t.Fatalf("Expected %+v but got %+v", *tc.Expected, *actual)
+		}
+
+		// the ID should round-trip
+		if actual.String() != tc.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", tc.Input, actual.String())
+		}
+	}
+}
+
+func TestValidateAppConfigurationStoreName(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ShouldError bool
+	}{
+		{Value: "abcd", ShouldError: true},
+		{Value: "abcde", ShouldError: false},
+		{Value: "my-App-Config-1", ShouldError: false},
+		{Value: "my_app_config", ShouldError: true},
+		{Value: "my.app.config", ShouldError: true},
+		{Value: "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwx", ShouldError: false},
+		{Value: "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxy", ShouldError: true},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAppConfigurationStoreName(tc.Value, "name")
+		if tc.ShouldError != (len(errors) > 0) {
+			t.Fatalf("Expected validating %q to error (%t) but got %d errors", tc.Value, tc.ShouldError, len(errors))
+		}
+	}
+}
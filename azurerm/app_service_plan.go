@@ -0,0 +1,41 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+)
+
+// The Maximum Elastic Worker Count of an (Elastic Premium) App Service Plan isn't available in the version of the
+// Azure SDK used by this Provider, as such this is managed using the `resourcemanager` client - these are the models
+// for the API Version `2019-08-01`.
+
+type appServicePlanElasticProperties struct {
+	Properties *struct {
+		MaximumElasticWorkerCount *int32 `json:"maximumElasticWorkerCount,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+// expandAppServicePlanWithMaximumElasticWorkerCount adds the `maximumElasticWorkerCount` property (which isn't
+// available in the Azure SDK) to the App Service Plan payload
+func expandAppServicePlanWithMaximumElasticWorkerCount(plan web.AppServicePlan, maximumElasticWorkerCount int32) (map[string]interface{}, error) {
+	serialized, err := json.Marshal(plan)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing App Service Plan: %+v", err)
+	}
+
+	output := make(map[string]interface{})
+	if err := json.Unmarshal(serialized, &output); err != nil {
+		return nil, fmt.Errorf("Error deserializing App Service Plan: %+v", err)
+	}
+
+	properties, ok := output["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["maximumElasticWorkerCount"] = maximumElasticWorkerCount
+	output["properties"] = properties
+
+	return output, nil
+}
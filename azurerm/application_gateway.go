@@ -0,0 +1,122 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// retrieveCachedApplicationGatewayById returns the Application Gateway from the cache shared with its sub-resources, retrieving
+// it if it's not cached. The returned Application Gateway is shared and as such must not be modified.
+func retrieveCachedApplicationGatewayById(applicationGatewayID string, meta interface{}) (*network.ApplicationGateway, bool, error) {
+	value, err := meta.(*ArmClient).parentResourceCache.Get(applicationGatewayID, func() (interface{}, string, error) {
+		gateway, exists, err := retrieveApplicationGatewayById(applicationGatewayID, meta)
+		if err != nil || !exists {
+			return nil, "", err
+		}
+
+		etag := ""
+		if gateway.Etag != nil {
+			etag = *gateway.Etag
+		}
+		return gateway, etag, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if value == nil {
+		return nil, false, nil
+	}
+
+	return value.(*network.ApplicationGateway), true, nil
+}
+
+func findApplicationGatewayBackendAddressPoolByName(gateway *network.ApplicationGateway, name string) (*network.ApplicationGatewayBackendAddressPool, int, bool) {
+	if gateway == nil || gateway.ApplicationGatewayPropertiesFormat == nil || gateway.ApplicationGatewayPropertiesFormat.BackendAddressPools == nil {
+		return nil, -1, false
+	}
+
+	for i, pool := range *gateway.ApplicationGatewayPropertiesFormat.BackendAddressPools {
+		if pool.Name != nil && *pool.Name == name {
+			return &pool, i, true
+		}
+	}
+
+	return nil, -1, false
+}
+
+func findApplicationGatewayHTTPListenerByName(gateway *network.ApplicationGateway, name string) (*network.ApplicationGatewayHTTPListener, int, bool) {
+	if gateway == nil || gateway.ApplicationGatewayPropertiesFormat == nil || gateway.ApplicationGatewayPropertiesFormat.HTTPListeners == nil {
+		return nil, -1, false
+	}
+
+	for i, listener := range *gateway.ApplicationGatewayPropertiesFormat.HTTPListeners {
+		if listener.Name != nil && *listener.Name == name {
+			return &listener, i, true
+		}
+	}
+
+	return nil, -1, false
+}
+
+func findApplicationGatewayRequestRoutingRuleByName(gateway *network.ApplicationGateway, name string) (*network.ApplicationGatewayRequestRoutingRule, int, bool) {
+	if gateway == nil || gateway.ApplicationGatewayPropertiesFormat == nil || gateway.ApplicationGatewayPropertiesFormat.RequestRoutingRules == nil {
+		return nil, -1, false
+	}
+
+	for i, rule := range *gateway.ApplicationGatewayPropertiesFormat.RequestRoutingRules {
+		if rule.Name != nil && *rule.Name == name {
+			return &rule, i, true
+		}
+	}
+
+	return nil, -1, false
+}
+
+// updateApplicationGateway submits the (modified) Application Gateway and waits for the update to complete
+func updateApplicationGateway(gateway *network.ApplicationGateway, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, name, err := ApplicationGatewayResGroupAndNameFromID(*gateway.ID)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway Name and Group: %+v", err)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, name, *gateway)
+	if err != nil {
+		return fmt.Errorf("Error Updating ApplicationGateway %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of ApplicationGateway %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	meta.(*ArmClient).parentResourceCache.Invalidate(*gateway.ID)
+
+	return nil
+}
+
+// sets the application_gateway_id in the ResourceData from the sub resources full id
+func applicationGatewaySubResourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	r, err := regexp.Compile(`.+\/applicationGateways\/.+?\/`)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayID := strings.TrimSuffix(r.FindString(d.Id()), "/")
+	parsed, err := parseAzureResourceID(gatewayID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse application gateway id from %s", d.Id())
+	}
+
+	if parsed.Path["applicationGateways"] == "" {
+		return nil, fmt.Errorf("parsed ID is invalid")
+	}
+
+	d.Set("application_gateway_id", gatewayID)
+	return []*schema.ResourceData{d}, nil
+}
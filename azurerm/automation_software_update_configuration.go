@@ -0,0 +1,67 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2017-05-15-preview/automation"
+)
+
+// Dynamic Azure Queries (which target the Virtual Machines within a Scope matching a set of Locations and Tags) for a
+// Software Update Configuration aren't available in the version of the Azure SDK used by this Provider, as such
+// these are managed using the `resourcemanager` client - these are the models for the API Version `2019-06-01`.
+
+type automationSoftwareUpdateConfigurationTargetProperties struct {
+	Properties *struct {
+		UpdateConfiguration *struct {
+			Targets *automationSoftwareUpdateConfigurationTargets `json:"targets,omitempty"`
+		} `json:"updateConfiguration,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+type automationSoftwareUpdateConfigurationTargets struct {
+	AzureQueries *[]automationSoftwareUpdateConfigurationAzureQuery `json:"azureQueries,omitempty"`
+}
+
+type automationSoftwareUpdateConfigurationAzureQuery struct {
+	Scope       *[]string                                         `json:"scope,omitempty"`
+	Locations   *[]string                                         `json:"locations,omitempty"`
+	TagSettings *automationSoftwareUpdateConfigurationTagSettings `json:"tagSettings,omitempty"`
+}
+
+type automationSoftwareUpdateConfigurationTagSettings struct {
+	Tags           map[string][]string `json:"tags,omitempty"`
+	FilterOperator string              `json:"filterOperator,omitempty"`
+}
+
+// expandAutomationSoftwareUpdateConfigurationWithAzureQueries adds the `targets` (which aren't available in the
+// Azure SDK) to the `updateConfiguration` of the Software Update Configuration payload
+func expandAutomationSoftwareUpdateConfigurationWithAzureQueries(configuration automation.SoftwareUpdateConfiguration, queries []automationSoftwareUpdateConfigurationAzureQuery) (map[string]interface{}, error) {
+	serialized, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing Software Update Configuration: %+v", err)
+	}
+
+	output := make(map[string]interface{})
+	if err := json.Unmarshal(serialized, &output); err != nil {
+		return nil, fmt.Errorf("Error deserializing Software Update Configuration: %+v", err)
+	}
+
+	properties, ok := output["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+
+	updateConfiguration, ok := properties["updateConfiguration"].(map[string]interface{})
+	if !ok {
+		updateConfiguration = make(map[string]interface{})
+	}
+
+	updateConfiguration["targets"] = automationSoftwareUpdateConfigurationTargets{
+		AzureQueries: &queries,
+	}
+	properties["updateConfiguration"] = updateConfiguration
+	output["properties"] = properties
+
+	return output, nil
+}
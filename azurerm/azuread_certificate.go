@@ -0,0 +1,194 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// azureADCertificateSchema returns the schema shared by the Certificate (Key Credential) resources for both
+// Applications and Service Principals, where `objectIdField` is the field containing the parent's Object ID
+func azureADCertificateSchema(objectIdField string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		objectIdField: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.UUID,
+		},
+
+		"key_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.UUID,
+		},
+
+		"type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "AsymmetricX509Cert",
+			ValidateFunc: validation.StringInSlice([]string{
+				"AsymmetricX509Cert",
+				"Symmetric",
+			}, false),
+		},
+
+		"encoding": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "pem",
+			ValidateFunc: validation.StringInSlice([]string{
+				"base64",
+				"pem",
+			}, false),
+		},
+
+		"value": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+
+		"usage": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "Verify",
+			ValidateFunc: validation.StringInSlice([]string{
+				"Sign",
+				"Verify",
+			}, false),
+		},
+
+		"start_date": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RFC3339Time,
+		},
+
+		"end_date": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RFC3339Time,
+		},
+	}
+}
+
+func expandAzureADCertificate(d *schema.ResourceData) (*graphrbac.KeyCredential, error) {
+	value, err := decodeAzureADCertificateValue(d.Get("value").(string), d.Get("encoding").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding `value`: %+v", err)
+	}
+
+	var keyId string
+	if v, ok := d.GetOk("key_id"); ok {
+		keyId = v.(string)
+	} else {
+		kid, err := uuid.GenerateUUID()
+		if err != nil {
+			return nil, err
+		}
+
+		keyId = kid
+	}
+
+	// errors will be handled by the validation
+	endDate, _ := time.Parse(time.RFC3339, d.Get("end_date").(string))
+
+	credential := graphrbac.KeyCredential{
+		KeyID:   utils.String(keyId),
+		Type:    utils.String(d.Get("type").(string)),
+		Usage:   utils.String(d.Get("usage").(string)),
+		Value:   utils.String(value),
+		EndDate: &date.Time{Time: endDate},
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		// errors will be handled by the validation
+		startDate, _ := time.Parse(time.RFC3339, v.(string))
+		credential.StartDate = &date.Time{Time: startDate}
+	}
+
+	return &credential, nil
+}
+
+// decodeAzureADCertificateValue returns the Base64 encoded DER value expected by the API
+func decodeAzureADCertificateValue(value string, encoding string) (string, error) {
+	if encoding == "pem" {
+		block, _ := pem.Decode([]byte(value))
+		if block == nil {
+			return "", fmt.Errorf("Expected a PEM encoded Certificate but no PEM data was found")
+		}
+
+		return base64.StdEncoding.EncodeToString(block.Bytes), nil
+	}
+
+	// the value may have been wrapped across multiple lines
+	value = strings.Join(strings.Fields(value), "")
+	if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+		return "", fmt.Errorf("Expected a Base64 encoded Certificate: %+v", err)
+	}
+
+	return value, nil
+}
+
+func findAzureADCertificate(credentials *[]graphrbac.KeyCredential, keyId string) *graphrbac.KeyCredential {
+	if credentials == nil {
+		return nil
+	}
+
+	for _, c := range *credentials {
+		if c.KeyID == nil {
+			continue
+		}
+
+		if *c.KeyID == keyId {
+			return &c
+		}
+	}
+
+	return nil
+}
+
+func flattenAzureADCertificate(d *schema.ResourceData, credential *graphrbac.KeyCredential) {
+	// value is available in the SDK but isn't returned from the API
+	d.Set("key_id", credential.KeyID)
+	d.Set("type", credential.Type)
+	d.Set("usage", credential.Usage)
+
+	if endDate := credential.EndDate; endDate != nil {
+		d.Set("end_date", endDate.Format(time.RFC3339))
+	}
+
+	if startDate := credential.StartDate; startDate != nil {
+		d.Set("start_date", startDate.Format(time.RFC3339))
+	}
+}
+
+func parseAzureADCertificateId(input string) (string, string, error) {
+	id := strings.Split(input, "/")
+	if len(id) != 2 || id[0] == "" || id[1] == "" {
+		return "", "", fmt.Errorf("ID should be in the format {objectId}/{keyId} - but got %q", input)
+	}
+
+	return id[0], id[1], nil
+}
@@ -0,0 +1,103 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"testing"
+)
+
+func TestDecodeAzureADCertificateValue(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/application_gateway_test.cer")
+	if err != nil {
+		t.Fatalf("Error reading the test Certificate: %+v", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatalf("Expected the test Certificate to be PEM encoded")
+	}
+	expected := base64.StdEncoding.EncodeToString(block.Bytes)
+
+	cases := []struct {
+		Value       string
+		Encoding    string
+		ExpectError bool
+	}{
+		{
+			Value:       string(data),
+			Encoding:    "pem",
+			ExpectError: false,
+		},
+		{
+			Value:       expected,
+			Encoding:    "base64",
+			ExpectError: false,
+		},
+		{
+			// line-wrapped Base64
+			Value:       expected[:64] + "\n" + expected[64:],
+			Encoding:    "base64",
+			ExpectError: false,
+		},
+		{
+			Value:       expected,
+			Encoding:    "pem",
+			ExpectError: true,
+		},
+		{
+			Value:       "not-base64!",
+			Encoding:    "base64",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := decodeAzureADCertificateValue(tc.Value, tc.Encoding)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error decoding %q as %q but didn't get one", tc.Value, tc.Encoding)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error decoding %q as %q but got: %+v", tc.Value, tc.Encoding, err)
+		}
+
+		if actual != expected {
+			t.Fatalf("Expected %q but got %q", expected, actual)
+		}
+	}
+}
+
+func TestParseAzureADCertificateId(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "00000000-0000-0000-0000-000000000000",
+			ExpectError: true,
+		},
+		{
+			Input:       "00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111",
+			ExpectError: false,
+		},
+		{
+			Input:       "00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, _, err := parseAzureADCertificateId(tc.Input)
+		if tc.ExpectError != (err != nil) {
+			t.Fatalf("Expected an error to be %t for %q but got: %+v", tc.ExpectError, tc.Input, err)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// The Blueprint Resource Provider isn't available in the version of the Azure SDK used by this Provider,
+// as such these resources are managed using the `resourcemanager` client - these are the models for the API
+// Version `2018-11-01-preview`.
+
+type blueprintAssignment struct {
+	ID         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Location   *string                        `json:"location,omitempty"`
+	Identity   *blueprintAssignmentIdentity   `json:"identity,omitempty"`
+	Properties *blueprintAssignmentProperties `json:"properties,omitempty"`
+}
+
+type blueprintAssignmentIdentity struct {
+	Type                   string                                    `json:"type"`
+	PrincipalID            *string                                   `json:"principalId,omitempty"`
+	TenantID               *string                                   `json:"tenantId,omitempty"`
+	UserAssignedIdentities map[string]*blueprintUserAssignedIdentity `json:"userAssignedIdentities,omitempty"`
+}
+
+type blueprintUserAssignedIdentity struct {
+	PrincipalID *string `json:"principalId,omitempty"`
+	ClientID    *string `json:"clientId,omitempty"`
+}
+
+type blueprintAssignmentProperties struct {
+	DisplayName       *string                          `json:"displayName,omitempty"`
+	Description       *string                          `json:"description,omitempty"`
+	BlueprintID       *string                          `json:"blueprintId,omitempty"`
+	Parameters        map[string]interface{}           `json:"parameters"`
+	ResourceGroups    map[string]interface{}           `json:"resourceGroups"`
+	Locks             *blueprintAssignmentLockSettings `json:"locks,omitempty"`
+	Status            *blueprintAssignmentStatus       `json:"status,omitempty"`
+	ProvisioningState *string                          `json:"provisioningState,omitempty"`
+}
+
+type blueprintAssignmentLockSettings struct {
+	Mode               string    `json:"mode"`
+	ExcludedPrincipals *[]string `json:"excludedPrincipals,omitempty"`
+}
+
+type blueprintAssignmentStatus struct {
+	TimeCreated  *string `json:"timeCreated,omitempty"`
+	LastModified *string `json:"lastModified,omitempty"`
+}
+
+type blueprintDefinition struct {
+	ID         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *blueprintDefinitionProperties `json:"properties,omitempty"`
+}
+
+type blueprintDefinitionProperties struct {
+	DisplayName *string                    `json:"displayName,omitempty"`
+	Description *string                    `json:"description,omitempty"`
+	TargetScope *string                    `json:"targetScope,omitempty"`
+	Status      *blueprintAssignmentStatus `json:"status,omitempty"`
+}
+
+type blueprintPublishedVersion struct {
+	ID         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+	Properties *blueprintPublishedVersionProperties `json:"properties,omitempty"`
+}
+
+type blueprintPublishedVersionProperties struct {
+	DisplayName   *string                    `json:"displayName,omitempty"`
+	Description   *string                    `json:"description,omitempty"`
+	TargetScope   *string                    `json:"targetScope,omitempty"`
+	BlueprintName *string                    `json:"blueprintName,omitempty"`
+	ChangeNotes   *string                    `json:"changeNotes,omitempty"`
+	Status        *blueprintAssignmentStatus `json:"status,omitempty"`
+}
+
+// Blueprints can be defined at either a Subscription or a Management Group
+const blueprintScopePattern = `(/subscriptions/[^/]+|/providers/Microsoft.Management/managementGroups/[^/]+)`
+
+var (
+	blueprintScopeRegex           = regexp.MustCompile(`(?i)^` + blueprintScopePattern + `$`)
+	blueprintVersionIDRegex       = regexp.MustCompile(`(?i)^` + blueprintScopePattern + `/providers/Microsoft.Blueprint/blueprints/[^/]+/versions/[^/]+$`)
+	blueprintAssignmentIDRegex    = regexp.MustCompile(`(?i)^/subscriptions/([^/]+)/providers/Microsoft.Blueprint/blueprintAssignments/([^/]+)$`)
+	blueprintTargetSubscriptionID = regexp.MustCompile(`^/subscriptions/[^/]+$`)
+)
+
+type blueprintAssignmentID struct {
+	Scope string
+	Name  string
+}
+
+func blueprintAssignmentResourceID(scope, name string) string {
+	return fmt.Sprintf("%s/providers/Microsoft.Blueprint/blueprintAssignments/%s", strings.TrimSuffix(scope, "/"), name)
+}
+
+func parseBlueprintAssignmentID(input string) (*blueprintAssignmentID, error) {
+	matches := blueprintAssignmentIDRegex.FindStringSubmatch(input)
+	if len(matches) != 3 {
+		return nil, fmt.Errorf("Expected the Blueprint Assignment ID %q to be in the format `/subscriptions/{subscriptionId}/providers/Microsoft.Blueprint/blueprintAssignments/{name}`", input)
+	}
+
+	return &blueprintAssignmentID{
+		Scope: fmt.Sprintf("/subscriptions/%s", matches[1]),
+		Name:  matches[2],
+	}, nil
+}
+
+func validateBlueprintScopeID(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !blueprintScopeRegex.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a Subscription (`/subscriptions/{subscriptionId}`) or a Management Group (`/providers/Microsoft.Management/managementGroups/{groupId}`): %q", k, value))
+	}
+
+	return warnings, errors
+}
+
+func validateBlueprintVersionID(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !blueprintVersionIDRegex.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a published Blueprint Version (`{scope}/providers/Microsoft.Blueprint/blueprints/{name}/versions/{version}`): %q", k, value))
+	}
+
+	return warnings, errors
+}
+
+func validateBlueprintTargetSubscriptionID(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !blueprintTargetSubscriptionID.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a Subscription in the format `/subscriptions/{subscriptionId}`: %q", k, value))
+	}
+
+	return warnings, errors
+}
+
+func blueprintAssignmentStateRefreshFunc(meta interface{}, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client := meta.(*ArmClient).blueprintClient
+		ctx := meta.(*ArmClient).StopContext
+
+		var assignment blueprintAssignment
+		resp, err := client.Get(ctx, id, &assignment)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return resp, "deleted", nil
+			}
+
+			return nil, "", fmt.Errorf("Error retrieving Blueprint Assignment %q: %+v", id, err)
+		}
+
+		if assignment.Properties == nil || assignment.Properties.ProvisioningState == nil {
+			return nil, "", fmt.Errorf("Error retrieving Blueprint Assignment %q: `provisioningState` was nil", id)
+		}
+
+		return assignment, strings.ToLower(*assignment.Properties.ProvisioningState), nil
+	}
+}
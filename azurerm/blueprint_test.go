@@ -0,0 +1,127 @@
+package azurerm
+
+import "testing"
+
+func TestParseBlueprintAssignmentID(t *testing.T) {
+	cases := []struct {
+		Input         string
+		ExpectedScope string
+		ExpectedName  string
+		ShouldError   bool
+	}{
+		{
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000",
+			ShouldError: true,
+		},
+		{
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Blueprint/blueprintAssignments/",
+			ShouldError: true,
+		},
+		{
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Blueprint/blueprintAssignments/assignment1",
+			ShouldError: true,
+		},
+		{
+			Input:         "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Blueprint/blueprintAssignments/assignment1",
+			ExpectedScope: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			ExpectedName:  "assignment1",
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := parseBlueprintAssignmentID(tc.Input)
+		if tc.ShouldError {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but got none", tc.Input)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Error parsing %q: %+v", tc.Input, err)
+		}
+
+		if actual.Scope != tc.ExpectedScope || actual.Name != tc.ExpectedName {
+			t.Fatalf("Expected Scope %q / Name %q but got Scope %q / Name %q", tc.ExpectedScope, tc.ExpectedName, actual.Scope, actual.Name)
+		}
+	}
+}
+
+func TestValidateBlueprintVersionID(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ShouldError bool
+	}{
+		{Value: "", ShouldError: true},
+		{Value: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Blueprint/blueprints/blueprint1", ShouldError: true},
+		{Value: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Blueprint/blueprints/blueprint1/versions/v1", ShouldError: false},
+		{Value: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Blueprint/blueprints/blueprint1/versions/v1", ShouldError: false},
+		{Value: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Blueprint/blueprints/blueprint1/versions/v1", ShouldError: true},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateBlueprintVersionID(tc.Value, "version_id")
+		if tc.ShouldError != (len(errors) > 0) {
+			t.Fatalf("Expected validating %q to error (%t) but got %d errors", tc.Value, tc.ShouldError, len(errors))
+		}
+	}
+}
+
+func TestValidateBlueprintScopeID(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ShouldError bool
+	}{
+		{Value: "", ShouldError: true},
+		{Value: "/subscriptions/00000000-0000-0000-0000-000000000000", ShouldError: false},
+		{Value: "/providers/Microsoft.Management/managementGroups/group1", ShouldError: false},
+		{Value: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1", ShouldError: true},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateBlueprintScopeID(tc.Value, "scope_id")
+		if tc.ShouldError != (len(errors) > 0) {
+			t.Fatalf("Expected validating %q to error (%t) but got %d errors", tc.Value, tc.ShouldError, len(errors))
+		}
+	}
+}
+
+func TestExpandBlueprintAssignmentIdentity(t *testing.T) {
+	identityId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+
+	cases := []struct {
+		Type        string
+		IdentityIds []interface{}
+		ShouldError bool
+	}{
+		{Type: "SystemAssigned", IdentityIds: []interface{}{}},
+		{Type: "SystemAssigned", IdentityIds: []interface{}{identityId}, ShouldError: true},
+		{Type: "UserAssigned", IdentityIds: []interface{}{}, ShouldError: true},
+		{Type: "UserAssigned", IdentityIds: []interface{}{identityId}},
+	}
+
+	for _, tc := range cases {
+		input := []interface{}{
+			map[string]interface{}{
+				"type":         tc.Type,
+				"identity_ids": tc.IdentityIds,
+			},
+		}
+
+		actual, err := expandBlueprintAssignmentIdentity(input)
+		if tc.ShouldError {
+			if err == nil {
+				t.Fatalf("Expected an error for type %q with %d identity_ids but got none", tc.Type, len(tc.IdentityIds))
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Error expanding type %q with %d identity_ids: %+v", tc.Type, len(tc.IdentityIds), err)
+		}
+
+		if actual.Type != tc.Type || len(actual.UserAssignedIdentities) != len(tc.IdentityIds) {
+			t.Fatalf("Expected type %q with %d User Assigned Identities but got %+v", tc.Type, len(tc.IdentityIds), actual)
+		}
+	}
+}
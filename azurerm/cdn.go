@@ -0,0 +1,99 @@
+package azurerm
+
+// The Delivery Rule actions (such as URL Redirects) and Key Vault certificates for Custom Domains aren't available
+// in the version of the Azure SDK used by this Provider, as such these are managed using the `resourcemanager`
+// client - these are the models for the API Version `2019-04-15`.
+
+const (
+	cdnDeliveryRuleConditionURLPath          = "UrlPath"
+	cdnDeliveryRuleConditionURLFileExtension = "UrlFileExtension"
+
+	cdnDeliveryRuleActionCacheExpiration = "CacheExpiration"
+	cdnDeliveryRuleActionURLRedirect     = "UrlRedirect"
+
+	cdnCertificateSourceAzureKeyVault = "AzureKeyVault"
+)
+
+type cdnEndpointDeliveryPolicyUpdate struct {
+	Properties cdnEndpointDeliveryPolicyProperties `json:"properties"`
+}
+
+type cdnEndpointDeliveryPolicyProperties struct {
+	DeliveryPolicy *cdnDeliveryPolicy `json:"deliveryPolicy,omitempty"`
+}
+
+type cdnDeliveryPolicy struct {
+	Description *string           `json:"description,omitempty"`
+	Rules       []cdnDeliveryRule `json:"rules"`
+}
+
+type cdnDeliveryRule struct {
+	Order      int32                      `json:"order"`
+	Conditions []cdnDeliveryRuleCondition `json:"conditions,omitempty"`
+	Actions    []cdnDeliveryRuleAction    `json:"actions"`
+}
+
+type cdnDeliveryRuleCondition struct {
+	Name       string                       `json:"name"`
+	Parameters *cdnMatchConditionParameters `json:"parameters,omitempty"`
+}
+
+type cdnMatchConditionParameters struct {
+	OdataType       string   `json:"@odata.type"`
+	Operator        string   `json:"operator"`
+	NegateCondition *bool    `json:"negateCondition,omitempty"`
+	MatchValues     []string `json:"matchValues,omitempty"`
+}
+
+type cdnDeliveryRuleAction struct {
+	Name       string                           `json:"name"`
+	Parameters *cdnDeliveryRuleActionParameters `json:"parameters,omitempty"`
+}
+
+// cdnDeliveryRuleActionParameters contains the parameters for each type of action, since the Actions
+// are polymorphic on their `name` - only the fields for the type of action are populated.
+type cdnDeliveryRuleActionParameters struct {
+	OdataType string `json:"@odata.type"`
+
+	// CacheExpiration
+	CacheBehavior *string `json:"cacheBehavior,omitempty"`
+	CacheType     *string `json:"cacheType,omitempty"`
+	CacheDuration *string `json:"cacheDuration,omitempty"`
+
+	// UrlRedirect
+	RedirectType        *string `json:"redirectType,omitempty"`
+	DestinationProtocol *string `json:"destinationProtocol,omitempty"`
+	CustomPath          *string `json:"customPath,omitempty"`
+	CustomHostname      *string `json:"customHostname,omitempty"`
+	CustomQueryString   *string `json:"customQueryString,omitempty"`
+	CustomFragment      *string `json:"customFragment,omitempty"`
+}
+
+type cdnCustomDomain struct {
+	ID         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *cdnCustomDomainProperties `json:"properties,omitempty"`
+}
+
+type cdnCustomDomainProperties struct {
+	HostName                     *string                     `json:"hostName,omitempty"`
+	CustomHTTPSProvisioningState *string                     `json:"customHttpsProvisioningState,omitempty"`
+	CustomHTTPSParameters        *cdnCustomDomainHTTPSParams `json:"customHttpsParameters,omitempty"`
+}
+
+type cdnCustomDomainHTTPSParams struct {
+	CertificateSource           string                                  `json:"certificateSource"`
+	ProtocolType                string                                  `json:"protocolType"`
+	CertificateSourceParameters *cdnKeyVaultCertificateSourceParameters `json:"certificateSourceParameters,omitempty"`
+}
+
+type cdnKeyVaultCertificateSourceParameters struct {
+	OdataType         string `json:"@odata.type"`
+	SubscriptionID    string `json:"subscriptionId"`
+	ResourceGroupName string `json:"resourceGroupName"`
+	VaultName         string `json:"vaultName"`
+	SecretName        string `json:"secretName"`
+	SecretVersion     string `json:"secretVersion"`
+	UpdateRule        string `json:"updateRule"`
+	DeleteRule        string `json:"deleteRule"`
+}
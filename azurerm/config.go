@@ -12,27 +12,37 @@ import (
 
 	appinsights "github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
+	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2017-09-01/batch"
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
+	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/mgmt/2017-04-18/cognitiveservices"
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/consumption/mgmt/2018-03-31/consumption"
 	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2018-04-01/containerinstance"
 	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2017-10-01/containerregistry"
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/Azure/azure-sdk-for-go/services/databricks/mgmt/2018-04-01/databricks"
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
 	analyticsAccount "github.com/Azure/azure-sdk-for-go/services/datalake/analytics/mgmt/2016-11-01/account"
 	"github.com/Azure/azure-sdk-for-go/services/datalake/store/2016-11-01/filesystem"
 	storeAccount "github.com/Azure/azure-sdk-for-go/services/datalake/store/mgmt/2016-11-01/account"
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
 	"github.com/Azure/azure-sdk-for-go/services/eventgrid/mgmt/2018-01-01/eventgrid"
 	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/Azure/azure-sdk-for-go/services/iothub/mgmt/2018-04-01/devices"
 	keyVault "github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
-	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2016-10-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
+	"github.com/Azure/azure-sdk-for-go/services/maps/mgmt/2018-05-01/maps"
+	"github.com/Azure/azure-sdk-for-go/services/mediaservices/mgmt/2015-10-01/media"
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-01-01/apimanagement"
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
+	automationPreview "github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2017-05-15-preview/automation"
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/Azure/azure-sdk-for-go/services/preview/msi/mgmt/2015-08-31-preview/msi"
@@ -46,7 +56,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-06-01/subscriptions"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-12-01/policy"
-	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/managedapplications"
 	"github.com/Azure/azure-sdk-for-go/services/scheduler/mgmt/2016-03-01/scheduler"
 	"github.com/Azure/azure-sdk-for-go/services/search/mgmt/2015-08-19/search"
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
@@ -61,6 +72,12 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
+	resourceBatch "github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/batch"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/cache"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/recording"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourcemanager"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -73,6 +90,21 @@ type ArmClient struct {
 	usingServicePrincipal    bool
 	environment              azure.Environment
 	skipProviderRegistration bool
+	defaultTags              map[string]interface{}
+	ignoreTagKeys            []string
+	ignoreTagKeyPrefixes     []string
+
+	requiredTagKeys                      []string
+	requiredTagKeysExemptedResourceTypes []string
+
+	features features.UserFeatures
+
+	subResourceBatcher  *resourceBatch.Batcher
+	parentResourceCache *cache.Cache
+
+	skipLocationValidation  bool
+	availableLocationsOnce  sync.Once
+	availableLocationsCache []subscriptions.Location
 
 	StopContext context.Context
 
@@ -83,6 +115,9 @@ type ArmClient struct {
 	automationCredentialClient automation.CredentialClient
 	automationScheduleClient   automation.ScheduleClient
 
+	automationSoftwareUpdateConfigurationsClient        automationPreview.SoftwareUpdateConfigurationsClient
+	automationSoftwareUpdateConfigurationsTargetsClient resourcemanager.Client
+
 	dnsClient   dns.RecordSetsClient
 	zonesClient dns.ZonesClient
 
@@ -90,51 +125,102 @@ type ArmClient struct {
 	containerServicesClient  containerservice.ContainerServicesClient
 	kubernetesClustersClient containerservice.ManagedClustersClient
 	containerGroupsClient    containerinstance.ContainerGroupsClient
+	containerInstanceClient  resourcemanager.Client
 
-	eventGridTopicsClient       eventgrid.TopicsClient
-	eventHubClient              eventhub.EventHubsClient
-	eventHubConsumerGroupClient eventhub.ConsumerGroupsClient
-	eventHubNamespacesClient    eventhub.NamespacesClient
+	containerRegistryTasksClient resourcemanager.Client
 
-	workspacesClient operationalinsights.WorkspacesClient
-	solutionsClient  operationsmanagement.SolutionsClient
+	eventGridTopicsClient           eventgrid.TopicsClient
+	eventHubClient                  eventhub.EventHubsClient
+	eventHubClustersClient          resourcemanager.Client
+	eventHubConsumerGroupClient     eventhub.ConsumerGroupsClient
+	eventHubNamespacesClient        eventhub.NamespacesClient
+	eventHubNamespacesPreviewClient resourcemanager.Client
+	eventHubDisasterRecoveryClient  eventhub.DisasterRecoveryConfigsClient
+
+	workspacesClient   operationalinsights.WorkspacesClient
+	solutionsClient    operationsmanagement.SolutionsClient
+	logAnalyticsClient resourcemanager.Client
 
 	redisClient               redis.Client
 	redisFirewallClient       redis.FirewallRulesClient
 	redisPatchSchedulesClient redis.PatchSchedulesClient
 
+	// API Management
+	apiManagementAPIClient           apimanagement.APIClient
+	apiManagementAPIPolicyClient     apimanagement.APIPolicyClient
+	apiManagementProductClient       apimanagement.ProductClient
+	apiManagementProductPolicyClient apimanagement.ProductPolicyClient
+	apiManagementServiceClient       apimanagement.ServiceClient
+	apiManagementSubscriptionClient  apimanagement.SubscriptionClient
+	apiManagementUserClient          apimanagement.UserClient
+
+	// App Configuration
+	appConfigurationClient resourcemanager.Client
+
 	// Application Insights
-	appInsightsClient appinsights.ComponentsClient
+	appInsightsClient               appinsights.ComponentsClient
+	appInsightsAPIKeyClient         appinsights.APIKeysClient
+	appInsightsAnalyticsItemsClient appinsights.AnalyticsItemsClient
+	appInsightsWebTestsClient       appinsights.WebTestsClient
 
 	// Authentication
 	roleAssignmentsClient   authorization.RoleAssignmentsClient
 	roleDefinitionsClient   authorization.RoleDefinitionsClient
 	applicationsClient      graphrbac.ApplicationsClient
+	objectsClient           graphrbac.ObjectsClient
 	servicePrincipalsClient graphrbac.ServicePrincipalsClient
 
+	// Federated Identity Credentials are only available in the Microsoft Graph API
+	federatedIdentityCredentialsClient msgraph.FederatedIdentityCredentialsClient
+
 	// Autoscale Settings
 	autoscaleSettingsClient insights.AutoscaleSettingsClient
 
+	// Batch
+	batchAccountClient     batch.AccountClient
+	batchApplicationClient batch.ApplicationClient
+	batchPoolClient        batch.PoolClient
+
+	// Blueprints
+	blueprintClient resourcemanager.Client
+
 	// CDN
+	cdnClient              resourcemanager.Client
 	cdnCustomDomainsClient cdn.CustomDomainsClient
 	cdnEndpointsClient     cdn.EndpointsClient
 	cdnProfilesClient      cdn.ProfilesClient
 
+	// Cognitive Services
+	cognitiveAccountsClient cognitiveservices.AccountsClient
+
 	// Compute
-	availSetClient         compute.AvailabilitySetsClient
-	diskClient             compute.DisksClient
-	imageClient            compute.ImagesClient
-	snapshotsClient        compute.SnapshotsClient
-	usageOpsClient         compute.UsageClient
-	vmExtensionImageClient compute.VirtualMachineExtensionImagesClient
-	vmExtensionClient      compute.VirtualMachineExtensionsClient
-	vmScaleSetClient       compute.VirtualMachineScaleSetsClient
-	vmImageClient          compute.VirtualMachineImagesClient
-	vmClient               compute.VirtualMachinesClient
+	availSetClient                  compute.AvailabilitySetsClient
+	computeProximityPlacementClient resourcemanager.Client
+	diskClient                      compute.DisksClient
+	imageClient                     compute.ImagesClient
+	proximityPlacementGroupsClient  resourcemanager.Client
+	resourceSkusClient              compute.ResourceSkusClient
+	sharedImageClient               resourcemanager.Client
+	snapshotsClient                 compute.SnapshotsClient
+	usageOpsClient                  compute.UsageClient
+	vmExtensionImageClient          compute.VirtualMachineExtensionImagesClient
+	vmExtensionClient               compute.VirtualMachineExtensionsClient
+	vmScaleSetClient                compute.VirtualMachineScaleSetsClient
+	vmImageClient                   compute.VirtualMachineImagesClient
+	vmClient                        compute.VirtualMachinesClient
+
+	// Consumption
+	consumptionBudgetsClient consumption.BudgetsClient
 
 	// Devices
 	iothubResourceClient devices.IotHubResourceClient
 
+	// DevTestLabs
+	devTestLabsClient            dtl.LabsClient
+	devTestSchedulesClient       dtl.SchedulesClient
+	devTestVirtualMachinesClient dtl.VirtualMachinesClient
+	devTestVirtualNetworksClient dtl.VirtualNetworksClient
+
 	// Databases
 	mysqlConfigurationsClient            mysql.ConfigurationsClient
 	mysqlDatabasesClient                 mysql.DatabasesClient
@@ -152,6 +238,16 @@ type ArmClient struct {
 	sqlServerAzureADAdministratorsClient sql.ServerAzureADAdministratorsClient
 	sqlVirtualNetworkRulesClient         sql.VirtualNetworkRulesClient
 
+	// Databricks
+	databricksWorkspacesClient databricks.WorkspacesClient
+
+	// Data Factory
+	dataFactoryClient              datafactory.FactoriesClient
+	dataFactoryDatasetClient       datafactory.DatasetsClient
+	dataFactoryLinkedServiceClient datafactory.LinkedServicesClient
+	dataFactoryPipelineClient      datafactory.PipelinesClient
+	dataFactoryTriggerClient       datafactory.TriggersClient
+
 	// Data Lake Store
 	dataLakeStoreAccountClient       storeAccount.AccountsClient
 	dataLakeStoreFirewallRulesClient storeAccount.FirewallRulesClient
@@ -164,43 +260,69 @@ type ArmClient struct {
 	// KeyVault
 	keyVaultClient           keyvault.VaultsClient
 	keyVaultManagementClient keyVault.BaseClient
+	keyVaultDataPlaneClient  resourcemanager.Client
+
+	// Kusto
+	kustoClient resourcemanager.Client
 
 	// Logic
 	logicWorkflowsClient logic.WorkflowsClient
 
+	// Maps
+	mapsAccountsClient maps.AccountsClient
+
+	// Media
+	mediaServicesClient media.ServiceClient
+
 	// Management Groups
 	managementGroupsClient             managementgroups.Client
 	managementGroupsSubscriptionClient managementgroups.SubscriptionsClient
 
 	// Monitor
-	actionGroupsClient      insights.ActionGroupsClient
-	monitorAlertRulesClient insights.AlertRulesClient
+	actionGroupsClient                  insights.ActionGroupsClient
+	actionRulesClient                   resourcemanager.Client
+	monitorActivityLogAlertsClient      insights.ActivityLogAlertsClient
+	monitorAlertRulesClient             insights.AlertRulesClient
+	monitorMetricAlertsClient           resourcemanager.Client
+	monitorMetricDefinitionsClient      insights.MetricDefinitionsClient
+	monitorScheduledQueryRulesClient    insights.ScheduledQueryRulesClient
+	monitorScheduledQueryRulesLogClient resourcemanager.Client
 
 	// MSI
 	userAssignedIdentitiesClient msi.UserAssignedIdentitiesClient
 
 	// Networking
-	applicationGatewayClient        network.ApplicationGatewaysClient
-	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
-	expressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
-	expressRouteCircuitClient       network.ExpressRouteCircuitsClient
-	expressRoutePeeringsClient      network.ExpressRouteCircuitPeeringsClient
-	ifaceClient                     network.InterfacesClient
-	loadBalancerClient              network.LoadBalancersClient
-	localNetConnClient              network.LocalNetworkGatewaysClient
-	packetCapturesClient            network.PacketCapturesClient
-	publicIPClient                  network.PublicIPAddressesClient
-	routesClient                    network.RoutesClient
-	routeTablesClient               network.RouteTablesClient
-	secGroupClient                  network.SecurityGroupsClient
-	secRuleClient                   network.SecurityRulesClient
-	subnetClient                    network.SubnetsClient
-	netUsageClient                  network.UsagesClient
-	vnetGatewayConnectionsClient    network.VirtualNetworkGatewayConnectionsClient
-	vnetGatewayClient               network.VirtualNetworkGatewaysClient
-	vnetClient                      network.VirtualNetworksClient
-	vnetPeeringsClient              network.VirtualNetworkPeeringsClient
-	watcherClient                   network.WatchersClient
+	applicationGatewayClient             network.ApplicationGatewaysClient
+	applicationSecurityGroupsClient      network.ApplicationSecurityGroupsClient
+	bgpServiceCommunitiesClient          network.BgpServiceCommunitiesClient
+	expressRouteAuthsClient              network.ExpressRouteCircuitAuthorizationsClient
+	expressRouteCircuitClient            network.ExpressRouteCircuitsClient
+	expressRoutePeeringsClient           network.ExpressRouteCircuitPeeringsClient
+	hubVnetConnectionsClient             network.HubVirtualNetworkConnectionsClient
+	ifaceClient                          network.InterfacesClient
+	loadBalancerClient                   network.LoadBalancersClient
+	localNetConnClient                   network.LocalNetworkGatewaysClient
+	packetCapturesClient                 network.PacketCapturesClient
+	publicIPClient                       network.PublicIPAddressesClient
+	routeFiltersClient                   network.RouteFiltersClient
+	routesClient                         network.RoutesClient
+	routeTablesClient                    network.RouteTablesClient
+	secGroupClient                       network.SecurityGroupsClient
+	secRuleClient                        network.SecurityRulesClient
+	subnetClient                         network.SubnetsClient
+	netUsageClient                       network.UsagesClient
+	vnetGatewayConnectionsClient         network.VirtualNetworkGatewayConnectionsClient
+	vnetGatewayClient                    network.VirtualNetworkGatewaysClient
+	vnetGatewayAadClient                 resourcemanager.Client
+	vnetClient                           network.VirtualNetworksClient
+	vnetPeeringsClient                   network.VirtualNetworkPeeringsClient
+	virtualHubsClient                    network.VirtualHubsClient
+	virtualWansClient                    network.VirtualWANsClient
+	vpnConnectionsClient                 network.VpnConnectionsClient
+	vpnGatewaysClient                    network.VpnGatewaysClient
+	vpnSitesClient                       network.VpnSitesClient
+	watcherClient                        network.WatchersClient
+	webApplicationFirewallPoliciesClient resourcemanager.Client
 
 	// Notification Hubs
 	notificationHubsClient       notificationhubs.Client
@@ -210,26 +332,39 @@ type ArmClient struct {
 	recoveryServicesVaultsClient recoveryservices.VaultsClient
 
 	// Relay
-	relayNamespacesClient relay.NamespacesClient
+	relayHybridConnectionsClient relay.HybridConnectionsClient
+	relayNamespacesClient        relay.NamespacesClient
 
 	// Resources
-	managementLocksClient locks.ManagementLocksClient
-	deploymentsClient     resources.DeploymentsClient
-	providersClient       resources.ProvidersClient
-	resourcesClient       resources.Client
-	resourceGroupsClient  resources.GroupsClient
-	subscriptionsClient   subscriptions.Client
+	managedApplicationDefinitionsClient managedapplications.ApplicationDefinitionsClient
+	managedApplicationsClient           managedapplications.ApplicationsClient
+	managementLocksClient               locks.ManagementLocksClient
+	deploymentsClient                   resources.DeploymentsClient
+	tenantDeploymentsClient             resourcemanager.Client
+	providersClient                     resources.ProvidersClient
+	resourceGraphClient                 resourcemanager.Client
+	resourcesClient                     resources.Client
+	resourceGroupsClient                resources.GroupsClient
+	subscriptionsClient                 subscriptions.Client
+	subscriptionTagsClient              resourcemanager.Client
+	tenantsClient                       resourcemanager.Client
 
 	// Scheduler
 	schedulerJobCollectionsClient scheduler.JobCollectionsClient
 	schedulerJobsClient           scheduler.JobsClient
 
 	// Search
-	searchServicesClient search.ServicesClient
+	searchAdminKeysClient search.AdminKeysClient
+	searchQueryKeysClient search.QueryKeysClient
+	searchServicesClient  search.ServicesClient
+
+	// Security Center
+	securityCenterClient resourcemanager.Client
 
 	// ServiceBus
 	serviceBusQueuesClient            servicebus.QueuesClient
 	serviceBusNamespacesClient        servicebus.NamespacesClient
+	serviceBusDisasterRecoveryClient  servicebus.DisasterRecoveryConfigsClient
 	serviceBusTopicsClient            servicebus.TopicsClient
 	serviceBusSubscriptionsClient     servicebus.SubscriptionsClient
 	serviceBusSubscriptionRulesClient servicebus.RulesClient
@@ -243,26 +378,44 @@ type ArmClient struct {
 
 	// Traffic Manager
 	trafficManagerGeographialHierarchiesClient trafficmanager.GeographicHierarchiesClient
-	trafficManagerProfilesClient               trafficmanager.ProfilesClient
+	trafficManagerClient                       resourcemanager.Client
 	trafficManagerEndpointsClient              trafficmanager.EndpointsClient
 
 	// Web
-	appServicePlansClient web.AppServicePlansClient
-	appServicesClient     web.AppsClient
+	appServicePlansClient        web.AppServicePlansClient
+	appServicePlansElasticClient resourcemanager.Client
+	appServicesClient            web.AppsClient
 
 	// Policy
 	policyAssignmentsClient policy.AssignmentsClient
 	policyDefinitionsClient policy.DefinitionsClient
+
+	// Portal
+	portalClient resourcemanager.Client
 }
 
 func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
 	setUserAgent(client)
 	client.Authorizer = auth
-	client.Sender = autorest.CreateSender(withRequestLogging())
+	client.Sender = buildSender()
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
 	client.PollingDuration = 60 * time.Minute
 }
 
+// requestRecorder records or replays the HTTP requests made by the ArmClient, which allows
+// the Acceptance Tests to be run without access to Azure - this is only set within the tests
+var requestRecorder *recording.Recorder
+
+func buildSender() autorest.Sender {
+	decorators := make([]autorest.SendDecorator, 0)
+	if requestRecorder != nil {
+		decorators = append(decorators, requestRecorder.SendDecorator())
+	}
+	decorators = append(decorators, withRequestLogging())
+
+	return autorest.CreateSender(decorators...)
+}
+
 func withRequestLogging() autorest.SendDecorator {
 	return func(s autorest.Sender) autorest.Sender {
 		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
@@ -308,6 +461,21 @@ func setUserAgent(client *autorest.Client) {
 }
 
 func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConfig, endpoint string) (*autorest.BearerAuthorizer, error) {
+	if requestRecorder.Replaying() {
+		// requests aren't sent to Azure when replaying, so a placeholder token is sufficient
+		token := adal.Token{
+			AccessToken: "replayed",
+			ExpiresOn:   "4102444800",
+		}
+		spt, err := adal.NewServicePrincipalTokenFromManualToken(*oauthConfig, c.ClientID, endpoint, token)
+		if err != nil {
+			return nil, err
+		}
+		spt.SetAutoRefresh(false)
+
+		return autorest.NewBearerAuthorizer(spt), nil
+	}
+
 	useServicePrincipal := c.ClientSecret != ""
 
 	if useServicePrincipal {
@@ -374,6 +542,7 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		environment:              env,
 		usingServicePrincipal:    c.ClientSecret != "",
 		skipProviderRegistration: c.SkipProviderRegistration,
+		parentResourceCache:      cache.New(),
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -386,7 +555,7 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
 	}
 
-	sender := autorest.CreateSender(withRequestLogging())
+	sender := buildSender()
 
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
@@ -402,6 +571,13 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		return nil, err
 	}
 
+	// Microsoft Graph Endpoints
+	msGraphEndpoint := msgraph.EndpointForEnvironment(env)
+	msGraphAuth, err := getAuthorizationToken(c, oauthConfig, msGraphEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
 	// Key Vault Endpoints
 	keyVaultAuth := autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
 		keyVaultSpt, err := getAuthorizationToken(c, oauthConfig, resource)
@@ -412,34 +588,48 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		return keyVaultSpt, nil
 	})
 
+	client.registerApiManagementClients(endpoint, c.SubscriptionID, auth)
+	client.registerAppConfigurationClients(endpoint, auth)
 	client.registerAppInsightsClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerAutomationClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth, sender)
+	client.registerAuthentication(endpoint, graphEndpoint, msGraphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth, msGraphAuth, sender)
+	client.registerBatchClients(endpoint, c.SubscriptionID, auth)
+	client.registerBlueprintClients(endpoint, auth)
 	client.registerCDNClients(endpoint, c.SubscriptionID, auth, sender)
+	client.registerCognitiveServiceClients(endpoint, c.SubscriptionID, auth)
 	client.registerComputeClients(endpoint, c.SubscriptionID, auth, sender)
+	client.registerConsumptionClients(endpoint, c.SubscriptionID, auth)
 	client.registerContainerInstanceClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerContainerRegistryClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerContainerServicesClients(endpoint, c.SubscriptionID, auth)
 	client.registerCosmosDBClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
+	client.registerDatabricksClients(endpoint, c.SubscriptionID, auth)
+	client.registerDataFactoryClients(endpoint, c.SubscriptionID, auth)
 	client.registerDataLakeStoreClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerDeviceClients(endpoint, c.SubscriptionID, auth, sender)
+	client.registerDevTestClients(endpoint, c.SubscriptionID, auth)
 	client.registerDNSClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerEventGridClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerEventHubClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth, sender)
+	client.registerKustoClients(endpoint, auth)
 	client.registerLogicClients(endpoint, c.SubscriptionID, auth, sender)
+	client.registerMapsClients(endpoint, c.SubscriptionID, auth)
+	client.registerMediaServiceClients(endpoint, c.SubscriptionID, auth)
 	client.registerMonitorClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerNetworkingClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerNotificationHubsClient(endpoint, c.SubscriptionID, auth, sender)
 	client.registerOperationalInsightsClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerRecoveryServiceClients(endpoint, c.SubscriptionID, auth)
 	client.registerPolicyClients(endpoint, c.SubscriptionID, auth)
+	client.registerPortalClients(endpoint, auth)
 	client.registerManagementGroupClients(endpoint, auth)
 	client.registerRedisClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerRelayClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerResourcesClients(endpoint, c.SubscriptionID, auth)
 	client.registerSearchClients(endpoint, c.SubscriptionID, auth)
+	client.registerSecurityCenterClients(endpoint, auth)
 	client.registerServiceBusClients(endpoint, c.SubscriptionID, auth)
 	client.registerServiceFabricClients(endpoint, c.SubscriptionID, auth)
 	client.registerSchedulerClients(endpoint, c.SubscriptionID, auth)
@@ -450,10 +640,58 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 	return &client, nil
 }
 
+func (c *ArmClient) registerApiManagementClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	apiClient := apimanagement.NewAPIClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&apiClient.Client, auth)
+	c.apiManagementAPIClient = apiClient
+
+	apiPolicyClient := apimanagement.NewAPIPolicyClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&apiPolicyClient.Client, auth)
+	c.apiManagementAPIPolicyClient = apiPolicyClient
+
+	productClient := apimanagement.NewProductClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&productClient.Client, auth)
+	c.apiManagementProductClient = productClient
+
+	productPolicyClient := apimanagement.NewProductPolicyClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&productPolicyClient.Client, auth)
+	c.apiManagementProductPolicyClient = productPolicyClient
+
+	serviceClient := apimanagement.NewServiceClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&serviceClient.Client, auth)
+	c.apiManagementServiceClient = serviceClient
+
+	subscriptionClient := apimanagement.NewSubscriptionClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&subscriptionClient.Client, auth)
+	c.apiManagementSubscriptionClient = subscriptionClient
+
+	userClient := apimanagement.NewUserClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&userClient.Client, auth)
+	c.apiManagementUserClient = userClient
+}
+
+func (c *ArmClient) registerAppConfigurationClients(endpoint string, auth autorest.Authorizer) {
+	appConfigurationClient := resourcemanager.NewWithBaseURI(endpoint, "2019-10-01")
+	c.configureClient(&appConfigurationClient.Client, auth)
+	c.appConfigurationClient = appConfigurationClient
+}
+
 func (c *ArmClient) registerAppInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	ai := appinsights.NewComponentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ai.Client, auth)
 	c.appInsightsClient = ai
+
+	apiKeyClient := appinsights.NewAPIKeysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&apiKeyClient.Client, auth)
+	c.appInsightsAPIKeyClient = apiKeyClient
+
+	analyticsItemsClient := appinsights.NewAnalyticsItemsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&analyticsItemsClient.Client, auth)
+	c.appInsightsAnalyticsItemsClient = analyticsItemsClient
+
+	webTestsClient := appinsights.NewWebTestsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&webTestsClient.Client, auth)
+	c.appInsightsWebTestsClient = webTestsClient
 }
 
 func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
@@ -472,9 +710,18 @@ func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, a
 	scheduleClient := automation.NewScheduleClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&scheduleClient.Client, auth)
 	c.automationScheduleClient = scheduleClient
+
+	softwareUpdateConfigurationsClient := automationPreview.NewSoftwareUpdateConfigurationsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&softwareUpdateConfigurationsClient.Client, auth)
+	c.automationSoftwareUpdateConfigurationsClient = softwareUpdateConfigurationsClient
+
+	// the dynamic Azure Queries for a Software Update Configuration require a newer API Version than the Azure SDK supports
+	softwareUpdateConfigurationsTargetsClient := resourcemanager.NewWithBaseURI(endpoint, "2019-06-01")
+	c.configureClient(&softwareUpdateConfigurationsTargetsClient.Client, auth)
+	c.automationSoftwareUpdateConfigurationsTargetsClient = softwareUpdateConfigurationsTargetsClient
 }
 
-func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, subscriptionId, tenantId string, auth, graphAuth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, msGraphEndpoint, subscriptionId, tenantId string, auth, graphAuth, msGraphAuth autorest.Authorizer, sender autorest.Sender) {
 	assignmentsClient := authorization.NewRoleAssignmentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&assignmentsClient.Client, auth)
 	c.roleAssignmentsClient = assignmentsClient
@@ -487,12 +734,44 @@ func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, subscription
 	c.configureClient(&applicationsClient.Client, graphAuth)
 	c.applicationsClient = applicationsClient
 
+	objectsClient := graphrbac.NewObjectsClientWithBaseURI(graphEndpoint, tenantId)
+	c.configureClient(&objectsClient.Client, graphAuth)
+	c.objectsClient = objectsClient
+
 	servicePrincipalsClient := graphrbac.NewServicePrincipalsClientWithBaseURI(graphEndpoint, tenantId)
 	c.configureClient(&servicePrincipalsClient.Client, graphAuth)
 	c.servicePrincipalsClient = servicePrincipalsClient
+
+	federatedIdentityCredentialsClient := msgraph.NewFederatedIdentityCredentialsClientWithBaseURI(msGraphEndpoint)
+	c.configureClient(&federatedIdentityCredentialsClient.Client, msGraphAuth)
+	c.federatedIdentityCredentialsClient = federatedIdentityCredentialsClient
+}
+
+func (c *ArmClient) registerBatchClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	accountClient := batch.NewAccountClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&accountClient.Client, auth)
+	c.batchAccountClient = accountClient
+
+	applicationClient := batch.NewApplicationClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&applicationClient.Client, auth)
+	c.batchApplicationClient = applicationClient
+
+	poolClient := batch.NewPoolClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&poolClient.Client, auth)
+	c.batchPoolClient = poolClient
+}
+
+func (c *ArmClient) registerBlueprintClients(endpoint string, auth autorest.Authorizer) {
+	blueprintClient := resourcemanager.NewWithBaseURI(endpoint, "2018-11-01-preview")
+	c.configureClient(&blueprintClient.Client, auth)
+	c.blueprintClient = blueprintClient
 }
 
 func (c *ArmClient) registerCDNClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+	cdnClient := resourcemanager.NewWithBaseURI(endpoint, "2019-04-15")
+	c.configureClient(&cdnClient.Client, auth)
+	c.cdnClient = cdnClient
+
 	customDomainsClient := cdn.NewCustomDomainsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&customDomainsClient.Client, auth)
 	c.cdnCustomDomainsClient = customDomainsClient
@@ -506,6 +785,18 @@ func (c *ArmClient) registerCDNClients(endpoint, subscriptionId string, auth aut
 	c.cdnProfilesClient = profilesClient
 }
 
+func (c *ArmClient) registerCognitiveServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	accountsClient := cognitiveservices.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&accountsClient.Client, auth)
+	c.cognitiveAccountsClient = accountsClient
+}
+
+func (c *ArmClient) registerConsumptionClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	budgetsClient := consumption.NewBudgetsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&budgetsClient.Client, auth)
+	c.consumptionBudgetsClient = budgetsClient
+}
+
 func (c *ArmClient) registerCosmosDBClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	cdb := documentdb.NewDatabaseAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&cdb.Client, auth)
@@ -517,6 +808,12 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&availabilitySetsClient.Client, auth)
 	c.availSetClient = availabilitySetsClient
 
+	// placing a Virtual Machine, Virtual Machine Scale Set or Availability Set within
+	// a Proximity Placement Group requires a newer API Version than the Azure SDK supports
+	proximityPlacementClient := resourcemanager.NewWithBaseURI(endpoint, "2018-04-01")
+	c.configureClient(&proximityPlacementClient.Client, auth)
+	c.computeProximityPlacementClient = proximityPlacementClient
+
 	diskClient := compute.NewDisksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&diskClient.Client, auth)
 	c.diskClient = diskClient
@@ -525,6 +822,18 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&imagesClient.Client, auth)
 	c.imageClient = imagesClient
 
+	proximityPlacementGroupsClient := resourcemanager.NewWithBaseURI(endpoint, "2018-04-01")
+	c.configureClient(&proximityPlacementGroupsClient.Client, auth)
+	c.proximityPlacementGroupsClient = proximityPlacementGroupsClient
+
+	sharedImageClient := resourcemanager.NewWithBaseURI(endpoint, "2018-06-01")
+	c.configureClient(&sharedImageClient.Client, auth)
+	c.sharedImageClient = sharedImageClient
+
+	resourceSkusClient := compute.NewResourceSkusClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&resourceSkusClient.Client, auth)
+	c.resourceSkusClient = resourceSkusClient
+
 	snapshotsClient := compute.NewSnapshotsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&snapshotsClient.Client, auth)
 	c.snapshotsClient = snapshotsClient
@@ -558,12 +867,20 @@ func (c *ArmClient) registerContainerInstanceClients(endpoint, subscriptionId st
 	cgc := containerinstance.NewContainerGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&cgc.Client, auth)
 	c.containerGroupsClient = cgc
+
+	containerInstanceClient := resourcemanager.NewWithBaseURI(endpoint, "2018-10-01")
+	c.configureClient(&containerInstanceClient.Client, auth)
+	c.containerInstanceClient = containerInstanceClient
 }
 
 func (c *ArmClient) registerContainerRegistryClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	crc := containerregistry.NewRegistriesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&crc.Client, auth)
 	c.containerRegistryClient = crc
+
+	tasksClient := resourcemanager.NewWithBaseURI(endpoint, "2019-04-01")
+	c.configureClient(&tasksClient.Client, auth)
+	c.containerRegistryTasksClient = tasksClient
 }
 
 func (c *ArmClient) registerContainerServicesClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -643,6 +960,34 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.sqlVirtualNetworkRulesClient = sqlVNRClient
 }
 
+func (c *ArmClient) registerDatabricksClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	workspacesClient := databricks.NewWorkspacesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&workspacesClient.Client, auth)
+	c.databricksWorkspacesClient = workspacesClient
+}
+
+func (c *ArmClient) registerDataFactoryClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	dataFactoryClient := datafactory.NewFactoriesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&dataFactoryClient.Client, auth)
+	c.dataFactoryClient = dataFactoryClient
+
+	dataFactoryDatasetClient := datafactory.NewDatasetsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&dataFactoryDatasetClient.Client, auth)
+	c.dataFactoryDatasetClient = dataFactoryDatasetClient
+
+	dataFactoryLinkedServiceClient := datafactory.NewLinkedServicesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&dataFactoryLinkedServiceClient.Client, auth)
+	c.dataFactoryLinkedServiceClient = dataFactoryLinkedServiceClient
+
+	dataFactoryPipelineClient := datafactory.NewPipelinesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&dataFactoryPipelineClient.Client, auth)
+	c.dataFactoryPipelineClient = dataFactoryPipelineClient
+
+	dataFactoryTriggerClient := datafactory.NewTriggersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&dataFactoryTriggerClient.Client, auth)
+	c.dataFactoryTriggerClient = dataFactoryTriggerClient
+}
+
 func (c *ArmClient) registerDataLakeStoreClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	storeAccountClient := storeAccount.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&storeAccountClient.Client, auth)
@@ -671,6 +1016,24 @@ func (c *ArmClient) registerDeviceClients(endpoint, subscriptionId string, auth
 	c.iothubResourceClient = iotClient
 }
 
+func (c *ArmClient) registerDevTestClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	labsClient := dtl.NewLabsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&labsClient.Client, auth)
+	c.devTestLabsClient = labsClient
+
+	schedulesClient := dtl.NewSchedulesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&schedulesClient.Client, auth)
+	c.devTestSchedulesClient = schedulesClient
+
+	virtualMachinesClient := dtl.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualMachinesClient.Client, auth)
+	c.devTestVirtualMachinesClient = virtualMachinesClient
+
+	virtualNetworksClient := dtl.NewVirtualNetworksClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualNetworksClient.Client, auth)
+	c.devTestVirtualNetworksClient = virtualNetworksClient
+}
+
 func (c *ArmClient) registerDNSClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	dn := dns.NewRecordSetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dn.Client, auth)
@@ -692,6 +1055,10 @@ func (c *ArmClient) registerEventHubClients(endpoint, subscriptionId string, aut
 	c.configureClient(&ehc.Client, auth)
 	c.eventHubClient = ehc
 
+	clustersClient := resourcemanager.NewWithBaseURI(endpoint, "2018-01-01-preview")
+	c.configureClient(&clustersClient.Client, auth)
+	c.eventHubClustersClient = clustersClient
+
 	chcgc := eventhub.NewConsumerGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&chcgc.Client, auth)
 	c.eventHubConsumerGroupClient = chcgc
@@ -699,6 +1066,14 @@ func (c *ArmClient) registerEventHubClients(endpoint, subscriptionId string, aut
 	ehnc := eventhub.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ehnc.Client, auth)
 	c.eventHubNamespacesClient = ehnc
+
+	namespacesPreviewClient := resourcemanager.NewWithBaseURI(endpoint, "2018-01-01-preview")
+	c.configureClient(&namespacesPreviewClient.Client, auth)
+	c.eventHubNamespacesPreviewClient = namespacesPreviewClient
+
+	ehdrc := eventhub.NewDisasterRecoveryConfigsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&ehdrc.Client, auth)
+	c.eventHubDisasterRecoveryClient = ehdrc
 }
 
 func (c *ArmClient) registerKeyVaultClients(endpoint, subscriptionId string, auth autorest.Authorizer, keyVaultAuth autorest.Authorizer, sender autorest.Sender) {
@@ -709,6 +1084,18 @@ func (c *ArmClient) registerKeyVaultClients(endpoint, subscriptionId string, aut
 	keyVaultManagementClient := keyVault.New()
 	c.configureClient(&keyVaultManagementClient.Client, keyVaultAuth)
 	c.keyVaultManagementClient = keyVaultManagementClient
+
+	// newer features of the Key Vault Data Plane (such as Key Release Policies) aren't available in the Key Vault SDK,
+	// the Base URI is the URI of the Key Vault, as such it's set on a copy of this client for each request
+	keyVaultDataPlaneClient := resourcemanager.NewWithBaseURI("", "7.3")
+	c.configureClient(&keyVaultDataPlaneClient.Client, keyVaultAuth)
+	c.keyVaultDataPlaneClient = keyVaultDataPlaneClient
+}
+
+func (c *ArmClient) registerKustoClients(endpoint string, auth autorest.Authorizer) {
+	kustoClient := resourcemanager.NewWithBaseURI(endpoint, "2019-01-21")
+	c.configureClient(&kustoClient.Client, auth)
+	c.kustoClient = kustoClient
 }
 
 func (c *ArmClient) registerLogicClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
@@ -717,15 +1104,51 @@ func (c *ArmClient) registerLogicClients(endpoint, subscriptionId string, auth a
 	c.logicWorkflowsClient = workflowsClient
 }
 
+func (c *ArmClient) registerMapsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	accountsClient := maps.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&accountsClient.Client, auth)
+	c.mapsAccountsClient = accountsClient
+}
+
+func (c *ArmClient) registerMediaServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	mediaServicesClient := media.NewServiceClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&mediaServicesClient.Client, auth)
+	c.mediaServicesClient = mediaServicesClient
+}
+
 func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	actionGroupsClient := insights.NewActionGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&actionGroupsClient.Client, auth)
 	c.actionGroupsClient = actionGroupsClient
 
+	actionRulesClient := resourcemanager.NewWithBaseURI(endpoint, "2019-05-05-preview")
+	c.configureClient(&actionRulesClient.Client, auth)
+	c.actionRulesClient = actionRulesClient
+
+	activityLogAlertsClient := insights.NewActivityLogAlertsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&activityLogAlertsClient.Client, auth)
+	c.monitorActivityLogAlertsClient = activityLogAlertsClient
+
 	arc := insights.NewAlertRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&arc.Client, auth)
 	c.monitorAlertRulesClient = arc
 
+	metricAlertsClient := resourcemanager.NewWithBaseURI(endpoint, "2018-03-01")
+	c.configureClient(&metricAlertsClient.Client, auth)
+	c.monitorMetricAlertsClient = metricAlertsClient
+
+	metricDefinitionsClient := insights.NewMetricDefinitionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&metricDefinitionsClient.Client, auth)
+	c.monitorMetricDefinitionsClient = metricDefinitionsClient
+
+	scheduledQueryRulesClient := insights.NewScheduledQueryRulesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scheduledQueryRulesClient.Client, auth)
+	c.monitorScheduledQueryRulesClient = scheduledQueryRulesClient
+
+	scheduledQueryRulesLogClient := resourcemanager.NewWithBaseURI(endpoint, "2018-04-16")
+	c.configureClient(&scheduledQueryRulesLogClient.Client, auth)
+	c.monitorScheduledQueryRulesLogClient = scheduledQueryRulesLogClient
+
 	autoscaleSettingsClient := insights.NewAutoscaleSettingsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&autoscaleSettingsClient.Client, auth)
 	c.autoscaleSettingsClient = autoscaleSettingsClient
@@ -740,6 +1163,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&appSecurityGroupsClient.Client, auth)
 	c.applicationSecurityGroupsClient = appSecurityGroupsClient
 
+	bgpServiceCommunitiesClient := network.NewBgpServiceCommunitiesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&bgpServiceCommunitiesClient.Client, auth)
+	c.bgpServiceCommunitiesClient = bgpServiceCommunitiesClient
+
 	expressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRouteAuthsClient.Client, auth)
 	c.expressRouteAuthsClient = expressRouteAuthsClient
@@ -752,6 +1179,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&expressRoutePeeringsClient.Client, auth)
 	c.expressRoutePeeringsClient = expressRoutePeeringsClient
 
+	hubVnetConnectionsClient := network.NewHubVirtualNetworkConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&hubVnetConnectionsClient.Client, auth)
+	c.hubVnetConnectionsClient = hubVnetConnectionsClient
+
 	interfacesClient := network.NewInterfacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&interfacesClient.Client, auth)
 	c.ifaceClient = interfacesClient
@@ -768,6 +1199,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&gatewaysClient.Client, auth)
 	c.vnetGatewayClient = gatewaysClient
 
+	gatewaysAadClient := resourcemanager.NewWithBaseURI(endpoint, "2019-08-01")
+	c.configureClient(&gatewaysAadClient.Client, auth)
+	c.vnetGatewayAadClient = gatewaysAadClient
+
 	gatewayConnectionsClient := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&gatewayConnectionsClient.Client, auth)
 	c.vnetGatewayConnectionsClient = gatewayConnectionsClient
@@ -788,6 +1223,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&publicIPAddressesClient.Client, auth)
 	c.publicIPClient = publicIPAddressesClient
 
+	routeFiltersClient := network.NewRouteFiltersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&routeFiltersClient.Client, auth)
+	c.routeFiltersClient = routeFiltersClient
+
 	routesClient := network.NewRoutesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&routesClient.Client, auth)
 	c.routesClient = routesClient
@@ -812,9 +1251,33 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&userAssignedIdentitiesClient.Client, auth)
 	c.userAssignedIdentitiesClient = userAssignedIdentitiesClient
 
+	virtualHubsClient := network.NewVirtualHubsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualHubsClient.Client, auth)
+	c.virtualHubsClient = virtualHubsClient
+
+	virtualWansClient := network.NewVirtualWANsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualWansClient.Client, auth)
+	c.virtualWansClient = virtualWansClient
+
+	vpnConnectionsClient := network.NewVpnConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnConnectionsClient.Client, auth)
+	c.vpnConnectionsClient = vpnConnectionsClient
+
+	vpnGatewaysClient := network.NewVpnGatewaysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnGatewaysClient.Client, auth)
+	c.vpnGatewaysClient = vpnGatewaysClient
+
+	vpnSitesClient := network.NewVpnSitesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnSitesClient.Client, auth)
+	c.vpnSitesClient = vpnSitesClient
+
 	watchersClient := network.NewWatchersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&watchersClient.Client, auth)
 	c.watcherClient = watchersClient
+
+	webApplicationFirewallPoliciesClient := resourcemanager.NewWithBaseURI(endpoint, "2019-09-01")
+	c.configureClient(&webApplicationFirewallPoliciesClient.Client, auth)
+	c.webApplicationFirewallPoliciesClient = webApplicationFirewallPoliciesClient
 }
 
 func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth *autorest.BearerAuthorizer, sender autorest.Sender) {
@@ -835,6 +1298,10 @@ func (c *ArmClient) registerOperationalInsightsClients(endpoint, subscriptionId
 	solutionsClient := operationsmanagement.NewSolutionsClientWithBaseURI(endpoint, subscriptionId, "Microsoft.OperationsManagement", "solutions", "testing")
 	c.configureClient(&solutionsClient.Client, auth)
 	c.solutionsClient = solutionsClient
+
+	logAnalyticsClient := resourcemanager.NewWithBaseURI(endpoint, "2020-08-01")
+	c.configureClient(&logAnalyticsClient.Client, auth)
+	c.logAnalyticsClient = logAnalyticsClient
 }
 
 func (c *ArmClient) registerRecoveryServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -858,6 +1325,10 @@ func (c *ArmClient) registerRedisClients(endpoint, subscriptionId string, auth a
 }
 
 func (c *ArmClient) registerRelayClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+	relayHybridConnectionsClient := relay.NewHybridConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&relayHybridConnectionsClient.Client, auth)
+	c.relayHybridConnectionsClient = relayHybridConnectionsClient
+
 	relayNamespacesClient := relay.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&relayNamespacesClient.Client, auth)
 	c.relayNamespacesClient = relayNamespacesClient
@@ -872,10 +1343,25 @@ func (c *ArmClient) registerResourcesClients(endpoint, subscriptionId string, au
 	c.configureClient(&deploymentsClient.Client, auth)
 	c.deploymentsClient = deploymentsClient
 
+	// the version of the Resources SDK used by this Provider doesn't support deployments at the Tenant scope
+	tenantDeploymentsClient := resourcemanager.NewWithBaseURI(endpoint, "2019-10-01")
+	c.configureClient(&tenantDeploymentsClient.Client, auth)
+	c.tenantDeploymentsClient = tenantDeploymentsClient
+
+	// the version of the Resources SDK used by this Provider doesn't support Tags at the Subscription scope
+	subscriptionTagsClient := resourcemanager.NewWithBaseURI(endpoint, "2019-10-01")
+	c.configureClient(&subscriptionTagsClient.Client, auth)
+	c.subscriptionTagsClient = subscriptionTagsClient
+
 	resourcesClient := resources.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&resourcesClient.Client, auth)
 	c.resourcesClient = resourcesClient
 
+	// the Resource Graph isn't available in the version of the Azure SDK used by this Provider
+	resourceGraphClient := resourcemanager.NewWithBaseURI(endpoint, "2019-04-01")
+	c.configureClient(&resourceGraphClient.Client, auth)
+	c.resourceGraphClient = resourceGraphClient
+
 	resourceGroupsClient := resources.NewGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&resourceGroupsClient.Client, auth)
 	c.resourceGroupsClient = resourceGroupsClient
@@ -887,6 +1373,19 @@ func (c *ArmClient) registerResourcesClients(endpoint, subscriptionId string, au
 	subscriptionsClient := subscriptions.NewClientWithBaseURI(endpoint)
 	c.configureClient(&subscriptionsClient.Client, auth)
 	c.subscriptionsClient = subscriptionsClient
+
+	// the version of the Subscriptions SDK used by this Provider doesn't expose the Display Name of a Tenant
+	tenantsClient := resourcemanager.NewWithBaseURI(endpoint, "2020-01-01")
+	c.configureClient(&tenantsClient.Client, auth)
+	c.tenantsClient = tenantsClient
+
+	managedApplicationDefinitionsClient := managedapplications.NewApplicationDefinitionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&managedApplicationDefinitionsClient.Client, auth)
+	c.managedApplicationDefinitionsClient = managedApplicationDefinitionsClient
+
+	managedApplicationsClient := managedapplications.NewApplicationsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&managedApplicationsClient.Client, auth)
+	c.managedApplicationsClient = managedApplicationsClient
 }
 
 func (c *ArmClient) registerSchedulerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -903,6 +1402,20 @@ func (c *ArmClient) registerSearchClients(endpoint, subscriptionId string, auth
 	searchClient := search.NewServicesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&searchClient.Client, auth)
 	c.searchServicesClient = searchClient
+
+	adminKeysClient := search.NewAdminKeysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&adminKeysClient.Client, auth)
+	c.searchAdminKeysClient = adminKeysClient
+
+	queryKeysClient := search.NewQueryKeysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&queryKeysClient.Client, auth)
+	c.searchQueryKeysClient = queryKeysClient
+}
+
+func (c *ArmClient) registerSecurityCenterClients(endpoint string, auth autorest.Authorizer) {
+	securityCenterClient := resourcemanager.NewWithBaseURI(endpoint, "2017-08-01-preview")
+	c.configureClient(&securityCenterClient.Client, auth)
+	c.securityCenterClient = securityCenterClient
 }
 
 func (c *ArmClient) registerServiceBusClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -914,6 +1427,10 @@ func (c *ArmClient) registerServiceBusClients(endpoint, subscriptionId string, a
 	c.configureClient(&namespacesClient.Client, auth)
 	c.serviceBusNamespacesClient = namespacesClient
 
+	disasterRecoveryClient := servicebus.NewDisasterRecoveryConfigsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&disasterRecoveryClient.Client, auth)
+	c.serviceBusDisasterRecoveryClient = disasterRecoveryClient
+
 	topicsClient := servicebus.NewTopicsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&topicsClient.Client, auth)
 	c.serviceBusTopicsClient = topicsClient
@@ -952,9 +1469,9 @@ func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId strin
 	c.configureClient(&geographicalHierarchiesClient.Client, auth)
 	c.trafficManagerGeographialHierarchiesClient = geographicalHierarchiesClient
 
-	profilesClient := trafficmanager.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&profilesClient.Client, auth)
-	c.trafficManagerProfilesClient = profilesClient
+	trafficManagerClient := resourcemanager.NewWithBaseURI(endpoint, "2018-08-01")
+	c.configureClient(&trafficManagerClient.Client, auth)
+	c.trafficManagerClient = trafficManagerClient
 }
 
 func (c *ArmClient) registerWebClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -962,6 +1479,10 @@ func (c *ArmClient) registerWebClients(endpoint, subscriptionId string, auth aut
 	c.configureClient(&appServicePlansClient.Client, auth)
 	c.appServicePlansClient = appServicePlansClient
 
+	appServicePlansElasticClient := resourcemanager.NewWithBaseURI(endpoint, "2019-08-01")
+	c.configureClient(&appServicePlansElasticClient.Client, auth)
+	c.appServicePlansElasticClient = appServicePlansElasticClient
+
 	appsClient := web.NewAppsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appsClient.Client, auth)
 	c.appServicesClient = appsClient
@@ -977,6 +1498,12 @@ func (c *ArmClient) registerPolicyClients(endpoint, subscriptionId string, auth
 	c.policyDefinitionsClient = policyDefinitionsClient
 }
 
+func (c *ArmClient) registerPortalClients(endpoint string, auth autorest.Authorizer) {
+	portalClient := resourcemanager.NewWithBaseURI(endpoint, "2015-08-01-preview")
+	c.configureClient(&portalClient.Client, auth)
+	c.portalClient = portalClient
+}
+
 func (c *ArmClient) registerManagementGroupClients(endpoint string, auth autorest.Authorizer) {
 	managementGroupsClient := managementgroups.NewClientWithBaseURI(endpoint)
 	c.configureClient(&managementGroupsClient.Client, auth)
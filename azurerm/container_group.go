@@ -0,0 +1,141 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2018-04-01/containerinstance"
+)
+
+// Probes, GPU's and Diagnostics for Container Groups aren't available in the version of the Azure SDK used by
+// this Provider, as such the Container Group is created using the `resourcemanager` client - these are the models
+// for the fields added in the API Version `2018-10-01`, which are merged into the Container Group from the SDK.
+
+type containerGroupExtensions struct {
+	Properties *containerGroupExtensionProperties `json:"properties,omitempty"`
+}
+
+type containerGroupExtensionProperties struct {
+	Containers  []containerExtensions      `json:"containers,omitempty"`
+	Diagnostics *containerGroupDiagnostics `json:"diagnostics,omitempty"`
+}
+
+type containerExtensions struct {
+	Name       string                        `json:"name"`
+	Properties *containerExtensionProperties `json:"properties,omitempty"`
+}
+
+type containerExtensionProperties struct {
+	LivenessProbe  *containerProbe               `json:"livenessProbe,omitempty"`
+	ReadinessProbe *containerProbe               `json:"readinessProbe,omitempty"`
+	Resources      *containerExtensionsResources `json:"resources,omitempty"`
+}
+
+type containerExtensionsResources struct {
+	Requests *containerExtensionsResourceRequests `json:"requests,omitempty"`
+}
+
+type containerExtensionsResourceRequests struct {
+	Gpu *containerGpuResource `json:"gpu,omitempty"`
+}
+
+type containerGpuResource struct {
+	Count int32  `json:"count"`
+	Sku   string `json:"sku"`
+}
+
+type containerProbe struct {
+	Exec                *containerExec    `json:"exec,omitempty"`
+	HTTPGet             *containerHTTPGet `json:"httpGet,omitempty"`
+	InitialDelaySeconds *int32            `json:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       *int32            `json:"periodSeconds,omitempty"`
+	FailureThreshold    *int32            `json:"failureThreshold,omitempty"`
+	SuccessThreshold    *int32            `json:"successThreshold,omitempty"`
+	TimeoutSeconds      *int32            `json:"timeoutSeconds,omitempty"`
+}
+
+type containerExec struct {
+	Command []string `json:"command"`
+}
+
+type containerHTTPGet struct {
+	Path   *string `json:"path,omitempty"`
+	Port   int32   `json:"port"`
+	Scheme *string `json:"scheme,omitempty"`
+}
+
+type containerGroupDiagnostics struct {
+	LogAnalytics *containerGroupLogAnalytics `json:"logAnalytics,omitempty"`
+}
+
+type containerGroupLogAnalytics struct {
+	WorkspaceID  string  `json:"workspaceId"`
+	WorkspaceKey string  `json:"workspaceKey,omitempty"`
+	LogType      *string `json:"logType,omitempty"`
+}
+
+// mergeContainerGroupExtensions returns the payload for the Container Group from the SDK, combined with
+// the Probes, GPU's and Diagnostics which are only available in the newer API Version
+func mergeContainerGroupExtensions(group containerinstance.ContainerGroup, extensions containerGroupExtensions) (map[string]interface{}, error) {
+	raw, err := json.Marshal(group)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing the Container Group: %+v", err)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, fmt.Errorf("Error deserializing the Container Group: %+v", err)
+	}
+
+	if extensions.Properties == nil {
+		return payload, nil
+	}
+
+	properties, ok := payload["properties"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Expected the Container Group to contain `properties`")
+	}
+
+	if diagnostics := extensions.Properties.Diagnostics; diagnostics != nil {
+		properties["diagnostics"] = diagnostics
+	}
+
+	containers, _ := properties["containers"].([]interface{})
+	for _, extension := range extensions.Properties.Containers {
+		if extension.Properties == nil {
+			continue
+		}
+
+		for _, raw := range containers {
+			container := raw.(map[string]interface{})
+			if container["name"] != extension.Name {
+				continue
+			}
+
+			containerProps, ok := container["properties"].(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("Expected the Container %q to contain `properties`", extension.Name)
+			}
+
+			if probe := extension.Properties.LivenessProbe; probe != nil {
+				containerProps["livenessProbe"] = probe
+			}
+
+			if probe := extension.Properties.ReadinessProbe; probe != nil {
+				containerProps["readinessProbe"] = probe
+			}
+
+			if resources := extension.Properties.Resources; resources != nil && resources.Requests != nil && resources.Requests.Gpu != nil {
+				resourcesProps, _ := containerProps["resources"].(map[string]interface{})
+				requests, _ := resourcesProps["requests"].(map[string]interface{})
+				if requests == nil {
+					return nil, fmt.Errorf("Expected the Container %q to contain `resources.requests`", extension.Name)
+				}
+
+				requests["gpu"] = resources.Requests.Gpu
+			}
+		}
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,131 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Container Registry Tasks (which support Timer Triggers, unlike the older Build Tasks) aren't available in the
+// version of the Azure SDK used by this Provider, as such these are managed using the `resourcemanager` client -
+// these are the models for the API Version `2019-04-01`.
+
+const (
+	containerRegistryTaskStatusEnabled  = "Enabled"
+	containerRegistryTaskStatusDisabled = "Disabled"
+
+	// the Base Image Trigger is disabled (rather than having a type) when `base_image_trigger` is set to `None`
+	containerRegistryTaskBaseImageTriggerNone = "None"
+
+	containerRegistryTaskSourceTriggerName    = "defaultSourceTriggerName"
+	containerRegistryTaskBaseImageTriggerName = "defaultBaseimageTriggerName"
+)
+
+type containerRegistryTask struct {
+	ID         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Location   *string                          `json:"location,omitempty"`
+	Tags       map[string]*string               `json:"tags,omitempty"`
+	Properties *containerRegistryTaskProperties `json:"properties,omitempty"`
+}
+
+type containerRegistryTaskProperties struct {
+	Status             string                                   `json:"status,omitempty"`
+	Platform           *containerRegistryTaskPlatform           `json:"platform,omitempty"`
+	AgentConfiguration *containerRegistryTaskAgentConfiguration `json:"agentConfiguration,omitempty"`
+	Timeout            *int32                                   `json:"timeout,omitempty"`
+	Step               *containerRegistryTaskDockerStep         `json:"step,omitempty"`
+	Trigger            *containerRegistryTaskTrigger            `json:"trigger,omitempty"`
+}
+
+type containerRegistryTaskPlatform struct {
+	OS string `json:"os,omitempty"`
+}
+
+type containerRegistryTaskAgentConfiguration struct {
+	CPU *int32 `json:"cpu,omitempty"`
+}
+
+type containerRegistryTaskDockerStep struct {
+	Type               string                           `json:"type"`
+	DockerFilePath     *string                          `json:"dockerFilePath,omitempty"`
+	ContextPath        *string                          `json:"contextPath,omitempty"`
+	ContextAccessToken *string                          `json:"contextAccessToken,omitempty"`
+	ImageNames         *[]string                        `json:"imageNames,omitempty"`
+	IsPushEnabled      *bool                            `json:"isPushEnabled,omitempty"`
+	NoCache            *bool                            `json:"noCache,omitempty"`
+	Arguments          *[]containerRegistryTaskArgument `json:"arguments,omitempty"`
+}
+
+type containerRegistryTaskArgument struct {
+	Name     *string `json:"name,omitempty"`
+	Value    *string `json:"value,omitempty"`
+	IsSecret *bool   `json:"isSecret,omitempty"`
+}
+
+type containerRegistryTaskTrigger struct {
+	SourceTriggers   *[]containerRegistryTaskSourceTrigger  `json:"sourceTriggers,omitempty"`
+	BaseImageTrigger *containerRegistryTaskBaseImageTrigger `json:"baseImageTrigger,omitempty"`
+	TimerTriggers    *[]containerRegistryTaskTimerTrigger   `json:"timerTriggers,omitempty"`
+}
+
+type containerRegistryTaskSourceTrigger struct {
+	Name                *string                                `json:"name,omitempty"`
+	SourceRepository    *containerRegistryTaskSourceRepository `json:"sourceRepository,omitempty"`
+	SourceTriggerEvents *[]string                              `json:"sourceTriggerEvents,omitempty"`
+	Status              string                                 `json:"status,omitempty"`
+}
+
+type containerRegistryTaskSourceRepository struct {
+	SourceControlType           string                         `json:"sourceControlType,omitempty"`
+	RepositoryURL               *string                        `json:"repositoryUrl,omitempty"`
+	Branch                      *string                        `json:"branch,omitempty"`
+	SourceControlAuthProperties *containerRegistryTaskAuthInfo `json:"sourceControlAuthProperties,omitempty"`
+}
+
+type containerRegistryTaskAuthInfo struct {
+	TokenType string  `json:"tokenType,omitempty"`
+	Token     *string `json:"token,omitempty"`
+}
+
+type containerRegistryTaskBaseImageTrigger struct {
+	Name                 *string `json:"name,omitempty"`
+	BaseImageTriggerType string  `json:"baseImageTriggerType,omitempty"`
+	Status               string  `json:"status,omitempty"`
+}
+
+type containerRegistryTaskTimerTrigger struct {
+	Name     *string `json:"name,omitempty"`
+	Schedule *string `json:"schedule,omitempty"`
+	Status   string  `json:"status,omitempty"`
+}
+
+// buildContainerRegistryTaskContextPath returns the Git context used by the Docker Step, which takes the
+// form `{repositoryUrl}#{branch}:{folder}` - where the folder is omitted for the root of the repository
+func buildContainerRegistryTaskContextPath(repositoryUrl, branch, contextPath string) string {
+	output := fmt.Sprintf("%s#%s", repositoryUrl, branch)
+
+	if folder := strings.Trim(contextPath, "/"); folder != "" {
+		output = fmt.Sprintf("%s:%s", output, folder)
+	}
+
+	return output
+}
+
+// parseContainerRegistryTaskContextPath returns the branch and context path from the Git context used by the
+// Docker Step - defaulting to the root of the repository
+func parseContainerRegistryTaskContextPath(input string) (branch string, contextPath string) {
+	contextPath = "/"
+
+	index := strings.LastIndex(input, "#")
+	if index == -1 {
+		return "", contextPath
+	}
+
+	branch = input[index+1:]
+	if segments := strings.SplitN(branch, ":", 2); len(segments) == 2 {
+		branch = segments[0]
+		contextPath = fmt.Sprintf("/%s", segments[1])
+	}
+
+	return branch, contextPath
+}
@@ -0,0 +1,200 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func validateAzureRMDataFactoryName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("invalid name for Data Factory %q (%q): the name must begin with a letter or number, contain only letters, numbers and hyphens, and every hyphen must be immediately preceded and followed by a letter or number", k, value))
+	}
+
+	if len(value) < 3 || len(value) > 63 {
+		errors = append(errors, fmt.Errorf("invalid name for Data Factory %q (%q): the name must be between 3 and 63 characters long", k, value))
+	}
+
+	return
+}
+
+func validateAzureRMDataFactoryChildName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[A-Za-z0-9_][^<>*#.%&:\\+?/]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("invalid name %q (%q): the name must begin with a letter, number or underscore and cannot contain the characters <>*#.%%&:\\+?/", k, value))
+	}
+
+	if len(value) > 260 {
+		errors = append(errors, fmt.Errorf("invalid name %q (%q): the name must be no longer than 260 characters", k, value))
+	}
+
+	return
+}
+
+func dataFactoryParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+	}
+}
+
+func dataFactoryAnnotationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// expandDataFactoryParameters expands a map of default values into String parameters
+func expandDataFactoryParameters(input map[string]interface{}) map[string]*datafactory.ParameterSpecification {
+	output := make(map[string]*datafactory.ParameterSpecification)
+
+	for k, v := range input {
+		output[k] = &datafactory.ParameterSpecification{
+			Type:         datafactory.ParameterTypeString,
+			DefaultValue: v.(string),
+		}
+	}
+
+	return output
+}
+
+func flattenDataFactoryParameters(input map[string]*datafactory.ParameterSpecification) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	for k, v := range input {
+		if v == nil {
+			continue
+		}
+
+		// we only support String parameters, so skip anything else which may have been created elsewhere
+		if value, ok := v.DefaultValue.(string); ok {
+			output[k] = value
+		}
+	}
+
+	return output
+}
+
+func expandDataFactoryAnnotations(input []interface{}) *[]interface{} {
+	annotations := make([]interface{}, 0)
+
+	for _, v := range input {
+		annotations = append(annotations, v.(string))
+	}
+
+	return &annotations
+}
+
+func flattenDataFactoryAnnotations(input *[]interface{}) []string {
+	annotations := make([]string, 0)
+	if input == nil {
+		return annotations
+	}
+
+	for _, v := range *input {
+		if value, ok := v.(string); ok {
+			annotations = append(annotations, value)
+		}
+	}
+
+	return annotations
+}
+
+func expandDataFactoryIntegrationRuntimeReference(name string) *datafactory.IntegrationRuntimeReference {
+	if name == "" {
+		return nil
+	}
+
+	return &datafactory.IntegrationRuntimeReference{
+		Type:          utils.String("IntegrationRuntimeReference"),
+		ReferenceName: utils.String(name),
+	}
+}
+
+func flattenDataFactoryIntegrationRuntimeReference(input *datafactory.IntegrationRuntimeReference) string {
+	if input == nil || input.ReferenceName == nil {
+		return ""
+	}
+
+	return *input.ReferenceName
+}
+
+func expandDataFactoryLinkedServiceReference(name string) *datafactory.LinkedServiceReference {
+	return &datafactory.LinkedServiceReference{
+		Type:          utils.String("LinkedServiceReference"),
+		ReferenceName: utils.String(name),
+	}
+}
+
+func flattenDataFactoryLinkedServiceReference(input *datafactory.LinkedServiceReference) string {
+	if input == nil || input.ReferenceName == nil {
+		return ""
+	}
+
+	return *input.ReferenceName
+}
+
+// suppressDataFactoryJsonDiff compares two JSON documents after removing any empty values, since the
+// Data Factory API populates empty arrays/objects (e.g. `dependsOn` and `userProperties`) on Activities
+func suppressDataFactoryJsonDiff(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(removeEmptyDataFactoryJsonValues(oldValue), removeEmptyDataFactoryJsonValues(newValue))
+}
+
+func removeEmptyDataFactoryJsonValues(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{})
+		for key, val := range v {
+			cleaned := removeEmptyDataFactoryJsonValues(val)
+			if isEmptyDataFactoryJsonValue(cleaned) {
+				continue
+			}
+			output[key] = cleaned
+		}
+		return output
+
+	case []interface{}:
+		output := make([]interface{}, 0)
+		for _, val := range v {
+			output = append(output, removeEmptyDataFactoryJsonValues(val))
+		}
+		return output
+	}
+
+	return input
+}
+
+func isEmptyDataFactoryJsonValue(input interface{}) bool {
+	switch v := input.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+
+	return false
+}
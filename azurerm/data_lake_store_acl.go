@@ -0,0 +1,288 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the ACL's of Files and Folders within a Data Lake Store are managed in the same way - however Default ACE's
+// (which are inherited by new children) can only be assigned to Folders
+
+func dataLakeStoreACLSchema(pathField string, supportsDefaultScope bool) map[string]*schema.Schema {
+	scopes := []string{"access"}
+	if supportsDefaultScope {
+		scopes = append(scopes, "default")
+	}
+
+	return map[string]*schema.Schema{
+		"account_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		pathField: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateFilePath(),
+		},
+
+		"owner": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+
+		"group": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+
+		"ace": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"scope": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "access",
+						ValidateFunc: validation.StringInSlice(scopes, false),
+					},
+
+					"type": {
+						Type:     schema.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"user",
+							"group",
+							"mask",
+							"other",
+						}, false),
+					},
+
+					"id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+
+					"permissions": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateDataLakeStoreACEPermissions,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmDataLakeStoreACLCreateUpdate(d *schema.ResourceData, meta interface{}, pathField string) error {
+	client := meta.(*ArmClient).dataLakeStoreFilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	accountName := d.Get("account_name").(string)
+	path := d.Get(pathField).(string)
+
+	if d.HasChange("owner") || d.HasChange("group") {
+		owner := d.Get("owner").(string)
+		group := d.Get("group").(string)
+		if owner != "" || group != "" {
+			if _, err := client.SetOwner(ctx, accountName, path, owner, group); err != nil {
+				return fmt.Errorf("Error setting Owner for %q (Data Lake Store %q): %+v", path, accountName, err)
+			}
+		}
+	}
+
+	aclSpec := expandDataLakeStoreACLSpec(d.Get("ace").(*schema.Set).List())
+	if _, err := client.SetACL(ctx, accountName, path, aclSpec); err != nil {
+		return fmt.Errorf("Error setting ACL for %q (Data Lake Store %q): %+v", path, accountName, err)
+	}
+
+	if d.IsNewResource() {
+		// example.azuredatalakestore.net/test/example.txt
+		d.SetId(fmt.Sprintf("%s.%s%s", accountName, client.AdlsFileSystemDNSSuffix, path))
+	}
+
+	return resourceArmDataLakeStoreACLRead(d, meta, pathField)
+}
+
+func resourceArmDataLakeStoreACLRead(d *schema.ResourceData, meta interface{}, pathField string) error {
+	client := meta.(*ArmClient).dataLakeStoreFilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseDataLakeStoreFileId(d.Id(), client.AdlsFileSystemDNSSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetACLStatus(ctx, id.storageAccountName, id.filePath, utils.Bool(false))
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Data Lake Store Path %q was not found (Account %q) - removing from state!", id.filePath, id.storageAccountName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving ACL for %q (Data Lake Store %q): %+v", id.filePath, id.storageAccountName, err)
+	}
+
+	d.Set("account_name", id.storageAccountName)
+	d.Set(pathField, id.filePath)
+
+	if status := resp.ACLStatus; status != nil {
+		d.Set("owner", status.Owner)
+		d.Set("group", status.Group)
+
+		if err := d.Set("ace", flattenDataLakeStoreACLEntries(status.Entries, status.Permission)); err != nil {
+			return fmt.Errorf("Error setting `ace`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmDataLakeStoreACLDelete(d *schema.ResourceData, meta interface{}, removeDefaultACL bool) error {
+	client := meta.(*ArmClient).dataLakeStoreFilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseDataLakeStoreFileId(d.Id(), client.AdlsFileSystemDNSSuffix)
+	if err != nil {
+		return err
+	}
+
+	// the base entries (and the owner) can't be removed - so only the extended entries are removed
+	resp, err := client.RemoveACL(ctx, id.storageAccountName, id.filePath)
+	if err != nil {
+		if response.WasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error removing ACL from %q (Data Lake Store %q): %+v", id.filePath, id.storageAccountName, err)
+	}
+
+	if removeDefaultACL {
+		resp, err := client.RemoveDefaultACL(ctx, id.storageAccountName, id.filePath)
+		if err != nil && !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error removing Default ACL from %q (Data Lake Store %q): %+v", id.filePath, id.storageAccountName, err)
+		}
+	}
+
+	return nil
+}
+
+// expandDataLakeStoreACLSpec builds the ACL Spec (e.g. `user::rwx,user:00000000-0000-0000-0000-000000000000:r-x`) from the ACE's
+func expandDataLakeStoreACLSpec(input []interface{}) string {
+	entries := make([]string, 0)
+	for _, v := range input {
+		ace := v.(map[string]interface{})
+
+		entry := fmt.Sprintf("%s:%s:%s", ace["type"].(string), ace["id"].(string), ace["permissions"].(string))
+		if ace["scope"].(string) == "default" {
+			entry = fmt.Sprintf("default:%s", entry)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return strings.Join(entries, ",")
+}
+
+// flattenDataLakeStoreACLEntries parses the ACL entries returned from the API - the base entries for the owning
+// user, owning group and others aren't always included in these, in which case they're taken from the permission
+func flattenDataLakeStoreACLEntries(entries *[]string, permission *string) []interface{} {
+	results := make([]interface{}, 0)
+	baseEntries := map[string]bool{}
+
+	if entries != nil {
+		for _, entry := range *entries {
+			scope := "access"
+			parts := strings.Split(entry, ":")
+			if len(parts) == 4 && parts[0] == "default" {
+				scope = "default"
+				parts = parts[1:]
+			}
+			if len(parts) != 3 {
+				log.Printf("[DEBUG] Unable to parse Data Lake Store ACL entry %q - ignoring", entry)
+				continue
+			}
+
+			if scope == "access" && parts[1] == "" {
+				baseEntries[parts[0]] = true
+			}
+
+			results = append(results, map[string]interface{}{
+				"scope":       scope,
+				"type":        parts[0],
+				"id":          parts[1],
+				"permissions": parts[2],
+			})
+		}
+	}
+
+	if permission == nil || len(*permission) < 3 {
+		return results
+	}
+
+	// e.g. `770` or `1770` when the sticky bit is set
+	octal := (*permission)[len(*permission)-3:]
+	for i, entryType := range []string{"user", "group", "other"} {
+		// when a mask is present the group digit of the permission is the mask, rather than the owning group
+		if baseEntries[entryType] || (entryType == "group" && baseEntries["mask"]) {
+			continue
+		}
+
+		permissions, ok := dataLakeStoreOctalToPermissions(octal[i])
+		if !ok {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"scope":       "access",
+			"type":        entryType,
+			"id":          "",
+			"permissions": permissions,
+		})
+	}
+
+	return results
+}
+
+func dataLakeStoreOctalToPermissions(input byte) (string, bool) {
+	if input < '0' || input > '7' {
+		return "", false
+	}
+
+	value := input - '0'
+	permissions := []byte("---")
+	if value&4 != 0 {
+		permissions[0] = 'r'
+	}
+	if value&2 != 0 {
+		permissions[1] = 'w'
+	}
+	if value&1 != 0 {
+		permissions[2] = 'x'
+	}
+
+	return string(permissions), true
+}
+
+func validateDataLakeStoreACEPermissions(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[r-][w-][x-]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be in the format `rwx`, with `-` in place of any permissions which aren't granted (e.g. `r-x`)", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,104 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandDataLakeStoreACLSpec(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"scope":       "access",
+			"type":        "user",
+			"id":          "",
+			"permissions": "rwx",
+		},
+		map[string]interface{}{
+			"scope":       "access",
+			"type":        "user",
+			"id":          "00000000-0000-0000-0000-000000000000",
+			"permissions": "r-x",
+		},
+		map[string]interface{}{
+			"scope":       "default",
+			"type":        "group",
+			"id":          "11111111-1111-1111-1111-111111111111",
+			"permissions": "r--",
+		},
+	}
+
+	expected := "user::rwx,user:00000000-0000-0000-0000-000000000000:r-x,default:group:11111111-1111-1111-1111-111111111111:r--"
+	if actual := expandDataLakeStoreACLSpec(input); actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestFlattenDataLakeStoreACLEntries(t *testing.T) {
+	testCases := []struct {
+		name       string
+		entries    *[]string
+		permission *string
+		expected   []interface{}
+	}{
+		{
+			name:       "base entries taken from the permission",
+			entries:    &[]string{"user:00000000-0000-0000-0000-000000000000:r-x"},
+			permission: utils.String("1750"),
+			expected: []interface{}{
+				map[string]interface{}{"scope": "access", "type": "user", "id": "00000000-0000-0000-0000-000000000000", "permissions": "r-x"},
+				map[string]interface{}{"scope": "access", "type": "user", "id": "", "permissions": "rwx"},
+				map[string]interface{}{"scope": "access", "type": "group", "id": "", "permissions": "r-x"},
+				map[string]interface{}{"scope": "access", "type": "other", "id": "", "permissions": "---"},
+			},
+		},
+		{
+			name:       "base entries returned from the API",
+			entries:    &[]string{"user::rwx", "group::r-x", "mask::r-x", "other::---", "default:user::rwx"},
+			permission: utils.String("750"),
+			expected: []interface{}{
+				map[string]interface{}{"scope": "access", "type": "user", "id": "", "permissions": "rwx"},
+				map[string]interface{}{"scope": "access", "type": "group", "id": "", "permissions": "r-x"},
+				map[string]interface{}{"scope": "access", "type": "mask", "id": "", "permissions": "r-x"},
+				map[string]interface{}{"scope": "access", "type": "other", "id": "", "permissions": "---"},
+				map[string]interface{}{"scope": "default", "type": "user", "id": "", "permissions": "rwx"},
+			},
+		},
+		{
+			name:       "invalid entries are ignored",
+			entries:    &[]string{"user"},
+			permission: nil,
+			expected:   []interface{}{},
+		},
+	}
+
+	for _, test := range testCases {
+		actual := flattenDataLakeStoreACLEntries(test.entries, test.permission)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Fatalf("Expected %+v for %q but got %+v", test.expected, test.name, actual)
+		}
+	}
+}
+
+func TestValidateDataLakeStoreACEPermissions(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "rwx", ErrCount: 0},
+		{Value: "r-x", ErrCount: 0},
+		{Value: "---", ErrCount: 0},
+		{Value: "", ErrCount: 1},
+		{Value: "xwr", ErrCount: 1},
+		{Value: "rwxr", ErrCount: 1},
+		{Value: "7", ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateDataLakeStoreACEPermissions(tc.Value, "permissions")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmAppConfigurationKey() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmAppConfigurationKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"configuration_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"locked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmAppConfigurationKeyRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	id := appConfigurationKeyID{
+		ConfigurationStoreID: d.Get("configuration_store_id").(string),
+		Key:                  d.Get("key").(string),
+		Label:                d.Get("label").(string),
+	}
+
+	client, err := appConfigurationKeyValuesClient(ctx, meta, id.ConfigurationStoreID)
+	if err != nil {
+		return err
+	}
+
+	keyValue, err := client.Get(ctx, id.Key, id.Label)
+	if err != nil {
+		if utils.ResponseWasNotFound(keyValue.Response) {
+			return fmt.Errorf("Error: Key %q (Label %q) was not found in Configuration Store %q", id.Key, id.Label, id.ConfigurationStoreID)
+		}
+
+		return fmt.Errorf("Error retrieving Key %q (Label %q / Configuration Store %q): %+v", id.Key, id.Label, id.ConfigurationStoreID, err)
+	}
+
+	d.SetId(id.String())
+
+	d.Set("value", keyValue.Value)
+	d.Set("content_type", keyValue.ContentType)
+	d.Set("etag", keyValue.Etag)
+	d.Set("locked", keyValue.Locked)
+
+	flattenAndSetTags(d, keyValue.Tags, nil)
+
+	return nil
+}
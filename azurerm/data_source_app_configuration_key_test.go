@@ -0,0 +1,45 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMAppConfigurationKey_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_app_configuration_key.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMAppConfigurationKey_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "value", "hello"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(dataSourceName, "locked", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.environment", "Production"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMAppConfigurationKey_basic(rInt int, location string) string {
+	template := testAccAzureRMAppConfigurationKey_complete(rInt, location, "hello", "text/plain")
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_app_configuration_key" "test" {
+  configuration_store_id = "${azurerm_app_configuration_key.test.configuration_store_id}"
+  key                    = "${azurerm_app_configuration_key.test.key}"
+  label                  = "${azurerm_app_configuration_key.test.label}"
+}
+`, template)
+}
@@ -159,7 +159,7 @@ func dataSourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	err = siteCredFuture.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &siteCredFuture.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -207,7 +207,7 @@ func dataSourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, nil)
 
 	return nil
 }
@@ -116,7 +116,7 @@ func dataSourceAppServicePlanRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("sku", flattenAppServicePlanSku(sku))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, nil)
 
 	return nil
 }
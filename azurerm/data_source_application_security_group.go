@@ -50,7 +50,7 @@ func dataSourceArmApplicationSecurityGroupRead(d *schema.ResourceData, meta inte
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, nil)
 
 	return nil
 }
@@ -98,7 +98,7 @@ func dataSourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{}
 		d.Set("admin_password", "")
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, nil)
 
 	return nil
 }
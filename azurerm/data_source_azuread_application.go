@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,14 +18,21 @@ func dataSourceArmAzureADApplication() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Computed:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "application_id"},
 			},
 
 			"name": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Computed:      true,
-				ConflictsWith: []string{"object_id"},
+				ConflictsWith: []string{"object_id", "application_id"},
+			},
+
+			"application_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"object_id", "name"},
 			},
 
 			"homepage": {
@@ -57,11 +65,6 @@ func dataSourceArmAzureADApplication() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-
-			"application_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
 		},
 	}
 }
@@ -85,28 +88,27 @@ func dataSourceArmAzureADApplicationRead(d *schema.ResourceData, meta interface{
 
 		application = resp
 	} else {
-		resp, err := client.ListComplete(ctx, "")
-		if err != nil {
-			return fmt.Errorf("Error listing Azure AD Applications: %+v", err)
+		var filter string
+		var description string
+		if v, ok := d.GetOk("application_id"); ok {
+			filter = fmt.Sprintf("appId eq '%s'", escapeAzureADFilterValue(v.(string)))
+			description = fmt.Sprintf("an Application ID of %q", v.(string))
+		} else {
+			name := d.Get("name").(string)
+			filter = fmt.Sprintf("displayName eq '%s'", escapeAzureADFilterValue(name))
+			description = fmt.Sprintf("a name of %q", name)
 		}
 
-		name := d.Get("name").(string)
-
-		var app *graphrbac.Application
-		for _, v := range *resp.Response().Value {
-			if v.DisplayName != nil {
-				if *v.DisplayName == name {
-					app = &v
-					break
-				}
-			}
+		results, err := client.ListComplete(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("Error listing Azure AD Applications: %+v", err)
 		}
 
-		if app == nil {
-			return fmt.Errorf("Couldn't locate an Azure AD Application with a name of %q", name)
+		if !results.NotDone() {
+			return fmt.Errorf("Couldn't locate an Azure AD Application with %s", description)
 		}
 
-		application = *app
+		application = results.Value()
 	}
 
 	d.SetId(*application.ObjectID)
@@ -154,3 +156,8 @@ func flattenAzureADDataSourceApplicationReplyUrls(input *[]string) []string {
 
 	return output
 }
+
+// escapeAzureADFilterValue escapes a value for use within an OData filter, where single quotes are doubled
+func escapeAzureADFilterValue(input string) string {
+	return strings.Replace(input, "'", "''", -1)
+}
@@ -93,6 +93,32 @@ func TestAccDataSourceAzureRMAzureADApplication_byName(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAzureRMAzureADApplication_byApplicationId(t *testing.T) {
+	dataSourceName := "data.azurerm_azuread_application.test"
+	id := uuid.New().String()
+	config := testAccDataSourceAzureRMAzureADApplication_applicationId(id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMActiveDirectoryApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMActiveDirectoryApplication_basic(id),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryApplicationExists(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "name", fmt.Sprintf("acctest%s", id)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "object_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "application_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceAzureRMAzureADApplication_objectId(id string) string {
 	template := testAccAzureRMActiveDirectoryApplication_basic(id)
 	return fmt.Sprintf(`
@@ -125,3 +151,14 @@ data "azurerm_azuread_application" "test" {
 }
 `, template)
 }
+
+func testAccDataSourceAzureRMAzureADApplication_applicationId(id string) string {
+	template := testAccAzureRMActiveDirectoryApplication_basic(id)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_azuread_application" "test" {
+  application_id = "${azurerm_azuread_application.test.application_id}"
+}
+`, template)
+}
@@ -56,46 +56,28 @@ func dataSourceArmActiveDirectoryServicePrincipalRead(d *schema.ResourceData, me
 
 		servicePrincipal = &app
 	} else {
-		apps, err := client.ListComplete(ctx, "")
-		if err != nil {
-			return fmt.Errorf("Error listing Service Principals: %+v", err)
-		}
-
+		var filter string
+		var description string
 		if v, ok := d.GetOk("display_name"); ok {
-			displayName := v.(string)
-
-			for _, app := range *apps.Response().Value {
-				if app.DisplayName == nil {
-					continue
-				}
-
-				if *app.DisplayName == displayName {
-					servicePrincipal = &app
-					break
-				}
-			}
-
-			if servicePrincipal == nil {
-				return fmt.Errorf("A Service Principal with the Display Name %q was not found", displayName)
-			}
+			filter = fmt.Sprintf("displayName eq '%s'", escapeAzureADFilterValue(v.(string)))
+			description = fmt.Sprintf("with the Display Name %q", v.(string))
 		} else {
 			applicationId := d.Get("application_id").(string)
+			filter = fmt.Sprintf("appId eq '%s'", escapeAzureADFilterValue(applicationId))
+			description = fmt.Sprintf("for Application ID %q", applicationId)
+		}
 
-			for _, app := range *apps.Response().Value {
-				if app.AppID == nil {
-					continue
-				}
-
-				if *app.AppID == applicationId {
-					servicePrincipal = &app
-					break
-				}
-			}
+		results, err := client.ListComplete(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("Error listing Service Principals: %+v", err)
+		}
 
-			if servicePrincipal == nil {
-				return fmt.Errorf("A Service Principal for Application ID %q was not found", applicationId)
-			}
+		if !results.NotDone() {
+			return fmt.Errorf("A Service Principal %s was not found", description)
 		}
+
+		app := results.Value()
+		servicePrincipal = &app
 	}
 
 	d.SetId(*servicePrincipal.ObjectID)
@@ -0,0 +1,143 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmBgpServiceCommunities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmBgpServiceCommunitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				StateFunc:        azureRMNormalizeLocation,
+				DiffSuppressFunc: azureRMSuppressLocationDiff,
+			},
+
+			"service_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"communities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefixes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmBgpServiceCommunitiesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).bgpServiceCommunitiesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Listing BGP Service Communities")
+	results, err := client.ListComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error listing BGP Service Communities: %+v", err)
+	}
+
+	communities := make([]interface{}, 0)
+	for results.NotDone() {
+		service := results.Value()
+
+		if props := service.BgpServiceCommunityPropertiesFormat; props != nil && props.BgpCommunities != nil {
+			name := ""
+			if props.ServiceName != nil {
+				name = *props.ServiceName
+			}
+
+			if serviceName == "" || strings.EqualFold(serviceName, name) {
+				for _, community := range *props.BgpCommunities {
+					region := ""
+					if community.ServiceSupportedRegion != nil {
+						region = *community.ServiceSupportedRegion
+					}
+
+					// Global communities are available in every region
+					if location != "" && !strings.EqualFold(region, "Global") && azureRMNormalizeLocation(region) != location {
+						continue
+					}
+
+					output := map[string]interface{}{
+						"service_name": name,
+						"region":       region,
+					}
+
+					if community.CommunityName != nil {
+						output["name"] = *community.CommunityName
+					}
+
+					if community.CommunityValue != nil {
+						output["value"] = *community.CommunityValue
+					}
+
+					if community.ServiceGroup != nil {
+						output["service_group"] = *community.ServiceGroup
+					}
+
+					prefixes := make([]interface{}, 0)
+					if community.CommunityPrefixes != nil {
+						for _, v := range *community.CommunityPrefixes {
+							prefixes = append(prefixes, v)
+						}
+					}
+					output["prefixes"] = prefixes
+
+					communities = append(communities, output)
+				}
+			}
+		}
+
+		if err := results.Next(); err != nil {
+			return fmt.Errorf("Error listing BGP Service Communities: %+v", err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("communities", communities); err != nil {
+		return fmt.Errorf("Error setting `communities`: %+v", err)
+	}
+
+	return nil
+}
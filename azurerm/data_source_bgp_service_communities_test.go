@@ -0,0 +1,56 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMBgpServiceCommunities_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_bgp_service_communities.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMBgpServiceCommunities_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "communities.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMBgpServiceCommunities_location(t *testing.T) {
+	dataSourceName := "data.azurerm_bgp_service_communities.test"
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMBgpServiceCommunities_location(location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "communities.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "communities.0.value"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAzureRMBgpServiceCommunities_basic = `
+data "azurerm_bgp_service_communities" "test" {}
+`
+
+func testAccDataSourceAzureRMBgpServiceCommunities_location(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_bgp_service_communities" "test" {
+  location = "%s"
+}
+`, location)
+}
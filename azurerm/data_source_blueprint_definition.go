@@ -0,0 +1,119 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmBlueprintDefinition() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmBlueprintDefinitionRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"scope_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateBlueprintScopeID,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"target_scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmBlueprintDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).blueprintClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	scope := strings.TrimSuffix(d.Get("scope_id").(string), "/")
+
+	id := fmt.Sprintf("%s/providers/Microsoft.Blueprint/blueprints/%s", scope, name)
+	var definition blueprintDefinition
+	resp, err := client.Get(ctx, id, &definition)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error: Blueprint Definition %q was not found in Scope %q", name, scope)
+		}
+
+		return fmt.Errorf("Error retrieving Blueprint Definition %q (Scope %q): %+v", name, scope, err)
+	}
+
+	values, err := client.List(ctx, fmt.Sprintf("%s/versions", id))
+	if err != nil {
+		return fmt.Errorf("Error listing the Published Versions of Blueprint Definition %q (Scope %q): %+v", name, scope, err)
+	}
+
+	versions := make([]interface{}, 0)
+	for _, value := range values {
+		var version blueprintPublishedVersion
+		if err := json.Unmarshal(value, &version); err != nil {
+			return fmt.Errorf("Error unmarshalling the Published Versions of Blueprint Definition %q (Scope %q): %+v", name, scope, err)
+		}
+
+		if version.Name != nil {
+			versions = append(versions, *version.Name)
+		}
+	}
+
+	d.SetId(id)
+
+	if props := definition.Properties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+		d.Set("target_scope", props.TargetScope)
+
+		if status := props.Status; status != nil {
+			d.Set("time_created", status.TimeCreated)
+			d.Set("last_modified", status.LastModified)
+		}
+	}
+
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("Error setting `versions`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceAzureRMBlueprintDefinition_basic(t *testing.T) {
+	blueprintName, blueprintVersion := testAccAzureRMBlueprintPreCheck(t)
+
+	dataSourceName := "data.azurerm_blueprint_definition.test"
+	config := testAccDataSourceAzureRMBlueprintDefinition_basic(blueprintName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", blueprintName),
+					resource.TestCheckResourceAttr(dataSourceName, "target_scope", "subscription"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "time_created"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.#"),
+					testCheckAzureRMBlueprintDefinitionHasVersion(dataSourceName, blueprintVersion),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBlueprintDefinitionHasVersion(dataSourceName string, version string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", dataSourceName)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "versions.") && k != "versions.#" && v == version {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Expected the Published Version %q to be returned for %s", version, dataSourceName)
+	}
+}
+
+func testAccDataSourceAzureRMBlueprintDefinition_basic(blueprintName string) string {
+	return fmt.Sprintf(`
+data "azurerm_subscription" "current" {}
+
+data "azurerm_blueprint_definition" "test" {
+  name     = "%s"
+  scope_id = "${data.azurerm_subscription.current.id}"
+}
+`, blueprintName)
+}
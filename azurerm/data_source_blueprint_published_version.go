@@ -0,0 +1,108 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmBlueprintPublishedVersion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmBlueprintPublishedVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"scope_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateBlueprintScopeID,
+			},
+
+			"blueprint_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"target_scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"change_notes": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmBlueprintPublishedVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).blueprintClient
+	ctx := meta.(*ArmClient).StopContext
+
+	scope := strings.TrimSuffix(d.Get("scope_id").(string), "/")
+	blueprintName := d.Get("blueprint_name").(string)
+	versionName := d.Get("version").(string)
+
+	id := fmt.Sprintf("%s/providers/Microsoft.Blueprint/blueprints/%s/versions/%s", scope, blueprintName, versionName)
+	var version blueprintPublishedVersion
+	resp, err := client.Get(ctx, id, &version)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error: Published Version %q of Blueprint Definition %q was not found in Scope %q", versionName, blueprintName, scope)
+		}
+
+		return fmt.Errorf("Error retrieving Published Version %q of Blueprint Definition %q (Scope %q): %+v", versionName, blueprintName, scope, err)
+	}
+
+	d.SetId(id)
+
+	d.Set("type", version.Type)
+	if props := version.Properties; props != nil {
+		d.Set("target_scope", props.TargetScope)
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+		d.Set("change_notes", props.ChangeNotes)
+
+		if status := props.Status; status != nil {
+			d.Set("time_created", status.TimeCreated)
+			d.Set("last_modified", status.LastModified)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMBlueprintPublishedVersion_basic(t *testing.T) {
+	blueprintName, blueprintVersion := testAccAzureRMBlueprintPreCheck(t)
+
+	dataSourceName := "data.azurerm_blueprint_published_version.test"
+	config := testAccDataSourceAzureRMBlueprintPublishedVersion_basic(blueprintName, blueprintVersion)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "target_scope", "subscription"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "Microsoft.Blueprint/blueprints/versions"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "time_created"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMBlueprintPublishedVersion_basic(blueprintName string, blueprintVersion string) string {
+	return fmt.Sprintf(`
+data "azurerm_subscription" "current" {}
+
+data "azurerm_blueprint_published_version" "test" {
+  scope_id       = "${data.azurerm_subscription.current.id}"
+  blueprint_name = "%s"
+  version        = "%s"
+}
+`, blueprintName, blueprintVersion)
+}
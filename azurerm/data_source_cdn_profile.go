@@ -58,7 +58,7 @@ func dataSourceArmCdnProfileRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("sku", string(sku.Name))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, nil)
 
 	return nil
 }
@@ -1,11 +1,16 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
 )
 
 func dataSourceArmClientConfig() *schema.Resource {
@@ -21,10 +26,26 @@ func dataSourceArmClientConfig() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tenant_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"subscription_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscription_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscription_quota_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"service_principal_application_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -60,10 +81,39 @@ func dataSourceArmClientConfigRead(d *schema.ResourceData, meta interface{}) err
 		servicePrincipal = &(listResult.Values())[0]
 	}
 
+	// the Object ID of the authenticated principal is taken from the access token, since the Graph API
+	// can't be used to look this up when authenticating via Managed Service Identity
+	objectId, err := getObjectIdFromAuthorizer(client.subscriptionsClient.Authorizer)
+	if err != nil {
+		return fmt.Errorf("Error determining the Object ID of the authenticated principal: %+v", err)
+	}
+
+	subscription, err := client.subscriptionsClient.Get(ctx, client.subscriptionId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subscription %q: %+v", client.subscriptionId, err)
+	}
+
+	tenants, err := client.tenantsClient.List(ctx, "/tenants")
+	if err != nil {
+		return fmt.Errorf("Error listing Tenants: %+v", err)
+	}
+
+	tenantDisplayName, err := findTenantDisplayName(tenants, client.tenantId)
+	if err != nil {
+		return err
+	}
+
 	d.SetId(time.Now().UTC().String())
 	d.Set("client_id", client.clientId)
 	d.Set("tenant_id", client.tenantId)
+	d.Set("tenant_display_name", tenantDisplayName)
 	d.Set("subscription_id", client.subscriptionId)
+	d.Set("object_id", objectId)
+
+	d.Set("subscription_display_name", subscription.DisplayName)
+	if policies := subscription.SubscriptionPolicies; policies != nil {
+		d.Set("subscription_quota_id", policies.QuotaID)
+	}
 
 	if principal := servicePrincipal; principal != nil {
 		d.Set("service_principal_application_id", principal.AppID)
@@ -72,3 +122,45 @@ func dataSourceArmClientConfigRead(d *schema.ResourceData, meta interface{}) err
 
 	return nil
 }
+
+// clientConfigTenant is the subset of the Tenant returned from the `2020-01-01` API which is used by this Data Source
+type clientConfigTenant struct {
+	TenantID    string `json:"tenantId"`
+	DisplayName string `json:"displayName"`
+}
+
+// findTenantDisplayName returns the Display Name of the Tenant with the specified ID from the list of Tenants the
+// authenticated principal has access to - or an empty string if the Tenant doesn't expose a Display Name.
+func findTenantDisplayName(tenants []json.RawMessage, tenantId string) (string, error) {
+	for _, raw := range tenants {
+		var tenant clientConfigTenant
+		if err := json.Unmarshal(raw, &tenant); err != nil {
+			return "", fmt.Errorf("Error unmarshalling Tenant: %+v", err)
+		}
+
+		if strings.EqualFold(tenant.TenantID, tenantId) {
+			return tenant.DisplayName, nil
+		}
+	}
+
+	return "", nil
+}
+
+func getObjectIdFromAuthorizer(authorizer autorest.Authorizer) (string, error) {
+	if authorizer == nil {
+		return "", fmt.Errorf("No Authorizer has been configured")
+	}
+
+	// the Authorizer doesn't expose the token directly - so we prepare a request and pull it from the header
+	req, err := autorest.Prepare(&http.Request{}, authorizer.WithAuthorization())
+	if err != nil {
+		return "", fmt.Errorf("Error obtaining an access token: %+v", err)
+	}
+
+	header := req.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return "", fmt.Errorf("Expected a Bearer token in the Authorization header")
+	}
+
+	return authentication.ObjectIdFromAccessToken(strings.TrimPrefix(header, "Bearer "))
+}
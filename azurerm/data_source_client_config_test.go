@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"os"
 	"regexp"
 	"testing"
@@ -24,7 +25,11 @@ func TestAccDataSourceAzureRMClientConfig_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAzureRMClientConfigAttr(dataSourceName, "client_id", clientId),
 					testAzureRMClientConfigAttr(dataSourceName, "tenant_id", tenantId),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tenant_display_name"),
 					testAzureRMClientConfigAttr(dataSourceName, "subscription_id", subscriptionId),
+					testAzureRMClientConfigGUIDAttr(dataSourceName, "object_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "subscription_display_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "subscription_quota_id"),
 					testAzureRMClientConfigGUIDAttr(dataSourceName, "service_principal_application_id"),
 					testAzureRMClientConfigGUIDAttr(dataSourceName, "service_principal_object_id"),
 				),
@@ -33,6 +38,47 @@ func TestAccDataSourceAzureRMClientConfig_basic(t *testing.T) {
 	})
 }
 
+func TestFindTenantDisplayName(t *testing.T) {
+	tenants := []json.RawMessage{
+		json.RawMessage(`{"id":"/tenants/aaaaaaaa-0000-0000-0000-000000000000","tenantId":"aaaaaaaa-0000-0000-0000-000000000000","displayName":"First"}`),
+		json.RawMessage(`{"id":"/tenants/11111111-1111-1111-1111-111111111111","tenantId":"11111111-1111-1111-1111-111111111111","displayName":"Second"}`),
+	}
+
+	cases := []struct {
+		TenantId string
+		Expected string
+	}{
+		{
+			TenantId: "11111111-1111-1111-1111-111111111111",
+			Expected: "Second",
+		},
+		{
+			// the Tenant ID in the Provider configuration may not match the casing returned from the API
+			TenantId: "AAAAAAAA-0000-0000-0000-000000000000",
+			Expected: "First",
+		},
+		{
+			TenantId: "22222222-2222-2222-2222-222222222222",
+			Expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := findTenantDisplayName(tenants, tc.TenantId)
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", tc.TenantId, err)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected the Display Name for %q to be %q but got %q", tc.TenantId, tc.Expected, actual)
+		}
+	}
+
+	if _, err := findTenantDisplayName([]json.RawMessage{json.RawMessage(`[]`)}, "00000000-0000-0000-0000-000000000000"); err == nil {
+		t.Fatalf("Expected an error when the Tenant can't be unmarshalled but didn't get one")
+	}
+}
+
 // Wraps resource.TestCheckResourceAttr to prevent leaking values to console
 // in case of mismatch
 func testAzureRMClientConfigAttr(name, key, value string) resource.TestCheckFunc {
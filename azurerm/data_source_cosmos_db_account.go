@@ -177,7 +177,7 @@ func dataSourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{})
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
 	d.Set("kind", string(resp.Kind))
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, nil)
 
 	if props := resp.DatabaseAccountProperties; props != nil {
 		d.Set("offer_type", string(props.DatabaseAccountOfferType))
@@ -0,0 +1,169 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datalake/store/2016-11-01/filesystem"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the contents are stored in the state - so the size of Files which can be read is capped
+const dataLakeStoreFileMaxContentLength = 4 * 1024 * 1024
+
+func dataSourceArmDataLakeStoreFile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmDataLakeStoreFileRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"remote_file_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFilePath(),
+			},
+
+			"include_content": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"max_content_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1024 * 1024,
+				ValidateFunc: validation.IntBetween(1, dataLakeStoreFileMaxContentLength),
+			},
+
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"modification_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"access_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"permission": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmDataLakeStoreFileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeStoreFilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	accountName := d.Get("account_name").(string)
+	remoteFilePath := d.Get("remote_file_path").(string)
+
+	resp, err := client.GetFileStatus(ctx, accountName, remoteFilePath, utils.Bool(false))
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Data Lake Store File %q was not found (Account %q)", remoteFilePath, accountName)
+		}
+
+		return fmt.Errorf("Error making Read request on Azure Data Lake Store File %q (Account %q): %+v", remoteFilePath, accountName, err)
+	}
+
+	props := resp.FileStatus
+	if props == nil {
+		return fmt.Errorf("Error retrieving Data Lake Store File %q (Account %q): `fileStatus` was nil", remoteFilePath, accountName)
+	}
+	if props.Type != filesystem.FILE {
+		return fmt.Errorf("Error: %q (Account %q) is a %s rather than a File", remoteFilePath, accountName, string(props.Type))
+	}
+
+	// example.azuredatalakestore.net/test/example.txt
+	d.SetId(fmt.Sprintf("%s.%s%s", accountName, client.AdlsFileSystemDNSSuffix, remoteFilePath))
+
+	length := int64(0)
+	if props.Length != nil {
+		length = *props.Length
+	}
+	d.Set("length", int(length))
+	d.Set("modification_time", flattenDataLakeStoreFileTime(props.ModificationTime))
+	d.Set("access_time", flattenDataLakeStoreFileTime(props.AccessTime))
+	d.Set("permission", props.Permission)
+	d.Set("owner", props.Owner)
+	d.Set("group", props.Group)
+
+	content := ""
+	if d.Get("include_content").(bool) {
+		maxContentLength := int64(d.Get("max_content_length").(int))
+		if length > maxContentLength {
+			return fmt.Errorf("Error reading Data Lake Store File %q (Account %q): the File is %d bytes, which is larger than the `max_content_length` of %d bytes", remoteFilePath, accountName, length, maxContentLength)
+		}
+
+		content, err = readDataLakeStoreFileContent(ctx, client, accountName, remoteFilePath, maxContentLength)
+		if err != nil {
+			return err
+		}
+	}
+	d.Set("content", content)
+
+	return nil
+}
+
+func readDataLakeStoreFileContent(ctx context.Context, client filesystem.Client, accountName string, remoteFilePath string, maxContentLength int64) (string, error) {
+	resp, err := client.Open(ctx, accountName, remoteFilePath, utils.Int64(maxContentLength), nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("Error opening Data Lake Store File %q (Account %q): %+v", remoteFilePath, accountName, err)
+	}
+	if resp.Value == nil {
+		return "", fmt.Errorf("Error opening Data Lake Store File %q (Account %q): the response body was nil", remoteFilePath, accountName)
+	}
+
+	body := *resp.Value
+	defer body.Close()
+
+	// the File could've been appended to since its length was retrieved - so the contents are also capped here
+	contents, err := ioutil.ReadAll(io.LimitReader(body, maxContentLength))
+	if err != nil {
+		return "", fmt.Errorf("Error reading Data Lake Store File %q (Account %q): %+v", remoteFilePath, accountName, err)
+	}
+
+	return string(contents), nil
+}
+
+// flattenDataLakeStoreFileTime converts the milliseconds since the epoch returned from the API into an RFC3339 date
+func flattenDataLakeStoreFileTime(input *int64) string {
+	if input == nil || *input == 0 {
+		return ""
+	}
+
+	return time.Unix(0, *input*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,95 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMDataLakeStoreFile_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_data_lake_store_file.test"
+
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMDataLakeStoreFile_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "length"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "modification_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "permission"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "owner"),
+					resource.TestCheckResourceAttr(dataSourceName, "content", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMDataLakeStoreFile_content(t *testing.T) {
+	dataSourceName := "data.azurerm_data_lake_store_file.test"
+
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMDataLakeStoreFile_content(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexp.MustCompile("^-----BEGIN CERTIFICATE-----")),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenDataLakeStoreFileTime(t *testing.T) {
+	cases := []struct {
+		Input    *int64
+		Expected string
+	}{
+		{Input: nil, Expected: ""},
+		{Input: utils.Int64(0), Expected: ""},
+		{Input: utils.Int64(1514768523123), Expected: "2018-01-01T01:02:03Z"},
+	}
+
+	for _, tc := range cases {
+		if actual := flattenDataLakeStoreFileTime(tc.Input); actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func testAccDataSourceAzureRMDataLakeStoreFile_basic(rInt int, rs, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_data_lake_store_file" "test" {
+  account_name     = "${azurerm_data_lake_store_file.test.account_name}"
+  remote_file_path = "${azurerm_data_lake_store_file.test.remote_file_path}"
+}
+`, testAccAzureRMDataLakeStoreFile_basic(rInt, rs, location))
+}
+
+func testAccDataSourceAzureRMDataLakeStoreFile_content(rInt int, rs, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_data_lake_store_file" "test" {
+  account_name     = "${azurerm_data_lake_store_file.test.account_name}"
+  remote_file_path = "${azurerm_data_lake_store_file.test.remote_file_path}"
+  include_content  = true
+}
+`, testAccAzureRMDataLakeStoreFile_basic(rInt, rs, location))
+}
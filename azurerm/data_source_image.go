@@ -33,6 +33,7 @@ func dataSourceArmImage() *schema.Resource {
 			"name": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ConflictsWith: []string{"name_regex"},
 			},
 
@@ -155,8 +156,7 @@ func dataSourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if len(list) < 1 {
-			d.SetId("")
-			return nil
+			return fmt.Errorf("Error: No Images were found matching the `name_regex` %q (Resource Group %q)", nameRegex.(string), resGroup)
 		}
 
 		if len(list) > 1 {
@@ -193,7 +193,7 @@ func dataSourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	flattenAndSetTags(d, img.Tags)
+	flattenAndSetTags(d, img.Tags, nil)
 
 	return nil
 }
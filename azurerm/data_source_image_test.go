@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -65,6 +66,22 @@ func TestAccDataSourceAzureRMImage_localFilter(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAzureRMImage_localFilterNoMatch(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMImageLocalFilterNoMatch(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("No Images were found matching the `name_regex`"),
+			},
+		},
+	})
+}
+
 func testAccDataSourceAzureRMImageBasic(rInt int, rString string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -350,3 +367,17 @@ output "location" {
 
 `, rInt, location, rInt, rInt, rInt, rInt, rString, rInt, rInt, rInt, rInt)
 }
+
+func testAccDataSourceAzureRMImageLocalFilterNoMatch(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+data "azurerm_image" "test" {
+  name_regex          = "^does-not-exist-\\d+"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location)
+}
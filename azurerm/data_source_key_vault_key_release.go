@@ -0,0 +1,90 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmKeyVaultKeyRelease() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmKeyVaultKeyReleaseRead,
+
+		Schema: map[string]*schema.Schema{
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// the attestation token of the environment the Key is being released to
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"nonce": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"encryption_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"CKM_RSA_AES_KEY_WRAP",
+					"RSA_AES_KEY_WRAP_256",
+					"RSA_AES_KEY_WRAP_384",
+				}, false),
+			},
+
+			"value": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmKeyVaultKeyReleaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultDataPlaneClient
+	ctx := meta.(*ArmClient).StopContext
+
+	keyId := d.Get("key_vault_key_id").(string)
+	id, err := parseKeyVaultChildID(keyId)
+	if err != nil {
+		return err
+	}
+
+	// the Key Release API isn't available in the version of the Key Vault SDK used by this Provider
+	client.BaseURI = id.KeyVaultBaseUrl
+
+	parameters := keyVaultKeyReleaseParameters{
+		Target: utils.String(d.Get("target").(string)),
+	}
+	if v, ok := d.GetOk("nonce"); ok {
+		parameters.Nonce = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("encryption_algorithm"); ok {
+		parameters.EncryptionAlgorithm = utils.String(v.(string))
+	}
+
+	var result keyVaultKeyReleaseResult
+	resp, err := client.Post(ctx, fmt.Sprintf("keys/%s/%s", id.Name, id.Version), "release", parameters, &result)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Key %q (Version %q / Key Vault URI %q) does not exist", id.Name, id.Version, id.KeyVaultBaseUrl)
+		}
+		return fmt.Errorf("Error releasing Key %q (Version %q / Key Vault URI %q): %+v", id.Name, id.Version, id.KeyVaultBaseUrl, err)
+	}
+
+	d.SetId(keyId)
+	d.Set("value", result.Value)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMKeyVaultKeyRelease_basic(t *testing.T) {
+	// the attestation token must be issued to an environment which satisfies the Release Policy
+	attestationTokenEnvVariable := "ARM_TEST_ATTESTATION_TOKEN"
+	attestationToken := os.Getenv(attestationTokenEnvVariable)
+	if attestationToken == "" {
+		t.Skipf("Skipping as %q is not specified", attestationTokenEnvVariable)
+	}
+
+	dataSourceName := "data.azurerm_key_vault_key_release.test"
+	rs := acctest.RandString(6)
+	config := testAccDataSourceAzureRMKeyVaultKeyRelease_basic(rs, testLocation(), attestationToken)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMKeyVaultKeyRelease_basic(rString string, location string, attestationToken string) string {
+	template := testAccAzureRMKeyVaultKey_exportable(rString, location, "sevsnpvm")
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_key_release" "test" {
+  key_vault_key_id = "${azurerm_key_vault_key.test.id}"
+  target           = "%s"
+}
+`, template, attestationToken)
+}
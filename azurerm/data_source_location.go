@@ -0,0 +1,262 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-06-01/subscriptions"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// the Subscriptions client in the Azure SDK uses an API Version which doesn't return the metadata for each
+// Location (e.g. the Paired Regions) - so the Locations are listed using a newer API Version and decoded here
+const locationMetadataApiVersion = "2020-01-01"
+
+type locationWithMetadata struct {
+	Name        *string               `json:"name,omitempty"`
+	DisplayName *string               `json:"displayName,omitempty"`
+	Metadata    *locationMetadataInfo `json:"metadata,omitempty"`
+}
+
+type locationMetadataInfo struct {
+	RegionType       *string                 `json:"regionType,omitempty"`
+	RegionCategory   *string                 `json:"regionCategory,omitempty"`
+	GeographyGroup   *string                 `json:"geographyGroup,omitempty"`
+	Latitude         *string                 `json:"latitude,omitempty"`
+	Longitude        *string                 `json:"longitude,omitempty"`
+	PhysicalLocation *string                 `json:"physicalLocation,omitempty"`
+	PairedRegion     *[]locationPairedRegion `json:"pairedRegion,omitempty"`
+}
+
+type locationPairedRegion struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type locationWithMetadataListResult struct {
+	Value *[]locationWithMetadata `json:"value,omitempty"`
+}
+
+func dataSourceArmLocation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmLocationRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"region_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"region_category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"geography_group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"physical_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"latitude": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"longitude": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"paired_regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"zones_supported": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmLocationRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	input := d.Get("location").(string)
+	normalized := azureRMNormalizeLocation(input)
+
+	locations, err := listLocationsWithMetadata(ctx, armClient.subscriptionsClient, armClient.subscriptionId)
+	if err != nil {
+		return fmt.Errorf("Error listing Locations for Subscription %q: %+v", armClient.subscriptionId, err)
+	}
+
+	location := findLocationWithMetadata(normalized, locations)
+	if location == nil {
+		return fmt.Errorf("Error: Location %q was not found in Subscription %q", input, armClient.subscriptionId)
+	}
+
+	name := *location.Name
+	zones, err := retrieveAvailabilityZonesForLocation(ctx, armClient.resourceSkusClient, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Availability Zones for Location %q: %+v", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/locations/%s", armClient.subscriptionId, name))
+
+	d.Set("name", name)
+	d.Set("display_name", location.DisplayName)
+
+	pairedRegions := make([]string, 0)
+	if metadata := location.Metadata; metadata != nil {
+		d.Set("region_type", metadata.RegionType)
+		d.Set("region_category", metadata.RegionCategory)
+		d.Set("geography_group", metadata.GeographyGroup)
+		d.Set("physical_location", metadata.PhysicalLocation)
+		d.Set("latitude", metadata.Latitude)
+		d.Set("longitude", metadata.Longitude)
+
+		if regions := metadata.PairedRegion; regions != nil {
+			for _, region := range *regions {
+				if region.Name != nil {
+					pairedRegions = append(pairedRegions, *region.Name)
+				}
+			}
+		}
+	}
+	if err := d.Set("paired_regions", pairedRegions); err != nil {
+		return fmt.Errorf("Error setting `paired_regions`: %+v", err)
+	}
+
+	if err := d.Set("zones", zones); err != nil {
+		return fmt.Errorf("Error setting `zones`: %+v", err)
+	}
+	d.Set("zones_supported", len(zones) > 0)
+
+	return nil
+}
+
+func listLocationsWithMetadata(ctx context.Context, client subscriptions.Client, subscriptionId string) ([]locationWithMetadata, error) {
+	req, err := client.ListLocationsPreparer(ctx, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", locationMetadataApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.ListLocationsSender(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result locationWithMetadataListResult
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Value == nil {
+		return []locationWithMetadata{}, nil
+	}
+
+	return *result.Value, nil
+}
+
+// findLocationWithMetadata returns the location whose Name or Display Name matches the (normalized) location
+func findLocationWithMetadata(normalized string, locations []locationWithMetadata) *locationWithMetadata {
+	for _, location := range locations {
+		if location.Name == nil {
+			continue
+		}
+
+		if normalized == azureRMNormalizeLocation(*location.Name) {
+			return &location
+		}
+		if location.DisplayName != nil && normalized == azureRMNormalizeLocation(*location.DisplayName) {
+			return &location
+		}
+	}
+
+	return nil
+}
+
+// retrieveAvailabilityZonesForLocation returns the Availability Zones in which Virtual Machines can be provisioned in
+// the specified location - which is empty when the location doesn't support Availability Zones
+func retrieveAvailabilityZonesForLocation(ctx context.Context, client compute.ResourceSkusClient, location string) ([]string, error) {
+	skus, err := client.ListComplete(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	zones := make(map[string]struct{})
+	for skus.NotDone() {
+		sku := skus.Value()
+		if sku.ResourceType != nil && strings.EqualFold(*sku.ResourceType, "virtualMachines") && sku.LocationInfo != nil {
+			for _, info := range *sku.LocationInfo {
+				if info.Location == nil || azureRMNormalizeLocation(*info.Location) != location || info.Zones == nil {
+					continue
+				}
+
+				for _, zone := range *info.Zones {
+					zones[zone] = struct{}{}
+				}
+			}
+		}
+
+		if err := skus.Next(); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]string, 0)
+	for zone := range zones {
+		results = append(results, zone)
+	}
+	sort.Strings(results)
+
+	return results, nil
+}
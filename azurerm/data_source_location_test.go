@@ -0,0 +1,105 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMLocation_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_location.test"
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMLocation_basic(location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", azureRMNormalizeLocation(location)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "display_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "latitude"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "longitude"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "zones_supported"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMLocation_zones(t *testing.T) {
+	dataSourceName := "data.azurerm_location.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMLocation_basic("West Europe"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", "westeurope"),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", "West Europe"),
+					resource.TestCheckResourceAttr(dataSourceName, "physical_location", "Netherlands"),
+					resource.TestCheckResourceAttr(dataSourceName, "paired_regions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "paired_regions.0", "northeurope"),
+					resource.TestCheckResourceAttr(dataSourceName, "zones_supported", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "zones.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindLocationWithMetadata(t *testing.T) {
+	locations := []locationWithMetadata{
+		{
+			Name:        utils.String("westeurope"),
+			DisplayName: utils.String("West Europe"),
+		},
+		{
+			Name:        utils.String("westus2"),
+			DisplayName: utils.String("West US 2"),
+		},
+	}
+
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "westeurope",
+			Expected: "westeurope",
+		},
+		{
+			Input:    "West US 2",
+			Expected: "westus2",
+		},
+		{
+			Input:    "eastus",
+			Expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		location := findLocationWithMetadata(azureRMNormalizeLocation(tc.Input), locations)
+		actual := ""
+		if location != nil {
+			actual = *location.Name
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q to match %q but got %q", tc.Input, tc.Expected, actual)
+		}
+	}
+}
+
+func testAccDataSourceAzureRMLocation_basic(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_location" "test" {
+  location = "%s"
+}
+`, location)
+}
@@ -0,0 +1,177 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmMetricAlertRule() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmMetricAlertRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metric_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"period": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aggregation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"email_action": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"send_to_service_owners": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"custom_emails": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"webhook_action": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"properties": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmMetricAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorAlertRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	name := d.Get("name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Metric Alert Rule %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+
+		return fmt.Errorf("Error making Read request on Metric Alert Rule %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if alertRule := resp.AlertRule; alertRule != nil {
+		d.Set("description", alertRule.Description)
+		d.Set("enabled", alertRule.IsEnabled)
+
+		if lastUpdated := alertRule.LastUpdatedTime; lastUpdated != nil {
+			d.Set("last_updated_time", lastUpdated.Format(time.RFC3339))
+		}
+
+		if condition := alertRule.Condition; condition != nil {
+			if thresholdRuleCondition, ok := condition.AsThresholdRuleCondition(); ok && thresholdRuleCondition != nil {
+				d.Set("operator", string(thresholdRuleCondition.Operator))
+				d.Set("threshold", thresholdRuleCondition.Threshold)
+				d.Set("period", thresholdRuleCondition.WindowSize)
+				d.Set("aggregation", string(thresholdRuleCondition.TimeAggregation))
+
+				if dataSource := thresholdRuleCondition.DataSource; dataSource != nil {
+					if metricDataSource, ok := dataSource.AsRuleMetricDataSource(); ok && metricDataSource != nil {
+						d.Set("resource_id", metricDataSource.ResourceURI)
+						d.Set("metric_name", metricDataSource.MetricName)
+					}
+				}
+			}
+		}
+
+		emailActions, webhookActions := flattenAzureRmMetricAlertRuleActions(alertRule.Actions)
+		if err := d.Set("email_action", emailActions); err != nil {
+			return fmt.Errorf("Error setting `email_action`: %+v", err)
+		}
+		if err := d.Set("webhook_action", webhookActions); err != nil {
+			return fmt.Errorf("Error setting `webhook_action`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, filterTags(resp.Tags, "$type"), nil)
+
+	return nil
+}
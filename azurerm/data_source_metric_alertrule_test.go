@@ -0,0 +1,48 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMMetricAlertRule_virtualMachineCpu(t *testing.T) {
+	dataSourceName := "data.azurerm_metric_alertrule.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMMetricAlertRule_virtualMachineCpu(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "resource_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_name", "Percentage CPU"),
+					resource.TestCheckResourceAttr(dataSourceName, "operator", "GreaterThan"),
+					resource.TestCheckResourceAttr(dataSourceName, "threshold", "75"),
+					resource.TestCheckResourceAttr(dataSourceName, "aggregation", "Average"),
+					resource.TestCheckResourceAttr(dataSourceName, "period", "PT5M"),
+					resource.TestCheckResourceAttr(dataSourceName, "email_action.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhook_action.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_updated_time"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "tags.$type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMMetricAlertRule_virtualMachineCpu(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_metric_alertrule" "test" {
+  name                = "${azurerm_metric_alertrule.test.name}"
+  resource_group_name = "${azurerm_metric_alertrule.test.resource_group_name}"
+}
+`, testAccAzureRMMetricAlertRule_virtualMachineCpu(rInt, location, true))
+}
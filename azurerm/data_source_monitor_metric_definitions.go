@@ -0,0 +1,178 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func dataSourceArmMonitorMetricDefinitions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmMonitorMetricDefinitionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"metric_namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"metric_definitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"primary_aggregation_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"supported_aggregation_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"dimensions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"is_dimension_required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmMonitorMetricDefinitionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorMetricDefinitionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceId := d.Get("resource_id").(string)
+	metricNamespace := d.Get("metric_namespace").(string)
+
+	resp, err := client.List(ctx, resourceId, metricNamespace)
+	if err != nil {
+		return fmt.Errorf("Error listing Metric Definitions for Resource %q: %+v", resourceId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/providers/microsoft.insights/metricDefinitions", resourceId))
+
+	definitions := flattenMonitorMetricDefinitions(resp.Value)
+	if err := d.Set("metric_definitions", definitions); err != nil {
+		return fmt.Errorf("Error setting `metric_definitions`: %+v", err)
+	}
+
+	names := make([]string, 0)
+	for _, v := range definitions {
+		definition := v.(map[string]interface{})
+		names = append(names, definition["name"].(string))
+	}
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("Error setting `names`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenMonitorMetricDefinitions(input *[]insights.MetricDefinition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := make(map[string]interface{})
+
+		name := ""
+		displayName := ""
+		if v.Name != nil {
+			if v.Name.Value != nil {
+				name = *v.Name.Value
+			}
+			if v.Name.LocalizedValue != nil {
+				displayName = *v.Name.LocalizedValue
+			}
+		}
+		output["name"] = name
+		output["display_name"] = displayName
+
+		if v.Namespace != nil {
+			output["namespace"] = *v.Namespace
+		}
+
+		output["unit"] = string(v.Unit)
+		output["primary_aggregation_type"] = string(v.PrimaryAggregationType)
+
+		aggregationTypes := make([]interface{}, 0)
+		if v.SupportedAggregationTypes != nil {
+			for _, aggregationType := range *v.SupportedAggregationTypes {
+				aggregationTypes = append(aggregationTypes, string(aggregationType))
+			}
+		}
+		output["supported_aggregation_types"] = aggregationTypes
+
+		dimensions := make([]interface{}, 0)
+		if v.Dimensions != nil {
+			for _, dimension := range *v.Dimensions {
+				if dimension.Value != nil {
+					dimensions = append(dimensions, *dimension.Value)
+				}
+			}
+		}
+		output["dimensions"] = dimensions
+
+		if v.IsDimensionRequired != nil {
+			output["is_dimension_required"] = *v.IsDimensionRequired
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
@@ -0,0 +1,110 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenMonitorMetricDefinitions(t *testing.T) {
+	input := []insights.MetricDefinition{
+		{
+			Name: &insights.LocalizableString{
+				Value:          utils.String("Transactions"),
+				LocalizedValue: utils.String("Transactions"),
+			},
+			Namespace:              utils.String("Microsoft.Storage/storageAccounts"),
+			Unit:                   insights.UnitCount,
+			PrimaryAggregationType: insights.Total,
+			SupportedAggregationTypes: &[]insights.AggregationType{
+				insights.Total,
+				insights.Average,
+			},
+			Dimensions: &[]insights.LocalizableString{
+				{
+					Value: utils.String("ResponseType"),
+				},
+			},
+			IsDimensionRequired: utils.Bool(false),
+		},
+		{
+			Name: &insights.LocalizableString{
+				Value: utils.String("UsedCapacity"),
+			},
+		},
+	}
+
+	actual := flattenMonitorMetricDefinitions(&input)
+	if len(actual) != 2 {
+		t.Fatalf("Expected 2 Metric Definitions but got %d", len(actual))
+	}
+
+	first := actual[0].(map[string]interface{})
+	if first["name"] != "Transactions" {
+		t.Fatalf("Expected the name to be `Transactions` but got %q", first["name"])
+	}
+	if first["primary_aggregation_type"] != "Total" {
+		t.Fatalf("Expected the primary aggregation type to be `Total` but got %q", first["primary_aggregation_type"])
+	}
+	if len(first["supported_aggregation_types"].([]interface{})) != 2 {
+		t.Fatalf("Expected 2 supported aggregation types but got %d", len(first["supported_aggregation_types"].([]interface{})))
+	}
+	if len(first["dimensions"].([]interface{})) != 1 {
+		t.Fatalf("Expected 1 dimension but got %d", len(first["dimensions"].([]interface{})))
+	}
+
+	second := actual[1].(map[string]interface{})
+	if second["display_name"] != "" {
+		t.Fatalf("Expected the display name to be empty but got %q", second["display_name"])
+	}
+	if len(second["dimensions"].([]interface{})) != 0 {
+		t.Fatalf("Expected no dimensions but got %d", len(second["dimensions"].([]interface{})))
+	}
+}
+
+func TestAccDataSourceAzureRMMonitorMetricDefinitions_storageAccount(t *testing.T) {
+	dataSourceName := "data.azurerm_monitor_metric_definitions.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMMonitorMetricDefinitions_storageAccount(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metric_definitions.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metric_definitions.0.unit"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metric_definitions.0.primary_aggregation_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMMonitorMetricDefinitions_storageAccount(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+data "azurerm_monitor_metric_definitions" "test" {
+  resource_id = "${azurerm_storage_account.test.id}"
+}
+`, rInt, location, rString)
+}
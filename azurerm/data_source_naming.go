@@ -0,0 +1,82 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
+)
+
+func dataSourceArmNaming() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmNamingRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(naming.ResourceTypes(), false),
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"seed": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"min_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmNamingRead(d *schema.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	resourceType := d.Get("resource_type").(string)
+	prefix := d.Get("prefix").(string)
+	suffix := d.Get("suffix").(string)
+
+	// Data Sources are read during every plan, so the name is derived from a seed rather than being
+	// random - otherwise a new name would be generated (and the resource replaced) each time
+	seed := d.Get("seed").(string)
+	if seed == "" {
+		seed = fmt.Sprintf("%s/%s/%s/%s", subscriptionId, resourceType, prefix, suffix)
+	}
+
+	name, err := naming.GenerateFromSeed(resourceType, prefix, suffix, seed)
+	if err != nil {
+		return fmt.Errorf("Error generating name for %q: %+v", resourceType, err)
+	}
+
+	rule := naming.Rules[resourceType]
+
+	d.SetId(name)
+	d.Set("name", name)
+	d.Set("min_length", rule.MinLength)
+	d.Set("max_length", rule.MaxLength)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMNaming_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_naming.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMNaming_basic("azurerm_storage_account", "Acc-Test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "name", regexp.MustCompile("^acctest[a-z0-9]{8}$")),
+					resource.TestCheckResourceAttr(dataSourceName, "min_length", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_length", "24"),
+				),
+			},
+			{
+				Config: testAccDataSourceAzureRMNaming_basic("azurerm_key_vault", "acc-test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "name", regexp.MustCompile("^acc-test-[a-z0-9]{8}$")),
+					resource.TestCheckResourceAttr(dataSourceName, "min_length", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_length", "24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMNaming_basic(resourceType string, prefix string) string {
+	return fmt.Sprintf(`
+data "azurerm_naming" "test" {
+  resource_type = "%s"
+  prefix        = "%s"
+}
+`, resourceType, prefix)
+}
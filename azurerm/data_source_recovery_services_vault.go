@@ -60,6 +60,6 @@ func dataSourceArmRecoveryServicesVaultRead(d *schema.ResourceData, meta interfa
 		d.Set("sku", string(sku.Name))
 	}
 
-	flattenAndSetTags(d, vault.Tags)
+	flattenAndSetTags(d, vault.Tags, nil)
 	return nil
 }
@@ -0,0 +1,109 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmResourceGraphQuery() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourceGraphQueryRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"subscription_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+			},
+
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+				},
+			},
+
+			"total_records": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmResourceGraphQueryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourceGraphClient
+	ctx := meta.(*ArmClient).StopContext
+
+	query := d.Get("query").(string)
+
+	subscriptionIds := make([]string, 0)
+	for _, v := range d.Get("subscription_ids").([]interface{}) {
+		subscriptionIds = append(subscriptionIds, v.(string))
+	}
+	if len(subscriptionIds) == 0 {
+		subscriptionIds = append(subscriptionIds, meta.(*ArmClient).subscriptionId)
+	}
+
+	request := resourceGraphQueryRequest{
+		Subscriptions: subscriptionIds,
+		Query:         query,
+		Options: &resourceGraphQueryRequestOptions{
+			ResultFormat: "objectArray",
+		},
+	}
+
+	results := make([]interface{}, 0)
+	totalRecords := 0
+	for {
+		var resp resourceGraphQueryResponse
+		if _, err := client.Post(ctx, resourceGraphResourcesID, "resources", request, &resp); err != nil {
+			return fmt.Errorf("Error running Resource Graph Query %q: %+v", query, err)
+		}
+
+		for _, row := range resp.Data {
+			result, err := flattenResourceGraphRow(row)
+			if err != nil {
+				return fmt.Errorf("Error flattening the results of Resource Graph Query %q: %+v", query, err)
+			}
+			results = append(results, result)
+		}
+
+		if resp.TotalRecords != nil {
+			totalRecords = int(*resp.TotalRecords)
+		}
+
+		// the results are paged when there's more than 1000 rows
+		if resp.SkipToken == nil || *resp.SkipToken == "" {
+			break
+		}
+		request.Options.SkipToken = utils.String(*resp.SkipToken)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(fmt.Sprintf("%s-%s", strings.Join(subscriptionIds, ","), query))))
+
+	d.Set("subscription_ids", subscriptionIds)
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("Error setting `results`: %+v", err)
+	}
+	d.Set("total_records", totalRecords)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestFlattenResourceGraphRow(t *testing.T) {
+	row := map[string]interface{}{
+		"name":       "vm1",
+		"count":      float64(3),
+		"properties": map[string]interface{}{"vmId": "abc"},
+		"zones":      nil,
+	}
+
+	output, err := flattenResourceGraphRow(row)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := map[string]string{
+		"name":       "vm1",
+		"count":      "3",
+		"properties": `{"vmId":"abc"}`,
+		"zones":      "",
+	}
+	for column, value := range expected {
+		if output[column] != value {
+			t.Fatalf("Expected column %q to be %q but got %q", column, value, output[column])
+		}
+	}
+}
+
+func TestAccDataSourceAzureRMResourceGraphQuery_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_resource_graph_query.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResourceGraphQuery_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "subscription_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.name", fmt.Sprintf("acctestRG-%d", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.tags", `{"environment":"test"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMResourceGraphQuery_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  tags {
+    environment = "test"
+  }
+}
+
+data "azurerm_resource_graph_query" "test" {
+  query = "ResourceContainers | where type =~ 'microsoft.resources/subscriptions/resourcegroups' and name =~ '${azurerm_resource_group.test.name}' | project name, tags"
+}
+`, rInt, location)
+}
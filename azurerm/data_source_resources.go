@@ -0,0 +1,176 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceArmResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"required_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	resourceType := d.Get("type").(string)
+	namePrefix := d.Get("name_prefix").(string)
+	requiredTags := d.Get("required_tags").(map[string]interface{})
+
+	// the API doesn't support combining a filter on tags with any other filter, so only the type is filtered server-side
+	filter := ""
+	if resourceType != "" {
+		filter = fmt.Sprintf("resourceType eq '%s'", resourceType)
+	}
+
+	var results resources.ListResultIterator
+	var err error
+	if resourceGroup != "" {
+		log.Printf("[DEBUG] Listing Resources in Resource Group %q (Filter %q)", resourceGroup, filter)
+		results, err = client.ListByResourceGroupComplete(ctx, resourceGroup, filter, "", nil)
+		if err != nil {
+			return fmt.Errorf("Error listing Resources in Resource Group %q: %+v", resourceGroup, err)
+		}
+	} else {
+		log.Printf("[DEBUG] Listing Resources in the Subscription (Filter %q)", filter)
+		results, err = client.ListComplete(ctx, filter, "", nil)
+		if err != nil {
+			return fmt.Errorf("Error listing Resources: %+v", err)
+		}
+	}
+
+	filteredResources := make([]resources.GenericResource, 0)
+	for results.NotDone() {
+		element := results.Value()
+
+		if dataSourceArmResourceMatches(element, namePrefix, requiredTags) {
+			filteredResources = append(filteredResources, element)
+		}
+
+		if err := results.Next(); err != nil {
+			return fmt.Errorf("Error listing the next page of Resources: %+v", err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("resources", flattenDataSourceResources(filteredResources)); err != nil {
+		return fmt.Errorf("Error setting `resources`: %+v", err)
+	}
+
+	return nil
+}
+
+func dataSourceArmResourceMatches(input resources.GenericResource, namePrefix string, requiredTags map[string]interface{}) bool {
+	if namePrefix != "" {
+		if input.Name == nil || !strings.HasPrefix(*input.Name, namePrefix) {
+			return false
+		}
+	}
+
+	for key, value := range requiredTags {
+		tagValue, ok := input.Tags[key]
+		if !ok || tagValue == nil || *tagValue != value.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenDataSourceResources(input []resources.GenericResource) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, element := range input {
+		output := make(map[string]interface{})
+
+		if element.ID != nil {
+			output["id"] = *element.ID
+		}
+
+		if element.Name != nil {
+			output["name"] = *element.Name
+		}
+
+		if element.Type != nil {
+			output["type"] = *element.Type
+		}
+
+		if element.Location != nil {
+			output["location"] = azureRMNormalizeLocation(*element.Location)
+		}
+
+		tags := make(map[string]interface{})
+		for key, value := range element.Tags {
+			if value != nil {
+				tags[key] = *value
+			}
+		}
+		output["tags"] = tags
+
+		results = append(results, output)
+	}
+
+	return results
+}
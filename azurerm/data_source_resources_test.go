@@ -0,0 +1,128 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMResources_byResourceGroupAndType(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resourceConfig := testAccDataSourceAzureRMResources_template(ri, location)
+	dataSourceConfig := testAccDataSourceAzureRMResources_byResourceGroupAndType(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.type", "Microsoft.Network/publicIPAddresses"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMResources_byNamePrefixAndTags(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resourceConfig := testAccDataSourceAzureRMResources_template(ri, location)
+	dataSourceConfig := testAccDataSourceAzureRMResources_byNamePrefixAndTags(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.name", fmt.Sprintf("acctestpip-%d-first", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.environment", "Production"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMResources_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "first" {
+  name                         = "acctestpip-%d-first"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "Dynamic"
+
+  tags {
+    environment = "Production"
+  }
+}
+
+resource "azurerm_public_ip" "second" {
+  name                         = "acctestpip-%d-second"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "Dynamic"
+
+  tags {
+    environment = "Staging"
+  }
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccDataSourceAzureRMResources_byResourceGroupAndType(rInt int, location string) string {
+	template := testAccDataSourceAzureRMResources_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  type                = "Microsoft.Network/publicIPAddresses"
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMResources_byNamePrefixAndTags(rInt int, location string) string {
+	template := testAccDataSourceAzureRMResources_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  name_prefix = "acctestpip-%d"
+
+  required_tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
@@ -95,7 +95,7 @@ func dataSourceArmSchedulerJobCollectionRead(d *schema.ResourceData, meta interf
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
 
-	flattenAndSetTags(d, collection.Tags)
+	flattenAndSetTags(d, collection.Tags, nil)
 
 	//resource specific
 	if properties := collection.Properties; properties != nil {
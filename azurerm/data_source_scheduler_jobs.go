@@ -0,0 +1,255 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/scheduler/mgmt/2016-03-01/scheduler"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Azure Scheduler is being retired in favour of Logic Apps - this Data Source exposes the Jobs within a
+// Job Collection in the shape of the `azurerm_logic_app_trigger_recurrence` and `azurerm_logic_app_action_http`
+// resources, alongside a list of the settings which can't be migrated automatically
+func dataSourceArmSchedulerJobs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSchedulerJobsRead,
+
+		Schema: map[string]*schema.Schema{
+			"job_collection_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"jobs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"logic_app_trigger_recurrence": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"frequency": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"interval": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"logic_app_action_http": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"method": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"uri": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"body": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"headers": {
+										Type:     schema.TypeMap,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"unsupported_settings": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmSchedulerJobsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).schedulerJobsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	jobCollection := d.Get("job_collection_name").(string)
+
+	collection, err := meta.(*ArmClient).schedulerJobCollectionsClient.Get(ctx, resourceGroup, jobCollection)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Scheduler Job Collection %q (Resource Group %q): %+v", jobCollection, resourceGroup, err)
+	}
+	if collection.ID == nil {
+		return fmt.Errorf("Cannot read ID for Scheduler Job Collection %q (Resource Group %q)", jobCollection, resourceGroup)
+	}
+
+	jobs := make([]interface{}, 0)
+	iterator, err := client.ListComplete(ctx, resourceGroup, jobCollection, nil, nil, "")
+	if err != nil {
+		return fmt.Errorf("Error listing Scheduler Jobs within Job Collection %q (Resource Group %q): %+v", jobCollection, resourceGroup, err)
+	}
+	for iterator.NotDone() {
+		job := iterator.Value()
+
+		flattened, err := flattenSchedulerJobForMigration(job)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, flattened)
+
+		if err := iterator.Next(); err != nil {
+			return fmt.Errorf("Error listing Scheduler Jobs within Job Collection %q (Resource Group %q): %+v", jobCollection, resourceGroup, err)
+		}
+	}
+
+	d.SetId(*collection.ID)
+	d.Set("job_collection_name", jobCollection)
+	d.Set("resource_group_name", resourceGroup)
+
+	if err := d.Set("jobs", jobs); err != nil {
+		return fmt.Errorf("Error setting `jobs`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenSchedulerJobForMigration(job scheduler.JobDefinition) (map[string]interface{}, error) {
+	output := map[string]interface{}{
+		"logic_app_trigger_recurrence": []interface{}{},
+		"logic_app_action_http":        []interface{}{},
+	}
+	unsupported := make([]string, 0)
+
+	if job.ID != nil {
+		id, err := parseAzureResourceID(*job.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		output["id"] = *job.ID
+		output["name"] = id.Path["jobs"]
+	}
+
+	props := job.Properties
+	if props == nil {
+		output["unsupported_settings"] = unsupported
+		return output, nil
+	}
+
+	output["state"] = string(props.State)
+	if v := props.StartTime; v != nil {
+		output["start_time"] = (*v).Format(time.RFC3339)
+	}
+
+	if recurrence := props.Recurrence; recurrence != nil {
+		// the frequencies supported by Scheduler are a subset of those supported by Logic Apps
+		block := map[string]interface{}{
+			"frequency": string(recurrence.Frequency),
+			"interval":  1,
+		}
+		if v := recurrence.Interval; v != nil {
+			block["interval"] = int(*v)
+		}
+		output["logic_app_trigger_recurrence"] = []interface{}{block}
+
+		if recurrence.Count != nil {
+			unsupported = append(unsupported, "recurrence.count")
+		}
+		if recurrence.EndTime != nil {
+			unsupported = append(unsupported, "recurrence.end_time")
+		}
+		if recurrence.Schedule != nil {
+			unsupported = append(unsupported, "recurrence.schedule")
+		}
+	}
+
+	if action := props.Action; action != nil {
+		actionType := string(action.Type)
+		isWebAction := strings.EqualFold(actionType, string(scheduler.HTTP)) || strings.EqualFold(actionType, string(scheduler.HTTPS))
+		if isWebAction && action.Request != nil {
+			output["logic_app_action_http"] = flattenSchedulerJobHTTPRequestForMigration(action.Request)
+
+			if action.Request.Authentication != nil {
+				unsupported = append(unsupported, "action_web.authentication")
+			}
+		} else {
+			unsupported = append(unsupported, fmt.Sprintf("action (type %q)", actionType))
+		}
+
+		if action.ErrorAction != nil {
+			unsupported = append(unsupported, "error_action")
+		}
+		if action.RetryPolicy != nil && action.RetryPolicy.RetryType != scheduler.None {
+			unsupported = append(unsupported, "retry")
+		}
+	}
+
+	output["unsupported_settings"] = unsupported
+	return output, nil
+}
+
+func flattenSchedulerJobHTTPRequestForMigration(request *scheduler.HTTPRequest) []interface{} {
+	block := map[string]interface{}{}
+
+	if v := request.Method; v != nil {
+		// Logic Apps requires the method to be uppercase
+		block["method"] = strings.ToUpper(*v)
+	}
+	if v := request.URI; v != nil {
+		block["uri"] = *v
+	}
+	if v := request.Body; v != nil {
+		block["body"] = *v
+	}
+
+	headers := map[string]interface{}{}
+	for k, v := range request.Headers {
+		if v != nil {
+			headers[k] = *v
+		}
+	}
+	block["headers"] = headers
+
+	return []interface{}{block}
+}
@@ -0,0 +1,121 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/scheduler/mgmt/2016-03-01/scheduler"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMSchedulerJobs_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_scheduler_jobs.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSchedulerJobs_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.0.name", fmt.Sprintf("acctest-%d-job", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.0.logic_app_trigger_recurrence.0.frequency", "Hour"),
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.0.logic_app_trigger_recurrence.0.interval", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.0.logic_app_action_http.0.method", "PUT"),
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.0.logic_app_action_http.0.uri", "http://example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.0.logic_app_action_http.0.headers.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenSchedulerJobForMigration(t *testing.T) {
+	job := scheduler.JobDefinition{
+		ID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Scheduler/jobCollections/collection/jobs/job1"),
+		Properties: &scheduler.JobProperties{
+			State: scheduler.JobStateEnabled,
+			Action: &scheduler.JobAction{
+				Type: scheduler.HTTPS,
+				Request: &scheduler.HTTPRequest{
+					URI:    utils.String("https://example.com"),
+					Method: utils.String("post"),
+				},
+				ErrorAction: &scheduler.JobErrorAction{
+					Type: scheduler.StorageQueue,
+				},
+			},
+			Recurrence: &scheduler.JobRecurrence{
+				Frequency: scheduler.Week,
+				Schedule: &scheduler.JobRecurrenceSchedule{
+					WeekDays: &[]scheduler.DayOfWeek{scheduler.Monday},
+				},
+			},
+		},
+	}
+
+	output, err := flattenSchedulerJobForMigration(job)
+	if err != nil {
+		t.Fatalf("Error flattening Scheduler Job: %+v", err)
+	}
+
+	if name := output["name"]; name != "job1" {
+		t.Fatalf("Expected the name to be `job1` but got %q", name)
+	}
+
+	recurrence := output["logic_app_trigger_recurrence"].([]interface{})[0].(map[string]interface{})
+	if recurrence["frequency"] != "Week" || recurrence["interval"] != 1 {
+		t.Fatalf("Expected a weekly recurrence with an interval of 1 but got %+v", recurrence)
+	}
+
+	action := output["logic_app_action_http"].([]interface{})[0].(map[string]interface{})
+	if action["method"] != "POST" || action["uri"] != "https://example.com" {
+		t.Fatalf("Expected a POST to https://example.com but got %+v", action)
+	}
+
+	unsupported := output["unsupported_settings"].([]string)
+	expected := []string{"recurrence.schedule", "error_action"}
+	if len(unsupported) != len(expected) {
+		t.Fatalf("Expected the unsupported settings to be %+v but got %+v", expected, unsupported)
+	}
+	for i, v := range expected {
+		if unsupported[i] != v {
+			t.Fatalf("Expected the unsupported settings to be %+v but got %+v", expected, unsupported)
+		}
+	}
+}
+
+func testAccDataSourceSchedulerJobs_basic(rInt int, location string) string {
+	return fmt.Sprintf(`%s
+resource "azurerm_scheduler_job" "test" {
+  name                = "acctest-%d-job"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  job_collection_name = "${azurerm_scheduler_job_collection.test.name}"
+
+  action_web {
+    url    = "http://example.com"
+    method = "put"
+    body   = "this is some text"
+
+    headers = {
+      "Content-Type" = "text"
+    }
+  }
+
+  recurrence {
+    frequency = "Hour"
+    interval  = 2
+  }
+}
+
+data "azurerm_scheduler_jobs" "test" {
+  job_collection_name = "${azurerm_scheduler_job_collection.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  depends_on          = ["azurerm_scheduler_job.test"]
+}
+`, testAccAzureRMSchedulerJob_template(rInt, location), rInt)
+}
@@ -0,0 +1,135 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmSearchService() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSearchServiceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"sku": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"replica_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"partition_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"primary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"query_keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmSearchServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).searchServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name, nil)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Search Service %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Search Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", string(sku.Name))
+	}
+
+	if props := resp.ServiceProperties; props != nil {
+		if count := props.PartitionCount; count != nil {
+			d.Set("partition_count", int(*count))
+		}
+
+		if count := props.ReplicaCount; count != nil {
+			d.Set("replica_count", int(*count))
+		}
+	}
+
+	adminKeysClient := meta.(*ArmClient).searchAdminKeysClient
+	adminKeys, err := adminKeysClient.Get(ctx, resourceGroup, name, nil)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Admin Keys for Search Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("primary_key", adminKeys.PrimaryKey)
+	d.Set("secondary_key", adminKeys.SecondaryKey)
+
+	queryKeysClient := meta.(*ArmClient).searchQueryKeysClient
+	queryKeys, err := queryKeysClient.ListBySearchService(ctx, resourceGroup, name, nil)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Query Keys for Search Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := d.Set("query_keys", flattenSearchQueryKeys(queryKeys.Value)); err != nil {
+		return fmt.Errorf("Error setting `query_keys`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags, nil)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMSearchService_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_search_service.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMSearchService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "sku", "standard"),
+					resource.TestCheckResourceAttr(dataSourceName, "replica_count", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "partition_count", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "secondary_key"),
+					resource.TestCheckResourceAttr(dataSourceName, "query_keys.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_keys.0.key"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMSearchService_basic(rInt int, location string) string {
+	resource := testAccAzureRMSearchService_complete(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_search_service" "test" {
+  name                = "${azurerm_search_service.test.name}"
+  resource_group_name = "${azurerm_search_service.test.resource_group_name}"
+}
+`, resource)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -22,6 +23,12 @@ func dataSourceArmStorageAccount() *schema.Resource {
 
 			"location": locationForDataSourceSchema(),
 
+			"skip_access_keys": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"account_kind": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -116,12 +123,17 @@ func dataSourceArmStorageAccount() *schema.Resource {
 				Computed: true,
 			},
 
-			// NOTE: The API does not appear to expose a secondary file endpoint
 			"primary_file_endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			// NOTE: the secondary file endpoint is only returned by the API for some account kinds
+			"secondary_file_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"primary_access_key": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -158,6 +170,42 @@ func dataSourceArmStorageAccount() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"primary_queue_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_queue_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_table_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_table_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_file_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_file_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"tags": tagsForDataSourceSchema(),
 		},
 	}
@@ -182,12 +230,25 @@ func dataSourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(*resp.ID)
 
-	keys, err := client.ListKeys(ctx, resourceGroup, name)
-	if err != nil {
-		return err
+	// the keys are optional, since the principal may not have permission to list them
+	primaryAccessKey := ""
+	secondaryAccessKey := ""
+	if !d.Get("skip_access_keys").(bool) {
+		keys, err := client.ListKeys(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error listing Keys for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if accessKeys := keys.Keys; accessKeys != nil {
+			if len(*accessKeys) > 0 && (*accessKeys)[0].Value != nil {
+				primaryAccessKey = *(*accessKeys)[0].Value
+			}
+			if len(*accessKeys) > 1 && (*accessKeys)[1].Value != nil {
+				secondaryAccessKey = *(*accessKeys)[1].Value
+			}
+		}
 	}
 
-	accessKeys := *keys.Keys
 	if location := resp.Location; location != nil {
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
@@ -225,56 +286,69 @@ func dataSourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("primary_location", props.PrimaryLocation)
 		d.Set("secondary_location", props.SecondaryLocation)
 
-		if len(accessKeys) > 0 {
-			pcs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *accessKeys[0].Value, endpointSuffix)
+		if primaryAccessKey != "" {
+			pcs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, primaryAccessKey, endpointSuffix)
 			d.Set("primary_connection_string", pcs)
+		} else {
+			d.Set("primary_connection_string", "")
 		}
 
-		if len(accessKeys) > 1 {
-			scs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *accessKeys[1].Value, endpointSuffix)
+		if secondaryAccessKey != "" {
+			scs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, secondaryAccessKey, endpointSuffix)
 			d.Set("secondary_connection_string", scs)
+		} else {
+			d.Set("secondary_connection_string", "")
 		}
 
-		if endpoints := props.PrimaryEndpoints; endpoints != nil {
-			d.Set("primary_blob_endpoint", endpoints.Blob)
-			d.Set("primary_queue_endpoint", endpoints.Queue)
-			d.Set("primary_table_endpoint", endpoints.Table)
-			d.Set("primary_file_endpoint", endpoints.File)
+		setStorageAccountEndpoints(d, "primary", props.PrimaryEndpoints, *resp.Name, primaryAccessKey)
+		setStorageAccountEndpoints(d, "secondary", props.SecondaryEndpoints, *resp.Name, secondaryAccessKey)
+	}
 
-			pscs := fmt.Sprintf("DefaultEndpointsProtocol=https;BlobEndpoint=%s;AccountName=%s;AccountKey=%s",
-				*endpoints.Blob, *resp.Name, *accessKeys[0].Value)
-			d.Set("primary_blob_connection_string", pscs)
-		}
+	d.Set("primary_access_key", primaryAccessKey)
+	d.Set("secondary_access_key", secondaryAccessKey)
 
-		if endpoints := props.SecondaryEndpoints; endpoints != nil {
-			if blob := endpoints.Blob; blob != nil {
-				d.Set("secondary_blob_endpoint", blob)
-				sscs := fmt.Sprintf("DefaultEndpointsProtocol=https;BlobEndpoint=%s;AccountName=%s;AccountKey=%s",
-					*blob, *resp.Name, *accessKeys[1].Value)
-				d.Set("secondary_blob_connection_string", sscs)
-			} else {
-				d.Set("secondary_blob_endpoint", "")
-				d.Set("secondary_blob_connection_string", "")
-			}
+	flattenAndSetTags(d, resp.Tags, nil)
 
-			if endpoints.Queue != nil {
-				d.Set("secondary_queue_endpoint", endpoints.Queue)
-			} else {
-				d.Set("secondary_queue_endpoint", "")
-			}
+	return nil
+}
 
-			if endpoints.Table != nil {
-				d.Set("secondary_table_endpoint", endpoints.Table)
-			} else {
-				d.Set("secondary_table_endpoint", "")
-			}
-		}
+// setStorageAccountEndpoints sets the endpoint and connection string for each service
+// e.g. `primary_blob_endpoint` and `primary_blob_connection_string`
+func setStorageAccountEndpoints(d *schema.ResourceData, prefix string, endpoints *storage.Endpoints, accountName string, accessKey string) {
+	services := map[string]*string{
+		"blob":  nil,
+		"queue": nil,
+		"table": nil,
+		"file":  nil,
+	}
+
+	if endpoints != nil {
+		services["blob"] = endpoints.Blob
+		services["queue"] = endpoints.Queue
+		services["table"] = endpoints.Table
+		services["file"] = endpoints.File
 	}
 
-	d.Set("primary_access_key", accessKeys[0].Value)
-	d.Set("secondary_access_key", accessKeys[1].Value)
+	for service, endpoint := range services {
+		endpointKey := fmt.Sprintf("%s_%s_endpoint", prefix, service)
+		connectionStringKey := fmt.Sprintf("%s_%s_connection_string", prefix, service)
+
+		if endpoint == nil {
+			d.Set(endpointKey, "")
+			d.Set(connectionStringKey, "")
+			continue
+		}
 
-	flattenAndSetTags(d, resp.Tags)
+		d.Set(endpointKey, *endpoint)
 
-	return nil
+		if accessKey == "" {
+			d.Set(connectionStringKey, "")
+			continue
+		}
+
+		// e.g. `BlobEndpoint` / `QueueEndpoint`
+		endpointName := fmt.Sprintf("%s%sEndpoint", strings.ToUpper(service[:1]), service[1:])
+		connectionString := fmt.Sprintf("DefaultEndpointsProtocol=https;%s=%s;AccountName=%s;AccountKey=%s", endpointName, *endpoint, accountName, accessKey)
+		d.Set(connectionStringKey, connectionString)
+	}
 }
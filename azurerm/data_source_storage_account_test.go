@@ -31,6 +31,41 @@ func TestAccDataSourceAzureRMStorageAccount_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "account_replication_type", "LRS"),
 					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(dataSourceName, "tags.environment", "production"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_access_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_queue_connection_string"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_table_connection_string"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_file_connection_string"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMStorageAccount_skipAccessKeys(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_account.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+	preConfig := testAccDataSourceAzureRMStorageAccount_basic(ri, rs, location)
+	config := testAccDataSourceAzureRMStorageAccount_skipAccessKeys(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "account_tier", "Standard"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_blob_endpoint"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_file_endpoint"),
+					resource.TestCheckResourceAttr(dataSourceName, "primary_access_key", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "primary_connection_string", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "primary_blob_connection_string", ""),
 				),
 			},
 		},
@@ -70,3 +105,16 @@ data "azurerm_storage_account" "test" {
 }
 `, config)
 }
+
+func testAccDataSourceAzureRMStorageAccount_skipAccessKeys(rInt int, rString string, location string) string {
+	config := testAccDataSourceAzureRMStorageAccount_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_account" "test" {
+  name                = "${azurerm_storage_account.test.name}"
+  resource_group_name = "${azurerm_storage_account.test.resource_group_name}"
+  skip_access_keys    = true
+}
+`, config)
+}
@@ -2,8 +2,11 @@ package azurerm
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-06-01/subscriptions"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
@@ -12,6 +15,28 @@ func dataSourceArmSubscriptions() *schema.Resource {
 		Read: dataSourceArmSubscriptionsRead,
 
 		Schema: map[string]*schema.Schema{
+			"display_name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"display_name_contains": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(subscriptions.Enabled),
+					string(subscriptions.Warned),
+					string(subscriptions.PastDue),
+					string(subscriptions.Disabled),
+					string(subscriptions.Deleted),
+				}, true),
+			},
+
 			"subscriptions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -28,6 +53,10 @@ func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) er
 	subClient := armClient.subscriptionsClient
 	ctx := armClient.StopContext
 
+	displayNamePrefix := strings.ToLower(d.Get("display_name_prefix").(string))
+	displayNameContains := strings.ToLower(d.Get("display_name_contains").(string))
+	state := d.Get("state").(string)
+
 	//ListComplete returns an iterator struct
 	results, err := subClient.ListComplete(ctx)
 	if err != nil {
@@ -37,8 +66,26 @@ func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) er
 	//iterate across each subscriptions and append them to slice
 	subscriptions := make([]map[string]interface{}, 0)
 	for err = nil; results.NotDone(); err = results.Next() {
+		if err != nil {
+			return fmt.Errorf("Error listing subscriptions: %+v", err)
+		}
+
 		val := results.Value()
 
+		displayName := ""
+		if v := val.DisplayName; v != nil {
+			displayName = strings.ToLower(*v)
+		}
+		if displayNamePrefix != "" && !strings.HasPrefix(displayName, displayNamePrefix) {
+			continue
+		}
+		if displayNameContains != "" && !strings.Contains(displayName, displayNameContains) {
+			continue
+		}
+		if state != "" && !strings.EqualFold(string(val.State), state) {
+			continue
+		}
+
 		s := make(map[string]interface{})
 
 		if v := val.SubscriptionID; v != nil {
@@ -24,3 +24,31 @@ func TestAccDataSourceAzureRMSubscriptions_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceAzureRMSubscriptions_filtered(t *testing.T) {
+	resourceName := "data.azurerm_subscriptions.filtered"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMSubscriptions_filtered,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "subscriptions.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "subscriptions.0.subscription_id", "data.azurerm_subscription.current", "subscription_id"),
+					resource.TestCheckResourceAttr(resourceName, "subscriptions.0.state", "Enabled"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAzureRMSubscriptions_filtered = `
+data "azurerm_subscription" "current" {}
+
+data "azurerm_subscriptions" "filtered" {
+  display_name_prefix = "${data.azurerm_subscription.current.display_name}"
+  state               = "Enabled"
+}
+`
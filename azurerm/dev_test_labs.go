@@ -0,0 +1,159 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func schemaDevTestVirtualMachineInboundNatRule() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		// since these aren't returned from the API
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"protocol": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(dtl.TCP),
+						string(dtl.UDP),
+					}, false),
+				},
+
+				"backend_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(1, 65535),
+				},
+
+				"frontend_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func schemaDevTestVirtualMachineGalleryImageReference() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"offer": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"publisher": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"sku": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+		},
+	}
+}
+
+func expandDevTestLabVirtualMachineNatRules(input *schema.Set) []dtl.InboundNatRule {
+	rules := make([]dtl.InboundNatRule, 0)
+	if input == nil {
+		return rules
+	}
+
+	for _, val := range input.List() {
+		v := val.(map[string]interface{})
+		backendPort := v["backend_port"].(int)
+		protocol := v["protocol"].(string)
+
+		rule := dtl.InboundNatRule{
+			TransportProtocol: dtl.TransportProtocol(protocol),
+			BackendPort:       utils.Int32(int32(backendPort)),
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandDevTestLabVirtualMachineGalleryImageReference(input []interface{}, osType string) *dtl.GalleryImageReference {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &dtl.GalleryImageReference{
+		Offer:     utils.String(v["offer"].(string)),
+		OsType:    utils.String(osType),
+		Publisher: utils.String(v["publisher"].(string)),
+		Sku:       utils.String(v["sku"].(string)),
+		Version:   utils.String(v["version"].(string)),
+	}
+}
+
+func flattenDevTestVirtualMachineGalleryImage(input *dtl.GalleryImageReference) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	output := make(map[string]interface{})
+	if input.Offer != nil {
+		output["offer"] = *input.Offer
+	}
+	if input.Publisher != nil {
+		output["publisher"] = *input.Publisher
+	}
+	if input.Sku != nil {
+		output["sku"] = *input.Sku
+	}
+	if input.Version != nil {
+		output["version"] = *input.Version
+	}
+
+	results = append(results, output)
+	return results
+}
+
+func validateDevTestVirtualMachineName(maxLength int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (warnings []string, errors []error) {
+		value := v.(string)
+
+		if !regexp.MustCompile(`^[a-zA-Z0-9-]+$`).MatchString(value) {
+			errors = append(errors, fmt.Errorf("%q can only contain letters, numbers and hyphens", k))
+		}
+
+		if len(value) > maxLength {
+			errors = append(errors, fmt.Errorf("%q can be at most %d characters in length", k, maxLength))
+		}
+
+		return warnings, errors
+	}
+}
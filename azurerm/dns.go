@@ -0,0 +1,58 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// dnsRecordSetMaxRecords is the maximum number of records within a DNS Record Set
+	dnsRecordSetMaxRecords = 20
+
+	// dnsTxtRecordMaxStringLength is the maximum length of each string within a TXT record - as such
+	// longer values are split across multiple strings, which are concatenated by DNS clients
+	dnsTxtRecordMaxStringLength = 255
+
+	// dnsTxtRecordMaxValueLength is the maximum length of all of the strings within a TXT record
+	dnsTxtRecordMaxValueLength = 1024
+)
+
+// The ETag of a DNS Record Set changes each time it's modified - when `prevent_overwrite` is enabled this is sent
+// in the If-Match header when updating or deleting the Record Set, such that changes made outside of Terraform
+// since it was last read cause the request to fail rather than being silently overwritten.
+
+func dnsRecordSetETagSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+func dnsRecordSetPreventOverwriteSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+}
+
+// dnsRecordSetIfMatch returns the ETag which the Record Set must match for it to be updated or deleted - or an
+// empty string when changes made outside of Terraform should be overwritten, which is the default
+func dnsRecordSetIfMatch(d *schema.ResourceData) string {
+	if d.IsNewResource() || !d.Get("prevent_overwrite").(bool) {
+		return ""
+	}
+
+	return d.Get("etag").(string)
+}
+
+// dnsRecordSetError returns a more helpful error when the Record Set has been modified since it was last read
+func dnsRecordSetError(resp autorest.Response, recordType string, name string, zoneName string, err error) error {
+	if resp.Response != nil && resp.StatusCode == http.StatusPreconditionFailed {
+		return fmt.Errorf("DNS %s Record %q (Zone %q) has been modified outside of Terraform since it was last read - run `terraform refresh` to retrieve the latest version, or set `prevent_overwrite` to `false` to overwrite these changes: %+v", recordType, name, zoneName, err)
+	}
+
+	return err
+}
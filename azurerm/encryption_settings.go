@@ -3,6 +3,7 @@ package azurerm
 import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -29,13 +30,15 @@ func encryptionSettingsSchema() *schema.Schema {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"secret_url": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.KeyVaultChildID,
 							},
 
 							"source_vault_id": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.ResourceID,
 							},
 						},
 					},
@@ -47,13 +50,15 @@ func encryptionSettingsSchema() *schema.Schema {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"key_url": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.KeyVaultChildID,
 							},
 
 							"source_vault_id": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.ResourceID,
 							},
 						},
 					},
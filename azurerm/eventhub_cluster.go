@@ -0,0 +1,66 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+)
+
+// Dedicated Event Hub Clusters (and placing a Namespace on one) aren't available in the version of the Azure SDK
+// used by this Provider, as such these are managed using the `resourcemanager` client - these are the models for
+// the API Version `2018-01-01-preview`.
+
+type eventHubCluster struct {
+	ID         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Location   *string                    `json:"location,omitempty"`
+	Tags       map[string]*string         `json:"tags,omitempty"`
+	Sku        *eventHubClusterSku        `json:"sku,omitempty"`
+	Properties *eventHubClusterProperties `json:"properties,omitempty"`
+}
+
+type eventHubClusterSku struct {
+	Name     string `json:"name"`
+	Capacity *int32 `json:"capacity,omitempty"`
+}
+
+type eventHubClusterProperties struct {
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+	MetricID  *string `json:"metricId,omitempty"`
+	Status    *string `json:"status,omitempty"`
+}
+
+type eventHubNamespaceClusterProperties struct {
+	Properties *struct {
+		ClusterArmID *string `json:"clusterArmId,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+func eventHubClusterResourceID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/clusters/%s", subscriptionId, resourceGroup, name)
+}
+
+// expandEventHubNamespaceWithDedicatedCluster adds the `clusterArmId` property (which isn't available in the
+// Azure SDK) to the EventHub Namespace payload, so that the Namespace is placed on the Dedicated Cluster
+func expandEventHubNamespaceWithDedicatedCluster(parameters eventhub.EHNamespace, clusterId string) (map[string]interface{}, error) {
+	serialized, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing EventHub Namespace: %+v", err)
+	}
+
+	output := make(map[string]interface{})
+	if err := json.Unmarshal(serialized, &output); err != nil {
+		return nil, fmt.Errorf("Error deserializing EventHub Namespace: %+v", err)
+	}
+
+	properties, ok := output["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["clusterArmId"] = clusterId
+	output["properties"] = properties
+
+	return output, nil
+}
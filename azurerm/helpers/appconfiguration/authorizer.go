@@ -0,0 +1,91 @@
+package appconfiguration
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// HMACAuthorizer signs each request using an Access Key of the Configuration Store.
+type HMACAuthorizer struct {
+	credential string
+	secret     string
+
+	// now returns the current time - which is overridden in the tests
+	now func() time.Time
+}
+
+// NewHMACAuthorizer creates an HMACAuthorizer for the specified Access Key.
+// Parameters:
+// credential - the ID of the Access Key.
+// secret - the base64-encoded value of the Access Key.
+func NewHMACAuthorizer(credential string, secret string) *HMACAuthorizer {
+	return &HMACAuthorizer{
+		credential: credential,
+		secret:     secret,
+		now:        time.Now,
+	}
+}
+
+// WithAuthorization returns a PrepareDecorator which signs the request, adding the `x-ms-date`,
+// `x-ms-content-sha256` and `Authorization` headers.
+func (a *HMACAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			key, err := base64.StdEncoding.DecodeString(a.secret)
+			if err != nil {
+				return r, fmt.Errorf("Error decoding the Access Key %q: %+v", a.credential, err)
+			}
+
+			var body []byte
+			if r.Body != nil {
+				body, err = ioutil.ReadAll(r.Body)
+				if err != nil {
+					return r, fmt.Errorf("Error reading the request body: %+v", err)
+				}
+				r.Body.Close()
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			}
+
+			contentHash := sha256.Sum256(body)
+			encodedContentHash := base64.StdEncoding.EncodeToString(contentHash[:])
+			date := a.now().UTC().Format(http.TimeFormat)
+
+			host := r.Host
+			if host == "" {
+				host = r.URL.Host
+			}
+
+			pathAndQuery := r.URL.EscapedPath()
+			if r.URL.RawQuery != "" {
+				pathAndQuery = fmt.Sprintf("%s?%s", pathAndQuery, r.URL.RawQuery)
+			}
+
+			stringToSign := fmt.Sprintf("%s\n%s\n%s;%s;%s", r.Method, pathAndQuery, date, host, encodedContentHash)
+			mac := hmac.New(sha256.New, key)
+			mac.Write([]byte(stringToSign))
+			signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+			if r.Header == nil {
+				r.Header = make(http.Header)
+			}
+			r.Header.Set("x-ms-date", date)
+			r.Header.Set("x-ms-content-sha256", encodedContentHash)
+			r.Header.Set("Authorization", fmt.Sprintf("HMAC-SHA256 Credential=%s&SignedHeaders=x-ms-date;host;x-ms-content-sha256&Signature=%s", a.credential, signature))
+
+			return r, nil
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package appconfiguration
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestHMACAuthorizer(t *testing.T) {
+	secret := base64.StdEncoding.EncodeToString([]byte("super-secret"))
+	authorizer := NewHMACAuthorizer("abc-123", secret)
+	authorizer.now = func() time.Time {
+		return time.Date(2019, 10, 1, 12, 30, 0, 0, time.UTC)
+	}
+
+	req, err := autorest.Prepare(&http.Request{},
+		autorest.AsPut(),
+		autorest.WithBaseURL("https://example.azconfig.io"),
+		autorest.WithPathParameters("/kv/{key}", keyPathParameters("app/setting")),
+		autorest.WithQueryParameters(map[string]interface{}{"api-version": apiVersion}),
+		autorest.WithString(`{"value":"hello"}`),
+		authorizer.WithAuthorization())
+	if err != nil {
+		t.Fatalf("Error preparing the request: %+v", err)
+	}
+
+	expectedDate := "Tue, 01 Oct 2019 12:30:00 GMT"
+	if v := req.Header.Get("x-ms-date"); v != expectedDate {
+		t.Fatalf("Expected `x-ms-date` to be %q but got %q", expectedDate, v)
+	}
+
+	contentHash := sha256.Sum256([]byte(`{"value":"hello"}`))
+	expectedContentHash := base64.StdEncoding.EncodeToString(contentHash[:])
+	if v := req.Header.Get("x-ms-content-sha256"); v != expectedContentHash {
+		t.Fatalf("Expected `x-ms-content-sha256` to be %q but got %q", expectedContentHash, v)
+	}
+
+	stringToSign := fmt.Sprintf("PUT\n/kv/app%%2Fsetting?api-version=1.0\n%s;example.azconfig.io;%s", expectedDate, expectedContentHash)
+	mac := hmac.New(sha256.New, []byte("super-secret"))
+	mac.Write([]byte(stringToSign))
+	expectedAuthorization := fmt.Sprintf("HMAC-SHA256 Credential=abc-123&SignedHeaders=x-ms-date;host;x-ms-content-sha256&Signature=%s", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	if v := req.Header.Get("Authorization"); v != expectedAuthorization {
+		t.Fatalf("Expected `Authorization` to be %q but got %q", expectedAuthorization, v)
+	}
+
+	// the body must still be readable once it's been hashed
+	body := make([]byte, 64)
+	n, _ := req.Body.Read(body)
+	if !strings.EqualFold(string(body[:n]), `{"value":"hello"}`) {
+		t.Fatalf("Expected the body to be preserved but got %q", string(body[:n]))
+	}
+}
+
+func TestHMACAuthorizer_invalidSecret(t *testing.T) {
+	authorizer := NewHMACAuthorizer("abc-123", "not base64!")
+
+	_, err := autorest.Prepare(&http.Request{},
+		autorest.AsGet(),
+		autorest.WithBaseURL("https://example.azconfig.io/kv/setting"),
+		authorizer.WithAuthorization())
+	if err == nil {
+		t.Fatalf("Expected an error for an Access Key which isn't base64-encoded")
+	}
+}
@@ -0,0 +1,33 @@
+// Package appconfiguration contains a client for the data plane of an App Configuration Store, which isn't
+// available in the version of the Azure SDK used by this Provider. Requests are authenticated using an Access Key
+// of the Configuration Store (rather than Azure Active Directory), as described here:
+// https://docs.microsoft.com/en-us/azure/azure-app-configuration/rest-api-authentication-hmac
+package appconfiguration
+
+import (
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const apiVersion = "1.0"
+
+// BaseClient is the base client for the data plane of an App Configuration Store.
+type BaseClient struct {
+	autorest.Client
+	Endpoint string
+}
+
+// New creates an instance of the BaseClient client for the Configuration Store with the specified Endpoint
+// (e.g. `https://example.azconfig.io`), authenticated using the specified Access Key.
+// Parameters:
+// endpoint - the Endpoint of the Configuration Store.
+// credential - the ID of the Access Key.
+// secret - the base64-encoded value of the Access Key.
+func New(endpoint string, credential string, secret string) BaseClient {
+	client := autorest.NewClientWithUserAgent("")
+	client.Authorizer = NewHMACAuthorizer(credential, secret)
+
+	return BaseClient{
+		Client:   client,
+		Endpoint: endpoint,
+	}
+}
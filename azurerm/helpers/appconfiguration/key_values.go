@@ -0,0 +1,151 @@
+package appconfiguration
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// KeyValuesClient is the client for the Key Values within a Configuration Store.
+type KeyValuesClient struct {
+	BaseClient
+}
+
+// NewKeyValuesClient creates an instance of the KeyValuesClient client.
+// Parameters:
+// endpoint - the Endpoint of the Configuration Store.
+// credential - the ID of the Access Key.
+// secret - the base64-encoded value of the Access Key.
+func NewKeyValuesClient(endpoint string, credential string, secret string) KeyValuesClient {
+	return KeyValuesClient{New(endpoint, credential, secret)}
+}
+
+// Get retrieves the Key Value with the specified Key and Label.
+// Parameters:
+// key - the Key of the setting.
+// label - the Label of the setting - or an empty string if the setting has no Label.
+func (client KeyValuesClient) Get(ctx context.Context, key string, label string) (result KeyValue, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.Endpoint),
+		autorest.WithPathParameters("/kv/{key}", keyPathParameters(key)),
+		autorest.WithQueryParameters(keyQueryParameters(label)))
+
+	resp, err := client.send(ctx, preparer, "Get")
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.KeyValuesClient", "Get", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// Put creates or replaces the Key Value with the specified Key and Label.
+// Parameters:
+// key - the Key of the setting.
+// label - the Label of the setting - or an empty string if the setting has no Label.
+// parameters - the Value, Content Type and Tags of the setting.
+func (client KeyValuesClient) Put(ctx context.Context, key string, label string, parameters KeyValue) (result KeyValue, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/vnd.microsoft.appconfig.kv+json"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.Endpoint),
+		autorest.WithPathParameters("/kv/{key}", keyPathParameters(key)),
+		autorest.WithQueryParameters(keyQueryParameters(label)),
+		autorest.WithJSON(parameters))
+
+	resp, err := client.send(ctx, preparer, "Put")
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.KeyValuesClient", "Put", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// Delete deletes the Key Value with the specified Key and Label.
+// Parameters:
+// key - the Key of the setting.
+// label - the Label of the setting - or an empty string if the setting has no Label.
+func (client KeyValuesClient) Delete(ctx context.Context, key string, label string) (result autorest.Response, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(client.Endpoint),
+		autorest.WithPathParameters("/kv/{key}", keyPathParameters(key)),
+		autorest.WithQueryParameters(keyQueryParameters(label)))
+
+	resp, err := client.send(ctx, preparer, "Delete")
+	result.Response = resp
+	if err != nil {
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.KeyValuesClient", "Delete", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (client KeyValuesClient) send(ctx context.Context, preparer autorest.Preparer, method string) (*http.Response, error) {
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "appconfiguration.KeyValuesClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req,
+		autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, "appconfiguration.KeyValuesClient", method, resp, "Failure sending request")
+	}
+
+	return resp, nil
+}
+
+func keyPathParameters(key string) map[string]interface{} {
+	return map[string]interface{}{
+		"key": autorest.Encode("path", key),
+	}
+}
+
+func keyQueryParameters(label string) map[string]interface{} {
+	parameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	// omitting the Label refers to the setting without a Label
+	if label != "" {
+		parameters["label"] = autorest.Encode("query", label)
+	}
+
+	return parameters
+}
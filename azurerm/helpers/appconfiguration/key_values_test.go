@@ -0,0 +1,116 @@
+package appconfiguration
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestClient(endpoint string) KeyValuesClient {
+	return NewKeyValuesClient(endpoint, "abc-123", base64.StdEncoding.EncodeToString([]byte("super-secret")))
+}
+
+func TestKeyValuesClient_Put(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Fatalf("Expected a PUT but got %q", r.Method)
+		}
+		if r.URL.EscapedPath() != "/kv/app%2Fsetting" {
+			t.Fatalf("Expected the Key to be encoded in the path but got %q", r.URL.EscapedPath())
+		}
+		if v := r.URL.Query().Get("label"); v != "prod" {
+			t.Fatalf("Expected the Label to be `prod` but got %q", v)
+		}
+		if v := r.Header.Get("Authorization"); !strings.HasPrefix(v, "HMAC-SHA256 Credential=abc-123&") {
+			t.Fatalf("Expected the request to be signed but got the `Authorization` header %q", v)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		var kv KeyValue
+		if err := json.Unmarshal(body, &kv); err != nil {
+			t.Fatalf("Error unmarshalling the request body: %+v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.microsoft.appconfig.kv+json")
+		fmt.Fprintf(w, `{"key":"app/setting","label":"prod","value":%q,"content_type":%q,"tags":{}}`, *kv.Value, *kv.ContentType)
+	}))
+	defer server.Close()
+
+	value := "hello"
+	contentType := "text/plain"
+	result, err := newTestClient(server.URL).Put(context.Background(), "app/setting", "prod", KeyValue{
+		Value:       &value,
+		ContentType: &contentType,
+	})
+	if err != nil {
+		t.Fatalf("Error putting the Key Value: %+v", err)
+	}
+
+	if result.Value == nil || *result.Value != "hello" {
+		t.Fatalf("Expected the Value to be `hello` but got %+v", result.Value)
+	}
+	if result.Label == nil || *result.Label != "prod" {
+		t.Fatalf("Expected the Label to be `prod` but got %+v", result.Label)
+	}
+}
+
+func TestKeyValuesClient_GetWithoutLabel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["label"]; ok {
+			t.Fatalf("Expected the Label to be omitted but got %q", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.microsoft.appconfig.kv+json")
+		fmt.Fprint(w, `{"key":"setting","label":null,"value":"hello","locked":false}`)
+	}))
+	defer server.Close()
+
+	result, err := newTestClient(server.URL).Get(context.Background(), "setting", "")
+	if err != nil {
+		t.Fatalf("Error retrieving the Key Value: %+v", err)
+	}
+
+	if result.Label != nil {
+		t.Fatalf("Expected no Label but got %q", *result.Label)
+	}
+	if result.Value == nil || *result.Value != "hello" {
+		t.Fatalf("Expected the Value to be `hello` but got %+v", result.Value)
+	}
+}
+
+func TestKeyValuesClient_GetNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	result, err := newTestClient(server.URL).Get(context.Background(), "setting", "")
+	if err == nil {
+		t.Fatalf("Expected an error for a Key Value which doesn't exist")
+	}
+
+	if result.Response.Response == nil || result.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected the response to be returned with a 404")
+	}
+}
+
+func TestKeyValuesClient_Delete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Fatalf("Expected a DELETE but got %q", r.Method)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	if _, err := newTestClient(server.URL).Delete(context.Background(), "setting", "prod"); err != nil {
+		t.Fatalf("Error deleting the Key Value: %+v", err)
+	}
+}
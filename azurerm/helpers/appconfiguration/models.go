@@ -0,0 +1,22 @@
+package appconfiguration
+
+import "github.com/Azure/go-autorest/autorest"
+
+// KeyValue is a configuration setting within a Configuration Store, identified by its Key and Label.
+type KeyValue struct {
+	autorest.Response `json:"-"`
+	// Key - The Key of the setting. Read-only.
+	Key *string `json:"key,omitempty"`
+	// Label - The Label of the setting, which is used to hold multiple values for the same Key. Read-only.
+	Label *string `json:"label,omitempty"`
+	// Value - The Value of the setting.
+	Value *string `json:"value,omitempty"`
+	// ContentType - The Content Type of the Value, such as `application/json`.
+	ContentType *string `json:"content_type,omitempty"`
+	// Etag - The Etag of the setting. Read-only.
+	Etag *string `json:"etag,omitempty"`
+	// Locked - Whether the setting is locked, in which case it can't be modified. Read-only.
+	Locked *bool `json:"locked,omitempty"`
+	// Tags - The Tags assigned to the setting.
+	Tags map[string]*string `json:"tags"`
+}
@@ -0,0 +1,36 @@
+package authentication
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ObjectIdFromAccessToken returns the Object ID of the authenticated principal from the `oid` claim of the
+// specified JWT access token. This works regardless of how the token was obtained (Service Principal,
+// Managed Service Identity or the Azure CLI) - since the Graph API can't be queried for all of these.
+func ObjectIdFromAccessToken(accessToken string) (string, error) {
+	segments := strings.Split(accessToken, ".")
+	if len(segments) != 3 {
+		return "", fmt.Errorf("Expected the Access Token to contain 3 segments but got %d", len(segments))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return "", fmt.Errorf("Error decoding the claims from the Access Token: %+v", err)
+	}
+
+	var claims struct {
+		ObjectId string `json:"oid"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("Error parsing the claims from the Access Token: %+v", err)
+	}
+
+	if claims.ObjectId == "" {
+		return "", fmt.Errorf("The Access Token doesn't contain an `oid` claim")
+	}
+
+	return claims.ObjectId, nil
+}
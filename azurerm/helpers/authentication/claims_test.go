@@ -0,0 +1,59 @@
+package authentication
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+)
+
+func TestObjectIdFromAccessToken(t *testing.T) {
+	encode := func(v string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(v))
+	}
+	header := encode(`{"alg":"RS256","typ":"JWT"}`)
+
+	cases := []struct {
+		Token    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Token: "",
+			Error: true,
+		},
+		{
+			Token: "not-a-jwt",
+			Error: true,
+		},
+		{
+			Token: fmt.Sprintf("%s.%s.signature", header, "!!!"),
+			Error: true,
+		},
+		{
+			Token: fmt.Sprintf("%s.%s.signature", header, encode(`{"tid":"c056adac-c6a6-4ddf-ab20-0f26d47f7eea"}`)),
+			Error: true,
+		},
+		{
+			Token:    fmt.Sprintf("%s.%s.signature", header, encode(`{"oid":"9b10b986-7a61-4542-8d5a-9fcd96112585","tid":"c056adac-c6a6-4ddf-ab20-0f26d47f7eea"}`)),
+			Expected: "9b10b986-7a61-4542-8d5a-9fcd96112585",
+		},
+	}
+
+	for _, v := range cases {
+		objectId, err := ObjectIdFromAccessToken(v.Token)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("Expected an error for %q but didn't get one", v.Token)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.Token, err)
+		}
+
+		if objectId != v.Expected {
+			t.Fatalf("Expected the Object ID to be %q but got %q", v.Expected, objectId)
+		}
+	}
+}
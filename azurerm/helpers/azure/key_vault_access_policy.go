@@ -1,7 +1,7 @@
 package azure
 
 import (
-	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2016-10-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/satori/go.uuid"
@@ -48,11 +48,17 @@ func SchemaKeyVaultKeyPermissions() *schema.Schema {
 				string(keyvault.KeyPermissionsDelete),
 				string(keyvault.KeyPermissionsEncrypt),
 				string(keyvault.KeyPermissionsGet),
+				// used to manage Key Rotation Policies, which aren't available in the version of the Azure SDK used by this Provider
+				"getrotationpolicy",
 				string(keyvault.KeyPermissionsImport),
 				string(keyvault.KeyPermissionsList),
 				string(keyvault.KeyPermissionsPurge),
 				string(keyvault.KeyPermissionsRecover),
+				// used to release Exportable Keys, which isn't available in the version of the Azure SDK used by this Provider
+				"release",
 				string(keyvault.KeyPermissionsRestore),
+				"rotate",
+				"setrotationpolicy",
 				string(keyvault.KeyPermissionsSign),
 				string(keyvault.KeyPermissionsUnwrapKey),
 				string(keyvault.KeyPermissionsUpdate),
@@ -85,6 +91,33 @@ func SchemaKeyVaultSecretPermissions() *schema.Schema {
 	}
 }
 
+func SchemaKeyVaultStoragePermissions() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(keyvault.StoragePermissionsBackup),
+				string(keyvault.StoragePermissionsDelete),
+				string(keyvault.StoragePermissionsDeletesas),
+				string(keyvault.StoragePermissionsGet),
+				string(keyvault.StoragePermissionsGetsas),
+				string(keyvault.StoragePermissionsList),
+				string(keyvault.StoragePermissionsListsas),
+				string(keyvault.StoragePermissionsPurge),
+				string(keyvault.StoragePermissionsRecover),
+				string(keyvault.StoragePermissionsRegeneratekey),
+				string(keyvault.StoragePermissionsRestore),
+				string(keyvault.StoragePermissionsSet),
+				string(keyvault.StoragePermissionsSetsas),
+				string(keyvault.StoragePermissionsUpdate),
+			}, true),
+			DiffSuppressFunc: suppress.CaseDifference,
+		},
+	}
+}
+
 func ExpandKeyVaultAccessPolicies(input []interface{}) (*[]keyvault.AccessPolicyEntry, error) {
 	output := make([]keyvault.AccessPolicyEntry, 0)
 
@@ -94,12 +127,14 @@ func ExpandKeyVaultAccessPolicies(input []interface{}) (*[]keyvault.AccessPolicy
 		certificatePermissionsRaw := policyRaw["certificate_permissions"].([]interface{})
 		keyPermissionsRaw := policyRaw["key_permissions"].([]interface{})
 		secretPermissionsRaw := policyRaw["secret_permissions"].([]interface{})
+		storagePermissionsRaw := policyRaw["storage_permissions"].([]interface{})
 
 		policy := keyvault.AccessPolicyEntry{
 			Permissions: &keyvault.Permissions{
 				Certificates: ExpandCertificatePermissions(certificatePermissionsRaw),
 				Keys:         ExpandKeyPermissions(keyPermissionsRaw),
 				Secrets:      ExpandSecretPermissions(secretPermissionsRaw),
+				Storage:      ExpandStoragePermissions(storagePermissionsRaw),
 			},
 		}
 
@@ -150,6 +185,9 @@ func FlattenKeyVaultAccessPolicies(policies *[]keyvault.AccessPolicyEntry) []map
 
 			secrets := FlattenSecretPermissions(permissions.Secrets)
 			policyRaw["secret_permissions"] = secrets
+
+			storage := FlattenStoragePermissions(permissions.Storage)
+			policyRaw["storage_permissions"] = storage
 		}
 
 		result = append(result, policyRaw)
@@ -222,3 +260,25 @@ func FlattenSecretPermissions(input *[]keyvault.SecretPermissions) []interface{}
 
 	return output
 }
+
+func ExpandStoragePermissions(input []interface{}) *[]keyvault.StoragePermissions {
+	output := make([]keyvault.StoragePermissions, 0)
+
+	for _, permission := range input {
+		output = append(output, keyvault.StoragePermissions(permission.(string)))
+	}
+
+	return &output
+}
+
+func FlattenStoragePermissions(input *[]keyvault.StoragePermissions) []interface{} {
+	output := make([]interface{}, 0)
+
+	if input != nil {
+		for _, storagePermission := range *input {
+			output = append(output, string(storagePermission))
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,125 @@
+package azure
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func SchemaKeyVaultNetworkAcls() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"default_action": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(keyvault.Allow),
+				string(keyvault.Deny),
+			}, false),
+		},
+
+		"bypass": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(keyvault.AzureServices),
+				string(keyvault.None),
+			}, false),
+		},
+
+		"ip_rules": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      schema.HashString,
+		},
+
+		"virtual_network_subnet_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      schema.HashString,
+		},
+	}
+}
+
+// ExpandKeyVaultNetworkAcls expands a map matching SchemaKeyVaultNetworkAcls, returning an error
+// when the combination of `default_action`, `bypass` and the rules is one the API would accept
+// but which can't do what the user intended.
+func ExpandKeyVaultNetworkAcls(input map[string]interface{}) (*keyvault.NetworkRuleSet, error) {
+	defaultAction := input["default_action"].(string)
+	bypass := input["bypass"].(string)
+
+	ipRules := make([]keyvault.IPRule, 0)
+	if v, ok := input["ip_rules"].(*schema.Set); ok {
+		for _, raw := range v.List() {
+			value := raw.(string)
+			ipRules = append(ipRules, keyvault.IPRule{
+				Value: &value,
+			})
+		}
+	}
+
+	virtualNetworkRules := make([]keyvault.VirtualNetworkRule, 0)
+	if v, ok := input["virtual_network_subnet_ids"].(*schema.Set); ok {
+		for _, raw := range v.List() {
+			id := raw.(string)
+			virtualNetworkRules = append(virtualNetworkRules, keyvault.VirtualNetworkRule{
+				ID: &id,
+			})
+		}
+	}
+
+	hasRules := len(ipRules) > 0 || len(virtualNetworkRules) > 0
+	if defaultAction == string(keyvault.Allow) && hasRules {
+		return nil, fmt.Errorf("`ip_rules` and `virtual_network_subnet_ids` have no effect when `default_action` is `Allow` - set `default_action` to `Deny` to restrict access to these rules")
+	}
+
+	if defaultAction == string(keyvault.Deny) && bypass == string(keyvault.None) && !hasRules {
+		return nil, fmt.Errorf("setting `default_action` to `Deny` and `bypass` to `None` without any `ip_rules` or `virtual_network_subnet_ids` would block all access to the Key Vault")
+	}
+
+	return &keyvault.NetworkRuleSet{
+		DefaultAction:       keyvault.NetworkRuleAction(defaultAction),
+		Bypass:              keyvault.NetworkRuleBypassOptions(bypass),
+		IPRules:             &ipRules,
+		VirtualNetworkRules: &virtualNetworkRules,
+	}, nil
+}
+
+func FlattenKeyVaultNetworkAcls(input *keyvault.NetworkRuleSet) map[string]interface{} {
+	output := map[string]interface{}{
+		"default_action": string(keyvault.Allow),
+		"bypass":         string(keyvault.AzureServices),
+	}
+
+	ipRules := make([]interface{}, 0)
+	virtualNetworkSubnetIds := make([]interface{}, 0)
+
+	if input != nil {
+		output["default_action"] = string(input.DefaultAction)
+		output["bypass"] = string(input.Bypass)
+
+		if rules := input.IPRules; rules != nil {
+			for _, rule := range *rules {
+				if rule.Value != nil {
+					ipRules = append(ipRules, *rule.Value)
+				}
+			}
+		}
+
+		if rules := input.VirtualNetworkRules; rules != nil {
+			for _, rule := range *rules {
+				if rule.ID != nil {
+					virtualNetworkSubnetIds = append(virtualNetworkSubnetIds, *rule.ID)
+				}
+			}
+		}
+	}
+
+	output["ip_rules"] = schema.NewSet(schema.HashString, ipRules)
+	output["virtual_network_subnet_ids"] = schema.NewSet(schema.HashString, virtualNetworkSubnetIds)
+
+	return output
+}
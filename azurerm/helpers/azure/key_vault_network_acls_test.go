@@ -0,0 +1,85 @@
+package azure
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestExpandKeyVaultNetworkAcls(t *testing.T) {
+	cases := []struct {
+		DefaultAction string
+		Bypass        string
+		IPRules       []interface{}
+		SubnetIDs     []interface{}
+		ExpectError   bool
+	}{
+		{
+			DefaultAction: "Allow",
+			Bypass:        "AzureServices",
+			ExpectError:   false,
+		},
+		{
+			DefaultAction: "Allow",
+			Bypass:        "None",
+			ExpectError:   false,
+		},
+		{
+			DefaultAction: "Allow",
+			Bypass:        "AzureServices",
+			IPRules:       []interface{}{"10.0.0.0/24"},
+			ExpectError:   true,
+		},
+		{
+			DefaultAction: "Allow",
+			Bypass:        "AzureServices",
+			SubnetIDs:     []interface{}{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"},
+			ExpectError:   true,
+		},
+		{
+			DefaultAction: "Deny",
+			Bypass:        "AzureServices",
+			ExpectError:   false,
+		},
+		{
+			DefaultAction: "Deny",
+			Bypass:        "None",
+			ExpectError:   true,
+		},
+		{
+			DefaultAction: "Deny",
+			Bypass:        "None",
+			IPRules:       []interface{}{"10.0.0.0/24"},
+			ExpectError:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		input := map[string]interface{}{
+			"default_action":             tc.DefaultAction,
+			"bypass":                     tc.Bypass,
+			"ip_rules":                   schema.NewSet(schema.HashString, tc.IPRules),
+			"virtual_network_subnet_ids": schema.NewSet(schema.HashString, tc.SubnetIDs),
+		}
+
+		result, err := ExpandKeyVaultNetworkAcls(input)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error for %q / %q with %d IP Rules and %d Subnets but didn't get one", tc.DefaultAction, tc.Bypass, len(tc.IPRules), len(tc.SubnetIDs))
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q / %q but got: %+v", tc.DefaultAction, tc.Bypass, err)
+		}
+
+		if string(result.DefaultAction) != tc.DefaultAction {
+			t.Fatalf("Expected `default_action` to be %q but got %q", tc.DefaultAction, result.DefaultAction)
+		}
+
+		if len(*result.IPRules) != len(tc.IPRules) {
+			t.Fatalf("Expected %d IP Rules but got %d", len(tc.IPRules), len(*result.IPRules))
+		}
+	}
+}
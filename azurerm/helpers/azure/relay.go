@@ -0,0 +1,134 @@
+package azure
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
+)
+
+// validation
+func ValidateRelayNamespaceName() schema.SchemaValidateFunc {
+	return validation.StringLenBetween(6, 50)
+}
+
+func ValidateRelayHybridConnectionName() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9]([-._/a-zA-Z0-9]{0,258}[a-zA-Z0-9])?$`),
+		"The hybrid connection name can contain only letters, numbers, periods, hyphens, underscores and forward slashes. The name must start and end with a letter or number and be up to 260 characters long.",
+	)
+}
+
+func ValidateRelayAuthorizationRuleName() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile("^[a-zA-Z0-9]([-._a-zA-Z0-9]{0,48}[a-zA-Z0-9])?$"),
+		"The authorization rule name can contain only letters, numbers, periods, hyphens and underscores. The name must start and end with a letter or number and be up to 50 characters long.",
+	)
+}
+
+// schema
+func ExpandRelayAuthorizationRuleRights(d *schema.ResourceData) *[]relay.AccessRights {
+	rights := []relay.AccessRights{}
+
+	if d.Get("listen").(bool) {
+		rights = append(rights, relay.Listen)
+	}
+
+	if d.Get("send").(bool) {
+		rights = append(rights, relay.Send)
+	}
+
+	if d.Get("manage").(bool) {
+		rights = append(rights, relay.Manage)
+	}
+
+	return &rights
+}
+
+func FlattenRelayAuthorizationRuleRights(rights *[]relay.AccessRights) (listen bool, send bool, manage bool) {
+	//zero (initial) value for a bool in go is false
+
+	if rights != nil {
+		for _, right := range *rights {
+			switch right {
+			case relay.Listen:
+				listen = true
+			case relay.Send:
+				send = true
+			case relay.Manage:
+				manage = true
+			default:
+				log.Printf("[DEBUG] Unknown Authorization Rule Right '%s'", right)
+			}
+		}
+	}
+
+	return
+}
+
+func RelayAuthorizationRuleSchemaFrom(s map[string]*schema.Schema) map[string]*schema.Schema {
+	authSchema := map[string]*schema.Schema{
+		"listen": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"send": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"manage": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"primary_key": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"primary_connection_string": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_key": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_connection_string": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+	return MergeSchema(s, authSchema)
+}
+
+func RelayAuthorizationRuleCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	listen, hasListen := d.GetOk("listen")
+	send, hasSend := d.GetOk("send")
+	manage, hasManage := d.GetOk("manage")
+
+	if !hasListen && !hasSend && !hasManage {
+		return fmt.Errorf("One of the `listen`, `send` or `manage` properties needs to be set")
+	}
+
+	if manage.(bool) && !listen.(bool) && !send.(bool) {
+		return fmt.Errorf("if `manage` is set both `listen` and `send` must be set to true too")
+	}
+
+	return nil
+}
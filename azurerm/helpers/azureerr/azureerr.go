@@ -0,0 +1,194 @@
+// Package azureerr classifies the errors returned from Azure (for example as throttled, or denied by an Azure Policy).
+// It's used by `response.RetryError` to decide whether a request made within `resource.Retry` can be retried - such
+// that resources which retry requests (for example whilst waiting for a dependency to replicate) don't retry terminal
+// errors. Requests which aren't retried (the majority of create/update paths) don't need to classify the error.
+package azureerr
+
+import (
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Class is the category of an error returned from Azure, which determines whether the request can be retried
+type Class string
+
+const (
+	// Unknown is an error which doesn't fall into any of the other Classes - and is terminal
+	Unknown Class = "Unknown"
+
+	// Throttled is returned when too many requests have been made - and can be retried
+	Throttled Class = "Throttled"
+
+	// Conflict is returned when another operation is in progress on the resource (or pre-empted this one) - and can
+	// be retried. Other conflicts (which share the same HTTP Status Code) are Unknown, since they're generally terminal
+	Conflict Class = "Conflict"
+
+	// AlreadyExists is returned when the resource being created already exists - and is terminal
+	AlreadyExists Class = "AlreadyExists"
+
+	// NotFound is returned when the resource (or a resource it references, such as a Principal) doesn't exist -
+	// which whilst terminal in general, can be retried when waiting for a dependency to replicate
+	NotFound Class = "NotFound"
+
+	// QuotaExceeded is returned when the Subscription doesn't have enough quota for the request - and is terminal
+	QuotaExceeded Class = "QuotaExceeded"
+
+	// PolicyDenied is returned when the request is disallowed by an Azure Policy - and is terminal
+	PolicyDenied Class = "PolicyDenied"
+
+	// Forbidden is returned when the credentials don't have permission to make the request - and is terminal
+	Forbidden Class = "Forbidden"
+)
+
+var classesForCodes = map[string]Class{
+	"TooManyRequests":                                Throttled,
+	"SubscriptionRequestsThrottled":                  Throttled,
+	"AnotherOperationInProgress":                     Conflict,
+	"OperationPreempted":                             Conflict,
+	"RoleAssignmentExists":                           AlreadyExists,
+	"ResourceExists":                                 AlreadyExists,
+	"NotFound":                                       NotFound,
+	"ResourceNotFound":                               NotFound,
+	"ResourceGroupNotFound":                          NotFound,
+	"PrincipalNotFound":                              NotFound,
+	"Request_ResourceNotFound":                       NotFound,
+	"QuotaExceeded":                                  QuotaExceeded,
+	"PublicIPCountLimitReached":                      QuotaExceeded,
+	"MaxStorageAccountsCountPerSubscriptionExceeded": QuotaExceeded,
+	"RequestDisallowedByPolicy":                      PolicyDenied,
+	"AuthorizationFailed":                            Forbidden,
+	"LinkedAuthorizationFailed":                      Forbidden,
+	"Authorization_RequestDenied":                    Forbidden,
+}
+
+var classesForStatusCodes = map[int]Class{
+	http.StatusTooManyRequests: Throttled,
+	http.StatusNotFound:        NotFound,
+	http.StatusForbidden:       Forbidden,
+}
+
+// Classify returns the Class of an error returned from the Azure SDK (or the Azure AD/Microsoft Graph APIs) -
+// the error code returned by the API takes precedence over the HTTP Status Code, since (for example) a request
+// denied by an Azure Policy is also returned as a 403.
+func Classify(err error) Class {
+	if err == nil {
+		return Unknown
+	}
+
+	statusCode, code, detailCodes := details(err)
+
+	if class, ok := classesForCodes[code]; ok {
+		return class
+	}
+
+	// some Resource Providers return quota errors with a generic code (e.g. `OperationNotAllowed`), where the
+	// specific error code is only included in the details
+	for _, detailCode := range detailCodes {
+		if class, ok := classesForCodes[detailCode]; ok && class == QuotaExceeded {
+			return class
+		}
+	}
+
+	if class, ok := classesForStatusCodes[statusCode]; ok {
+		return class
+	}
+
+	return Unknown
+}
+
+// IsRetryable returns whether the request which returned the error can be retried as-is
+func IsRetryable(err error) bool {
+	class := Classify(err)
+	return class == Throttled || class == Conflict
+}
+
+// IsNotFound returns whether the error was caused by the resource (or a resource it references) not existing
+func IsNotFound(err error) bool {
+	return Classify(err) == NotFound
+}
+
+// IsPrincipalNotFound returns whether the error was caused by a Principal (e.g. a Service Principal) which doesn't
+// exist - which is returned for newly created Principals until they've replicated to Azure Resource Manager
+func IsPrincipalNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	_, code, _ := details(err)
+	return code == "PrincipalNotFound"
+}
+
+// wrappedError is implemented by errors which wrap the error returned from the Azure SDK
+type wrappedError interface {
+	Unwrap() error
+}
+
+// details unwraps the error to find the HTTP Status Code, error code and the error codes of any details returned from the API
+func details(err error) (statusCode int, code string, detailCodes []string) {
+	for err != nil {
+		var next error
+
+		switch e := err.(type) {
+		case autorest.DetailedError:
+			statusCode = detailedErrorStatusCode(statusCode, e)
+			next = e.Original
+		case *autorest.DetailedError:
+			statusCode = detailedErrorStatusCode(statusCode, *e)
+			next = e.Original
+		case azure.RequestError:
+			statusCode = detailedErrorStatusCode(statusCode, e.DetailedError)
+			next = e.ServiceError
+		case *azure.RequestError:
+			statusCode = detailedErrorStatusCode(statusCode, e.DetailedError)
+			next = e.ServiceError
+		case *azure.ServiceError:
+			if e != nil {
+				code = e.Code
+				detailCodes = serviceErrorDetailCodes(*e)
+			}
+		case azure.ServiceError:
+			code = e.Code
+			detailCodes = serviceErrorDetailCodes(e)
+		case wrappedError:
+			// e.g. the error returned when a long-running operation fails, where the status code
+			// of the last poll is a 200 - so only the error within it is useful here
+			next = e.Unwrap()
+		}
+
+		// a nil *ServiceError is a non-nil error, so is checked explicitly
+		if se, ok := next.(*azure.ServiceError); ok && se == nil {
+			next = nil
+		}
+		err = next
+	}
+
+	return statusCode, code, detailCodes
+}
+
+func serviceErrorDetailCodes(e azure.ServiceError) []string {
+	codes := make([]string, 0)
+	for _, detail := range e.Details {
+		if v, ok := detail["code"].(string); ok && v != "" {
+			codes = append(codes, v)
+		}
+	}
+	return codes
+}
+
+func detailedErrorStatusCode(existing int, e autorest.DetailedError) int {
+	if existing != 0 {
+		return existing
+	}
+
+	if v, ok := e.StatusCode.(int); ok && v != 0 {
+		return v
+	}
+
+	if e.Response != nil {
+		return e.Response.StatusCode
+	}
+
+	return 0
+}
@@ -0,0 +1,212 @@
+package azureerr
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type operationError struct {
+	err error
+}
+
+func (e operationError) Error() string {
+	return e.err.Error()
+}
+
+func (e operationError) Unwrap() error {
+	return e.err
+}
+
+func sdkError(statusCode int, code string, message string) error {
+	return sdkErrorWithDetails(statusCode, code, message)
+}
+
+func sdkErrorWithDetails(statusCode int, code string, message string, detailCodes ...string) error {
+	details := make([]map[string]interface{}, 0)
+	for _, detailCode := range detailCodes {
+		details = append(details, map[string]interface{}{
+			"code": detailCode,
+		})
+	}
+
+	resp := &http.Response{
+		StatusCode: statusCode,
+	}
+	requestError := &azure.RequestError{
+		DetailedError: autorest.DetailedError{
+			StatusCode: statusCode,
+			Response:   resp,
+		},
+		ServiceError: &azure.ServiceError{
+			Code:    code,
+			Message: message,
+			Details: details,
+		},
+	}
+	return autorest.NewErrorWithError(requestError, "authorization.RoleAssignmentsClient", "Create", resp, "Failure responding to request")
+}
+
+func TestClassify(t *testing.T) {
+	testCases := []struct {
+		name      string
+		err       error
+		expected  Class
+		retryable bool
+	}{
+		{
+			name:     "nil",
+			err:      nil,
+			expected: Unknown,
+		},
+		{
+			name:     "not an Azure error",
+			err:      fmt.Errorf("connection reset by peer"),
+			expected: Unknown,
+		},
+		{
+			name:      "throttled",
+			err:       sdkError(http.StatusTooManyRequests, "", ""),
+			expected:  Throttled,
+			retryable: true,
+		},
+		{
+			name:      "another operation in progress",
+			err:       sdkError(http.StatusConflict, "AnotherOperationInProgress", "Another operation is in progress"),
+			expected:  Conflict,
+			retryable: true,
+		},
+		{
+			name:      "operation preempted",
+			err:       sdkError(http.StatusConflict, "OperationPreempted", "The operation was preempted by another operation"),
+			expected:  Conflict,
+			retryable: true,
+		},
+		{
+			name:     "conflict without a code",
+			err:      sdkError(http.StatusConflict, "", ""),
+			expected: Unknown,
+		},
+		{
+			name:     "generic conflict",
+			err:      sdkError(http.StatusConflict, "Conflict", "The storage account name is already in use."),
+			expected: Unknown,
+		},
+		{
+			name:     "role assignment exists",
+			err:      sdkError(http.StatusConflict, "RoleAssignmentExists", "The role assignment already exists."),
+			expected: AlreadyExists,
+		},
+		{
+			name:     "not found",
+			err:      sdkError(http.StatusNotFound, "ResourceNotFound", "The Resource was not found."),
+			expected: NotFound,
+		},
+		{
+			name:     "principal not found",
+			err:      sdkError(http.StatusBadRequest, "PrincipalNotFound", "Principal 00000000000000000000000000000000 does not exist in the directory."),
+			expected: NotFound,
+		},
+		{
+			name:     "quota exceeded",
+			err:      sdkError(http.StatusBadRequest, "QuotaExceeded", "Operation results in exceeding quota limits."),
+			expected: QuotaExceeded,
+		},
+		{
+			name:     "quota exceeded within the details",
+			err:      sdkErrorWithDetails(http.StatusConflict, "OperationNotAllowed", "Operation results in exceeding approved Total Regional Cores quota.", "QuotaExceeded"),
+			expected: QuotaExceeded,
+		},
+		{
+			name:     "quota exceeded for a specific resource type",
+			err:      sdkError(http.StatusBadRequest, "PublicIPCountLimitReached", "Cannot create more than 10 public IP addresses for this subscription in this region."),
+			expected: QuotaExceeded,
+		},
+		{
+			name:     "quota mentioned in the message only",
+			err:      sdkError(http.StatusBadRequest, "InvalidParameter", "The value of quota_id is invalid."),
+			expected: Unknown,
+		},
+		{
+			name:     "details which aren't quota related",
+			err:      sdkErrorWithDetails(http.StatusNotFound, "ResourceNotFound", "The Resource was not found.", "AuthorizationFailed"),
+			expected: NotFound,
+		},
+		{
+			name:     "policy denied",
+			err:      sdkError(http.StatusForbidden, "RequestDisallowedByPolicy", "Resource was disallowed by policy."),
+			expected: PolicyDenied,
+		},
+		{
+			name:     "forbidden",
+			err:      sdkError(http.StatusForbidden, "AuthorizationFailed", "The client does not have authorization to perform action."),
+			expected: Forbidden,
+		},
+		{
+			name:     "forbidden without a code",
+			err:      sdkError(http.StatusForbidden, "", ""),
+			expected: Forbidden,
+		},
+		{
+			name:      "failed long-running operation",
+			err:       &azure.ServiceError{Code: "AnotherOperationInProgress"},
+			expected:  Conflict,
+			retryable: true,
+		},
+		{
+			name: "failed long-running operation with identifiers",
+			err: operationError{
+				err: &azure.ServiceError{Code: "RequestDisallowedByPolicy"},
+			},
+			expected: PolicyDenied,
+		},
+	}
+
+	for _, test := range testCases {
+		if actual := Classify(test.err); actual != test.expected {
+			t.Fatalf("Expected %q to be classified as %q but got %q", test.name, test.expected, actual)
+		}
+
+		if actual := IsRetryable(test.err); actual != test.retryable {
+			t.Fatalf("Expected %q to be retryable %t but got %t", test.name, test.retryable, actual)
+		}
+	}
+}
+
+func TestIsPrincipalNotFound(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "nil",
+			err:      nil,
+			expected: false,
+		},
+		{
+			name:     "principal not found",
+			err:      sdkError(http.StatusBadRequest, "PrincipalNotFound", "Principal 00000000000000000000000000000000 does not exist in the directory."),
+			expected: true,
+		},
+		{
+			name:     "scope not found",
+			err:      sdkError(http.StatusNotFound, "ResourceGroupNotFound", "Resource group 'example' could not be found."),
+			expected: false,
+		},
+		{
+			name:     "role definition not found",
+			err:      sdkError(http.StatusNotFound, "RoleDefinitionDoesNotExist", "The specified role definition does not exist."),
+			expected: false,
+		},
+	}
+
+	for _, test := range testCases {
+		if actual := IsPrincipalNotFound(test.err); actual != test.expected {
+			t.Fatalf("Expected %q to be a Principal not found error %t but got %t", test.name, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package batch
+
+import (
+	"sync"
+	"time"
+)
+
+// Change modifies the parent resource, returning an error if the change can't be applied - in which
+// case the parent must be left unmodified, since the other changes in the batch are still applied
+type Change func(parent interface{}) error
+
+// Operations are used to retrieve and update a parent resource
+type Operations struct {
+	// Get retrieves the current version of the parent resource
+	Get func() (interface{}, error)
+
+	// Update sends the parent resource (containing the changes) to Azure, returning the updated version
+	Update func(parent interface{}) (interface{}, error)
+}
+
+// Locker is used to ensure only a single update is made to a parent resource at a time - which is
+// satisfied by a `mutexkv.MutexKV`, allowing updates to be serialized with those made outside of a batch
+type Locker interface {
+	Lock(key string)
+	Unlock(key string)
+}
+
+// Batcher coalesces concurrent changes to the same parent resource (for example Probes, Rules and
+// NAT Rules within a Load Balancer) into a single update - rather than retrieving and updating the
+// parent resource once per change.
+//
+// Changes are grouped whilst the previous update for the parent resource is in progress, and
+// optionally for a further window - allowing more changes to be included in each update.
+type Batcher struct {
+	window time.Duration
+	locker Locker
+
+	lock    sync.Mutex
+	pending map[string]*batch
+}
+
+type batch struct {
+	changes []Change
+	errors  []error
+	result  interface{}
+	done    chan struct{}
+}
+
+// New returns a Batcher which waits for the specified window before updating a parent resource
+func New(window time.Duration, locker Locker) *Batcher {
+	return &Batcher{
+		window:  window,
+		locker:  locker,
+		pending: make(map[string]*batch),
+	}
+}
+
+// Submit queues the change to the parent resource identified by the key, blocking until it's been
+// applied - returning the updated parent resource, or the error which prevented the change being applied.
+func (b *Batcher) Submit(key string, operations Operations, change Change) (interface{}, error) {
+	b.lock.Lock()
+	current, exists := b.pending[key]
+	if !exists {
+		current = &batch{
+			done: make(chan struct{}),
+		}
+		b.pending[key] = current
+	}
+	index := len(current.changes)
+	current.changes = append(current.changes, change)
+	b.lock.Unlock()
+
+	// the first change in each batch is responsible for applying it
+	if exists {
+		<-current.done
+		return current.result, current.errors[index]
+	}
+
+	if b.window > 0 {
+		time.Sleep(b.window)
+	}
+
+	b.locker.Lock(key)
+	defer b.locker.Unlock(key)
+
+	// any changes submitted whilst waiting for the lock are part of this batch, those submitted
+	// from here onwards are part of the next one
+	b.lock.Lock()
+	delete(b.pending, key)
+	b.lock.Unlock()
+
+	current.apply(operations)
+	close(current.done)
+
+	return current.result, current.errors[index]
+}
+
+func (b *batch) apply(operations Operations) {
+	b.errors = make([]error, len(b.changes))
+
+	parent, err := operations.Get()
+	if err != nil {
+		for i := range b.errors {
+			b.errors[i] = err
+		}
+		return
+	}
+
+	applied := make([]int, 0)
+	for i, change := range b.changes {
+		if err := change(parent); err != nil {
+			b.errors[i] = err
+			continue
+		}
+
+		applied = append(applied, i)
+	}
+
+	if len(applied) == 0 {
+		return
+	}
+
+	result, err := operations.Update(parent)
+	if err != nil {
+		for _, i := range applied {
+			b.errors[i] = err
+		}
+		return
+	}
+
+	b.result = result
+}
@@ -0,0 +1,132 @@
+package batch
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testLocker struct {
+	lock sync.Mutex
+}
+
+func (l *testLocker) Lock(key string) {
+	l.lock.Lock()
+}
+
+func (l *testLocker) Unlock(key string) {
+	l.lock.Unlock()
+}
+
+func TestBatcherCoalescesChanges(t *testing.T) {
+	batcher := New(100*time.Millisecond, &testLocker{})
+
+	var updates int
+	parent := make([]string, 0)
+	operations := Operations{
+		Get: func() (interface{}, error) {
+			return &parent, nil
+		},
+		Update: func(p interface{}) (interface{}, error) {
+			updates++
+			return p, nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	errors := make([]error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errors[i] = batcher.Submit("parent", operations, func(p interface{}) error {
+				items := p.(*[]string)
+				*items = append(*items, fmt.Sprintf("item-%d", i))
+				return nil
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errors {
+		if err != nil {
+			t.Fatalf("Expected change %d to be applied but got: %+v", i, err)
+		}
+	}
+
+	if len(parent) != 10 {
+		t.Fatalf("Expected 10 changes to be applied but got %d", len(parent))
+	}
+
+	if updates != 1 {
+		t.Fatalf("Expected the changes to be coalesced into a single update but got %d", updates)
+	}
+}
+
+func TestBatcherFailedChange(t *testing.T) {
+	batcher := New(100*time.Millisecond, &testLocker{})
+
+	var updates int
+	parent := make([]string, 0)
+	operations := Operations{
+		Get: func() (interface{}, error) {
+			return &parent, nil
+		},
+		Update: func(p interface{}) (interface{}, error) {
+			updates++
+			return p, nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	errors := make([]error, 2)
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errors[i] = batcher.Submit("parent", operations, func(p interface{}) error {
+				if i == 1 {
+					return fmt.Errorf("invalid change")
+				}
+
+				items := p.(*[]string)
+				*items = append(*items, "item")
+				return nil
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	if errors[0] != nil {
+		t.Fatalf("Expected the valid change to be applied but got: %+v", errors[0])
+	}
+
+	if errors[1] == nil {
+		t.Fatalf("Expected the invalid change to return an error")
+	}
+
+	if len(parent) != 1 || updates != 1 {
+		t.Fatalf("Expected a single update containing the valid change but got %d update(s) with %+v", updates, parent)
+	}
+}
+
+func TestBatcherFailedUpdate(t *testing.T) {
+	batcher := New(0, &testLocker{})
+
+	operations := Operations{
+		Get: func() (interface{}, error) {
+			return nil, nil
+		},
+		Update: func(p interface{}) (interface{}, error) {
+			return nil, fmt.Errorf("update failed")
+		},
+	}
+
+	_, err := batcher.Submit("parent", operations, func(p interface{}) error {
+		return nil
+	})
+	if err == nil {
+		t.Fatalf("Expected the error from the update to be returned")
+	}
+}
@@ -0,0 +1,105 @@
+package cache
+
+import (
+	"strings"
+	"sync"
+)
+
+// Fetch retrieves a resource, returning the resource and its ETag - or a nil resource if it doesn't exist
+type Fetch func() (interface{}, string, error)
+
+// Cache memoizes parent resources (for example Load Balancers) which are retrieved by each of their child
+// resources, such that the parent resource is retrieved once rather than once per child resource.
+//
+// Entries are keyed by the (case-insensitive) Resource ID and record the ETag of the cached version - and
+// must be invalidated (or replaced) when the resource is updated.
+type Cache struct {
+	lock    sync.Mutex
+	entries map[string]*entry
+}
+
+type entry struct {
+	value interface{}
+	etag  string
+	err   error
+	done  chan struct{}
+}
+
+// New returns an empty Cache
+func New() *Cache {
+	return &Cache{
+		entries: make(map[string]*entry),
+	}
+}
+
+// Get returns the cached version of the resource with the specified ID, using fetch to retrieve it if it's not
+// cached. Concurrent calls for the same uncached resource share a single retrieval.
+//
+// The returned resource is shared between callers and as such must not be modified.
+func (c *Cache) Get(id string, fetch Fetch) (interface{}, error) {
+	key := strings.ToLower(id)
+
+	c.lock.Lock()
+	if existing, ok := c.entries[key]; ok {
+		c.lock.Unlock()
+		<-existing.done
+		return existing.value, existing.err
+	}
+
+	current := &entry{
+		done: make(chan struct{}),
+	}
+	c.entries[key] = current
+	c.lock.Unlock()
+
+	current.value, current.etag, current.err = fetch()
+	close(current.done)
+
+	// errors and missing resources are only shared with concurrent callers, rather than being cached
+	if current.err != nil || current.value == nil {
+		c.lock.Lock()
+		if c.entries[key] == current {
+			delete(c.entries, key)
+		}
+		c.lock.Unlock()
+	}
+
+	return current.value, current.err
+}
+
+// Set caches the specified version of the resource (for example as returned from an update), unless the
+// cached version has the same ETag
+func (c *Cache) Set(id string, etag string, value interface{}) {
+	key := strings.ToLower(id)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if existing, ok := c.entries[key]; ok {
+		select {
+		case <-existing.done:
+			if existing.err == nil && existing.value != nil && etag != "" && existing.etag == etag {
+				return
+			}
+		default:
+			// a retrieval is in progress, which may return an older version than this one
+		}
+	}
+
+	current := &entry{
+		value: value,
+		etag:  etag,
+		done:  make(chan struct{}),
+	}
+	close(current.done)
+	c.entries[key] = current
+}
+
+// Invalidate removes the cached version of the resource with the specified ID
+func (c *Cache) Invalidate(id string) {
+	key := strings.ToLower(id)
+
+	c.lock.Lock()
+	delete(c.entries, key)
+	c.lock.Unlock()
+}
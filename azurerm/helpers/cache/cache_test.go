@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCacheGetSharesRetrieval(t *testing.T) {
+	cache := New()
+
+	var lock sync.Mutex
+	var fetches int
+	fetch := func() (interface{}, string, error) {
+		lock.Lock()
+		fetches++
+		lock.Unlock()
+
+		time.Sleep(50 * time.Millisecond)
+		return "parent", "etag-1", nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := cache.Get("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1", fetch)
+			if err != nil {
+				t.Errorf("Expected no error but got: %+v", err)
+			}
+			if value != "parent" {
+				t.Errorf("Expected the cached value but got %+v", value)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// IDs are case-insensitive
+	if _, err := cache.Get("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/Microsoft.Network/loadBalancers/LB1", fetch); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if fetches != 1 {
+		t.Fatalf("Expected a single retrieval but got %d", fetches)
+	}
+}
+
+func TestCacheGetDoesNotCacheErrors(t *testing.T) {
+	cache := New()
+
+	var fetches int
+	fetch := func() (interface{}, string, error) {
+		fetches++
+		if fetches == 1 {
+			return nil, "", fmt.Errorf("retrieval failed")
+		}
+
+		return "parent", "etag-1", nil
+	}
+
+	if _, err := cache.Get("lb1", fetch); err == nil {
+		t.Fatalf("Expected an error for the first retrieval")
+	}
+
+	value, err := cache.Get("lb1", fetch)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if value != "parent" || fetches != 2 {
+		t.Fatalf("Expected the resource to be retrieved again but got %+v after %d retrievals", value, fetches)
+	}
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	cache := New()
+
+	var fetches int
+	fetch := func() (interface{}, string, error) {
+		fetches++
+		return fmt.Sprintf("parent-%d", fetches), fmt.Sprintf("etag-%d", fetches), nil
+	}
+
+	cache.Get("lb1", fetch)
+	cache.Invalidate("LB1")
+
+	value, _ := cache.Get("lb1", fetch)
+	if value != "parent-2" {
+		t.Fatalf("Expected the resource to be retrieved again after invalidation but got %+v", value)
+	}
+}
+
+func TestCacheSet(t *testing.T) {
+	cache := New()
+
+	fetch := func() (interface{}, string, error) {
+		return "parent", "etag-1", nil
+	}
+	cache.Get("lb1", fetch)
+
+	// the same version shouldn't replace the cached resource
+	cache.Set("lb1", "etag-1", "same-version")
+	if value, _ := cache.Get("lb1", fetch); value != "parent" {
+		t.Fatalf("Expected the cached resource to be retained but got %+v", value)
+	}
+
+	cache.Set("lb1", "etag-2", "updated")
+	if value, _ := cache.Get("lb1", fetch); value != "updated" {
+		t.Fatalf("Expected the updated resource to be cached but got %+v", value)
+	}
+}
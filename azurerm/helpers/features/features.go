@@ -0,0 +1,164 @@
+// Package features contains the opt-in behaviours which can be enabled using the `features` block
+// within the Provider block, for example:
+//
+//	provider "azurerm" {
+//	  features {
+//	    key_vault {
+//	      purge_soft_delete_on_destroy = true
+//	    }
+//	  }
+//	}
+//
+// Each feature is registered (at package initialization) using Register, which returns the Flag
+// used to check whether the feature has been enabled - as such adding a new feature is a case of:
+//
+//  1. Registering it in `registry.go`, with the block and field name, a default value and a description
+//     (which is also used as the description of the field in the Provider Schema).
+//  2. Checking whether it's enabled within the resource, using `meta.(*ArmClient).features.Enabled(flag)`.
+//  3. Documenting it in `website/docs/index.html.markdown`.
+package features
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Flag identifies a registered feature
+type Flag struct {
+	Block string
+	Name  string
+}
+
+func (f Flag) String() string {
+	return fmt.Sprintf("%s.%s", f.Block, f.Name)
+}
+
+type feature struct {
+	Flag
+	Default     bool
+	Description string
+}
+
+var registered = make(map[Flag]feature)
+
+// Register adds a feature to the `features` block within the specified nested block, returning the
+// Flag used to check whether it's enabled. Features must be registered at package initialization,
+// since the Provider Schema is built from the registered features.
+func Register(block, name string, defaultValue bool, description string) Flag {
+	flag := Flag{
+		Block: block,
+		Name:  name,
+	}
+
+	if _, exists := registered[flag]; exists {
+		panic(fmt.Sprintf("The feature %q has already been registered", flag))
+	}
+
+	registered[flag] = feature{
+		Flag:        flag,
+		Default:     defaultValue,
+		Description: description,
+	}
+	return flag
+}
+
+// UserFeatures are the features which have been enabled within the Provider block
+type UserFeatures struct {
+	values map[Flag]bool
+}
+
+// Enabled returns whether the specified feature is enabled, or its default value if it's not configured
+func (u UserFeatures) Enabled(flag Flag) bool {
+	if v, ok := u.values[flag]; ok {
+		return v
+	}
+
+	if f, ok := registered[flag]; ok {
+		return f.Default
+	}
+
+	return false
+}
+
+// Default returns the UserFeatures where each feature has its default value
+func Default() UserFeatures {
+	return UserFeatures{
+		values: make(map[Flag]bool),
+	}
+}
+
+// Schema returns the `features` block for the Provider Schema, containing each registered feature
+func Schema() *schema.Schema {
+	blocks := make(map[string]map[string]*schema.Schema)
+	for _, f := range registered {
+		if _, ok := blocks[f.Block]; !ok {
+			blocks[f.Block] = make(map[string]*schema.Schema)
+		}
+
+		blocks[f.Block][f.Name] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     f.Default,
+			Description: f.Description,
+		}
+	}
+
+	fields := make(map[string]*schema.Schema)
+	for name, block := range blocks {
+		fields[name] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: block,
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+// Expand returns the UserFeatures configured within the `features` block
+func Expand(input []interface{}) UserFeatures {
+	features := Default()
+	if len(input) == 0 || input[0] == nil {
+		return features
+	}
+
+	raw := input[0].(map[string]interface{})
+	for _, flag := range Flags() {
+		blocks, ok := raw[flag.Block].([]interface{})
+		if !ok || len(blocks) == 0 || blocks[0] == nil {
+			continue
+		}
+
+		block := blocks[0].(map[string]interface{})
+		if v, ok := block[flag.Name].(bool); ok {
+			features.values[flag] = v
+		}
+	}
+
+	return features
+}
+
+// Flags returns the sorted list of registered features
+func Flags() []Flag {
+	flags := make([]Flag, 0)
+	for flag := range registered {
+		flags = append(flags, flag)
+	}
+
+	sort.Slice(flags, func(i, j int) bool {
+		return flags[i].String() < flags[j].String()
+	})
+	return flags
+}
@@ -0,0 +1,80 @@
+package features
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestSchemaContainsRegisteredFeatures(t *testing.T) {
+	blocks := Schema().Elem.(*schema.Resource).Schema
+	for _, flag := range Flags() {
+		block, ok := blocks[flag.Block]
+		if !ok {
+			t.Fatalf("Expected the block %q to exist for %q", flag.Block, flag)
+		}
+
+		if _, ok := block.Elem.(*schema.Resource).Schema[flag.Name]; !ok {
+			t.Fatalf("Expected the field %q to exist for %q", flag.Name, flag)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected bool
+	}{
+		{
+			Name:     "Not Configured",
+			Input:    []interface{}{},
+			Expected: false,
+		},
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+						},
+					},
+				},
+			},
+			Expected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			features := Expand(tc.Input)
+			if actual := features.Enabled(KeyVaultPurgeSoftDeleteOnDestroy); actual != tc.Expected {
+				t.Fatalf("Expected %q to be %t but got %t", KeyVaultPurgeSoftDeleteOnDestroy, tc.Expected, actual)
+			}
+
+			if features.Enabled(VirtualMachineDeleteOSDiskOnDeletion) {
+				t.Fatalf("Expected %q to have its default value", VirtualMachineDeleteOSDiskOnDeletion)
+			}
+		})
+	}
+}
+
+func TestRegisterDuplicatePanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("Expected registering a duplicate feature to panic")
+		}
+	}()
+
+	Register(KeyVaultPurgeSoftDeleteOnDestroy.Block, KeyVaultPurgeSoftDeleteOnDestroy.Name, false, "duplicate")
+}
@@ -0,0 +1,28 @@
+package features
+
+var (
+	// KeyVaultPurgeSoftDeleteOnDestroy purges Key Vaults with Soft Delete enabled when they're destroyed,
+	// so that the name can be reused immediately
+	KeyVaultPurgeSoftDeleteOnDestroy = Register("key_vault", "purge_soft_delete_on_destroy", false,
+		"Should Key Vaults with Soft Delete enabled be purged when they're destroyed?")
+
+	// KeyVaultRecoverSoftDeletedKeyVaults recovers a soft-deleted Key Vault with the same name and location
+	// rather than attempting to create a new one (which would fail, since the name is in use)
+	KeyVaultRecoverSoftDeletedKeyVaults = Register("key_vault", "recover_soft_deleted_key_vaults", false,
+		"Should a soft-deleted Key Vault with the same name be recovered, rather than creating a new Key Vault?")
+
+	// KeyVaultRequireImportOfExistingAccessPolicies returns an error when creating an `azurerm_key_vault_access_policy`
+	// for an Object ID (and Application ID) which already has an Access Policy, rather than merging the two
+	KeyVaultRequireImportOfExistingAccessPolicies = Register("key_vault", "require_import_of_existing_access_policies", false,
+		"Should an error be returned when creating a Key Vault Access Policy for an Object ID which already has an Access Policy, requiring it's imported rather than merging the permissions?")
+
+	// VirtualMachineDeleteOSDiskOnDeletion deletes the OS Disk when a Virtual Machine is destroyed,
+	// regardless of the `delete_os_disk_on_termination` field
+	VirtualMachineDeleteOSDiskOnDeletion = Register("virtual_machine", "delete_os_disk_on_deletion", false,
+		"Should the OS Disk attached to a Virtual Machine be deleted when the Virtual Machine is destroyed?")
+
+	// VirtualMachineScaleSetRollInstancesWhenRequired upgrades the instances within a Virtual Machine Scale Set
+	// using a `Manual` Upgrade Policy to the latest model when the Scale Set is updated
+	VirtualMachineScaleSetRollInstancesWhenRequired = Register("virtual_machine_scale_set", "roll_instances_when_required", false,
+		"Should the instances within a Virtual Machine Scale Set with a `Manual` Upgrade Policy be upgraded to the latest model when the Scale Set is updated?")
+)
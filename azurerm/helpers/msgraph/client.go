@@ -0,0 +1,47 @@
+// Package msgraph contains a client for the parts of the Microsoft Graph API which aren't available
+// in the Azure Active Directory Graph API exposed by the Azure SDK (such as Federated Identity Credentials).
+//
+// Unlike the Resource Providers managed through the `resourcemanager` client, the Microsoft Graph API isn't
+// an Azure Resource Manager API - it's versioned in the path rather than the `api-version` query string, pages
+// results using `@odata.nextLink` and has no long-running operations - as such it has a client of its own.
+package msgraph
+
+import (
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+const (
+	// DefaultBaseURI is the default URI used for the Microsoft Graph API
+	DefaultBaseURI = "https://graph.microsoft.com"
+
+	apiVersion = "v1.0"
+)
+
+// BaseClient is the base client for the Microsoft Graph API.
+type BaseClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+// NewWithBaseURI creates an instance of the BaseClient client.
+func NewWithBaseURI(baseURI string) BaseClient {
+	return BaseClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: baseURI,
+	}
+}
+
+// EndpointForEnvironment returns the Microsoft Graph endpoint for the specified Azure Environment
+func EndpointForEnvironment(env azure.Environment) string {
+	switch env.Name {
+	case azure.ChinaCloud.Name:
+		return "https://microsoftgraph.chinacloudapi.cn"
+	case azure.GermanCloud.Name:
+		return "https://graph.microsoft.de"
+	case azure.USGovernmentCloud.Name:
+		return "https://graph.microsoft.us"
+	}
+
+	return DefaultBaseURI
+}
@@ -0,0 +1,221 @@
+package msgraph
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// FederatedIdentityCredentialsClient is the client for the Federated Identity Credentials of an Application.
+type FederatedIdentityCredentialsClient struct {
+	BaseClient
+}
+
+// NewFederatedIdentityCredentialsClientWithBaseURI creates an instance of the FederatedIdentityCredentialsClient client.
+func NewFederatedIdentityCredentialsClientWithBaseURI(baseURI string) FederatedIdentityCredentialsClient {
+	return FederatedIdentityCredentialsClient{NewWithBaseURI(baseURI)}
+}
+
+// Create creates a Federated Identity Credential for the specified Application.
+// Parameters:
+// applicationObjectID - the object ID of the Application.
+// parameters - the Federated Identity Credential to create.
+func (client FederatedIdentityCredentialsClient) Create(ctx context.Context, applicationObjectID string, parameters FederatedIdentityCredential) (result FederatedIdentityCredential, err error) {
+	pathParameters := map[string]interface{}{
+		"apiVersion":          apiVersion,
+		"applicationObjectId": autorest.Encode("path", applicationObjectID),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/applications/{applicationObjectId}/federatedIdentityCredentials", pathParameters),
+		autorest.WithJSON(parameters))
+
+	resp, err := client.send(ctx, preparer, "Create")
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "msgraph.FederatedIdentityCredentialsClient", "Create", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// Get retrieves the specified Federated Identity Credential for the specified Application.
+// Parameters:
+// applicationObjectID - the object ID of the Application.
+// id - the ID of the Federated Identity Credential.
+func (client FederatedIdentityCredentialsClient) Get(ctx context.Context, applicationObjectID string, id string) (result FederatedIdentityCredential, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/applications/{applicationObjectId}/federatedIdentityCredentials/{id}", credentialPathParameters(applicationObjectID, id)))
+
+	resp, err := client.send(ctx, preparer, "Get")
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "msgraph.FederatedIdentityCredentialsClient", "Get", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// List retrieves all of the Federated Identity Credentials for the specified Application, following the
+// `@odata.nextLink` of each page until the last page has been retrieved.
+// Parameters:
+// applicationObjectID - the object ID of the Application.
+func (client FederatedIdentityCredentialsClient) List(ctx context.Context, applicationObjectID string) (result FederatedIdentityCredentialListResult, err error) {
+	pathParameters := map[string]interface{}{
+		"apiVersion":          apiVersion,
+		"applicationObjectId": autorest.Encode("path", applicationObjectID),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/applications/{applicationObjectId}/federatedIdentityCredentials", pathParameters))
+
+	credentials := make([]FederatedIdentityCredential, 0)
+	for {
+		var page FederatedIdentityCredentialListResult
+		resp, err := client.send(ctx, preparer, "List")
+		if err != nil {
+			result.Response = autorest.Response{Response: resp}
+			return result, err
+		}
+
+		err = autorest.Respond(
+			resp,
+			client.ByInspecting(),
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&page),
+			autorest.ByClosing())
+		result.Response = autorest.Response{Response: resp}
+		if err != nil {
+			return result, autorest.NewErrorWithError(err, "msgraph.FederatedIdentityCredentialsClient", "List", resp, "Failure responding to request")
+		}
+
+		if page.Value != nil {
+			credentials = append(credentials, *page.Value...)
+		}
+
+		if page.NextLink == nil || *page.NextLink == "" {
+			break
+		}
+
+		// the Next Link is an absolute URI which already contains the query string for the next page
+		preparer = autorest.CreatePreparer(
+			autorest.AsGet(),
+			autorest.WithBaseURL(*page.NextLink))
+	}
+
+	result.Value = &credentials
+	return result, nil
+}
+
+// Update updates the specified Federated Identity Credential for the specified Application - the Name can't be updated.
+// Parameters:
+// applicationObjectID - the object ID of the Application.
+// id - the ID of the Federated Identity Credential.
+// parameters - the fields of the Federated Identity Credential to update.
+func (client FederatedIdentityCredentialsClient) Update(ctx context.Context, applicationObjectID string, id string, parameters FederatedIdentityCredential) (result autorest.Response, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/applications/{applicationObjectId}/federatedIdentityCredentials/{id}", credentialPathParameters(applicationObjectID, id)),
+		autorest.WithJSON(parameters))
+
+	resp, err := client.send(ctx, preparer, "Update")
+	result.Response = resp
+	if err != nil {
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "msgraph.FederatedIdentityCredentialsClient", "Update", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// Delete deletes the specified Federated Identity Credential from the specified Application.
+// Parameters:
+// applicationObjectID - the object ID of the Application.
+// id - the ID of the Federated Identity Credential.
+func (client FederatedIdentityCredentialsClient) Delete(ctx context.Context, applicationObjectID string, id string) (result autorest.Response, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/applications/{applicationObjectId}/federatedIdentityCredentials/{id}", credentialPathParameters(applicationObjectID, id)))
+
+	resp, err := client.send(ctx, preparer, "Delete")
+	result.Response = resp
+	if err != nil {
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "msgraph.FederatedIdentityCredentialsClient", "Delete", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (client FederatedIdentityCredentialsClient) send(ctx context.Context, preparer autorest.Preparer, method string) (*http.Response, error) {
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "msgraph.FederatedIdentityCredentialsClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req,
+		autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, "msgraph.FederatedIdentityCredentialsClient", method, resp, "Failure sending request")
+	}
+
+	return resp, nil
+}
+
+func credentialPathParameters(applicationObjectID string, id string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion":          apiVersion,
+		"applicationObjectId": autorest.Encode("path", applicationObjectID),
+		"id":                  autorest.Encode("path", id),
+	}
+}
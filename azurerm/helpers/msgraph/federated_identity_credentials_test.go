@@ -0,0 +1,177 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestClient(baseURI string) FederatedIdentityCredentialsClient {
+	client := NewFederatedIdentityCredentialsClientWithBaseURI(baseURI)
+	client.RetryAttempts = 3
+	client.RetryDuration = 0
+	return client
+}
+
+func TestFederatedIdentityCredentialsClient_ListFollowsNextLink(t *testing.T) {
+	requests := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/v1.0/applications/app-id/federatedIdentityCredentials" {
+			t.Fatalf("Unexpected path %q", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("$skiptoken") {
+		case "":
+			fmt.Fprintf(w, `{"value":[{"id":"1","name":"first"},{"id":"2","name":"second"}],"@odata.nextLink":"%s/v1.0/applications/app-id/federatedIdentityCredentials?$skiptoken=page2"}`, server.URL)
+		case "page2":
+			fmt.Fprint(w, `{"value":[{"id":"3","name":"third"}]}`)
+		default:
+			t.Fatalf("Unexpected query %q", r.URL.RawQuery)
+		}
+	}))
+	defer server.Close()
+
+	result, err := newTestClient(server.URL).List(context.Background(), "app-id")
+	if err != nil {
+		t.Fatalf("Error listing the Federated Identity Credentials: %+v", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("Expected 2 requests but got %d", requests)
+	}
+
+	if result.Value == nil || len(*result.Value) != 3 {
+		t.Fatalf("Expected 3 Federated Identity Credentials but got %+v", result.Value)
+	}
+
+	for i, expected := range []string{"first", "second", "third"} {
+		if actual := (*result.Value)[i].Name; actual == nil || *actual != expected {
+			t.Fatalf("Expected Federated Identity Credential %d to be %q but got %+v", i, expected, actual)
+		}
+	}
+}
+
+func TestFederatedIdentityCredentialsClient_ListWithoutResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"value":[]}`)
+	}))
+	defer server.Close()
+
+	result, err := newTestClient(server.URL).List(context.Background(), "app-id")
+	if err != nil {
+		t.Fatalf("Error listing the Federated Identity Credentials: %+v", err)
+	}
+
+	if result.Value == nil || len(*result.Value) != 0 {
+		t.Fatalf("Expected no Federated Identity Credentials but got %+v", result.Value)
+	}
+}
+
+func TestFederatedIdentityCredentialsClient_ListRetriesThrottledPage(t *testing.T) {
+	requests := 0
+	throttled := false
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Query().Get("$skiptoken") == "" {
+			fmt.Fprintf(w, `{"value":[{"id":"1","name":"first"}],"@odata.nextLink":"%s/v1.0/applications/app-id/federatedIdentityCredentials?$skiptoken=page2"}`, server.URL)
+			return
+		}
+
+		if !throttled {
+			throttled = true
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"error":{"code":"TooManyRequests","message":"Too many requests"}}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"value":[{"id":"2","name":"second"}]}`)
+	}))
+	defer server.Close()
+
+	result, err := newTestClient(server.URL).List(context.Background(), "app-id")
+	if err != nil {
+		t.Fatalf("Error listing the Federated Identity Credentials: %+v", err)
+	}
+
+	if requests != 3 {
+		t.Fatalf("Expected 3 requests (including the throttled request) but got %d", requests)
+	}
+
+	if result.Value == nil || len(*result.Value) != 2 {
+		t.Fatalf("Expected 2 Federated Identity Credentials but got %+v", result.Value)
+	}
+}
+
+func TestFederatedIdentityCredentialsClient_GetRetriesWhenThrottled(t *testing.T) {
+	requests := 0
+	var firstRequest, secondRequest time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+
+		if requests == 1 {
+			firstRequest = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"error":{"code":"TooManyRequests","message":"Too many requests"}}`)
+			return
+		}
+
+		secondRequest = time.Now()
+		fmt.Fprint(w, `{"id":"1","name":"first","issuer":"https://token.actions.githubusercontent.com"}`)
+	}))
+	defer server.Close()
+
+	result, err := newTestClient(server.URL).Get(context.Background(), "app-id", "1")
+	if err != nil {
+		t.Fatalf("Error retrieving the Federated Identity Credential: %+v", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("Expected 2 requests but got %d", requests)
+	}
+
+	// the `Retry-After` header should be honoured before the request is retried
+	if delay := secondRequest.Sub(firstRequest); delay < time.Second {
+		t.Fatalf("Expected the retry to be delayed by at least 1s but it was delayed by %s", delay)
+	}
+
+	if result.Name == nil || *result.Name != "first" {
+		t.Fatalf("Expected the Name to be `first` but got %+v", result.Name)
+	}
+}
+
+func TestFederatedIdentityCredentialsClient_GetNotFound(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":{"code":"Request_ResourceNotFound","message":"Resource does not exist"}}`)
+	}))
+	defer server.Close()
+
+	result, err := newTestClient(server.URL).Get(context.Background(), "app-id", "1")
+	if err == nil {
+		t.Fatalf("Expected an error retrieving a Federated Identity Credential which doesn't exist")
+	}
+
+	if requests != 1 {
+		t.Fatalf("Expected a 404 not to be retried but got %d requests", requests)
+	}
+
+	if result.Response.Response == nil || result.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected the Response to be a 404 but got %+v", result.Response.Response)
+	}
+}
@@ -0,0 +1,30 @@
+package msgraph
+
+import "github.com/Azure/go-autorest/autorest"
+
+// FederatedIdentityCredential is a credential which allows an external identity provider (such as a CI
+// system issuing OpenID Connect tokens) to exchange its tokens for an access token for the Application.
+type FederatedIdentityCredential struct {
+	autorest.Response `json:"-"`
+	// ID - The unique identifier of the Federated Identity Credential. Read-only.
+	ID *string `json:"id,omitempty"`
+	// Name - The unique name of the Federated Identity Credential, which can't be changed once created.
+	Name *string `json:"name,omitempty"`
+	// Description - A user-provided description of the Federated Identity Credential.
+	Description *string `json:"description,omitempty"`
+	// Issuer - The URL of the external identity provider, which must match the `issuer` claim of the external token.
+	Issuer *string `json:"issuer,omitempty"`
+	// Subject - The identifier of the external workload, which must match the `sub` claim of the external token.
+	Subject *string `json:"subject,omitempty"`
+	// Audiences - The audiences which can appear in the `aud` claim of the external token.
+	Audiences *[]string `json:"audiences,omitempty"`
+}
+
+// FederatedIdentityCredentialListResult is a list of the Federated Identity Credentials of an Application.
+type FederatedIdentityCredentialListResult struct {
+	autorest.Response `json:"-"`
+	// Value - The Federated Identity Credentials within this page.
+	Value *[]FederatedIdentityCredential `json:"value,omitempty"`
+	// NextLink - The URL of the next page of results, which is only present when there are further pages.
+	NextLink *string `json:"@odata.nextLink,omitempty"`
+}
@@ -0,0 +1,251 @@
+package naming
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	lowercaseLetters = "abcdefghijklmnopqrstuvwxyz"
+	uppercaseLetters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	numbers          = "0123456789"
+
+	// randomLength is the number of random characters included in a name, where space allows
+	randomLength = 8
+
+	// minimumRandomLength is the fewest random characters a name can contain whilst still being unique
+	minimumRandomLength = 4
+)
+
+// Rule describes the constraints Azure places on the name of a resource
+type Rule struct {
+	MinLength int
+	MaxLength int
+
+	// Lowercase specifies that the name can only contain lowercase characters
+	Lowercase bool
+
+	// Dashes specifies that the name can contain dashes, which are used to separate
+	// the prefix and suffix from the random characters
+	Dashes bool
+
+	// StartWithLetter specifies that the name must begin with a letter
+	StartWithLetter bool
+}
+
+// Rules are the naming constraints for each supported resource type
+var Rules = map[string]Rule{
+	"azurerm_app_service": {
+		MinLength: 2,
+		MaxLength: 60,
+		Dashes:    true,
+	},
+	"azurerm_app_service_plan": {
+		MinLength: 1,
+		MaxLength: 60,
+		Dashes:    true,
+	},
+	"azurerm_container_registry": {
+		MinLength: 5,
+		MaxLength: 49,
+	},
+	"azurerm_cosmosdb_account": {
+		MinLength: 3,
+		MaxLength: 50,
+		Lowercase: true,
+		Dashes:    true,
+	},
+	"azurerm_eventhub_namespace": {
+		MinLength:       6,
+		MaxLength:       50,
+		Dashes:          true,
+		StartWithLetter: true,
+	},
+	"azurerm_key_vault": {
+		MinLength:       3,
+		MaxLength:       24,
+		Dashes:          true,
+		StartWithLetter: true,
+	},
+	"azurerm_kubernetes_cluster": {
+		MinLength: 1,
+		MaxLength: 63,
+		Dashes:    true,
+	},
+	"azurerm_redis_cache": {
+		MinLength: 1,
+		MaxLength: 63,
+		Dashes:    true,
+	},
+	"azurerm_resource_group": {
+		MinLength: 1,
+		MaxLength: 80,
+		Dashes:    true,
+	},
+	"azurerm_search_service": {
+		MinLength: 2,
+		MaxLength: 60,
+		Lowercase: true,
+		Dashes:    true,
+	},
+	"azurerm_servicebus_namespace": {
+		MinLength:       6,
+		MaxLength:       50,
+		Dashes:          true,
+		StartWithLetter: true,
+	},
+	"azurerm_sql_server": {
+		MinLength: 3,
+		MaxLength: 50,
+		Lowercase: true,
+		Dashes:    true,
+	},
+	"azurerm_storage_account": {
+		MinLength: 3,
+		MaxLength: 24,
+		Lowercase: true,
+	},
+	"azurerm_virtual_network": {
+		MinLength: 2,
+		MaxLength: 64,
+		Dashes:    true,
+	},
+}
+
+// ResourceTypes returns the sorted list of resource types which names can be generated for
+func ResourceTypes() []string {
+	types := make([]string, 0)
+	for k := range Rules {
+		types = append(types, k)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Generate returns a unique name for the specified resource type, which contains the prefix and suffix
+// (when specified) and complies with the naming constraints for that resource type.
+//
+// The random characters are sourced from `crypto/rand` so that names generated by tests running
+// in parallel (or in separate processes started at the same time) don't collide.
+func Generate(resourceType, prefix, suffix string) (string, error) {
+	return generate(resourceType, prefix, suffix, func(n int) ([]byte, error) {
+		b := make([]byte, n)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("Error generating random characters: %+v", err)
+		}
+		return b, nil
+	})
+}
+
+// GenerateFromSeed returns a name as per Generate, however the characters are derived from the seed
+// rather than being random - such that the same seed always results in the same name.
+func GenerateFromSeed(resourceType, prefix, suffix, seed string) (string, error) {
+	return generate(resourceType, prefix, suffix, func(n int) ([]byte, error) {
+		b := make([]byte, 0)
+		for i := 0; len(b) < n; i++ {
+			hash := sha256.Sum256([]byte(fmt.Sprintf("%s-%d", seed, i)))
+			b = append(b, hash[:]...)
+		}
+		return b[:n], nil
+	})
+}
+
+// MustGenerate returns a name as per Generate, panicking if this fails - which is intended for use in tests
+func MustGenerate(resourceType, prefix, suffix string) string {
+	name, err := Generate(resourceType, prefix, suffix)
+	if err != nil {
+		panic(err)
+	}
+	return name
+}
+
+func generate(resourceType, prefix, suffix string, source func(n int) ([]byte, error)) (string, error) {
+	rule, ok := Rules[resourceType]
+	if !ok {
+		return "", fmt.Errorf("Names cannot be generated for the resource type %q", resourceType)
+	}
+
+	prefix = rule.sanitize(prefix)
+	suffix = rule.sanitize(suffix)
+
+	separator := ""
+	if rule.Dashes {
+		separator = "-"
+	}
+
+	fixed := make([]string, 0)
+	if prefix != "" {
+		fixed = append(fixed, prefix)
+	}
+	if suffix != "" {
+		fixed = append(fixed, suffix)
+	}
+	fixedLength := len(strings.Join(fixed, "")) + len(separator)*len(fixed)
+
+	length := randomLength
+	if remaining := rule.MaxLength - fixedLength; remaining < length {
+		length = remaining
+	}
+	if length < minimumRandomLength {
+		return "", fmt.Errorf("The prefix %q and suffix %q are too long for a %q name, which can be at most %d characters", prefix, suffix, resourceType, rule.MaxLength)
+	}
+
+	if rule.StartWithLetter && prefix != "" && !strings.ContainsAny(prefix[:1], lowercaseLetters+uppercaseLetters) {
+		return "", fmt.Errorf("A %q name must start with a letter but the prefix was %q", resourceType, prefix)
+	}
+
+	b, err := source(length)
+	if err != nil {
+		return "", err
+	}
+
+	random := make([]byte, length)
+	for i, v := range b {
+		// the random characters are always lowercase, since not all resources treat names case-insensitively
+		charset := lowercaseLetters + numbers
+		if i == 0 && prefix == "" && rule.StartWithLetter {
+			charset = lowercaseLetters
+		}
+		random[i] = charset[int(v)%len(charset)]
+	}
+
+	segments := make([]string, 0)
+	if prefix != "" {
+		segments = append(segments, prefix)
+	}
+	segments = append(segments, string(random))
+	if suffix != "" {
+		segments = append(segments, suffix)
+	}
+
+	name := strings.Join(segments, separator)
+	if len(name) < rule.MinLength {
+		return "", fmt.Errorf("The generated name %q is shorter than the minimum length of %d for a %q", name, rule.MinLength, resourceType)
+	}
+
+	return name, nil
+}
+
+// sanitize removes any characters from the input which aren't valid within a name
+func (r Rule) sanitize(input string) string {
+	if r.Lowercase {
+		input = strings.ToLower(input)
+	}
+
+	allowed := lowercaseLetters + uppercaseLetters + numbers
+	if r.Dashes {
+		allowed += "-"
+	}
+
+	output := make([]rune, 0)
+	for _, c := range input {
+		if strings.ContainsRune(allowed, c) {
+			output = append(output, c)
+		}
+	}
+
+	return strings.Trim(string(output), "-")
+}
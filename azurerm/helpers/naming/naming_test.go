@@ -0,0 +1,125 @@
+package naming
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	for _, resourceType := range ResourceTypes() {
+		rule := Rules[resourceType]
+
+		for _, prefix := range []string{"", "acctest", "ACC_test"} {
+			name, err := Generate(resourceType, prefix, "")
+			if err != nil {
+				t.Fatalf("Error generating a name for %q with the prefix %q: %+v", resourceType, prefix, err)
+			}
+
+			if len(name) < rule.MinLength || len(name) > rule.MaxLength {
+				t.Fatalf("Expected the name %q for %q to be between %d and %d characters", name, resourceType, rule.MinLength, rule.MaxLength)
+			}
+
+			pattern := `^[a-zA-Z0-9]+$`
+			if rule.Dashes {
+				pattern = `^[a-zA-Z0-9]+(-[a-zA-Z0-9]+)*$`
+			}
+			if !regexp.MustCompile(pattern).MatchString(name) {
+				t.Fatalf("Expected the name %q for %q to match %q", name, resourceType, pattern)
+			}
+
+			if rule.Lowercase && strings.ToLower(name) != name {
+				t.Fatalf("Expected the name %q for %q to be lowercase", name, resourceType)
+			}
+
+			if rule.StartWithLetter && !regexp.MustCompile(`^[a-zA-Z]`).MatchString(name) {
+				t.Fatalf("Expected the name %q for %q to start with a letter", name, resourceType)
+			}
+		}
+	}
+}
+
+func TestGenerateIsUnique(t *testing.T) {
+	names := make(map[string]struct{})
+	for i := 0; i < 1000; i++ {
+		name, err := Generate("azurerm_storage_account", "acctest", "")
+		if err != nil {
+			t.Fatalf("Error generating name: %+v", err)
+		}
+
+		if _, exists := names[name]; exists {
+			t.Fatalf("Expected the name %q to be unique", name)
+		}
+		names[name] = struct{}{}
+	}
+}
+
+func TestGenerateFromSeed(t *testing.T) {
+	first, err := GenerateFromSeed("azurerm_key_vault", "kv", "prod", "example")
+	if err != nil {
+		t.Fatalf("Error generating name: %+v", err)
+	}
+
+	second, err := GenerateFromSeed("azurerm_key_vault", "kv", "prod", "example")
+	if err != nil {
+		t.Fatalf("Error generating name: %+v", err)
+	}
+
+	if first != second {
+		t.Fatalf("Expected the same seed to generate the same name but got %q and %q", first, second)
+	}
+
+	if !regexp.MustCompile(`^kv-[a-z0-9]{8}-prod$`).MatchString(first) {
+		t.Fatalf("Expected the name to contain the prefix and suffix but got %q", first)
+	}
+
+	other, err := GenerateFromSeed("azurerm_key_vault", "kv", "prod", "other")
+	if err != nil {
+		t.Fatalf("Error generating name: %+v", err)
+	}
+
+	if first == other {
+		t.Fatalf("Expected different seeds to generate different names but got %q", first)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	cases := []struct {
+		ResourceType string
+		Prefix       string
+		Suffix       string
+	}{
+		{
+			// unsupported resource type
+			ResourceType: "azurerm_example",
+		},
+		{
+			// prefix and suffix leave no space for the random characters
+			ResourceType: "azurerm_storage_account",
+			Prefix:       "abcdefghijklm",
+			Suffix:       "nopqrstuv",
+		},
+		{
+			// must start with a letter
+			ResourceType: "azurerm_key_vault",
+			Prefix:       "1kv",
+		},
+	}
+
+	for _, tc := range cases {
+		if name, err := Generate(tc.ResourceType, tc.Prefix, tc.Suffix); err == nil {
+			t.Fatalf("Expected an error generating a name for %q with the prefix %q and suffix %q but got %q", tc.ResourceType, tc.Prefix, tc.Suffix, name)
+		}
+	}
+}
+
+func TestGenerateTruncatesRandomCharacters(t *testing.T) {
+	name, err := Generate("azurerm_storage_account", "abcdefghijklmnopqr", "")
+	if err != nil {
+		t.Fatalf("Error generating name: %+v", err)
+	}
+
+	if len(name) != 24 {
+		t.Fatalf("Expected the name %q to be truncated to 24 characters", name)
+	}
+}
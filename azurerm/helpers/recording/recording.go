@@ -0,0 +1,459 @@
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type Mode string
+
+const (
+	// Disabled sends requests to Azure without recording them
+	Disabled Mode = ""
+
+	// Record sends requests to Azure and saves a sanitized copy of each interaction
+	Record Mode = "record"
+
+	// Replay returns previously recorded interactions without sending any requests to Azure
+	Replay Mode = "replay"
+)
+
+const redactedValue = "REDACTED"
+
+const (
+	// SubscriptionIDPlaceholder replaces the value of `ARM_SUBSCRIPTION_ID` within recordings
+	SubscriptionIDPlaceholder = "00000000-0000-0000-0000-000000000000"
+
+	// TenantIDPlaceholder replaces the value of `ARM_TENANT_ID` within recordings
+	TenantIDPlaceholder = "00000000-0000-0000-0000-000000000001"
+
+	// ClientIDPlaceholder replaces the value of `ARM_CLIENT_ID` within recordings
+	ClientIDPlaceholder = "00000000-0000-0000-0000-000000000002"
+)
+
+// headersToRecord are the response headers needed to replay long-running operations
+var headersToRecord = []string{
+	"Azure-AsyncOperation",
+	"Content-Type",
+	"Location",
+	"Retry-After",
+}
+
+// sensitiveFieldPattern matches JSON string fields which contain credentials, so that these can be redacted
+var sensitiveFieldPattern = regexp.MustCompile(`(?i)("[a-z]*(password|secret|key|connectionstring|token|sas)"\s*:\s*)"[^"]*"`)
+
+// randomValuePattern matches the values generated by `acctest.RandInt` and the UUIDs generated within the tests,
+// these differ every time a test is run and so are mapped to the recorded values when replaying
+var randomValuePattern = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9]{10,}`)
+
+// urlTokenPattern splits a URL into runs of alphanumeric characters and the separators between them
+var urlTokenPattern = regexp.MustCompile(`[a-zA-Z0-9]+|[^a-zA-Z0-9]+`)
+
+// randomStringPattern matches the values generated by `acctest.RandString` - since these can't be told apart from
+// the rest of the URL they're only treated as random when a run of these differs between the recorded and current URL
+var randomStringPattern = regexp.MustCompile(`^[a-z0-9]*[a-z][a-z0-9]*$`)
+
+// minimumRandomStringLength is the shortest value generated by `acctest.RandString` which is mapped when replaying
+const minimumRandomStringLength = 4
+
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       string              `json:"body,omitempty"`
+}
+
+// Recorder records the HTTP interactions made by the Azure SDK clients, or replays previously
+// recorded interactions - which allows Acceptance Tests to be run without access to Azure.
+type Recorder struct {
+	mode         Mode
+	directory    string
+	replacements map[string]string
+
+	lock         sync.Mutex
+	path         string
+	interactions []Interaction
+	used         []bool
+	randomValues map[string]string
+}
+
+// NewFromEnvironment returns a Recorder configured using the `ARM_TEST_RECORDING_MODE` and
+// `ARM_TEST_RECORDING_DIR` Environment Variables, or nil if recording is disabled.
+//
+// The values of `ARM_SUBSCRIPTION_ID`, `ARM_TENANT_ID` and `ARM_CLIENT_ID` are replaced with
+// placeholders when recording - and the placeholders replaced with the current values when replaying.
+func NewFromEnvironment() (*Recorder, error) {
+	mode := Mode(strings.ToLower(os.Getenv("ARM_TEST_RECORDING_MODE")))
+	if mode == Disabled {
+		return nil, nil
+	}
+
+	if mode != Record && mode != Replay {
+		return nil, fmt.Errorf("`ARM_TEST_RECORDING_MODE` must be either %q or %q, got %q", Record, Replay, mode)
+	}
+
+	directory := os.Getenv("ARM_TEST_RECORDING_DIR")
+	if directory == "" {
+		directory = filepath.Join("testdata", "recordings")
+	}
+
+	replacements := map[string]string{
+		os.Getenv("ARM_SUBSCRIPTION_ID"): SubscriptionIDPlaceholder,
+		os.Getenv("ARM_TENANT_ID"):       TenantIDPlaceholder,
+		os.Getenv("ARM_CLIENT_ID"):       ClientIDPlaceholder,
+	}
+
+	return New(mode, directory, replacements), nil
+}
+
+// New returns a Recorder which saves/loads recordings within the specified directory. Each key in
+// the replacements map is replaced with its value when recording, and vice versa when replaying.
+func New(mode Mode, directory string, replacements map[string]string) *Recorder {
+	filtered := make(map[string]string)
+	for k, v := range replacements {
+		if k != "" && v != "" {
+			filtered[k] = v
+		}
+	}
+
+	return &Recorder{
+		mode:         mode,
+		directory:    directory,
+		replacements: filtered,
+	}
+}
+
+// Replaying returns whether the Recorder is returning previously recorded interactions
+func (r *Recorder) Replaying() bool {
+	return r != nil && r.mode == Replay
+}
+
+// HasRecording returns whether a recording exists for the specified name
+func (r *Recorder) HasRecording(name string) bool {
+	_, err := os.Stat(r.recordingPath(name))
+	return err == nil
+}
+
+// Start begins a new recording with the specified name, or loads the existing recording when replaying
+func (r *Recorder) Start(name string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.path = r.recordingPath(name)
+	r.interactions = make([]Interaction, 0)
+	r.used = make([]bool, 0)
+	r.randomValues = make(map[string]string)
+
+	if r.mode == Record {
+		return os.MkdirAll(r.directory, 0755)
+	}
+
+	contents, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		return fmt.Errorf("Error reading recording %q: %+v", r.path, err)
+	}
+
+	if err := json.Unmarshal(contents, &r.interactions); err != nil {
+		return fmt.Errorf("Error parsing recording %q: %+v", r.path, err)
+	}
+
+	r.used = make([]bool, len(r.interactions))
+	return nil
+}
+
+// SendDecorator returns an autorest.SendDecorator which records or replays each request
+func (r *Recorder) SendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			if r.mode == Replay {
+				return r.replay(req)
+			}
+
+			return r.record(s, req)
+		})
+	}
+}
+
+func (r *Recorder) record(s autorest.Sender, req *http.Request) (*http.Response, error) {
+	requestBody, err := readAndRestoreRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Do(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	headers := make(map[string][]string)
+	for _, name := range headersToRecord {
+		if values, ok := resp.Header[http.CanonicalHeaderKey(name)]; ok {
+			sanitized := make([]string, 0)
+			for _, v := range values {
+				sanitized = append(sanitized, r.sanitize(v))
+			}
+			headers[name] = sanitized
+		}
+	}
+
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    r.sanitize(req.URL.String()),
+			Body:   redact(r.sanitize(requestBody)),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    headers,
+			Body:       redact(r.sanitize(string(responseBody))),
+		},
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.path == "" {
+		return resp, nil
+	}
+
+	r.interactions = append(r.interactions, interaction)
+	if err := r.save(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	url := r.sanitize(req.URL.String())
+
+	// prefer an interaction which only differs by the random values already seen in this run, before falling back to
+	// one which also differs by new random strings - which avoids mapping values between unrelated resources
+	index := -1
+	var randomValues map[string]string
+	for _, allowNewRandomStrings := range []bool{false, true} {
+		for i, interaction := range r.interactions {
+			if r.used[i] || interaction.Request.Method != req.Method {
+				continue
+			}
+
+			if v, ok := r.matchRandomValues(interaction.Request.URL, url, allowNewRandomStrings); ok {
+				index = i
+				randomValues = v
+				break
+			}
+		}
+
+		if index != -1 {
+			break
+		}
+	}
+
+	if index == -1 {
+		return nil, replayError(fmt.Sprintf("No recorded interaction was found for %s %s in %q", req.Method, url, r.path))
+	}
+
+	interaction := r.interactions[index]
+	r.used[index] = true
+
+	// map the random values used when recording to the ones used in this run
+	for recorded, current := range randomValues {
+		r.randomValues[recorded] = current
+	}
+
+	resp := &http.Response{
+		Status:     fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode: interaction.Response.StatusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     make(http.Header),
+		Request:    req,
+	}
+
+	for name, values := range interaction.Response.Headers {
+		for _, v := range values {
+			resp.Header.Add(name, r.desanitize(v))
+		}
+	}
+
+	// there's no need to wait between polling attempts when replaying
+	resp.Header.Set("Retry-After", "0")
+
+	body := r.desanitize(interaction.Response.Body)
+	resp.Body = ioutil.NopCloser(strings.NewReader(body))
+	resp.ContentLength = int64(len(body))
+
+	return resp, nil
+}
+
+// matchRandomValues returns the random values used in the recorded URL mapped to those used in the current URL, and
+// whether the URLs match once these are taken into account. Random strings not already seen in this run are only
+// matched when allowNewRandomStrings is true.
+func (r *Recorder) matchRandomValues(recorded, current string, allowNewRandomStrings bool) (map[string]string, bool) {
+	values := make(map[string]string)
+
+	recordedValues := randomValuePattern.FindAllString(recorded, -1)
+	currentValues := randomValuePattern.FindAllString(current, -1)
+	if len(recordedValues) != len(currentValues) {
+		return nil, false
+	}
+	for i := range recordedValues {
+		values[recordedValues[i]] = currentValues[i]
+	}
+
+	recordedTokens := urlTokenPattern.FindAllString(randomValuePattern.ReplaceAllString(recorded, "{random}"), -1)
+	currentTokens := urlTokenPattern.FindAllString(randomValuePattern.ReplaceAllString(current, "{random}"), -1)
+	if len(recordedTokens) != len(currentTokens) {
+		return nil, false
+	}
+
+	for i := range recordedTokens {
+		recordedToken := recordedTokens[i]
+		currentToken := currentTokens[i]
+		if recordedToken == currentToken {
+			continue
+		}
+
+		if len(recordedToken) != len(currentToken) || len(recordedToken) < minimumRandomStringLength {
+			return nil, false
+		}
+
+		if !randomStringPattern.MatchString(recordedToken) || !randomStringPattern.MatchString(currentToken) {
+			return nil, false
+		}
+
+		if existing, ok := r.randomValues[recordedToken]; ok {
+			if existing != currentToken {
+				return nil, false
+			}
+		} else if !allowNewRandomStrings {
+			return nil, false
+		}
+
+		if existing, ok := values[recordedToken]; ok && existing != currentToken {
+			return nil, false
+		}
+		values[recordedToken] = currentToken
+	}
+
+	return values, true
+}
+
+func (r *Recorder) recordingPath(name string) string {
+	fileName := strings.Replace(name, "/", "_", -1) + ".json"
+	return filepath.Join(r.directory, fileName)
+}
+
+func (r *Recorder) save() error {
+	contents, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Error serializing recording %q: %+v", r.path, err)
+	}
+
+	if err := ioutil.WriteFile(r.path, contents, 0644); err != nil {
+		return fmt.Errorf("Error writing recording %q: %+v", r.path, err)
+	}
+
+	return nil
+}
+
+func (r *Recorder) sanitize(input string) string {
+	for value, placeholder := range r.replacements {
+		input = strings.Replace(input, value, placeholder, -1)
+	}
+
+	return input
+}
+
+func (r *Recorder) desanitize(input string) string {
+	for value, placeholder := range r.replacements {
+		input = strings.Replace(input, placeholder, value, -1)
+	}
+
+	// replace the longest values first, since a random string can be a part of a longer one
+	recordedValues := make([]string, 0, len(r.randomValues))
+	for recorded := range r.randomValues {
+		recordedValues = append(recordedValues, recorded)
+	}
+	sort.Slice(recordedValues, func(i, j int) bool {
+		if len(recordedValues[i]) != len(recordedValues[j]) {
+			return len(recordedValues[i]) > len(recordedValues[j])
+		}
+		return recordedValues[i] < recordedValues[j]
+	})
+
+	replacements := make([]string, 0, len(recordedValues)*2)
+	for _, recorded := range recordedValues {
+		replacements = append(replacements, recorded, r.randomValues[recorded])
+	}
+	input = strings.NewReplacer(replacements...).Replace(input)
+
+	return input
+}
+
+// replayError is returned when there's no recorded interaction for a request, which implements net.Error so that
+// autorest doesn't retry the request - since it treats any other error as a temporary network error
+type replayError string
+
+func (e replayError) Error() string {
+	return string(e)
+}
+
+func (e replayError) Timeout() bool {
+	return false
+}
+
+func (e replayError) Temporary() bool {
+	return false
+}
+
+func redact(input string) string {
+	return sensitiveFieldPattern.ReplaceAllString(input, fmt.Sprintf(`${1}"%s"`, redactedValue))
+}
+
+func readAndRestoreRequestBody(req *http.Request) (string, error) {
+	if req.Body == nil {
+		return "", nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return "", err
+	}
+
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return string(body), nil
+}
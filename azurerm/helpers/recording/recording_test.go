@@ -0,0 +1,306 @@
+package recording
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Location", "https://example.com/subscriptions/real-subscription/operations/1")
+		w.Header().Set("X-Ignored", "value")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"` + r.URL.Path + `","properties":{"sharedKey":"super-secret"}}`))
+	}))
+	defer server.Close()
+
+	directory, err := ioutil.TempDir("", "recording")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+
+	recorder := New(Record, directory, map[string]string{
+		"real-subscription": "00000000-0000-0000-0000-000000000000",
+	})
+	if err := recorder.Start("TestAccExample/basic"); err != nil {
+		t.Fatalf("Error starting recording: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(http.DefaultClient, recorder.SendDecorator())
+	resp := sendRequest(t, sender, server.URL+"/subscriptions/real-subscription/resourceGroups/acctestRG-1234567890123")
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected the recorded response to have a status code of 201 but got %d", resp.StatusCode)
+	}
+
+	if !recorder.HasRecording("TestAccExample/basic") {
+		t.Fatalf("Expected a recording to have been saved")
+	}
+
+	contents, err := ioutil.ReadFile(recorder.recordingPath("TestAccExample/basic"))
+	if err != nil {
+		t.Fatalf("Error reading recording: %+v", err)
+	}
+
+	for _, unexpected := range []string{"real-subscription", "super-secret", "X-Ignored"} {
+		if strings.Contains(string(contents), unexpected) {
+			t.Fatalf("Expected the recording not to contain %q:\n%s", unexpected, contents)
+		}
+	}
+
+	replayer := New(Replay, directory, map[string]string{
+		"fake-subscription": "00000000-0000-0000-0000-000000000000",
+	})
+	if err := replayer.Start("TestAccExample/basic"); err != nil {
+		t.Fatalf("Error loading recording: %+v", err)
+	}
+
+	// the server isn't used when replaying, so close it to ensure no requests are sent
+	server.Close()
+
+	sender = autorest.DecorateSender(http.DefaultClient, replayer.SendDecorator())
+	resp = sendRequest(t, sender, server.URL+"/subscriptions/fake-subscription/resourceGroups/acctestRG-9876543210987")
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected the replayed response to have a status code of 201 but got %d", resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Error reading replayed response: %+v", err)
+	}
+
+	expected := `{"id":"/subscriptions/fake-subscription/resourceGroups/acctestRG-9876543210987","properties":{"sharedKey":"REDACTED"}}`
+	if string(body) != expected {
+		t.Fatalf("Expected the replayed body to be %q but got %q", expected, string(body))
+	}
+
+	if location := resp.Header.Get("Location"); location != "https://example.com/subscriptions/fake-subscription/operations/1" {
+		t.Fatalf("Expected the replayed Location header to use the current subscription but got %q", location)
+	}
+
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "0" {
+		t.Fatalf("Expected the replayed Retry-After header to be 0 but got %q", retryAfter)
+	}
+
+	// each interaction is only replayed once
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/subscriptions/fake-subscription/resourceGroups/acctestRG-9876543210987", nil)
+	if _, err := sender.Do(req); err == nil {
+		t.Fatalf("Expected an error when no recorded interactions remain")
+	}
+}
+
+func TestMatchRandomValues(t *testing.T) {
+	cases := []struct {
+		Name                  string
+		Recorded              string
+		Current               string
+		Known                 map[string]string
+		AllowNewRandomStrings bool
+		ExpectedMatch         bool
+		ExpectedValues        map[string]string
+	}{
+		{
+			Name:           "Identical",
+			Recorded:       "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example?api-version=2018-05-01",
+			Current:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example?api-version=2018-05-01",
+			ExpectedMatch:  true,
+			ExpectedValues: map[string]string{"00000000-0000-0000-0000-000000000000": "00000000-0000-0000-0000-000000000000"},
+		},
+		{
+			Name:           "Random Integer",
+			Recorded:       "https://management.azure.com/resourcegroups/acctestRG-1234567890123?api-version=2018-05-01",
+			Current:        "https://management.azure.com/resourcegroups/acctestRG-987654321098765?api-version=2018-05-01",
+			ExpectedMatch:  true,
+			ExpectedValues: map[string]string{"1234567890123": "987654321098765"},
+		},
+		{
+			Name:           "UUID",
+			Recorded:       "https://management.azure.com/roleAssignments/6e1c6a7b-3e5b-4a0f-9b3c-1e2d3f4a5b6c?api-version=2015-07-01",
+			Current:        "https://management.azure.com/roleAssignments/0f9e8d7c-6b5a-4c3d-8e2f-1a0b9c8d7e6f?api-version=2015-07-01",
+			ExpectedMatch:  true,
+			ExpectedValues: map[string]string{"6e1c6a7b-3e5b-4a0f-9b3c-1e2d3f4a5b6c": "0f9e8d7c-6b5a-4c3d-8e2f-1a0b9c8d7e6f"},
+		},
+		{
+			Name:                  "Random String",
+			Recorded:              "https://management.azure.com/resourcegroups/acctestRG-1234567890123/providers/Microsoft.Storage/storageAccounts/acctestsaab12cd?api-version=2018-02-01",
+			Current:               "https://management.azure.com/resourcegroups/acctestRG-1234567890124/providers/Microsoft.Storage/storageAccounts/acctestsaxy98zw?api-version=2018-02-01",
+			AllowNewRandomStrings: true,
+			ExpectedMatch:         true,
+			ExpectedValues: map[string]string{
+				"1234567890123":   "1234567890124",
+				"acctestsaab12cd": "acctestsaxy98zw",
+			},
+		},
+		{
+			Name:          "New Random String when only Known Values are Allowed",
+			Recorded:      "https://management.azure.com/storageAccounts/acctestsaab12cd?api-version=2018-02-01",
+			Current:       "https://management.azure.com/storageAccounts/acctestsaxy98zw?api-version=2018-02-01",
+			ExpectedMatch: false,
+		},
+		{
+			Name:          "Known Random String",
+			Recorded:      "https://management.azure.com/storageAccounts/acctestsaab12cd?api-version=2018-02-01",
+			Current:       "https://management.azure.com/storageAccounts/acctestsaxy98zw?api-version=2018-02-01",
+			Known:         map[string]string{"acctestsaab12cd": "acctestsaxy98zw"},
+			ExpectedMatch: true,
+			ExpectedValues: map[string]string{
+				"acctestsaab12cd": "acctestsaxy98zw",
+			},
+		},
+		{
+			Name:                  "Random String mapped to a different value",
+			Recorded:              "https://management.azure.com/storageAccounts/acctestsaab12cd?api-version=2018-02-01",
+			Current:               "https://management.azure.com/storageAccounts/acctestsaxy98zw?api-version=2018-02-01",
+			Known:                 map[string]string{"acctestsaab12cd": "acctestsaqq11qq"},
+			AllowNewRandomStrings: true,
+			ExpectedMatch:         false,
+		},
+		{
+			Name:                  "Different Resource Provider",
+			Recorded:              "https://management.azure.com/providers/Microsoft.Storage/storageAccounts/example?api-version=2018-02-01",
+			Current:               "https://management.azure.com/providers/Microsoft.Compute/virtualMachines/example?api-version=2018-02-01",
+			AllowNewRandomStrings: true,
+			ExpectedMatch:         false,
+		},
+		{
+			Name:                  "Different API Version",
+			Recorded:              "https://management.azure.com/resourcegroups/example?api-version=2017-05-10",
+			Current:               "https://management.azure.com/resourcegroups/example?api-version=2018-05-01",
+			AllowNewRandomStrings: true,
+			ExpectedMatch:         false,
+		},
+	}
+
+	for _, tc := range cases {
+		recorder := New(Replay, "", nil)
+		recorder.randomValues = make(map[string]string)
+		for k, v := range tc.Known {
+			recorder.randomValues[k] = v
+		}
+
+		values, ok := recorder.matchRandomValues(tc.Recorded, tc.Current, tc.AllowNewRandomStrings)
+		if ok != tc.ExpectedMatch {
+			t.Fatalf("Expected %q to match %t but got %t", tc.Name, tc.ExpectedMatch, ok)
+		}
+
+		if ok && !reflect.DeepEqual(values, tc.ExpectedValues) {
+			t.Fatalf("Expected the values for %q to be %+v but got %+v", tc.Name, tc.ExpectedValues, values)
+		}
+	}
+}
+
+func TestReplayRandomStrings(t *testing.T) {
+	directory, err := ioutil.TempDir("", "recording")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+
+	recording := `[
+  {
+    "request": {"method": "PUT", "url": "https://example.com/storageAccounts/acctestsaab12cd"},
+    "response": {"status_code": 200, "body": "{\"name\":\"acctestsaab12cd\",\"endpoint\":\"https://acctestsaab12cd.blob.core.windows.net/\"}"}
+  },
+  {
+    "request": {"method": "PUT", "url": "https://example.com/storageAccounts/acctestsaab12cd/containers/acctestscab12cd"},
+    "response": {"status_code": 200, "body": "{\"name\":\"acctestscab12cd\"}"}
+  }
+]`
+	if err := ioutil.WriteFile(filepath.Join(directory, "TestAccExample.json"), []byte(recording), 0644); err != nil {
+		t.Fatalf("Error writing recording: %+v", err)
+	}
+
+	replayer := New(Replay, directory, nil)
+	if err := replayer.Start("TestAccExample"); err != nil {
+		t.Fatalf("Error loading recording: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(http.DefaultClient, replayer.SendDecorator())
+	cases := []struct {
+		URL      string
+		Expected string
+	}{
+		{
+			URL:      "https://example.com/storageAccounts/acctestsaxy98zw",
+			Expected: `{"name":"acctestsaxy98zw","endpoint":"https://acctestsaxy98zw.blob.core.windows.net/"}`,
+		},
+		{
+			URL:      "https://example.com/storageAccounts/acctestsaxy98zw/containers/acctestscxy98zw",
+			Expected: `{"name":"acctestscxy98zw"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		req, err := http.NewRequest(http.MethodPut, tc.URL, nil)
+		if err != nil {
+			t.Fatalf("Error building request: %+v", err)
+		}
+
+		resp, err := sender.Do(req)
+		if err != nil {
+			t.Fatalf("Error replaying %q: %+v", tc.URL, err)
+		}
+
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("Error reading replayed response: %+v", err)
+		}
+
+		if string(body) != tc.Expected {
+			t.Fatalf("Expected the replayed body for %q to be %q but got %q", tc.URL, tc.Expected, string(body))
+		}
+	}
+}
+
+func TestRedact(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    `{"name":"example"}`,
+			Expected: `{"name":"example"}`,
+		},
+		{
+			Input:    `{"administratorLoginPassword":"P@ssw0rd"}`,
+			Expected: `{"administratorLoginPassword":"REDACTED"}`,
+		},
+		{
+			Input:    `{"primaryKey": "abc", "secondaryConnectionString": "def"}`,
+			Expected: `{"primaryKey": "REDACTED", "secondaryConnectionString": "REDACTED"}`,
+		},
+		{
+			Input:    `{"keyVaultId":"/subscriptions/0000"}`,
+			Expected: `{"keyVaultId":"/subscriptions/0000"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := redact(tc.Input); actual != tc.Expected {
+			t.Fatalf("Expected %q to be redacted to %q but got %q", tc.Input, tc.Expected, actual)
+		}
+	}
+}
+
+func sendRequest(t *testing.T, sender autorest.Sender, url string) *http.Response {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+
+	return resp
+}
@@ -0,0 +1,256 @@
+// Package resourcemanager contains a client for Azure Resource Manager resources whose Resource Provider isn't
+// available in the version of the Azure SDK used by this Provider. Requests are sent to the ID of the resource using
+// the API Version supported by that Resource Provider - through the same autorest pipeline as the Azure SDK clients,
+// such that authentication, retries, Resource Provider registration and long-running operations behave the same.
+package resourcemanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// DefaultBaseURI is the default URI used for Azure Resource Manager
+const DefaultBaseURI = "https://management.azure.com"
+
+// Client sends requests for resources within a single Resource Provider, using the specified API Version.
+type Client struct {
+	autorest.Client
+	BaseURI    string
+	APIVersion string
+}
+
+// NewWithBaseURI creates an instance of the Client client.
+func NewWithBaseURI(baseURI string, apiVersion string) Client {
+	return Client{
+		Client:     autorest.NewClientWithUserAgent(""),
+		BaseURI:    baseURI,
+		APIVersion: apiVersion,
+	}
+}
+
+// Future is the result of a create, update or delete - which may be a long-running operation.
+type Future struct {
+	azure.Future
+}
+
+// Get retrieves the resource with the specified ID, unmarshalling it into result.
+// Parameters:
+// resourceID - the fully qualified ID of the resource, including the resource name and resource type.
+// result - a pointer to the value the resource is unmarshalled into.
+func (client Client) Get(ctx context.Context, resourceID string, result interface{}) (autorest.Response, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{resourceId}", resourcePathParameters(resourceID)),
+		autorest.WithQueryParameters(client.queryParameters()))
+
+	resp, err := client.send(ctx, preparer, "Get")
+	if err != nil {
+		return autorest.Response{Response: resp}, err
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "resourcemanager.Client", "Get", resp, "Failure responding to request")
+	}
+
+	return autorest.Response{Response: resp}, err
+}
+
+// CreateOrUpdate creates or replaces the resource with the specified ID.
+// Parameters:
+// resourceID - the fully qualified ID of the resource, including the resource name and resource type.
+// parameters - the resource to create or replace, which is serialized as JSON.
+func (client Client) CreateOrUpdate(ctx context.Context, resourceID string, parameters interface{}) (Future, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{resourceId}", resourcePathParameters(resourceID)),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(client.queryParameters()))
+
+	return client.sendForFuture(ctx, preparer, "CreateOrUpdate", http.StatusOK, http.StatusCreated, http.StatusAccepted)
+}
+
+// Update updates the specified fields of the resource with the specified ID.
+// Parameters:
+// resourceID - the fully qualified ID of the resource, including the resource name and resource type.
+// parameters - the fields of the resource to update, which are serialized as JSON.
+func (client Client) Update(ctx context.Context, resourceID string, parameters interface{}) (Future, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{resourceId}", resourcePathParameters(resourceID)),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(client.queryParameters()))
+
+	return client.sendForFuture(ctx, preparer, "Update", http.StatusOK, http.StatusAccepted)
+}
+
+// Delete deletes the resource with the specified ID.
+// Parameters:
+// resourceID - the fully qualified ID of the resource, including the resource name and resource type.
+func (client Client) Delete(ctx context.Context, resourceID string) (Future, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{resourceId}", resourcePathParameters(resourceID)),
+		autorest.WithQueryParameters(client.queryParameters()))
+
+	return client.sendForFuture(ctx, preparer, "Delete", http.StatusOK, http.StatusAccepted, http.StatusNoContent)
+}
+
+// Post invokes an action on the resource with the specified ID (for example listing the access keys), unmarshalling
+// the response into result. Actions which are accepted for asynchronous processing return a 202 and are not polled.
+// Parameters:
+// resourceID - the fully qualified ID of the resource, including the resource name and resource type.
+// action - the name of the action, such as `listKeys`.
+// parameters - the body of the request, which is serialized as JSON - or nil if the action has no body.
+// result - a pointer to the value the response is unmarshalled into - or nil if the action has no response body.
+func (client Client) Post(ctx context.Context, resourceID string, action string, parameters interface{}, result interface{}) (autorest.Response, error) {
+	decorators := []autorest.PrepareDecorator{
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{resourceId}/{action}", map[string]interface{}{
+			"resourceId": strings.TrimPrefix(resourceID, "/"),
+			"action":     autorest.Encode("path", action),
+		}),
+		autorest.WithQueryParameters(client.queryParameters()),
+	}
+	if parameters != nil {
+		decorators = append(decorators,
+			autorest.AsContentType("application/json; charset=utf-8"),
+			autorest.WithJSON(parameters))
+	}
+
+	resp, err := client.send(ctx, autorest.CreatePreparer(decorators...), "Post")
+	if err != nil {
+		return autorest.Response{Response: resp}, err
+	}
+
+	responders := []autorest.RespondDecorator{
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
+	}
+	if result != nil {
+		responders = append(responders, autorest.ByUnmarshallingJSON(result))
+	}
+	responders = append(responders, autorest.ByClosing())
+
+	err = autorest.Respond(resp, responders...)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "resourcemanager.Client", "Post", resp, "Failure responding to request")
+	}
+
+	return autorest.Response{Response: resp}, err
+}
+
+// List retrieves each resource within the collection with the specified ID, following the `nextLink` of each page.
+// Parameters:
+// collectionID - the fully qualified ID of the collection, such as the ID of the parent resource and the resource type.
+func (client Client) List(ctx context.Context, collectionID string) ([]json.RawMessage, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{resourceId}", resourcePathParameters(collectionID)),
+		autorest.WithQueryParameters(client.queryParameters()))
+
+	values := make([]json.RawMessage, 0)
+	for preparer != nil {
+		resp, err := client.send(ctx, preparer, "List")
+		if err != nil {
+			return nil, err
+		}
+
+		var page listResult
+		err = autorest.Respond(
+			resp,
+			client.ByInspecting(),
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&page),
+			autorest.ByClosing())
+		if err != nil {
+			return nil, autorest.NewErrorWithError(err, "resourcemanager.Client", "List", resp, "Failure responding to request")
+		}
+
+		values = append(values, page.Value...)
+
+		preparer = nil
+		if page.NextLink != nil && *page.NextLink != "" {
+			preparer = autorest.CreatePreparer(
+				autorest.AsGet(),
+				autorest.WithBaseURL(*page.NextLink))
+		}
+	}
+
+	return values, nil
+}
+
+func (client Client) sendForFuture(ctx context.Context, preparer autorest.Preparer, method string, statusCodes ...int) (Future, error) {
+	var future Future
+
+	resp, err := client.send(ctx, preparer, method)
+	if err != nil {
+		return future, err
+	}
+
+	future.Future, err = azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "resourcemanager.Client", method, resp, "Failure responding to request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(statusCodes...),
+		autorest.ByClosing())
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "resourcemanager.Client", method, resp, "Failure responding to request")
+	}
+
+	return future, nil
+}
+
+func (client Client) send(ctx context.Context, preparer autorest.Preparer, method string) (*http.Response, error) {
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "resourcemanager.Client", method, nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, "resourcemanager.Client", method, resp, "Failure sending request")
+	}
+
+	return resp, nil
+}
+
+func (client Client) queryParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"api-version": client.APIVersion,
+	}
+}
+
+func resourcePathParameters(resourceID string) map[string]interface{} {
+	// the Resource ID isn't encoded (as in the Azure SDK) since it's made up of multiple path segments
+	return map[string]interface{}{
+		"resourceId": strings.TrimPrefix(resourceID, "/"),
+	}
+}
+
+type listResult struct {
+	Value    []json.RawMessage `json:"value"`
+	NextLink *string           `json:"nextLink,omitempty"`
+}
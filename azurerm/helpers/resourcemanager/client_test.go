@@ -0,0 +1,312 @@
+package resourcemanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testResourceID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Example/widgets/example"
+
+type testWidget struct {
+	ID         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *testWidgetProperties `json:"properties,omitempty"`
+}
+
+type testWidgetProperties struct {
+	Size *int32 `json:"size,omitempty"`
+}
+
+type testServer struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	requests []string
+}
+
+func newTestServer(t *testing.T, handler func(s *testServer, w http.ResponseWriter, r *http.Request)) *testServer {
+	s := &testServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, r.URL.RequestURI()))
+		s.lock.Unlock()
+
+		handler(s, w, r)
+	}))
+
+	return s
+}
+
+func (s *testServer) Requests() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+func newTestClient(baseURI string) Client {
+	client := NewWithBaseURI(baseURI, "2019-01-01")
+	client.PollingDelay = time.Millisecond
+	client.RetryDuration = time.Millisecond
+	return client
+}
+
+func TestClientGet(t *testing.T) {
+	server := newTestServer(t, func(s *testServer, w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != testResourceID {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":"ResourceNotFound","message":"not found"}}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"` + testResourceID + `","name":"example","properties":{"size":3}}`))
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	var widget testWidget
+	resp, err := client.Get(context.TODO(), testResourceID, &widget)
+	if err != nil {
+		t.Fatalf("Error retrieving the widget: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a status code of 200 but got %d", resp.StatusCode)
+	}
+	if widget.Name == nil || *widget.Name != "example" || widget.Properties == nil || *widget.Properties.Size != 3 {
+		t.Fatalf("Expected the widget to be unmarshalled but got %+v", widget)
+	}
+
+	expected := fmt.Sprintf("GET %s?api-version=2019-01-01", testResourceID)
+	if requests := server.Requests(); len(requests) != 1 || requests[0] != expected {
+		t.Fatalf("Expected a single request %q but got %+v", expected, requests)
+	}
+
+	resp, err = client.Get(context.TODO(), testResourceID+"-missing", &widget)
+	if err == nil {
+		t.Fatalf("Expected an error retrieving a missing widget")
+	}
+	if resp.Response == nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected the response for a missing widget to have a status code of 404 but got %+v", resp.Response)
+	}
+}
+
+func TestClientCreateOrUpdate_longRunning(t *testing.T) {
+	polls := 0
+	server := newTestServer(t, func(s *testServer, w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			var widget testWidget
+			if err := json.Unmarshal(body, &widget); err != nil || widget.Properties == nil || *widget.Properties.Size != 5 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			w.Header().Set("Azure-AsyncOperation", s.URL+"/operations/1")
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"` + testResourceID + `","properties":{"provisioningState":"Creating"}}`))
+
+		case r.URL.Path == "/operations/1":
+			polls++
+			if polls < 2 {
+				w.Write([]byte(`{"status":"InProgress"}`))
+				return
+			}
+			w.Write([]byte(`{"status":"Succeeded"}`))
+
+		default:
+			w.Write([]byte(`{"id":"` + testResourceID + `","properties":{"size":5,"provisioningState":"Succeeded"}}`))
+		}
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	size := int32(5)
+	widget := testWidget{
+		Properties: &testWidgetProperties{
+			Size: &size,
+		},
+	}
+	future, err := client.CreateOrUpdate(context.TODO(), testResourceID, widget)
+	if err != nil {
+		t.Fatalf("Error creating the widget: %+v", err)
+	}
+
+	if err := future.WaitForCompletionRef(context.TODO(), client.Client); err != nil {
+		t.Fatalf("Error waiting for the widget to be created: %+v", err)
+	}
+
+	if polls != 2 {
+		t.Fatalf("Expected the operation to be polled twice but got %d", polls)
+	}
+}
+
+func TestClientDelete(t *testing.T) {
+	cases := []struct {
+		Name          string
+		StatusCode    int
+		ExpectedError bool
+	}{
+		{
+			Name:       "Accepted",
+			StatusCode: http.StatusAccepted,
+		},
+		{
+			Name:       "No Content",
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:          "Not Found",
+			StatusCode:    http.StatusNotFound,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			server := newTestServer(t, func(s *testServer, w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					if tc.StatusCode == http.StatusAccepted {
+						w.Header().Set("Location", s.URL+"/operationResults/1")
+					}
+					w.WriteHeader(tc.StatusCode)
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+			})
+			defer server.Close()
+
+			client := newTestClient(server.URL)
+
+			future, err := client.Delete(context.TODO(), testResourceID)
+			if tc.ExpectedError {
+				if err == nil {
+					t.Fatalf("Expected an error but didn't get one")
+				}
+
+				if resp := future.Response(); resp == nil || resp.StatusCode != tc.StatusCode {
+					t.Fatalf("Expected the Future to contain the response with a status code of %d but got %+v", tc.StatusCode, resp)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error deleting the widget: %+v", err)
+			}
+
+			if err := future.WaitForCompletionRef(context.TODO(), client.Client); err != nil {
+				t.Fatalf("Error waiting for the widget to be deleted: %+v", err)
+			}
+		})
+	}
+}
+
+func TestClientPost(t *testing.T) {
+	server := newTestServer(t, func(s *testServer, w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != testResourceID+"/listKeys" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"primaryKey":"abc123"}`))
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	var keys struct {
+		PrimaryKey *string `json:"primaryKey"`
+	}
+	if _, err := client.Post(context.TODO(), testResourceID, "listKeys", nil, &keys); err != nil {
+		t.Fatalf("Error listing the keys: %+v", err)
+	}
+
+	if keys.PrimaryKey == nil || *keys.PrimaryKey != "abc123" {
+		t.Fatalf("Expected the Primary Key to be `abc123` but got %+v", keys.PrimaryKey)
+	}
+}
+
+func TestClientPost_accepted(t *testing.T) {
+	server := newTestServer(t, func(s *testServer, w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != testResourceID+"/enableCustomHttps" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"certificateSource":"AzureKeyVault"`) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	parameters := map[string]string{
+		"certificateSource": "AzureKeyVault",
+	}
+	resp, err := client.Post(context.TODO(), testResourceID, "enableCustomHttps", parameters, nil)
+	if err != nil {
+		t.Fatalf("Error invoking the action: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("Expected a 202 but got %d", resp.StatusCode)
+	}
+}
+
+func TestClientList(t *testing.T) {
+	server := newTestServer(t, func(s *testServer, w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Query().Get("$skiptoken") == "" {
+			w.Write([]byte(`{"value":[{"name":"first"},{"name":"second"}],"nextLink":"` + s.URL + r.URL.Path + `?api-version=2019-01-01&$skiptoken=2"}`))
+			return
+		}
+
+		w.Write([]byte(`{"value":[{"name":"third"}]}`))
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	values, err := client.List(context.TODO(), "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Example/widgets")
+	if err != nil {
+		t.Fatalf("Error listing the widgets: %+v", err)
+	}
+
+	names := make([]string, 0)
+	for _, v := range values {
+		var widget testWidget
+		if err := json.Unmarshal(v, &widget); err != nil {
+			t.Fatalf("Error unmarshalling the widget: %+v", err)
+		}
+		names = append(names, *widget.Name)
+	}
+
+	if fmt.Sprintf("%v", names) != "[first second third]" {
+		t.Fatalf("Expected the widgets from both pages but got %+v", names)
+	}
+
+	if requests := server.Requests(); len(requests) != 2 {
+		t.Fatalf("Expected 2 requests but got %+v", requests)
+	}
+}
@@ -0,0 +1,80 @@
+package response
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	correlationRequestIdHeader = "x-ms-correlation-request-id"
+	requestIdHeader            = "x-ms-request-id"
+)
+
+// OperationError is returned when a long-running operation fails - and includes the identifiers of the request
+// (which are also shown in the Activity Log) needed to raise a Support Ticket with Azure.
+type OperationError struct {
+	// Err is the error returned from the Azure SDK, which includes the error payload returned from the API
+	Err error
+
+	// Status is the status of the operation at the time it failed (e.g. `Failed` or `Canceled`)
+	Status string
+
+	// StatusCode is the HTTP Status Code of the last response received for the operation
+	StatusCode int
+
+	// CorrelationRequestId is the ID which ties together each of the requests made for this operation
+	CorrelationRequestId string
+
+	// RequestId is the ID of the last request made for this operation
+	RequestId string
+}
+
+func (e OperationError) Error() string {
+	details := make([]string, 0)
+	if e.Status != "" {
+		details = append(details, fmt.Sprintf("Status %q", e.Status))
+	}
+	if e.StatusCode != 0 {
+		details = append(details, fmt.Sprintf("HTTP Status Code %d", e.StatusCode))
+	}
+	if e.CorrelationRequestId != "" {
+		details = append(details, fmt.Sprintf("Correlation Request ID %q", e.CorrelationRequestId))
+	}
+	if e.RequestId != "" {
+		details = append(details, fmt.Sprintf("Request ID %q", e.RequestId))
+	}
+
+	return fmt.Sprintf("%+v\n\n%s", e.Err, strings.Join(details, ", "))
+}
+
+// Unwrap returns the error returned from the Azure SDK
+func (e OperationError) Unwrap() error {
+	return e.Err
+}
+
+// NewOperationError wraps the error returned from a long-running operation with the identifiers from the last
+// response received for it. The error is returned as-is if there's no response to take these from.
+func NewOperationError(err error, status string, resp *http.Response) error {
+	if err == nil {
+		return nil
+	}
+
+	if resp == nil {
+		return err
+	}
+
+	correlationRequestId := resp.Header.Get(correlationRequestIdHeader)
+	requestId := resp.Header.Get(requestIdHeader)
+	if correlationRequestId == "" && requestId == "" {
+		return err
+	}
+
+	return OperationError{
+		Err:                  err,
+		Status:               status,
+		StatusCode:           resp.StatusCode,
+		CorrelationRequestId: correlationRequestId,
+		RequestId:            requestId,
+	}
+}
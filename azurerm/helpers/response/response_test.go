@@ -1,8 +1,12 @@
 package response
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azureerr"
 )
 
 func TestConflict_DroppedConnection(t *testing.T) {
@@ -63,3 +67,125 @@ func TestNotFound_StatusCodes(t *testing.T) {
 		}
 	}
 }
+
+func TestNewOperationError(t *testing.T) {
+	sdkError := fmt.Errorf("Code=\"Conflict\" Message=\"Another operation is in progress\"")
+
+	testCases := []struct {
+		name     string
+		err      error
+		resp     *http.Response
+		expected string
+	}{
+		{
+			name:     "no error",
+			err:      nil,
+			resp:     &http.Response{},
+			expected: "",
+		},
+		{
+			name:     "no response",
+			err:      sdkError,
+			resp:     nil,
+			expected: sdkError.Error(),
+		},
+		{
+			name: "no identifiers",
+			err:  sdkError,
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+			},
+			expected: sdkError.Error(),
+		},
+		{
+			name: "identifiers",
+			err:  sdkError,
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header: http.Header{
+					"X-Ms-Correlation-Request-Id": []string{"00000000-0000-0000-0000-000000000000"},
+					"X-Ms-Request-Id":             []string{"11111111-1111-1111-1111-111111111111"},
+				},
+			},
+			expected: sdkError.Error() + "\n\nStatus \"Failed\", HTTP Status Code 200, Correlation Request ID \"00000000-0000-0000-0000-000000000000\", Request ID \"11111111-1111-1111-1111-111111111111\"",
+		},
+	}
+
+	for _, test := range testCases {
+		err := NewOperationError(test.err, "Failed", test.resp)
+		actual := ""
+		if err != nil {
+			actual = err.Error()
+		}
+
+		if actual != test.expected {
+			t.Fatalf("Expected %q for %q - got %q", test.expected, test.name, actual)
+		}
+	}
+
+	if _, ok := NewOperationError(sdkError, "Failed", testCases[3].resp).(OperationError); !ok {
+		t.Fatalf("Expected an OperationError to be returned when the response contains identifiers")
+	}
+}
+
+func TestRetryError(t *testing.T) {
+	testCases := []struct {
+		name      string
+		err       error
+		retryWhen []func(error) bool
+		retryable bool
+	}{
+		{
+			name:      "throttled",
+			err:       &azure.ServiceError{Code: "TooManyRequests"},
+			retryable: true,
+		},
+		{
+			name: "failed long-running operation which can be retried",
+			err: OperationError{
+				Err:        &azure.ServiceError{Code: "AnotherOperationInProgress"},
+				Status:     "Failed",
+				StatusCode: http.StatusOK,
+			},
+			retryable: true,
+		},
+		{
+			name:      "forbidden",
+			err:       &azure.ServiceError{Code: "AuthorizationFailed"},
+			retryable: false,
+		},
+		{
+			name:      "principal not found",
+			err:       &azure.ServiceError{Code: "PrincipalNotFound"},
+			retryable: false,
+		},
+		{
+			name:      "principal not found whilst waiting for replication",
+			err:       &azure.ServiceError{Code: "PrincipalNotFound"},
+			retryWhen: []func(error) bool{azureerr.IsPrincipalNotFound},
+			retryable: true,
+		},
+		{
+			name:      "scope not found whilst waiting for replication",
+			err:       &azure.ServiceError{Code: "ResourceGroupNotFound"},
+			retryWhen: []func(error) bool{azureerr.IsPrincipalNotFound},
+			retryable: false,
+		},
+	}
+
+	for _, test := range testCases {
+		actual := RetryError(test.err, test.retryWhen...)
+		if actual == nil {
+			t.Fatalf("Expected a RetryError for %q but got nil", test.name)
+		}
+
+		if actual.Retryable != test.retryable {
+			t.Fatalf("Expected %q to be retryable %t but got %t", test.name, test.retryable, actual.Retryable)
+		}
+	}
+
+	if RetryError(nil) != nil {
+		t.Fatalf("Expected no RetryError when there's no error")
+	}
+}
@@ -0,0 +1,28 @@
+package response
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azureerr"
+)
+
+// RetryError converts an error returned from Azure into a RetryError for use within `resource.Retry` - the error is
+// retryable when Azure indicates the request can be retried as-is (e.g. it was throttled, or another operation was
+// in progress) or when any of `retryWhen` return true for it (e.g. whilst waiting for a dependency to replicate),
+// otherwise it's terminal. nil is returned when err is nil.
+func RetryError(err error, retryWhen ...func(error) bool) *resource.RetryError {
+	if err == nil {
+		return nil
+	}
+
+	if azureerr.IsRetryable(err) {
+		return resource.RetryableError(err)
+	}
+
+	for _, f := range retryWhen {
+		if f(err) {
+			return resource.RetryableError(err)
+		}
+	}
+
+	return resource.NonRetryableError(err)
+}
@@ -0,0 +1,50 @@
+package suppress
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ISO8601Duration suppresses the diff between equivalent ISO8601 durations, e.g. `PT5M` and `PT300S`
+func ISO8601Duration(_, old, new string, _ *schema.ResourceData) bool {
+	oldMonths, oldSeconds, ok := parseISO8601Duration(old)
+	if !ok {
+		return false
+	}
+
+	newMonths, newSeconds, ok := parseISO8601Duration(new)
+	if !ok {
+		return false
+	}
+
+	return oldMonths == newMonths && oldSeconds == newSeconds
+}
+
+// parseISO8601Duration returns the number of months and seconds in the duration - years and months have a
+// variable length, so they're compared separately to the fixed-length components
+func parseISO8601Duration(input string) (months float64, seconds float64, ok bool) {
+	matches := regexp.MustCompile(`^P(?:([0-9]+)Y)?(?:([0-9]+)M)?(?:([0-9]+)W)?(?:([0-9]+)D)?(?:T(?:([0-9]+)H)?(?:([0-9]+)M)?(?:([0-9]+(?:\.[0-9]+)?)S)?)?$`).FindStringSubmatch(input)
+	if matches == nil || input == "P" || input[len(input)-1] == 'T' {
+		return 0, 0, false
+	}
+
+	values := make([]float64, len(matches))
+	for i, match := range matches[1:] {
+		if match == "" {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(match, 64)
+		if err != nil {
+			return 0, 0, false
+		}
+		values[i+1] = value
+	}
+
+	months = values[1]*12 + values[2]
+	seconds = values[3]*7*86400 + values[4]*86400 + values[5]*3600 + values[6]*60 + values[7]
+
+	return months, seconds, true
+}
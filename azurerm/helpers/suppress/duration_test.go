@@ -0,0 +1,81 @@
+package suppress
+
+import "testing"
+
+func TestISO8601Duration(t *testing.T) {
+	cases := []struct {
+		Name      string
+		DurationA string
+		DurationB string
+		Suppress  bool
+	}{
+		{
+			Name:      "empty",
+			DurationA: "",
+			DurationB: "",
+			Suppress:  false,
+		},
+		{
+			Name:      "neither are durations",
+			DurationA: "this is not a duration",
+			DurationB: "neither is this",
+			Suppress:  false,
+		},
+		{
+			Name:      "duration vs text",
+			DurationA: "PT5M",
+			DurationB: "five minutes",
+			Suppress:  false,
+		},
+		{
+			Name:      "same duration",
+			DurationA: "PT5M",
+			DurationB: "PT5M",
+			Suppress:  true,
+		},
+		{
+			Name:      "minutes vs seconds",
+			DurationA: "PT5M",
+			DurationB: "PT300S",
+			Suppress:  true,
+		},
+		{
+			Name:      "days vs hours",
+			DurationA: "P1D",
+			DurationB: "PT24H",
+			Suppress:  true,
+		},
+		{
+			Name:      "weeks vs days",
+			DurationA: "P1W",
+			DurationB: "P7D",
+			Suppress:  true,
+		},
+		{
+			Name:      "years vs months",
+			DurationA: "P1Y",
+			DurationB: "P12M",
+			Suppress:  true,
+		},
+		{
+			Name:      "months vs days",
+			DurationA: "P1M",
+			DurationB: "P30D",
+			Suppress:  false,
+		},
+		{
+			Name:      "two different durations",
+			DurationA: "PT5M",
+			DurationB: "PT15M",
+			Suppress:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if ISO8601Duration("test", tc.DurationA, tc.DurationB, nil) != tc.Suppress {
+				t.Fatalf("Expected ISO8601Duration to return %t for '%q' == '%q'", tc.Suppress, tc.DurationA, tc.DurationB)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// KeyVaultChildID validates the versioned ID of a Key, Secret or Certificate within a Key Vault,
+// for example `https://example.vault.azure.net/secrets/example/fdf067c93bbb4b22bff4d8b7a9a56217`
+func KeyVaultChildID(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	idURL, err := url.ParseRequestURI(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Key Vault Child ID: %v", k, err))
+		return
+	}
+
+	if idURL.Scheme != "https" || idURL.Host == "" {
+		errors = append(errors, fmt.Errorf("%q should be a Key Vault Child ID in the format `https://{vault}/{type}/{name}/{version}` - got %q", k, v))
+		return
+	}
+
+	path := strings.Trim(strings.TrimSpace(idURL.Path), "/")
+	if components := strings.Split(path, "/"); len(components) != 3 {
+		errors = append(errors, fmt.Errorf("%q should be a Key Vault Child ID with 3 segments (`{type}/{name}/{version}`) - got %d: %q", k, len(components), path))
+	}
+
+	return
+}
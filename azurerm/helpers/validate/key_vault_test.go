@@ -0,0 +1,49 @@
+package validate
+
+import "testing"
+
+func TestKeyVaultChildID(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Errors int
+	}{
+		{
+			Input:  "",
+			Errors: 1,
+		},
+		{
+			Input:  "nonsense",
+			Errors: 1,
+		},
+		{
+			Input:  "http://example.vault.azure.net/secrets/example/fdf067c93bbb4b22bff4d8b7a9a56217",
+			Errors: 1,
+		},
+		{
+			Input:  "https://example.vault.azure.net/secrets/example",
+			Errors: 1,
+		},
+		{
+			Input:  "https://example.vault.azure.net/secrets/example/fdf067c93bbb4b22bff4d8b7a9a56217/extra",
+			Errors: 1,
+		},
+		{
+			Input:  "https://example.vault.azure.net/secrets/example/fdf067c93bbb4b22bff4d8b7a9a56217",
+			Errors: 0,
+		},
+		{
+			Input:  "https://example.vault.azure.net/keys/example/fdf067c93bbb4b22bff4d8b7a9a56217/",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := KeyVaultChildID(tc.Input, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected KeyVaultChildID to have %d not %d errors for %q", tc.Errors, len(errors), tc.Input)
+			}
+		})
+	}
+}
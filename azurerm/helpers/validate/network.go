@@ -3,6 +3,7 @@ package validate
 import (
 	"fmt"
 	"net"
+	"strings"
 )
 
 func IPv4Address(i interface{}, k string) (_ []string, errors []error) {
@@ -47,15 +48,94 @@ func MACAddress(i interface{}, k string) (_ []string, errors []error) {
 }
 
 func PortNumber(i interface{}, k string) (_ []string, errors []error) {
+	return validatePortNumber(i, k, false)
+}
+
+func PortNumberOrZero(i interface{}, k string) (_ []string, errors []error) {
+	return validatePortNumber(i, k, true)
+}
+
+func validatePortNumber(i interface{}, k string, allowZero bool) (_ []string, errors []error) {
 	v, ok := i.(int)
 	if !ok {
 		errors = append(errors, fmt.Errorf("expected type of %q to be int", k))
 		return
 	}
 
+	if v == 0 && allowZero {
+		return
+	}
+
 	if v < 1 || 65535 < v {
 		errors = append(errors, fmt.Errorf("%q is not a valid port number: %q", k, i))
 	}
 
 	return
 }
+
+// networkSecurityRuleServiceTags are the Service Tags which can be used in place of an Address Prefix
+// within a Network Security Rule - some of these can be scoped to a region, e.g. `Storage.WestEurope`
+var networkSecurityRuleServiceTags = []string{
+	"ApiManagement",
+	"AppService",
+	"AppServiceManagement",
+	"AzureActiveDirectory",
+	"AzureBackup",
+	"AzureCloud",
+	"AzureConnectors",
+	"AzureContainerRegistry",
+	"AzureCosmosDB",
+	"AzureDataLake",
+	"AzureKeyVault",
+	"AzureLoadBalancer",
+	"AzureMachineLearning",
+	"AzureMonitor",
+	"AzureTrafficManager",
+	"BatchNodeManagement",
+	"EventHub",
+	"GatewayManager",
+	"Internet",
+	"MicrosoftContainerRegistry",
+	"ServiceBus",
+	"ServiceFabric",
+	"Sql",
+	"Storage",
+	"VirtualNetwork",
+
+	// legacy names which are still accepted by the API
+	"AZURE_LOADBALANCER",
+	"INTERNET",
+	"VIRTUAL_NETWORK",
+}
+
+// NetworkSecurityRuleAddressPrefix validates that the value is either `*`, an IP Address,
+// a CIDR or one of the Service Tags supported by Network Security Rules
+func NetworkSecurityRuleAddressPrefix(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" || v == "*" {
+		return
+	}
+
+	if ip := net.ParseIP(v); ip != nil {
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(v); err == nil {
+		return
+	}
+
+	tag := strings.SplitN(v, ".", 2)[0]
+	for _, serviceTag := range networkSecurityRuleServiceTags {
+		if strings.EqualFold(tag, serviceTag) {
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("%q must be `*`, an IP Address, a CIDR or a supported Service Tag (such as `VirtualNetwork` or `Storage.WestEurope`), got %q", k, v))
+	return
+}
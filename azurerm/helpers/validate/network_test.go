@@ -196,3 +196,99 @@ func TestPortNumber(t *testing.T) {
 		})
 	}
 }
+
+func TestPortNumberOrZero(t *testing.T) {
+	cases := []struct {
+		Port   int
+		Errors int
+	}{
+		{
+			Port:   -1,
+			Errors: 1,
+		},
+		{
+			Port:   0,
+			Errors: 0,
+		},
+		{
+			Port:   1,
+			Errors: 0,
+		},
+		{
+			Port:   65535,
+			Errors: 0,
+		},
+		{
+			Port:   65536,
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(strconv.Itoa(tc.Port), func(t *testing.T) {
+			_, errors := PortNumberOrZero(tc.Port, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected PortNumberOrZero to return %d error(s) not %d", len(errors), tc.Errors)
+			}
+		})
+	}
+}
+
+func TestNetworkSecurityRuleAddressPrefix(t *testing.T) {
+	cases := []struct {
+		Prefix string
+		Errors int
+	}{
+		{
+			Prefix: "*",
+			Errors: 0,
+		},
+		{
+			Prefix: "10.0.0.1",
+			Errors: 0,
+		},
+		{
+			Prefix: "10.0.0.0/16",
+			Errors: 0,
+		},
+		{
+			Prefix: "10.0.0.0/33",
+			Errors: 1,
+		},
+		{
+			Prefix: "VirtualNetwork",
+			Errors: 0,
+		},
+		{
+			Prefix: "internet",
+			Errors: 0,
+		},
+		{
+			Prefix: "AZURE_LOADBALANCER",
+			Errors: 0,
+		},
+		{
+			Prefix: "Storage.WestEurope",
+			Errors: 0,
+		},
+		{
+			Prefix: "VirtualNetworks",
+			Errors: 1,
+		},
+		{
+			Prefix: "Storages.WestEurope",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Prefix, func(t *testing.T) {
+			_, errors := NetworkSecurityRuleAddressPrefix(tc.Prefix, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected NetworkSecurityRuleAddressPrefix to return %d error(s) not %d", len(errors), tc.Errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package validate
+
+import "testing"
+
+func TestResourceID(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Errors int
+	}{
+		{
+			Input:  "",
+			Errors: 1,
+		},
+		{
+			Input:  "nonsense",
+			Errors: 1,
+		},
+		{
+			Input:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Errors: 0,
+		},
+		{
+			Input:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers",
+			Errors: 1,
+		},
+		{
+			Input:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := ResourceID(tc.Input, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected ResourceID to have %d not %d errors for %q", tc.Errors, len(errors), tc.Input)
+			}
+		})
+	}
+}
+
+func TestResourceIDOrEmpty(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Errors int
+	}{
+		{
+			Input:  "",
+			Errors: 0,
+		},
+		{
+			Input:  "nonsense",
+			Errors: 1,
+		},
+		{
+			Input:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := ResourceIDOrEmpty(tc.Input, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected ResourceIDOrEmpty to have %d not %d errors for %q", tc.Errors, len(errors), tc.Input)
+			}
+		})
+	}
+}
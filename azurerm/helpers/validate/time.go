@@ -2,13 +2,14 @@ package validate
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-//todo, now in terraform helper, switch over once vended
+// todo, now in terraform helper, switch over once vended
 // -> https://github.com/hashicorp/terraform/blob/master/helper/validation/validation.go#L263
 func RFC3339Time(i interface{}, k string) (_ []string, errors []error) {
 	v, ok := i.(string)
@@ -46,3 +47,20 @@ func RFC3339DateInFutureBy(d time.Duration) schema.SchemaValidateFunc {
 		return
 	}
 }
+
+// ISO8601Duration validates an ISO8601 duration (e.g. `PT5M` or `P1DT12H`), which must contain at least one
+// component - and where `T` is specified, at least one time component
+func ISO8601Duration(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	matched := regexp.MustCompile(`^P([0-9]+Y)?([0-9]+M)?([0-9]+W)?([0-9]+D)?(T([0-9]+H)?([0-9]+M)?([0-9]+(\.[0-9]+)?S)?)?$`).MatchString(v)
+	if !matched || v == "P" || v[len(v)-1] == 'T' {
+		errors = append(errors, fmt.Errorf("expected %q to be in ISO8601 duration format (e.g. `PT5M`), got %q", k, v))
+	}
+
+	return
+}
@@ -102,3 +102,80 @@ func TestRfc3339DateInFutureBy(t *testing.T) {
 		})
 	}
 }
+
+func TestISO8601Duration(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			// Date components only
+			Value:  "P1Y2M3D",
+			Errors: 0,
+		},
+		{
+			// Time components only
+			Value:  "PT7H42M3S",
+			Errors: 0,
+		},
+		{
+			// Date and time components
+			Value:  "P1Y2M3DT7H42M3S",
+			Errors: 0,
+		},
+		{
+			// Weeks
+			Value:  "P2W",
+			Errors: 0,
+		},
+		{
+			// Fractional seconds
+			Value:  "PT0.5S",
+			Errors: 0,
+		},
+		{
+			// Invalid prefix
+			Value:  "1Y2M3DT7H42M3S",
+			Errors: 1,
+		},
+		{
+			// Wrong order of components, i.e. invalid format
+			Value:  "PT7H42M3S1Y2M3D",
+			Errors: 1,
+		},
+		{
+			// No components
+			Value:  "P",
+			Errors: 1,
+		},
+		{
+			// No time components
+			Value:  "PT",
+			Errors: 1,
+		},
+		{
+			// No time components after the date components
+			Value:  "P1DT",
+			Errors: 1,
+		},
+		{
+			// Lowercase
+			Value:  "pt5m",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Value, func(t *testing.T) {
+			_, errors := ISO8601Duration(tc.Value, "example")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected ISO8601Duration to have %d not %d errors for %q", tc.Errors, len(errors), tc.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMApiManagementCustomDomain_importBasic(t *testing.T) {
+	resourceName := "azurerm_api_management_custom_domain.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementCustomDomain_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"proxy.0.certificate",
+					"proxy.0.certificate_password",
+					"portal.0.certificate",
+					"portal.0.certificate_password",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMApplicationInsightsAnalyticsItem_importSharedQuery(t *testing.T) {
+	resourceName := "azurerm_application_insights_analytics_item.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationInsightsAnalyticsItem_query(ri, testLocation(), "shared", "requests #test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsAnalyticsItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
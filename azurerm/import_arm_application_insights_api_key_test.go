@@ -0,0 +1,33 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMApplicationInsightsAPIKey_importReadPermissions(t *testing.T) {
+	resourceName := "azurerm_application_insights_api_key.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationInsightsAPIKey_basic(ri, testLocation(), `["aggregate", "api"]`, "[]")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the API Key is only returned when it's created
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+		},
+	})
+}
@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMCdnEndpointCustomDomain_importBasic(t *testing.T) {
+	endpointEnvVariable := "ARM_TEST_CDN_ENDPOINT"
+	endpointEnv := os.Getenv(endpointEnvVariable)
+	if endpointEnv == "" {
+		t.Skipf("Skipping as %q is not specified", endpointEnvVariable)
+	}
+
+	domainEnvVariable := "ARM_TEST_CDN_DOMAIN"
+	domainEnv := os.Getenv(domainEnvVariable)
+	if domainEnv == "" {
+		t.Skipf("Skipping as %q is not specified", domainEnvVariable)
+	}
+
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMCdnEndpointCustomDomain_basic(ri, testLocation(), endpointEnv, domainEnv, false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
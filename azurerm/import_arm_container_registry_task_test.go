@@ -0,0 +1,34 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMContainerRegistryTask_importBasic(t *testing.T) {
+	repositoryUrl, accessToken := testAccAzureRMContainerRegistryTaskSourceRepository(t)
+
+	resourceName := "azurerm_container_registry_task.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMContainerRegistryTask_basic(ri, testLocation(), repositoryUrl, accessToken)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the access token isn't returned from the API
+				ImportStateVerifyIgnore: []string{"source_repository.0.access_token"},
+			},
+		},
+	})
+}
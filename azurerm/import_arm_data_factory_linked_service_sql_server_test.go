@@ -0,0 +1,32 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMDataFactoryLinkedServiceSQLServer_importBasic(t *testing.T) {
+	resourceName := "azurerm_data_factory_linked_service_sql_server.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMDataFactoryLinkedServiceSQLServer_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryLinkedServiceSQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"connection_string"},
+			},
+		},
+	})
+}
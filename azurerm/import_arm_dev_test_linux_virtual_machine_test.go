@@ -0,0 +1,37 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMDevTestLinuxVirtualMachine_importBasic(t *testing.T) {
+	resourceName := "azurerm_dev_test_linux_virtual_machine.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestLinuxVirtualMachine_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestLinuxVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"inbound_nat_rule",
+					"password",
+					"ssh_key",
+				},
+			},
+		},
+	})
+}
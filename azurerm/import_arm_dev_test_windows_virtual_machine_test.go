@@ -0,0 +1,36 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMDevTestWindowsVirtualMachine_importBasic(t *testing.T) {
+	resourceName := "azurerm_dev_test_windows_virtual_machine.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestWindowsVirtualMachine_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"inbound_nat_rule",
+					"password",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMRelayHybridConnection_importBasic(t *testing.T) {
+	resourceName := "azurerm_relay_hybrid_connection.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMRelayHybridConnection_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRelayHybridConnection_importUserMetadata(t *testing.T) {
+	resourceName := "azurerm_relay_hybrid_connection.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMRelayHybridConnection_userMetadata(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
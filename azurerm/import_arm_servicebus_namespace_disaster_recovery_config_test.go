@@ -0,0 +1,31 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_importBasic(t *testing.T) {
+	resourceName := "azurerm_servicebus_namespace_disaster_recovery_config.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_basic(ri, testLocation(), testAltLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusNamespaceDisasterRecoveryConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -29,6 +29,7 @@ func TestAccAzureRMVirtualMachine_importBasic(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"delete_data_disks_on_termination",
 					"delete_os_disk_on_termination",
+					"delete_network_interfaces_on_termination",
 				},
 			},
 		},
@@ -57,6 +58,7 @@ func TestAccAzureRMVirtualMachine_importBasic_withZone(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"delete_data_disks_on_termination",
 					"delete_os_disk_on_termination",
+					"delete_network_interfaces_on_termination",
 				},
 			},
 		},
@@ -85,6 +87,7 @@ func TestAccAzureRMVirtualMachine_importBasic_managedDisk(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"delete_data_disks_on_termination",
 					"delete_os_disk_on_termination",
+					"delete_network_interfaces_on_termination",
 				},
 			},
 		},
@@ -0,0 +1,143 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Exportable Keys, Key Release Policies (used to release a Key to an attested environment, such as a Confidential
+// Virtual Machine) and Key Rotation Policies aren't available in the version of the Key Vault SDK used by this
+// Provider, as such these are managed using the `resourcemanager` client - these are the models for the Data Plane
+// API Version `7.3`.
+
+const (
+	keyVaultKeyReleasePolicyDefaultContentType = "application/json; charset=utf-8"
+
+	keyVaultKeyRotationPolicyActionRotate = "Rotate"
+	keyVaultKeyRotationPolicyActionNotify = "Notify"
+)
+
+type keyVaultKeyBundle struct {
+	Key           *keyVaultKeyJSONWebKey    `json:"key,omitempty"`
+	Attributes    *keyVaultKeyAttributes    `json:"attributes,omitempty"`
+	ReleasePolicy *keyVaultKeyReleasePolicy `json:"release_policy,omitempty"`
+}
+
+type keyVaultKeyJSONWebKey struct {
+	Kid *string `json:"kid,omitempty"`
+}
+
+type keyVaultKeyAttributes struct {
+	Exportable *bool `json:"exportable,omitempty"`
+}
+
+type keyVaultKeyReleasePolicy struct {
+	ContentType *string `json:"contentType,omitempty"`
+	Immutable   *bool   `json:"immutable,omitempty"`
+
+	// the policy document, which is base64url encoded
+	EncodedPolicy *string `json:"data,omitempty"`
+}
+
+type keyVaultKeyReleaseParameters struct {
+	Target              *string `json:"target,omitempty"`
+	Nonce               *string `json:"nonce,omitempty"`
+	EncryptionAlgorithm *string `json:"enc,omitempty"`
+}
+
+type keyVaultKeyReleaseResult struct {
+	Value *string `json:"value,omitempty"`
+}
+
+type keyVaultKeyRotationPolicy struct {
+	ID              *string                                    `json:"id,omitempty"`
+	LifetimeActions *[]keyVaultKeyRotationPolicyLifetimeAction `json:"lifetimeActions"`
+	Attributes      *keyVaultKeyRotationPolicyAttributes       `json:"attributes,omitempty"`
+}
+
+type keyVaultKeyRotationPolicyLifetimeAction struct {
+	Trigger *keyVaultKeyRotationPolicyTrigger `json:"trigger,omitempty"`
+	Action  *keyVaultKeyRotationPolicyAction  `json:"action,omitempty"`
+}
+
+type keyVaultKeyRotationPolicyTrigger struct {
+	TimeAfterCreate  *string `json:"timeAfterCreate,omitempty"`
+	TimeBeforeExpiry *string `json:"timeBeforeExpiry,omitempty"`
+}
+
+type keyVaultKeyRotationPolicyAction struct {
+	Type string `json:"type"`
+}
+
+type keyVaultKeyRotationPolicyAttributes struct {
+	ExpiryTime *string `json:"expiryTime,omitempty"`
+}
+
+type KeyVaultKeyRotationPolicyID struct {
+	KeyVaultBaseUrl string
+	KeyName         string
+}
+
+func parseKeyVaultKeyRotationPolicyID(id string) (*KeyVaultKeyRotationPolicyID, error) {
+	// example: https://example-keyvault.vault.azure.net/keys/example/rotationpolicy
+	idURL, err := url.ParseRequestURI(id)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Azure KeyVault Key Rotation Policy Id: %s", err)
+	}
+
+	components := strings.Split(strings.Trim(strings.TrimSpace(idURL.Path), "/"), "/")
+	if len(components) != 3 || components[0] != "keys" || components[2] != "rotationpolicy" {
+		return nil, fmt.Errorf("Azure KeyVault Key Rotation Policy Id should be in the format `{vaultUri}/keys/{keyName}/rotationpolicy` but got %q", id)
+	}
+
+	return &KeyVaultKeyRotationPolicyID{
+		KeyVaultBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		KeyName:         components[1],
+	}, nil
+}
+
+// expandKeyVaultKeyWithReleasePolicy adds the `exportable` attribute and the `release_policy` (which aren't
+// available in the Key Vault SDK) to the parameters used to create or update a Key
+func expandKeyVaultKeyWithReleasePolicy(parameters interface{}, exportable *bool, policy *keyVaultKeyReleasePolicy) (map[string]interface{}, error) {
+	serialized, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing Key: %+v", err)
+	}
+
+	output := make(map[string]interface{})
+	if err := json.Unmarshal(serialized, &output); err != nil {
+		return nil, fmt.Errorf("Error deserializing Key: %+v", err)
+	}
+
+	if exportable != nil {
+		attributes, ok := output["attributes"].(map[string]interface{})
+		if !ok {
+			attributes = make(map[string]interface{})
+		}
+		attributes["exportable"] = *exportable
+		output["attributes"] = attributes
+	}
+
+	if policy != nil {
+		output["release_policy"] = policy
+	}
+
+	return output, nil
+}
+
+func encodeKeyVaultKeyReleasePolicy(policy string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(policy))
+}
+
+func decodeKeyVaultKeyReleasePolicy(input string) (string, error) {
+	// the padding is optional, so is removed prior to decoding
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(input, "="))
+	if err != nil {
+		return "", fmt.Errorf("Error decoding Key Release Policy: %+v", err)
+	}
+
+	return string(decoded), nil
+}
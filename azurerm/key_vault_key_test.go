@@ -0,0 +1,156 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestKeyVaultKeyReleasePolicyEncoding(t *testing.T) {
+	policy := `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net","allOf":[]}]}`
+
+	encoded := encodeKeyVaultKeyReleasePolicy(policy)
+
+	for _, input := range []string{encoded, encoded + "=="} {
+		decoded, err := decodeKeyVaultKeyReleasePolicy(input)
+		if err != nil {
+			t.Fatalf("Expected no error decoding %q but got: %+v", input, err)
+		}
+
+		if decoded != policy {
+			t.Fatalf("Expected the policy to be %q but got %q", policy, decoded)
+		}
+	}
+
+	if _, err := decodeKeyVaultKeyReleasePolicy("not*base64"); err == nil {
+		t.Fatalf("Expected an error decoding an invalid policy")
+	}
+}
+
+func TestExpandKeyVaultKeyWithReleasePolicy(t *testing.T) {
+	parameters := keyvault.KeyCreateParameters{
+		Kty:     keyvault.RSAHSM,
+		KeySize: utils.Int32(2048),
+		KeyAttributes: &keyvault.KeyAttributes{
+			Enabled: utils.Bool(true),
+		},
+	}
+	policy := &keyVaultKeyReleasePolicy{
+		ContentType:   utils.String(keyVaultKeyReleasePolicyDefaultContentType),
+		EncodedPolicy: utils.String(encodeKeyVaultKeyReleasePolicy("{}")),
+	}
+
+	output, err := expandKeyVaultKeyWithReleasePolicy(parameters, utils.Bool(true), policy)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if output["kty"] != "RSA-HSM" {
+		t.Fatalf("Expected the existing parameters to be retained but got %+v", output)
+	}
+
+	attributes := output["attributes"].(map[string]interface{})
+	if attributes["exportable"] != true || attributes["enabled"] != true {
+		t.Fatalf("Expected `exportable` to be added to the existing attributes but got %+v", attributes)
+	}
+
+	if output["release_policy"] != policy {
+		t.Fatalf("Expected the `release_policy` to be %+v but got %+v", policy, output["release_policy"])
+	}
+
+	output, err = expandKeyVaultKeyWithReleasePolicy(parameters, nil, nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if _, ok := output["release_policy"]; ok {
+		t.Fatalf("Expected no `release_policy` but got %+v", output)
+	}
+	if _, ok := output["attributes"].(map[string]interface{})["exportable"]; ok {
+		t.Fatalf("Expected no `exportable` attribute but got %+v", output)
+	}
+}
+
+func TestParseKeyVaultKeyRotationPolicyID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected *KeyVaultKeyRotationPolicyID
+	}{
+		{
+			Input: "https://my-keyvault.vault.azure.net/keys/test-key/rotationpolicy",
+			Expected: &KeyVaultKeyRotationPolicyID{
+				KeyVaultBaseUrl: "https://my-keyvault.vault.azure.net/",
+				KeyName:         "test-key",
+			},
+		},
+		{
+			// the ID of the Key, rather than of the Rotation Policy
+			Input: "https://my-keyvault.vault.azure.net/keys/test-key/fdf067c93bbb4b22bff4d8b7a9a56217",
+		},
+		{
+			Input: "https://my-keyvault.vault.azure.net/secrets/test-secret/rotationpolicy",
+		},
+		{
+			Input: "https://my-keyvault.vault.azure.net/keys/rotationpolicy",
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := parseKeyVaultKeyRotationPolicyID(tc.Input)
+		if tc.Expected == nil {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but got %+v", tc.Input, actual)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error parsing %q but got: %+v", tc.Input, err)
+		}
+
+		if *actual != *tc.Expected {
+			t.Fatalf("Expected %+v but got %+v", *tc.Expected, *actual)
+		}
+	}
+}
+
+func TestFlattenKeyVaultKeyRotationPolicyLifetimeActions(t *testing.T) {
+	input := []keyVaultKeyRotationPolicyLifetimeAction{
+		{
+			Trigger: &keyVaultKeyRotationPolicyTrigger{
+				TimeAfterCreate: utils.String("P30D"),
+			},
+			Action: &keyVaultKeyRotationPolicyAction{
+				Type: "rotate",
+			},
+		},
+		{
+			Trigger: &keyVaultKeyRotationPolicyTrigger{
+				TimeBeforeExpiry: utils.String("P29D"),
+			},
+			Action: &keyVaultKeyRotationPolicyAction{
+				Type: "Notify",
+			},
+		},
+	}
+
+	notifyBeforeExpiry, automatic := flattenKeyVaultKeyRotationPolicyLifetimeActions(&input)
+	if notifyBeforeExpiry != "P29D" {
+		t.Fatalf("Expected `notify_before_expiry` to be `P29D` but got %q", notifyBeforeExpiry)
+	}
+
+	if len(automatic) != 1 {
+		t.Fatalf("Expected 1 `automatic` block but got %+v", automatic)
+	}
+
+	v := automatic[0].(map[string]interface{})
+	if v["time_after_creation"] != "P30D" || v["time_before_expiry"] != "" {
+		t.Fatalf("Expected `time_after_creation` to be `P30D` but got %+v", v)
+	}
+
+	notifyBeforeExpiry, automatic = flattenKeyVaultKeyRotationPolicyLifetimeActions(nil)
+	if notifyBeforeExpiry != "" || len(automatic) != 0 {
+		t.Fatalf("Expected an empty policy but got %q / %+v", notifyBeforeExpiry, automatic)
+	}
+}
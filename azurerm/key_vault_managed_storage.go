@@ -0,0 +1,75 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+type KeyVaultManagedStorageAccountID struct {
+	KeyVaultBaseUrl string
+	Name            string
+}
+
+type KeyVaultManagedStorageAccountSasDefinitionID struct {
+	KeyVaultBaseUrl    string
+	StorageAccountName string
+	Name               string
+}
+
+func parseKeyVaultManagedStorageAccountID(id string) (*KeyVaultManagedStorageAccountID, error) {
+	// example: https://example-keyvault.vault.azure.net/storage/examplestorage
+	baseUrl, components, err := parseKeyVaultManagedStorageIDComponents(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(components) != 2 || components[0] != "storage" {
+		return nil, fmt.Errorf("Azure KeyVault Managed Storage Account Id should be in the format `{vaultUri}/storage/{name}` but got %q", id)
+	}
+
+	return &KeyVaultManagedStorageAccountID{
+		KeyVaultBaseUrl: baseUrl,
+		Name:            components[1],
+	}, nil
+}
+
+func parseKeyVaultManagedStorageAccountSasDefinitionID(id string) (*KeyVaultManagedStorageAccountSasDefinitionID, error) {
+	// example: https://example-keyvault.vault.azure.net/storage/examplestorage/sas/examplesas
+	baseUrl, components, err := parseKeyVaultManagedStorageIDComponents(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(components) != 4 || components[0] != "storage" || components[2] != "sas" {
+		return nil, fmt.Errorf("Azure KeyVault Managed Storage Account SAS Definition Id should be in the format `{vaultUri}/storage/{storageAccountName}/sas/{name}` but got %q", id)
+	}
+
+	return &KeyVaultManagedStorageAccountSasDefinitionID{
+		KeyVaultBaseUrl:    baseUrl,
+		StorageAccountName: components[1],
+		Name:               components[3],
+	}, nil
+}
+
+func parseKeyVaultManagedStorageIDComponents(id string) (string, []string, error) {
+	idURL, err := url.ParseRequestURI(id)
+	if err != nil {
+		return "", nil, fmt.Errorf("Cannot parse Azure KeyVault Managed Storage Id: %s", err)
+	}
+
+	path := strings.Trim(strings.TrimSpace(idURL.Path), "/")
+	baseUrl := fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host)
+	return baseUrl, strings.Split(path, "/"), nil
+}
+
+func validateKeyVaultManagedStorageName(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z]+$`).Match([]byte(value)); !matched {
+		es = append(es, fmt.Errorf("%q may only contain alphanumeric characters", k))
+	}
+
+	return
+}
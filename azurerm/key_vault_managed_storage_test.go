@@ -0,0 +1,136 @@
+package azurerm
+
+import "testing"
+
+func TestAccAzureRMKeyVaultManagedStorage_validateName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "hello",
+			ExpectError: false,
+		},
+		{
+			Input:       "HelloWorld21",
+			ExpectError: false,
+		},
+		{
+			Input:       "hello-world",
+			ExpectError: true,
+		},
+		{
+			Input:       "hello_world",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateKeyVaultManagedStorageName(tc.Input, "")
+
+		hasError := len(errors) > 0
+
+		if tc.ExpectError != hasError {
+			t.Fatalf("Expected the Key Vault Managed Storage Name to trigger a validation error (%t) for '%s'", tc.ExpectError, tc.Input)
+		}
+	}
+}
+
+func TestAccAzureRMKeyVaultManagedStorage_parseAccountID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    KeyVaultManagedStorageAccountID
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/secrets/hello/world",
+			ExpectError: true,
+		},
+		{
+			Input: "https://my-keyvault.vault.azure.net/storage/hello",
+			Expected: KeyVaultManagedStorageAccountID{
+				KeyVaultBaseUrl: "https://my-keyvault.vault.azure.net/",
+				Name:            "hello",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := parseKeyVaultManagedStorageAccountID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID '%s' but didn't get one", tc.Input)
+		}
+
+		if *id != tc.Expected {
+			t.Fatalf("Expected %+v but got %+v for ID '%s'", tc.Expected, *id, tc.Input)
+		}
+	}
+}
+
+func TestAccAzureRMKeyVaultManagedStorage_parseSasDefinitionID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    KeyVaultManagedStorageAccountSasDefinitionID
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/hello",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/hello/other/world",
+			ExpectError: true,
+		},
+		{
+			Input: "https://my-keyvault.vault.azure.net/storage/hello/sas/world",
+			Expected: KeyVaultManagedStorageAccountSasDefinitionID{
+				KeyVaultBaseUrl:    "https://my-keyvault.vault.azure.net/",
+				StorageAccountName: "hello",
+				Name:               "world",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := parseKeyVaultManagedStorageAccountSasDefinitionID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID '%s' but didn't get one", tc.Input)
+		}
+
+		if *id != tc.Expected {
+			t.Fatalf("Expected %+v but got %+v for ID '%s'", tc.Expected, *id, tc.Input)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// The Kusto Resource Provider isn't available in the version of the Azure SDK used by this Provider,
+// as such Kusto (Azure Data Explorer) is managed using the `resourcemanager` client - these are the models
+// for the API Version `2019-01-21`.
+
+type kustoCluster struct {
+	ID         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Location   *string                 `json:"location,omitempty"`
+	Tags       map[string]*string      `json:"tags,omitempty"`
+	Sku        *kustoClusterSku        `json:"sku,omitempty"`
+	Properties *kustoClusterProperties `json:"properties,omitempty"`
+}
+
+type kustoClusterSku struct {
+	Name     string `json:"name"`
+	Capacity *int32 `json:"capacity,omitempty"`
+	Tier     string `json:"tier"`
+}
+
+type kustoClusterProperties struct {
+	State             *string `json:"state,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	URI               *string `json:"uri,omitempty"`
+	DataIngestionURI  *string `json:"dataIngestionUri,omitempty"`
+}
+
+type kustoDatabase struct {
+	ID         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Location   *string                  `json:"location,omitempty"`
+	Properties *kustoDatabaseProperties `json:"properties,omitempty"`
+}
+
+type kustoDatabaseProperties struct {
+	SoftDeletePeriod  *string                  `json:"softDeletePeriod,omitempty"`
+	HotCachePeriod    *string                  `json:"hotCachePeriod,omitempty"`
+	Statistics        *kustoDatabaseStatistics `json:"statistics,omitempty"`
+	ProvisioningState *string                  `json:"provisioningState,omitempty"`
+}
+
+type kustoDatabaseStatistics struct {
+	Size *float64 `json:"size,omitempty"`
+}
+
+type kustoEventHubDataConnection struct {
+	ID         *string                                `json:"id,omitempty"`
+	Name       *string                                `json:"name,omitempty"`
+	Location   *string                                `json:"location,omitempty"`
+	Kind       string                                 `json:"kind"`
+	Properties *kustoEventHubDataConnectionProperties `json:"properties,omitempty"`
+}
+
+type kustoEventHubDataConnectionProperties struct {
+	EventHubResourceID *string `json:"eventHubResourceId,omitempty"`
+	ConsumerGroup      *string `json:"consumerGroup,omitempty"`
+	TableName          *string `json:"tableName,omitempty"`
+	MappingRuleName    *string `json:"mappingRuleName,omitempty"`
+	DataFormat         *string `json:"dataFormat,omitempty"`
+}
+
+func kustoClusterResourceID(subscriptionId, resourceGroup, clusterName string) string {
+	return azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Kusto", "clusters", clusterName)
+}
+
+func kustoDatabaseResourceID(subscriptionId, resourceGroup, clusterName, databaseName string) string {
+	return fmt.Sprintf("%s/databases/%s", kustoClusterResourceID(subscriptionId, resourceGroup, clusterName), databaseName)
+}
+
+func kustoDataConnectionResourceID(subscriptionId, resourceGroup, clusterName, databaseName, name string) string {
+	return fmt.Sprintf("%s/dataConnections/%s", kustoDatabaseResourceID(subscriptionId, resourceGroup, clusterName, databaseName), name)
+}
+
+func validateAzureRMKustoClusterName(v interface{}, k string) (warnings []string, errors []error) {
+	name := v.(string)
+
+	if !regexp.MustCompile(`^[a-z][a-z0-9]+$`).MatchString(name) {
+		errors = append(errors, fmt.Errorf("%q must begin with a letter and may only contain lowercase letters and numbers: %q", k, name))
+	}
+
+	if len(name) < 4 || len(name) > 22 {
+		errors = append(errors, fmt.Errorf("%q must be between 4 and 22 characters: %q", k, name))
+	}
+
+	return
+}
+
+func validateAzureRMKustoEntityName(v interface{}, k string) (warnings []string, errors []error) {
+	name := v.(string)
+
+	if !regexp.MustCompile(`^[\w\-.]+$`).MatchString(name) {
+		errors = append(errors, fmt.Errorf("%q may only contain letters, numbers, underscores, dashes and periods: %q", k, name))
+	}
+
+	if len(name) > 260 {
+		errors = append(errors, fmt.Errorf("%q must be no longer than 260 characters: %q", k, name))
+	}
+
+	return
+}
@@ -0,0 +1,45 @@
+package azurerm
+
+import "testing"
+
+func TestValidateAzureRMKustoClusterName(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ShouldError bool
+	}{
+		{Value: "abc", ShouldError: true},
+		{Value: "abcd", ShouldError: false},
+		{Value: "abcd1234", ShouldError: false},
+		{Value: "1abcd", ShouldError: true},
+		{Value: "Abcd", ShouldError: true},
+		{Value: "ab-cd", ShouldError: true},
+		{Value: "abcdefghijklmnopqrstuv", ShouldError: false},
+		{Value: "abcdefghijklmnopqrstuvw", ShouldError: true},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAzureRMKustoClusterName(tc.Value, "name")
+		if tc.ShouldError != (len(errors) > 0) {
+			t.Fatalf("Expected validating %q to error (%t) but got %d errors", tc.Value, tc.ShouldError, len(errors))
+		}
+	}
+}
+
+func TestValidateAzureRMKustoEntityName(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ShouldError bool
+	}{
+		{Value: "database", ShouldError: false},
+		{Value: "my-database_1.0", ShouldError: false},
+		{Value: "my database", ShouldError: true},
+		{Value: "database/1", ShouldError: true},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAzureRMKustoEntityName(tc.Value, "name")
+		if tc.ShouldError != (len(errors) > 0) {
+			t.Fatalf("Expected validating %q to error (%t) but got %d errors", tc.Value, tc.ShouldError, len(errors))
+		}
+	}
+}
@@ -2,16 +2,22 @@ package azurerm
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/batch"
 )
 
+var errLoadBalancerNotFound = errors.New("Load Balancer was not found")
+
 func resourceGroupAndLBNameFromId(loadBalancerId string) (string, string, error) {
 	id, err := parseAzureResourceID(loadBalancerId)
 	if err != nil {
@@ -43,6 +49,31 @@ func retrieveLoadBalancerById(loadBalancerId string, meta interface{}) (*network
 	return &resp, true, nil
 }
 
+// retrieveCachedLoadBalancerById returns the Load Balancer from the cache shared with its sub-resources, retrieving it
+// if it's not cached. The returned Load Balancer is shared and as such must not be modified.
+func retrieveCachedLoadBalancerById(loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
+	value, err := meta.(*ArmClient).parentResourceCache.Get(loadBalancerId, func() (interface{}, string, error) {
+		loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerId, meta)
+		if err != nil || !exists {
+			return nil, "", err
+		}
+
+		etag := ""
+		if loadBalancer.Etag != nil {
+			etag = *loadBalancer.Etag
+		}
+		return loadBalancer, etag, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if value == nil {
+		return nil, false, nil
+	}
+
+	return value.(*network.LoadBalancer), true, nil
+}
+
 func findLoadBalancerBackEndAddressPoolByName(lb *network.LoadBalancer, name string) (*network.BackendAddressPool, int, bool) {
 	if lb == nil || lb.LoadBalancerPropertiesFormat == nil || lb.LoadBalancerPropertiesFormat.BackendAddressPools == nil {
 		return nil, -1, false
@@ -127,6 +158,90 @@ func findLoadBalancerProbeByName(lb *network.LoadBalancer, name string) (*networ
 	return nil, -1, false
 }
 
+// updateLoadBalancer applies the change to the Load Balancer, returning the updated Load Balancer and whether it exists.
+//
+// Concurrent changes to the same Load Balancer (for example from its Probes, Rules and NAT Rules) are coalesced into a
+// single update, rather than each change retrieving and updating the Load Balancer in turn.
+func updateLoadBalancer(meta interface{}, loadBalancerId string, change func(lb *network.LoadBalancer) error) (*network.LoadBalancer, bool, error) {
+	client := meta.(*ArmClient).loadBalancerClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(loadBalancerId)
+	if err != nil {
+		return nil, false, fmt.Errorf("Error Getting Load Balancer Name and Group: %+v", err)
+	}
+
+	operations := batch.Operations{
+		Get: func() (interface{}, error) {
+			loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerId, meta)
+			if err != nil {
+				return nil, fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
+			}
+			if !exists {
+				return nil, errLoadBalancerNotFound
+			}
+
+			return loadBalancer, nil
+		},
+		Update: func(parent interface{}) (interface{}, error) {
+			loadBalancer := parent.(*network.LoadBalancer)
+
+			future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
+			if err != nil {
+				return nil, fmt.Errorf("Error Creating/Updating Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+			}
+
+			if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+				return nil, fmt.Errorf("Error waiting for completion of Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+			}
+
+			log.Printf("[DEBUG] Waiting for Load Balancer %q (Resource Group %q) to become available", loadBalancerName, resGroup)
+			stateConf := &resource.StateChangeConf{
+				Pending: []string{"Accepted", "Updating"},
+				Target:  []string{"Succeeded"},
+				Refresh: loadbalancerStateRefreshFunc(ctx, client, resGroup, loadBalancerName),
+				Timeout: 10 * time.Minute,
+			}
+			if _, err := stateConf.WaitForState(); err != nil {
+				return nil, fmt.Errorf("Error waiting for Load Balancer %q (Resource Group %q) to become available: %+v", loadBalancerName, resGroup, err)
+			}
+
+			read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+			if err != nil {
+				return nil, fmt.Errorf("Error retrieving Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+			}
+			if read.ID == nil {
+				return nil, fmt.Errorf("Cannot read Load Balancer %q (Resource Group %q) ID", loadBalancerName, resGroup)
+			}
+
+			etag := ""
+			if read.Etag != nil {
+				etag = *read.Etag
+			}
+			meta.(*ArmClient).parentResourceCache.Set(loadBalancerId, etag, &read)
+
+			return &read, nil
+		},
+	}
+
+	result, err := meta.(*ArmClient).subResourceBatcher.Submit(loadBalancerId, operations, func(parent interface{}) error {
+		return change(parent.(*network.LoadBalancer))
+	})
+	if err != nil {
+		if err == errLoadBalancerNotFound {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	if result == nil {
+		return nil, true, nil
+	}
+
+	return result.(*network.LoadBalancer), true, nil
+}
+
 func loadbalancerStateRefreshFunc(ctx context.Context, client network.LoadBalancersClient, resourceGroupName string, loadbalancer string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.Get(ctx, resourceGroupName, loadbalancer, "")
@@ -166,3 +281,33 @@ func loadBalancerSubResourceStateImporter(d *schema.ResourceData, m interface{})
 	d.Set("loadbalancer_id", lbID)
 	return []*schema.ResourceData{d}, nil
 }
+
+// loadBalancerFrontendIPConfigurationIsIPv6 returns whether the Frontend IP Configuration is an IPv6 frontend - which
+// is determined by the version of the Public IP Address associated with it, since Private frontends are always IPv4
+func loadBalancerFrontendIPConfigurationIsIPv6(meta interface{}, config network.FrontendIPConfiguration) (bool, error) {
+	client := meta.(*ArmClient).publicIPClient
+	ctx := meta.(*ArmClient).StopContext
+
+	props := config.FrontendIPConfigurationPropertiesFormat
+	if props == nil || props.PublicIPAddress == nil || props.PublicIPAddress.ID == nil {
+		return false, nil
+	}
+
+	id, err := parseAzureResourceID(*props.PublicIPAddress.ID)
+	if err != nil {
+		return false, err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["publicIPAddresses"]
+
+	resp, err := client.Get(ctx, resGroup, name, "")
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving Public IP %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if props := resp.PublicIPAddressPropertiesFormat; props != nil {
+		return props.PublicIPAddressVersion == network.IPv6, nil
+	}
+
+	return false, nil
+}
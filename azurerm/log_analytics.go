@@ -0,0 +1,127 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// The Log Analytics Clusters, Linked Services and Tables aren't available in the version of the Azure SDK used by
+// this Provider, as such these resources are managed using the `resourcemanager` client - these are the models for
+// the API Version `2020-08-01`.
+
+type logAnalyticsCluster struct {
+	ID         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Location   *string                        `json:"location,omitempty"`
+	Tags       map[string]*string             `json:"tags"`
+	Identity   *logAnalyticsClusterIdentity   `json:"identity,omitempty"`
+	Sku        *logAnalyticsClusterSku        `json:"sku,omitempty"`
+	Properties *logAnalyticsClusterProperties `json:"properties,omitempty"`
+}
+
+type logAnalyticsClusterIdentity struct {
+	Type        string  `json:"type"`
+	PrincipalID *string `json:"principalId,omitempty"`
+	TenantID    *string `json:"tenantId,omitempty"`
+}
+
+type logAnalyticsClusterSku struct {
+	Name     string `json:"name"`
+	Capacity *int64 `json:"capacity,omitempty"`
+}
+
+type logAnalyticsClusterProperties struct {
+	ClusterID          *string                         `json:"clusterId,omitempty"`
+	ProvisioningState  *string                         `json:"provisioningState,omitempty"`
+	KeyVaultProperties *logAnalyticsKeyVaultProperties `json:"keyVaultProperties,omitempty"`
+}
+
+type logAnalyticsKeyVaultProperties struct {
+	KeyVaultURI *string `json:"keyVaultUri"`
+	KeyName     *string `json:"keyName"`
+	KeyVersion  *string `json:"keyVersion"`
+}
+
+type logAnalyticsLinkedService struct {
+	ID         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *logAnalyticsLinkedServiceProperties `json:"properties,omitempty"`
+}
+
+type logAnalyticsLinkedServiceProperties struct {
+	ResourceID            *string `json:"resourceId,omitempty"`
+	WriteAccessResourceID *string `json:"writeAccessResourceId,omitempty"`
+	ProvisioningState     *string `json:"provisioningState,omitempty"`
+}
+
+func logAnalyticsClusterResourceID(subscriptionId, resourceGroup, name string) string {
+	return azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.OperationalInsights", "clusters", name)
+}
+
+func validateLogAnalyticsClusterName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 4 and 63 characters, can only contain alphanumeric characters and hyphens, must start with a letter and end with a letter or number: %q", k, value))
+	}
+
+	return warnings, errors
+}
+
+func validateLogAnalyticsClusterSize(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(int)
+
+	for _, size := range []int{1000, 2000, 5000} {
+		if value == size {
+			return warnings, errors
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("%q must be one of 1000, 2000 or 5000: %d", k, value))
+	return warnings, errors
+}
+
+// logAnalyticsClusterPatch is used to update the Key Vault Properties of a Cluster, without modifying the Tags
+type logAnalyticsClusterPatch struct {
+	Properties *logAnalyticsClusterProperties `json:"properties"`
+}
+
+// validateLogAnalyticsKeyVaultKeyID validates the versioned ID of a Key Vault Key, such as
+// `https://example.vault.azure.net/keys/example/fdf067c93bbb4b22bff4d8b7a9a56217`
+func validateLogAnalyticsKeyVaultKeyID(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	id, err := parseKeyVaultChildID(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be the versioned ID of a Key Vault Key: %+v", k, err))
+		return warnings, errors
+	}
+
+	if !strings.HasSuffix(strings.TrimSuffix(value, "/"), fmt.Sprintf("/keys/%s/%s", id.Name, id.Version)) {
+		errors = append(errors, fmt.Errorf("%q must be the versioned ID of a Key Vault Key: %q", k, value))
+	}
+
+	return warnings, errors
+}
+
+type logAnalyticsTable struct {
+	ID         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *logAnalyticsTableProperties `json:"properties,omitempty"`
+}
+
+type logAnalyticsTableProperties struct {
+	// RetentionInDays is sent as `-1` to reset the Table to the Retention of the Workspace
+	RetentionInDays *int32 `json:"retentionInDays,omitempty"`
+}
+
+func validateLogAnalyticsTableName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,62}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 63 characters, can only contain alphanumeric characters and underscores and must start with a letter: %q", k, value))
+	}
+
+	return warnings, errors
+}
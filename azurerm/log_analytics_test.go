@@ -0,0 +1,93 @@
+package azurerm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateLogAnalyticsClusterName(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ShouldError bool
+	}{
+		{Value: "", ShouldError: true},
+		{Value: "abc", ShouldError: true},
+		{Value: "abcd", ShouldError: false},
+		{Value: "Cluster-01", ShouldError: false},
+		{Value: "1cluster", ShouldError: true},
+		{Value: "cluster-", ShouldError: true},
+		{Value: "cluster_01", ShouldError: true},
+		{Value: "a" + strings.Repeat("b", 61) + "c", ShouldError: false},
+		{Value: "a" + strings.Repeat("b", 62) + "c", ShouldError: true},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateLogAnalyticsClusterName(tc.Value, "name")
+		if hasErrors := len(errors) > 0; hasErrors != tc.ShouldError {
+			t.Fatalf("Expected %q to error: %t but got errors: %+v", tc.Value, tc.ShouldError, errors)
+		}
+	}
+}
+
+func TestValidateLogAnalyticsClusterSize(t *testing.T) {
+	cases := []struct {
+		Value       int
+		ShouldError bool
+	}{
+		{Value: 0, ShouldError: true},
+		{Value: 500, ShouldError: true},
+		{Value: 1000, ShouldError: false},
+		{Value: 2000, ShouldError: false},
+		{Value: 3000, ShouldError: true},
+		{Value: 5000, ShouldError: false},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateLogAnalyticsClusterSize(tc.Value, "size_gb")
+		if hasErrors := len(errors) > 0; hasErrors != tc.ShouldError {
+			t.Fatalf("Expected %d to error: %t but got errors: %+v", tc.Value, tc.ShouldError, errors)
+		}
+	}
+}
+
+func TestValidateLogAnalyticsKeyVaultKeyID(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ShouldError bool
+	}{
+		{Value: "", ShouldError: true},
+		{Value: "https://example.vault.azure.net/keys/example", ShouldError: true},
+		{Value: "https://example.vault.azure.net/secrets/example/fdf067c93bbb4b22bff4d8b7a9a56217", ShouldError: true},
+		{Value: "https://example.vault.azure.net/keys/example/fdf067c93bbb4b22bff4d8b7a9a56217", ShouldError: false},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateLogAnalyticsKeyVaultKeyID(tc.Value, "key_vault_key_id")
+		if hasErrors := len(errors) > 0; hasErrors != tc.ShouldError {
+			t.Fatalf("Expected %q to error: %t but got errors: %+v", tc.Value, tc.ShouldError, errors)
+		}
+	}
+}
+
+func TestValidateLogAnalyticsTableName(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ShouldError bool
+	}{
+		{Value: "", ShouldError: true},
+		{Value: "Perf", ShouldError: false},
+		{Value: "SecurityEvent", ShouldError: false},
+		{Value: "Custom_CL", ShouldError: false},
+		{Value: "_Custom", ShouldError: true},
+		{Value: "Custom-Table", ShouldError: true},
+		{Value: "a" + strings.Repeat("b", 62), ShouldError: false},
+		{Value: "a" + strings.Repeat("b", 63), ShouldError: true},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateLogAnalyticsTableName(tc.Value, "name")
+		if hasErrors := len(errors) > 0; hasErrors != tc.ShouldError {
+			t.Fatalf("Expected %q to error: %t but got errors: %+v", tc.Value, tc.ShouldError, errors)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+)
+
+// azureRMResourceID builds the ID of a top-level resource within a Resource Group,
+// which allows the ID to be known before the resource has finished provisioning.
+func azureRMResourceID(subscriptionId, resourceGroup, provider, resourceType, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/%s/%s/%s", subscriptionId, resourceGroup, provider, resourceType, name)
+}
+
+// waitForCreateOrUpdate waits for a long-running create/update operation to complete.
+//
+// Once Azure has accepted a create request the resource can exist even if the operation later
+// fails (or Terraform is interrupted) - when this happens the resource would be missing from the
+// state and the next apply would fail since it already exists. To avoid this the expected ID is
+// set prior to waiting for new resources, which means Terraform persists the resource into the
+// state and marks it as tainted should an error be returned - so that it's replaced on the next apply.
+func waitForCreateOrUpdate(ctx context.Context, d *schema.ResourceData, future *azure.Future, client autorest.Client, expectedId string) error {
+	if d.IsNewResource() {
+		d.SetId(expectedId)
+	}
+
+	return waitForCompletion(ctx, future, client)
+}
+
+// waitForCompletion waits for a long-running operation to complete - should it fail the error includes the
+// Correlation Request ID and Request ID of the operation, so that it can be traced in the Activity Log.
+func waitForCompletion(ctx context.Context, future *azure.Future, client autorest.Client) error {
+	if err := future.WaitForCompletionRef(ctx, client); err != nil {
+		return response.NewOperationError(err, future.Status(), future.Response())
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package azurerm
+
+import "testing"
+
+func TestAzureRMResourceID(t *testing.T) {
+	id := azureRMResourceID("00000000-0000-0000-0000-000000000000", "group1", "Microsoft.Network", "routeFilters", "filter1")
+	expected := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/routeFilters/filter1"
+	if id != expected {
+		t.Fatalf("expected ID to equal %s, actual %s", expected, id)
+	}
+
+	parsed, err := parseAzureResourceID(id)
+	if err != nil {
+		t.Fatalf("expected ID to be parseable, got %+v", err)
+	}
+
+	if parsed.Path["routeFilters"] != "filter1" {
+		t.Fatalf("expected parsed name to equal filter1, actual %s", parsed.Path["routeFilters"])
+	}
+}
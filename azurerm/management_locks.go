@@ -0,0 +1,118 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var scopeLockedErrorRegex = regexp.MustCompile(`following scope\(s\) are locked: '([^']+)'`)
+
+// wrapResourcesWithManagementLockErrors decorates the Create, Update and Delete functions of each resource so that
+// when an operation is blocked by a Management Lock, the error returned includes the details of the lock(s) responsible
+func wrapResourcesWithManagementLockErrors(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		if r.Create != nil {
+			r.Create = withManagementLockErrors(r.Create)
+		}
+		if r.Update != nil {
+			r.Update = withManagementLockErrors(r.Update)
+		}
+		if r.Delete != nil {
+			r.Delete = withManagementLockErrors(r.Delete)
+		}
+	}
+}
+
+func withManagementLockErrors(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		err := f(d, meta)
+		if err == nil {
+			return nil
+		}
+
+		scopes := parseScopeLockedError(err)
+		if len(scopes) == 0 {
+			return err
+		}
+
+		client, ok := meta.(*ArmClient)
+		if !ok {
+			return err
+		}
+
+		lockDetails, lockErr := describeManagementLocksForScopes(client.StopContext, client.managementLocksClient, scopes)
+		if lockErr != nil {
+			log.Printf("[DEBUG] Error retrieving the Management Locks which blocked the operation: %+v", lockErr)
+			return fmt.Errorf("%+v\n\nThis operation was blocked by a Management Lock on: %s", err, strings.Join(scopes, ", "))
+		}
+
+		return fmt.Errorf("%+v\n\nThis operation was blocked by the following Management Lock(s):\n\n%s", err, lockDetails)
+	}
+}
+
+// parseScopeLockedError returns the locked scopes from a `ScopeLocked` error returned by the API, which is in the format:
+// The scope '{scope}' cannot perform delete operation because following scope(s) are locked: '{scope}'. Please remove the lock and try again.
+func parseScopeLockedError(err error) []string {
+	if err == nil {
+		return nil
+	}
+
+	message := err.Error()
+	if !strings.Contains(message, "ScopeLocked") {
+		return nil
+	}
+
+	scopes := make([]string, 0)
+	for _, match := range scopeLockedErrorRegex.FindAllStringSubmatch(message, -1) {
+		for _, scope := range strings.Split(match[1], ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+
+	return scopes
+}
+
+func describeManagementLocksForScopes(ctx context.Context, client locks.ManagementLocksClient, scopes []string) (string, error) {
+	// there's no API to list the locks at an arbitrary scope, however those at the Subscription level
+	// include the locks on all of the Resource Groups and Resources within it
+	results, err := client.ListAtSubscriptionLevelComplete(ctx, "")
+	if err != nil {
+		return "", err
+	}
+
+	descriptions := make([]string, 0)
+	for results.NotDone() {
+		lock := results.Value()
+		if lock.ID != nil {
+			if id, err := parseAzureRMLockId(*lock.ID); err == nil {
+				for _, scope := range scopes {
+					if strings.EqualFold(id.Scope, scope) {
+						level := ""
+						if props := lock.ManagementLockProperties; props != nil {
+							level = string(props.Level)
+						}
+						descriptions = append(descriptions, fmt.Sprintf("* %q (Level %q / Scope %q)", id.Name, level, id.Scope))
+					}
+				}
+			}
+		}
+
+		if err := results.Next(); err != nil {
+			return "", err
+		}
+	}
+
+	if len(descriptions) == 0 {
+		return "", fmt.Errorf("No Management Locks were found for the scope(s) %q", strings.Join(scopes, ", "))
+	}
+
+	return strings.Join(descriptions, "\n"), nil
+}
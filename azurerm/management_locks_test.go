@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseScopeLockedError(t *testing.T) {
+	cases := []struct {
+		Error    error
+		Expected []string
+	}{
+		{
+			Error:    nil,
+			Expected: []string{},
+		},
+		{
+			Error:    fmt.Errorf("network.VirtualNetworksClient#Delete: Failure sending request: StatusCode=404 -- Original Error: Code=\"NotFound\""),
+			Expected: []string{},
+		},
+		{
+			Error:    fmt.Errorf("network.VirtualNetworksClient#Delete: Failure sending request: StatusCode=409 -- Original Error: Code=\"ScopeLocked\" Message=\"The scope '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1' cannot perform delete operation because following scope(s) are locked: '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1'. Please remove the lock and try again.\""),
+			Expected: []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"},
+		},
+		{
+			Error:    fmt.Errorf("Code=\"ScopeLocked\" Message=\"The scope '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1' cannot perform write operation because following scope(s) are locked: '/subscriptions/00000000-0000-0000-0000-000000000000, /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1'. Please remove the lock and try again.\""),
+			Expected: []string{"/subscriptions/00000000-0000-0000-0000-000000000000", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"},
+		},
+	}
+
+	for _, tc := range cases {
+		scopes := parseScopeLockedError(tc.Error)
+
+		if len(scopes) != len(tc.Expected) {
+			t.Fatalf("Expected %d scopes but got %d for %q", len(tc.Expected), len(scopes), tc.Error)
+		}
+
+		for i, scope := range scopes {
+			if scope != tc.Expected[i] {
+				t.Fatalf("Expected scope %d to be %q but got %q", i, tc.Expected[i], scope)
+			}
+		}
+	}
+}
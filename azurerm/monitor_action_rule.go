@@ -0,0 +1,464 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// The Alerts Management Resource Provider isn't available in the version of the Azure SDK used by this Provider,
+// as such Action Rules are managed using the `resourcemanager` client - these are the models for the API
+// Version `2019-05-05-preview`.
+
+type monitorActionRule struct {
+	ID         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Location   *string                      `json:"location,omitempty"`
+	Tags       map[string]*string           `json:"tags,omitempty"`
+	Properties *monitorActionRuleProperties `json:"properties,omitempty"`
+}
+
+type monitorActionRuleProperties struct {
+	Type              string                              `json:"type"`
+	Scope             *monitorActionRuleScope             `json:"scope,omitempty"`
+	Conditions        *monitorActionRuleConditions        `json:"conditions,omitempty"`
+	Description       *string                             `json:"description,omitempty"`
+	Status            string                              `json:"status"`
+	SuppressionConfig *monitorActionRuleSuppressionConfig `json:"suppressionConfig,omitempty"`
+	ActionGroupID     *string                             `json:"actionGroupId,omitempty"`
+}
+
+type monitorActionRuleScope struct {
+	ScopeType string   `json:"scopeType"`
+	Values    []string `json:"values"`
+}
+
+type monitorActionRuleConditions struct {
+	AlertRuleID        *monitorActionRuleCondition `json:"alertRuleId,omitempty"`
+	Description        *monitorActionRuleCondition `json:"description,omitempty"`
+	MonitorCondition   *monitorActionRuleCondition `json:"monitorCondition,omitempty"`
+	MonitorService     *monitorActionRuleCondition `json:"monitorService,omitempty"`
+	Severity           *monitorActionRuleCondition `json:"severity,omitempty"`
+	TargetResourceType *monitorActionRuleCondition `json:"targetResourceType,omitempty"`
+}
+
+type monitorActionRuleCondition struct {
+	Operator string   `json:"operator"`
+	Values   []string `json:"values"`
+}
+
+type monitorActionRuleSuppressionConfig struct {
+	RecurrenceType string                                `json:"recurrenceType"`
+	Schedule       *monitorActionRuleSuppressionSchedule `json:"schedule,omitempty"`
+}
+
+type monitorActionRuleSuppressionSchedule struct {
+	StartDate        string `json:"startDate"`
+	EndDate          string `json:"endDate"`
+	StartTime        string `json:"startTime"`
+	EndTime          string `json:"endTime"`
+	RecurrenceValues []int  `json:"recurrenceValues,omitempty"`
+}
+
+const (
+	monitorActionRuleTypeActionGroup = "ActionGroup"
+	monitorActionRuleTypeSuppression = "Suppression"
+
+	// the Schedule of a Suppression is split into a Date and a Time, both of which are in UTC
+	monitorActionRuleScheduleDateLayout = "01/02/2006"
+	monitorActionRuleScheduleTimeLayout = "15:04:05"
+)
+
+var monitorActionRuleWeekDays = []string{
+	"Sunday",
+	"Monday",
+	"Tuesday",
+	"Wednesday",
+	"Thursday",
+	"Friday",
+	"Saturday",
+}
+
+func monitorActionRuleScopeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"ResourceGroup",
+						"Resource",
+					}, false),
+				},
+
+				"resource_ids": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+					Set: schema.HashString,
+				},
+			},
+		},
+	}
+}
+
+func monitorActionRuleConditionSchema() *schema.Schema {
+	equalityOperators := []string{"Equals", "NotEquals"}
+	containsOperators := []string{"Equals", "NotEquals", "Contains", "DoesNotContain"}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"alert_rule_id": monitorActionRuleConditionValueSchema(containsOperators, validation.NoZeroValues),
+
+				"description": monitorActionRuleConditionValueSchema(containsOperators, validation.NoZeroValues),
+
+				"monitor": monitorActionRuleConditionValueSchema(equalityOperators, validation.StringInSlice([]string{
+					"Fired",
+					"Resolved",
+				}, false)),
+
+				"monitor_service": monitorActionRuleConditionValueSchema(equalityOperators, validation.StringInSlice([]string{
+					"ActivityLog Administrative",
+					"ActivityLog Autoscale",
+					"ActivityLog Policy",
+					"ActivityLog Recommendation",
+					"ActivityLog Security",
+					"Application Insights",
+					"Azure Backup",
+					"Data Box Edge",
+					"Data Box Gateway",
+					"Health Platform",
+					"Log Analytics",
+					"Platform",
+					"Resource Health",
+				}, false)),
+
+				"severity": monitorActionRuleConditionValueSchema(equalityOperators, validation.StringInSlice([]string{
+					"Sev0",
+					"Sev1",
+					"Sev2",
+					"Sev3",
+					"Sev4",
+				}, false)),
+
+				"target_resource_type": monitorActionRuleConditionValueSchema(equalityOperators, validation.NoZeroValues),
+			},
+		},
+	}
+}
+
+func monitorActionRuleConditionValueSchema(operators []string, validateValue schema.SchemaValidateFunc) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"operator": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(operators, false),
+				},
+
+				"values": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateValue,
+					},
+					Set: schema.HashString,
+				},
+			},
+		},
+	}
+}
+
+func monitorActionRuleResourceID(subscriptionId, resourceGroup, name string) string {
+	return azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.AlertsManagement", "actionRules", name)
+}
+
+func monitorActionRuleStatus(enabled bool) string {
+	if enabled {
+		return "Enabled"
+	}
+
+	return "Disabled"
+}
+
+// retrieveMonitorActionRule returns the Action Rule with the specified ID, or nil if it doesn't exist
+func retrieveMonitorActionRule(meta interface{}, id string) (*monitorActionRule, error) {
+	client := meta.(*ArmClient).actionRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	var rule monitorActionRule
+	resp, err := client.Get(ctx, id, &rule)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func createOrUpdateMonitorActionRule(d *schema.ResourceData, meta interface{}, id string, rule monitorActionRule) error {
+	client := meta.(*ArmClient).actionRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	future, err := client.CreateOrUpdate(ctx, id, rule)
+	if err != nil {
+		return err
+	}
+
+	return waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id)
+}
+
+func deleteMonitorActionRule(meta interface{}, id string) error {
+	client := meta.(*ArmClient).actionRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	future, err := client.Delete(ctx, id)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return err
+	}
+
+	return waitForCompletion(ctx, &future.Future, client.Client)
+}
+
+func expandMonitorActionRuleScope(input []interface{}) *monitorActionRuleScope {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &monitorActionRuleScope{
+		ScopeType: v["type"].(string),
+		Values:    expandMonitorActionRuleValues(v["resource_ids"].(*schema.Set)),
+	}
+}
+
+func flattenMonitorActionRuleScope(input *monitorActionRuleScope) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         input.ScopeType,
+			"resource_ids": flattenMonitorActionRuleValues(input.Values),
+		},
+	}
+}
+
+func expandMonitorActionRuleConditions(input []interface{}) *monitorActionRuleConditions {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &monitorActionRuleConditions{
+		AlertRuleID:        expandMonitorActionRuleCondition(v["alert_rule_id"].([]interface{})),
+		Description:        expandMonitorActionRuleCondition(v["description"].([]interface{})),
+		MonitorCondition:   expandMonitorActionRuleCondition(v["monitor"].([]interface{})),
+		MonitorService:     expandMonitorActionRuleCondition(v["monitor_service"].([]interface{})),
+		Severity:           expandMonitorActionRuleCondition(v["severity"].([]interface{})),
+		TargetResourceType: expandMonitorActionRuleCondition(v["target_resource_type"].([]interface{})),
+	}
+}
+
+func expandMonitorActionRuleCondition(input []interface{}) *monitorActionRuleCondition {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &monitorActionRuleCondition{
+		Operator: v["operator"].(string),
+		Values:   expandMonitorActionRuleValues(v["values"].(*schema.Set)),
+	}
+}
+
+func flattenMonitorActionRuleConditions(input *monitorActionRuleConditions) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"alert_rule_id":        flattenMonitorActionRuleCondition(input.AlertRuleID),
+			"description":          flattenMonitorActionRuleCondition(input.Description),
+			"monitor":              flattenMonitorActionRuleCondition(input.MonitorCondition),
+			"monitor_service":      flattenMonitorActionRuleCondition(input.MonitorService),
+			"severity":             flattenMonitorActionRuleCondition(input.Severity),
+			"target_resource_type": flattenMonitorActionRuleCondition(input.TargetResourceType),
+		},
+	}
+}
+
+func flattenMonitorActionRuleCondition(input *monitorActionRuleCondition) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"operator": input.Operator,
+			"values":   flattenMonitorActionRuleValues(input.Values),
+		},
+	}
+}
+
+func expandMonitorActionRuleValues(input *schema.Set) []string {
+	values := make([]string, 0)
+	for _, v := range input.List() {
+		values = append(values, v.(string))
+	}
+
+	return values
+}
+
+func flattenMonitorActionRuleValues(input []string) *schema.Set {
+	values := make([]interface{}, 0)
+	for _, v := range input {
+		values = append(values, v)
+	}
+
+	return schema.NewSet(schema.HashString, values)
+}
+
+func expandMonitorActionRuleSuppressionConfig(input []interface{}) (*monitorActionRuleSuppressionConfig, error) {
+	v := input[0].(map[string]interface{})
+	recurrenceType := v["recurrence_type"].(string)
+	config := monitorActionRuleSuppressionConfig{
+		RecurrenceType: recurrenceType,
+	}
+
+	schedules := v["schedule"].([]interface{})
+	if recurrenceType == "Always" {
+		if len(schedules) > 0 {
+			return nil, fmt.Errorf("`schedule` cannot be specified when `recurrence_type` is `Always`")
+		}
+
+		return &config, nil
+	}
+
+	if len(schedules) == 0 || schedules[0] == nil {
+		return nil, fmt.Errorf("`schedule` must be specified when `recurrence_type` is %q", recurrenceType)
+	}
+
+	schedule := schedules[0].(map[string]interface{})
+	weekly := schedule["recurrence_weekly"].(*schema.Set).List()
+	monthly := schedule["recurrence_monthly"].(*schema.Set).List()
+
+	switch recurrenceType {
+	case "Weekly":
+		if len(weekly) == 0 || len(monthly) > 0 {
+			return nil, fmt.Errorf("only `recurrence_weekly` must be specified when `recurrence_type` is `Weekly`")
+		}
+	case "Monthly":
+		if len(monthly) == 0 || len(weekly) > 0 {
+			return nil, fmt.Errorf("only `recurrence_monthly` must be specified when `recurrence_type` is `Monthly`")
+		}
+	default:
+		if len(weekly) > 0 || len(monthly) > 0 {
+			return nil, fmt.Errorf("`recurrence_weekly` and `recurrence_monthly` cannot be specified when `recurrence_type` is %q", recurrenceType)
+		}
+	}
+
+	// these have been validated by the schema
+	startDate, _ := time.Parse(time.RFC3339, schedule["start_date_utc"].(string))
+	endDate, _ := time.Parse(time.RFC3339, schedule["end_date_utc"].(string))
+	startDate = startDate.UTC()
+	endDate = endDate.UTC()
+
+	recurrenceValues := make([]int, 0)
+	for _, day := range weekly {
+		for i, weekDay := range monitorActionRuleWeekDays {
+			if day.(string) == weekDay {
+				recurrenceValues = append(recurrenceValues, i)
+			}
+		}
+	}
+	for _, day := range monthly {
+		recurrenceValues = append(recurrenceValues, day.(int))
+	}
+
+	config.Schedule = &monitorActionRuleSuppressionSchedule{
+		StartDate:        startDate.Format(monitorActionRuleScheduleDateLayout),
+		EndDate:          endDate.Format(monitorActionRuleScheduleDateLayout),
+		StartTime:        startDate.Format(monitorActionRuleScheduleTimeLayout),
+		EndTime:          endDate.Format(monitorActionRuleScheduleTimeLayout),
+		RecurrenceValues: recurrenceValues,
+	}
+
+	return &config, nil
+}
+
+func flattenMonitorActionRuleSuppressionConfig(input *monitorActionRuleSuppressionConfig) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	schedules := make([]interface{}, 0)
+	if schedule := input.Schedule; schedule != nil {
+		weekly := make([]interface{}, 0)
+		monthly := make([]interface{}, 0)
+		for _, value := range schedule.RecurrenceValues {
+			switch input.RecurrenceType {
+			case "Weekly":
+				if value >= 0 && value < len(monitorActionRuleWeekDays) {
+					weekly = append(weekly, monitorActionRuleWeekDays[value])
+				}
+			case "Monthly":
+				monthly = append(monthly, value)
+			}
+		}
+
+		schedules = append(schedules, map[string]interface{}{
+			"start_date_utc":     flattenMonitorActionRuleScheduleTime(schedule.StartDate, schedule.StartTime),
+			"end_date_utc":       flattenMonitorActionRuleScheduleTime(schedule.EndDate, schedule.EndTime),
+			"recurrence_weekly":  schema.NewSet(schema.HashString, weekly),
+			"recurrence_monthly": schema.NewSet(set.HashInt, monthly),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"recurrence_type": input.RecurrenceType,
+			"schedule":        schedules,
+		},
+	}
+}
+
+func flattenMonitorActionRuleScheduleTime(date, timeOfDay string) string {
+	t, err := time.Parse(monitorActionRuleScheduleDateLayout+" "+monitorActionRuleScheduleTimeLayout, date+" "+timeOfDay)
+	if err != nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
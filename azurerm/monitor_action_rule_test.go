@@ -0,0 +1,177 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
+)
+
+func TestExpandMonitorActionRuleSuppressionConfig(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Input         map[string]interface{}
+		Expected      *monitorActionRuleSuppressionConfig
+		ExpectedError bool
+	}{
+		{
+			Name: "Always",
+			Input: map[string]interface{}{
+				"recurrence_type": "Always",
+				"schedule":        []interface{}{},
+			},
+			Expected: &monitorActionRuleSuppressionConfig{
+				RecurrenceType: "Always",
+			},
+		},
+		{
+			Name: "Always with a Schedule",
+			Input: map[string]interface{}{
+				"recurrence_type": "Always",
+				"schedule": []interface{}{
+					monitorActionRuleTestSchedule("2019-01-01T01:02:03Z", "2019-01-03T15:04:05Z", nil, nil),
+				},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "Daily without a Schedule",
+			Input: map[string]interface{}{
+				"recurrence_type": "Daily",
+				"schedule":        []interface{}{},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "Once in a different Time Zone",
+			Input: map[string]interface{}{
+				"recurrence_type": "Once",
+				"schedule": []interface{}{
+					monitorActionRuleTestSchedule("2019-01-01T01:02:03+01:00", "2019-01-03T15:04:05+01:00", nil, nil),
+				},
+			},
+			Expected: &monitorActionRuleSuppressionConfig{
+				RecurrenceType: "Once",
+				Schedule: &monitorActionRuleSuppressionSchedule{
+					StartDate:        "01/01/2019",
+					EndDate:          "01/03/2019",
+					StartTime:        "00:02:03",
+					EndTime:          "14:04:05",
+					RecurrenceValues: []int{},
+				},
+			},
+		},
+		{
+			Name: "Weekly",
+			Input: map[string]interface{}{
+				"recurrence_type": "Weekly",
+				"schedule": []interface{}{
+					monitorActionRuleTestSchedule("2019-01-01T01:02:03Z", "2019-01-03T15:04:05Z", []interface{}{"Sunday", "Saturday"}, nil),
+				},
+			},
+			Expected: &monitorActionRuleSuppressionConfig{
+				RecurrenceType: "Weekly",
+				Schedule: &monitorActionRuleSuppressionSchedule{
+					StartDate:        "01/01/2019",
+					EndDate:          "01/03/2019",
+					StartTime:        "01:02:03",
+					EndTime:          "15:04:05",
+					RecurrenceValues: []int{0, 6},
+				},
+			},
+		},
+		{
+			Name: "Weekly with Monthly values",
+			Input: map[string]interface{}{
+				"recurrence_type": "Weekly",
+				"schedule": []interface{}{
+					monitorActionRuleTestSchedule("2019-01-01T01:02:03Z", "2019-01-03T15:04:05Z", []interface{}{"Sunday"}, []interface{}{1}),
+				},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "Monthly",
+			Input: map[string]interface{}{
+				"recurrence_type": "Monthly",
+				"schedule": []interface{}{
+					monitorActionRuleTestSchedule("2019-01-01T01:02:03Z", "2019-01-03T15:04:05Z", nil, []interface{}{31}),
+				},
+			},
+			Expected: &monitorActionRuleSuppressionConfig{
+				RecurrenceType: "Monthly",
+				Schedule: &monitorActionRuleSuppressionSchedule{
+					StartDate:        "01/01/2019",
+					EndDate:          "01/03/2019",
+					StartTime:        "01:02:03",
+					EndTime:          "15:04:05",
+					RecurrenceValues: []int{31},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, err := expandMonitorActionRuleSuppressionConfig([]interface{}{tc.Input})
+			if tc.ExpectedError {
+				if err == nil {
+					t.Fatalf("Expected an error but didn't get one")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if !reflect.DeepEqual(tc.Expected, actual) {
+				t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenMonitorActionRuleSuppressionConfig(t *testing.T) {
+	input := &monitorActionRuleSuppressionConfig{
+		RecurrenceType: "Weekly",
+		Schedule: &monitorActionRuleSuppressionSchedule{
+			StartDate:        "01/01/2019",
+			EndDate:          "01/03/2019",
+			StartTime:        "01:02:03",
+			EndTime:          "15:04:05",
+			RecurrenceValues: []int{0, 6},
+		},
+	}
+
+	actual := flattenMonitorActionRuleSuppressionConfig(input)
+	if len(actual) != 1 {
+		t.Fatalf("Expected 1 item but got %d", len(actual))
+	}
+
+	schedule := actual[0].(map[string]interface{})["schedule"].([]interface{})[0].(map[string]interface{})
+	if v := schedule["start_date_utc"].(string); v != "2019-01-01T01:02:03Z" {
+		t.Fatalf("Expected `start_date_utc` to be `2019-01-01T01:02:03Z` but got %q", v)
+	}
+	if v := schedule["end_date_utc"].(string); v != "2019-01-03T15:04:05Z" {
+		t.Fatalf("Expected `end_date_utc` to be `2019-01-03T15:04:05Z` but got %q", v)
+	}
+
+	weekly := schedule["recurrence_weekly"].(*schema.Set)
+	if weekly.Len() != 2 || !weekly.Contains("Sunday") || !weekly.Contains("Saturday") {
+		t.Fatalf("Expected `recurrence_weekly` to contain `Sunday` and `Saturday` but got %+v", weekly.List())
+	}
+	if monthly := schedule["recurrence_monthly"].(*schema.Set); monthly.Len() != 0 {
+		t.Fatalf("Expected `recurrence_monthly` to be empty but got %+v", monthly.List())
+	}
+}
+
+func monitorActionRuleTestSchedule(start, end string, weekly, monthly []interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"start_date_utc":     start,
+		"end_date_utc":       end,
+		"recurrence_weekly":  schema.NewSet(schema.HashString, weekly),
+		"recurrence_monthly": schema.NewSet(set.HashInt, monthly),
+	}
+}
@@ -0,0 +1,79 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Metric Alerts which are scoped to multiple resources, a Resource Group or a Subscription aren't available in the
+// version of the Azure SDK used by this Provider, as such these are managed using the `resourcemanager` client -
+// these are the models for the API Version `2018-03-01`.
+
+const (
+	monitorMetricAlertSingleResourceCriteriaType   = "Microsoft.Azure.Monitor.SingleResourceMultipleMetricCriteria"
+	monitorMetricAlertMultipleResourceCriteriaType = "Microsoft.Azure.Monitor.MultipleResourceMultipleMetricCriteria"
+	monitorMetricAlertStaticThresholdCriterionType = "StaticThresholdCriterion"
+)
+
+type monitorMetricAlert struct {
+	ID         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Location   *string                       `json:"location,omitempty"`
+	Tags       map[string]*string            `json:"tags,omitempty"`
+	Properties *monitorMetricAlertProperties `json:"properties,omitempty"`
+}
+
+type monitorMetricAlertProperties struct {
+	Description          *string                     `json:"description,omitempty"`
+	Severity             *int32                      `json:"severity,omitempty"`
+	Enabled              *bool                       `json:"enabled,omitempty"`
+	Scopes               []string                    `json:"scopes"`
+	EvaluationFrequency  *string                     `json:"evaluationFrequency,omitempty"`
+	WindowSize           *string                     `json:"windowSize,omitempty"`
+	TargetResourceType   *string                     `json:"targetResourceType,omitempty"`
+	TargetResourceRegion *string                     `json:"targetResourceRegion,omitempty"`
+	Criteria             *monitorMetricAlertCriteria `json:"criteria,omitempty"`
+	AutoMitigate         *bool                       `json:"autoMitigate,omitempty"`
+	Actions              []monitorMetricAlertAction  `json:"actions"`
+}
+
+type monitorMetricAlertCriteria struct {
+	OdataType string                        `json:"odata.type"`
+	AllOf     []monitorMetricAlertCriterion `json:"allOf"`
+}
+
+type monitorMetricAlertCriterion struct {
+	CriterionType   string                        `json:"criterionType"`
+	Name            string                        `json:"name"`
+	MetricNamespace string                        `json:"metricNamespace,omitempty"`
+	MetricName      string                        `json:"metricName"`
+	TimeAggregation string                        `json:"timeAggregation"`
+	Operator        string                        `json:"operator"`
+	Threshold       float64                       `json:"threshold"`
+	Dimensions      []monitorMetricAlertDimension `json:"dimensions,omitempty"`
+}
+
+type monitorMetricAlertDimension struct {
+	Name     string   `json:"name"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values"`
+}
+
+type monitorMetricAlertAction struct {
+	ActionGroupID     string            `json:"actionGroupId"`
+	WebhookProperties map[string]string `json:"webHookProperties,omitempty"`
+}
+
+func monitorMetricAlertResourceID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/metricAlerts/%s", subscriptionId, resourceGroup, name)
+}
+
+// monitorMetricAlertIsSingleResource returns whether the Metric Alert is scoped to a single resource, which uses
+// different criteria to a Metric Alert scoped to multiple resources, a Resource Group or a Subscription
+func monitorMetricAlertIsSingleResource(scopes []string) bool {
+	if len(scopes) != 1 {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(scopes[0]), "/providers/")
+}
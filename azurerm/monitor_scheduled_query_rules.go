@@ -0,0 +1,50 @@
+package azurerm
+
+import (
+	"fmt"
+)
+
+// The Log to Metric Action for Scheduled Query Rules isn't available in the version of the Azure SDK used by this
+// Provider, as such these are managed using the `resourcemanager` client - these are the models for the API Version
+// `2018-04-16`.
+
+const monitorScheduledQueryRulesLogToMetricActionType = "Microsoft.WindowsAzure.Management.Monitoring.Alerts.Models.Microsoft.AppInsights.Nexus.DataContracts.Resources.ScheduledQueryRules.LogToMetricAction"
+
+type monitorScheduledQueryRule struct {
+	ID         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Location   *string                              `json:"location,omitempty"`
+	Tags       map[string]*string                   `json:"tags,omitempty"`
+	Properties *monitorScheduledQueryRuleProperties `json:"properties,omitempty"`
+}
+
+type monitorScheduledQueryRuleProperties struct {
+	Description *string                          `json:"description,omitempty"`
+	Enabled     string                           `json:"enabled"`
+	Source      *monitorScheduledQueryRuleSource `json:"source,omitempty"`
+	Action      *monitorScheduledQueryRuleAction `json:"action,omitempty"`
+}
+
+type monitorScheduledQueryRuleSource struct {
+	DataSourceID *string `json:"dataSourceId,omitempty"`
+}
+
+type monitorScheduledQueryRuleAction struct {
+	OdataType string                              `json:"odata.type"`
+	Criteria  []monitorScheduledQueryRuleCriteria `json:"criteria,omitempty"`
+}
+
+type monitorScheduledQueryRuleCriteria struct {
+	MetricName string                               `json:"metricName"`
+	Dimensions []monitorScheduledQueryRuleDimension `json:"dimensions,omitempty"`
+}
+
+type monitorScheduledQueryRuleDimension struct {
+	Name     string   `json:"name"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values"`
+}
+
+func monitorScheduledQueryRuleResourceID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/scheduledqueryrules/%s", subscriptionId, resourceGroup, name)
+}
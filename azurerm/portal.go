@@ -0,0 +1,13 @@
+package azurerm
+
+// The Portal Resource Provider isn't available in the version of the Azure SDK used by this Provider,
+// as such Dashboards are managed using the `resourcemanager` client - these are the models for the
+// API Version `2015-08-01-preview`.
+
+type portalDashboard struct {
+	ID         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Location   *string                `json:"location,omitempty"`
+	Tags       map[string]*string     `json:"tags"`
+	Properties map[string]interface{} `json:"properties"`
+}
@@ -9,13 +9,17 @@ import (
 	"log"
 	"strings"
 	"sync"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/batch"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 )
 
@@ -65,6 +69,12 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"skip_location_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_LOCATION_VALIDATION", false),
+			},
 			"use_msi": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -75,31 +85,101 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_MSI_ENDPOINT", ""),
 			},
+
+			"default_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateAzureRMTags,
+			},
+
+			"ignore_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+
+						"key_prefixes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+					},
+				},
+			},
+
+			"required_tag_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"required_tag_keys_exempted_resource_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"sub_resource_batch_window_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_SUB_RESOURCE_BATCH_WINDOW_SECONDS", 0),
+				ValidateFunc: validation.IntBetween(0, 60),
+			},
+
+			"features": features.Schema(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"azurerm_azuread_application":                   dataSourceArmAzureADApplication(),
 			"azurerm_azuread_service_principal":             dataSourceArmActiveDirectoryServicePrincipal(),
+			"azurerm_app_configuration_key":                 dataSourceArmAppConfigurationKey(),
 			"azurerm_application_security_group":            dataSourceArmApplicationSecurityGroup(),
 			"azurerm_app_service":                           dataSourceArmAppService(),
 			"azurerm_app_service_plan":                      dataSourceAppServicePlan(),
+			"azurerm_bgp_service_communities":               dataSourceArmBgpServiceCommunities(),
+			"azurerm_blueprint_definition":                  dataSourceArmBlueprintDefinition(),
+			"azurerm_blueprint_published_version":           dataSourceArmBlueprintPublishedVersion(),
 			"azurerm_builtin_role_definition":               dataSourceArmBuiltInRoleDefinition(),
 			"azurerm_cdn_profile":                           dataSourceArmCdnProfile(),
 			"azurerm_client_config":                         dataSourceArmClientConfig(),
 			"azurerm_cosmosdb_account":                      dataSourceArmCosmosDBAccount(),
 			"azurerm_container_registry":                    dataSourceArmContainerRegistry(),
 			"azurerm_data_lake_store":                       dataSourceArmDataLakeStoreAccount(),
+			"azurerm_data_lake_store_file":                  dataSourceArmDataLakeStoreFile(),
 			"azurerm_dns_zone":                              dataSourceArmDnsZone(),
 			"azurerm_eventhub_namespace":                    dataSourceEventHubNamespace(),
 			"azurerm_image":                                 dataSourceArmImage(),
 			"azurerm_key_vault":                             dataSourceArmKeyVault(),
 			"azurerm_key_vault_access_policy":               dataSourceArmKeyVaultAccessPolicy(),
+			"azurerm_key_vault_key_release":                 dataSourceArmKeyVaultKeyRelease(),
 			"azurerm_key_vault_secret":                      dataSourceArmKeyVaultSecret(),
 			"azurerm_kubernetes_cluster":                    dataSourceArmKubernetesCluster(),
+			"azurerm_location":                              dataSourceArmLocation(),
 			"azurerm_log_analytics_workspace":               dataSourceLogAnalyticsWorkspace(),
 			"azurerm_logic_app_workflow":                    dataSourceArmLogicAppWorkflow(),
 			"azurerm_managed_disk":                          dataSourceArmManagedDisk(),
 			"azurerm_management_group":                      dataSourceArmManagementGroup(),
+			"azurerm_metric_alertrule":                      dataSourceArmMetricAlertRule(),
+			"azurerm_monitor_metric_definitions":            dataSourceArmMonitorMetricDefinitions(),
+			"azurerm_naming":                                dataSourceArmNaming(),
 			"azurerm_network_interface":                     dataSourceArmNetworkInterface(),
 			"azurerm_network_security_group":                dataSourceArmNetworkSecurityGroup(),
 			"azurerm_notification_hub":                      dataSourceNotificationHub(),
@@ -108,10 +188,14 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_public_ip":                             dataSourceArmPublicIP(),
 			"azurerm_public_ips":                            dataSourceArmPublicIPs(),
 			"azurerm_recovery_services_vault":               dataSourceArmRecoveryServicesVault(),
+			"azurerm_resource_graph_query":                  dataSourceArmResourceGraphQuery(),
 			"azurerm_resource_group":                        dataSourceArmResourceGroup(),
+			"azurerm_resources":                             dataSourceArmResources(),
 			"azurerm_role_definition":                       dataSourceArmRoleDefinition(),
 			"azurerm_route_table":                           dataSourceArmRouteTable(),
 			"azurerm_scheduler_job_collection":              dataSourceArmSchedulerJobCollection(),
+			"azurerm_scheduler_jobs":                        dataSourceArmSchedulerJobs(),
+			"azurerm_search_service":                        dataSourceArmSearchService(),
 			"azurerm_snapshot":                              dataSourceArmSnapshot(),
 			"azurerm_storage_account":                       dataSourceArmStorageAccount(),
 			"azurerm_storage_account_sas":                   dataSourceArmStorageAccountSharedAccessSignature(),
@@ -124,152 +208,255 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"azurerm_azuread_application":                     resourceArmActiveDirectoryApplication(),
-			"azurerm_azuread_service_principal":               resourceArmActiveDirectoryServicePrincipal(),
-			"azurerm_azuread_service_principal_password":      resourceArmActiveDirectoryServicePrincipalPassword(),
-			"azurerm_application_gateway":                     resourceArmApplicationGateway(),
-			"azurerm_application_insights":                    resourceArmApplicationInsights(),
-			"azurerm_application_security_group":              resourceArmApplicationSecurityGroup(),
-			"azurerm_app_service":                             resourceArmAppService(),
-			"azurerm_app_service_plan":                        resourceArmAppServicePlan(),
-			"azurerm_app_service_active_slot":                 resourceArmAppServiceActiveSlot(),
-			"azurerm_app_service_custom_hostname_binding":     resourceArmAppServiceCustomHostnameBinding(),
-			"azurerm_app_service_slot":                        resourceArmAppServiceSlot(),
-			"azurerm_automation_account":                      resourceArmAutomationAccount(),
-			"azurerm_automation_credential":                   resourceArmAutomationCredential(),
-			"azurerm_automation_runbook":                      resourceArmAutomationRunbook(),
-			"azurerm_automation_schedule":                     resourceArmAutomationSchedule(),
-			"azurerm_autoscale_setting":                       resourceArmAutoScaleSetting(),
-			"azurerm_availability_set":                        resourceArmAvailabilitySet(),
-			"azurerm_cdn_endpoint":                            resourceArmCdnEndpoint(),
-			"azurerm_cdn_profile":                             resourceArmCdnProfile(),
-			"azurerm_container_registry":                      resourceArmContainerRegistry(),
-			"azurerm_container_service":                       resourceArmContainerService(),
-			"azurerm_container_group":                         resourceArmContainerGroup(),
-			"azurerm_cosmosdb_account":                        resourceArmCosmosDBAccount(),
-			"azurerm_data_lake_analytics_account":             resourceArmDataLakeAnalyticsAccount(),
-			"azurerm_data_lake_analytics_firewall_rule":       resourceArmDataLakeAnalyticsFirewallRule(),
-			"azurerm_data_lake_store":                         resourceArmDataLakeStore(),
-			"azurerm_data_lake_store_file":                    resourceArmDataLakeStoreFile(),
-			"azurerm_data_lake_store_firewall_rule":           resourceArmDataLakeStoreFirewallRule(),
-			"azurerm_dns_a_record":                            resourceArmDnsARecord(),
-			"azurerm_dns_aaaa_record":                         resourceArmDnsAAAARecord(),
-			"azurerm_dns_caa_record":                          resourceArmDnsCaaRecord(),
-			"azurerm_dns_cname_record":                        resourceArmDnsCNameRecord(),
-			"azurerm_dns_mx_record":                           resourceArmDnsMxRecord(),
-			"azurerm_dns_ns_record":                           resourceArmDnsNsRecord(),
-			"azurerm_dns_ptr_record":                          resourceArmDnsPtrRecord(),
-			"azurerm_dns_srv_record":                          resourceArmDnsSrvRecord(),
-			"azurerm_dns_txt_record":                          resourceArmDnsTxtRecord(),
-			"azurerm_dns_zone":                                resourceArmDnsZone(),
-			"azurerm_eventgrid_topic":                         resourceArmEventGridTopic(),
-			"azurerm_eventhub":                                resourceArmEventHub(),
-			"azurerm_eventhub_authorization_rule":             resourceArmEventHubAuthorizationRule(),
-			"azurerm_eventhub_consumer_group":                 resourceArmEventHubConsumerGroup(),
-			"azurerm_eventhub_namespace":                      resourceArmEventHubNamespace(),
-			"azurerm_eventhub_namespace_authorization_rule":   resourceArmEventHubNamespaceAuthorizationRule(),
-			"azurerm_express_route_circuit":                   resourceArmExpressRouteCircuit(),
-			"azurerm_express_route_circuit_authorization":     resourceArmExpressRouteCircuitAuthorization(),
-			"azurerm_express_route_circuit_peering":           resourceArmExpressRouteCircuitPeering(),
-			"azurerm_function_app":                            resourceArmFunctionApp(),
-			"azurerm_image":                                   resourceArmImage(),
-			"azurerm_iothub":                                  resourceArmIotHub(),
-			"azurerm_key_vault":                               resourceArmKeyVault(),
-			"azurerm_key_vault_access_policy":                 resourceArmKeyVaultAccessPolicy(),
-			"azurerm_key_vault_certificate":                   resourceArmKeyVaultCertificate(),
-			"azurerm_key_vault_key":                           resourceArmKeyVaultKey(),
-			"azurerm_key_vault_secret":                        resourceArmKeyVaultSecret(),
-			"azurerm_kubernetes_cluster":                      resourceArmKubernetesCluster(),
-			"azurerm_lb":                                      resourceArmLoadBalancer(),
-			"azurerm_lb_backend_address_pool":                 resourceArmLoadBalancerBackendAddressPool(),
-			"azurerm_lb_nat_rule":                             resourceArmLoadBalancerNatRule(),
-			"azurerm_lb_nat_pool":                             resourceArmLoadBalancerNatPool(),
-			"azurerm_lb_probe":                                resourceArmLoadBalancerProbe(),
-			"azurerm_lb_rule":                                 resourceArmLoadBalancerRule(),
-			"azurerm_local_network_gateway":                   resourceArmLocalNetworkGateway(),
-			"azurerm_log_analytics_solution":                  resourceArmLogAnalyticsSolution(),
-			"azurerm_log_analytics_workspace":                 resourceArmLogAnalyticsWorkspace(),
-			"azurerm_logic_app_action_custom":                 resourceArmLogicAppActionCustom(),
-			"azurerm_logic_app_action_http":                   resourceArmLogicAppActionHTTP(),
-			"azurerm_logic_app_trigger_custom":                resourceArmLogicAppTriggerCustom(),
-			"azurerm_logic_app_trigger_http_request":          resourceArmLogicAppTriggerHttpRequest(),
-			"azurerm_logic_app_trigger_recurrence":            resourceArmLogicAppTriggerRecurrence(),
-			"azurerm_logic_app_workflow":                      resourceArmLogicAppWorkflow(),
-			"azurerm_managed_disk":                            resourceArmManagedDisk(),
-			"azurerm_management_lock":                         resourceArmManagementLock(),
-			"azurerm_management_group":                        resourceArmManagementGroup(),
-			"azurerm_metric_alertrule":                        resourceArmMetricAlertRule(),
-			"azurerm_monitor_action_group":                    resourceArmMonitorActionGroup(),
-			"azurerm_mysql_configuration":                     resourceArmMySQLConfiguration(),
-			"azurerm_mysql_database":                          resourceArmMySqlDatabase(),
-			"azurerm_mysql_firewall_rule":                     resourceArmMySqlFirewallRule(),
-			"azurerm_mysql_server":                            resourceArmMySqlServer(),
-			"azurerm_network_interface":                       resourceArmNetworkInterface(),
-			"azurerm_network_security_group":                  resourceArmNetworkSecurityGroup(),
-			"azurerm_network_security_rule":                   resourceArmNetworkSecurityRule(),
-			"azurerm_network_watcher":                         resourceArmNetworkWatcher(),
-			"azurerm_notification_hub":                        resourceArmNotificationHub(),
-			"azurerm_notification_hub_authorization_rule":     resourceArmNotificationHubAuthorizationRule(),
-			"azurerm_notification_hub_namespace":              resourceArmNotificationHubNamespace(),
-			"azurerm_packet_capture":                          resourceArmPacketCapture(),
-			"azurerm_policy_assignment":                       resourceArmPolicyAssignment(),
-			"azurerm_policy_definition":                       resourceArmPolicyDefinition(),
-			"azurerm_postgresql_configuration":                resourceArmPostgreSQLConfiguration(),
-			"azurerm_postgresql_database":                     resourceArmPostgreSQLDatabase(),
-			"azurerm_postgresql_firewall_rule":                resourceArmPostgreSQLFirewallRule(),
-			"azurerm_postgresql_server":                       resourceArmPostgreSQLServer(),
-			"azurerm_postgresql_virtual_network_rule":         resourceArmPostgreSQLVirtualNetworkRule(),
-			"azurerm_public_ip":                               resourceArmPublicIp(),
-			"azurerm_relay_namespace":                         resourceArmRelayNamespace(),
-			"azurerm_recovery_services_vault":                 resourceArmRecoveryServicesVault(),
-			"azurerm_redis_cache":                             resourceArmRedisCache(),
-			"azurerm_redis_firewall_rule":                     resourceArmRedisFirewallRule(),
-			"azurerm_resource_group":                          resourceArmResourceGroup(),
-			"azurerm_role_assignment":                         resourceArmRoleAssignment(),
-			"azurerm_role_definition":                         resourceArmRoleDefinition(),
-			"azurerm_route":                                   resourceArmRoute(),
-			"azurerm_route_table":                             resourceArmRouteTable(),
-			"azurerm_search_service":                          resourceArmSearchService(),
-			"azurerm_servicebus_namespace":                    resourceArmServiceBusNamespace(),
-			"azurerm_servicebus_namespace_authorization_rule": resourceArmServiceBusNamespaceAuthorizationRule(),
-			"azurerm_servicebus_queue":                        resourceArmServiceBusQueue(),
-			"azurerm_servicebus_queue_authorization_rule":     resourceArmServiceBusQueueAuthorizationRule(),
-			"azurerm_servicebus_subscription":                 resourceArmServiceBusSubscription(),
-			"azurerm_servicebus_subscription_rule":            resourceArmServiceBusSubscriptionRule(),
-			"azurerm_servicebus_topic":                        resourceArmServiceBusTopic(),
-			"azurerm_servicebus_topic_authorization_rule":     resourceArmServiceBusTopicAuthorizationRule(),
-			"azurerm_service_fabric_cluster":                  resourceArmServiceFabricCluster(),
-			"azurerm_snapshot":                                resourceArmSnapshot(),
-			"azurerm_scheduler_job":                           resourceArmSchedulerJob(),
-			"azurerm_scheduler_job_collection":                resourceArmSchedulerJobCollection(),
-			"azurerm_sql_database":                            resourceArmSqlDatabase(),
-			"azurerm_sql_elasticpool":                         resourceArmSqlElasticPool(),
-			"azurerm_sql_firewall_rule":                       resourceArmSqlFirewallRule(),
-			"azurerm_sql_active_directory_administrator":      resourceArmSqlAdministrator(),
-			"azurerm_sql_server":                              resourceArmSqlServer(),
-			"azurerm_sql_virtual_network_rule":                resourceArmSqlVirtualNetworkRule(),
-			"azurerm_storage_account":                         resourceArmStorageAccount(),
-			"azurerm_storage_blob":                            resourceArmStorageBlob(),
-			"azurerm_storage_container":                       resourceArmStorageContainer(),
-			"azurerm_storage_share":                           resourceArmStorageShare(),
-			"azurerm_storage_queue":                           resourceArmStorageQueue(),
-			"azurerm_storage_table":                           resourceArmStorageTable(),
-			"azurerm_subnet":                                  resourceArmSubnet(),
-			"azurerm_template_deployment":                     resourceArmTemplateDeployment(),
-			"azurerm_traffic_manager_endpoint":                resourceArmTrafficManagerEndpoint(),
-			"azurerm_traffic_manager_profile":                 resourceArmTrafficManagerProfile(),
-			"azurerm_user_assigned_identity":                  resourceArmUserAssignedIdentity(),
-			"azurerm_virtual_machine":                         resourceArmVirtualMachine(),
-			"azurerm_virtual_machine_data_disk_attachment":    resourceArmVirtualMachineDataDiskAttachment(),
-			"azurerm_virtual_machine_extension":               resourceArmVirtualMachineExtensions(),
-			"azurerm_virtual_machine_scale_set":               resourceArmVirtualMachineScaleSet(),
-			"azurerm_virtual_network":                         resourceArmVirtualNetwork(),
-			"azurerm_virtual_network_gateway":                 resourceArmVirtualNetworkGateway(),
-			"azurerm_virtual_network_gateway_connection":      resourceArmVirtualNetworkGatewayConnection(),
-			"azurerm_virtual_network_peering":                 resourceArmVirtualNetworkPeering(),
+			"azurerm_azuread_application":                               resourceArmActiveDirectoryApplication(),
+			"azurerm_azuread_application_certificate":                   resourceArmActiveDirectoryApplicationCertificate(),
+			"azurerm_azuread_application_federated_identity_credential": resourceArmActiveDirectoryApplicationFederatedIdentityCredential(),
+			"azurerm_azuread_propagation_wait":                          resourceArmActiveDirectoryPropagationWait(),
+			"azurerm_azuread_service_principal":                         resourceArmActiveDirectoryServicePrincipal(),
+			"azurerm_azuread_service_principal_certificate":             resourceArmActiveDirectoryServicePrincipalCertificate(),
+			"azurerm_azuread_service_principal_password":                resourceArmActiveDirectoryServicePrincipalPassword(),
+			"azurerm_api_management":                                    resourceArmApiManagementService(),
+			"azurerm_api_management_api":                                resourceArmApiManagementApi(),
+			"azurerm_api_management_api_policy":                         resourceArmApiManagementApiPolicy(),
+			"azurerm_api_management_custom_domain":                      resourceArmApiManagementCustomDomain(),
+			"azurerm_api_management_product":                            resourceArmApiManagementProduct(),
+			"azurerm_api_management_product_policy":                     resourceArmApiManagementProductPolicy(),
+			"azurerm_api_management_subscription":                       resourceArmApiManagementSubscription(),
+			"azurerm_api_management_user":                               resourceArmApiManagementUser(),
+			"azurerm_application_gateway":                               resourceArmApplicationGateway(),
+			"azurerm_application_gateway_backend_pool":                  resourceArmApplicationGatewayBackendPool(),
+			"azurerm_application_gateway_http_listener":                 resourceArmApplicationGatewayHTTPListener(),
+			"azurerm_application_gateway_routing_rule":                  resourceArmApplicationGatewayRoutingRule(),
+			"azurerm_application_insights":                              resourceArmApplicationInsights(),
+			"azurerm_application_insights_analytics_item":               resourceArmApplicationInsightsAnalyticsItem(),
+			"azurerm_application_insights_api_key":                      resourceArmApplicationInsightsAPIKey(),
+			"azurerm_application_insights_web_test":                     resourceArmApplicationInsightsWebTest(),
+			"azurerm_application_security_group":                        resourceArmApplicationSecurityGroup(),
+			"azurerm_app_configuration":                                 resourceArmAppConfiguration(),
+			"azurerm_app_configuration_key":                             resourceArmAppConfigurationKey(),
+			"azurerm_app_service":                                       resourceArmAppService(),
+			"azurerm_app_service_plan":                                  resourceArmAppServicePlan(),
+			"azurerm_app_service_active_slot":                           resourceArmAppServiceActiveSlot(),
+			"azurerm_app_service_custom_hostname_binding":               resourceArmAppServiceCustomHostnameBinding(),
+			"azurerm_app_service_slot":                                  resourceArmAppServiceSlot(),
+			"azurerm_automation_account":                                resourceArmAutomationAccount(),
+			"azurerm_automation_credential":                             resourceArmAutomationCredential(),
+			"azurerm_automation_runbook":                                resourceArmAutomationRunbook(),
+			"azurerm_automation_schedule":                               resourceArmAutomationSchedule(),
+			"azurerm_automation_software_update_configuration":          resourceArmAutomationSoftwareUpdateConfiguration(),
+			"azurerm_autoscale_setting":                                 resourceArmAutoScaleSetting(),
+			"azurerm_availability_set":                                  resourceArmAvailabilitySet(),
+			"azurerm_batch_account":                                     resourceArmBatchAccount(),
+			"azurerm_batch_application":                                 resourceArmBatchApplication(),
+			"azurerm_batch_pool":                                        resourceArmBatchPool(),
+			"azurerm_blueprint_assignment":                              resourceArmBlueprintAssignment(),
+			"azurerm_cdn_endpoint":                                      resourceArmCdnEndpoint(),
+			"azurerm_cdn_endpoint_custom_domain":                        resourceArmCdnEndpointCustomDomain(),
+			"azurerm_cdn_profile":                                       resourceArmCdnProfile(),
+			"azurerm_cognitive_account":                                 resourceArmCognitiveAccount(),
+			"azurerm_consumption_budget":                                resourceArmConsumptionBudget(),
+			"azurerm_container_registry":                                resourceArmContainerRegistry(),
+			"azurerm_container_registry_task":                           resourceArmContainerRegistryTask(),
+			"azurerm_container_service":                                 resourceArmContainerService(),
+			"azurerm_container_group":                                   resourceArmContainerGroup(),
+			"azurerm_cosmosdb_account":                                  resourceArmCosmosDBAccount(),
+			"azurerm_dashboard":                                         resourceArmDashboard(),
+			"azurerm_data_factory":                                      resourceArmDataFactory(),
+			"azurerm_data_factory_dataset_azure_blob":                   resourceArmDataFactoryDatasetAzureBlob(),
+			"azurerm_data_factory_dataset_sql_server_table":             resourceArmDataFactoryDatasetSQLServerTable(),
+			"azurerm_data_factory_linked_service_azure_storage":         resourceArmDataFactoryLinkedServiceAzureStorage(),
+			"azurerm_data_factory_linked_service_key_vault":             resourceArmDataFactoryLinkedServiceKeyVault(),
+			"azurerm_data_factory_linked_service_sql_server":            resourceArmDataFactoryLinkedServiceSQLServer(),
+			"azurerm_data_factory_pipeline":                             resourceArmDataFactoryPipeline(),
+			"azurerm_data_factory_trigger_schedule":                     resourceArmDataFactoryTriggerSchedule(),
+			"azurerm_data_lake_analytics_account":                       resourceArmDataLakeAnalyticsAccount(),
+			"azurerm_data_lake_analytics_firewall_rule":                 resourceArmDataLakeAnalyticsFirewallRule(),
+			"azurerm_data_lake_store":                                   resourceArmDataLakeStore(),
+			"azurerm_data_lake_store_directory":                         resourceArmDataLakeStoreDirectory(),
+			"azurerm_data_lake_store_file":                              resourceArmDataLakeStoreFile(),
+			"azurerm_data_lake_store_file_acl":                          resourceArmDataLakeStoreFileACL(),
+			"azurerm_data_lake_store_firewall_rule":                     resourceArmDataLakeStoreFirewallRule(),
+			"azurerm_data_lake_store_folder_acl":                        resourceArmDataLakeStoreFolderACL(),
+			"azurerm_databricks_workspace":                              resourceArmDatabricksWorkspace(),
+			"azurerm_dev_test_lab":                                      resourceArmDevTestLab(),
+			"azurerm_dev_test_linux_virtual_machine":                    resourceArmDevTestLinuxVirtualMachine(),
+			"azurerm_dev_test_schedule":                                 resourceArmDevTestSchedule(),
+			"azurerm_dev_test_virtual_network":                          resourceArmDevTestVirtualNetwork(),
+			"azurerm_dev_test_windows_virtual_machine":                  resourceArmDevTestWindowsVirtualMachine(),
+			"azurerm_dns_a_record":                                      resourceArmDnsARecord(),
+			"azurerm_dns_aaaa_record":                                   resourceArmDnsAAAARecord(),
+			"azurerm_dns_caa_record":                                    resourceArmDnsCaaRecord(),
+			"azurerm_dns_cname_record":                                  resourceArmDnsCNameRecord(),
+			"azurerm_dns_mx_record":                                     resourceArmDnsMxRecord(),
+			"azurerm_dns_ns_record":                                     resourceArmDnsNsRecord(),
+			"azurerm_dns_ptr_record":                                    resourceArmDnsPtrRecord(),
+			"azurerm_dns_srv_record":                                    resourceArmDnsSrvRecord(),
+			"azurerm_dns_txt_record":                                    resourceArmDnsTxtRecord(),
+			"azurerm_dns_zone":                                          resourceArmDnsZone(),
+			"azurerm_eventgrid_topic":                                   resourceArmEventGridTopic(),
+			"azurerm_eventhub":                                          resourceArmEventHub(),
+			"azurerm_eventhub_authorization_rule":                       resourceArmEventHubAuthorizationRule(),
+			"azurerm_eventhub_cluster":                                  resourceArmEventHubCluster(),
+			"azurerm_eventhub_consumer_group":                           resourceArmEventHubConsumerGroup(),
+			"azurerm_eventhub_namespace":                                resourceArmEventHubNamespace(),
+			"azurerm_eventhub_namespace_disaster_recovery_config":       resourceArmEventHubNamespaceDisasterRecoveryConfig(),
+			"azurerm_eventhub_namespace_authorization_rule":             resourceArmEventHubNamespaceAuthorizationRule(),
+			"azurerm_express_route_circuit":                             resourceArmExpressRouteCircuit(),
+			"azurerm_express_route_circuit_authorization":               resourceArmExpressRouteCircuitAuthorization(),
+			"azurerm_express_route_circuit_peering":                     resourceArmExpressRouteCircuitPeering(),
+			"azurerm_function_app":                                      resourceArmFunctionApp(),
+			"azurerm_image":                                             resourceArmImage(),
+			"azurerm_iothub":                                            resourceArmIotHub(),
+			"azurerm_key_vault":                                         resourceArmKeyVault(),
+			"azurerm_key_vault_access_policy":                           resourceArmKeyVaultAccessPolicy(),
+			"azurerm_key_vault_certificate":                             resourceArmKeyVaultCertificate(),
+			"azurerm_key_vault_certificate_issuer":                      resourceArmKeyVaultCertificateIssuer(),
+			"azurerm_key_vault_key":                                     resourceArmKeyVaultKey(),
+			"azurerm_key_vault_key_rotation_policy":                     resourceArmKeyVaultKeyRotationPolicy(),
+			"azurerm_key_vault_managed_storage_account":                 resourceArmKeyVaultManagedStorageAccount(),
+			"azurerm_key_vault_managed_storage_sas_definition":          resourceArmKeyVaultManagedStorageSasDefinition(),
+			"azurerm_key_vault_network_rules":                           resourceArmKeyVaultNetworkRules(),
+			"azurerm_key_vault_secret":                                  resourceArmKeyVaultSecret(),
+			"azurerm_kubernetes_cluster":                                resourceArmKubernetesCluster(),
+			"azurerm_kusto_cluster":                                     resourceArmKustoCluster(),
+			"azurerm_kusto_database":                                    resourceArmKustoDatabase(),
+			"azurerm_kusto_eventhub_data_connection":                    resourceArmKustoEventHubDataConnection(),
+			"azurerm_lb":                                                resourceArmLoadBalancer(),
+			"azurerm_lb_backend_address_pool":                           resourceArmLoadBalancerBackendAddressPool(),
+			"azurerm_lb_nat_rule":                                       resourceArmLoadBalancerNatRule(),
+			"azurerm_lb_nat_pool":                                       resourceArmLoadBalancerNatPool(),
+			"azurerm_lb_probe":                                          resourceArmLoadBalancerProbe(),
+			"azurerm_lb_rule":                                           resourceArmLoadBalancerRule(),
+			"azurerm_local_network_gateway":                             resourceArmLocalNetworkGateway(),
+			"azurerm_log_analytics_cluster":                             resourceArmLogAnalyticsCluster(),
+			"azurerm_log_analytics_cluster_customer_managed_key":        resourceArmLogAnalyticsClusterCustomerManagedKey(),
+			"azurerm_log_analytics_linked_service":                      resourceArmLogAnalyticsLinkedService(),
+			"azurerm_log_analytics_solution":                            resourceArmLogAnalyticsSolution(),
+			"azurerm_log_analytics_workspace":                           resourceArmLogAnalyticsWorkspace(),
+			"azurerm_log_analytics_workspace_table":                     resourceArmLogAnalyticsWorkspaceTable(),
+			"azurerm_logic_app_action_custom":                           resourceArmLogicAppActionCustom(),
+			"azurerm_logic_app_action_http":                             resourceArmLogicAppActionHTTP(),
+			"azurerm_logic_app_trigger_custom":                          resourceArmLogicAppTriggerCustom(),
+			"azurerm_logic_app_trigger_http_request":                    resourceArmLogicAppTriggerHttpRequest(),
+			"azurerm_logic_app_trigger_recurrence":                      resourceArmLogicAppTriggerRecurrence(),
+			"azurerm_logic_app_workflow":                                resourceArmLogicAppWorkflow(),
+			"azurerm_managed_application":                               resourceArmManagedApplication(),
+			"azurerm_managed_application_definition":                    resourceArmManagedApplicationDefinition(),
+			"azurerm_managed_disk":                                      resourceArmManagedDisk(),
+			"azurerm_maps_account":                                      resourceArmMapsAccount(),
+			"azurerm_media_services_account":                            resourceArmMediaServicesAccount(),
+			"azurerm_management_lock":                                   resourceArmManagementLock(),
+			"azurerm_management_group":                                  resourceArmManagementGroup(),
+			"azurerm_metric_alertrule":                                  resourceArmMetricAlertRule(),
+			"azurerm_monitor_action_group":                              resourceArmMonitorActionGroup(),
+			"azurerm_monitor_action_rule_action_group":                  resourceArmMonitorActionRuleActionGroup(),
+			"azurerm_monitor_action_rule_suppression":                   resourceArmMonitorActionRuleSuppression(),
+			"azurerm_monitor_metric_alert":                              resourceArmMonitorMetricAlert(),
+			"azurerm_monitor_resource_health_alert":                     resourceArmMonitorResourceHealthAlert(),
+			"azurerm_monitor_scheduled_query_rules_alert":               resourceArmMonitorScheduledQueryRulesAlert(),
+			"azurerm_monitor_scheduled_query_rules_log":                 resourceArmMonitorScheduledQueryRulesLog(),
+			"azurerm_mysql_configuration":                               resourceArmMySQLConfiguration(),
+			"azurerm_mysql_database":                                    resourceArmMySqlDatabase(),
+			"azurerm_mysql_firewall_rule":                               resourceArmMySqlFirewallRule(),
+			"azurerm_mysql_server":                                      resourceArmMySqlServer(),
+			"azurerm_network_interface":                                 resourceArmNetworkInterface(),
+			"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
+			"azurerm_network_security_group":                        resourceArmNetworkSecurityGroup(),
+			"azurerm_network_security_rule":                         resourceArmNetworkSecurityRule(),
+			"azurerm_network_watcher":                               resourceArmNetworkWatcher(),
+			"azurerm_notification_hub":                              resourceArmNotificationHub(),
+			"azurerm_notification_hub_authorization_rule":           resourceArmNotificationHubAuthorizationRule(),
+			"azurerm_notification_hub_namespace":                    resourceArmNotificationHubNamespace(),
+			"azurerm_notification_hub_namespace_authorization_rule": resourceArmNotificationHubNamespaceAuthorizationRule(),
+			"azurerm_packet_capture":                                resourceArmPacketCapture(),
+			"azurerm_policy_assignment":                             resourceArmPolicyAssignment(),
+			"azurerm_policy_definition":                             resourceArmPolicyDefinition(),
+			"azurerm_postgresql_configuration":                      resourceArmPostgreSQLConfiguration(),
+			"azurerm_postgresql_database":                           resourceArmPostgreSQLDatabase(),
+			"azurerm_postgresql_firewall_rule":                      resourceArmPostgreSQLFirewallRule(),
+			"azurerm_postgresql_server":                             resourceArmPostgreSQLServer(),
+			"azurerm_postgresql_virtual_network_rule":               resourceArmPostgreSQLVirtualNetworkRule(),
+			"azurerm_proximity_placement_group":                     resourceArmProximityPlacementGroup(),
+			"azurerm_public_ip":                                     resourceArmPublicIp(),
+			"azurerm_relay_hybrid_connection":                       resourceArmRelayHybridConnection(),
+			"azurerm_relay_hybrid_connection_authorization_rule":    resourceArmRelayHybridConnectionAuthorizationRule(),
+			"azurerm_relay_namespace":                               resourceArmRelayNamespace(),
+			"azurerm_relay_namespace_authorization_rule":            resourceArmRelayNamespaceAuthorizationRule(),
+			"azurerm_recovery_services_vault":                       resourceArmRecoveryServicesVault(),
+			"azurerm_redis_cache":                                   resourceArmRedisCache(),
+			"azurerm_redis_firewall_rule":                           resourceArmRedisFirewallRule(),
+			"azurerm_resource_group":                                resourceArmResourceGroup(),
+			"azurerm_resource_move":                                 resourceArmResourceMove(),
+			"azurerm_role_assignment":                               resourceArmRoleAssignment(),
+			"azurerm_role_definition":                               resourceArmRoleDefinition(),
+			"azurerm_route":                                         resourceArmRoute(),
+			"azurerm_route_filter":                                  resourceArmRouteFilter(),
+			"azurerm_route_table":                                   resourceArmRouteTable(),
+			"azurerm_search_service":                                resourceArmSearchService(),
+			"azurerm_security_center_contact":                       resourceArmSecurityCenterContact(),
+			"azurerm_security_center_subscription_pricing":          resourceArmSecurityCenterSubscriptionPricing(),
+			"azurerm_security_center_workspace":                     resourceArmSecurityCenterWorkspace(),
+			"azurerm_servicebus_namespace":                          resourceArmServiceBusNamespace(),
+			"azurerm_servicebus_namespace_disaster_recovery_config": resourceArmServiceBusNamespaceDisasterRecoveryConfig(),
+			"azurerm_servicebus_namespace_authorization_rule":       resourceArmServiceBusNamespaceAuthorizationRule(),
+			"azurerm_servicebus_queue":                              resourceArmServiceBusQueue(),
+			"azurerm_servicebus_queue_authorization_rule":           resourceArmServiceBusQueueAuthorizationRule(),
+			"azurerm_servicebus_subscription":                       resourceArmServiceBusSubscription(),
+			"azurerm_servicebus_subscription_rule":                  resourceArmServiceBusSubscriptionRule(),
+			"azurerm_servicebus_topic":                              resourceArmServiceBusTopic(),
+			"azurerm_servicebus_topic_authorization_rule":           resourceArmServiceBusTopicAuthorizationRule(),
+			"azurerm_service_fabric_cluster":                        resourceArmServiceFabricCluster(),
+			"azurerm_shared_image":                                  resourceArmSharedImage(),
+			"azurerm_shared_image_gallery":                          resourceArmSharedImageGallery(),
+			"azurerm_shared_image_version":                          resourceArmSharedImageVersion(),
+			"azurerm_snapshot":                                      resourceArmSnapshot(),
+			"azurerm_scheduler_job":                                 resourceArmSchedulerJob(),
+			"azurerm_scheduler_job_collection":                      resourceArmSchedulerJobCollection(),
+			"azurerm_sql_database":                                  resourceArmSqlDatabase(),
+			"azurerm_sql_elasticpool":                               resourceArmSqlElasticPool(),
+			"azurerm_sql_firewall_rule":                             resourceArmSqlFirewallRule(),
+			"azurerm_sql_active_directory_administrator":            resourceArmSqlAdministrator(),
+			"azurerm_sql_server":                                    resourceArmSqlServer(),
+			"azurerm_sql_virtual_network_rule":                      resourceArmSqlVirtualNetworkRule(),
+			"azurerm_storage_account":                               resourceArmStorageAccount(),
+			"azurerm_storage_blob":                                  resourceArmStorageBlob(),
+			"azurerm_storage_container":                             resourceArmStorageContainer(),
+			"azurerm_storage_share":                                 resourceArmStorageShare(),
+			"azurerm_storage_queue":                                 resourceArmStorageQueue(),
+			"azurerm_storage_table":                                 resourceArmStorageTable(),
+			"azurerm_subnet":                                        resourceArmSubnet(),
+			"azurerm_subscription_tags":                             resourceArmSubscriptionTags(),
+			"azurerm_subscription_template_deployment":              resourceArmSubscriptionTemplateDeployment(),
+			"azurerm_template_deployment":                           resourceArmTemplateDeployment(),
+			"azurerm_tenant_template_deployment":                    resourceArmTenantTemplateDeployment(),
+			"azurerm_traffic_manager_endpoint":                      resourceArmTrafficManagerEndpoint(),
+			"azurerm_traffic_manager_profile":                       resourceArmTrafficManagerProfile(),
+			"azurerm_user_assigned_identity":                        resourceArmUserAssignedIdentity(),
+			"azurerm_virtual_hub":                                   resourceArmVirtualHub(),
+			"azurerm_virtual_hub_connection":                        resourceArmVirtualHubConnection(),
+			"azurerm_virtual_machine":                               resourceArmVirtualMachine(),
+			"azurerm_virtual_machine_data_disk_attachment":          resourceArmVirtualMachineDataDiskAttachment(),
+			"azurerm_virtual_machine_disk_encryption":               resourceArmVirtualMachineDiskEncryption(),
+			"azurerm_virtual_machine_extension":                     resourceArmVirtualMachineExtensions(),
+			"azurerm_virtual_machine_run_command":                   resourceArmVirtualMachineRunCommand(),
+			"azurerm_virtual_machine_scale_set":                     resourceArmVirtualMachineScaleSet(),
+			"azurerm_virtual_network":                               resourceArmVirtualNetwork(),
+			"azurerm_virtual_network_gateway":                       resourceArmVirtualNetworkGateway(),
+			"azurerm_virtual_network_gateway_connection":            resourceArmVirtualNetworkGatewayConnection(),
+			"azurerm_virtual_network_peering":                       resourceArmVirtualNetworkPeering(),
+			"azurerm_virtual_wan":                                   resourceArmVirtualWan(),
+			"azurerm_vpn_gateway":                                   resourceArmVpnGateway(),
+			"azurerm_vpn_gateway_connection":                        resourceArmVpnGatewayConnection(),
+			"azurerm_vpn_site":                                      resourceArmVpnSite(),
+			"azurerm_web_application_firewall_policy":               resourceArmWebApplicationFirewallPolicy(),
+			"azurerm_webhook_notification":                          resourceArmWebhookNotification(),
 		},
 	}
 
+	wrapResourcesWithManagementLockErrors(p.ResourcesMap)
+	wrapResourcesWithProviderTags(p.ResourcesMap)
+	wrapResourcesWithLocationValidation(p.ResourcesMap)
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
@@ -325,6 +512,20 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 		}
 
 		client.StopContext = p.StopContext()
+		client.defaultTags = d.Get("default_tags").(map[string]interface{})
+		if v := d.Get("ignore_tags").([]interface{}); len(v) > 0 && v[0] != nil {
+			ignoreTags := v[0].(map[string]interface{})
+			client.ignoreTagKeys = expandProviderTagsList(ignoreTags["keys"].([]interface{}))
+			client.ignoreTagKeyPrefixes = expandProviderTagsList(ignoreTags["key_prefixes"].([]interface{}))
+		}
+		client.requiredTagKeys = expandProviderTagsList(d.Get("required_tag_keys").([]interface{}))
+		client.requiredTagKeysExemptedResourceTypes = expandProviderTagsList(d.Get("required_tag_keys_exempted_resource_types").([]interface{}))
+		client.features = features.Expand(d.Get("features").([]interface{}))
+		client.skipLocationValidation = d.Get("skip_location_validation").(bool)
+
+		// changes to sub-resources of the same parent (e.g. Load Balancer Rules) are coalesced into a single update
+		batchWindow := time.Duration(d.Get("sub_resource_batch_window_seconds").(int)) * time.Second
+		client.subResourceBatcher = batch.New(batchWindow, armMutexKV)
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
@@ -366,34 +567,50 @@ func registerProviderWithSubscription(ctx context.Context, providerName string,
 
 func determineAzureResourceProvidersToRegister(providerList []resources.Provider) map[string]struct{} {
 	providers := map[string]struct{}{
+		"Microsoft.AlertsManagement":    {},
+		"Microsoft.ApiManagement":       {},
+		"Microsoft.AppConfiguration":    {},
 		"Microsoft.Authorization":       {},
 		"Microsoft.Automation":          {},
+		"Microsoft.Batch":               {},
+		"Microsoft.Blueprint":           {},
 		"Microsoft.Cache":               {},
 		"Microsoft.Cdn":                 {},
+		"Microsoft.CognitiveServices":   {},
 		"Microsoft.Compute":             {},
+		"Microsoft.Consumption":         {},
 		"Microsoft.ContainerInstance":   {},
 		"Microsoft.ContainerRegistry":   {},
 		"Microsoft.ContainerService":    {},
+		"Microsoft.Databricks":          {},
+		"Microsoft.DataFactory":         {},
 		"Microsoft.DataLakeStore":       {},
 		"Microsoft.DBforMySQL":          {},
 		"Microsoft.DBforPostgreSQL":     {},
 		"Microsoft.Devices":             {},
+		"Microsoft.DevTestLab":          {},
 		"Microsoft.DocumentDB":          {},
 		"Microsoft.EventGrid":           {},
 		"Microsoft.EventHub":            {},
 		"Microsoft.KeyVault":            {},
+		"Microsoft.Kusto":               {},
 		"microsoft.insights":            {},
 		"Microsoft.Logic":               {},
 		"Microsoft.ManagedIdentity":     {},
+		"Microsoft.Maps":                {},
+		"Microsoft.Media":               {},
 		"Microsoft.Management":          {},
 		"Microsoft.Network":             {},
 		"Microsoft.NotificationHubs":    {},
 		"Microsoft.OperationalInsights": {},
+		"Microsoft.Portal":              {},
 		"Microsoft.Relay":               {},
 		"Microsoft.Resources":           {},
 		"Microsoft.Search":              {},
+		"Microsoft.Security":            {},
 		"Microsoft.ServiceBus":          {},
 		"Microsoft.ServiceFabric":       {},
+		"Microsoft.Solutions":           {},
 		"Microsoft.Sql":                 {},
 		"Microsoft.Storage":             {},
 	}
@@ -0,0 +1,166 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-06-01/subscriptions"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// wrapResourcesWithLocationValidation decorates each resource which exposes a top-level `location` field (using
+// `locationSchema`) with a CustomizeDiff which checks the location is available to the Subscription - such that a
+// typo is caught at plan time (alongside a suggestion for the nearest match) rather than when the resource is created.
+func wrapResourcesWithLocationValidation(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		location, ok := r.Schema["location"]
+		if !ok || location.Type != schema.TypeString || location.Computed || location.StateFunc == nil {
+			continue
+		}
+
+		r.CustomizeDiff = withLocationValidation(name, r.CustomizeDiff)
+	}
+}
+
+func withLocationValidation(resourceType string, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if f != nil {
+			if err := f(diff, meta); err != nil {
+				return err
+			}
+		}
+
+		client, ok := meta.(*ArmClient)
+		if !ok || client.skipLocationValidation {
+			return nil
+		}
+
+		// existing resources have already been validated
+		if diff.Id() != "" && !diff.HasChange("location") {
+			return nil
+		}
+
+		// computed values are read as an empty string - and are checked once they're known
+		location := diff.Get("location").(string)
+		if location == "" || location == config.UnknownVariableValue {
+			return nil
+		}
+
+		locations := client.availableLocations()
+		if len(locations) == 0 {
+			return nil
+		}
+
+		if err := validateLocationIsAvailable(location, locations); err != nil {
+			return fmt.Errorf("`location` for %q: %+v", resourceType, err)
+		}
+
+		return nil
+	}
+}
+
+// availableLocations returns the locations available to the Subscription, which are retrieved once per run -
+// if these can't be retrieved (for example due to permissions) the locations aren't validated
+func (c *ArmClient) availableLocations() []subscriptions.Location {
+	c.availableLocationsOnce.Do(func() {
+		resp, err := c.subscriptionsClient.ListLocations(c.StopContext, c.subscriptionId)
+		if err != nil {
+			log.Printf("[WARN] Unable to list the Locations available to Subscription %q - Locations won't be validated: %+v", c.subscriptionId, err)
+			return
+		}
+
+		if resp.Value != nil {
+			c.availableLocationsCache = *resp.Value
+		}
+	})
+
+	return c.availableLocationsCache
+}
+
+// validateLocationIsAvailable checks the location matches either the Name (e.g. `westeurope`) or the Display
+// Name (e.g. `West Europe`) of one of the available locations, suggesting the nearest match if it doesn't
+func validateLocationIsAvailable(location string, locations []subscriptions.Location) error {
+	normalized := azureRMNormalizeLocation(location)
+
+	// resources which aren't tied to a region (e.g. DNS Zones) use the `global` location
+	if normalized == "global" {
+		return nil
+	}
+
+	names := make([]string, 0)
+	for _, l := range locations {
+		if l.Name == nil {
+			continue
+		}
+
+		if normalized == azureRMNormalizeLocation(*l.Name) {
+			return nil
+		}
+		if l.DisplayName != nil && normalized == azureRMNormalizeLocation(*l.DisplayName) {
+			return nil
+		}
+
+		names = append(names, *l.Name)
+	}
+
+	if suggestion := closestLocation(normalized, names); suggestion != "" {
+		return fmt.Errorf("%q isn't available to this Subscription - did you mean %q?", location, suggestion)
+	}
+
+	sort.Strings(names)
+	return fmt.Errorf("%q isn't available to this Subscription - possible values are %s", location, strings.Join(names, ", "))
+}
+
+// closestLocation returns the location name with the smallest edit distance from the input,
+// providing it's close enough to be a likely typo
+func closestLocation(input string, names []string) string {
+	closest := ""
+	closestDistance := -1
+	for _, name := range names {
+		distance := levenshteinDistance(input, name)
+		if closestDistance == -1 || distance < closestDistance || (distance == closestDistance && name < closest) {
+			closest = name
+			closestDistance = distance
+		}
+	}
+
+	// a third of the characters being different is considered a typo, more than that is a different location
+	if closestDistance == -1 || closestDistance > len(input)/3+1 {
+		return ""
+	}
+
+	return closest
+}
+
+func levenshteinDistance(first, second string) int {
+	previous := make([]int, len(second)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(first); i++ {
+		current := make([]int, len(second)+1)
+		current[0] = i
+		for j := 1; j <= len(second); j++ {
+			cost := 1
+			if first[i-1] == second[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(minInt(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous = current
+	}
+
+	return previous[len(second)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,115 @@
+package azurerm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-06-01/subscriptions"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateLocationIsAvailable(t *testing.T) {
+	locations := []subscriptions.Location{
+		{
+			Name:        utils.String("westeurope"),
+			DisplayName: utils.String("West Europe"),
+		},
+		{
+			Name:        utils.String("westus2"),
+			DisplayName: utils.String("West US 2"),
+		},
+		{
+			Name:        utils.String("southeastasia"),
+			DisplayName: utils.String("Southeast Asia"),
+		},
+	}
+
+	cases := []struct {
+		Location      string
+		ExpectError   bool
+		ErrorContains string
+	}{
+		{
+			Location:    "westeurope",
+			ExpectError: false,
+		},
+		{
+			Location:    "West Europe",
+			ExpectError: false,
+		},
+		{
+			Location:    "WestUS2",
+			ExpectError: false,
+		},
+		{
+			Location:    "global",
+			ExpectError: false,
+		},
+		{
+			Location:      "westeurpoe",
+			ExpectError:   true,
+			ErrorContains: `did you mean "westeurope"?`,
+		},
+		{
+			Location:      "West US 3",
+			ExpectError:   true,
+			ErrorContains: `did you mean "westus2"?`,
+		},
+		{
+			Location:      "moon",
+			ExpectError:   true,
+			ErrorContains: "possible values are southeastasia, westeurope, westus2",
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateLocationIsAvailable(tc.Location, locations)
+		if tc.ExpectError != (err != nil) {
+			t.Fatalf("Expected an error to be %t for %q but got: %+v", tc.ExpectError, tc.Location, err)
+		}
+
+		if err != nil && !strings.Contains(err.Error(), tc.ErrorContains) {
+			t.Fatalf("Expected the error for %q to contain %q but got: %+v", tc.Location, tc.ErrorContains, err)
+		}
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	cases := []struct {
+		First    string
+		Second   string
+		Expected int
+	}{
+		{
+			First:    "",
+			Second:   "",
+			Expected: 0,
+		},
+		{
+			First:    "westeurope",
+			Second:   "",
+			Expected: 10,
+		},
+		{
+			First:    "westeurope",
+			Second:   "westeurope",
+			Expected: 0,
+		},
+		{
+			First:    "westeurpoe",
+			Second:   "westeurope",
+			Expected: 2,
+		},
+		{
+			First:    "eastus",
+			Second:   "eastus2",
+			Expected: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := levenshteinDistance(tc.First, tc.Second); actual != tc.Expected {
+			t.Fatalf("Expected the distance between %q and %q to be %d but got %d", tc.First, tc.Second, tc.Expected, actual)
+		}
+	}
+}
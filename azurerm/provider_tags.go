@@ -0,0 +1,197 @@
+package azurerm
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// wrapResourcesWithProviderTags adds a computed `tags_all` field to each resource which exposes a `tags` field, containing
+// all of the tags assigned to the resource - including those applied from the `default_tags` configured on the Provider,
+// which are merged into the tags sent to Azure by expandTags (and removed from `tags` by flattenAndSetTags, unless
+// they're also defined on the resource). Since the `default_tags` aren't a part of the resource's configuration, the
+// `tags_all` are computed during the plan - such that a change to the `default_tags` shows as a diff to each resource.
+func wrapResourcesWithProviderTags(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		tags, ok := r.Schema["tags"]
+		if !ok || !resourceSupportsProviderTags(tags) {
+			continue
+		}
+
+		r.Schema["tags_all"] = &schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+		}
+		r.CustomizeDiff = withProviderTags(name, tags.ForceNew, r.CustomizeDiff)
+	}
+}
+
+func resourceSupportsProviderTags(tags *schema.Schema) bool {
+	return tags.Type == schema.TypeMap && tags.Optional && tags.Computed
+}
+
+// withProviderTags computes the `tags_all` for the resource, and ensures these contain each of the `required_tag_keys`
+// unless the resource type has been exempted. Resources where the tags can't be updated in-place only receive the
+// `default_tags` when they're created, since changing these would otherwise recreate the resource.
+func withProviderTags(resourceType string, tagsForceNew bool, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if f != nil {
+			if err := f(diff, meta); err != nil {
+				return err
+			}
+		}
+
+		client, ok := meta.(*ArmClient)
+		if !ok {
+			return nil
+		}
+
+		updatable := diff.Id() == "" || !tagsForceNew
+
+		tags, known := resourceDiffTags(diff)
+		if !known {
+			// the tags will be checked once they're known
+			if updatable {
+				return diff.SetNewComputed("tags_all")
+			}
+
+			return nil
+		}
+
+		merged := mergeDefaultTags(client.defaultTags, tags)
+
+		requiredTagKeys := client.requiredTagKeys
+		for _, v := range client.requiredTagKeysExemptedResourceTypes {
+			if v == resourceType {
+				requiredTagKeys = nil
+				break
+			}
+		}
+		if missing := missingRequiredTagKeys(requiredTagKeys, merged); len(missing) > 0 {
+			return fmt.Errorf("`tags` for %q must contain the required tag keys %q - missing: %q", resourceType, requiredTagKeys, missing)
+		}
+
+		if !updatable {
+			return nil
+		}
+
+		merged = filterIgnoredTags(merged, client.ignoreTagKeys, client.ignoreTagKeyPrefixes)
+		if existing, ok := diff.Get("tags_all").(map[string]interface{}); ok && diff.Id() != "" && reflect.DeepEqual(existing, merged) {
+			return nil
+		}
+
+		return diff.SetNew("tags_all", merged)
+	}
+}
+
+// filterIgnoredTags returns the `tags` without any keys which either match one of the `keys`, or start with one of
+// the `prefixes` - both of which are compared case-insensitively, since tag names in Azure are case-insensitive.
+func filterIgnoredTags(tags map[string]interface{}, keys []string, prefixes []string) map[string]interface{} {
+	output := make(map[string]interface{}, len(tags))
+
+	for k, v := range tags {
+		if tagKeyIsIgnored(k, keys, prefixes) {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+func tagKeyIsIgnored(key string, keys []string, prefixes []string) bool {
+	for _, v := range keys {
+		if strings.EqualFold(key, v) {
+			return true
+		}
+	}
+
+	for _, v := range prefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mergeDefaultTags returns the `defaultTags` merged with the `tags` defined on the resource, where
+// the tags defined on the resource take precedence over the Default Tags.
+// resourceDiffTags returns the `tags` for the resource from the diff, and whether these are known. The ResourceDiff
+// doesn't expose whether a value is known - and helper/schema panics when reading a map which isn't known until apply
+// (since the value from the configuration is nil), so this is recovered from rather than crashing the plan.
+func resourceDiffTags(diff *schema.ResourceDiff) (tags map[string]interface{}, known bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			tags, known = nil, false
+		}
+	}()
+
+	tags, _ = diff.Get("tags").(map[string]interface{})
+	return tags, true
+}
+
+func mergeDefaultTags(defaultTags map[string]interface{}, tags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaultTags)+len(tags))
+
+	for k, v := range defaultTags {
+		value, _ := tagValueToString(v)
+		output[k] = value
+	}
+
+	for k, v := range tags {
+		value, _ := tagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
+
+// removeDefaultTags returns the `tags` without the keys from the `defaultTags`, other than those which are also
+// defined in the `configured` tags for the resource.
+func removeDefaultTags(tags map[string]interface{}, defaultTags map[string]interface{}, configured map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tags))
+
+	for k, v := range tags {
+		if _, isDefault := defaultTags[k]; isDefault {
+			if _, isConfigured := configured[k]; !isConfigured {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// missingRequiredTagKeys returns the sorted list of `requiredTagKeys` which aren't present in `tags` - since tag
+// names in Azure are case-insensitive these are compared case-insensitively.
+func missingRequiredTagKeys(requiredTagKeys []string, tags map[string]interface{}) []string {
+	keys := make(map[string]struct{}, len(tags))
+	for k := range tags {
+		keys[strings.ToLower(k)] = struct{}{}
+	}
+
+	missing := make([]string, 0)
+	for _, k := range requiredTagKeys {
+		if _, ok := keys[strings.ToLower(k)]; !ok {
+			missing = append(missing, k)
+		}
+	}
+
+	sort.Strings(missing)
+	return missing
+}
+
+func expandProviderTagsList(input []interface{}) []string {
+	output := make([]string, 0)
+	for _, v := range input {
+		output = append(output, v.(string))
+	}
+	return output
+}
@@ -0,0 +1,463 @@
+package azurerm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	cases := []struct {
+		DefaultTags map[string]interface{}
+		Tags        map[string]interface{}
+		Expected    map[string]interface{}
+	}{
+		{
+			DefaultTags: map[string]interface{}{},
+			Tags:        map[string]interface{}{},
+			Expected:    map[string]interface{}{},
+		},
+		{
+			DefaultTags: map[string]interface{}{
+				"cost_center": "MSFT",
+			},
+			Tags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"cost_center": "MSFT",
+			},
+		},
+		{
+			DefaultTags: map[string]interface{}{
+				"cost_center": "MSFT",
+				"environment": "Production",
+			},
+			Tags: map[string]interface{}{
+				"environment": "staging",
+				"owner":       "terraform",
+			},
+			Expected: map[string]interface{}{
+				"cost_center": "MSFT",
+				"environment": "staging",
+				"owner":       "terraform",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		actual := mergeDefaultTags(v.DefaultTags, v.Tags)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	cases := []struct {
+		Tags        map[string]interface{}
+		DefaultTags map[string]interface{}
+		Configured  map[string]interface{}
+		Expected    map[string]interface{}
+	}{
+		{
+			Tags: map[string]interface{}{
+				"environment": "Production",
+			},
+			Expected: map[string]interface{}{
+				"environment": "Production",
+			},
+		},
+		{
+			Tags: map[string]interface{}{
+				"cost_center": "MSFT",
+				"environment": "staging",
+			},
+			DefaultTags: map[string]interface{}{
+				"cost_center": "MSFT",
+			},
+			Configured: map[string]interface{}{
+				"environment": "staging",
+			},
+			Expected: map[string]interface{}{
+				"environment": "staging",
+			},
+		},
+		{
+			Tags: map[string]interface{}{
+				"cost_center": "MSFT",
+				"environment": "staging",
+			},
+			DefaultTags: map[string]interface{}{
+				"cost_center": "MSFT",
+				"environment": "Production",
+			},
+			Configured: map[string]interface{}{
+				"environment": "staging",
+			},
+			Expected: map[string]interface{}{
+				"environment": "staging",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		actual := removeDefaultTags(v.Tags, v.DefaultTags, v.Configured)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func testProviderTagsResource(resourceType string, tags *schema.Schema) *schema.Resource {
+	resources := map[string]*schema.Resource{
+		resourceType: {
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"tags": tags,
+			},
+		},
+	}
+
+	wrapResourcesWithProviderTags(resources)
+	return resources[resourceType]
+}
+
+func TestProviderTags_tagsAll(t *testing.T) {
+	meta := &ArmClient{
+		defaultTags: map[string]interface{}{
+			"cost_center": "MSFT",
+			"environment": "Production",
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Schema   *schema.Schema
+		State    *terraform.InstanceState
+		Config   map[string]interface{}
+		Computed []string
+		Expected map[string]string
+	}{
+		{
+			Name:   "No Tags",
+			Schema: tagsSchema(),
+			Config: map[string]interface{}{
+				"name": "example",
+			},
+			Expected: map[string]string{
+				"tags_all.%":           "2",
+				"tags_all.cost_center": "MSFT",
+				"tags_all.environment": "Production",
+			},
+		},
+		{
+			Name:   "Overridden Tags",
+			Schema: tagsSchema(),
+			Config: map[string]interface{}{
+				"name": "example",
+				"tags": map[string]interface{}{
+					"environment": "staging",
+					"owner":       "terraform",
+				},
+			},
+			Expected: map[string]string{
+				"tags_all.%":           "3",
+				"tags_all.cost_center": "MSFT",
+				"tags_all.environment": "staging",
+				"tags_all.owner":       "terraform",
+			},
+		},
+		{
+			Name:   "Removed Default Tag",
+			Schema: tagsSchema(),
+			State: &terraform.InstanceState{
+				ID: "example",
+				Attributes: map[string]string{
+					"id":                   "example",
+					"name":                 "example",
+					"tags.%":               "0",
+					"tags_all.%":           "3",
+					"tags_all.cost_center": "MSFT",
+					"tags_all.environment": "Production",
+					"tags_all.legacy":      "true",
+				},
+			},
+			Config: map[string]interface{}{
+				"name": "example",
+			},
+			Expected: map[string]string{
+				"tags_all.%":      "2",
+				"tags_all.legacy": "",
+			},
+		},
+		{
+			Name:   "Unchanged",
+			Schema: tagsSchema(),
+			State: &terraform.InstanceState{
+				ID: "example",
+				Attributes: map[string]string{
+					"id":                   "example",
+					"name":                 "example",
+					"tags.%":               "0",
+					"tags_all.%":           "2",
+					"tags_all.cost_center": "MSFT",
+					"tags_all.environment": "Production",
+				},
+			},
+			Config: map[string]interface{}{
+				"name": "example",
+			},
+			Expected: map[string]string{},
+		},
+		{
+			Name:   "Tags which can't be updated in-place",
+			Schema: tagsForceNewSchema(),
+			State: &terraform.InstanceState{
+				ID: "example",
+				Attributes: map[string]string{
+					"id":         "example",
+					"name":       "example",
+					"tags.%":     "0",
+					"tags_all.%": "0",
+				},
+			},
+			Config: map[string]interface{}{
+				"name": "example",
+			},
+			Expected: map[string]string{},
+		},
+		{
+			Name:   "Computed Tags",
+			Schema: tagsSchema(),
+			Config: map[string]interface{}{
+				"name": "example",
+				"tags": map[string]interface{}{
+					"environment": config.UnknownVariableValue,
+				},
+			},
+			Computed: []string{"tags.environment"},
+			Expected: map[string]string{
+				"tags_all.%": "",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		r := testProviderTagsResource("azurerm_test", v.Schema)
+
+		c := terraform.NewResourceConfig(nil)
+		c.Raw = v.Config
+		c.Config = v.Config
+		c.ComputedKeys = v.Computed
+
+		diff, err := r.Diff(v.State, c, meta)
+		if err != nil {
+			t.Fatalf("Error computing the diff for %q: %+v", v.Name, err)
+		}
+
+		for key, expected := range v.Expected {
+			if diff == nil {
+				t.Fatalf("Expected a diff for %q but didn't get one", v.Name)
+			}
+
+			attr, ok := diff.Attributes[key]
+			if !ok {
+				t.Fatalf("Expected %q to be in the diff for %q but it wasn't", key, v.Name)
+			}
+
+			if attr.New != expected {
+				t.Fatalf("Expected %q to be %q for %q but got %q", key, expected, v.Name, attr.New)
+			}
+		}
+
+		if len(v.Expected) == 0 && diff != nil {
+			for key := range diff.Attributes {
+				if strings.HasPrefix(key, "tags") {
+					t.Fatalf("Expected no diff to the tags for %q but got %+v", v.Name, diff.Attributes)
+				}
+			}
+		}
+	}
+}
+
+func TestProviderTags_requiredTagKeys(t *testing.T) {
+	cases := []struct {
+		Name          string
+		ResourceType  string
+		DefaultTags   map[string]interface{}
+		Tags          map[string]interface{}
+		Computed      []string
+		ExpectedError bool
+	}{
+		{
+			Name:          "No Tags",
+			ResourceType:  "azurerm_test",
+			ExpectedError: true,
+		},
+		{
+			Name:         "Missing a Required Tag",
+			ResourceType: "azurerm_test",
+			Tags: map[string]interface{}{
+				"owner": "terraform",
+			},
+			ExpectedError: true,
+		},
+		{
+			Name:         "Required Tags Present",
+			ResourceType: "azurerm_test",
+			Tags: map[string]interface{}{
+				"Cost_Center": "MSFT",
+				"owner":       "terraform",
+			},
+			ExpectedError: false,
+		},
+		{
+			Name:         "Required Tags from the Default Tags",
+			ResourceType: "azurerm_test",
+			DefaultTags: map[string]interface{}{
+				"cost_center": "MSFT",
+			},
+			Tags: map[string]interface{}{
+				"owner": "terraform",
+			},
+			ExpectedError: false,
+		},
+		{
+			Name:          "Exempted Resource Type",
+			ResourceType:  "azurerm_exempted",
+			ExpectedError: false,
+		},
+		{
+			Name:         "Computed Tags",
+			ResourceType: "azurerm_test",
+			Tags: map[string]interface{}{
+				"owner": config.UnknownVariableValue,
+			},
+			Computed:      []string{"tags.owner"},
+			ExpectedError: false,
+		},
+	}
+
+	for _, v := range cases {
+		r := testProviderTagsResource(v.ResourceType, tagsSchema())
+		meta := &ArmClient{
+			defaultTags:                          v.DefaultTags,
+			requiredTagKeys:                      []string{"cost_center", "owner"},
+			requiredTagKeysExemptedResourceTypes: []string{"azurerm_exempted"},
+		}
+
+		raw := map[string]interface{}{
+			"name": "example",
+		}
+		if v.Tags != nil {
+			raw["tags"] = v.Tags
+		}
+
+		c := terraform.NewResourceConfig(nil)
+		c.Raw = raw
+		c.Config = raw
+		c.ComputedKeys = v.Computed
+
+		_, err := r.Diff(nil, c, meta)
+		if v.ExpectedError && err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+
+		if !v.ExpectedError && err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+		}
+	}
+}
+
+func TestMissingRequiredTagKeys(t *testing.T) {
+	cases := []struct {
+		RequiredTagKeys []string
+		Tags            map[string]interface{}
+		Expected        []string
+	}{
+		{
+			RequiredTagKeys: []string{},
+			Tags:            map[string]interface{}{},
+			Expected:        []string{},
+		},
+		{
+			RequiredTagKeys: []string{"owner", "cost_center"},
+			Tags:            map[string]interface{}{},
+			Expected:        []string{"cost_center", "owner"},
+		},
+		{
+			RequiredTagKeys: []string{"owner", "cost_center"},
+			Tags: map[string]interface{}{
+				"OWNER": "terraform",
+			},
+			Expected: []string{"cost_center"},
+		},
+		{
+			RequiredTagKeys: []string{"owner"},
+			Tags: map[string]interface{}{
+				"owner": "terraform",
+			},
+			Expected: []string{},
+		},
+	}
+
+	for _, v := range cases {
+		actual := missingRequiredTagKeys(v.RequiredTagKeys, v.Tags)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestFilterIgnoredTags(t *testing.T) {
+	cases := []struct {
+		Tags     map[string]interface{}
+		Keys     []string
+		Prefixes []string
+		Expected map[string]interface{}
+	}{
+		{
+			Tags: map[string]interface{}{
+				"environment": "Production",
+			},
+			Expected: map[string]interface{}{
+				"environment": "Production",
+			},
+		},
+		{
+			Tags: map[string]interface{}{
+				"environment": "Production",
+				"CreatedBy":   "Azure Policy",
+			},
+			Keys: []string{"createdby"},
+			Expected: map[string]interface{}{
+				"environment": "Production",
+			},
+		},
+		{
+			Tags: map[string]interface{}{
+				"environment":      "Production",
+				"policy-owner":     "platform",
+				"Policy-CreatedOn": "2018-10-01",
+			},
+			Prefixes: []string{"policy-"},
+			Expected: map[string]interface{}{
+				"environment": "Production",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		actual := filterIgnoredTags(v.Tags, v.Keys, v.Prefixes)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
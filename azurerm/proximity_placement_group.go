@@ -0,0 +1,85 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourcemanager"
+)
+
+// Proximity Placement Groups (and placing a Virtual Machine, Virtual Machine Scale Set or Availability Set within one)
+// aren't available in the version of the Azure SDK used by this Provider, as such these are managed using the
+// `resourcemanager` client - these are the models for the API Version `2018-04-01`.
+
+type proximityPlacementGroup struct {
+	ID         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Location   *string                            `json:"location,omitempty"`
+	Tags       map[string]*string                 `json:"tags,omitempty"`
+	Properties *proximityPlacementGroupProperties `json:"properties,omitempty"`
+}
+
+type proximityPlacementGroupProperties struct {
+	ProximityPlacementGroupType *string                               `json:"proximityPlacementGroupType,omitempty"`
+	VirtualMachines             *[]proximityPlacementGroupSubResource `json:"virtualMachines,omitempty"`
+	VirtualMachineScaleSets     *[]proximityPlacementGroupSubResource `json:"virtualMachineScaleSets,omitempty"`
+	AvailabilitySets            *[]proximityPlacementGroupSubResource `json:"availabilitySets,omitempty"`
+}
+
+type proximityPlacementGroupSubResource struct {
+	ID *string `json:"id,omitempty"`
+}
+
+// proximityPlacementGroupMembership is the subset of a Virtual Machine, Virtual Machine Scale Set or Availability Set
+// containing the Proximity Placement Group it's placed within
+type proximityPlacementGroupMembership struct {
+	Properties *struct {
+		ProximityPlacementGroup *proximityPlacementGroupSubResource `json:"proximityPlacementGroup,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+func proximityPlacementGroupResourceID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/proximityPlacementGroups/%s", subscriptionId, resourceGroup, name)
+}
+
+// expandComputeResourceWithProximityPlacementGroup adds the `proximityPlacementGroup` property (which isn't available
+// in the Azure SDK) to the payload for a Virtual Machine, Virtual Machine Scale Set or Availability Set
+func expandComputeResourceWithProximityPlacementGroup(parameters interface{}, proximityPlacementGroupId string) (map[string]interface{}, error) {
+	serialized, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing payload: %+v", err)
+	}
+
+	output := make(map[string]interface{})
+	if err := json.Unmarshal(serialized, &output); err != nil {
+		return nil, fmt.Errorf("Error deserializing payload: %+v", err)
+	}
+
+	properties, ok := output["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["proximityPlacementGroup"] = map[string]interface{}{
+		"id": proximityPlacementGroupId,
+	}
+	output["properties"] = properties
+
+	return output, nil
+}
+
+// retrieveProximityPlacementGroupId returns the ID of the Proximity Placement Group which the Virtual Machine, Virtual
+// Machine Scale Set or Availability Set is placed within, since this isn't returned in the version of the API used by
+// the Azure SDK
+func retrieveProximityPlacementGroupId(ctx context.Context, client resourcemanager.Client, id string) (string, error) {
+	var membership proximityPlacementGroupMembership
+	if _, err := client.Get(ctx, id, &membership); err != nil {
+		return "", err
+	}
+
+	if props := membership.Properties; props != nil && props.ProximityPlacementGroup != nil && props.ProximityPlacementGroup.ID != nil {
+		return *props.ProximityPlacementGroup.ID, nil
+	}
+
+	return "", nil
+}
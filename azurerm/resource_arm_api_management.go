@@ -0,0 +1,345 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-01-01/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementServiceCreateUpdate,
+		Read:   resourceArmApiManagementServiceRead,
+		Update: resourceArmApiManagementServiceCreateUpdate,
+		Delete: resourceArmApiManagementServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"publisher_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"publisher_email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(apimanagement.SkuTypeDeveloper),
+								string(apimanagement.SkuTypeBasic),
+								string(apimanagement.SkuTypeStandard),
+								string(apimanagement.SkuTypePremium),
+							}, false),
+						},
+						"capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"notification_sender_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+							}, true),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"gateway_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"gateway_regional_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"portal_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"management_api_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"scm_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"public_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmApiManagementServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for API Management Service creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	properties := apimanagement.ServiceResource{
+		Location: utils.String(location),
+		ServiceProperties: &apimanagement.ServiceProperties{
+			PublisherName:  utils.String(d.Get("publisher_name").(string)),
+			PublisherEmail: utils.String(d.Get("publisher_email").(string)),
+		},
+		Sku:      expandAzureRmApiManagementServiceSku(d),
+		Identity: expandAzureRmApiManagementServiceIdentity(d),
+		Tags:     expandTags(tags, meta),
+	}
+
+	if v, ok := d.GetOk("notification_sender_email"); ok {
+		properties.ServiceProperties.NotificationSenderEmail = utils.String(v.(string))
+	}
+
+	// the Custom Domains are managed by the `azurerm_api_management_custom_domain` resource,
+	// so we need to ensure these aren't removed when the Service is updated
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := existing.ServiceProperties; props != nil {
+			properties.ServiceProperties.HostnameConfigurations = props.HostnameConfigurations
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, properties)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.ApiManagement", "service", name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for API Management Service %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApiManagementServiceRead(d, meta)
+}
+
+func resourceArmApiManagementServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["service"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management Service %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ServiceProperties; props != nil {
+		d.Set("publisher_name", props.PublisherName)
+		d.Set("publisher_email", props.PublisherEmail)
+		d.Set("notification_sender_email", props.NotificationSenderEmail)
+		d.Set("gateway_url", props.GatewayURL)
+		d.Set("gateway_regional_url", props.GatewayRegionalURL)
+		d.Set("portal_url", props.PortalURL)
+		d.Set("management_api_url", props.ManagementAPIURL)
+		d.Set("scm_url", props.ScmURL)
+
+		publicIpAddresses := make([]string, 0)
+		if props.PublicIPAddresses != nil {
+			publicIpAddresses = *props.PublicIPAddresses
+		}
+		if err := d.Set("public_ip_addresses", publicIpAddresses); err != nil {
+			return fmt.Errorf("Error setting `public_ip_addresses`: %+v", err)
+		}
+	}
+
+	if err := d.Set("sku", flattenAzureRmApiManagementServiceSku(resp.Sku)); err != nil {
+		return fmt.Errorf("Error setting `sku`: %+v", err)
+	}
+
+	if err := d.Set("identity", flattenAzureRmApiManagementServiceIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmApiManagementServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["service"]
+
+	log.Printf("[DEBUG] Deleting API Management Service %q (Resource Group %q)", name, resourceGroup)
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandAzureRmApiManagementServiceSku(d *schema.ResourceData) *apimanagement.ServiceSkuProperties {
+	skus := d.Get("sku").([]interface{})
+	sku := skus[0].(map[string]interface{})
+
+	return &apimanagement.ServiceSkuProperties{
+		Name:     apimanagement.SkuType(sku["name"].(string)),
+		Capacity: utils.Int32(int32(sku["capacity"].(int))),
+	}
+}
+
+func flattenAzureRmApiManagementServiceSku(input *apimanagement.ServiceSkuProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	sku := make(map[string]interface{})
+	sku["name"] = string(input.Name)
+	if input.Capacity != nil {
+		sku["capacity"] = int(*input.Capacity)
+	}
+
+	return []interface{}{sku}
+}
+
+func expandAzureRmApiManagementServiceIdentity(d *schema.ResourceData) *apimanagement.ServiceIdentity {
+	identities := d.Get("identity").([]interface{})
+	if len(identities) == 0 {
+		return nil
+	}
+
+	identity := identities[0].(map[string]interface{})
+	identityType := identity["type"].(string)
+
+	return &apimanagement.ServiceIdentity{
+		Type: utils.String(identityType),
+	}
+}
+
+func flattenAzureRmApiManagementServiceIdentity(identity *apimanagement.ServiceIdentity) []interface{} {
+	if identity == nil {
+		return make([]interface{}, 0)
+	}
+
+	result := make(map[string]interface{})
+	if identity.Type != nil {
+		result["type"] = *identity.Type
+	}
+	if identity.PrincipalID != nil {
+		result["principal_id"] = identity.PrincipalID.String()
+	}
+	if identity.TenantID != nil {
+		result["tenant_id"] = identity.TenantID.String()
+	}
+
+	return []interface{}{result}
+}
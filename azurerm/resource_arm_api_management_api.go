@@ -0,0 +1,325 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-01-01/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementApi() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementApiCreateUpdate,
+		Read:   resourceArmApiManagementApiRead,
+		Update: resourceArmApiManagementApiCreateUpdate,
+		Delete: resourceArmApiManagementApiDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementApiName,
+			},
+
+			"api_management_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"protocols": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(apimanagement.ProtocolHTTP),
+						string(apimanagement.ProtocolHTTPS),
+					}, false),
+				},
+			},
+
+			"service_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"revision": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"soap_pass_through": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"subscription_key_parameter_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"header": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"query": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			// the imported content isn't returned by the API, as such this is write-only
+			"import": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_format": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(apimanagement.SwaggerJSON),
+								string(apimanagement.SwaggerLinkJSON),
+								string(apimanagement.WadlLinkJSON),
+								string(apimanagement.WadlXML),
+								string(apimanagement.Wsdl),
+								string(apimanagement.WsdlLink),
+							}, false),
+						},
+						"content_value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"is_current": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_online": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementApiCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementAPIClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for API Management API creation/update.")
+
+	name := d.Get("name").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	protocols := make([]apimanagement.Protocol, 0)
+	for _, v := range d.Get("protocols").(*schema.Set).List() {
+		protocols = append(protocols, apimanagement.Protocol(v.(string)))
+	}
+
+	properties := &apimanagement.APICreateOrUpdateProperties{
+		DisplayName:                   utils.String(d.Get("display_name").(string)),
+		Path:                          utils.String(d.Get("path").(string)),
+		Protocols:                     &protocols,
+		Description:                   utils.String(d.Get("description").(string)),
+		SubscriptionKeyParameterNames: expandApiManagementApiSubscriptionKeyParameterNames(d.Get("subscription_key_parameter_names").([]interface{})),
+		APIType:                       apimanagement.HTTP,
+	}
+
+	if d.Get("soap_pass_through").(bool) {
+		properties.APIType = apimanagement.Soap
+		properties.SoapAPIType = apimanagement.SoapPassThrough
+	}
+
+	if v, ok := d.GetOk("service_url"); ok {
+		properties.ServiceURL = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("revision"); ok {
+		properties.APIRevision = utils.String(v.(string))
+	}
+
+	if imports := d.Get("import").([]interface{}); len(imports) > 0 {
+		importBlock := imports[0].(map[string]interface{})
+		properties.ContentFormat = apimanagement.ContentFormat(importBlock["content_format"].(string))
+		properties.ContentValue = utils.String(importBlock["content_value"].(string))
+	}
+
+	parameters := apimanagement.APICreateOrUpdateParameter{
+		APICreateOrUpdateProperties: properties,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, name, parameters, ""); err != nil {
+		return fmt.Errorf("Error creating/updating API %q (API Management Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving API %q (API Management Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for API %q (API Management Service %q / Resource Group %q)", name, serviceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApiManagementApiRead(d, meta)
+}
+
+func resourceArmApiManagementApiRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementAPIClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	name := id.Path["apis"]
+
+	resp, err := client.Get(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API %q was not found in API Management Service %q / Resource Group %q - removing from state!", name, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API %q (API Management Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.APIContractProperties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("path", props.Path)
+		d.Set("service_url", props.ServiceURL)
+		d.Set("description", props.Description)
+		d.Set("revision", props.APIRevision)
+		d.Set("version", props.APIVersion)
+		d.Set("is_current", props.IsCurrent)
+		d.Set("is_online", props.IsOnline)
+		d.Set("soap_pass_through", props.APIType == apimanagement.Soap)
+
+		protocols := make([]string, 0)
+		if props.Protocols != nil {
+			for _, v := range *props.Protocols {
+				protocols = append(protocols, string(v))
+			}
+		}
+		if err := d.Set("protocols", protocols); err != nil {
+			return fmt.Errorf("Error setting `protocols`: %+v", err)
+		}
+
+		if err := d.Set("subscription_key_parameter_names", flattenApiManagementApiSubscriptionKeyParameterNames(props.SubscriptionKeyParameterNames)); err != nil {
+			return fmt.Errorf("Error setting `subscription_key_parameter_names`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementApiDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementAPIClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	name := id.Path["apis"]
+
+	deleteRevisions := utils.Bool(true)
+	resp, err := client.Delete(ctx, resourceGroup, serviceName, name, "*", deleteRevisions)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting API %q (API Management Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandApiManagementApiSubscriptionKeyParameterNames(input []interface{}) *apimanagement.SubscriptionKeyParameterNamesContract {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &apimanagement.SubscriptionKeyParameterNamesContract{
+		Header: utils.String(v["header"].(string)),
+		Query:  utils.String(v["query"].(string)),
+	}
+}
+
+func flattenApiManagementApiSubscriptionKeyParameterNames(input *apimanagement.SubscriptionKeyParameterNamesContract) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	if input.Header != nil {
+		result["header"] = *input.Header
+	}
+	if input.Query != nil {
+		result["query"] = *input.Query
+	}
+
+	return []interface{}{result}
+}
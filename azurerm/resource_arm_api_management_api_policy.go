@@ -0,0 +1,132 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementApiPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementApiPolicyCreateUpdate,
+		Read:   resourceArmApiManagementApiPolicyRead,
+		Update: resourceArmApiManagementApiPolicyCreateUpdate,
+		Delete: resourceArmApiManagementApiPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementApiName,
+			},
+
+			"api_management_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"xml_content": apiManagementPolicyXmlContentSchema(),
+
+			"xml_link": apiManagementPolicyXmlLinkSchema(),
+		},
+	}
+}
+
+func resourceArmApiManagementApiPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementAPIPolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	apiName := d.Get("api_name").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	parameters, err := expandApiManagementPolicy(d)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, apiName, *parameters, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Policy for API %q (API Management Service %q / Resource Group %q): %+v", apiName, serviceName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serviceName, apiName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Policy for API %q (API Management Service %q / Resource Group %q): %+v", apiName, serviceName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Policy of API %q (API Management Service %q / Resource Group %q)", apiName, serviceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApiManagementApiPolicyRead(d, meta)
+}
+
+func resourceArmApiManagementApiPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementAPIPolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	apiName := id.Path["apis"]
+
+	resp, err := client.Get(ctx, resourceGroup, serviceName, apiName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Policy for API %q was not found in API Management Service %q / Resource Group %q - removing from state!", apiName, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Policy for API %q (API Management Service %q / Resource Group %q): %+v", apiName, serviceName, resourceGroup, err)
+	}
+
+	d.Set("api_name", apiName)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.PolicyContractProperties; props != nil {
+		// the API returns the rendered XML regardless of how the Policy was specified
+		d.Set("xml_content", props.PolicyContent)
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementApiPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementAPIPolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	apiName := id.Path["apis"]
+
+	resp, err := client.Delete(ctx, resourceGroup, serviceName, apiName, "*")
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Policy for API %q (API Management Service %q / Resource Group %q): %+v", apiName, serviceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
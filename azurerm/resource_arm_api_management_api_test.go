@@ -0,0 +1,187 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMApiManagementApi_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_api.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementApi_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soap_pass_through", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_current", "true"),
+					resource.TestCheckResourceAttr(resourceName, "is_online", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiManagementApi_update(t *testing.T) {
+	resourceName := "azurerm_api_management_api.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiManagementApi_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soap_pass_through", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_current", "true"),
+					resource.TestCheckResourceAttr(resourceName, "is_online", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMApiManagementApi_update(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "api1 updated"),
+					resource.TestCheckResourceAttr(resourceName, "protocols.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "service_url", "https://example.com/foo/bar"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementApiExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.Attributes["name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).apiManagementAPIClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serviceName, id)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementAPIClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: API %q (API Management Service %q / Resource Group %q) does not exist", id, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiManagementApiDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).apiManagementAPIClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_api" {
+			continue
+		}
+
+		id := rs.Primary.Attributes["name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serviceName, id)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("API %q still exists in API Management Service %q", id, serviceName)
+	}
+
+	return nil
+}
+
+func testAccAzureRMApiManagementApi_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku {
+    name     = "Developer"
+    capacity = 1
+  }
+}
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["https"]
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMApiManagementApi_update(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku {
+    name     = "Developer"
+    capacity = 1
+  }
+}
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  display_name        = "api1 updated"
+  path                = "api1"
+  protocols           = ["http", "https"]
+  service_url         = "https://example.com/foo/bar"
+  description         = "What is this API about?"
+}
+`, rInt, location, rInt, rInt)
+}
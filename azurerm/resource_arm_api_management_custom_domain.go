@@ -0,0 +1,318 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-01-01/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementCustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementCustomDomainCreateUpdate,
+		Read:   resourceArmApiManagementCustomDomainRead,
+		Update: resourceArmApiManagementCustomDomainCreateUpdate,
+		Delete: resourceArmApiManagementCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_management_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"proxy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: apiManagementCustomDomainHostnameSchema(true),
+				},
+			},
+
+			"portal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: apiManagementCustomDomainHostnameSchema(false),
+				},
+			},
+
+			"management": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: apiManagementCustomDomainHostnameSchema(false),
+				},
+			},
+
+			"scm": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: apiManagementCustomDomainHostnameSchema(false),
+				},
+			},
+		},
+	}
+}
+
+func apiManagementCustomDomainHostnameSchema(isProxy bool) map[string]*schema.Schema {
+	hostnameSchema := map[string]*schema.Schema{
+		"host_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+
+		"key_vault_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.UrlIsHttpOrHttps(),
+		},
+
+		// the certificate and password aren't returned by the API, as such these are write-only
+		"certificate": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+
+		"certificate_password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+
+		"negotiate_client_certificate": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+
+	if isProxy {
+		hostnameSchema["default_ssl_binding"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		}
+	}
+
+	return hostnameSchema
+}
+
+func resourceArmApiManagementCustomDomainCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] preparing arguments for API Management Custom Domain creation/update.")
+
+	apiManagementId := d.Get("api_management_id").(string)
+	id, err := parseAzureResourceID(apiManagementId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+
+	hostnameConfigurations := expandApiManagementCustomDomainHostnameConfigurations(d)
+	if err := updateApiManagementHostnameConfigurations(meta, resourceGroup, serviceName, hostnameConfigurations); err != nil {
+		return err
+	}
+
+	// there's only ever a single set of Custom Domains per API Management Service
+	d.SetId(fmt.Sprintf("%s/customDomains/default", apiManagementId))
+
+	return resourceArmApiManagementCustomDomainRead(d, meta)
+}
+
+func resourceArmApiManagementCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+
+	resp, err := client.Get(ctx, resourceGroup, serviceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management Service %q was not found in Resource Group %q - removing Custom Domains from state!", serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+
+	d.Set("api_management_id", resp.ID)
+
+	proxy := make([]interface{}, 0)
+	portal := make([]interface{}, 0)
+	management := make([]interface{}, 0)
+	scm := make([]interface{}, 0)
+
+	if props := resp.ServiceProperties; props != nil && props.HostnameConfigurations != nil {
+		for _, config := range *props.HostnameConfigurations {
+			if config.HostName == nil {
+				continue
+			}
+			hostName := *config.HostName
+
+			// the default hostnames are managed by Azure, so we skip these
+			if strings.EqualFold(hostName, fmt.Sprintf("%s.azure-api.net", serviceName)) {
+				continue
+			}
+
+			output := flattenApiManagementCustomDomainHostnameConfiguration(d, config)
+			switch config.Type {
+			case apimanagement.Proxy:
+				if config.DefaultSslBinding != nil {
+					output["default_ssl_binding"] = *config.DefaultSslBinding
+				}
+				proxy = append(proxy, output)
+			case apimanagement.Portal:
+				portal = append(portal, output)
+			case apimanagement.Management:
+				management = append(management, output)
+			case apimanagement.Scm:
+				scm = append(scm, output)
+			}
+		}
+	}
+
+	if err := d.Set("proxy", proxy); err != nil {
+		return fmt.Errorf("Error setting `proxy`: %+v", err)
+	}
+	if err := d.Set("portal", portal); err != nil {
+		return fmt.Errorf("Error setting `portal`: %+v", err)
+	}
+	if err := d.Set("management", management); err != nil {
+		return fmt.Errorf("Error setting `management`: %+v", err)
+	}
+	if err := d.Set("scm", scm); err != nil {
+		return fmt.Errorf("Error setting `scm`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+
+	log.Printf("[DEBUG] Removing the Custom Domains from API Management Service %q (Resource Group %q)", serviceName, resourceGroup)
+	hostnameConfigurations := make([]apimanagement.HostnameConfiguration, 0)
+	return updateApiManagementHostnameConfigurations(meta, resourceGroup, serviceName, hostnameConfigurations)
+}
+
+func updateApiManagementHostnameConfigurations(meta interface{}, resourceGroup, serviceName string, hostnameConfigurations []apimanagement.HostnameConfiguration) error {
+	client := meta.(*ArmClient).apiManagementServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	parameters := apimanagement.ServiceUpdateParameters{
+		ServiceUpdateProperties: &apimanagement.ServiceUpdateProperties{
+			HostnameConfigurations: &hostnameConfigurations,
+		},
+	}
+
+	future, err := client.Update(ctx, resourceGroup, serviceName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error updating Custom Domains for API Management Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Custom Domains of API Management Service %q (Resource Group %q) to be updated: %+v", serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandApiManagementCustomDomainHostnameConfigurations(d *schema.ResourceData) []apimanagement.HostnameConfiguration {
+	results := make([]apimanagement.HostnameConfiguration, 0)
+
+	hostnameTypes := []apimanagement.HostnameType{
+		apimanagement.Proxy,
+		apimanagement.Portal,
+		apimanagement.Management,
+		apimanagement.Scm,
+	}
+
+	for _, hostnameType := range hostnameTypes {
+		key := strings.ToLower(string(hostnameType))
+		for _, raw := range d.Get(key).([]interface{}) {
+			v := raw.(map[string]interface{})
+
+			config := apimanagement.HostnameConfiguration{
+				Type:                       hostnameType,
+				HostName:                   utils.String(v["host_name"].(string)),
+				NegotiateClientCertificate: utils.Bool(v["negotiate_client_certificate"].(bool)),
+			}
+
+			if keyVaultId := v["key_vault_id"].(string); keyVaultId != "" {
+				config.KeyVaultID = utils.String(keyVaultId)
+			}
+
+			if certificate := v["certificate"].(string); certificate != "" {
+				config.EncodedCertificate = utils.String(certificate)
+			}
+
+			if password := v["certificate_password"].(string); password != "" {
+				config.CertificatePassword = utils.String(password)
+			}
+
+			if defaultSslBinding, ok := v["default_ssl_binding"]; ok {
+				config.DefaultSslBinding = utils.Bool(defaultSslBinding.(bool))
+			}
+
+			results = append(results, config)
+		}
+	}
+
+	return results
+}
+
+func flattenApiManagementCustomDomainHostnameConfiguration(d *schema.ResourceData, input apimanagement.HostnameConfiguration) map[string]interface{} {
+	output := map[string]interface{}{
+		"host_name": *input.HostName,
+	}
+
+	if input.KeyVaultID != nil {
+		output["key_vault_id"] = *input.KeyVaultID
+	}
+
+	if input.NegotiateClientCertificate != nil {
+		output["negotiate_client_certificate"] = *input.NegotiateClientCertificate
+	}
+
+	// the certificate and password aren't returned, so we pull these from the existing state
+	key := strings.ToLower(string(input.Type))
+	for _, raw := range d.Get(key).([]interface{}) {
+		existing := raw.(map[string]interface{})
+		if existing["host_name"].(string) != *input.HostName {
+			continue
+		}
+
+		output["certificate"] = existing["certificate"]
+		output["certificate_password"] = existing["certificate_password"]
+	}
+
+	return output
+}
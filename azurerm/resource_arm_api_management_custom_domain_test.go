@@ -0,0 +1,154 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApiManagementCustomDomain_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_custom_domain.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementCustomDomain_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "proxy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "proxy.0.host_name", "api.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "portal.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "portal.0.host_name", "portal.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementCustomDomainDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).apiManagementServiceClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_custom_domain" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.Attributes["api_management_id"])
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		serviceName := id.Path["service"]
+
+		resp, err := client.Get(ctx, resourceGroup, serviceName)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		if props := resp.ServiceProperties; props != nil && props.HostnameConfigurations != nil {
+			for _, config := range *props.HostnameConfigurations {
+				if config.HostName == nil {
+					continue
+				}
+
+				if !strings.EqualFold(*config.HostName, fmt.Sprintf("%s.azure-api.net", serviceName)) {
+					return fmt.Errorf("Custom Domain %q still exists on API Management Service %q", *config.HostName, serviceName)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMApiManagementCustomDomainExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.Attributes["api_management_id"])
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		serviceName := id.Path["service"]
+
+		client := testAccProvider.Meta().(*ArmClient).apiManagementServiceClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serviceName)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: API Management Service %q (Resource Group %q) does not exist", serviceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on apiManagementServiceClient: %+v", err)
+		}
+
+		if props := resp.ServiceProperties; props != nil && props.HostnameConfigurations != nil {
+			for _, config := range *props.HostnameConfigurations {
+				if config.HostName != nil && *config.HostName == "api.example.com" {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Bad: Custom Domains were not found on API Management Service %q (Resource Group %q)", serviceName, resourceGroup)
+	}
+}
+
+func testAccAzureRMApiManagementCustomDomain_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku {
+    name     = "Developer"
+    capacity = 1
+  }
+}
+
+resource "azurerm_api_management_custom_domain" "test" {
+  api_management_id = "${azurerm_api_management.test.id}"
+
+  proxy {
+    host_name            = "api.example.com"
+    certificate          = "${base64encode(file("testdata/keyvaultcert.pfx"))}"
+    certificate_password = ""
+  }
+
+  portal {
+    host_name            = "portal.example.com"
+    certificate          = "${base64encode(file("testdata/keyvaultcert.pfx"))}"
+    certificate_password = ""
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,205 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-01-01/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementProductCreateUpdate,
+		Read:   resourceArmApiManagementProductRead,
+		Update: resourceArmApiManagementProductCreateUpdate,
+		Delete: resourceArmApiManagementProductDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"product_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementChildName,
+			},
+
+			"api_management_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"subscription_required": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"published": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"approval_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"subscriptions_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"terms": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementProductCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementProductClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for API Management Product creation/update.")
+
+	productId := d.Get("product_id").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	subscriptionRequired := d.Get("subscription_required").(bool)
+	approvalRequired := d.Get("approval_required").(bool)
+	subscriptionsLimit := d.Get("subscriptions_limit").(int)
+
+	state := apimanagement.NotPublished
+	if d.Get("published").(bool) {
+		state = apimanagement.Published
+	}
+
+	properties := &apimanagement.ProductContractProperties{
+		DisplayName:          utils.String(d.Get("display_name").(string)),
+		Description:          utils.String(d.Get("description").(string)),
+		Terms:                utils.String(d.Get("terms").(string)),
+		SubscriptionRequired: utils.Bool(subscriptionRequired),
+		State:                state,
+	}
+
+	// the API only accepts these fields when a Subscription is required
+	if subscriptionRequired {
+		properties.ApprovalRequired = utils.Bool(approvalRequired)
+		if subscriptionsLimit > 0 {
+			properties.SubscriptionsLimit = utils.Int32(int32(subscriptionsLimit))
+		}
+	} else if approvalRequired || subscriptionsLimit > 0 {
+		return fmt.Errorf("`approval_required` and `subscriptions_limit` can only be set when `subscription_required` is `true`")
+	}
+
+	parameters := apimanagement.ProductContract{
+		ProductContractProperties: properties,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, productId, parameters, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serviceName, productId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Product %q (API Management Service %q / Resource Group %q)", productId, serviceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApiManagementProductRead(d, meta)
+}
+
+func resourceArmApiManagementProductRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementProductClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	productId := id.Path["products"]
+
+	resp, err := client.Get(ctx, resourceGroup, serviceName, productId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Product %q was not found in API Management Service %q / Resource Group %q - removing from state!", productId, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	d.Set("product_id", productId)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.ProductContractProperties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+		d.Set("terms", props.Terms)
+		d.Set("subscription_required", props.SubscriptionRequired)
+		d.Set("approval_required", props.ApprovalRequired)
+		d.Set("published", props.State == apimanagement.Published)
+
+		if limit := props.SubscriptionsLimit; limit != nil {
+			d.Set("subscriptions_limit", int(*limit))
+		}
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementProductDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementProductClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	productId := id.Path["products"]
+
+	log.Printf("[DEBUG] Deleting Product %q (API Management Service %q / Resource Group %q)", productId, serviceName, resourceGroup)
+	deleteSubscriptions := utils.Bool(true)
+	resp, err := client.Delete(ctx, resourceGroup, serviceName, productId, "*", deleteSubscriptions)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
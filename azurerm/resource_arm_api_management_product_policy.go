@@ -0,0 +1,132 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementProductPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementProductPolicyCreateUpdate,
+		Read:   resourceArmApiManagementProductPolicyRead,
+		Update: resourceArmApiManagementProductPolicyCreateUpdate,
+		Delete: resourceArmApiManagementProductPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"product_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementChildName,
+			},
+
+			"api_management_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"xml_content": apiManagementPolicyXmlContentSchema(),
+
+			"xml_link": apiManagementPolicyXmlLinkSchema(),
+		},
+	}
+}
+
+func resourceArmApiManagementProductPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementProductPolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	productId := d.Get("product_id").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	parameters, err := expandApiManagementPolicy(d)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, productId, *parameters, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Policy for Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serviceName, productId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Policy for Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Policy of Product %q (API Management Service %q / Resource Group %q)", productId, serviceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApiManagementProductPolicyRead(d, meta)
+}
+
+func resourceArmApiManagementProductPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementProductPolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	productId := id.Path["products"]
+
+	resp, err := client.Get(ctx, resourceGroup, serviceName, productId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Policy for Product %q was not found in API Management Service %q / Resource Group %q - removing from state!", productId, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Policy for Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	d.Set("product_id", productId)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.PolicyContractProperties; props != nil {
+		// the API returns the rendered XML regardless of how the Policy was specified
+		d.Set("xml_content", props.PolicyContent)
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementProductPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementProductPolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	productId := id.Path["products"]
+
+	resp, err := client.Delete(ctx, resourceGroup, serviceName, productId, "*")
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Policy for Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMApiManagementProductPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_product_policy.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementProductPolicy_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementProductPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementProductPolicyExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementProductPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.Attributes["product_id"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).apiManagementProductPolicyClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serviceName, id)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementProductPolicyClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Product Policy %q (API Management Service %q / Resource Group %q) does not exist", id, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiManagementProductPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).apiManagementProductPolicyClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_product_policy" {
+			continue
+		}
+
+		id := rs.Primary.Attributes["product_id"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serviceName, id)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Product Policy %q still exists in API Management Service %q", id, serviceName)
+	}
+
+	return nil
+}
+
+func testAccAzureRMApiManagementProductPolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku {
+    name     = "Developer"
+    capacity = 1
+  }
+}
+
+resource "azurerm_api_management_product" "test" {
+  product_id            = "test-product"
+  api_management_name   = "${azurerm_api_management.test.name}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  display_name          = "Test Product"
+  subscription_required = true
+  approval_required     = false
+  published             = true
+}
+
+resource "azurerm_api_management_product_policy" "test" {
+  product_id          = "${azurerm_api_management_product.test.product_id}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  xml_content = <<XML
+<policies>
+  <inbound>
+    <set-variable name="abc" value="@(context.Request.Headers.GetValueOrDefault("X-Header-Name", ""))" />
+    <find-and-replace from="xyz" to="abc" />
+  </inbound>
+</policies>
+XML
+}
+`, rInt, location, rInt)
+}
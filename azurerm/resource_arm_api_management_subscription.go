@@ -0,0 +1,210 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-01-01/apimanagement"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementSubscriptionCreateUpdate,
+		Read:   resourceArmApiManagementSubscriptionRead,
+		Update: resourceArmApiManagementSubscriptionCreateUpdate,
+		Delete: resourceArmApiManagementSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementChildName,
+			},
+
+			"api_management_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"user_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"product_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(apimanagement.Submitted),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apimanagement.Active),
+					string(apimanagement.Cancelled),
+					string(apimanagement.Expired),
+					string(apimanagement.Rejected),
+					string(apimanagement.Submitted),
+					string(apimanagement.Suspended),
+				}, false),
+			},
+
+			"primary_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementSubscriptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementSubscriptionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for API Management Subscription creation/update.")
+
+	subscriptionId := d.Get("subscription_id").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if subscriptionId == "" {
+		id, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("Error generating UUID for API Management Subscription: %+v", err)
+		}
+
+		subscriptionId = id
+	}
+
+	properties := apimanagement.SubscriptionCreateParameters{
+		SubscriptionCreateParameterProperties: &apimanagement.SubscriptionCreateParameterProperties{
+			DisplayName: utils.String(d.Get("display_name").(string)),
+			ProductID:   utils.String(d.Get("product_id").(string)),
+			UserID:      utils.String(d.Get("user_id").(string)),
+			State:       apimanagement.SubscriptionState(d.Get("state").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("primary_key"); ok {
+		properties.SubscriptionCreateParameterProperties.PrimaryKey = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("secondary_key"); ok {
+		properties.SubscriptionCreateParameterProperties.SecondaryKey = utils.String(v.(string))
+	}
+
+	notify := utils.Bool(false)
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, subscriptionId, properties, notify, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Subscription %q (API Management Service %q / Resource Group %q): %+v", subscriptionId, serviceName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serviceName, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subscription %q (API Management Service %q / Resource Group %q): %+v", subscriptionId, serviceName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Subscription %q (API Management Service %q / Resource Group %q)", subscriptionId, serviceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApiManagementSubscriptionRead(d, meta)
+}
+
+func resourceArmApiManagementSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementSubscriptionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	subscriptionId := id.Path["subscriptions"]
+
+	resp, err := client.Get(ctx, resourceGroup, serviceName, subscriptionId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Subscription %q was not found in API Management Service %q / Resource Group %q - removing from state!", subscriptionId, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subscription %q (API Management Service %q / Resource Group %q): %+v", subscriptionId, serviceName, resourceGroup, err)
+	}
+
+	d.Set("subscription_id", subscriptionId)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.SubscriptionContractProperties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("state", string(props.State))
+		d.Set("product_id", props.ProductID)
+		d.Set("user_id", props.UserID)
+		d.Set("primary_key", props.PrimaryKey)
+		d.Set("secondary_key", props.SecondaryKey)
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementSubscriptionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	subscriptionId := id.Path["subscriptions"]
+
+	log.Printf("[DEBUG] Deleting Subscription %q (API Management Service %q / Resource Group %q)", subscriptionId, serviceName, resourceGroup)
+	resp, err := client.Delete(ctx, resourceGroup, serviceName, subscriptionId, "*")
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Subscription %q (API Management Service %q / Resource Group %q): %+v", subscriptionId, serviceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
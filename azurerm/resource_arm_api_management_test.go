@@ -0,0 +1,319 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApiManagement_basic(t *testing.T) {
+	resourceName := "azurerm_api_management.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagement_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "Developer"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "gateway_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "portal_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "management_api_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "scm_url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiManagement_complete(t *testing.T) {
+	resourceName := "azurerm_api_management.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagement_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_sender_email", "notifications@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "SystemAssigned"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.tenant_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Acceptance", "Test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMApiManagementName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello-world",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1hello",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello_world",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 51),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAzureRMApiManagementName(tc.Value, "azurerm_api_management")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the API Management Service Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestAzureRMApiManagementChildName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "test-product_1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-test",
+			ErrCount: 1,
+		},
+		{
+			Value:    "test-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "test.product",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 81),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAzureRMApiManagementChildName(tc.Value, "product_id")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the API Management Child Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestAzureRMApiManagementApiName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "echo-api",
+			ErrCount: 0,
+		},
+		{
+			Value:    "echo api.v1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "echo#api",
+			ErrCount: 1,
+		},
+		{
+			Value:    "echo?api",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 257),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAzureRMApiManagementApiName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the API Management API Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestAzureRMApiManagementPolicyXml_suppress(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Old:      "<policies>\n  <inbound>\n    <base />\n  </inbound>\n</policies>",
+			New:      "<policies><inbound><base /></inbound></policies>",
+			Suppress: true,
+		},
+		{
+			Old:      "<policies>\r\n\t<inbound>\r\n\t\t<base />\r\n\t</inbound>\r\n</policies>\r\n",
+			New:      "<policies>\n  <inbound>\n    <base />\n  </inbound>\n</policies>",
+			Suppress: true,
+		},
+		{
+			Old:      "<policies><inbound><base /></inbound></policies>",
+			New:      "<policies><outbound><base /></outbound></policies>",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if suppressApiManagementPolicyXmlDiff("xml_content", tc.Old, tc.New, nil) != tc.Suppress {
+			t.Fatalf("Expected the diff between %q and %q to be suppressed: %t", tc.Old, tc.New, tc.Suppress)
+		}
+	}
+}
+
+func testCheckAzureRMApiManagementDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).apiManagementServiceClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("API Management Service still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMApiManagementExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		serviceName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for API Management Service: %s", serviceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).apiManagementServiceClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serviceName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementServiceClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: API Management Service %q (Resource Group %q) does not exist", serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApiManagement_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku {
+    name     = "Developer"
+    capacity = 1
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMApiManagement_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                      = "acctestAM-%d"
+  location                  = "${azurerm_resource_group.test.location}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  publisher_name            = "pub1"
+  publisher_email           = "pub1@email.com"
+  notification_sender_email = "notifications@example.com"
+
+  sku {
+    name     = "Developer"
+    capacity = 1
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags {
+    "Acceptance" = "Test"
+  }
+}
+`, rInt, location, rInt)
+}
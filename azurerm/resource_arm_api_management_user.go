@@ -0,0 +1,205 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-01-01/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementUserCreateUpdate,
+		Read:   resourceArmApiManagementUserRead,
+		Update: resourceArmApiManagementUserCreateUpdate,
+		Delete: resourceArmApiManagementUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementChildName,
+			},
+
+			"api_management_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMApiManagementName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"first_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"last_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// the API doesn't return the password, as such it's not possible to detect changes made outside of Terraform
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"confirmation": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apimanagement.Signup),
+					string(apimanagement.Invite),
+				}, false),
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apimanagement.UserStateActive),
+					string(apimanagement.UserStateBlocked),
+					string(apimanagement.UserStatePending),
+				}, false),
+			},
+
+			"note": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementUserCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementUserClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for API Management User creation/update.")
+
+	userId := d.Get("user_id").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	properties := apimanagement.UserCreateParameters{
+		UserCreateParameterProperties: &apimanagement.UserCreateParameterProperties{
+			FirstName: utils.String(d.Get("first_name").(string)),
+			LastName:  utils.String(d.Get("last_name").(string)),
+			Email:     utils.String(d.Get("email").(string)),
+			Note:      utils.String(d.Get("note").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("password"); ok {
+		properties.UserCreateParameterProperties.Password = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("confirmation"); ok {
+		properties.UserCreateParameterProperties.Confirmation = apimanagement.Confirmation(v.(string))
+	}
+
+	if v, ok := d.GetOk("state"); ok {
+		properties.UserCreateParameterProperties.State = apimanagement.UserState(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, userId, properties, ""); err != nil {
+		return fmt.Errorf("Error creating/updating User %q (API Management Service %q / Resource Group %q): %+v", userId, serviceName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serviceName, userId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving User %q (API Management Service %q / Resource Group %q): %+v", userId, serviceName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for User %q (API Management Service %q / Resource Group %q)", userId, serviceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApiManagementUserRead(d, meta)
+}
+
+func resourceArmApiManagementUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementUserClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	userId := id.Path["users"]
+
+	resp, err := client.Get(ctx, resourceGroup, serviceName, userId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] User %q was not found in API Management Service %q / Resource Group %q - removing from state!", userId, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving User %q (API Management Service %q / Resource Group %q): %+v", userId, serviceName, resourceGroup, err)
+	}
+
+	d.Set("user_id", userId)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.UserContractProperties; props != nil {
+		d.Set("first_name", props.FirstName)
+		d.Set("last_name", props.LastName)
+		d.Set("email", props.Email)
+		d.Set("note", props.Note)
+		d.Set("state", string(props.State))
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementUserClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	userId := id.Path["users"]
+
+	log.Printf("[DEBUG] Deleting User %q (API Management Service %q / Resource Group %q)", userId, serviceName, resourceGroup)
+	deleteSubscriptions := utils.Bool(true)
+	notify := utils.Bool(false)
+	resp, err := client.Delete(ctx, resourceGroup, serviceName, userId, "*", deleteSubscriptions, notify)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting User %q (API Management Service %q / Resource Group %q): %+v", userId, serviceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
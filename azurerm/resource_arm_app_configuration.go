@@ -0,0 +1,323 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppConfigurationCreateUpdate,
+		Read:   resourceArmAppConfigurationRead,
+		Update: resourceArmAppConfigurationCreateUpdate,
+		Delete: resourceArmAppConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppConfigurationStoreName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"sku": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "free",
+				ValidateFunc: validation.StringInSlice([]string{
+					"free",
+					"standard",
+				}, false),
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+							}, true),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_read_key":    appConfigurationAccessKeySchema(),
+			"secondary_read_key":  appConfigurationAccessKeySchema(),
+			"primary_write_key":   appConfigurationAccessKeySchema(),
+			"secondary_write_key": appConfigurationAccessKeySchema(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func appConfigurationAccessKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"secret": {
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+				"connection_string": {
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmAppConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appConfigurationClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for App Configuration creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	store := appConfigurationStore{
+		Location: utils.String(location),
+		Sku: &appConfigurationStoreSku{
+			Name: d.Get("sku").(string),
+		},
+		Identity:   expandAppConfigurationIdentity(d.Get("identity").([]interface{})),
+		Properties: &appConfigurationStoreProperties{},
+		Tags:       expandTags(tags, meta),
+	}
+
+	id := appConfigurationStoreResourceID(subscriptionId, resourceGroup, name)
+	future, err := client.CreateOrUpdate(ctx, id, store)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating App Configuration %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of App Configuration %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmAppConfigurationRead(d, meta)
+}
+
+func resourceArmAppConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appConfigurationClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["configurationStores"]
+
+	var store appConfigurationStore
+	resp, err := client.Get(ctx, d.Id(), &store)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] App Configuration %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving App Configuration %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var keys appConfigurationStoreAccessKeys
+	if _, err := client.Post(ctx, d.Id(), "ListKeys", nil, &keys); err != nil {
+		return fmt.Errorf("Error listing the Access Keys for App Configuration %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := store.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := store.Sku; sku != nil {
+		d.Set("sku", sku.Name)
+	}
+
+	if props := store.Properties; props != nil {
+		d.Set("endpoint", props.Endpoint)
+	}
+
+	if err := d.Set("identity", flattenAppConfigurationIdentity(store.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	accessKeys := flattenAppConfigurationAccessKeys(keys.Value)
+	for _, field := range []string{"primary_read_key", "secondary_read_key", "primary_write_key", "secondary_write_key"} {
+		if err := d.Set(field, accessKeys[field]); err != nil {
+			return fmt.Errorf("Error setting `%s`: %+v", field, err)
+		}
+	}
+
+	flattenAndSetTags(d, store.Tags, meta)
+
+	return nil
+}
+
+func resourceArmAppConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appConfigurationClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["configurationStores"]
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting App Configuration %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of App Configuration %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandAppConfigurationIdentity(input []interface{}) *appConfigurationStoreIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return &appConfigurationStoreIdentity{
+			Type: "None",
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &appConfigurationStoreIdentity{
+		Type: v["type"].(string),
+	}
+}
+
+func flattenAppConfigurationIdentity(input *appConfigurationStoreIdentity) []interface{} {
+	if input == nil || input.Type == "None" {
+		return []interface{}{}
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         input.Type,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+// flattenAppConfigurationAccessKeys maps each Access Key into the field it's exposed as, based on its name
+func flattenAppConfigurationAccessKeys(input []appConfigurationStoreAccessKey) map[string][]interface{} {
+	fields := map[string]string{
+		"Primary Read Only":   "primary_read_key",
+		"Secondary Read Only": "secondary_read_key",
+		"Primary":             "primary_write_key",
+		"Secondary":           "secondary_write_key",
+	}
+
+	output := map[string][]interface{}{
+		"primary_read_key":    {},
+		"secondary_read_key":  {},
+		"primary_write_key":   {},
+		"secondary_write_key": {},
+	}
+
+	for _, key := range input {
+		if key.Name == nil {
+			continue
+		}
+
+		field, ok := fields[*key.Name]
+		if !ok {
+			continue
+		}
+
+		id := ""
+		if key.ID != nil {
+			id = *key.ID
+		}
+
+		secret := ""
+		if key.Value != nil {
+			secret = *key.Value
+		}
+
+		connectionString := ""
+		if key.ConnectionString != nil {
+			connectionString = *key.ConnectionString
+		}
+
+		output[field] = []interface{}{
+			map[string]interface{}{
+				"id":                id,
+				"secret":            secret,
+				"connection_string": connectionString,
+			},
+		}
+	}
+
+	return output
+}
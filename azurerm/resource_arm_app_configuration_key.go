@@ -0,0 +1,165 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/appconfiguration"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppConfigurationKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppConfigurationKeyCreateUpdate,
+		Read:   resourceArmAppConfigurationKeyRead,
+		Update: resourceArmAppConfigurationKeyCreateUpdate,
+		Delete: resourceArmAppConfigurationKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmAppConfigurationKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	id := appConfigurationKeyID{
+		ConfigurationStoreID: d.Get("configuration_store_id").(string),
+		Key:                  d.Get("key").(string),
+		Label:                d.Get("label").(string),
+	}
+
+	client, err := appConfigurationKeyValuesClient(ctx, meta, id.ConfigurationStoreID)
+	if err != nil {
+		return err
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+	keyValue := appconfiguration.KeyValue{
+		Value:       utils.String(d.Get("value").(string)),
+		ContentType: utils.String(d.Get("content_type").(string)),
+		Tags:        expandTags(tags, meta),
+	}
+
+	if _, err := client.Put(ctx, id.Key, id.Label, keyValue); err != nil {
+		return fmt.Errorf("Error creating/updating Key %q (Label %q / Configuration Store %q): %+v", id.Key, id.Label, id.ConfigurationStoreID, err)
+	}
+
+	d.SetId(id.String())
+
+	return resourceArmAppConfigurationKeyRead(d, meta)
+}
+
+func resourceArmAppConfigurationKeyRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseAppConfigurationKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var store appConfigurationStore
+	resp, err := armClient.appConfigurationClient.Get(ctx, id.ConfigurationStoreID, &store)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Configuration Store %q was not found - removing Key %q (Label %q) from state!", id.ConfigurationStoreID, id.Key, id.Label)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Configuration Store %q: %+v", id.ConfigurationStoreID, err)
+	}
+
+	client, err := appConfigurationKeyValuesClient(ctx, meta, id.ConfigurationStoreID)
+	if err != nil {
+		return err
+	}
+
+	keyValue, err := client.Get(ctx, id.Key, id.Label)
+	if err != nil {
+		if utils.ResponseWasNotFound(keyValue.Response) {
+			log.Printf("[DEBUG] Key %q (Label %q) was not found in Configuration Store %q - removing from state!", id.Key, id.Label, id.ConfigurationStoreID)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key %q (Label %q / Configuration Store %q): %+v", id.Key, id.Label, id.ConfigurationStoreID, err)
+	}
+
+	d.Set("configuration_store_id", id.ConfigurationStoreID)
+	d.Set("key", id.Key)
+	d.Set("label", id.Label)
+	d.Set("value", keyValue.Value)
+	d.Set("content_type", keyValue.ContentType)
+	d.Set("etag", keyValue.Etag)
+
+	flattenAndSetTags(d, keyValue.Tags, meta)
+
+	return nil
+}
+
+func resourceArmAppConfigurationKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAppConfigurationKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := appConfigurationKeyValuesClient(ctx, meta, id.ConfigurationStoreID)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.Key, id.Label)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Key %q (Label %q / Configuration Store %q): %+v", id.Key, id.Label, id.ConfigurationStoreID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppConfigurationKey_basic(t *testing.T) {
+	resourceName := "azurerm_app_configuration_key.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppConfigurationKey_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppConfigurationKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppConfigurationKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "acctest/setting"),
+					resource.TestCheckResourceAttr(resourceName, "label", ""),
+					resource.TestCheckResourceAttr(resourceName, "value", "hello"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppConfigurationKey_update(t *testing.T) {
+	resourceName := "azurerm_app_configuration_key.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppConfigurationKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppConfigurationKey_complete(ri, location, "hello", "text/plain"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppConfigurationKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "label", "prod"),
+					resource.TestCheckResourceAttr(resourceName, "value", "hello"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMAppConfigurationKey_complete(ri, location, "{\\\"hello\\\":\\\"world\\\"}", "application/json"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppConfigurationKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "{\"hello\":\"world\"}"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppConfigurationKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAppConfigurationKeyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client, err := appConfigurationKeyValuesClient(ctx, testAccProvider.Meta(), id.ConfigurationStoreID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.Key, id.Label)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Key %q (Label %q) does not exist in Configuration Store %q", id.Key, id.Label, id.ConfigurationStoreID)
+			}
+
+			return fmt.Errorf("Bad: Get on the Key Values client: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppConfigurationKeyDestroy(s *terraform.State) error {
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_configuration_key" {
+			continue
+		}
+
+		id, err := parseAppConfigurationKeyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		// the Key Values are removed along with the Configuration Store
+		var store appConfigurationStore
+		storeResp, err := testAccProvider.Meta().(*ArmClient).appConfigurationClient.Get(ctx, id.ConfigurationStoreID, &store)
+		if err != nil {
+			if utils.ResponseWasNotFound(storeResp) {
+				return nil
+			}
+
+			return err
+		}
+
+		client, err := appConfigurationKeyValuesClient(ctx, testAccProvider.Meta(), id.ConfigurationStoreID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.Key, id.Label)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Key %q (Label %q) still exists in Configuration Store %q", id.Key, id.Label, id.ConfigurationStoreID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMAppConfigurationKey_basic(rInt int, location string) string {
+	template := testAccAzureRMAppConfiguration_free(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key" "test" {
+  configuration_store_id = "${azurerm_app_configuration.test.id}"
+  key                    = "acctest/setting"
+  value                  = "hello"
+}
+`, template)
+}
+
+func testAccAzureRMAppConfigurationKey_complete(rInt int, location string, value string, contentType string) string {
+	template := testAccAzureRMAppConfiguration_free(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key" "test" {
+  configuration_store_id = "${azurerm_app_configuration.test.id}"
+  key                    = "acctest/setting"
+  label                  = "prod"
+  value                  = "%s"
+  content_type           = "%s"
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, value, contentType)
+}
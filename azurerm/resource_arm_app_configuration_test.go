@@ -0,0 +1,163 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppConfiguration_free(t *testing.T) {
+	resourceName := "azurerm_app_configuration.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppConfiguration_free(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "free"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_read_key.0.connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_write_key.0.connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_read_key.0.connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_write_key.0.connection_string"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppConfiguration_standard(t *testing.T) {
+	resourceName := "azurerm_app_configuration.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppConfiguration_standard(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "standard"),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "SystemAssigned"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.tenant_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppConfigurationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).appConfigurationClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var store appConfigurationStore
+		resp, err := client.Get(ctx, rs.Primary.ID, &store)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: App Configuration %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on appConfigurationClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppConfigurationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appConfigurationClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_configuration" {
+			continue
+		}
+
+		var store appConfigurationStore
+		resp, err := client.Get(ctx, rs.Primary.ID, &store)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("App Configuration still exists:\n%#v", store)
+	}
+
+	return nil
+}
+
+func testAccAzureRMAppConfiguration_free(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "testacc-appconf%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "free"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMAppConfiguration_standard(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "testacc-appconf%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "standard"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -212,7 +212,7 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID: utils.String(appServicePlanId),
 			Enabled:      utils.Bool(enabled),
@@ -236,7 +236,9 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	err = createFuture.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Web", "sites", name)
+	err = waitForCreateOrUpdate(ctx, d, &createFuture.Future, client.Client, expectedId)
 	if err != nil {
 		return err
 	}
@@ -275,7 +277,7 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 	siteConfig := azure.ExpandAppServiceSiteConfig(d.Get("site_config"))
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID: utils.String(appServicePlanId),
 			Enabled:      utils.Bool(enabled),
@@ -289,7 +291,7 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -364,7 +366,7 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 			return fmt.Errorf("Error updating Managed Service Identity for App Service %q: %+v", name, err)
 		}
 
-		err = future.WaitForCompletionRef(ctx, client.Client)
+		err = waitForCompletion(ctx, &future.Future, client.Client)
 
 		if err != nil {
 			return fmt.Errorf("Error updating Managed Service Identity for App Service %q: %+v", name, err)
@@ -420,7 +422,7 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	err = siteCredFuture.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &siteCredFuture.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -466,7 +468,7 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	identity := flattenAzureRmAppServiceMachineIdentity(resp.Identity)
 	if err := d.Set("identity", identity); err != nil {
@@ -42,6 +42,7 @@ func resourceArmAppServicePlan() *schema.Resource {
 					// @tombuildsstuff: I believe `app` is the older representation of `Windows`
 					// thus we need to support it to be able to import resources without recreating them.
 					"App",
+					"elastic",
 					"FunctionApp",
 					"Linux",
 					"Windows",
@@ -103,6 +104,13 @@ func resourceArmAppServicePlan() *schema.Resource {
 				Computed: true,
 			},
 
+			"maximum_elastic_worker_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -126,19 +134,40 @@ func resourceArmAppServicePlanCreateUpdate(d *schema.ResourceData, meta interfac
 	appServicePlan := web.AppServicePlan{
 		Location:                 &location,
 		AppServicePlanProperties: properties,
-		Kind: &kind,
-		Tags: expandTags(tags),
-		Sku:  &sku,
+		Kind:                     &kind,
+		Tags:                     expandTags(tags, meta),
+		Sku:                      &sku,
 	}
 
-	createFuture, err := client.CreateOrUpdate(ctx, resGroup, name, appServicePlan)
-	if err != nil {
-		return err
-	}
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Web", "serverfarms", name)
 
-	err = createFuture.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return err
+	if v, ok := d.GetOk("maximum_elastic_worker_count"); ok {
+		// the Maximum Elastic Worker Count isn't available in the version of the Azure SDK used by this Provider
+		elasticClient := meta.(*ArmClient).appServicePlansElasticClient
+
+		body, err := expandAppServicePlanWithMaximumElasticWorkerCount(appServicePlan, int32(v.(int)))
+		if err != nil {
+			return err
+		}
+
+		createFuture, err := elasticClient.CreateOrUpdate(ctx, expectedId, body)
+		if err != nil {
+			return err
+		}
+
+		if err := waitForCreateOrUpdate(ctx, d, &createFuture.Future, elasticClient.Client, expectedId); err != nil {
+			return err
+		}
+	} else {
+		createFuture, err := client.CreateOrUpdate(ctx, resGroup, name, appServicePlan)
+		if err != nil {
+			return err
+		}
+
+		if err := waitForCreateOrUpdate(ctx, d, &createFuture.Future, client.Client, expectedId); err != nil {
+			return err
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
@@ -197,7 +226,16 @@ func resourceArmAppServicePlanRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("sku", flattenAppServicePlanSku(sku))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	// the Maximum Elastic Worker Count isn't returned in the version of the API used by the Azure SDK
+	var elasticProps appServicePlanElasticProperties
+	if _, err := meta.(*ArmClient).appServicePlansElasticClient.Get(ctx, d.Id(), &elasticProps); err != nil {
+		return fmt.Errorf("Error retrieving Maximum Elastic Worker Count for Azure App Service Plan %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if props := elasticProps.Properties; props != nil && props.MaximumElasticWorkerCount != nil {
+		d.Set("maximum_elastic_worker_count", int(*props.MaximumElasticWorkerCount))
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
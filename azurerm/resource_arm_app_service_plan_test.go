@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -156,6 +157,102 @@ func TestAccAzureRMAppServicePlan_premiumWindowsUpdated(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAppServicePlan_scaleUpToPremiumV2(t *testing.T) {
+	resourceName := "azurerm_app_service_plan.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	var planId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServicePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServicePlan_standardWindows(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServicePlanExists(resourceName),
+					testCheckAzureRMAppServicePlanID(resourceName, &planId),
+				),
+			},
+			{
+				Config: testAccAzureRMAppServicePlan_premiumV2Windows(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServicePlanExists(resourceName),
+					testCheckAzureRMAppServicePlanID(resourceName, &planId),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "PremiumV2"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.size", "P1v2"),
+				),
+			},
+			{
+				Config: testAccAzureRMAppServicePlan_standardWindows(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServicePlanExists(resourceName),
+					testCheckAzureRMAppServicePlanID(resourceName, &planId),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "Standard"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppServicePlan_elasticPremium(t *testing.T) {
+	resourceName := "azurerm_app_service_plan.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppServicePlan_elasticPremium(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServicePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServicePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kind", "elastic"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "ElasticPremium"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.per_site_scaling", "true"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_elastic_worker_count", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestExpandAppServicePlanWithMaximumElasticWorkerCount(t *testing.T) {
+	plan := web.AppServicePlan{
+		Location: utils.String("westeurope"),
+		Kind:     utils.String("elastic"),
+		AppServicePlanProperties: &web.AppServicePlanProperties{
+			PerSiteScaling: utils.Bool(true),
+		},
+	}
+
+	output, err := expandAppServicePlanWithMaximumElasticWorkerCount(plan, 20)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if output["kind"] != "elastic" {
+		t.Fatalf("Expected the kind to be retained but got %+v", output["kind"])
+	}
+
+	properties := output["properties"].(map[string]interface{})
+	if properties["maximumElasticWorkerCount"] != int32(20) {
+		t.Fatalf("Expected `maximumElasticWorkerCount` to be 20 but got %+v", properties["maximumElasticWorkerCount"])
+	}
+
+	if properties["perSiteScaling"] != true {
+		t.Fatalf("Expected the existing properties to be retained but got %+v", properties)
+	}
+}
+
 func TestAccAzureRMAppServicePlan_completeWindows(t *testing.T) {
 	resourceName := "azurerm_app_service_plan.test"
 	ri := acctest.RandInt()
@@ -256,6 +353,28 @@ func testCheckAzureRMAppServicePlanExists(name string) resource.TestCheckFunc {
 	}
 }
 
+// testCheckAzureRMAppServicePlanID records the ID of the App Service Plan in the first step, ensuring it's
+// unchanged in subsequent steps (that is, the App Service Plan has been updated rather than replaced)
+func testCheckAzureRMAppServicePlanID(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if *id == "" {
+			*id = rs.Primary.ID
+			return nil
+		}
+
+		if rs.Primary.ID != *id {
+			return fmt.Errorf("Expected App Service Plan %q to be updated in-place but it was replaced (%q => %q)", name, *id, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccAzureRMAppServicePlan_basicWindows(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -362,6 +481,53 @@ resource "azurerm_app_service_plan" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMAppServicePlan_premiumV2Windows(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "PremiumV2"
+    size = "P1v2"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMAppServicePlan_elasticPremium(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  kind                = "elastic"
+
+  maximum_elastic_worker_count = 20
+
+  sku {
+    tier = "ElasticPremium"
+    size = "EP1"
+  }
+
+  properties {
+    per_site_scaling = true
+  }
+}
+`, rInt, location, rInt)
+}
+
 func testAccAzureRMAppServicePlan_completeWindows(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -162,7 +162,7 @@ func resourceArmAppServiceSlotCreate(d *schema.ResourceData, meta interface{}) e
 	siteConfig := azure.ExpandAppServiceSiteConfig(d.Get("site_config"))
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID: utils.String(appServicePlanId),
 			Enabled:      utils.Bool(enabled),
@@ -197,7 +197,9 @@ func resourceArmAppServiceSlotCreate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	err = createFuture.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := fmt.Sprintf("%s/slots/%s", azureRMResourceID(subscriptionId, resGroup, "Microsoft.Web", "sites", appServiceName), slot)
+	err = waitForCreateOrUpdate(ctx, d, &createFuture.Future, client.Client, expectedId)
 	if err != nil {
 		return err
 	}
@@ -236,7 +238,7 @@ func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) e
 	tags := d.Get("tags").(map[string]interface{})
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID: utils.String(appServicePlanId),
 			Enabled:      utils.Bool(enabled),
@@ -253,7 +255,7 @@ func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	err = createFuture.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &createFuture.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -382,7 +384,7 @@ func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -718,11 +718,32 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
+	if hasOnlyTagChanges(d, "azurerm_application_gateway", resourceArmApplicationGateway) {
+		log.Printf("[DEBUG] Only `tags` have changed - updating the Tags for Application Gateway %q (Resource Group %q)", name, resGroup)
+		future, err := client.UpdateTags(ctx, resGroup, name, network.TagsObject{
+			Tags: expandTags(tags, meta),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating Tags for Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Tags for Application Gateway %q (Resource Group %q) to be updated: %+v", name, resGroup, err)
+		}
+
+		return resourceArmApplicationGatewayRead(d, meta)
+	}
+
 	// Gateway ID is needed to link sub-resources together in expand functions
 	gatewayID := fmt.Sprintf(
 		"/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s",
 		armClient.subscriptionId, resGroup, name)
 
+	// the standalone sub-resources (e.g. `azurerm_application_gateway_backend_pool`) update the
+	// Application Gateway too, so lock to ensure these changes aren't lost
+	armMutexKV.Lock(gatewayID)
+	defer armMutexKV.Unlock(gatewayID)
+
 	properties := network.ApplicationGatewayPropertiesFormat{}
 	properties.Sku = expandApplicationGatewaySku(d)
 	properties.SslPolicy = expandApplicationGatewaySslPolicy(d)
@@ -743,9 +764,9 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 	}
 
 	gateway := network.ApplicationGateway{
-		Name:     utils.String(name),
-		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Name:                               utils.String(name),
+		Location:                           utils.String(location),
+		Tags:                               expandTags(tags, meta),
 		ApplicationGatewayPropertiesFormat: &properties,
 	}
 
@@ -754,7 +775,9 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error Creating/Updating ApplicationGateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Network", "applicationGateways", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error Creating/Updating ApplicationGateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -768,6 +791,7 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 	}
 
 	d.SetId(*read.ID)
+	meta.(*ArmClient).parentResourceCache.Invalidate(d.Id())
 
 	return resourceArmApplicationGatewayRead(d, meta)
 }
@@ -835,7 +859,7 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 			flattenApplicationGatewayWafConfig(applicationGateway.ApplicationGatewayPropertiesFormat.WebApplicationFirewallConfiguration)))
 	}
 
-	flattenAndSetTags(d, applicationGateway.Tags)
+	flattenAndSetTags(d, applicationGateway.Tags, meta)
 
 	return nil
 }
@@ -856,11 +880,13 @@ func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error deleting for AppGateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for deletion of AppGateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
+	meta.(*ArmClient).parentResourceCache.Invalidate(d.Id())
+
 	d.SetId("")
 	return nil
 }
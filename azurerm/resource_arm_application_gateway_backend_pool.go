@@ -0,0 +1,210 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationGatewayBackendPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationGatewayBackendPoolCreateUpdate,
+		Read:   resourceArmApplicationGatewayBackendPoolRead,
+		Update: resourceArmApplicationGatewayBackendPoolCreateUpdate,
+		Delete: resourceArmApplicationGatewayBackendPoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: applicationGatewaySubResourceStateImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"application_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"ip_address_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"fqdn_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmApplicationGatewayBackendPoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	gatewayID := d.Get("application_gateway_id").(string)
+
+	armMutexKV.Lock(gatewayID)
+	defer armMutexKV.Unlock(gatewayID)
+
+	gateway, exists, err := retrieveApplicationGatewayById(gatewayID, meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
+	}
+	if !exists {
+		return fmt.Errorf("ApplicationGateway %q was not found", gatewayID)
+	}
+
+	if d.IsNewResource() {
+		if _, _, exists := findApplicationGatewayBackendAddressPoolByName(gateway, name); exists {
+			return fmt.Errorf("A Backend Address Pool named %q already exists on ApplicationGateway %q - please import it into the state with `terraform import`", name, gatewayID)
+		}
+	}
+
+	backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
+	for _, v := range d.Get("ip_address_list").([]interface{}) {
+		backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+			IPAddress: utils.String(v.(string)),
+		})
+	}
+	for _, v := range d.Get("fqdn_list").([]interface{}) {
+		backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+			Fqdn: utils.String(v.(string)),
+		})
+	}
+
+	pool := network.ApplicationGatewayBackendAddressPool{
+		Name: utils.String(name),
+		ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+			BackendAddresses: &backendAddresses,
+		},
+	}
+
+	pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+	if existing := gateway.ApplicationGatewayPropertiesFormat.BackendAddressPools; existing != nil {
+		for _, v := range *existing {
+			// this pool is being updated, so remove the old copy
+			if v.Name != nil && *v.Name == name {
+				continue
+			}
+
+			pools = append(pools, v)
+		}
+	}
+	pools = append(pools, pool)
+	gateway.ApplicationGatewayPropertiesFormat.BackendAddressPools = &pools
+
+	if err := updateApplicationGateway(gateway, meta); err != nil {
+		return err
+	}
+
+	read, _, err := retrieveApplicationGatewayById(gatewayID, meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
+	}
+
+	config, _, exists := findApplicationGatewayBackendAddressPoolByName(read, name)
+	if !exists || config.ID == nil {
+		return fmt.Errorf("Cannot find created Backend Address Pool %q in ApplicationGateway %q", name, gatewayID)
+	}
+
+	d.SetId(*config.ID)
+
+	return resourceArmApplicationGatewayBackendPoolRead(d, meta)
+}
+
+func resourceArmApplicationGatewayBackendPoolRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["backendAddressPools"]
+
+	gateway, exists, err := retrieveCachedApplicationGatewayById(d.Get("application_gateway_id").(string), meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] ApplicationGateway for Backend Address Pool %q not found. Removing from state", name)
+		return nil
+	}
+
+	config, _, exists := findApplicationGatewayBackendAddressPoolByName(gateway, name)
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] ApplicationGateway Backend Address Pool %q not found. Removing from state", name)
+		return nil
+	}
+
+	d.Set("name", config.Name)
+
+	ipAddressList := make([]interface{}, 0)
+	fqdnList := make([]interface{}, 0)
+	if props := config.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil && props.BackendAddresses != nil {
+		for _, address := range *props.BackendAddresses {
+			if address.IPAddress != nil {
+				ipAddressList = append(ipAddressList, *address.IPAddress)
+			}
+
+			if address.Fqdn != nil {
+				fqdnList = append(fqdnList, *address.Fqdn)
+			}
+		}
+	}
+
+	if err := d.Set("ip_address_list", ipAddressList); err != nil {
+		return fmt.Errorf("Error setting `ip_address_list`: %+v", err)
+	}
+
+	if err := d.Set("fqdn_list", fqdnList); err != nil {
+		return fmt.Errorf("Error setting `fqdn_list`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmApplicationGatewayBackendPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	gatewayID := d.Get("application_gateway_id").(string)
+
+	armMutexKV.Lock(gatewayID)
+	defer armMutexKV.Unlock(gatewayID)
+
+	gateway, exists, err := retrieveApplicationGatewayById(gatewayID, meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
+	}
+	if !exists {
+		return nil
+	}
+
+	_, index, exists := findApplicationGatewayBackendAddressPoolByName(gateway, name)
+	if !exists {
+		return nil
+	}
+
+	existing := *gateway.ApplicationGatewayPropertiesFormat.BackendAddressPools
+	pools := append(existing[:index], existing[index+1:]...)
+	gateway.ApplicationGatewayPropertiesFormat.BackendAddressPools = &pools
+
+	return updateApplicationGateway(gateway, meta)
+}
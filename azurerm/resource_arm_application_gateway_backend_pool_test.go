@@ -0,0 +1,255 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApplicationGatewayBackendPool_basic(t *testing.T) {
+	resourceName := "azurerm_application_gateway_backend_pool.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayBackendPool_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayBackendPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fqdn_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fqdn_list.0", "terraform.io"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGatewayBackendPool_update(t *testing.T) {
+	resourceName := "azurerm_application_gateway_backend_pool.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayBackendPool_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayBackendPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_list.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMApplicationGatewayBackendPool_ipAddresses(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayBackendPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fqdn_list.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_list.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGatewayBackendPool_removal(t *testing.T) {
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayBackendPool_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayBackendPoolExists("azurerm_application_gateway_backend_pool.test"),
+				),
+			},
+			{
+				Config: testAccAzureRMApplicationGatewaySubResource_template(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayBackendPoolNotExists("azurerm_application_gateway.test", "pool-standalone"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationGatewayBackendPoolExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		poolName := rs.Primary.Attributes["name"]
+		gatewayID := rs.Primary.Attributes["application_gateway_id"]
+
+		gateway, exists, err := retrieveApplicationGatewayById(gatewayID, testAccProvider.Meta())
+		if err != nil {
+			return fmt.Errorf("Bad: Get on ApplicationGatewayClient: %+v", err)
+		}
+		if !exists {
+			return fmt.Errorf("Bad: App Gateway %q does not exist", gatewayID)
+		}
+
+		if _, _, exists := findApplicationGatewayBackendAddressPoolByName(gateway, poolName); !exists {
+			return fmt.Errorf("Bad: Backend Address Pool %q does not exist on App Gateway %q", poolName, gatewayID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApplicationGatewayBackendPoolNotExists(gatewayResourceName string, poolName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[gatewayResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", gatewayResourceName)
+		}
+
+		gateway, exists, err := retrieveApplicationGatewayById(rs.Primary.ID, testAccProvider.Meta())
+		if err != nil {
+			return fmt.Errorf("Bad: Get on ApplicationGatewayClient: %+v", err)
+		}
+		if !exists {
+			return fmt.Errorf("Bad: App Gateway %q does not exist", rs.Primary.ID)
+		}
+
+		if _, _, exists := findApplicationGatewayBackendAddressPoolByName(gateway, poolName); exists {
+			return fmt.Errorf("Bad: Backend Address Pool %q still exists on App Gateway %q", poolName, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccAzureRMApplicationGatewaySubResource_template provisions an Application Gateway which ignores changes
+// to the sub-resources which can be managed using standalone resources
+func testAccAzureRMApplicationGatewaySubResource_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.254.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "subnet-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.254.0.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctest-pubip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestgw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "Standard_Small"
+    tier     = "Standard"
+    capacity = 1
+  }
+
+  gateway_ip_configuration {
+    name      = "gw-ip-config1"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  frontend_ip_configuration {
+    name                 = "ip-config-public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  frontend_port {
+    name = "port-80"
+    port = 80
+  }
+
+  frontend_port {
+    name = "port-8080"
+    port = 8080
+  }
+
+  backend_address_pool {
+    name = "pool-1"
+  }
+
+  backend_http_settings {
+    name                  = "backend-http-1"
+    port                  = 80
+    protocol              = "Http"
+    cookie_based_affinity = "Disabled"
+    request_timeout       = 30
+  }
+
+  http_listener {
+    name                           = "listener-1"
+    frontend_ip_configuration_name = "ip-config-public"
+    frontend_port_name             = "port-80"
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = "rule-1"
+    rule_type                  = "Basic"
+    http_listener_name         = "listener-1"
+    backend_address_pool_name  = "pool-1"
+    backend_http_settings_name = "backend-http-1"
+  }
+
+  lifecycle {
+    ignore_changes = ["backend_address_pool", "http_listener", "request_routing_rule"]
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMApplicationGatewayBackendPool_basic(rInt int, location string) string {
+	template := testAccAzureRMApplicationGatewaySubResource_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_pool" "test" {
+  name                   = "pool-standalone"
+  application_gateway_id = "${azurerm_application_gateway.test.id}"
+  fqdn_list              = ["terraform.io"]
+}
+`, template)
+}
+
+func testAccAzureRMApplicationGatewayBackendPool_ipAddresses(rInt int, location string) string {
+	template := testAccAzureRMApplicationGatewaySubResource_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_pool" "test" {
+  name                   = "pool-standalone"
+  application_gateway_id = "${azurerm_application_gateway.test.id}"
+  ip_address_list        = ["10.254.0.10", "10.254.0.11"]
+}
+`, template)
+}
@@ -0,0 +1,264 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationGatewayHTTPListener() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationGatewayHTTPListenerCreateUpdate,
+		Read:   resourceArmApplicationGatewayHTTPListenerRead,
+		Update: resourceArmApplicationGatewayHTTPListenerCreateUpdate,
+		Delete: resourceArmApplicationGatewayHTTPListenerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: applicationGatewaySubResourceStateImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"application_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"frontend_ip_configuration_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"frontend_ip_configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"frontend_port_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"frontend_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"protocol": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.HTTP),
+					string(network.HTTPS),
+				}, true),
+			},
+
+			"host_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ssl_certificate_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ssl_certificate_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"require_sni": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmApplicationGatewayHTTPListenerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	gatewayID := d.Get("application_gateway_id").(string)
+
+	armMutexKV.Lock(gatewayID)
+	defer armMutexKV.Unlock(gatewayID)
+
+	gateway, exists, err := retrieveApplicationGatewayById(gatewayID, meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
+	}
+	if !exists {
+		return fmt.Errorf("ApplicationGateway %q was not found", gatewayID)
+	}
+
+	if d.IsNewResource() {
+		if _, _, exists := findApplicationGatewayHTTPListenerByName(gateway, name); exists {
+			return fmt.Errorf("A HTTP Listener named %q already exists on ApplicationGateway %q - please import it into the state with `terraform import`", name, gatewayID)
+		}
+	}
+
+	frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, d.Get("frontend_ip_configuration_name").(string))
+	frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, d.Get("frontend_port_name").(string))
+
+	listener := network.ApplicationGatewayHTTPListener{
+		Name: utils.String(name),
+		ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
+			FrontendIPConfiguration: &network.SubResource{
+				ID: utils.String(frontendIPConfigID),
+			},
+			FrontendPort: &network.SubResource{
+				ID: utils.String(frontendPortID),
+			},
+			Protocol:                    network.ApplicationGatewayProtocol(d.Get("protocol").(string)),
+			RequireServerNameIndication: utils.Bool(d.Get("require_sni").(bool)),
+		},
+	}
+
+	if host := d.Get("host_name").(string); host != "" {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostName = utils.String(host)
+	}
+
+	if sslCertName := d.Get("ssl_certificate_name").(string); sslCertName != "" {
+		certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslCertificate = &network.SubResource{
+			ID: utils.String(certID),
+		}
+	}
+
+	listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+	if existing := gateway.ApplicationGatewayPropertiesFormat.HTTPListeners; existing != nil {
+		for _, v := range *existing {
+			// this listener is being updated, so remove the old copy
+			if v.Name != nil && *v.Name == name {
+				continue
+			}
+
+			listeners = append(listeners, v)
+		}
+	}
+	listeners = append(listeners, listener)
+	gateway.ApplicationGatewayPropertiesFormat.HTTPListeners = &listeners
+
+	if err := updateApplicationGateway(gateway, meta); err != nil {
+		return err
+	}
+
+	read, _, err := retrieveApplicationGatewayById(gatewayID, meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
+	}
+
+	config, _, exists := findApplicationGatewayHTTPListenerByName(read, name)
+	if !exists || config.ID == nil {
+		return fmt.Errorf("Cannot find created HTTP Listener %q in ApplicationGateway %q", name, gatewayID)
+	}
+
+	d.SetId(*config.ID)
+
+	return resourceArmApplicationGatewayHTTPListenerRead(d, meta)
+}
+
+func resourceArmApplicationGatewayHTTPListenerRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["httpListeners"]
+
+	gateway, exists, err := retrieveCachedApplicationGatewayById(d.Get("application_gateway_id").(string), meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] ApplicationGateway for HTTP Listener %q not found. Removing from state", name)
+		return nil
+	}
+
+	config, _, exists := findApplicationGatewayHTTPListenerByName(gateway, name)
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] ApplicationGateway HTTP Listener %q not found. Removing from state", name)
+		return nil
+	}
+
+	d.Set("name", config.Name)
+
+	if props := config.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
+		if port := props.FrontendPort; port != nil && port.ID != nil {
+			portID, err := parseAzureResourceID(*port.ID)
+			if err != nil {
+				return err
+			}
+			d.Set("frontend_port_name", portID.Path["frontendPorts"])
+			d.Set("frontend_port_id", port.ID)
+		}
+
+		if feConfig := props.FrontendIPConfiguration; feConfig != nil && feConfig.ID != nil {
+			feConfigID, err := parseAzureResourceID(*feConfig.ID)
+			if err != nil {
+				return err
+			}
+			d.Set("frontend_ip_configuration_name", feConfigID.Path["frontendIPConfigurations"])
+			d.Set("frontend_ip_configuration_id", feConfig.ID)
+		}
+
+		d.Set("protocol", string(props.Protocol))
+		d.Set("host_name", props.HostName)
+		d.Set("require_sni", props.RequireServerNameIndication)
+
+		if cert := props.SslCertificate; cert != nil && cert.ID != nil {
+			certID, err := parseAzureResourceID(*cert.ID)
+			if err != nil {
+				return err
+			}
+			d.Set("ssl_certificate_name", certID.Path["sslCertificates"])
+			d.Set("ssl_certificate_id", cert.ID)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmApplicationGatewayHTTPListenerDelete(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	gatewayID := d.Get("application_gateway_id").(string)
+
+	armMutexKV.Lock(gatewayID)
+	defer armMutexKV.Unlock(gatewayID)
+
+	gateway, exists, err := retrieveApplicationGatewayById(gatewayID, meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
+	}
+	if !exists {
+		return nil
+	}
+
+	_, index, exists := findApplicationGatewayHTTPListenerByName(gateway, name)
+	if !exists {
+		return nil
+	}
+
+	existing := *gateway.ApplicationGatewayPropertiesFormat.HTTPListeners
+	listeners := append(existing[:index], existing[index+1:]...)
+	gateway.ApplicationGatewayPropertiesFormat.HTTPListeners = &listeners
+
+	return updateApplicationGateway(gateway, meta)
+}
@@ -0,0 +1,117 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApplicationGatewayHTTPListener_basic(t *testing.T) {
+	resourceName := "azurerm_application_gateway_http_listener.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayHTTPListener_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayHTTPListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "Http"),
+					resource.TestCheckResourceAttrSet(resourceName, "frontend_ip_configuration_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "frontend_port_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGatewayHTTPListener_hostName(t *testing.T) {
+	resourceName := "azurerm_application_gateway_http_listener.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayHTTPListener_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayHTTPListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "host_name", ""),
+				),
+			},
+			{
+				Config: testAccAzureRMApplicationGatewayHTTPListener_hostName(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayHTTPListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "host_name", "terraform.io"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationGatewayHTTPListenerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		listenerName := rs.Primary.Attributes["name"]
+		gatewayID := rs.Primary.Attributes["application_gateway_id"]
+
+		gateway, exists, err := retrieveApplicationGatewayById(gatewayID, testAccProvider.Meta())
+		if err != nil {
+			return fmt.Errorf("Bad: Get on ApplicationGatewayClient: %+v", err)
+		}
+		if !exists {
+			return fmt.Errorf("Bad: App Gateway %q does not exist", gatewayID)
+		}
+
+		if _, _, exists := findApplicationGatewayHTTPListenerByName(gateway, listenerName); !exists {
+			return fmt.Errorf("Bad: HTTP Listener %q does not exist on App Gateway %q", listenerName, gatewayID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApplicationGatewayHTTPListener_basic(rInt int, location string) string {
+	template := testAccAzureRMApplicationGatewaySubResource_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "listener-standalone"
+  application_gateway_id         = "${azurerm_application_gateway.test.id}"
+  frontend_ip_configuration_name = "ip-config-public"
+  frontend_port_name             = "port-8080"
+  protocol                       = "Http"
+}
+`, template)
+}
+
+func testAccAzureRMApplicationGatewayHTTPListener_hostName(rInt int, location string) string {
+	template := testAccAzureRMApplicationGatewaySubResource_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "listener-standalone"
+  application_gateway_id         = "${azurerm_application_gateway.test.id}"
+  frontend_ip_configuration_name = "ip-config-public"
+  frontend_port_name             = "port-8080"
+  protocol                       = "Http"
+  host_name                      = "terraform.io"
+}
+`, template)
+}
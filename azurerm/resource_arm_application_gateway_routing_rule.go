@@ -0,0 +1,267 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationGatewayRoutingRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationGatewayRoutingRuleCreateUpdate,
+		Read:   resourceArmApplicationGatewayRoutingRuleRead,
+		Update: resourceArmApplicationGatewayRoutingRuleCreateUpdate,
+		Delete: resourceArmApplicationGatewayRoutingRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: applicationGatewaySubResourceStateImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"application_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"rule_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Basic),
+					string(network.PathBasedRouting),
+				}, true),
+			},
+
+			"http_listener_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"http_listener_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"backend_address_pool_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"backend_address_pool_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"backend_http_settings_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"backend_http_settings_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"url_path_map_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"url_path_map_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmApplicationGatewayRoutingRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	gatewayID := d.Get("application_gateway_id").(string)
+
+	armMutexKV.Lock(gatewayID)
+	defer armMutexKV.Unlock(gatewayID)
+
+	gateway, exists, err := retrieveApplicationGatewayById(gatewayID, meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
+	}
+	if !exists {
+		return fmt.Errorf("ApplicationGateway %q was not found", gatewayID)
+	}
+
+	if d.IsNewResource() {
+		if _, _, exists := findApplicationGatewayRequestRoutingRuleByName(gateway, name); exists {
+			return fmt.Errorf("A Request Routing Rule named %q already exists on ApplicationGateway %q - please import it into the state with `terraform import`", name, gatewayID)
+		}
+	}
+
+	httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, d.Get("http_listener_name").(string))
+
+	rule := network.ApplicationGatewayRequestRoutingRule{
+		Name: utils.String(name),
+		ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType: network.ApplicationGatewayRequestRoutingRuleType(d.Get("rule_type").(string)),
+			HTTPListener: &network.SubResource{
+				ID: utils.String(httpListenerID),
+			},
+		},
+	}
+
+	if backendAddressPoolName := d.Get("backend_address_pool_name").(string); backendAddressPoolName != "" {
+		backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendAddressPool = &network.SubResource{
+			ID: utils.String(backendAddressPoolID),
+		}
+	}
+
+	if backendHTTPSettingsName := d.Get("backend_http_settings_name").(string); backendHTTPSettingsName != "" {
+		backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
+			ID: utils.String(backendHTTPSettingsID),
+		}
+	}
+
+	if urlPathMapName := d.Get("url_path_map_name").(string); urlPathMapName != "" {
+		urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.URLPathMap = &network.SubResource{
+			ID: utils.String(urlPathMapID),
+		}
+	}
+
+	rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+	if existing := gateway.ApplicationGatewayPropertiesFormat.RequestRoutingRules; existing != nil {
+		for _, v := range *existing {
+			// this rule is being updated, so remove the old copy
+			if v.Name != nil && *v.Name == name {
+				continue
+			}
+
+			rules = append(rules, v)
+		}
+	}
+	rules = append(rules, rule)
+	gateway.ApplicationGatewayPropertiesFormat.RequestRoutingRules = &rules
+
+	if err := updateApplicationGateway(gateway, meta); err != nil {
+		return err
+	}
+
+	read, _, err := retrieveApplicationGatewayById(gatewayID, meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
+	}
+
+	config, _, exists := findApplicationGatewayRequestRoutingRuleByName(read, name)
+	if !exists || config.ID == nil {
+		return fmt.Errorf("Cannot find created Request Routing Rule %q in ApplicationGateway %q", name, gatewayID)
+	}
+
+	d.SetId(*config.ID)
+
+	return resourceArmApplicationGatewayRoutingRuleRead(d, meta)
+}
+
+func resourceArmApplicationGatewayRoutingRuleRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["requestRoutingRules"]
+
+	gateway, exists, err := retrieveCachedApplicationGatewayById(d.Get("application_gateway_id").(string), meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] ApplicationGateway for Request Routing Rule %q not found. Removing from state", name)
+		return nil
+	}
+
+	config, _, exists := findApplicationGatewayRequestRoutingRuleByName(gateway, name)
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] ApplicationGateway Request Routing Rule %q not found. Removing from state", name)
+		return nil
+	}
+
+	d.Set("name", config.Name)
+
+	if props := config.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil {
+		d.Set("rule_type", string(props.RuleType))
+
+		subResources := []struct {
+			subResource *network.SubResource
+			key         string
+			segment     string
+		}{
+			{props.HTTPListener, "http_listener", "httpListeners"},
+			{props.BackendAddressPool, "backend_address_pool", "backendAddressPools"},
+			{props.BackendHTTPSettings, "backend_http_settings", "backendHttpSettingsCollection"},
+			{props.URLPathMap, "url_path_map", "urlPathMaps"},
+		}
+
+		for _, v := range subResources {
+			name := ""
+			subResourceId := ""
+			if v.subResource != nil && v.subResource.ID != nil {
+				parsed, err := parseAzureResourceID(*v.subResource.ID)
+				if err != nil {
+					return err
+				}
+
+				name = parsed.Path[v.segment]
+				subResourceId = *v.subResource.ID
+			}
+
+			d.Set(fmt.Sprintf("%s_name", v.key), name)
+			d.Set(fmt.Sprintf("%s_id", v.key), subResourceId)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmApplicationGatewayRoutingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	gatewayID := d.Get("application_gateway_id").(string)
+
+	armMutexKV.Lock(gatewayID)
+	defer armMutexKV.Unlock(gatewayID)
+
+	gateway, exists, err := retrieveApplicationGatewayById(gatewayID, meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
+	}
+	if !exists {
+		return nil
+	}
+
+	_, index, exists := findApplicationGatewayRequestRoutingRuleByName(gateway, name)
+	if !exists {
+		return nil
+	}
+
+	existing := *gateway.ApplicationGatewayPropertiesFormat.RequestRoutingRules
+	rules := append(existing[:index], existing[index+1:]...)
+	gateway.ApplicationGatewayPropertiesFormat.RequestRoutingRules = &rules
+
+	return updateApplicationGateway(gateway, meta)
+}
@@ -0,0 +1,91 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApplicationGatewayRoutingRule_basic(t *testing.T) {
+	resourceName := "azurerm_application_gateway_routing_rule.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayRoutingRule_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayRoutingRuleExists(resourceName),
+					testCheckAzureRMApplicationGatewayBackendPoolExists("azurerm_application_gateway_backend_pool.test"),
+					testCheckAzureRMApplicationGatewayHTTPListenerExists("azurerm_application_gateway_http_listener.test"),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "Basic"),
+					resource.TestCheckResourceAttrSet(resourceName, "http_listener_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "backend_address_pool_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "backend_http_settings_id"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationGatewayRoutingRuleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		ruleName := rs.Primary.Attributes["name"]
+		gatewayID := rs.Primary.Attributes["application_gateway_id"]
+
+		gateway, exists, err := retrieveApplicationGatewayById(gatewayID, testAccProvider.Meta())
+		if err != nil {
+			return fmt.Errorf("Bad: Get on ApplicationGatewayClient: %+v", err)
+		}
+		if !exists {
+			return fmt.Errorf("Bad: App Gateway %q does not exist", gatewayID)
+		}
+
+		if _, _, exists := findApplicationGatewayRequestRoutingRuleByName(gateway, ruleName); !exists {
+			return fmt.Errorf("Bad: Request Routing Rule %q does not exist on App Gateway %q", ruleName, gatewayID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApplicationGatewayRoutingRule_basic(rInt int, location string) string {
+	template := testAccAzureRMApplicationGatewaySubResource_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_pool" "test" {
+  name                   = "pool-standalone"
+  application_gateway_id = "${azurerm_application_gateway.test.id}"
+  fqdn_list              = ["terraform.io"]
+}
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "listener-standalone"
+  application_gateway_id         = "${azurerm_application_gateway.test.id}"
+  frontend_ip_configuration_name = "ip-config-public"
+  frontend_port_name             = "port-8080"
+  protocol                       = "Http"
+}
+
+resource "azurerm_application_gateway_routing_rule" "test" {
+  name                       = "rule-standalone"
+  application_gateway_id     = "${azurerm_application_gateway.test.id}"
+  rule_type                  = "Basic"
+  http_listener_name         = "${azurerm_application_gateway_http_listener.test.name}"
+  backend_address_pool_name  = "${azurerm_application_gateway_backend_pool.test.name}"
+  backend_http_settings_name = "backend-http-1"
+}
+`, template)
+}
@@ -0,0 +1,250 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationInsightsAnalyticsItem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationInsightsAnalyticsItemCreateUpdate,
+		Read:   resourceArmApplicationInsightsAnalyticsItemRead,
+		Update: resourceArmApplicationInsightsAnalyticsItemCreateUpdate,
+		Delete: resourceArmApplicationInsightsAnalyticsItemDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"application_insights_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(insights.Query),
+					string(insights.Function),
+					string(insights.Folder),
+				}, false),
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(insights.ItemScopeShared),
+					string(insights.ItemScopeUser),
+				}, false),
+			},
+
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"function_alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"time_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmApplicationInsightsAnalyticsItemCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsAnalyticsItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Application Insights Analytics Item creation/update.")
+
+	name := d.Get("name").(string)
+	appInsightsId := d.Get("application_insights_id").(string)
+	itemType := d.Get("type").(string)
+	scope := d.Get("scope").(string)
+	functionAlias := d.Get("function_alias").(string)
+
+	id, err := parseAzureResourceID(appInsightsId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appInsightsName := id.Path["components"]
+
+	if itemType == string(insights.Function) && functionAlias == "" {
+		return fmt.Errorf("A `function_alias` must be specified when `type` is set to `function`")
+	}
+
+	parameters := insights.ApplicationInsightsComponentAnalyticsItem{
+		Name:    utils.String(name),
+		Content: utils.String(d.Get("content").(string)),
+		Scope:   insights.ItemScope(scope),
+		Type:    insights.ItemType(itemType),
+	}
+
+	if functionAlias != "" {
+		parameters.Properties = &insights.ApplicationInsightsComponentAnalyticsItemProperties{
+			FunctionAlias: utils.String(functionAlias),
+		}
+	}
+
+	// when updating the existing Item is overwritten in-place
+	overrideItem := false
+	if !d.IsNewResource() {
+		itemId, _, err := parseApplicationInsightsAnalyticsItemId(d.Id())
+		if err != nil {
+			return err
+		}
+		parameters.ID = utils.String(itemId)
+		overrideItem = true
+	}
+
+	scopePath := applicationInsightsAnalyticsItemScopePath(scope)
+	result, err := client.Put(ctx, resourceGroup, appInsightsName, scopePath, parameters, utils.Bool(overrideItem))
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Analytics Item %q (Application Insights %q / Resource Group %q): %+v", name, appInsightsName, resourceGroup, err)
+	}
+
+	if result.ID == nil {
+		return fmt.Errorf("Cannot read ID for Analytics Item %q (Application Insights %q / Resource Group %q)", name, appInsightsName, resourceGroup)
+	}
+
+	// the API only returns the ID of the Item, so we build up the Resource ID
+	d.SetId(fmt.Sprintf("%s/%s/%s", appInsightsId, string(scopePath), *result.ID))
+
+	return resourceArmApplicationInsightsAnalyticsItemRead(d, meta)
+}
+
+func resourceArmApplicationInsightsAnalyticsItemRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsAnalyticsItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appInsightsName := id.Path["components"]
+
+	itemId, scopePath, err := parseApplicationInsightsAnalyticsItemId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, appInsightsName, scopePath, itemId, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Analytics Item %q was not found in Application Insights %q / Resource Group %q - removing from state!", itemId, appInsightsName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Analytics Item %q (Application Insights %q / Resource Group %q): %+v", itemId, appInsightsName, resourceGroup, err)
+	}
+
+	appInsightsId := strings.TrimSuffix(d.Id(), fmt.Sprintf("/%s/%s", string(scopePath), itemId))
+
+	d.Set("name", resp.Name)
+	d.Set("application_insights_id", appInsightsId)
+	d.Set("type", string(resp.Type))
+	d.Set("scope", string(resp.Scope))
+	d.Set("content", resp.Content)
+	d.Set("version", resp.Version)
+	d.Set("time_created", resp.TimeCreated)
+	d.Set("time_modified", resp.TimeModified)
+
+	functionAlias := ""
+	if props := resp.Properties; props != nil && props.FunctionAlias != nil {
+		functionAlias = *props.FunctionAlias
+	}
+	d.Set("function_alias", functionAlias)
+
+	return nil
+}
+
+func resourceArmApplicationInsightsAnalyticsItemDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsAnalyticsItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appInsightsName := id.Path["components"]
+
+	itemId, scopePath, err := parseApplicationInsightsAnalyticsItemId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, resourceGroup, appInsightsName, scopePath, itemId, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Analytics Item %q (Application Insights %q / Resource Group %q): %+v", itemId, appInsightsName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func applicationInsightsAnalyticsItemScopePath(scope string) insights.ItemScopePath {
+	// Items owned by a specific user live under a different path to Shared Items
+	if scope == string(insights.ItemScopeUser) {
+		return insights.MyanalyticsItems
+	}
+
+	return insights.AnalyticsItems
+}
+
+func parseApplicationInsightsAnalyticsItemId(input string) (string, insights.ItemScopePath, error) {
+	id, err := parseAzureResourceID(input)
+	if err != nil {
+		return "", "", err
+	}
+
+	if v, ok := id.Path[string(insights.AnalyticsItems)]; ok {
+		return v, insights.AnalyticsItems, nil
+	}
+
+	if v, ok := id.Path[string(insights.MyanalyticsItems)]; ok {
+		return v, insights.MyanalyticsItems, nil
+	}
+
+	return "", "", fmt.Errorf("Unable to parse the Analytics Item ID from %q", input)
+}
@@ -0,0 +1,205 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMApplicationInsightsAnalyticsItem_sharedQuery(t *testing.T) {
+	resourceName := "azurerm_application_insights_analytics_item.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMApplicationInsightsAnalyticsItem_query(ri, location, "shared", "requests #test")
+	updatedConfig := testAccAzureRMApplicationInsightsAnalyticsItem_query(ri, location, "shared", "requests | take 10")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsAnalyticsItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsAnalyticsItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scope", "shared"),
+					resource.TestCheckResourceAttr(resourceName, "content", "requests #test"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsAnalyticsItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content", "requests | take 10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationInsightsAnalyticsItem_userQuery(t *testing.T) {
+	resourceName := "azurerm_application_insights_analytics_item.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationInsightsAnalyticsItem_query(ri, testLocation(), "user", "requests #test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsAnalyticsItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsAnalyticsItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scope", "user"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationInsightsAnalyticsItem_function(t *testing.T) {
+	resourceName := "azurerm_application_insights_analytics_item.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationInsightsAnalyticsItem_function(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsAnalyticsItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsAnalyticsItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "function"),
+					resource.TestCheckResourceAttr(resourceName, "function_alias", "myfunction"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationInsightsAnalyticsItemExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		appInsightsName := id.Path["components"]
+
+		itemId, scopePath, err := parseApplicationInsightsAnalyticsItemId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).appInsightsAnalyticsItemsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, appInsightsName, scopePath, itemId, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Analytics Item %q (Application Insights %q / Resource Group %q) does not exist", itemId, appInsightsName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appInsightsAnalyticsItemsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApplicationInsightsAnalyticsItemDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appInsightsAnalyticsItemsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_application_insights_analytics_item" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		appInsightsName := id.Path["components"]
+
+		itemId, scopePath, err := parseApplicationInsightsAnalyticsItemId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, resourceGroup, appInsightsName, scopePath, itemId, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Analytics Item %q still exists in Application Insights %q", itemId, appInsightsName)
+	}
+
+	return nil
+}
+
+func testAccAzureRMApplicationInsightsAnalyticsItem_query(rInt int, location, scope, content string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+
+resource "azurerm_application_insights_analytics_item" "test" {
+  name                    = "acctestappinsightsquery-%d"
+  application_insights_id = "${azurerm_application_insights.test.id}"
+  type                    = "query"
+  scope                   = "%s"
+  content                 = "%s"
+}
+`, rInt, location, rInt, rInt, scope, content)
+}
+
+func testAccAzureRMApplicationInsightsAnalyticsItem_function(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+
+resource "azurerm_application_insights_analytics_item" "test" {
+  name                    = "acctestappinsightsfunction-%d"
+  application_insights_id = "${azurerm_application_insights.test.id}"
+  type                    = "function"
+  scope                   = "shared"
+  content                 = "requests | take 10"
+  function_alias          = "myfunction"
+}
+`, rInt, location, rInt, rInt)
+}
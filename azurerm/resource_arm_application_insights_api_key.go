@@ -0,0 +1,210 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationInsightsAPIKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationInsightsAPIKeyCreate,
+		Read:   resourceArmApplicationInsightsAPIKeyRead,
+		Delete: resourceArmApplicationInsightsAPIKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"application_insights_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"read_permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"agentconfig",
+						"aggregate",
+						"api",
+						"draft",
+						"extendqueries",
+						"search",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"write_permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"annotations",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"api_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmApplicationInsightsAPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsAPIKeyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Application Insights API Key creation.")
+
+	name := d.Get("name").(string)
+	appInsightsId := d.Get("application_insights_id").(string)
+
+	id, err := parseAzureResourceID(appInsightsId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appInsightsName := id.Path["components"]
+
+	readPermissions := expandApplicationInsightsAPIKeyPermissions(appInsightsId, d.Get("read_permissions").(*schema.Set).List())
+	writePermissions := expandApplicationInsightsAPIKeyPermissions(appInsightsId, d.Get("write_permissions").(*schema.Set).List())
+	if len(readPermissions) == 0 && len(writePermissions) == 0 {
+		return fmt.Errorf("At least one of `read_permissions` or `write_permissions` must be specified")
+	}
+
+	parameters := insights.APIKeyRequest{
+		Name:                  utils.String(name),
+		LinkedReadProperties:  &readPermissions,
+		LinkedWriteProperties: &writePermissions,
+	}
+
+	result, err := client.Create(ctx, resourceGroup, appInsightsName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating API Key %q (Application Insights %q / Resource Group %q): %+v", name, appInsightsName, resourceGroup, err)
+	}
+
+	if result.ID == nil {
+		return fmt.Errorf("Cannot read ID for API Key %q (Application Insights %q / Resource Group %q)", name, appInsightsName, resourceGroup)
+	}
+
+	// the API returns the ID in a different casing to the Application Insights ID, so we build up the Resource ID
+	segments := strings.Split(*result.ID, "/")
+	keyId := segments[len(segments)-1]
+	d.SetId(fmt.Sprintf("%s/apiKeys/%s", appInsightsId, keyId))
+
+	// the API Key is only returned when it's created
+	d.Set("api_key", result.APIKey)
+
+	return resourceArmApplicationInsightsAPIKeyRead(d, meta)
+}
+
+func resourceArmApplicationInsightsAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsAPIKeyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appInsightsName := id.Path["components"]
+	keyId := id.Path["apiKeys"]
+
+	resp, err := client.Get(ctx, resourceGroup, appInsightsName, keyId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Key %q was not found in Application Insights %q / Resource Group %q - removing from state!", keyId, appInsightsName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Key %q (Application Insights %q / Resource Group %q): %+v", keyId, appInsightsName, resourceGroup, err)
+	}
+
+	appInsightsId := strings.TrimSuffix(d.Id(), fmt.Sprintf("/apiKeys/%s", keyId))
+
+	d.Set("name", resp.Name)
+	d.Set("application_insights_id", appInsightsId)
+
+	if err := d.Set("read_permissions", flattenApplicationInsightsAPIKeyPermissions(resp.LinkedReadProperties)); err != nil {
+		return fmt.Errorf("Error setting `read_permissions`: %+v", err)
+	}
+	if err := d.Set("write_permissions", flattenApplicationInsightsAPIKeyPermissions(resp.LinkedWriteProperties)); err != nil {
+		return fmt.Errorf("Error setting `write_permissions`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmApplicationInsightsAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsAPIKeyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appInsightsName := id.Path["components"]
+	keyId := id.Path["apiKeys"]
+
+	resp, err := client.Delete(ctx, resourceGroup, appInsightsName, keyId)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting API Key %q (Application Insights %q / Resource Group %q): %+v", keyId, appInsightsName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandApplicationInsightsAPIKeyPermissions(appInsightsId string, input []interface{}) []string {
+	results := make([]string, 0)
+
+	// permissions are scoped to the Application Insights component, e.g. `{appInsightsId}/api`
+	for _, v := range input {
+		results = append(results, fmt.Sprintf("%s/%s", appInsightsId, v.(string)))
+	}
+
+	return results
+}
+
+func flattenApplicationInsightsAPIKeyPermissions(input *[]string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		segments := strings.Split(v, "/")
+		results = append(results, segments[len(segments)-1])
+	}
+
+	return results
+}
@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMApplicationInsightsAPIKey_readPermissions(t *testing.T) {
+	resourceName := "azurerm_application_insights_api_key.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationInsightsAPIKey_basic(ri, testLocation(), `["aggregate", "api", "draft", "extendqueries", "search"]`, "[]")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_permissions.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "write_permissions.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationInsightsAPIKey_writePermissions(t *testing.T) {
+	resourceName := "azurerm_application_insights_api_key.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationInsightsAPIKey_basic(ri, testLocation(), "[]", `["annotations"]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_permissions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "write_permissions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationInsightsAPIKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		appInsightsName := id.Path["components"]
+		keyId := id.Path["apiKeys"]
+
+		client := testAccProvider.Meta().(*ArmClient).appInsightsAPIKeyClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, appInsightsName, keyId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: API Key %q (Application Insights %q / Resource Group %q) does not exist", keyId, appInsightsName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appInsightsAPIKeyClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApplicationInsightsAPIKeyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appInsightsAPIKeyClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_application_insights_api_key" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		appInsightsName := id.Path["components"]
+		keyId := id.Path["apiKeys"]
+
+		resp, err := client.Get(ctx, resourceGroup, appInsightsName, keyId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("API Key %q still exists in Application Insights %q", keyId, appInsightsName)
+	}
+
+	return nil
+}
+
+func testAccAzureRMApplicationInsightsAPIKey_basic(rInt int, location, readPermissions, writePermissions string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+
+resource "azurerm_application_insights_api_key" "test" {
+  name                    = "acctestappinsightsapikey-%d"
+  application_insights_id = "${azurerm_application_insights.test.id}"
+  read_permissions        = %s
+  write_permissions       = %s
+}
+`, rInt, location, rInt, rInt, readPermissions, writePermissions)
+}
@@ -0,0 +1,284 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationInsightsWebTest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationInsightsWebTestCreateUpdate,
+		Read:   resourceArmApplicationInsightsWebTestRead,
+		Update: resourceArmApplicationInsightsWebTestCreateUpdate,
+		Delete: resourceArmApplicationInsightsWebTestDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"application_insights_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"kind": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(insights.Ping),
+					string(insights.Multistep),
+				}, false),
+			},
+
+			"frequency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validateApplicationInsightsWebTestFrequency,
+			},
+
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 120),
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"retry_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"geo_locations": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"configuration": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"synthetic_monitor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmApplicationInsightsWebTestCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsWebTestsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Application Insights Web Test creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	appInsightsId := d.Get("application_insights_id").(string)
+	kind := d.Get("kind").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	// Web Tests are linked to the Application Insights component through a "hidden-link" tag
+	tags[applicationInsightsWebTestHiddenLinkTag(appInsightsId)] = "Resource"
+
+	parameters := insights.WebTest{
+		Kind:     insights.WebTestKind(kind),
+		Location: utils.String(location),
+		WebTestProperties: &insights.WebTestProperties{
+			SyntheticMonitorID: utils.String(name),
+			WebTestName:        utils.String(name),
+			Description:        utils.String(d.Get("description").(string)),
+			Enabled:            utils.Bool(d.Get("enabled").(bool)),
+			Frequency:          utils.Int32(int32(d.Get("frequency").(int))),
+			Timeout:            utils.Int32(int32(d.Get("timeout").(int))),
+			WebTestKind:        insights.WebTestKind(kind),
+			RetryEnabled:       utils.Bool(d.Get("retry_enabled").(bool)),
+			Locations:          expandApplicationInsightsWebTestGeoLocations(d.Get("geo_locations").([]interface{})),
+			Configuration: &insights.WebTestPropertiesConfiguration{
+				WebTest: utils.String(d.Get("configuration").(string)),
+			},
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Application Insights Web Test %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Insights Web Test %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Application Insights Web Test %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApplicationInsightsWebTestRead(d, meta)
+}
+
+func resourceArmApplicationInsightsWebTestRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsWebTestsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["webtests"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Application Insights Web Test %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Application Insights Web Test %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("kind", string(resp.Kind))
+
+	if props := resp.WebTestProperties; props != nil {
+		d.Set("synthetic_monitor_id", props.SyntheticMonitorID)
+		d.Set("description", props.Description)
+		d.Set("enabled", props.Enabled)
+		d.Set("frequency", props.Frequency)
+		d.Set("timeout", props.Timeout)
+		d.Set("retry_enabled", props.RetryEnabled)
+
+		if config := props.Configuration; config != nil {
+			d.Set("configuration", config.WebTest)
+		}
+
+		if err := d.Set("geo_locations", flattenApplicationInsightsWebTestGeoLocations(props.Locations)); err != nil {
+			return fmt.Errorf("Error setting `geo_locations`: %+v", err)
+		}
+	}
+
+	// the hidden-link tag is managed by us, so we pull the Application Insights ID out of it rather than exposing it
+	for key := range resp.Tags {
+		if strings.HasPrefix(key, "hidden-link:") {
+			d.Set("application_insights_id", strings.TrimPrefix(key, "hidden-link:"))
+			delete(resp.Tags, key)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmApplicationInsightsWebTestDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsWebTestsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["webtests"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Application Insights Web Test %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func validateApplicationInsightsWebTestFrequency(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(int)
+
+	if value != 300 && value != 600 && value != 900 {
+		errors = append(errors, fmt.Errorf("%q must be one of 300, 600 or 900 seconds", k))
+	}
+
+	return warnings, errors
+}
+
+func applicationInsightsWebTestHiddenLinkTag(appInsightsId string) string {
+	return fmt.Sprintf("hidden-link:%s", appInsightsId)
+}
+
+func expandApplicationInsightsWebTestGeoLocations(input []interface{}) *[]insights.WebTestGeolocation {
+	locations := make([]insights.WebTestGeolocation, 0)
+
+	for _, v := range input {
+		locations = append(locations, insights.WebTestGeolocation{
+			Location: utils.String(v.(string)),
+		})
+	}
+
+	return &locations
+}
+
+func flattenApplicationInsightsWebTestGeoLocations(input *[]insights.WebTestGeolocation) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		if v.Location != nil {
+			results = append(results, *v.Location)
+		}
+	}
+
+	return results
+}
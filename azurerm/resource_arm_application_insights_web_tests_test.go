@@ -0,0 +1,232 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMApplicationInsightsWebTest_basic(t *testing.T) {
+	resourceName := "azurerm_application_insights_web_test.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationInsightsWebTest_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsWebTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsWebTestExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kind", "ping"),
+					resource.TestCheckResourceAttr(resourceName, "geo_locations.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "synthetic_monitor_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationInsightsWebTest_update(t *testing.T) {
+	resourceName := "azurerm_application_insights_web_test.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMApplicationInsightsWebTest_basic(ri, location)
+	updatedConfig := testAccAzureRMApplicationInsightsWebTest_complete(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsWebTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsWebTestExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "300"),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "30"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsWebTestExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "900"),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "retry_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "geo_locations.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMApplicationInsightsWebTestFrequency_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    300,
+			ErrCount: 0,
+		},
+		{
+			Value:    600,
+			ErrCount: 0,
+		},
+		{
+			Value:    900,
+			ErrCount: 0,
+		},
+		{
+			Value:    1200,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateApplicationInsightsWebTestFrequency(tc.Value, "frequency")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Web Test Frequency %d to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMApplicationInsightsWebTestExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).appInsightsWebTestsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Application Insights Web Test %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appInsightsWebTestsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApplicationInsightsWebTestDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appInsightsWebTestsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_application_insights_web_test" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Application Insights Web Test still exists:\n%#v", resp.WebTestProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMApplicationInsightsWebTest_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+
+resource "azurerm_application_insights_web_test" "test" {
+  name                    = "acctestappinsightswebtest-%d"
+  location                = "${azurerm_resource_group.test.location}"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  application_insights_id = "${azurerm_application_insights.test.id}"
+  kind                    = "ping"
+  geo_locations           = ["us-tx-sn1-azr"]
+
+  configuration = <<XML
+<WebTest Name="WebTest1" Id="ABD48585-0831-40CB-9069-682EA6BB3583" Enabled="True" CssProjectStructure="" CssIteration="" Timeout="0" WorkItemIds="" xmlns="http://microsoft.com/schemas/VisualStudio/TeamTest/2010" Description="" CredentialUserName="" CredentialPassword="" PreAuthenticate="True" Proxy="default" StopOnError="False" RecordedResultFile="" ResultsLocale="">
+  <Items>
+    <Request Method="GET" Guid="a5f10126-e4cd-570d-961c-cea43999a200" Version="1.1" Url="http://microsoft.com" ThinkTime="0" Timeout="300" ParseDependentRequests="True" FollowRedirects="True" RecordResult="True" Cache="False" ResponseTimeGoal="0" Encoding="utf-8" ExpectedHttpStatusCode="200" ExpectedResponseUrl="" ReportingName="" IgnoreHttpStatusCode="False" />
+  </Items>
+</WebTest>
+XML
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMApplicationInsightsWebTest_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+
+resource "azurerm_application_insights_web_test" "test" {
+  name                    = "acctestappinsightswebtest-%d"
+  location                = "${azurerm_resource_group.test.location}"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  application_insights_id = "${azurerm_application_insights.test.id}"
+  kind                    = "ping"
+  frequency               = 900
+  timeout                 = 120
+  enabled                 = true
+  retry_enabled           = true
+  description             = "Pings microsoft.com from three locations"
+  geo_locations           = ["us-tx-sn1-azr", "us-il-ch1-azr", "emea-nl-ams-azr"]
+
+  configuration = <<XML
+<WebTest Name="WebTest1" Id="ABD48585-0831-40CB-9069-682EA6BB3583" Enabled="True" CssProjectStructure="" CssIteration="" Timeout="0" WorkItemIds="" xmlns="http://microsoft.com/schemas/VisualStudio/TeamTest/2010" Description="" CredentialUserName="" CredentialPassword="" PreAuthenticate="True" Proxy="default" StopOnError="False" RecordedResultFile="" ResultsLocale="">
+  <Items>
+    <Request Method="GET" Guid="a5f10126-e4cd-570d-961c-cea43999a200" Version="1.1" Url="http://microsoft.com" ThinkTime="0" Timeout="300" ParseDependentRequests="True" FollowRedirects="True" RecordResult="True" Cache="False" ResponseTimeGoal="0" Encoding="utf-8" ExpectedHttpStatusCode="200" ExpectedResponseUrl="" ReportingName="" IgnoreHttpStatusCode="False" />
+  </Items>
+</WebTest>
+XML
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
@@ -47,14 +47,16 @@ func resourceArmApplicationSecurityGroupCreateUpdate(d *schema.ResourceData, met
 
 	securityGroup := network.ApplicationSecurityGroup{
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 	}
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, securityGroup)
 	if err != nil {
 		return fmt.Errorf("Error creating Application Security Group %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Network", "applicationSecurityGroups", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error waiting for the Application Security Group %q (Resource Group %q) to finish creating: %+v", name, resourceGroup, err)
 	}
@@ -98,7 +100,7 @@ func resourceArmApplicationSecurityGroupRead(d *schema.ResourceData, meta interf
 	if location := resp.Location; location != nil {
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -123,7 +125,7 @@ func resourceArmApplicationSecurityGroupDelete(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		if !response.WasNotFound(future.Response()) {
 			return fmt.Errorf("Error waiting for deletion of Application Security Group %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -81,7 +81,7 @@ func resourceArmAutomationAccountCreateUpdate(d *schema.ResourceData, meta inter
 		},
 
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 	}
 
 	_, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
@@ -133,7 +133,7 @@ func resourceArmAutomationAccountRead(d *schema.ResourceData, meta interface{})
 	flattenAndSetAutomationAccountSku(d, resp.Sku)
 
 	if tags := resp.Tags; tags != nil {
-		flattenAndSetTags(d, tags)
+		flattenAndSetTags(d, tags, meta)
 	}
 
 	return nil
@@ -139,7 +139,7 @@ func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta inter
 		},
 
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 	}
 
 	_, err := client.CreateOrUpdate(ctx, resGroup, accName, name, parameters)
@@ -198,7 +198,7 @@ func resourceArmAutomationRunbookRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if tags := resp.Tags; tags != nil {
-		flattenAndSetTags(d, tags)
+		flattenAndSetTags(d, tags, meta)
 	}
 
 	return nil
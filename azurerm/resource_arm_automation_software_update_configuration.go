@@ -0,0 +1,784 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2017-05-15-preview/automation"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAutomationSoftwareUpdateConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationSoftwareUpdateConfigurationCreateUpdate,
+		Read:   resourceArmAutomationSoftwareUpdateConfigurationRead,
+		Update: resourceArmAutomationSoftwareUpdateConfigurationCreateUpdate,
+		Delete: resourceArmAutomationSoftwareUpdateConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"automation_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"operating_system": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(automation.Linux),
+					string(automation.Windows),
+				}, false),
+			},
+
+			"windows": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"linux"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"classifications": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(automation.WindowsUpdateClassesCritical),
+									string(automation.WindowsUpdateClassesDefinition),
+									string(automation.WindowsUpdateClassesFeaturePack),
+									string(automation.WindowsUpdateClassesSecurity),
+									string(automation.WindowsUpdateClassesServicePack),
+									string(automation.WindowsUpdateClassesTools),
+									string(automation.WindowsUpdateClassesUnclassified),
+									string(automation.WindowsUpdateClassesUpdateRollup),
+									string(automation.WindowsUpdateClassesUpdates),
+								}, false),
+							},
+							Set: set.HashStringIgnoreCase,
+						},
+
+						"included_kb_numbers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+
+						"excluded_kb_numbers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+
+						"reboot_setting": automationSoftwareUpdateConfigurationRebootSettingSchema(),
+					},
+				},
+			},
+
+			"linux": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"windows"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"classifications": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(automation.Critical),
+									string(automation.Other),
+									string(automation.Security),
+									string(automation.Unclassified),
+								}, false),
+							},
+							Set: set.HashStringIgnoreCase,
+						},
+
+						"included_packages": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+
+						"excluded_packages": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+
+						"reboot_setting": automationSoftwareUpdateConfigurationRebootSettingSchema(),
+					},
+				},
+			},
+
+			"virtual_machine_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"non_azure_computer_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"azure_query": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scopes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+
+						"locations": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								StateFunc:        azureRMNormalizeLocation,
+								DiffSuppressFunc: azureRMSuppressLocationDiff,
+							},
+						},
+
+						"tags": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+								},
+							},
+						},
+
+						"tag_filter": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "All",
+							ValidateFunc: validation.StringInSlice([]string{
+								"All",
+								"Any",
+							}, false),
+						},
+					},
+				},
+			},
+
+			// the maintenance window, as an ISO8601 duration
+			"duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PT2H",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frequency": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(automation.Day),
+								string(automation.Hour),
+								string(automation.Month),
+								string(automation.OneTime),
+								string(automation.Week),
+							}, true),
+						},
+
+						//ignored when frequency is `OneTime`
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+
+						"start_time": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validate.RFC3339Time,
+						},
+
+						"expiry_time": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validate.RFC3339Time,
+						},
+
+						"time_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "UTC",
+						},
+
+						"week_days": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Monday",
+									"Tuesday",
+									"Wednesday",
+									"Thursday",
+									"Friday",
+									"Saturday",
+									"Sunday",
+								}, true),
+							},
+							Set: set.HashStringIgnoreCase,
+						},
+
+						"month_days": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validate.IntBetweenAndNot(-1, 31, 0),
+							},
+							Set: set.HashInt,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			operatingSystem := diff.Get("operating_system").(string)
+
+			_, hasWindows := diff.GetOk("windows")
+			if operatingSystem == string(automation.Windows) && !hasWindows {
+				return fmt.Errorf("a `windows` block must be specified when `operating_system` is `Windows`")
+			}
+
+			_, hasLinux := diff.GetOk("linux")
+			if operatingSystem == string(automation.Linux) && !hasLinux {
+				return fmt.Errorf("a `linux` block must be specified when `operating_system` is `Linux`")
+			}
+
+			_, hasVirtualMachines := diff.GetOk("virtual_machine_ids")
+			_, hasNonAzureComputers := diff.GetOk("non_azure_computer_names")
+			_, hasAzureQueries := diff.GetOk("azure_query")
+			if !hasVirtualMachines && !hasNonAzureComputers && !hasAzureQueries {
+				return fmt.Errorf("at least one of `virtual_machine_ids`, `non_azure_computer_names` or `azure_query` must be specified")
+			}
+
+			return nil
+		},
+	}
+}
+
+func automationSoftwareUpdateConfigurationRebootSettingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "IfRequired",
+		ValidateFunc: validation.StringInSlice([]string{
+			"Always",
+			"IfRequired",
+			"Never",
+			"RebootOnly",
+		}, false),
+	}
+}
+
+func resourceArmAutomationSoftwareUpdateConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationSoftwareUpdateConfigurationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM Automation Software Update Configuration creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("automation_account_name").(string)
+
+	parameters := automation.SoftwareUpdateConfiguration{
+		Name: utils.String(name),
+		SoftwareUpdateConfigurationProperties: &automation.SoftwareUpdateConfigurationProperties{
+			UpdateConfiguration: expandAutomationSoftwareUpdateConfigurationUpdateConfiguration(d),
+			ScheduleInfo:        expandAutomationSoftwareUpdateConfigurationSchedule(d.Get("schedule").([]interface{})),
+		},
+	}
+
+	// the dynamic Azure Queries aren't available in the version of the Azure SDK used by this Provider - the newer
+	// API Version is also used when they're removed, since the older API Version leaves the existing queries in place
+	if queries := expandAutomationSoftwareUpdateConfigurationAzureQueries(d.Get("azure_query").([]interface{})); len(queries) > 0 || d.HasChange("azure_query") {
+		targetsClient := meta.(*ArmClient).automationSoftwareUpdateConfigurationsTargetsClient
+
+		body, err := expandAutomationSoftwareUpdateConfigurationWithAzureQueries(parameters, queries)
+		if err != nil {
+			return err
+		}
+
+		subscriptionId := meta.(*ArmClient).subscriptionId
+		id := fmt.Sprintf("%s/softwareUpdateConfigurations/%s", azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Automation", "automationAccounts", accountName), name)
+		future, err := targetsClient.CreateOrUpdate(ctx, id, body)
+		if err != nil {
+			return fmt.Errorf("Error creating/updating Automation Software Update Configuration %q (Automation Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+		}
+
+		if err := waitForCompletion(ctx, &future.Future, targetsClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for creation/update of Automation Software Update Configuration %q (Automation Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+		}
+	} else {
+		if _, err := client.Create(ctx, resourceGroup, accountName, name, parameters, ""); err != nil {
+			return fmt.Errorf("Error creating/updating Automation Software Update Configuration %q (Automation Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+		}
+	}
+
+	read, err := client.GetByName(ctx, resourceGroup, accountName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Automation Software Update Configuration %q (Automation Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Automation Software Update Configuration %q (Automation Account %q / Resource Group %q)", name, accountName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAutomationSoftwareUpdateConfigurationRead(d, meta)
+}
+
+func resourceArmAutomationSoftwareUpdateConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationSoftwareUpdateConfigurationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+	name := id.Path["softwareUpdateConfigurations"]
+
+	resp, err := client.GetByName(ctx, resourceGroup, accountName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Automation Software Update Configuration %q was not found in Automation Account %q / Resource Group %q - removing from state!", name, accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Automation Software Update Configuration %q (Automation Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("automation_account_name", accountName)
+
+	if props := resp.SoftwareUpdateConfigurationProperties; props != nil {
+		if config := props.UpdateConfiguration; config != nil {
+			d.Set("operating_system", string(config.OperatingSystem))
+			d.Set("duration", config.Duration)
+
+			if err := d.Set("windows", flattenAutomationSoftwareUpdateConfigurationWindows(config.Windows)); err != nil {
+				return fmt.Errorf("Error setting `windows`: %+v", err)
+			}
+
+			if err := d.Set("linux", flattenAutomationSoftwareUpdateConfigurationLinux(config.Linux)); err != nil {
+				return fmt.Errorf("Error setting `linux`: %+v", err)
+			}
+
+			if err := d.Set("virtual_machine_ids", flattenAutomationSoftwareUpdateConfigurationStrings(config.AzureVirtualMachines)); err != nil {
+				return fmt.Errorf("Error setting `virtual_machine_ids`: %+v", err)
+			}
+
+			if err := d.Set("non_azure_computer_names", flattenAutomationSoftwareUpdateConfigurationStrings(config.NonAzureComputerNames)); err != nil {
+				return fmt.Errorf("Error setting `non_azure_computer_names`: %+v", err)
+			}
+		}
+
+		if err := d.Set("schedule", flattenAutomationSoftwareUpdateConfigurationSchedule(props.ScheduleInfo)); err != nil {
+			return fmt.Errorf("Error setting `schedule`: %+v", err)
+		}
+	}
+
+	// the dynamic Azure Queries aren't returned in the version of the API used by the Azure SDK
+	var targets automationSoftwareUpdateConfigurationTargetProperties
+	if _, err := meta.(*ArmClient).automationSoftwareUpdateConfigurationsTargetsClient.Get(ctx, d.Id(), &targets); err != nil {
+		return fmt.Errorf("Error retrieving Azure Queries for Automation Software Update Configuration %q (Automation Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+	var azureQueries *[]automationSoftwareUpdateConfigurationAzureQuery
+	if props := targets.Properties; props != nil && props.UpdateConfiguration != nil && props.UpdateConfiguration.Targets != nil {
+		azureQueries = props.UpdateConfiguration.Targets.AzureQueries
+	}
+	if err := d.Set("azure_query", flattenAutomationSoftwareUpdateConfigurationAzureQueries(azureQueries)); err != nil {
+		return fmt.Errorf("Error setting `azure_query`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmAutomationSoftwareUpdateConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationSoftwareUpdateConfigurationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+	name := id.Path["softwareUpdateConfigurations"]
+
+	resp, err := client.Delete(ctx, resourceGroup, accountName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Automation Software Update Configuration %q (Automation Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandAutomationSoftwareUpdateConfigurationUpdateConfiguration(d *schema.ResourceData) *automation.UpdateConfiguration {
+	config := automation.UpdateConfiguration{
+		OperatingSystem:       automation.OperatingSystemType(d.Get("operating_system").(string)),
+		Duration:              utils.String(d.Get("duration").(string)),
+		AzureVirtualMachines:  expandAutomationSoftwareUpdateConfigurationStringsPtr(d.Get("virtual_machine_ids").([]interface{})),
+		NonAzureComputerNames: expandAutomationSoftwareUpdateConfigurationStringsPtr(d.Get("non_azure_computer_names").([]interface{})),
+	}
+
+	if v := d.Get("windows").([]interface{}); len(v) > 0 {
+		windows := v[0].(map[string]interface{})
+		config.Windows = &automation.WindowsProperties{
+			// the classifications are sent as a comma separated string
+			IncludedUpdateClassifications: automation.WindowsUpdateClasses(strings.Join(expandAutomationSoftwareUpdateConfigurationStrings(windows["classifications"].(*schema.Set).List()), ", ")),
+			IncludedKbNumbers:             expandAutomationSoftwareUpdateConfigurationStringsPtr(windows["included_kb_numbers"].([]interface{})),
+			ExcludedKbNumbers:             expandAutomationSoftwareUpdateConfigurationStringsPtr(windows["excluded_kb_numbers"].([]interface{})),
+			RebootSetting:                 utils.String(windows["reboot_setting"].(string)),
+		}
+	}
+
+	if v := d.Get("linux").([]interface{}); len(v) > 0 {
+		linux := v[0].(map[string]interface{})
+		config.Linux = &automation.LinuxProperties{
+			IncludedPackageClassifications: automation.LinuxUpdateClasses(strings.Join(expandAutomationSoftwareUpdateConfigurationStrings(linux["classifications"].(*schema.Set).List()), ", ")),
+			IncludedPackageNameMasks:       expandAutomationSoftwareUpdateConfigurationStringsPtr(linux["included_packages"].([]interface{})),
+			ExcludedPackageNameMasks:       expandAutomationSoftwareUpdateConfigurationStringsPtr(linux["excluded_packages"].([]interface{})),
+			RebootSetting:                  utils.String(linux["reboot_setting"].(string)),
+		}
+	}
+
+	return &config
+}
+
+func flattenAutomationSoftwareUpdateConfigurationWindows(input *automation.WindowsProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"classifications":     flattenAutomationSoftwareUpdateConfigurationClassifications(string(input.IncludedUpdateClassifications)),
+		"included_kb_numbers": flattenAutomationSoftwareUpdateConfigurationStrings(input.IncludedKbNumbers),
+		"excluded_kb_numbers": flattenAutomationSoftwareUpdateConfigurationStrings(input.ExcludedKbNumbers),
+	}
+
+	if v := input.RebootSetting; v != nil {
+		output["reboot_setting"] = *v
+	}
+
+	return []interface{}{output}
+}
+
+func flattenAutomationSoftwareUpdateConfigurationLinux(input *automation.LinuxProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"classifications":   flattenAutomationSoftwareUpdateConfigurationClassifications(string(input.IncludedPackageClassifications)),
+		"included_packages": flattenAutomationSoftwareUpdateConfigurationStrings(input.IncludedPackageNameMasks),
+		"excluded_packages": flattenAutomationSoftwareUpdateConfigurationStrings(input.ExcludedPackageNameMasks),
+	}
+
+	if v := input.RebootSetting; v != nil {
+		output["reboot_setting"] = *v
+	}
+
+	return []interface{}{output}
+}
+
+func flattenAutomationSoftwareUpdateConfigurationClassifications(input string) *schema.Set {
+	output := &schema.Set{F: set.HashStringIgnoreCase}
+
+	for _, classification := range strings.Split(input, ",") {
+		if v := strings.TrimSpace(classification); v != "" {
+			output.Add(v)
+		}
+	}
+
+	return output
+}
+
+func expandAutomationSoftwareUpdateConfigurationAzureQueries(input []interface{}) []automationSoftwareUpdateConfigurationAzureQuery {
+	queries := make([]automationSoftwareUpdateConfigurationAzureQuery, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		locations := make([]string, 0)
+		for _, location := range raw["locations"].([]interface{}) {
+			locations = append(locations, azureRMNormalizeLocation(location.(string)))
+		}
+
+		tags := make(map[string][]string)
+		for _, t := range raw["tags"].([]interface{}) {
+			tag := t.(map[string]interface{})
+			tags[tag["tag"].(string)] = expandAutomationSoftwareUpdateConfigurationStrings(tag["values"].([]interface{}))
+		}
+
+		queries = append(queries, automationSoftwareUpdateConfigurationAzureQuery{
+			Scope:     expandAutomationSoftwareUpdateConfigurationStringsPtr(raw["scopes"].([]interface{})),
+			Locations: &locations,
+			TagSettings: &automationSoftwareUpdateConfigurationTagSettings{
+				Tags:           tags,
+				FilterOperator: raw["tag_filter"].(string),
+			},
+		})
+	}
+
+	return queries
+}
+
+func flattenAutomationSoftwareUpdateConfigurationAzureQueries(input *[]automationSoftwareUpdateConfigurationAzureQuery) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, query := range *input {
+		locations := make([]interface{}, 0)
+		if query.Locations != nil {
+			for _, location := range *query.Locations {
+				locations = append(locations, azureRMNormalizeLocation(location))
+			}
+		}
+
+		// the tags are returned as a map, so are sorted by name to give a consistent ordering
+		tags := make([]interface{}, 0)
+		tagFilter := "All"
+		if settings := query.TagSettings; settings != nil {
+			names := make([]string, 0)
+			for name := range settings.Tags {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				values := make([]interface{}, 0)
+				for _, value := range settings.Tags[name] {
+					values = append(values, value)
+				}
+
+				tags = append(tags, map[string]interface{}{
+					"tag":    name,
+					"values": values,
+				})
+			}
+
+			if settings.FilterOperator != "" {
+				tagFilter = settings.FilterOperator
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"scopes":     flattenAutomationSoftwareUpdateConfigurationStrings(query.Scope),
+			"locations":  locations,
+			"tags":       tags,
+			"tag_filter": tagFilter,
+		})
+	}
+
+	return results
+}
+
+func expandAutomationSoftwareUpdateConfigurationSchedule(input []interface{}) *automation.ScheduleProperties {
+	v := input[0].(map[string]interface{})
+
+	schedule := automation.ScheduleProperties{
+		Frequency: automation.ScheduleFrequency(v["frequency"].(string)),
+		TimeZone:  utils.String(v["time_zone"].(string)),
+		IsEnabled: utils.Bool(true),
+	}
+
+	// these are validated by the schema
+	startTime, _ := time.Parse(time.RFC3339, v["start_time"].(string))
+	schedule.StartTime = &date.Time{Time: startTime}
+
+	if expiry := v["expiry_time"].(string); expiry != "" {
+		expiryTime, _ := time.Parse(time.RFC3339, expiry)
+		schedule.ExpiryTime = &date.Time{Time: expiryTime}
+	}
+
+	if !strings.EqualFold(string(schedule.Frequency), string(automation.OneTime)) {
+		schedule.Interval = utils.Int32(int32(v["interval"].(int)))
+	}
+
+	weekDays := make([]string, 0)
+	for _, day := range v["week_days"].(*schema.Set).List() {
+		weekDays = append(weekDays, day.(string))
+	}
+
+	monthDays := make([]int32, 0)
+	for _, day := range v["month_days"].(*schema.Set).List() {
+		monthDays = append(monthDays, int32(day.(int)))
+	}
+
+	if len(weekDays) > 0 || len(monthDays) > 0 {
+		schedule.AdvancedSchedule = &automation.AdvancedSchedule{}
+		if len(weekDays) > 0 {
+			schedule.AdvancedSchedule.WeekDays = &weekDays
+		}
+		if len(monthDays) > 0 {
+			schedule.AdvancedSchedule.MonthDays = &monthDays
+		}
+	}
+
+	return &schedule
+}
+
+func flattenAutomationSoftwareUpdateConfigurationSchedule(input *automation.ScheduleProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"frequency": string(input.Frequency),
+		// the interval isn't returned when frequency is `OneTime`
+		"interval": 1,
+	}
+
+	if v := input.Interval; v != nil {
+		output["interval"] = int(*v)
+	}
+	if v := input.StartTime; v != nil {
+		output["start_time"] = v.Format(time.RFC3339)
+	}
+	if v := input.ExpiryTime; v != nil {
+		output["expiry_time"] = v.Format(time.RFC3339)
+	}
+	if v := input.TimeZone; v != nil {
+		output["time_zone"] = *v
+	}
+
+	weekDays := &schema.Set{F: set.HashStringIgnoreCase}
+	monthDays := &schema.Set{F: set.HashInt}
+	if advanced := input.AdvancedSchedule; advanced != nil {
+		if v := advanced.WeekDays; v != nil {
+			for _, day := range *v {
+				weekDays.Add(day)
+			}
+		}
+		if v := advanced.MonthDays; v != nil {
+			for _, day := range *v {
+				monthDays.Add(int(day))
+			}
+		}
+	}
+	output["week_days"] = weekDays
+	output["month_days"] = monthDays
+
+	return []interface{}{output}
+}
+
+func expandAutomationSoftwareUpdateConfigurationStrings(input []interface{}) []string {
+	results := make([]string, 0)
+	for _, v := range input {
+		results = append(results, v.(string))
+	}
+	return results
+}
+
+func expandAutomationSoftwareUpdateConfigurationStringsPtr(input []interface{}) *[]string {
+	results := expandAutomationSoftwareUpdateConfigurationStrings(input)
+	return &results
+}
+
+func flattenAutomationSoftwareUpdateConfigurationStrings(input *[]string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, v)
+	}
+	return results
+}
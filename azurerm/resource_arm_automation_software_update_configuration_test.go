@@ -0,0 +1,302 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2017-05-15-preview/automation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAutomationSoftwareUpdateConfiguration_linux(t *testing.T) {
+	resourceName := "azurerm_automation_software_update_configuration.test"
+	ri := acctest.RandInt()
+	startTime := time.Now().UTC().Add(time.Hour * 7).Format("2006-01-02T15:04:00Z07:00")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationSoftwareUpdateConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationSoftwareUpdateConfiguration_linux(ri, testLocation(), startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationSoftwareUpdateConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "operating_system", "Linux"),
+					resource.TestCheckResourceAttr(resourceName, "linux.0.classifications.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.frequency", "Day"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAutomationSoftwareUpdateConfiguration_windows(t *testing.T) {
+	resourceName := "azurerm_automation_software_update_configuration.test"
+	ri := acctest.RandInt()
+	startTime := time.Now().UTC().Add(time.Hour * 7).Format("2006-01-02T15:04:00Z07:00")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationSoftwareUpdateConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationSoftwareUpdateConfiguration_windows(ri, testLocation(), startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationSoftwareUpdateConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "operating_system", "Windows"),
+					resource.TestCheckResourceAttr(resourceName, "windows.0.excluded_kb_numbers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.week_days.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAutomationSoftwareUpdateConfiguration_azureQuery(t *testing.T) {
+	resourceName := "azurerm_automation_software_update_configuration.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	startTime := time.Now().UTC().Add(time.Hour * 7).Format("2006-01-02T15:04:00Z07:00")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationSoftwareUpdateConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationSoftwareUpdateConfiguration_azureQuery(ri, location, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationSoftwareUpdateConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "azure_query.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "azure_query.0.scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "azure_query.0.tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "azure_query.0.tags.0.tag", "environment"),
+					resource.TestCheckResourceAttr(resourceName, "azure_query.0.tag_filter", "Any"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMAutomationSoftwareUpdateConfiguration_linux(ri, location, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationSoftwareUpdateConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "azure_query.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandAutomationSoftwareUpdateConfigurationWithAzureQueries(t *testing.T) {
+	scope := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+	parameters := automation.SoftwareUpdateConfiguration{
+		SoftwareUpdateConfigurationProperties: &automation.SoftwareUpdateConfigurationProperties{
+			UpdateConfiguration: &automation.UpdateConfiguration{
+				OperatingSystem: automation.Linux,
+				Duration:        utils.String("PT2H"),
+			},
+		},
+	}
+	queries := expandAutomationSoftwareUpdateConfigurationAzureQueries([]interface{}{
+		map[string]interface{}{
+			"scopes":    []interface{}{scope},
+			"locations": []interface{}{"West Europe"},
+			"tags": []interface{}{
+				map[string]interface{}{
+					"tag":    "environment",
+					"values": []interface{}{"Production", "Staging"},
+				},
+			},
+			"tag_filter": "Any",
+		},
+	})
+
+	output, err := expandAutomationSoftwareUpdateConfigurationWithAzureQueries(parameters, queries)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	properties := output["properties"].(map[string]interface{})
+	updateConfiguration := properties["updateConfiguration"].(map[string]interface{})
+	if updateConfiguration["duration"] != "PT2H" {
+		t.Fatalf("Expected the existing properties to be retained but got %+v", updateConfiguration)
+	}
+
+	targets := updateConfiguration["targets"].(automationSoftwareUpdateConfigurationTargets)
+	if targets.AzureQueries == nil || len(*targets.AzureQueries) != 1 {
+		t.Fatalf("Expected 1 Azure Query but got %+v", targets.AzureQueries)
+	}
+
+	query := (*targets.AzureQueries)[0]
+	if (*query.Scope)[0] != scope {
+		t.Fatalf("Expected the scope to be %q but got %+v", scope, *query.Scope)
+	}
+	if (*query.Locations)[0] != "westeurope" {
+		t.Fatalf("Expected the location to be normalized but got %+v", *query.Locations)
+	}
+	if query.TagSettings.FilterOperator != "Any" || len(query.TagSettings.Tags["environment"]) != 2 {
+		t.Fatalf("Expected the tag settings to be expanded but got %+v", query.TagSettings)
+	}
+}
+
+func testCheckAzureRMAutomationSoftwareUpdateConfigurationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).automationSoftwareUpdateConfigurationsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_automation_software_update_configuration" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["automation_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.GetByName(ctx, resourceGroup, accountName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Automation Software Update Configuration %q (Automation Account %q / Resource Group %q) still exists", name, accountName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAutomationSoftwareUpdateConfigurationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["automation_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).automationSoftwareUpdateConfigurationsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetByName(ctx, resourceGroup, accountName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Automation Software Update Configuration %q (Automation Account %q / Resource Group %q) does not exist", name, accountName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on automationSoftwareUpdateConfigurationsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAutomationSoftwareUpdateConfiguration_linux(rInt int, location string, startTime string) string {
+	template := testAccAzureRMAutomationSchedule_prerequisites(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_software_update_configuration" "test" {
+  name                     = "acctestsuc-%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  automation_account_name  = "${azurerm_automation_account.test.name}"
+  operating_system         = "Linux"
+  non_azure_computer_names = ["acctest-server1"]
+
+  linux {
+    classifications   = ["Critical", "Security"]
+    excluded_packages = ["kernel*"]
+  }
+
+  schedule {
+    frequency  = "Day"
+    start_time = "%s"
+  }
+}
+`, template, rInt, startTime)
+}
+
+func testAccAzureRMAutomationSoftwareUpdateConfiguration_windows(rInt int, location string, startTime string) string {
+	template := testAccAzureRMAutomationSchedule_prerequisites(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_software_update_configuration" "test" {
+  name                     = "acctestsuc-%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  automation_account_name  = "${azurerm_automation_account.test.name}"
+  operating_system         = "Windows"
+  non_azure_computer_names = ["acctest-server1"]
+  duration                 = "PT3H"
+
+  windows {
+    classifications     = ["Critical", "Security", "UpdateRollup"]
+    excluded_kb_numbers = ["KB890830"]
+    reboot_setting      = "Never"
+  }
+
+  schedule {
+    frequency  = "Week"
+    start_time = "%s"
+    week_days  = ["Saturday", "Sunday"]
+  }
+}
+`, template, rInt, startTime)
+}
+
+func testAccAzureRMAutomationSoftwareUpdateConfiguration_azureQuery(rInt int, location string, startTime string) string {
+	template := testAccAzureRMAutomationSchedule_prerequisites(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_software_update_configuration" "test" {
+  name                    = "acctestsuc-%d"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+  operating_system        = "Linux"
+
+  azure_query {
+    scopes     = ["${azurerm_resource_group.test.id}"]
+    locations  = ["${azurerm_resource_group.test.location}"]
+    tag_filter = "Any"
+
+    tags {
+      tag    = "environment"
+      values = ["Production", "Staging"]
+    }
+  }
+
+  linux {
+    classifications   = ["Critical", "Security"]
+    excluded_packages = ["kernel*"]
+  }
+
+  schedule {
+    frequency  = "Day"
+    start_time = "%s"
+  }
+}
+`, template, rInt, startTime)
+}
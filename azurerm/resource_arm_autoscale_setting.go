@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -108,9 +110,10 @@ func resourceArmAutoScaleSetting() *schema.Resource {
 													ValidateFunc: azure.ValidateResourceID,
 												},
 												"time_grain": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validateIso8601Duration(),
+													Type:             schema.TypeString,
+													Required:         true,
+													DiffSuppressFunc: suppress.ISO8601Duration,
+													ValidateFunc:     validate.ISO8601Duration,
 												},
 												"statistic": {
 													Type:     schema.TypeString,
@@ -124,9 +127,10 @@ func resourceArmAutoScaleSetting() *schema.Resource {
 													DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 												},
 												"time_window": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validateIso8601Duration(),
+													Type:             schema.TypeString,
+													Required:         true,
+													DiffSuppressFunc: suppress.ISO8601Duration,
+													ValidateFunc:     validate.ISO8601Duration,
 												},
 												"time_aggregation": {
 													Type:     schema.TypeString,
@@ -191,9 +195,10 @@ func resourceArmAutoScaleSetting() *schema.Resource {
 													ValidateFunc: validation.IntAtLeast(0),
 												},
 												"cooldown": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validateIso8601Duration(),
+													Type:             schema.TypeString,
+													Required:         true,
+													DiffSuppressFunc: suppress.ISO8601Duration,
+													ValidateFunc:     validate.ISO8601Duration,
 												},
 											},
 										},
@@ -214,14 +219,16 @@ func resourceArmAutoScaleSetting() *schema.Resource {
 										ValidateFunc: validateAutoScaleSettingsTimeZone(),
 									},
 									"start": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validateRFC3339Date,
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppress.RFC3339Time,
+										ValidateFunc:     validate.RFC3339Time,
 									},
 									"end": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validateRFC3339Date,
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppress.RFC3339Time,
+										ValidateFunc:     validate.RFC3339Time,
 									},
 								},
 							},
@@ -358,7 +365,7 @@ func resourceArmAutoScaleSettingCreateOrUpdate(d *schema.ResourceData, meta inte
 	}
 
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTags(tags, meta)
 
 	parameters := insights.AutoscaleSettingResource{
 		Location: utils.String(location),
@@ -434,7 +441,7 @@ func resourceArmAutoScaleSettingRead(d *schema.ResourceData, meta interface{}) e
 
 	// Return a new tag map filtered by the specified tag names.
 	tagMap := filterTags(resp.Tags, "$type")
-	flattenAndSetTags(d, tagMap)
+	flattenAndSetTags(d, tagMap, meta)
 
 	return nil
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -55,6 +57,14 @@ func resourceArmAvailabilitySet() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"proximity_placement_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -81,7 +91,7 @@ func resourceArmAvailabilitySetCreate(d *schema.ResourceData, meta interface{})
 			PlatformFaultDomainCount:  utils.Int32(int32(faultDomainCount)),
 			PlatformUpdateDomainCount: utils.Int32(int32(updateDomainCount)),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	if managed == true {
@@ -91,12 +101,34 @@ func resourceArmAvailabilitySetCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	resp, err := client.CreateOrUpdate(ctx, resGroup, name, availSet)
-	if err != nil {
-		return err
-	}
+	if proximityPlacementGroupId := d.Get("proximity_placement_group_id").(string); proximityPlacementGroupId != "" {
+		// placing an Availability Set within a Proximity Placement Group requires a newer API Version than the Azure SDK supports
+		placementClient := meta.(*ArmClient).computeProximityPlacementClient
 
-	d.SetId(*resp.ID)
+		body, err := expandComputeResourceWithProximityPlacementGroup(availSet, proximityPlacementGroupId)
+		if err != nil {
+			return err
+		}
+
+		id := azureRMResourceID(meta.(*ArmClient).subscriptionId, resGroup, "Microsoft.Compute", "availabilitySets", name)
+		future, err := placementClient.CreateOrUpdate(ctx, id, body)
+		if err != nil {
+			return err
+		}
+
+		if err := waitForCreateOrUpdate(ctx, d, &future.Future, placementClient.Client, id); err != nil {
+			return err
+		}
+
+		d.SetId(id)
+	} else {
+		resp, err := client.CreateOrUpdate(ctx, resGroup, name, availSet)
+		if err != nil {
+			return err
+		}
+
+		d.SetId(*resp.ID)
+	}
 
 	return resourceArmAvailabilitySetRead(d, meta)
 }
@@ -134,7 +166,13 @@ func resourceArmAvailabilitySetRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("managed", strings.EqualFold(*resp.Sku.Name, "Aligned"))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	proximityPlacementGroupId, err := retrieveProximityPlacementGroupId(ctx, meta.(*ArmClient).computeProximityPlacementClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving Proximity Placement Group for Availability Set %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	d.Set("proximity_placement_group_id", proximityPlacementGroupId)
+
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
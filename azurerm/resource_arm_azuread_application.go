@@ -1,14 +1,50 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// the Azure SDK doesn't expose the OAuth2 Permissions (Scopes) or App Roles of an Application - so these are
+// sent as Additional Properties and read back from the raw response using the types below
+type azureADApplicationOAuth2Permission struct {
+	ID                      *string `json:"id,omitempty"`
+	Value                   *string `json:"value,omitempty"`
+	Type                    *string `json:"type,omitempty"`
+	IsEnabled               *bool   `json:"isEnabled,omitempty"`
+	AdminConsentDisplayName *string `json:"adminConsentDisplayName,omitempty"`
+	AdminConsentDescription *string `json:"adminConsentDescription,omitempty"`
+	UserConsentDisplayName  *string `json:"userConsentDisplayName,omitempty"`
+	UserConsentDescription  *string `json:"userConsentDescription,omitempty"`
+}
+
+type azureADApplicationAppRole struct {
+	ID                 *string   `json:"id,omitempty"`
+	AllowedMemberTypes *[]string `json:"allowedMemberTypes,omitempty"`
+	DisplayName        *string   `json:"displayName,omitempty"`
+	Description        *string   `json:"description,omitempty"`
+	Value              *string   `json:"value,omitempty"`
+	IsEnabled          *bool     `json:"isEnabled,omitempty"`
+}
+
+type azureADApplicationPermissions struct {
+	OAuth2Permissions *[]azureADApplicationOAuth2Permission `json:"oauth2Permissions,omitempty"`
+	AppRoles          *[]azureADApplicationAppRole          `json:"appRoles,omitempty"`
+}
+
+var applicationResourceName = "azurerm_application"
+
 func resourceArmActiveDirectoryApplication() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmActiveDirectoryApplicationCreate,
@@ -60,6 +96,118 @@ func resourceArmActiveDirectoryApplication() *schema.Resource {
 				Optional: true,
 			},
 
+			// Azure AD creates a `user_impersonation` scope for new Applications - so this is Computed
+			"oauth2_permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.UUID,
+						},
+
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "User",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Admin",
+								"User",
+							}, false),
+						},
+
+						"admin_consent_display_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"admin_consent_description": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"user_consent_display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"user_consent_description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"is_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"app_role": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.UUID,
+						},
+
+						"allowed_member_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Application",
+									"User",
+								}, false),
+							},
+						},
+
+						"display_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"description": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"is_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
 			"application_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -87,6 +235,25 @@ func resourceArmActiveDirectoryApplicationCreate(d *schema.ResourceData, meta in
 		properties.Oauth2AllowImplicitFlow = utils.Bool(v.(bool))
 	}
 
+	additionalProperties := make(map[string]interface{})
+	if v, ok := d.GetOk("oauth2_permissions"); ok {
+		permissions, err := expandAzureADApplicationOAuth2Permissions(v.([]interface{}), []interface{}{})
+		if err != nil {
+			return err
+		}
+		additionalProperties["oauth2Permissions"] = permissions
+	}
+	if v, ok := d.GetOk("app_role"); ok {
+		roles, err := expandAzureADApplicationAppRoles(v.([]interface{}), []interface{}{})
+		if err != nil {
+			return err
+		}
+		additionalProperties["appRoles"] = roles
+	}
+	if len(additionalProperties) > 0 {
+		properties.AdditionalProperties = additionalProperties
+	}
+
 	app, err := client.Create(ctx, properties)
 	if err != nil {
 		return err
@@ -131,6 +298,14 @@ func resourceArmActiveDirectoryApplicationUpdate(d *schema.ResourceData, meta in
 		properties.Oauth2AllowImplicitFlow = utils.Bool(oauth)
 	}
 
+	if d.HasChange("oauth2_permissions") || d.HasChange("app_role") {
+		additionalProperties, err := expandAzureADApplicationPermissionChanges(ctx, client, d)
+		if err != nil {
+			return err
+		}
+		properties.AdditionalProperties = additionalProperties
+	}
+
 	_, err := client.Patch(ctx, d.Id(), properties)
 	if err != nil {
 		return fmt.Errorf("Error patching Azure AD Application with ID %q: %+v", d.Id(), err)
@@ -170,6 +345,19 @@ func resourceArmActiveDirectoryApplicationRead(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error setting `reply_urls`: %+v", err)
 	}
 
+	permissions, err := retrieveAzureADApplicationPermissions(ctx, client, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving OAuth2 Permissions and App Roles for Azure AD Application with ID %q: %+v", d.Id(), err)
+	}
+
+	if err := d.Set("oauth2_permissions", flattenAzureADApplicationOAuth2Permissions(permissions.OAuth2Permissions)); err != nil {
+		return fmt.Errorf("Error setting `oauth2_permissions`: %+v", err)
+	}
+
+	if err := d.Set("app_role", flattenAzureADApplicationAppRoles(permissions.AppRoles)); err != nil {
+		return fmt.Errorf("Error setting `app_role`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -253,3 +441,286 @@ func flattenAzureADApplicationReplyUrls(input *[]string) []string {
 
 	return output
 }
+
+// expandAzureADApplicationPermissionChanges returns the OAuth2 Permissions and App Roles to send to the API. Since
+// Azure AD rejects the removal of an enabled Permission or Role, any being removed are first disabled.
+func expandAzureADApplicationPermissionChanges(ctx context.Context, client graphrbac.ApplicationsClient, d *schema.ResourceData) (map[string]interface{}, error) {
+	oldPermissions, newPermissions := d.GetChange("oauth2_permissions")
+	permissions, err := expandAzureADApplicationOAuth2Permissions(newPermissions.([]interface{}), oldPermissions.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	oldRoles, newRoles := d.GetChange("app_role")
+	roles, err := expandAzureADApplicationAppRoles(newRoles.([]interface{}), oldRoles.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := retrieveAzureADApplicationPermissions(ctx, client, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving OAuth2 Permissions and App Roles for Azure AD Application with ID %q: %+v", d.Id(), err)
+	}
+
+	permissionIds := make(map[string]bool)
+	for _, v := range permissions {
+		permissionIds[*v.ID] = true
+	}
+	roleIds := make(map[string]bool)
+	for _, v := range roles {
+		roleIds[*v.ID] = true
+	}
+
+	disablePermissions := false
+	disabledPermissions := make([]azureADApplicationOAuth2Permission, 0)
+	if existing.OAuth2Permissions != nil {
+		for _, v := range *existing.OAuth2Permissions {
+			if v.ID != nil && !permissionIds[*v.ID] && v.IsEnabled != nil && *v.IsEnabled {
+				v.IsEnabled = utils.Bool(false)
+				disablePermissions = true
+			}
+			disabledPermissions = append(disabledPermissions, v)
+		}
+	}
+
+	disableRoles := false
+	disabledRoles := make([]azureADApplicationAppRole, 0)
+	if existing.AppRoles != nil {
+		for _, v := range *existing.AppRoles {
+			if v.ID != nil && !roleIds[*v.ID] && v.IsEnabled != nil && *v.IsEnabled {
+				v.IsEnabled = utils.Bool(false)
+				disableRoles = true
+			}
+			disabledRoles = append(disabledRoles, v)
+		}
+	}
+
+	if disablePermissions || disableRoles {
+		log.Printf("[DEBUG] Disabling the OAuth2 Permissions and App Roles being removed from Azure AD Application with ID %q", d.Id())
+		properties := graphrbac.ApplicationUpdateParameters{
+			AdditionalProperties: map[string]interface{}{
+				"oauth2Permissions": disabledPermissions,
+				"appRoles":          disabledRoles,
+			},
+		}
+		if _, err := client.Patch(ctx, d.Id(), properties); err != nil {
+			return nil, fmt.Errorf("Error disabling OAuth2 Permissions and App Roles for Azure AD Application with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return map[string]interface{}{
+		"oauth2Permissions": permissions,
+		"appRoles":          roles,
+	}, nil
+}
+
+func retrieveAzureADApplicationPermissions(ctx context.Context, client graphrbac.ApplicationsClient, objectId string) (*azureADApplicationPermissions, error) {
+	req, err := client.GetPreparer(ctx, objectId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result azureADApplicationPermissions
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// assignAzureADApplicationPermissionIds returns the ID to use for each OAuth2 Permission / App Role. Existing items are
+// matched on their key (e.g. `value`) so that their IDs remain stable when items are added, removed or re-ordered.
+func assignAzureADApplicationPermissionIds(input []interface{}, existing []interface{}, key func(map[string]interface{}) string) ([]string, error) {
+	existingIds := make(map[string]string)
+	for _, v := range existing {
+		item := v.(map[string]interface{})
+		if id := item["id"].(string); id != "" {
+			existingIds[key(item)] = id
+		}
+	}
+
+	ids := make([]string, len(input))
+	used := make(map[string]bool)
+	for i, v := range input {
+		item := v.(map[string]interface{})
+		if id, ok := existingIds[key(item)]; ok && !used[id] {
+			ids[i] = id
+			used[id] = true
+		}
+	}
+
+	for i, v := range input {
+		if ids[i] != "" {
+			continue
+		}
+
+		item := v.(map[string]interface{})
+		if id := item["id"].(string); id != "" && !used[id] {
+			ids[i] = id
+			used[id] = true
+			continue
+		}
+
+		id, err := uuid.GenerateUUID()
+		if err != nil {
+			return nil, fmt.Errorf("Error generating an ID: %+v", err)
+		}
+		ids[i] = id
+		used[id] = true
+	}
+
+	return ids, nil
+}
+
+func expandAzureADApplicationOAuth2Permissions(input []interface{}, existing []interface{}) ([]azureADApplicationOAuth2Permission, error) {
+	ids, err := assignAzureADApplicationPermissionIds(input, existing, func(item map[string]interface{}) string {
+		return item["value"].(string)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make([]azureADApplicationOAuth2Permission, 0)
+	for i, v := range input {
+		item := v.(map[string]interface{})
+		permissions = append(permissions, azureADApplicationOAuth2Permission{
+			ID:                      utils.String(ids[i]),
+			Value:                   utils.String(item["value"].(string)),
+			Type:                    utils.String(item["type"].(string)),
+			IsEnabled:               utils.Bool(item["is_enabled"].(bool)),
+			AdminConsentDisplayName: utils.String(item["admin_consent_display_name"].(string)),
+			AdminConsentDescription: utils.String(item["admin_consent_description"].(string)),
+			UserConsentDisplayName:  utils.String(item["user_consent_display_name"].(string)),
+			UserConsentDescription:  utils.String(item["user_consent_description"].(string)),
+		})
+	}
+
+	return permissions, nil
+}
+
+func flattenAzureADApplicationOAuth2Permissions(input *[]azureADApplicationOAuth2Permission) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		result := make(map[string]interface{})
+		if v.ID != nil {
+			result["id"] = *v.ID
+		}
+		if v.Value != nil {
+			result["value"] = *v.Value
+		}
+		if v.Type != nil {
+			result["type"] = *v.Type
+		}
+		if v.IsEnabled != nil {
+			result["is_enabled"] = *v.IsEnabled
+		}
+		if v.AdminConsentDisplayName != nil {
+			result["admin_consent_display_name"] = *v.AdminConsentDisplayName
+		}
+		if v.AdminConsentDescription != nil {
+			result["admin_consent_description"] = *v.AdminConsentDescription
+		}
+		if v.UserConsentDisplayName != nil {
+			result["user_consent_display_name"] = *v.UserConsentDisplayName
+		}
+		if v.UserConsentDescription != nil {
+			result["user_consent_description"] = *v.UserConsentDescription
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func expandAzureADApplicationAppRoles(input []interface{}, existing []interface{}) ([]azureADApplicationAppRole, error) {
+	// `value` is optional - so App Roles without one are matched on their Display Name
+	ids, err := assignAzureADApplicationPermissionIds(input, existing, func(item map[string]interface{}) string {
+		if value := item["value"].(string); value != "" {
+			return value
+		}
+		return fmt.Sprintf("displayName:%s", item["display_name"].(string))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]azureADApplicationAppRole, 0)
+	for i, v := range input {
+		item := v.(map[string]interface{})
+
+		memberTypes := make([]string, 0)
+		for _, memberType := range item["allowed_member_types"].(*schema.Set).List() {
+			memberTypes = append(memberTypes, memberType.(string))
+		}
+
+		role := azureADApplicationAppRole{
+			ID:                 utils.String(ids[i]),
+			AllowedMemberTypes: &memberTypes,
+			DisplayName:        utils.String(item["display_name"].(string)),
+			Description:        utils.String(item["description"].(string)),
+			IsEnabled:          utils.Bool(item["is_enabled"].(bool)),
+		}
+		if value := item["value"].(string); value != "" {
+			role.Value = utils.String(value)
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+func flattenAzureADApplicationAppRoles(input *[]azureADApplicationAppRole) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		result := make(map[string]interface{})
+		if v.ID != nil {
+			result["id"] = *v.ID
+		}
+
+		memberTypes := make([]interface{}, 0)
+		if v.AllowedMemberTypes != nil {
+			for _, memberType := range *v.AllowedMemberTypes {
+				memberTypes = append(memberTypes, memberType)
+			}
+		}
+		result["allowed_member_types"] = schema.NewSet(schema.HashString, memberTypes)
+
+		if v.DisplayName != nil {
+			result["display_name"] = *v.DisplayName
+		}
+		if v.Description != nil {
+			result["description"] = *v.Description
+		}
+		if v.Value != nil {
+			result["value"] = *v.Value
+		}
+		if v.IsEnabled != nil {
+			result["is_enabled"] = *v.IsEnabled
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
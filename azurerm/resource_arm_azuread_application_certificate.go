@@ -0,0 +1,154 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmActiveDirectoryApplicationCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmActiveDirectoryApplicationCertificateCreate,
+		Read:   resourceArmActiveDirectoryApplicationCertificateRead,
+		Delete: resourceArmActiveDirectoryApplicationCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: azureADCertificateSchema("application_object_id"),
+	}
+}
+
+func resourceArmActiveDirectoryApplicationCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	objectId := d.Get("application_object_id").(string)
+
+	credential, err := expandAzureADCertificate(d)
+	if err != nil {
+		return err
+	}
+	keyId := *credential.KeyID
+
+	azureRMLockByName(objectId, applicationResourceName)
+	defer azureRMUnlockByName(objectId, applicationResourceName)
+
+	existingCredentials, err := client.ListKeyCredentials(ctx, objectId)
+	if err != nil {
+		return fmt.Errorf("Error Listing Key Credentials for Application %q: %+v", objectId, err)
+	}
+
+	updatedCredentials := make([]graphrbac.KeyCredential, 0)
+	if existingCredentials.Value != nil {
+		updatedCredentials = *existingCredentials.Value
+	}
+
+	updatedCredentials = append(updatedCredentials, *credential)
+
+	parameters := graphrbac.KeyCredentialsUpdateParameters{
+		Value: &updatedCredentials,
+	}
+	_, err = client.UpdateKeyCredentials(ctx, objectId, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Certificate %q for Application %q: %+v", keyId, objectId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", objectId, keyId))
+
+	return resourceArmActiveDirectoryApplicationCertificateRead(d, meta)
+}
+
+func resourceArmActiveDirectoryApplicationCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	objectId, keyId, err := parseAzureADCertificateId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// ensure the parent Application exists
+	application, err := client.Get(ctx, objectId)
+	if err != nil {
+		// the parent Application has been removed - skip it
+		if utils.ResponseWasNotFound(application.Response) {
+			log.Printf("[DEBUG] Application with Object ID %q was not found - removing from state!", objectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application ID %q: %+v", objectId, err)
+	}
+
+	credentials, err := client.ListKeyCredentials(ctx, objectId)
+	if err != nil {
+		return fmt.Errorf("Error Listing Key Credentials for Application with Object ID %q: %+v", objectId, err)
+	}
+
+	credential := findAzureADCertificate(credentials.Value, keyId)
+	if credential == nil {
+		log.Printf("[DEBUG] Application Certificate %q (Object ID %q) was not found - removing from state!", keyId, objectId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_object_id", objectId)
+	flattenAzureADCertificate(d, credential)
+
+	return nil
+}
+
+func resourceArmActiveDirectoryApplicationCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	objectId, keyId, err := parseAzureADCertificateId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(objectId, applicationResourceName)
+	defer azureRMUnlockByName(objectId, applicationResourceName)
+
+	// ensure the parent Application exists
+	application, err := client.Get(ctx, objectId)
+	if err != nil {
+		// the parent Application was removed - skip it
+		if utils.ResponseWasNotFound(application.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Application ID %q: %+v", objectId, err)
+	}
+
+	existing, err := client.ListKeyCredentials(ctx, objectId)
+	if err != nil {
+		return fmt.Errorf("Error Listing Key Credentials for Application with Object ID %q: %+v", objectId, err)
+	}
+
+	updatedCredentials := make([]graphrbac.KeyCredential, 0)
+	if existing.Value != nil {
+		for _, credential := range *existing.Value {
+			if credential.KeyID == nil {
+				continue
+			}
+
+			if *credential.KeyID != keyId {
+				updatedCredentials = append(updatedCredentials, credential)
+			}
+		}
+	}
+
+	parameters := graphrbac.KeyCredentialsUpdateParameters{
+		Value: &updatedCredentials,
+	}
+	_, err = client.UpdateKeyCredentials(ctx, objectId, parameters)
+	if err != nil {
+		return fmt.Errorf("Error removing Certificate %q from Application %q: %+v", keyId, objectId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMActiveDirectoryApplicationCertificate_basic(t *testing.T) {
+	resourceName := "azurerm_azuread_application_certificate.test"
+	applicationId, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := testAccAzureRMActiveDirectoryApplicationCertificate_basic(applicationId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMActiveDirectoryApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					// can't assert on Value since it's not returned
+					testCheckAzureRMActiveDirectoryApplicationCertificateExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "AsymmetricX509Cert"),
+					resource.TestCheckResourceAttr(resourceName, "usage", "Verify"),
+					resource.TestCheckResourceAttr(resourceName, "end_date", "2030-01-01T01:02:03Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMActiveDirectoryApplicationCertificate_customKeyId(t *testing.T) {
+	resourceName := "azurerm_azuread_application_certificate.test"
+	applicationId, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyId, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := testAccAzureRMActiveDirectoryApplicationCertificate_customKeyId(applicationId, keyId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMActiveDirectoryApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryApplicationCertificateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_id", keyId),
+					resource.TestCheckResourceAttr(resourceName, "start_date", "2018-01-01T01:02:03Z"),
+					resource.TestCheckResourceAttr(resourceName, "end_date", "2030-01-01T01:02:03Z"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMActiveDirectoryApplicationCertificateExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).applicationsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		objectId, keyId, err := parseAzureADCertificateId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, objectId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Azure AD Application %q does not exist", objectId)
+			}
+			return fmt.Errorf("Bad: Get on Azure AD applicationsClient: %+v", err)
+		}
+
+		credentials, err := client.ListKeyCredentials(ctx, objectId)
+		if err != nil {
+			return fmt.Errorf("Error Listing Key Credentials for Application %q: %+v", objectId, err)
+		}
+
+		if findAzureADCertificate(credentials.Value, keyId) == nil {
+			return fmt.Errorf("Certificate %q was not found in Application %q", keyId, objectId)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMActiveDirectoryApplicationCertificate_basic(applicationId string) string {
+	return fmt.Sprintf(`
+resource "azurerm_azuread_application" "test" {
+  name = "acctestapp%s"
+}
+
+resource "azurerm_azuread_application_certificate" "test" {
+  application_object_id = "${azurerm_azuread_application.test.id}"
+  value                 = "${file("testdata/application_gateway_test.cer")}"
+  end_date              = "2030-01-01T01:02:03Z"
+}
+`, applicationId)
+}
+
+func testAccAzureRMActiveDirectoryApplicationCertificate_customKeyId(applicationId, keyId string) string {
+	return fmt.Sprintf(`
+resource "azurerm_azuread_application" "test" {
+  name = "acctestapp%s"
+}
+
+resource "azurerm_azuread_application_certificate" "test" {
+  application_object_id = "${azurerm_azuread_application.test.id}"
+  key_id                = "%s"
+  value                 = "${file("testdata/application_gateway_test.cer")}"
+  start_date            = "2018-01-01T01:02:03Z"
+  end_date              = "2030-01-01T01:02:03Z"
+}
+`, applicationId, keyId)
+}
@@ -0,0 +1,246 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmActiveDirectoryApplicationFederatedIdentityCredential() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmActiveDirectoryApplicationFederatedIdentityCredentialCreate,
+		Read:   resourceArmActiveDirectoryApplicationFederatedIdentityCredentialRead,
+		Update: resourceArmActiveDirectoryApplicationFederatedIdentityCredentialUpdate,
+		Delete: resourceArmActiveDirectoryApplicationFederatedIdentityCredentialDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateActiveDirectoryFederatedIdentityCredentialName,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"issuer": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.URLWithScheme([]string{"https"}),
+			},
+
+			"subject": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"audiences": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"credential_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmActiveDirectoryApplicationFederatedIdentityCredentialCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).federatedIdentityCredentialsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	objectId := d.Get("application_object_id").(string)
+	name := d.Get("display_name").(string)
+
+	credential := msgraph.FederatedIdentityCredential{
+		Name:        utils.String(name),
+		Description: utils.String(d.Get("description").(string)),
+		Issuer:      utils.String(d.Get("issuer").(string)),
+		Subject:     utils.String(d.Get("subject").(string)),
+		Audiences:   expandActiveDirectoryFederatedIdentityCredentialAudiences(d.Get("audiences").([]interface{})),
+	}
+
+	// Applications are created through the Azure AD Graph API - and can take a few minutes to replicate to the Microsoft Graph API
+	var created msgraph.FederatedIdentityCredential
+	err := resource.Retry(300*time.Second, func() *resource.RetryError {
+		existing, err := client.List(ctx, objectId)
+		if err != nil {
+			return response.RetryError(err, func(error) bool {
+				return utils.ResponseWasNotFound(existing.Response)
+			})
+		}
+
+		// the Name of a Federated Identity Credential is unique within an Application
+		if existing.Value != nil {
+			for _, v := range *existing.Value {
+				if v.Name != nil && strings.EqualFold(*v.Name, name) && v.ID != nil {
+					return resource.NonRetryableError(fmt.Errorf("A Federated Identity Credential with the name %q already exists for Application %q - to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information. ID: %q", name, objectId, "azurerm_azuread_application_federated_identity_credential", fmt.Sprintf("%s/%s", objectId, *v.ID)))
+				}
+			}
+		}
+
+		resp, err := client.Create(ctx, objectId, credential)
+		if err != nil {
+			return response.RetryError(err, func(error) bool {
+				return utils.ResponseWasNotFound(resp.Response)
+			})
+		}
+
+		created = resp
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Federated Identity Credential %q for Application %q: %+v", name, objectId, err)
+	}
+
+	if created.ID == nil {
+		return fmt.Errorf("Cannot read ID for Federated Identity Credential %q (Application %q)", name, objectId)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", objectId, *created.ID))
+
+	return resourceArmActiveDirectoryApplicationFederatedIdentityCredentialRead(d, meta)
+}
+
+func resourceArmActiveDirectoryApplicationFederatedIdentityCredentialUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).federatedIdentityCredentialsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	objectId, credentialId, err := parseActiveDirectoryFederatedIdentityCredentialId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the Name can't be updated - and is rejected by the API if it's specified
+	credential := msgraph.FederatedIdentityCredential{
+		Description: utils.String(d.Get("description").(string)),
+		Issuer:      utils.String(d.Get("issuer").(string)),
+		Subject:     utils.String(d.Get("subject").(string)),
+		Audiences:   expandActiveDirectoryFederatedIdentityCredentialAudiences(d.Get("audiences").([]interface{})),
+	}
+
+	if _, err := client.Update(ctx, objectId, credentialId, credential); err != nil {
+		return fmt.Errorf("Error updating Federated Identity Credential %q for Application %q: %+v", credentialId, objectId, err)
+	}
+
+	return resourceArmActiveDirectoryApplicationFederatedIdentityCredentialRead(d, meta)
+}
+
+func resourceArmActiveDirectoryApplicationFederatedIdentityCredentialRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).federatedIdentityCredentialsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	objectId, credentialId, err := parseActiveDirectoryFederatedIdentityCredentialId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// a 404 is returned if either the parent Application or the Credential has been removed
+	resp, err := client.Get(ctx, objectId, credentialId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Federated Identity Credential %q (Application %q) was not found - removing from state!", credentialId, objectId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Federated Identity Credential %q for Application %q: %+v", credentialId, objectId, err)
+	}
+
+	d.Set("application_object_id", objectId)
+	d.Set("credential_id", resp.ID)
+	d.Set("display_name", resp.Name)
+	d.Set("description", resp.Description)
+	d.Set("issuer", resp.Issuer)
+	d.Set("subject", resp.Subject)
+
+	audiences := make([]string, 0)
+	if resp.Audiences != nil {
+		audiences = *resp.Audiences
+	}
+	if err := d.Set("audiences", audiences); err != nil {
+		return fmt.Errorf("Error setting `audiences`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmActiveDirectoryApplicationFederatedIdentityCredentialDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).federatedIdentityCredentialsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	objectId, credentialId, err := parseActiveDirectoryFederatedIdentityCredentialId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, objectId, credentialId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Federated Identity Credential %q from Application %q: %+v", credentialId, objectId, err)
+	}
+
+	return nil
+}
+
+func parseActiveDirectoryFederatedIdentityCredentialId(input string) (string, string, error) {
+	id := strings.Split(input, "/")
+	if len(id) != 2 || id[0] == "" || id[1] == "" {
+		return "", "", fmt.Errorf("ID should be in the format {objectId}/{credentialId} - but got %q", input)
+	}
+
+	return id[0], id[1], nil
+}
+
+func expandActiveDirectoryFederatedIdentityCredentialAudiences(input []interface{}) *[]string {
+	audiences := make([]string, 0)
+	for _, v := range input {
+		audiences = append(audiences, v.(string))
+	}
+
+	return &audiences
+}
+
+func validateActiveDirectoryFederatedIdentityCredentialName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9][-_a-zA-Z0-9]{2,119}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 120 characters in length, start with a letter or number and can only contain letters, numbers, hyphens and underscores", k))
+	}
+
+	return warnings, errors
+}
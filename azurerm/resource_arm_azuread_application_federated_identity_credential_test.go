@@ -0,0 +1,246 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMActiveDirectoryApplicationFederatedIdentityCredential_basic(t *testing.T) {
+	resourceName := "azurerm_azuread_application_federated_identity_credential.test"
+	id := uuid.New().String()
+	config := testAccAzureRMActiveDirectoryApplicationFederatedIdentityCredential_basic(id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMActiveDirectoryApplicationFederatedIdentityCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryApplicationFederatedIdentityCredentialExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "credential_id"),
+					resource.TestCheckResourceAttr(resourceName, "issuer", "https://token.actions.githubusercontent.com"),
+					resource.TestCheckResourceAttr(resourceName, "subject", "repo:hashicorp/example:ref:refs/heads/master"),
+					resource.TestCheckResourceAttr(resourceName, "audiences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "audiences.0", "api://AzureADTokenExchange"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMActiveDirectoryApplicationFederatedIdentityCredential_update(t *testing.T) {
+	resourceName := "azurerm_azuread_application_federated_identity_credential.test"
+	id := uuid.New().String()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMActiveDirectoryApplicationFederatedIdentityCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMActiveDirectoryApplicationFederatedIdentityCredential_basic(id),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryApplicationFederatedIdentityCredentialExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				Config: testAccAzureRMActiveDirectoryApplicationFederatedIdentityCredential_circleci(id),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryApplicationFederatedIdentityCredentialExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "CircleCI"),
+					resource.TestCheckResourceAttr(resourceName, "issuer", "https://oidc.circleci.com/org/00000000-0000-0000-0000-000000000000"),
+					resource.TestCheckResourceAttr(resourceName, "subject", "org/00000000-0000-0000-0000-000000000000/project/11111111-1111-1111-1111-111111111111/user/22222222-2222-2222-2222-222222222222"),
+					resource.TestCheckResourceAttr(resourceName, "audiences.0", "00000000-0000-0000-0000-000000000000"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseActiveDirectoryFederatedIdentityCredentialId(t *testing.T) {
+	cases := []struct {
+		Input        string
+		ExpectError  bool
+		ObjectId     string
+		CredentialId string
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "00000000-0000-0000-0000-000000000000",
+			ExpectError: true,
+		},
+		{
+			Input:       "00000000-0000-0000-0000-000000000000/",
+			ExpectError: true,
+		},
+		{
+			Input:        "00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111",
+			ExpectError:  false,
+			ObjectId:     "00000000-0000-0000-0000-000000000000",
+			CredentialId: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			Input:       "00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/extra",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		objectId, credentialId, err := parseActiveDirectoryFederatedIdentityCredentialId(tc.Input)
+		if tc.ExpectError != (err != nil) {
+			t.Fatalf("Expected an error to be %t for %q but got: %+v", tc.ExpectError, tc.Input, err)
+		}
+
+		if objectId != tc.ObjectId || credentialId != tc.CredentialId {
+			t.Fatalf("Expected %q to be parsed as %q / %q but got %q / %q", tc.Input, tc.ObjectId, tc.CredentialId, objectId, credentialId)
+		}
+	}
+}
+
+func TestValidateActiveDirectoryFederatedIdentityCredentialName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "ab",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abc",
+			ErrCount: 0,
+		},
+		{
+			Value:    "circleci-main_branch",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-circleci",
+			ErrCount: 1,
+		},
+		{
+			Value:    "circle.ci",
+			ErrCount: 1,
+		},
+		{
+			Value:    "circleci/main",
+			ErrCount: 1,
+		},
+		{
+			Value:    fmt.Sprintf("a%0119d", 0),
+			ErrCount: 0,
+		},
+		{
+			Value:    fmt.Sprintf("a%0120d", 0),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateActiveDirectoryFederatedIdentityCredentialName(tc.Value, "display_name")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMActiveDirectoryApplicationFederatedIdentityCredentialExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).federatedIdentityCredentialsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		objectId, credentialId, err := parseActiveDirectoryFederatedIdentityCredentialId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, objectId, credentialId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Federated Identity Credential %q (Application %q) does not exist", credentialId, objectId)
+			}
+			return fmt.Errorf("Bad: Get on federatedIdentityCredentialsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMActiveDirectoryApplicationFederatedIdentityCredentialDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_azuread_application_federated_identity_credential" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).federatedIdentityCredentialsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		objectId, credentialId, err := parseActiveDirectoryFederatedIdentityCredentialId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, objectId, credentialId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Federated Identity Credential still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMActiveDirectoryApplicationFederatedIdentityCredential_basic(id string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_azuread_application_federated_identity_credential" "test" {
+  application_object_id = "${azurerm_azuread_application.test.id}"
+  display_name          = "acctest-%s"
+  issuer                = "https://token.actions.githubusercontent.com"
+  subject               = "repo:hashicorp/example:ref:refs/heads/master"
+  audiences             = ["api://AzureADTokenExchange"]
+}
+`, testAccAzureRMActiveDirectoryApplication_basic(id), id)
+}
+
+func testAccAzureRMActiveDirectoryApplicationFederatedIdentityCredential_circleci(id string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_azuread_application_federated_identity_credential" "test" {
+  application_object_id = "${azurerm_azuread_application.test.id}"
+  display_name          = "acctest-%s"
+  description           = "CircleCI"
+  issuer                = "https://oidc.circleci.com/org/00000000-0000-0000-0000-000000000000"
+  subject               = "org/00000000-0000-0000-0000-000000000000/project/11111111-1111-1111-1111-111111111111/user/22222222-2222-2222-2222-222222222222"
+  audiences             = ["00000000-0000-0000-0000-000000000000"]
+}
+`, testAccAzureRMActiveDirectoryApplication_basic(id), id)
+}
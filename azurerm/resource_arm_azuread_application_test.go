@@ -116,6 +116,104 @@ func TestAccAzureRMActiveDirectoryApplication_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMActiveDirectoryApplication_permissions(t *testing.T) {
+	resourceName := "azurerm_azuread_application.test"
+	id := uuid.New().String()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMActiveDirectoryApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMActiveDirectoryApplication_permissions(id),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "oauth2_permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oauth2_permissions.0.value", "read"),
+					resource.TestCheckResourceAttr(resourceName, "oauth2_permissions.0.type", "User"),
+					resource.TestCheckResourceAttrSet(resourceName, "oauth2_permissions.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "app_role.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "app_role.0.value", "Reader"),
+					resource.TestCheckResourceAttr(resourceName, "app_role.0.allowed_member_types.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "app_role.0.id"),
+				),
+			},
+			{
+				Config: testAccAzureRMActiveDirectoryApplication_permissionsUpdated(id),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "oauth2_permissions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "oauth2_permissions.0.value", "write"),
+					resource.TestCheckResourceAttr(resourceName, "oauth2_permissions.0.type", "Admin"),
+					resource.TestCheckResourceAttr(resourceName, "oauth2_permissions.1.value", "read"),
+					resource.TestCheckResourceAttr(resourceName, "app_role.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "app_role.0.value", "Writer"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAssignAzureADApplicationPermissionIds(t *testing.T) {
+	key := func(item map[string]interface{}) string {
+		return item["value"].(string)
+	}
+	item := func(id, value string) interface{} {
+		return map[string]interface{}{
+			"id":    id,
+			"value": value,
+		}
+	}
+
+	existing := []interface{}{
+		item("00000000-0000-0000-0000-000000000001", "read"),
+		item("00000000-0000-0000-0000-000000000002", "write"),
+	}
+
+	// a new item inserted at the start carries the ID of the first existing item (by index)
+	input := []interface{}{
+		item("00000000-0000-0000-0000-000000000001", "delete"),
+		item("00000000-0000-0000-0000-000000000002", "read"),
+		item("", "write"),
+	}
+
+	ids, err := assignAzureADApplicationPermissionIds(input, existing, key)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if ids[1] != "00000000-0000-0000-0000-000000000001" {
+		t.Fatalf("Expected `read` to keep its ID but got %q", ids[1])
+	}
+	if ids[2] != "00000000-0000-0000-0000-000000000002" {
+		t.Fatalf("Expected `write` to keep its ID but got %q", ids[2])
+	}
+	if ids[0] == "" || ids[0] == ids[1] || ids[0] == ids[2] {
+		t.Fatalf("Expected `delete` to be assigned a new ID but got %q", ids[0])
+	}
+
+	// an item whose value is renamed keeps its ID
+	input = []interface{}{
+		item("00000000-0000-0000-0000-000000000001", "read.all"),
+		item("00000000-0000-0000-0000-000000000002", "write"),
+	}
+
+	ids, err = assignAzureADApplicationPermissionIds(input, existing, key)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if ids[0] != "00000000-0000-0000-0000-000000000001" || ids[1] != "00000000-0000-0000-0000-000000000002" {
+		t.Fatalf("Expected the IDs to remain the same but got %+v", ids)
+	}
+}
+
 func testCheckAzureRMActiveDirectoryApplicationExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -191,3 +289,58 @@ resource "azurerm_azuread_application" "test" {
 }
 `, id, id, id, id)
 }
+
+func testAccAzureRMActiveDirectoryApplication_permissions(id string) string {
+	return fmt.Sprintf(`
+resource "azurerm_azuread_application" "test" {
+  name            = "acctest%s"
+  identifier_uris = ["http://%s.hashicorptest.com"]
+
+  oauth2_permissions {
+    value                      = "read"
+    admin_consent_display_name = "Read"
+    admin_consent_description  = "Allows the application to read data."
+    user_consent_display_name  = "Read your data"
+    user_consent_description   = "Allows the application to read your data."
+  }
+
+  app_role {
+    allowed_member_types = ["User", "Application"]
+    display_name         = "Reader"
+    description          = "Readers can read data."
+    value                = "Reader"
+  }
+}
+`, id, id)
+}
+
+func testAccAzureRMActiveDirectoryApplication_permissionsUpdated(id string) string {
+	return fmt.Sprintf(`
+resource "azurerm_azuread_application" "test" {
+  name            = "acctest%s"
+  identifier_uris = ["http://%s.hashicorptest.com"]
+
+  oauth2_permissions {
+    value                      = "write"
+    type                       = "Admin"
+    admin_consent_display_name = "Write"
+    admin_consent_description  = "Allows the application to write data."
+  }
+
+  oauth2_permissions {
+    value                      = "read"
+    admin_consent_display_name = "Read"
+    admin_consent_description  = "Allows the application to read data."
+    user_consent_display_name  = "Read your data"
+    user_consent_description   = "Allows the application to read your data."
+  }
+
+  app_role {
+    allowed_member_types = ["Application"]
+    display_name         = "Writer"
+    description          = "Writers can write data."
+    value                = "Writer"
+  }
+}
+`, id, id)
+}
@@ -0,0 +1,163 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azureerr"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Objects created in Azure Active Directory are replicated between the Graph replicas over a few minutes - during
+// which time Role Assignments and Key Vault Access Policies referencing them can fail with `PrincipalNotFound`.
+// This resource waits until the Object has been consistently visible, such that these can depend on it.
+func resourceArmActiveDirectoryPropagationWait() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmActiveDirectoryPropagationWaitCreate,
+		Read:   resourceArmActiveDirectoryPropagationWaitRead,
+		Delete: resourceArmActiveDirectoryPropagationWaitDelete,
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"consecutive_successes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 30),
+			},
+
+			"timeout_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"object_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmActiveDirectoryPropagationWaitCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).objectsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	objectId := d.Get("object_id").(string)
+	consecutiveSuccesses := d.Get("consecutive_successes").(int)
+	timeout := time.Duration(d.Get("timeout_minutes").(int)) * time.Minute
+
+	log.Printf("[DEBUG] Waiting for Azure AD Object %q to be visible %d times in a row..", objectId, consecutiveSuccesses)
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"404"},
+		Target:                    []string{"200"},
+		Refresh:                   activeDirectoryObjectRefreshFunc(ctx, client, objectId),
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: consecutiveSuccesses,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Azure AD Object %q to become visible: %+v", objectId, err)
+	}
+
+	d.SetId(objectId)
+
+	return resourceArmActiveDirectoryPropagationWaitRead(d, meta)
+}
+
+func resourceArmActiveDirectoryPropagationWaitRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).objectsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	objectId := d.Id()
+	object, err := retrieveActiveDirectoryObject(ctx, client, objectId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Azure AD Object %q: %+v", objectId, err)
+	}
+
+	// if the Object's been removed, the wait is re-created (and will fail) when the Object is next referenced
+	if object == nil {
+		log.Printf("[DEBUG] Azure AD Object %q was not found - removing from state!", objectId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("object_id", objectId)
+	d.Set("object_type", object.ObjectType)
+
+	return nil
+}
+
+func resourceArmActiveDirectoryPropagationWaitDelete(d *schema.ResourceData, meta interface{}) error {
+	// there's nothing to remove - this resource only exists in the state
+	return nil
+}
+
+func activeDirectoryObjectRefreshFunc(ctx context.Context, client graphrbac.ObjectsClient, objectId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := retrieveActiveDirectoryObject(ctx, client, objectId)
+		if err != nil {
+			if azureerr.IsRetryable(err) {
+				log.Printf("[DEBUG] Retrying retrieval of Azure AD Object %q: %+v", objectId, err)
+				return "pending", "404", nil
+			}
+
+			return nil, "", err
+		}
+
+		if object == nil {
+			return "pending", "404", nil
+		}
+
+		return *object, "200", nil
+	}
+}
+
+// retrieveActiveDirectoryObject returns the Object with the specified Object ID - or nil if it's not (yet) visible
+func retrieveActiveDirectoryObject(ctx context.Context, client graphrbac.ObjectsClient, objectId string) (*graphrbac.AADObject, error) {
+	parameters := graphrbac.GetObjectsParameters{
+		ObjectIds:                        &[]string{objectId},
+		IncludeDirectoryObjectReferences: utils.Bool(true),
+	}
+	objects, err := client.GetObjectsByObjectIdsComplete(ctx, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	for objects.NotDone() {
+		object := objects.Value()
+		if object.ObjectID != nil && strings.EqualFold(*object.ObjectID, objectId) {
+			return &object, nil
+		}
+
+		if err := objects.Next(); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,55 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMActiveDirectoryPropagationWait_servicePrincipal(t *testing.T) {
+	resourceName := "azurerm_azuread_propagation_wait.test"
+	id := uuid.New().String()
+	config := testAccAzureRMActiveDirectoryPropagationWait_servicePrincipal(id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "object_id", "azurerm_azuread_service_principal.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "object_type", "ServicePrincipal"),
+					resource.TestCheckResourceAttrSet("azurerm_role_assignment.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMActiveDirectoryPropagationWait_servicePrincipal(id string) string {
+	return fmt.Sprintf(`
+data "azurerm_subscription" "primary" {}
+
+resource "azurerm_azuread_application" "test" {
+  name = "acctestspa%s"
+}
+
+resource "azurerm_azuread_service_principal" "test" {
+  application_id = "${azurerm_azuread_application.test.application_id}"
+}
+
+resource "azurerm_azuread_propagation_wait" "test" {
+  object_id             = "${azurerm_azuread_service_principal.test.id}"
+  consecutive_successes = 3
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = "${data.azurerm_subscription.primary.id}"
+  role_definition_name = "Reader"
+  principal_id         = "${azurerm_azuread_propagation_wait.test.object_id}"
+}
+`, id)
+}
@@ -0,0 +1,154 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmActiveDirectoryServicePrincipalCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmActiveDirectoryServicePrincipalCertificateCreate,
+		Read:   resourceArmActiveDirectoryServicePrincipalCertificateRead,
+		Delete: resourceArmActiveDirectoryServicePrincipalCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: azureADCertificateSchema("service_principal_id"),
+	}
+}
+
+func resourceArmActiveDirectoryServicePrincipalCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicePrincipalsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	objectId := d.Get("service_principal_id").(string)
+
+	credential, err := expandAzureADCertificate(d)
+	if err != nil {
+		return err
+	}
+	keyId := *credential.KeyID
+
+	azureRMLockByName(objectId, servicePrincipalResourceName)
+	defer azureRMUnlockByName(objectId, servicePrincipalResourceName)
+
+	existingCredentials, err := client.ListKeyCredentials(ctx, objectId)
+	if err != nil {
+		return fmt.Errorf("Error Listing Key Credentials for Service Principal %q: %+v", objectId, err)
+	}
+
+	updatedCredentials := make([]graphrbac.KeyCredential, 0)
+	if existingCredentials.Value != nil {
+		updatedCredentials = *existingCredentials.Value
+	}
+
+	updatedCredentials = append(updatedCredentials, *credential)
+
+	parameters := graphrbac.KeyCredentialsUpdateParameters{
+		Value: &updatedCredentials,
+	}
+	_, err = client.UpdateKeyCredentials(ctx, objectId, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Certificate %q for Service Principal %q: %+v", keyId, objectId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", objectId, keyId))
+
+	return resourceArmActiveDirectoryServicePrincipalCertificateRead(d, meta)
+}
+
+func resourceArmActiveDirectoryServicePrincipalCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicePrincipalsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	objectId, keyId, err := parseAzureADCertificateId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// ensure the parent Service Principal exists
+	servicePrincipal, err := client.Get(ctx, objectId)
+	if err != nil {
+		// the parent Service Principal has been removed - skip it
+		if utils.ResponseWasNotFound(servicePrincipal.Response) {
+			log.Printf("[DEBUG] Service Principal with Object ID %q was not found - removing from state!", objectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+	}
+
+	credentials, err := client.ListKeyCredentials(ctx, objectId)
+	if err != nil {
+		return fmt.Errorf("Error Listing Key Credentials for Service Principal with Object ID %q: %+v", objectId, err)
+	}
+
+	credential := findAzureADCertificate(credentials.Value, keyId)
+	if credential == nil {
+		log.Printf("[DEBUG] Service Principal Certificate %q (Object ID %q) was not found - removing from state!", keyId, objectId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("service_principal_id", objectId)
+	flattenAzureADCertificate(d, credential)
+
+	return nil
+}
+
+func resourceArmActiveDirectoryServicePrincipalCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicePrincipalsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	objectId, keyId, err := parseAzureADCertificateId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(objectId, servicePrincipalResourceName)
+	defer azureRMUnlockByName(objectId, servicePrincipalResourceName)
+
+	// ensure the parent Service Principal exists
+	servicePrincipal, err := client.Get(ctx, objectId)
+	if err != nil {
+		// the parent Service Principal was removed - skip it
+		if utils.ResponseWasNotFound(servicePrincipal.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+	}
+
+	existing, err := client.ListKeyCredentials(ctx, objectId)
+	if err != nil {
+		return fmt.Errorf("Error Listing Key Credentials for Service Principal with Object ID %q: %+v", objectId, err)
+	}
+
+	updatedCredentials := make([]graphrbac.KeyCredential, 0)
+	if existing.Value != nil {
+		for _, credential := range *existing.Value {
+			if credential.KeyID == nil {
+				continue
+			}
+
+			if *credential.KeyID != keyId {
+				updatedCredentials = append(updatedCredentials, credential)
+			}
+		}
+	}
+
+	parameters := graphrbac.KeyCredentialsUpdateParameters{
+		Value: &updatedCredentials,
+	}
+	_, err = client.UpdateKeyCredentials(ctx, objectId, parameters)
+	if err != nil {
+		return fmt.Errorf("Error removing Certificate %q from Service Principal %q: %+v", keyId, objectId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2017-09-01/batch"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBatchApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBatchApplicationCreate,
+		Read:   resourceArmBatchApplicationRead,
+		Update: resourceArmBatchApplicationUpdate,
+		Delete: resourceArmBatchApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMBatchPoolName,
+			},
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMBatchAccountName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"allow_updates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"default_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func resourceArmBatchApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchApplicationClient
+	accountClient := meta.(*ArmClient).batchAccountClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Batch Application creation.")
+
+	name := d.Get("name").(string)
+	accountName := d.Get("account_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	account, err := accountClient.Get(ctx, resourceGroup, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Batch Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	if account.ID == nil {
+		return fmt.Errorf("Cannot read ID for Batch Account %q (Resource Group %q)", accountName, resourceGroup)
+	}
+
+	parameters := batch.ApplicationCreateParameters{
+		AllowUpdates: utils.Bool(d.Get("allow_updates").(bool)),
+	}
+
+	if v := d.Get("display_name").(string); v != "" {
+		parameters.DisplayName = utils.String(v)
+	}
+
+	if _, err := client.Create(ctx, resourceGroup, accountName, name, &parameters); err != nil {
+		return fmt.Errorf("Error creating Batch Application %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	// the Default Version can only be set once the Application exists
+	if v := d.Get("default_version").(string); v != "" {
+		update := batch.ApplicationUpdateParameters{
+			DefaultVersion: utils.String(v),
+		}
+		if _, err := client.Update(ctx, resourceGroup, accountName, name, update); err != nil {
+			return fmt.Errorf("Error setting the Default Version for Batch Application %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+		}
+	}
+
+	// the API only returns the name of the Application as the ID, so we build up the Resource ID
+	d.SetId(fmt.Sprintf("%s/applications/%s", *account.ID, name))
+
+	return resourceArmBatchApplicationRead(d, meta)
+}
+
+func resourceArmBatchApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchApplicationClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Batch Application update.")
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["batchAccounts"]
+	name := id.Path["applications"]
+
+	parameters := batch.ApplicationUpdateParameters{
+		AllowUpdates: utils.Bool(d.Get("allow_updates").(bool)),
+		DisplayName:  utils.String(d.Get("display_name").(string)),
+	}
+
+	if v := d.Get("default_version").(string); v != "" {
+		parameters.DefaultVersion = utils.String(v)
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, accountName, name, parameters); err != nil {
+		return fmt.Errorf("Error updating Batch Application %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	return resourceArmBatchApplicationRead(d, meta)
+}
+
+func resourceArmBatchApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchApplicationClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["batchAccounts"]
+	name := id.Path["applications"]
+
+	resp, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Batch Application %q was not found in Account %q / Resource Group %q - removing from state!", name, accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Batch Application %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("account_name", accountName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("allow_updates", resp.AllowUpdates)
+	d.Set("default_version", resp.DefaultVersion)
+	d.Set("display_name", resp.DisplayName)
+
+	return nil
+}
+
+func resourceArmBatchApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchApplicationClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["batchAccounts"]
+	name := id.Path["applications"]
+
+	resp, err := client.Delete(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Batch Application %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
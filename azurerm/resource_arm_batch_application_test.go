@@ -0,0 +1,174 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMBatchApplication_basic(t *testing.T) {
+	resourceName := "azurerm_batch_application.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccAzureRMBatchApplication_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBatchApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBatchApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_updates", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBatchApplication_update(t *testing.T) {
+	resourceName := "azurerm_batch_application.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+	preConfig := testAccAzureRMBatchApplication_basic(ri, rs, location)
+	postConfig := testAccAzureRMBatchApplication_update(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBatchApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBatchApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_updates", "true"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBatchApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_updates", "false"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "TestAccDisplayName"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBatchApplicationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).batchApplicationClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_batch_application" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Batch Application still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMBatchApplicationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		applicationName := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Batch Application: %s", applicationName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).batchApplicationClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, applicationName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on batchApplicationClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Batch Application %q (Account %q / Resource Group %q) does not exist", applicationName, accountName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMBatchApplication_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "testaccsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_batch_account" "test" {
+  name                = "testaccbatch%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  storage_account_id  = "${azurerm_storage_account.test.id}"
+}
+`, rInt, location, rString, rString)
+}
+
+func testAccAzureRMBatchApplication_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMBatchApplication_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_batch_application" "test" {
+  name                = "testaccapp%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  account_name        = "${azurerm_batch_account.test.name}"
+}
+`, template, rString)
+}
+
+func testAccAzureRMBatchApplication_update(rInt int, rString string, location string) string {
+	template := testAccAzureRMBatchApplication_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_batch_application" "test" {
+  name                = "testaccapp%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  account_name        = "${azurerm_batch_account.test.name}"
+  allow_updates       = false
+  display_name        = "TestAccDisplayName"
+}
+`, template, rString)
+}
@@ -0,0 +1,705 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2017-09-01/batch"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBatchPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBatchPoolCreate,
+		Read:   resourceArmBatchPoolRead,
+		Update: resourceArmBatchPoolUpdate,
+		Delete: resourceArmBatchPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMBatchPoolName,
+			},
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMBatchAccountName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"vm_size": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"node_agent_sku_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"max_tasks_per_node": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"storage_image_reference": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"publisher": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"offer": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"sku": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"fixed_scale": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"auto_scale"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_dedicated_nodes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"target_low_priority_nodes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"resize_timeout": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "PT15M",
+						},
+					},
+				},
+			},
+
+			"auto_scale": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"fixed_scale"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"evaluation_interval": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "PT15M",
+						},
+
+						"formula": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"start_task": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command_line": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"max_task_retry_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(-1),
+						},
+
+						"wait_for_success": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+
+						"user_identity": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"auto_user": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"elevation_level": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Default:  string(batch.NonAdmin),
+													ValidateFunc: validation.StringInSlice([]string{
+														string(batch.NonAdmin),
+														string(batch.Admin),
+													}, false),
+												},
+
+												"scope": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Default:  string(batch.AutoUserScopeTask),
+													ValidateFunc: validation.StringInSlice([]string{
+														string(batch.AutoUserScopeTask),
+														string(batch.AutoUserScopePool),
+													}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"resource_file": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"blob_source": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"file_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"file_mode": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchPoolClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Batch Pool creation.")
+
+	name := d.Get("name").(string)
+	accountName := d.Get("account_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	scaleSettings, err := expandBatchPoolScaleSettings(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := batch.Pool{
+		PoolProperties: &batch.PoolProperties{
+			VMSize:          utils.String(d.Get("vm_size").(string)),
+			MaxTasksPerNode: utils.Int32(int32(d.Get("max_tasks_per_node").(int))),
+			DeploymentConfiguration: &batch.DeploymentConfiguration{
+				VirtualMachineConfiguration: &batch.VirtualMachineConfiguration{
+					NodeAgentSkuID: utils.String(d.Get("node_agent_sku_id").(string)),
+					ImageReference: expandBatchPoolImageReference(d.Get("storage_image_reference").([]interface{})),
+				},
+			},
+			ScaleSettings: scaleSettings,
+			StartTask:     expandBatchPoolStartTask(d.Get("start_task").([]interface{})),
+		},
+	}
+
+	if v := d.Get("display_name").(string); v != "" {
+		parameters.PoolProperties.DisplayName = utils.String(v)
+	}
+
+	future, err := client.Create(ctx, resourceGroup, accountName, name, parameters, "", "")
+	if err != nil {
+		return fmt.Errorf("Error creating Batch Pool %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := fmt.Sprintf("%s/pools/%s", azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Batch", "batchAccounts", accountName), name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation of Batch Pool %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Batch Pool %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Batch Pool %q (Account %q / Resource Group %q)", name, accountName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmBatchPoolRead(d, meta)
+}
+
+func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchPoolClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Batch Pool update.")
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["batchAccounts"]
+	name := id.Path["pools"]
+
+	scaleSettings, err := expandBatchPoolScaleSettings(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := batch.Pool{
+		PoolProperties: &batch.PoolProperties{
+			ScaleSettings: scaleSettings,
+		},
+	}
+
+	if d.HasChange("start_task") {
+		startTask := expandBatchPoolStartTask(d.Get("start_task").([]interface{}))
+		if startTask == nil {
+			// an empty Start Task removes it from the Pool
+			startTask = &batch.StartTask{}
+		}
+		parameters.PoolProperties.StartTask = startTask
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, accountName, name, parameters, ""); err != nil {
+		return fmt.Errorf("Error updating Batch Pool %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	return resourceArmBatchPoolRead(d, meta)
+}
+
+func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchPoolClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["batchAccounts"]
+	name := id.Path["pools"]
+
+	resp, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Batch Pool %q was not found in Account %q / Resource Group %q - removing from state!", name, accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Batch Pool %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("account_name", accountName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.PoolProperties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("vm_size", props.VMSize)
+
+		if props.MaxTasksPerNode != nil {
+			d.Set("max_tasks_per_node", int(*props.MaxTasksPerNode))
+		}
+
+		if config := props.DeploymentConfiguration; config != nil && config.VirtualMachineConfiguration != nil {
+			vmConfig := config.VirtualMachineConfiguration
+			d.Set("node_agent_sku_id", vmConfig.NodeAgentSkuID)
+
+			if err := d.Set("storage_image_reference", flattenBatchPoolImageReference(vmConfig.ImageReference)); err != nil {
+				return fmt.Errorf("Error setting `storage_image_reference`: %+v", err)
+			}
+		}
+
+		fixedScale, autoScale := flattenBatchPoolScaleSettings(props.ScaleSettings)
+		if err := d.Set("fixed_scale", fixedScale); err != nil {
+			return fmt.Errorf("Error setting `fixed_scale`: %+v", err)
+		}
+		if err := d.Set("auto_scale", autoScale); err != nil {
+			return fmt.Errorf("Error setting `auto_scale`: %+v", err)
+		}
+
+		if err := d.Set("start_task", flattenBatchPoolStartTask(props.StartTask)); err != nil {
+			return fmt.Errorf("Error setting `start_task`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmBatchPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchPoolClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["batchAccounts"]
+	name := id.Path["pools"]
+
+	future, err := client.Delete(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Batch Pool %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Batch Pool %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandBatchPoolScaleSettings(d *schema.ResourceData) (*batch.ScaleSettings, error) {
+	fixedScale := d.Get("fixed_scale").([]interface{})
+	autoScale := d.Get("auto_scale").([]interface{})
+
+	if len(fixedScale) == 0 && len(autoScale) == 0 {
+		return nil, fmt.Errorf("Either a `fixed_scale` or an `auto_scale` block must be specified")
+	}
+
+	if len(autoScale) > 0 {
+		v := autoScale[0].(map[string]interface{})
+		return &batch.ScaleSettings{
+			AutoScale: &batch.AutoScaleSettings{
+				EvaluationInterval: utils.String(v["evaluation_interval"].(string)),
+				Formula:            utils.String(v["formula"].(string)),
+			},
+		}, nil
+	}
+
+	v := fixedScale[0].(map[string]interface{})
+	return &batch.ScaleSettings{
+		FixedScale: &batch.FixedScaleSettings{
+			TargetDedicatedNodes:   utils.Int32(int32(v["target_dedicated_nodes"].(int))),
+			TargetLowPriorityNodes: utils.Int32(int32(v["target_low_priority_nodes"].(int))),
+			ResizeTimeout:          utils.String(v["resize_timeout"].(string)),
+		},
+	}, nil
+}
+
+func flattenBatchPoolScaleSettings(input *batch.ScaleSettings) ([]interface{}, []interface{}) {
+	fixedScale := make([]interface{}, 0)
+	autoScale := make([]interface{}, 0)
+
+	if input == nil {
+		return fixedScale, autoScale
+	}
+
+	if settings := input.FixedScale; settings != nil {
+		output := make(map[string]interface{})
+		if settings.TargetDedicatedNodes != nil {
+			output["target_dedicated_nodes"] = int(*settings.TargetDedicatedNodes)
+		}
+		if settings.TargetLowPriorityNodes != nil {
+			output["target_low_priority_nodes"] = int(*settings.TargetLowPriorityNodes)
+		}
+		if settings.ResizeTimeout != nil {
+			output["resize_timeout"] = *settings.ResizeTimeout
+		}
+		fixedScale = append(fixedScale, output)
+	}
+
+	if settings := input.AutoScale; settings != nil {
+		output := make(map[string]interface{})
+		if settings.EvaluationInterval != nil {
+			output["evaluation_interval"] = *settings.EvaluationInterval
+		}
+		if settings.Formula != nil {
+			output["formula"] = *settings.Formula
+		}
+		autoScale = append(autoScale, output)
+	}
+
+	return fixedScale, autoScale
+}
+
+func expandBatchPoolImageReference(input []interface{}) *batch.ImageReference {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	reference := batch.ImageReference{}
+
+	if id := v["id"].(string); id != "" {
+		reference.ID = utils.String(id)
+	}
+	if publisher := v["publisher"].(string); publisher != "" {
+		reference.Publisher = utils.String(publisher)
+	}
+	if offer := v["offer"].(string); offer != "" {
+		reference.Offer = utils.String(offer)
+	}
+	if sku := v["sku"].(string); sku != "" {
+		reference.Sku = utils.String(sku)
+	}
+	if version := v["version"].(string); version != "" {
+		reference.Version = utils.String(version)
+	}
+
+	return &reference
+}
+
+func flattenBatchPoolImageReference(input *batch.ImageReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	if input.ID != nil {
+		output["id"] = *input.ID
+	}
+	if input.Publisher != nil {
+		output["publisher"] = *input.Publisher
+	}
+	if input.Offer != nil {
+		output["offer"] = *input.Offer
+	}
+	if input.Sku != nil {
+		output["sku"] = *input.Sku
+	}
+	if input.Version != nil {
+		output["version"] = *input.Version
+	}
+
+	return []interface{}{output}
+}
+
+func expandBatchPoolStartTask(input []interface{}) *batch.StartTask {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	environmentSettings := make([]batch.EnvironmentSetting, 0)
+	for key, value := range v["environment"].(map[string]interface{}) {
+		environmentSettings = append(environmentSettings, batch.EnvironmentSetting{
+			Name:  utils.String(key),
+			Value: utils.String(value.(string)),
+		})
+	}
+
+	resourceFiles := make([]batch.ResourceFile, 0)
+	for _, raw := range v["resource_file"].([]interface{}) {
+		file := raw.(map[string]interface{})
+		resourceFile := batch.ResourceFile{
+			BlobSource: utils.String(file["blob_source"].(string)),
+			FilePath:   utils.String(file["file_path"].(string)),
+		}
+		if fileMode := file["file_mode"].(string); fileMode != "" {
+			resourceFile.FileMode = utils.String(fileMode)
+		}
+		resourceFiles = append(resourceFiles, resourceFile)
+	}
+
+	userIdentity := batch.UserIdentity{}
+	if identities := v["user_identity"].([]interface{}); len(identities) > 0 {
+		identity := identities[0].(map[string]interface{})
+
+		if userName := identity["user_name"].(string); userName != "" {
+			userIdentity.UserName = utils.String(userName)
+		}
+
+		if autoUsers := identity["auto_user"].([]interface{}); len(autoUsers) > 0 {
+			autoUser := autoUsers[0].(map[string]interface{})
+			userIdentity.AutoUser = &batch.AutoUserSpecification{
+				ElevationLevel: batch.ElevationLevel(autoUser["elevation_level"].(string)),
+				Scope:          batch.AutoUserScope(autoUser["scope"].(string)),
+			}
+		}
+	}
+
+	return &batch.StartTask{
+		CommandLine:         utils.String(v["command_line"].(string)),
+		MaxTaskRetryCount:   utils.Int32(int32(v["max_task_retry_count"].(int))),
+		WaitForSuccess:      utils.Bool(v["wait_for_success"].(bool)),
+		EnvironmentSettings: &environmentSettings,
+		ResourceFiles:       &resourceFiles,
+		UserIdentity:        &userIdentity,
+	}
+}
+
+func flattenBatchPoolStartTask(input *batch.StartTask) []interface{} {
+	if input == nil || input.CommandLine == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"command_line": *input.CommandLine,
+	}
+
+	if input.MaxTaskRetryCount != nil {
+		output["max_task_retry_count"] = int(*input.MaxTaskRetryCount)
+	}
+
+	if input.WaitForSuccess != nil {
+		output["wait_for_success"] = *input.WaitForSuccess
+	}
+
+	environment := make(map[string]interface{})
+	if settings := input.EnvironmentSettings; settings != nil {
+		for _, setting := range *settings {
+			if setting.Name == nil || setting.Value == nil {
+				continue
+			}
+			environment[*setting.Name] = *setting.Value
+		}
+	}
+	output["environment"] = environment
+
+	resourceFiles := make([]interface{}, 0)
+	if files := input.ResourceFiles; files != nil {
+		for _, file := range *files {
+			resourceFile := make(map[string]interface{})
+			if file.BlobSource != nil {
+				resourceFile["blob_source"] = *file.BlobSource
+			}
+			if file.FilePath != nil {
+				resourceFile["file_path"] = *file.FilePath
+			}
+			if file.FileMode != nil {
+				resourceFile["file_mode"] = *file.FileMode
+			}
+			resourceFiles = append(resourceFiles, resourceFile)
+		}
+	}
+	output["resource_file"] = resourceFiles
+
+	userIdentities := make([]interface{}, 0)
+	if identity := input.UserIdentity; identity != nil {
+		userIdentity := make(map[string]interface{})
+		if identity.UserName != nil {
+			userIdentity["user_name"] = *identity.UserName
+		}
+
+		autoUsers := make([]interface{}, 0)
+		if autoUser := identity.AutoUser; autoUser != nil {
+			autoUsers = append(autoUsers, map[string]interface{}{
+				"elevation_level": string(autoUser.ElevationLevel),
+				"scope":           string(autoUser.Scope),
+			})
+		}
+		userIdentity["auto_user"] = autoUsers
+
+		userIdentities = append(userIdentities, userIdentity)
+	}
+	output["user_identity"] = userIdentities
+
+	return []interface{}{output}
+}
+
+func validateAzureRMBatchPoolName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q can contain any combination of alphanumeric characters, hyphens and underscores and must be between 1 and 64 characters in length", k))
+	}
+
+	return warnings, errors
+}
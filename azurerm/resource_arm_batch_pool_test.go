@@ -0,0 +1,311 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMBatchPool_basic(t *testing.T) {
+	resourceName := "azurerm_batch_pool.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccAzureRMBatchPool_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBatchPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBatchPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vm_size", "STANDARD_A1"),
+					resource.TestCheckResourceAttr(resourceName, "node_agent_sku_id", "batch.node.ubuntu 16.04"),
+					resource.TestCheckResourceAttr(resourceName, "storage_image_reference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_scale.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_scale.0.target_dedicated_nodes", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scale.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBatchPool_autoScale(t *testing.T) {
+	resourceName := "azurerm_batch_pool.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+	preConfig := testAccAzureRMBatchPool_basic(ri, rs, location)
+	postConfig := testAccAzureRMBatchPool_autoScale(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBatchPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBatchPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fixed_scale.#", "1"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBatchPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fixed_scale.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scale.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scale.0.evaluation_interval", "PT15M"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBatchPool_startTask(t *testing.T) {
+	resourceName := "azurerm_batch_pool.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccAzureRMBatchPool_startTask(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBatchPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBatchPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_task.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "start_task.0.command_line", "echo 'Hello World from $env'"),
+					resource.TestCheckResourceAttr(resourceName, "start_task.0.max_task_retry_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "start_task.0.wait_for_success", "true"),
+					resource.TestCheckResourceAttr(resourceName, "start_task.0.environment.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "start_task.0.environment.env", "TEST"),
+					resource.TestCheckResourceAttr(resourceName, "start_task.0.user_identity.0.auto_user.0.scope", "Task"),
+					resource.TestCheckResourceAttr(resourceName, "start_task.0.user_identity.0.auto_user.0.elevation_level", "NonAdmin"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMBatchPoolName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "test-pool_01",
+			ErrCount: 0,
+		},
+		{
+			Value:    "test.pool",
+			ErrCount: 1,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklm",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAzureRMBatchPoolName(tc.Value, "azurerm_batch_pool")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Batch Pool Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMBatchPoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).batchPoolClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_batch_pool" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Batch Pool still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMBatchPoolExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		poolName := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Batch Pool: %s", poolName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).batchPoolClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, poolName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on batchPoolClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Batch Pool %q (Account %q / Resource Group %q) does not exist", poolName, accountName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMBatchPool_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_batch_account" "test" {
+  name                = "testaccbatch%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMBatchPool_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMBatchPool_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_batch_pool" "test" {
+  name                = "testaccpool%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  account_name        = "${azurerm_batch_account.test.name}"
+  node_agent_sku_id   = "batch.node.ubuntu 16.04"
+  vm_size             = "STANDARD_A1"
+
+  fixed_scale {
+    target_dedicated_nodes = 1
+  }
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04.0-LTS"
+    version   = "latest"
+  }
+}
+`, template, rString)
+}
+
+func testAccAzureRMBatchPool_autoScale(rInt int, rString string, location string) string {
+	template := testAccAzureRMBatchPool_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_batch_pool" "test" {
+  name                = "testaccpool%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  account_name        = "${azurerm_batch_account.test.name}"
+  node_agent_sku_id   = "batch.node.ubuntu 16.04"
+  vm_size             = "STANDARD_A1"
+
+  auto_scale {
+    evaluation_interval = "PT15M"
+
+    formula = <<EOF
+      startingNumberOfVMs = 1;
+      maxNumberofVMs = 25;
+      pendingTaskSamplePercent = $PendingTasks.GetSamplePercent(180 * TimeInterval_Second);
+      pendingTaskSamples = pendingTaskSamplePercent < 70 ? startingNumberOfVMs : avg($PendingTasks.GetSample(180 * TimeInterval_Second));
+      $TargetDedicatedNodes=min(maxNumberofVMs, pendingTaskSamples);
+EOF
+  }
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04.0-LTS"
+    version   = "latest"
+  }
+}
+`, template, rString)
+}
+
+func testAccAzureRMBatchPool_startTask(rInt int, rString string, location string) string {
+	template := testAccAzureRMBatchPool_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_batch_pool" "test" {
+  name                = "testaccpool%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  account_name        = "${azurerm_batch_account.test.name}"
+  node_agent_sku_id   = "batch.node.ubuntu 16.04"
+  vm_size             = "STANDARD_A1"
+
+  fixed_scale {
+    target_dedicated_nodes = 1
+  }
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04.0-LTS"
+    version   = "latest"
+  }
+
+  start_task {
+    command_line         = "echo 'Hello World from $env'"
+    max_task_retry_count = 1
+    wait_for_success     = true
+
+    environment {
+      env = "TEST"
+    }
+
+    user_identity {
+      auto_user {
+        elevation_level = "NonAdmin"
+        scope           = "Task"
+      }
+    }
+  }
+}
+`, template, rString)
+}
@@ -0,0 +1,363 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBlueprintAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBlueprintAssignmentCreateUpdate,
+		Read:   resourceArmBlueprintAssignmentRead,
+		Update: resourceArmBlueprintAssignmentCreateUpdate,
+		Delete: resourceArmBlueprintAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"target_subscription_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBlueprintTargetSubscriptionID,
+			},
+
+			"location": locationSchema(),
+
+			"identity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+								"UserAssigned",
+							}, true),
+						},
+
+						"identity_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"version_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateBlueprintVersionID,
+			},
+
+			"parameter_values": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"resource_groups": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"lock_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "None",
+				ValidateFunc: validation.StringInSlice([]string{
+					"None",
+					"AllResourcesReadOnly",
+					"AllResourcesDoNotDelete",
+				}, false),
+			},
+
+			"lock_exclude_principals": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmBlueprintAssignmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).blueprintClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Blueprint Assignment creation/update.")
+
+	name := d.Get("name").(string)
+	targetScope := d.Get("target_subscription_id").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	identity, err := expandBlueprintAssignmentIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	assignment := blueprintAssignment{
+		Location: utils.String(location),
+		Identity: identity,
+		Properties: &blueprintAssignmentProperties{
+			BlueprintID:    utils.String(d.Get("version_id").(string)),
+			Parameters:     map[string]interface{}{},
+			ResourceGroups: map[string]interface{}{},
+			Locks: &blueprintAssignmentLockSettings{
+				Mode:               d.Get("lock_mode").(string),
+				ExcludedPrincipals: expandBlueprintAssignmentStrings(d.Get("lock_exclude_principals").([]interface{})),
+			},
+		},
+	}
+
+	if v := d.Get("parameter_values").(string); v != "" {
+		parameters, err := structure.ExpandJsonFromString(v)
+		if err != nil {
+			return fmt.Errorf("Error expanding `parameter_values`: %+v", err)
+		}
+		assignment.Properties.Parameters = parameters
+	}
+
+	if v := d.Get("resource_groups").(string); v != "" {
+		resourceGroups, err := structure.ExpandJsonFromString(v)
+		if err != nil {
+			return fmt.Errorf("Error expanding `resource_groups`: %+v", err)
+		}
+		assignment.Properties.ResourceGroups = resourceGroups
+	}
+
+	id := blueprintAssignmentResourceID(targetScope, name)
+	future, err := client.CreateOrUpdate(ctx, id, assignment)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Blueprint Assignment %q (Scope %q): %+v", name, targetScope, err)
+	}
+
+	// the Assignment is returned with a `provisioningState` which is polled until the Blueprint has been deployed
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Blueprint Assignment %q (Scope %q): %+v", name, targetScope, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmBlueprintAssignmentRead(d, meta)
+}
+
+func resourceArmBlueprintAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).blueprintClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseBlueprintAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var assignment blueprintAssignment
+	resp, err := client.Get(ctx, d.Id(), &assignment)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Blueprint Assignment %q was not found in Scope %q - removing from state!", id.Name, id.Scope)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Blueprint Assignment %q (Scope %q): %+v", id.Name, id.Scope, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("target_subscription_id", id.Scope)
+	if location := assignment.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if err := d.Set("identity", flattenBlueprintAssignmentIdentity(assignment.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if props := assignment.Properties; props != nil {
+		d.Set("version_id", props.BlueprintID)
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+
+		parameterValues := ""
+		if len(props.Parameters) > 0 {
+			parameterValues, err = structure.FlattenJsonToString(props.Parameters)
+			if err != nil {
+				return fmt.Errorf("Error flattening `parameter_values`: %+v", err)
+			}
+		}
+		d.Set("parameter_values", parameterValues)
+
+		resourceGroups := ""
+		if len(props.ResourceGroups) > 0 {
+			resourceGroups, err = structure.FlattenJsonToString(props.ResourceGroups)
+			if err != nil {
+				return fmt.Errorf("Error flattening `resource_groups`: %+v", err)
+			}
+		}
+		d.Set("resource_groups", resourceGroups)
+
+		if locks := props.Locks; locks != nil {
+			d.Set("lock_mode", locks.Mode)
+
+			excludedPrincipals := make([]interface{}, 0)
+			if locks.ExcludedPrincipals != nil {
+				for _, v := range *locks.ExcludedPrincipals {
+					excludedPrincipals = append(excludedPrincipals, v)
+				}
+			}
+			if err := d.Set("lock_exclude_principals", excludedPrincipals); err != nil {
+				return fmt.Errorf("Error setting `lock_exclude_principals`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmBlueprintAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).blueprintClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseBlueprintAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Blueprint Assignment %q (Scope %q): %+v", id.Name, id.Scope, err)
+	}
+
+	// the deletion returns a 200 with a `provisioningState` of `deleting` but no polling URL, as such
+	// we poll the Assignment until it's gone
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    blueprintAssignmentStateRefreshFunc(meta, d.Id()),
+		Timeout:    60 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Blueprint Assignment %q (Scope %q): %+v", id.Name, id.Scope, err)
+	}
+
+	return nil
+}
+
+func expandBlueprintAssignmentIdentity(input []interface{}) (*blueprintAssignmentIdentity, error) {
+	v := input[0].(map[string]interface{})
+	identityType := v["type"].(string)
+	identityIds := v["identity_ids"].([]interface{})
+
+	identity := blueprintAssignmentIdentity{
+		Type: identityType,
+	}
+
+	if identityType == "UserAssigned" {
+		if len(identityIds) == 0 {
+			return nil, fmt.Errorf("`identity_ids` must be specified when `type` is `UserAssigned`")
+		}
+
+		identity.UserAssignedIdentities = make(map[string]*blueprintUserAssignedIdentity)
+		for _, id := range identityIds {
+			identity.UserAssignedIdentities[id.(string)] = &blueprintUserAssignedIdentity{}
+		}
+	} else if len(identityIds) > 0 {
+		return nil, fmt.Errorf("`identity_ids` can only be specified when `type` is `UserAssigned`")
+	}
+
+	return &identity, nil
+}
+
+func flattenBlueprintAssignmentIdentity(input *blueprintAssignmentIdentity) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	// the User Assigned Identities are returned as a map, so are sorted to give a consistent ordering
+	ids := make([]string, 0)
+	for id := range input.UserAssignedIdentities {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	identityIds := make([]interface{}, 0)
+	for _, id := range ids {
+		identityIds = append(identityIds, id)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         input.Type,
+			"identity_ids": identityIds,
+			"principal_id": principalId,
+		},
+	}
+}
+
+func expandBlueprintAssignmentStrings(input []interface{}) *[]string {
+	output := make([]string, 0)
+	for _, v := range input {
+		output = append(output, v.(string))
+	}
+
+	return &output
+}
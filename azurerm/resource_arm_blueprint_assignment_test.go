@@ -0,0 +1,178 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Blueprint Definitions can't be managed by this Provider, as such these tests require a Blueprint which
+// has been published at the Subscription scope (containing no parameters) - specified via the
+// `ARM_TEST_BLUEPRINT_NAME` and `ARM_TEST_BLUEPRINT_VERSION` Environment Variables.
+func testAccAzureRMBlueprintPreCheck(t *testing.T) (string, string) {
+	blueprintName := os.Getenv("ARM_TEST_BLUEPRINT_NAME")
+	blueprintVersion := os.Getenv("ARM_TEST_BLUEPRINT_VERSION")
+	if blueprintName == "" || blueprintVersion == "" {
+		t.Skip("`ARM_TEST_BLUEPRINT_NAME` and `ARM_TEST_BLUEPRINT_VERSION` must be set to run the Blueprint tests")
+	}
+
+	return blueprintName, blueprintVersion
+}
+
+func TestAccAzureRMBlueprintAssignment_basic(t *testing.T) {
+	blueprintName, blueprintVersion := testAccAzureRMBlueprintPreCheck(t)
+
+	resourceName := "azurerm_blueprint_assignment.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMBlueprintAssignment_basic(ri, testLocation(), blueprintName, blueprintVersion, "None")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBlueprintAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBlueprintAssignmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "UserAssigned"),
+					resource.TestCheckResourceAttr(resourceName, "lock_mode", "None"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBlueprintAssignment_lockMode(t *testing.T) {
+	blueprintName, blueprintVersion := testAccAzureRMBlueprintPreCheck(t)
+
+	resourceName := "azurerm_blueprint_assignment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBlueprintAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBlueprintAssignment_basic(ri, location, blueprintName, blueprintVersion, "None"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBlueprintAssignmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lock_mode", "None"),
+				),
+			},
+			{
+				Config: testAccAzureRMBlueprintAssignment_basic(ri, location, blueprintName, blueprintVersion, "AllResourcesDoNotDelete"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBlueprintAssignmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lock_mode", "AllResourcesDoNotDelete"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBlueprintAssignmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).blueprintClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var assignment blueprintAssignment
+		resp, err := client.Get(ctx, rs.Primary.ID, &assignment)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Blueprint Assignment %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on blueprintClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMBlueprintAssignmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).blueprintClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_blueprint_assignment" {
+			continue
+		}
+
+		var assignment blueprintAssignment
+		resp, err := client.Get(ctx, rs.Primary.ID, &assignment)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Blueprint Assignment still exists:\n%#v", assignment)
+	}
+
+	return nil
+}
+
+func testAccAzureRMBlueprintAssignment_basic(rInt int, location string, blueprintName string, blueprintVersion string, lockMode string) string {
+	return fmt.Sprintf(`
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = "${data.azurerm_subscription.current.id}"
+  role_definition_name = "Owner"
+  principal_id         = "${azurerm_user_assigned_identity.test.principal_id}"
+}
+
+data "azurerm_blueprint_published_version" "test" {
+  scope_id       = "${data.azurerm_subscription.current.id}"
+  blueprint_name = "%s"
+  version        = "%s"
+}
+
+resource "azurerm_blueprint_assignment" "test" {
+  name                   = "acctestbpa-%d"
+  target_subscription_id = "${data.azurerm_subscription.current.id}"
+  version_id             = "${data.azurerm_blueprint_published_version.test.id}"
+  location               = "${azurerm_resource_group.test.location}"
+  lock_mode              = "%s"
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = ["${azurerm_user_assigned_identity.test.id}"]
+  }
+
+  depends_on = ["azurerm_role_assignment.test"]
+}
+`, rInt, location, rInt, blueprintName, blueprintVersion, rInt, lockMode)
+}
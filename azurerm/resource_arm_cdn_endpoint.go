@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -173,6 +174,132 @@ func resourceArmCdnEndpoint() *schema.Resource {
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
+			"delivery_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"order": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"url_path_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"match_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Literal",
+											"Wildcard",
+										}, false),
+									},
+								},
+							},
+						},
+
+						"url_file_extension_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"extensions": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+								},
+							},
+						},
+
+						"cache_expiration_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"behavior": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"BypassCache",
+											"Override",
+											"SetIfMissing",
+										}, false),
+									},
+									"duration": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateCdnEndpointCacheDuration,
+									},
+								},
+							},
+						},
+
+						"url_redirect_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"redirect_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Found",
+											"Moved",
+											"PermanentRedirect",
+											"TemporaryRedirect",
+										}, false),
+									},
+									"protocol": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "MatchRequest",
+										ValidateFunc: validation.StringInSlice([]string{
+											"Http",
+											"Https",
+											"MatchRequest",
+										}, false),
+									},
+									"hostname": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"query_string": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"fragment": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"host_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -220,7 +347,7 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 			QueryStringCachingBehavior: cdn.QueryStringCachingBehavior(cachingBehaviour),
 			OriginHostHeader:           utils.String(originHostHeader),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	if optimizationType != "" {
@@ -241,16 +368,29 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		endpoint.EndpointProperties.Origins = &origins
 	}
 
+	deliveryPolicy, err := expandArmCdnEndpointDeliveryPolicy(d.Get("delivery_rule").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `delivery_rule`: %+v", err)
+	}
+
 	future, err := client.Create(ctx, resourceGroup, profileName, name, endpoint)
 	if err != nil {
 		return fmt.Errorf("Error creating CDN Endpoint %q (Profile %q / Resource Group %q): %+v", name, profileName, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := fmt.Sprintf("%s/endpoints/%s", azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Cdn", "profiles", profileName), name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error waiting for CDN Endpoint %q (Profile %q / Resource Group %q) to finish creating: %+v", name, profileName, resourceGroup, err)
 	}
 
+	if len(deliveryPolicy.Rules) > 0 {
+		if err := updateArmCdnEndpointDeliveryPolicy(meta, expectedId, deliveryPolicy); err != nil {
+			return fmt.Errorf("Error setting the Delivery Rules for CDN Endpoint %q (Profile %q / Resource Group %q): %+v", name, profileName, resourceGroup, err)
+		}
+	}
+
 	read, err := client.Get(ctx, resourceGroup, profileName, name)
 	if err != nil {
 		return fmt.Errorf("Error retrieving CDN Endpoint %q (Profile %q / Resource Group %q): %+v", name, profileName, resourceGroup, err)
@@ -294,7 +434,7 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 			QueryStringCachingBehavior: cdn.QueryStringCachingBehavior(cachingBehaviour),
 			OriginHostHeader:           utils.String(hostHeader),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	if optimizationType != "" {
@@ -307,16 +447,27 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		endpoint.EndpointPropertiesUpdateParameters.ProbePath = utils.String(probePath)
 	}
 
+	deliveryPolicy, err := expandArmCdnEndpointDeliveryPolicy(d.Get("delivery_rule").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `delivery_rule`: %+v", err)
+	}
+
 	future, err := endpointsClient.Update(ctx, resourceGroup, profileName, name, endpoint)
 	if err != nil {
 		return fmt.Errorf("Error updating CDN Endpoint %q (Profile %q / Resource Group %q): %s", name, profileName, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, endpointsClient.Client)
+	err = waitForCompletion(ctx, &future.Future, endpointsClient.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for the CDN Endpoint %q (Profile %q / Resource Group %q) to finish updating: %+v", name, profileName, resourceGroup, err)
 	}
 
+	if d.HasChange("delivery_rule") {
+		if err := updateArmCdnEndpointDeliveryPolicy(meta, d.Id(), deliveryPolicy); err != nil {
+			return fmt.Errorf("Error updating the Delivery Rules for CDN Endpoint %q (Profile %q / Resource Group %q): %+v", name, profileName, resourceGroup, err)
+		}
+	}
+
 	return resourceArmCdnEndpointRead(d, meta)
 }
 
@@ -378,9 +529,21 @@ func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error
 		if err := d.Set("origin", origins); err != nil {
 			return fmt.Errorf("Error flattening `origin`: %+v", err)
 		}
+
+	}
+
+	// the Delivery Rules are retrieved from a newer API Version, since the SDK doesn't support the URL Redirect Action
+	var deliveryPolicyResp cdnEndpointDeliveryPolicyUpdate
+	if _, err := meta.(*ArmClient).cdnClient.Get(ctx, d.Id(), &deliveryPolicyResp); err != nil {
+		return fmt.Errorf("Error retrieving the Delivery Rules for CDN Endpoint %q (Profile %q / Resource Group %q): %+v", name, profileName, resourceGroup, err)
+	}
+
+	deliveryRules := flattenArmCdnEndpointDeliveryPolicy(deliveryPolicyResp.Properties.DeliveryPolicy)
+	if err := d.Set("delivery_rule", deliveryRules); err != nil {
+		return fmt.Errorf("Error flattening `delivery_rule`: %+v", err)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -408,7 +571,7 @@ func resourceArmCdnEndpointDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error deleting CDN Endpoint %q (Profile %q / Resource Group %q): %+v", name, profileName, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		if response.WasNotFound(future.Response()) {
 			return nil
@@ -419,6 +582,23 @@ func resourceArmCdnEndpointDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func updateArmCdnEndpointDeliveryPolicy(meta interface{}, id string, policy *cdnDeliveryPolicy) error {
+	client := meta.(*ArmClient).cdnClient
+	ctx := meta.(*ArmClient).StopContext
+
+	parameters := cdnEndpointDeliveryPolicyUpdate{
+		Properties: cdnEndpointDeliveryPolicyProperties{
+			DeliveryPolicy: policy,
+		},
+	}
+	future, err := client.Update(ctx, id, parameters)
+	if err != nil {
+		return err
+	}
+
+	return waitForCompletion(ctx, &future.Future, client.Client)
+}
+
 func expandArmCdnEndpointGeoFilters(d *schema.ResourceData) (*[]cdn.GeoFilter, error) {
 	filters := make([]cdn.GeoFilter, 0)
 
@@ -562,3 +742,213 @@ func flattenAzureRMCdnEndpointOrigin(input *[]cdn.DeepCreatedOrigin) []interface
 
 	return results
 }
+
+func expandArmCdnEndpointDeliveryPolicy(input []interface{}) (*cdnDeliveryPolicy, error) {
+	rules := make([]cdnDeliveryRule, 0)
+
+	for _, v := range input {
+		rule := v.(map[string]interface{})
+		order := rule["order"].(int)
+
+		conditions := make([]cdnDeliveryRuleCondition, 0)
+		for _, raw := range rule["url_path_condition"].([]interface{}) {
+			condition := raw.(map[string]interface{})
+
+			operator := "Equal"
+			if condition["match_type"].(string) == "Wildcard" {
+				operator = "Wildcard"
+			}
+
+			conditions = append(conditions, cdnDeliveryRuleCondition{
+				Name: cdnDeliveryRuleConditionURLPath,
+				Parameters: &cdnMatchConditionParameters{
+					OdataType:   "#Microsoft.Azure.Cdn.Models.DeliveryRuleUrlPathMatchConditionParameters",
+					Operator:    operator,
+					MatchValues: []string{condition["path"].(string)},
+				},
+			})
+		}
+
+		for _, raw := range rule["url_file_extension_condition"].([]interface{}) {
+			condition := raw.(map[string]interface{})
+
+			extensions := make([]string, 0)
+			for _, extension := range condition["extensions"].([]interface{}) {
+				extensions = append(extensions, extension.(string))
+			}
+
+			conditions = append(conditions, cdnDeliveryRuleCondition{
+				Name: cdnDeliveryRuleConditionURLFileExtension,
+				Parameters: &cdnMatchConditionParameters{
+					OdataType:   "#Microsoft.Azure.Cdn.Models.DeliveryRuleUrlFileExtensionMatchConditionParameters",
+					Operator:    "Equal",
+					MatchValues: extensions,
+				},
+			})
+		}
+
+		actions := make([]cdnDeliveryRuleAction, 0)
+		for _, raw := range rule["cache_expiration_action"].([]interface{}) {
+			action := raw.(map[string]interface{})
+
+			parameters := cdnDeliveryRuleActionParameters{
+				OdataType:     "#Microsoft.Azure.Cdn.Models.DeliveryRuleCacheExpirationActionParameters",
+				CacheBehavior: utils.String(action["behavior"].(string)),
+				CacheType:     utils.String("All"),
+			}
+
+			if duration := action["duration"].(string); duration != "" {
+				parameters.CacheDuration = utils.String(duration)
+			}
+
+			actions = append(actions, cdnDeliveryRuleAction{
+				Name:       cdnDeliveryRuleActionCacheExpiration,
+				Parameters: &parameters,
+			})
+		}
+
+		for _, raw := range rule["url_redirect_action"].([]interface{}) {
+			action := raw.(map[string]interface{})
+
+			parameters := cdnDeliveryRuleActionParameters{
+				OdataType:           "#Microsoft.Azure.Cdn.Models.DeliveryRuleUrlRedirectActionParameters",
+				RedirectType:        utils.String(action["redirect_type"].(string)),
+				DestinationProtocol: utils.String(action["protocol"].(string)),
+			}
+
+			if hostname := action["hostname"].(string); hostname != "" {
+				parameters.CustomHostname = utils.String(hostname)
+			}
+			if path := action["path"].(string); path != "" {
+				parameters.CustomPath = utils.String(path)
+			}
+			if queryString := action["query_string"].(string); queryString != "" {
+				parameters.CustomQueryString = utils.String(queryString)
+			}
+			if fragment := action["fragment"].(string); fragment != "" {
+				parameters.CustomFragment = utils.String(fragment)
+			}
+
+			actions = append(actions, cdnDeliveryRuleAction{
+				Name:       cdnDeliveryRuleActionURLRedirect,
+				Parameters: &parameters,
+			})
+		}
+
+		if len(actions) == 0 {
+			return nil, fmt.Errorf("Delivery Rule %d must specify at least one `cache_expiration_action` or `url_redirect_action`", order)
+		}
+
+		rules = append(rules, cdnDeliveryRule{
+			Order:      int32(order),
+			Conditions: conditions,
+			Actions:    actions,
+		})
+	}
+
+	return &cdnDeliveryPolicy{
+		Rules: rules,
+	}, nil
+}
+
+func flattenArmCdnEndpointDeliveryPolicy(input *cdnDeliveryPolicy) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range input.Rules {
+		urlPathConditions := make([]interface{}, 0)
+		urlFileExtensionConditions := make([]interface{}, 0)
+		for _, condition := range rule.Conditions {
+			parameters := condition.Parameters
+			if parameters == nil {
+				continue
+			}
+
+			switch condition.Name {
+			case cdnDeliveryRuleConditionURLPath:
+				path := ""
+				if len(parameters.MatchValues) > 0 {
+					path = parameters.MatchValues[0]
+				}
+
+				matchType := parameters.Operator
+				if matchType == "Equal" {
+					matchType = "Literal"
+				}
+
+				urlPathConditions = append(urlPathConditions, map[string]interface{}{
+					"path":       path,
+					"match_type": matchType,
+				})
+
+			case cdnDeliveryRuleConditionURLFileExtension:
+				extensions := make([]interface{}, 0)
+				for _, extension := range parameters.MatchValues {
+					extensions = append(extensions, extension)
+				}
+
+				urlFileExtensionConditions = append(urlFileExtensionConditions, map[string]interface{}{
+					"extensions": extensions,
+				})
+			}
+		}
+
+		cacheExpirationActions := make([]interface{}, 0)
+		urlRedirectActions := make([]interface{}, 0)
+		for _, action := range rule.Actions {
+			parameters := action.Parameters
+			if parameters == nil {
+				continue
+			}
+
+			switch action.Name {
+			case cdnDeliveryRuleActionCacheExpiration:
+				cacheExpirationActions = append(cacheExpirationActions, map[string]interface{}{
+					"behavior": cdnDeliveryRuleStringValue(parameters.CacheBehavior),
+					"duration": cdnDeliveryRuleStringValue(parameters.CacheDuration),
+				})
+
+			case cdnDeliveryRuleActionURLRedirect:
+				urlRedirectActions = append(urlRedirectActions, map[string]interface{}{
+					"redirect_type": cdnDeliveryRuleStringValue(parameters.RedirectType),
+					"protocol":      cdnDeliveryRuleStringValue(parameters.DestinationProtocol),
+					"hostname":      cdnDeliveryRuleStringValue(parameters.CustomHostname),
+					"path":          cdnDeliveryRuleStringValue(parameters.CustomPath),
+					"query_string":  cdnDeliveryRuleStringValue(parameters.CustomQueryString),
+					"fragment":      cdnDeliveryRuleStringValue(parameters.CustomFragment),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"order":                        int(rule.Order),
+			"url_path_condition":           urlPathConditions,
+			"url_file_extension_condition": urlFileExtensionConditions,
+			"cache_expiration_action":      cacheExpirationActions,
+			"url_redirect_action":          urlRedirectActions,
+		})
+	}
+
+	return results
+}
+
+func cdnDeliveryRuleStringValue(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	return *input
+}
+
+func validateCdnEndpointCacheDuration(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^(\d+\.)?\d{2}:\d{2}:\d{2}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be in the format `[d.]hh:mm:ss`", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,313 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCdnEndpointCustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCdnEndpointCustomDomainCreate,
+		Read:   resourceArmCdnEndpointCustomDomainRead,
+		Update: resourceArmCdnEndpointCustomDomainUpdate,
+		Delete: resourceArmCdnEndpointCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"profile_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"endpoint_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"host_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"cdn_managed_https_enabled": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"key_vault_certificate"},
+			},
+
+			"key_vault_certificate": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"cdn_managed_https_enabled"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_vault_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"secret_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"secret_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"https_provisioning_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmCdnEndpointCustomDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdnCustomDomainsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for CDN Endpoint Custom Domain creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	profileName := d.Get("profile_name").(string)
+	endpointName := d.Get("endpoint_name").(string)
+	hostName := d.Get("host_name").(string)
+
+	parameters := cdn.CustomDomainParameters{
+		CustomDomainPropertiesParameters: &cdn.CustomDomainPropertiesParameters{
+			HostName: utils.String(hostName),
+		},
+	}
+
+	future, err := client.Create(ctx, resourceGroup, profileName, endpointName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := fmt.Sprintf("%s/endpoints/%s/customDomains/%s", azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Cdn", "profiles", profileName), endpointName, name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation of CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q)", name, endpointName, profileName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	if certificates := d.Get("key_vault_certificate").([]interface{}); len(certificates) > 0 {
+		if err := enableArmCdnEndpointCustomDomainKeyVaultHTTPS(meta, d.Id(), certificates); err != nil {
+			return fmt.Errorf("Error enabling HTTPS using a Key Vault Certificate for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+		}
+	} else if d.Get("cdn_managed_https_enabled").(bool) {
+		if _, err := client.EnableCustomHTTPS(ctx, resourceGroup, profileName, endpointName, name); err != nil {
+			return fmt.Errorf("Error enabling HTTPS for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+		}
+	}
+
+	return resourceArmCdnEndpointCustomDomainRead(d, meta)
+}
+
+func resourceArmCdnEndpointCustomDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdnCustomDomainsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for CDN Endpoint Custom Domain update.")
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	profileName := id.Path["profiles"]
+	endpointName := id.Path["endpoints"]
+	name := id.Path["customDomains"]
+
+	if d.HasChange("cdn_managed_https_enabled") || d.HasChange("key_vault_certificate") {
+		if certificates := d.Get("key_vault_certificate").([]interface{}); len(certificates) > 0 {
+			if err := enableArmCdnEndpointCustomDomainKeyVaultHTTPS(meta, d.Id(), certificates); err != nil {
+				return fmt.Errorf("Error enabling HTTPS using a Key Vault Certificate for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+			}
+		} else if d.Get("cdn_managed_https_enabled").(bool) {
+			if _, err := client.EnableCustomHTTPS(ctx, resourceGroup, profileName, endpointName, name); err != nil {
+				return fmt.Errorf("Error enabling HTTPS for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+			}
+		} else {
+			if _, err := client.DisableCustomHTTPS(ctx, resourceGroup, profileName, endpointName, name); err != nil {
+				return fmt.Errorf("Error disabling HTTPS for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+			}
+		}
+	}
+
+	return resourceArmCdnEndpointCustomDomainRead(d, meta)
+}
+
+func resourceArmCdnEndpointCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdnClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	profileName := id.Path["profiles"]
+	endpointName := id.Path["endpoints"]
+	name := id.Path["customDomains"]
+
+	// the Custom Domain is retrieved using a newer API Version, since the SDK doesn't expose the Key Vault Certificate
+	var resp cdnCustomDomain
+	if r, err := client.Get(ctx, d.Id(), &resp); err != nil {
+		if utils.ResponseWasNotFound(r) {
+			log.Printf("[DEBUG] CDN Endpoint Custom Domain %q was not found in Endpoint %q / Profile %q / Resource Group %q - removing from state!", name, endpointName, profileName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("profile_name", profileName)
+	d.Set("endpoint_name", endpointName)
+
+	if props := resp.Properties; props != nil {
+		d.Set("host_name", props.HostName)
+
+		provisioningState := ""
+		if props.CustomHTTPSProvisioningState != nil {
+			provisioningState = *props.CustomHTTPSProvisioningState
+		}
+		d.Set("https_provisioning_state", provisioningState)
+
+		// HTTPS is considered enabled whilst the certificate is being provisioned, since this can take several hours
+		httpsEnabled := provisioningState == string(cdn.Enabled) || provisioningState == string(cdn.Enabling)
+
+		keyVaultCertificates := flattenArmCdnEndpointCustomDomainKeyVaultCertificate(props.CustomHTTPSParameters)
+		if err := d.Set("key_vault_certificate", keyVaultCertificates); err != nil {
+			return fmt.Errorf("Error flattening `key_vault_certificate`: %+v", err)
+		}
+
+		d.Set("cdn_managed_https_enabled", httpsEnabled && len(keyVaultCertificates) == 0)
+	}
+
+	return nil
+}
+
+func resourceArmCdnEndpointCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdnCustomDomainsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	profileName := id.Path["profiles"]
+	endpointName := id.Path["endpoints"]
+	name := id.Path["customDomains"]
+
+	future, err := client.Delete(ctx, resourceGroup, profileName, endpointName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func enableArmCdnEndpointCustomDomainKeyVaultHTTPS(meta interface{}, id string, input []interface{}) error {
+	client := meta.(*ArmClient).cdnClient
+	ctx := meta.(*ArmClient).StopContext
+
+	certificate := input[0].(map[string]interface{})
+	keyVaultId, err := parseAzureResourceID(certificate["key_vault_id"].(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := cdnCustomDomainHTTPSParams{
+		CertificateSource: cdnCertificateSourceAzureKeyVault,
+		ProtocolType:      "ServerNameIndication",
+		CertificateSourceParameters: &cdnKeyVaultCertificateSourceParameters{
+			OdataType:         "#Microsoft.Azure.Cdn.Models.KeyVaultCertificateSourceParameters",
+			SubscriptionID:    keyVaultId.SubscriptionID,
+			ResourceGroupName: keyVaultId.ResourceGroup,
+			VaultName:         keyVaultId.Path["vaults"],
+			SecretName:        certificate["secret_name"].(string),
+			SecretVersion:     certificate["secret_version"].(string),
+			UpdateRule:        "NoAction",
+			DeleteRule:        "NoAction",
+		},
+	}
+
+	_, err = client.Post(ctx, id, "enableCustomHttps", parameters, nil)
+	return err
+}
+
+func flattenArmCdnEndpointCustomDomainKeyVaultCertificate(input *cdnCustomDomainHTTPSParams) []interface{} {
+	if input == nil || input.CertificateSource != cdnCertificateSourceAzureKeyVault || input.CertificateSourceParameters == nil {
+		return []interface{}{}
+	}
+
+	parameters := input.CertificateSourceParameters
+	keyVaultId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s", parameters.SubscriptionID, parameters.ResourceGroupName, parameters.VaultName)
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_id":   keyVaultId,
+			"secret_name":    parameters.SecretName,
+			"secret_version": parameters.SecretVersion,
+		},
+	}
+}
@@ -0,0 +1,284 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMCdnEndpointCustomDomain_basic(t *testing.T) {
+	endpointEnvVariable := "ARM_TEST_CDN_ENDPOINT"
+	endpointEnv := os.Getenv(endpointEnvVariable)
+	if endpointEnv == "" {
+		t.Skipf("Skipping as %q is not specified", endpointEnvVariable)
+	}
+
+	domainEnvVariable := "ARM_TEST_CDN_DOMAIN"
+	domainEnv := os.Getenv(domainEnvVariable)
+	if domainEnv == "" {
+		t.Skipf("Skipping as %q is not specified", domainEnvVariable)
+	}
+
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMCdnEndpointCustomDomain_basic(ri, location, endpointEnv, domainEnv, false)
+	updatedConfig := testAccAzureRMCdnEndpointCustomDomain_basic(ri, location, endpointEnv, domainEnv, true)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "host_name", domainEnv),
+					resource.TestCheckResourceAttr(resourceName, "cdn_managed_https_enabled", "false"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cdn_managed_https_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCdnEndpointCustomDomain_keyVaultCertificate(t *testing.T) {
+	variables := make(map[string]string)
+	for _, name := range []string{"ARM_TEST_CDN_ENDPOINT", "ARM_TEST_CDN_DOMAIN", "ARM_TEST_CDN_KEY_VAULT_ID", "ARM_TEST_CDN_SECRET_NAME", "ARM_TEST_CDN_SECRET_VERSION"} {
+		value := os.Getenv(name)
+		if value == "" {
+			t.Skipf("Skipping as %q is not specified", name)
+		}
+		variables[name] = value
+	}
+
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMCdnEndpointCustomDomain_keyVaultCertificate(ri, location, variables["ARM_TEST_CDN_ENDPOINT"], variables["ARM_TEST_CDN_DOMAIN"], variables["ARM_TEST_CDN_KEY_VAULT_ID"], variables["ARM_TEST_CDN_SECRET_NAME"], variables["ARM_TEST_CDN_SECRET_VERSION"])
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cdn_managed_https_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_vault_certificate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "key_vault_certificate.0.secret_name", variables["ARM_TEST_CDN_SECRET_NAME"]),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFlattenArmCdnEndpointCustomDomainKeyVaultCertificate(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *cdnCustomDomainHTTPSParams
+		Expected []interface{}
+	}{
+		{
+			Name:     "not enabled",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "cdn managed",
+			Input: &cdnCustomDomainHTTPSParams{
+				CertificateSource: "Cdn",
+				ProtocolType:      "ServerNameIndication",
+			},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "key vault",
+			Input: &cdnCustomDomainHTTPSParams{
+				CertificateSource: cdnCertificateSourceAzureKeyVault,
+				ProtocolType:      "ServerNameIndication",
+				CertificateSourceParameters: &cdnKeyVaultCertificateSourceParameters{
+					SubscriptionID:    "00000000-0000-0000-0000-000000000000",
+					ResourceGroupName: "example",
+					VaultName:         "examplevault",
+					SecretName:        "certificate",
+					SecretVersion:     "abc123",
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"key_vault_id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.KeyVault/vaults/examplevault",
+					"secret_name":    "certificate",
+					"secret_version": "abc123",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := flattenArmCdnEndpointCustomDomainKeyVaultCertificate(tc.Input)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %+v for %q but got %+v", tc.Expected, tc.Name, actual)
+		}
+	}
+}
+
+func testCheckAzureRMCdnEndpointCustomDomainExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		profileName := rs.Primary.Attributes["profile_name"]
+		endpointName := rs.Primary.Attributes["endpoint_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).cdnCustomDomainsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q) does not exist", name, endpointName, profileName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on cdnCustomDomainsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCdnEndpointCustomDomainDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).cdnCustomDomainsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_cdn_endpoint_custom_domain" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		profileName := rs.Primary.Attributes["profile_name"]
+		endpointName := rs.Primary.Attributes["endpoint_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("CDN Endpoint Custom Domain still exists:\n%#v", resp.CustomDomainProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMCdnEndpointCustomDomain_basic(rInt int, location, endpointName, domain string, httpsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard_Verizon"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "%s"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  origin {
+    name       = "acceptanceTestCdnOrigin1"
+    host_name  = "www.example.com"
+    https_port = 443
+    http_port  = 80
+  }
+}
+
+resource "azurerm_cdn_endpoint_custom_domain" "test" {
+  name                      = "acctestcdndomain%d"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  profile_name              = "${azurerm_cdn_profile.test.name}"
+  endpoint_name             = "${azurerm_cdn_endpoint.test.name}"
+  host_name                 = "%s"
+  cdn_managed_https_enabled = %t
+}
+`, rInt, location, rInt, endpointName, rInt, domain, httpsEnabled)
+}
+
+func testAccAzureRMCdnEndpointCustomDomain_keyVaultCertificate(rInt int, location, endpointName, domain, keyVaultId, secretName, secretVersion string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard_Microsoft"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "%s"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  origin {
+    name       = "acceptanceTestCdnOrigin1"
+    host_name  = "www.example.com"
+    https_port = 443
+    http_port  = 80
+  }
+}
+
+resource "azurerm_cdn_endpoint_custom_domain" "test" {
+  name                = "acctestcdndomain%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  endpoint_name       = "${azurerm_cdn_endpoint.test.name}"
+  host_name           = "%s"
+
+  key_vault_certificate {
+    key_vault_id   = "%s"
+    secret_name    = "%s"
+    secret_version = "%s"
+  }
+}
+`, rInt, location, rInt, endpointName, rInt, domain, keyVaultId, secretName, secretVersion)
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -157,6 +158,75 @@ func TestAccAzureRMCdnEndpoint_withGeoFilters(t *testing.T) {
 		},
 	})
 }
+func TestAccAzureRMCdnEndpoint_deliveryRules(t *testing.T) {
+	resourceName := "azurerm_cdn_endpoint.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMCdnEndpoint_deliveryRules(ri, location)
+	updatedConfig := testAccAzureRMCdnEndpoint_deliveryRulesUpdated(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.0.url_path_condition.0.match_type", "Wildcard"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.0.cache_expiration_action.0.behavior", "Override"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.1.url_file_extension_condition.0.extensions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.1.cache_expiration_action.0.behavior", "BypassCache"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCdnEndpoint_urlRedirect(t *testing.T) {
+	resourceName := "azurerm_cdn_endpoint.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMCdnEndpoint_urlRedirect(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.0.cache_expiration_action.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.0.url_redirect_action.0.redirect_type", "PermanentRedirect"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.0.url_redirect_action.0.protocol", "Https"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.0.url_redirect_action.0.hostname", "www.example.org"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMCdnEndpoint_fullFields(t *testing.T) {
 	resourceName := "azurerm_cdn_endpoint.test"
 	ri := acctest.RandInt()
@@ -604,3 +674,272 @@ resource "azurerm_cdn_endpoint" "test" {
 }
 `, rInt, location, rInt, rInt, isHttpAllowed, isHttpsAllowed)
 }
+
+func testAccAzureRMCdnEndpoint_deliveryRules(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard_Microsoft"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "acctestcdnend%d"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  origin {
+    name       = "acceptanceTestCdnOrigin1"
+    host_name  = "www.example.com"
+    https_port = 443
+    http_port  = 80
+  }
+
+  delivery_rule {
+    order = 1
+
+    url_path_condition {
+      path       = "/images/*"
+      match_type = "Wildcard"
+    }
+
+    cache_expiration_action {
+      behavior = "Override"
+      duration = "1.00:00:00"
+    }
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMCdnEndpoint_deliveryRulesUpdated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard_Microsoft"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "acctestcdnend%d"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  origin {
+    name       = "acceptanceTestCdnOrigin1"
+    host_name  = "www.example.com"
+    https_port = 443
+    http_port  = 80
+  }
+
+  delivery_rule {
+    order = 1
+
+    url_path_condition {
+      path       = "/images/*"
+      match_type = "Wildcard"
+    }
+
+    cache_expiration_action {
+      behavior = "Override"
+      duration = "2.00:00:00"
+    }
+  }
+
+  delivery_rule {
+    order = 2
+
+    url_file_extension_condition {
+      extensions = ["html", "json"]
+    }
+
+    cache_expiration_action {
+      behavior = "BypassCache"
+    }
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func TestAzureRMCdnEndpointCacheDuration_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "01:00:00",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1.12:30:00",
+			ErrCount: 0,
+		},
+		{
+			Value:    "365.00:00:00",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1:00:00",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1 day",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateCdnEndpointCacheDuration(tc.Value, "duration")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Cache Duration %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func testAccAzureRMCdnEndpoint_urlRedirect(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard_Microsoft"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "acctestcdnend%d"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  origin {
+    name       = "acceptanceTestCdnOrigin1"
+    host_name  = "www.example.com"
+    https_port = 443
+    http_port  = 80
+  }
+
+  delivery_rule {
+    order = 1
+
+    url_path_condition {
+      path       = "/old"
+      match_type = "Literal"
+    }
+
+    url_redirect_action {
+      redirect_type = "PermanentRedirect"
+      protocol      = "Https"
+      hostname      = "www.example.org"
+      path          = "/new"
+    }
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func TestExpandArmCdnEndpointDeliveryPolicy(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"order": 1,
+			"url_path_condition": []interface{}{
+				map[string]interface{}{
+					"path":       "/old",
+					"match_type": "Literal",
+				},
+			},
+			"url_file_extension_condition": []interface{}{},
+			"cache_expiration_action":      []interface{}{},
+			"url_redirect_action": []interface{}{
+				map[string]interface{}{
+					"redirect_type": "Moved",
+					"protocol":      "Https",
+					"hostname":      "www.example.org",
+					"path":          "/new",
+					"query_string":  "",
+					"fragment":      "",
+				},
+			},
+		},
+		map[string]interface{}{
+			"order":              2,
+			"url_path_condition": []interface{}{},
+			"url_file_extension_condition": []interface{}{
+				map[string]interface{}{
+					"extensions": []interface{}{"html", "json"},
+				},
+			},
+			"cache_expiration_action": []interface{}{
+				map[string]interface{}{
+					"behavior": "Override",
+					"duration": "1.00:00:00",
+				},
+			},
+			"url_redirect_action": []interface{}{},
+		},
+	}
+
+	policy, err := expandArmCdnEndpointDeliveryPolicy(input)
+	if err != nil {
+		t.Fatalf("Error expanding the Delivery Policy: %+v", err)
+	}
+
+	if len(policy.Rules) != 2 {
+		t.Fatalf("Expected 2 Rules but got %d", len(policy.Rules))
+	}
+
+	redirect := policy.Rules[0]
+	if redirect.Conditions[0].Name != cdnDeliveryRuleConditionURLPath || redirect.Conditions[0].Parameters.Operator != "Equal" {
+		t.Fatalf("Expected a Literal Url Path Condition to use the `Equal` operator but got %+v", redirect.Conditions[0])
+	}
+	if redirect.Actions[0].Name != cdnDeliveryRuleActionURLRedirect || *redirect.Actions[0].Parameters.CustomHostname != "www.example.org" {
+		t.Fatalf("Expected a Url Redirect Action but got %+v", redirect.Actions[0])
+	}
+	if redirect.Actions[0].Parameters.CustomQueryString != nil {
+		t.Fatalf("Expected the empty Query String to be omitted")
+	}
+
+	// the rules should round-trip back to the same configuration
+	actual := flattenArmCdnEndpointDeliveryPolicy(policy)
+	if !reflect.DeepEqual(actual, input) {
+		t.Fatalf("Expected %+v but got %+v", input, actual)
+	}
+}
+
+func TestExpandArmCdnEndpointDeliveryPolicy_noActions(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"order":                        1,
+			"url_path_condition":           []interface{}{},
+			"url_file_extension_condition": []interface{}{},
+			"cache_expiration_action":      []interface{}{},
+			"url_redirect_action":          []interface{}{},
+		},
+	}
+
+	if _, err := expandArmCdnEndpointDeliveryPolicy(input); err == nil {
+		t.Fatalf("Expected an error when a Delivery Rule has no actions")
+	}
+}
@@ -66,7 +66,7 @@ func resourceArmCdnProfileCreate(d *schema.ResourceData, meta interface{}) error
 
 	cdnProfile := cdn.Profile{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		Sku: &cdn.Sku{
 			Name: cdn.SkuName(sku),
 		},
@@ -77,7 +77,9 @@ func resourceArmCdnProfileCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Cdn", "profiles", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return err
 	}
@@ -99,7 +101,7 @@ func resourceArmCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error
 	client := meta.(*ArmClient).cdnProfilesClient
 	ctx := meta.(*ArmClient).StopContext
 
-	if !d.HasChange("tags") {
+	if !d.HasChange("tags") && !d.HasChange("tags_all") {
 		return nil
 	}
 
@@ -108,7 +110,7 @@ func resourceArmCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error
 	newTags := d.Get("tags").(map[string]interface{})
 
 	props := cdn.ProfileUpdateParameters{
-		Tags: expandTags(newTags),
+		Tags: expandTags(newTags, meta),
 	}
 
 	future, err := client.Update(ctx, resourceGroup, name, props)
@@ -116,7 +118,7 @@ func resourceArmCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error issuing update request for CDN Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for the update of CDN Profile %q (Resource Group %q) to commplete: %+v", name, resourceGroup, err)
 	}
@@ -154,7 +156,7 @@ func resourceArmCdnProfileRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("sku", string(sku.Name))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -178,7 +180,7 @@ func resourceArmCdnProfileDelete(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error issuing delete request for CDN Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		if response.WasNotFound(future.Response()) {
 			return nil
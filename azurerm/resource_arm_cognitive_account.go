@@ -0,0 +1,292 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/mgmt/2017-04-18/cognitiveservices"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCognitiveAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCognitiveAccountCreate,
+		Read:   resourceArmCognitiveAccountRead,
+		Update: resourceArmCognitiveAccountUpdate,
+		Delete: resourceArmCognitiveAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitiveAccountName,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"kind": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cognitiveservices.BingAutosuggestv7),
+					string(cognitiveservices.BingCustomSearch),
+					string(cognitiveservices.BingSearchv7),
+					string(cognitiveservices.BingSpeech),
+					string(cognitiveservices.BingSpellCheckv7),
+					string(cognitiveservices.ComputerVision),
+					string(cognitiveservices.ContentModerator),
+					string(cognitiveservices.CustomSpeech),
+					string(cognitiveservices.CustomVisionPrediction),
+					string(cognitiveservices.CustomVisionTraining),
+					string(cognitiveservices.Emotion),
+					string(cognitiveservices.Face),
+					string(cognitiveservices.LUIS),
+					string(cognitiveservices.QnAMaker),
+					string(cognitiveservices.SpeakerRecognition),
+					string(cognitiveservices.SpeechTranslation),
+					string(cognitiveservices.TextAnalytics),
+					string(cognitiveservices.TextTranslation),
+					string(cognitiveservices.WebLM),
+				}, false),
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cognitiveservices.F0),
+								string(cognitiveservices.P0),
+								string(cognitiveservices.P1),
+								string(cognitiveservices.P2),
+								string(cognitiveservices.S0),
+								string(cognitiveservices.S1),
+								string(cognitiveservices.S2),
+								string(cognitiveservices.S3),
+								string(cognitiveservices.S4),
+								string(cognitiveservices.S5),
+								string(cognitiveservices.S6),
+							}, false),
+						},
+
+						"tier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cognitiveservices.Free),
+								string(cognitiveservices.Standard),
+								string(cognitiveservices.Premium),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cognitiveAccountsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Cognitive Services Account creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	kind := d.Get("kind").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := cognitiveservices.AccountCreateParameters{
+		Kind:     cognitiveservices.Kind(kind),
+		Location: utils.String(location),
+		Sku:      expandCognitiveAccountSku(d.Get("sku").([]interface{})),
+		// the API requires this to be an empty object
+		Properties: map[string]interface{}{},
+		Tags:       expandTags(tags, meta),
+	}
+
+	if _, err := client.Create(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.GetProperties(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Cognitive Services Account %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmCognitiveAccountRead(d, meta)
+}
+
+func resourceArmCognitiveAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cognitiveAccountsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["accounts"]
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := cognitiveservices.AccountUpdateParameters{
+		Sku:  expandCognitiveAccountSku(d.Get("sku").([]interface{})),
+		Tags: expandTags(tags, meta),
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error updating Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmCognitiveAccountRead(d, meta)
+}
+
+func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cognitiveAccountsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["accounts"]
+
+	resp, err := client.GetProperties(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Cognitive Services Account %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("kind", resp.Kind)
+
+	if err := d.Set("sku", flattenCognitiveAccountSku(resp.Sku)); err != nil {
+		return fmt.Errorf("Error setting `sku`: %+v", err)
+	}
+
+	if props := resp.AccountProperties; props != nil {
+		d.Set("endpoint", props.Endpoint)
+	}
+
+	keysResp, err := client.ListKeys(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error listing keys for Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("primary_access_key", keysResp.Key1)
+	d.Set("secondary_access_key", keysResp.Key2)
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmCognitiveAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cognitiveAccountsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["accounts"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandCognitiveAccountSku(input []interface{}) *cognitiveservices.Sku {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &cognitiveservices.Sku{
+		Name: cognitiveservices.SkuName(v["name"].(string)),
+		Tier: cognitiveservices.SkuTier(v["tier"].(string)),
+	}
+}
+
+func flattenCognitiveAccountSku(input *cognitiveservices.Sku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name": string(input.Name),
+			"tier": string(input.Tier),
+		},
+	}
+}
+
+func validateCognitiveAccountName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{1,62}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 2 and 64 characters in length, begin with a letter or number and can only contain letters, numbers and hyphens", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,232 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAzureRMCognitiveAccountName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "hello",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello-world-123",
+			ErrCount: 0,
+		},
+		{
+			Value:    "a",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-hello",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello_world",
+			ErrCount: 1,
+		},
+		{
+			Value:    acctest.RandString(65),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateCognitiveAccountName(tc.Value, "azurerm_cognitive_account")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Cognitive Account Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestAccAzureRMCognitiveAccount_basic(t *testing.T) {
+	resourceName := "azurerm_cognitive_account.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMCognitiveAccount_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCognitiveAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCognitiveAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kind", "Face"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_access_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCognitiveAccount_complete(t *testing.T) {
+	resourceName := "azurerm_cognitive_account.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMCognitiveAccount_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCognitiveAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCognitiveAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kind", "Face"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Acceptance", "Test"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_access_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCognitiveAccount_update(t *testing.T) {
+	resourceName := "azurerm_cognitive_account.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCognitiveAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCognitiveAccount_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCognitiveAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "S0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMCognitiveAccount_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCognitiveAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "S0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMCognitiveAccountExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).cognitiveAccountsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetProperties(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Cognitive Services Account %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on cognitiveAccountsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCognitiveAccountDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).cognitiveAccountsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_cognitive_account" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.GetProperties(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Cognitive Services Account still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMCognitiveAccount_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cognitive_account" "test" {
+  name                = "acctestcogacc-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  kind                = "Face"
+
+  sku {
+    name = "S0"
+    tier = "Standard"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMCognitiveAccount_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cognitive_account" "test" {
+  name                = "acctestcogacc-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  kind                = "Face"
+
+  sku {
+    name = "S0"
+    tier = "Standard"
+  }
+
+  tags {
+    Acceptance = "Test"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,618 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/consumption/mgmt/2018-03-31/consumption"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmConsumptionBudget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmConsumptionBudgetCreateUpdate,
+		Read:   resourceArmConsumptionBudgetRead,
+		Update: resourceArmConsumptionBudgetCreateUpdate,
+		Delete: resourceArmConsumptionBudgetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// when omitted the Budget is created at the Subscription scope
+			"resource_group_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: resourceAzurermResourceGroupNameDiffSuppress,
+				ValidateFunc:     validateArmResourceGroupName,
+			},
+
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(consumption.Cost),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(consumption.Cost),
+					string(consumption.Usage),
+				}, false),
+			},
+
+			"amount": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"time_grain": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(consumption.Monthly),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(consumption.Monthly),
+					string(consumption.Quarterly),
+					string(consumption.Annually),
+				}, false),
+			},
+
+			"time_period": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_date": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validate.RFC3339Time,
+						},
+						"end_date": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validate.RFC3339Time,
+						},
+					},
+				},
+			},
+
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_groups": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"resources": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+						"meters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.UUID,
+							},
+						},
+						"tag": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"notification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(consumption.EqualTo),
+								string(consumption.GreaterThan),
+								string(consumption.GreaterThanOrEqualTo),
+							}, false),
+						},
+						"threshold": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+						"contact_emails": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"contact_roles": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"contact_groups": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+					},
+				},
+			},
+
+			"current_spend": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmConsumptionBudgetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).consumptionBudgetsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Consumption Budget creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	category := d.Get("category").(string)
+
+	timePeriod, err := expandArmConsumptionBudgetTimePeriod(d.Get("time_period").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	filters := expandArmConsumptionBudgetFilters(d.Get("filter").([]interface{}))
+	if category == string(consumption.Usage) && (filters == nil || filters.Meters == nil || len(*filters.Meters) == 0) {
+		return fmt.Errorf("At least one `meters` filter must be specified when `category` is set to `Usage`")
+	}
+
+	if resourceGroup != "" && filters != nil && filters.ResourceGroups != nil && len(*filters.ResourceGroups) > 0 {
+		return fmt.Errorf("The `resource_groups` filter can only be used for Budgets at the Subscription scope")
+	}
+
+	parameters := consumption.Budget{
+		BudgetProperties: &consumption.BudgetProperties{
+			Category:      consumption.CategoryType(category),
+			Amount:        decimalPtr(decimal.NewFromFloat(d.Get("amount").(float64))),
+			TimeGrain:     consumption.TimeGrainType(d.Get("time_grain").(string)),
+			TimePeriod:    timePeriod,
+			Filters:       filters,
+			Notifications: expandArmConsumptionBudgetNotifications(d.Get("notification").([]interface{})),
+		},
+	}
+
+	// the eTag of the existing Budget must be provided when updating it
+	if !d.IsNewResource() {
+		existing, err := getArmConsumptionBudget(ctx, client, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Consumption Budget %q: %+v", name, err)
+		}
+		parameters.ETag = existing.ETag
+	}
+
+	if resourceGroup != "" {
+		if _, err := client.CreateOrUpdateByResourceGroupName(ctx, resourceGroup, name, parameters); err != nil {
+			return fmt.Errorf("Error creating/updating Consumption Budget %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	} else {
+		if _, err := client.CreateOrUpdate(ctx, name, parameters); err != nil {
+			return fmt.Errorf("Error creating/updating Consumption Budget %q: %+v", name, err)
+		}
+	}
+
+	read, err := getArmConsumptionBudget(ctx, client, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Consumption Budget %q: %+v", name, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Consumption Budget %q", name)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmConsumptionBudgetRead(d, meta)
+}
+
+func resourceArmConsumptionBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).consumptionBudgetsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup, name, err := parseArmConsumptionBudgetId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := getArmConsumptionBudget(ctx, client, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Consumption Budget %q was not found - removing from state!", name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Consumption Budget %q: %+v", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.BudgetProperties; props != nil {
+		d.Set("category", string(props.Category))
+		d.Set("time_grain", string(props.TimeGrain))
+
+		if amount := props.Amount; amount != nil {
+			value, _ := amount.Float64()
+			d.Set("amount", value)
+		}
+
+		if currentSpend := props.CurrentSpend; currentSpend != nil && currentSpend.Amount != nil {
+			value, _ := currentSpend.Amount.Float64()
+			d.Set("current_spend", value)
+		}
+
+		if err := d.Set("time_period", flattenArmConsumptionBudgetTimePeriod(props.TimePeriod)); err != nil {
+			return fmt.Errorf("Error setting `time_period`: %+v", err)
+		}
+
+		if err := d.Set("filter", flattenArmConsumptionBudgetFilters(props.Filters)); err != nil {
+			return fmt.Errorf("Error setting `filter`: %+v", err)
+		}
+
+		if err := d.Set("notification", flattenArmConsumptionBudgetNotifications(props.Notifications)); err != nil {
+			return fmt.Errorf("Error setting `notification`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmConsumptionBudgetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).consumptionBudgetsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup, name, err := parseArmConsumptionBudgetId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resourceGroup != "" {
+		resp, err := client.DeleteByResourceGroupName(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Error deleting Consumption Budget %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		return nil
+	}
+
+	resp, err := client.Delete(ctx, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Consumption Budget %q: %+v", name, err)
+		}
+	}
+
+	return nil
+}
+
+func getArmConsumptionBudget(ctx context.Context, client consumption.BudgetsClient, resourceGroup, name string) (consumption.Budget, error) {
+	if resourceGroup != "" {
+		return client.GetByResourceGroupName(ctx, resourceGroup, name)
+	}
+
+	return client.Get(ctx, name)
+}
+
+// Budgets can exist at either the Subscription or Resource Group scope, so the ID
+// doesn't necessarily contain a Resource Group which parseAzureResourceID requires
+func parseArmConsumptionBudgetId(input string) (string, string, error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	if len(segments)%2 != 0 {
+		return "", "", fmt.Errorf("The number of path segments is not divisible by 2 in %q", input)
+	}
+
+	var resourceGroup, name string
+	for i := 0; i < len(segments); i += 2 {
+		switch strings.ToLower(segments[i]) {
+		case "resourcegroups":
+			resourceGroup = segments[i+1]
+		case "budgets":
+			name = segments[i+1]
+		}
+	}
+
+	if name == "" {
+		return "", "", fmt.Errorf("Unable to parse the Budget name from %q", input)
+	}
+
+	return resourceGroup, name, nil
+}
+
+func expandArmConsumptionBudgetTimePeriod(input []interface{}) (*consumption.BudgetTimePeriod, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	startDate, err := time.Parse(time.RFC3339, v["start_date"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing `start_date`: %+v", err)
+	}
+
+	timePeriod := consumption.BudgetTimePeriod{
+		StartDate: &date.Time{Time: startDate},
+	}
+
+	if endDateRaw := v["end_date"].(string); endDateRaw != "" {
+		endDate, err := time.Parse(time.RFC3339, endDateRaw)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `end_date`: %+v", err)
+		}
+		timePeriod.EndDate = &date.Time{Time: endDate}
+	}
+
+	return &timePeriod, nil
+}
+
+func flattenArmConsumptionBudgetTimePeriod(input *consumption.BudgetTimePeriod) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	if input.StartDate != nil {
+		output["start_date"] = input.StartDate.Format(time.RFC3339)
+	}
+	if input.EndDate != nil {
+		output["end_date"] = input.EndDate.Format(time.RFC3339)
+	}
+
+	return []interface{}{output}
+}
+
+func expandArmConsumptionBudgetFilters(input []interface{}) *consumption.Filters {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	filters := consumption.Filters{}
+
+	if resourceGroups := expandArmConsumptionBudgetStrings(v["resource_groups"].([]interface{})); len(resourceGroups) > 0 {
+		filters.ResourceGroups = &resourceGroups
+	}
+
+	if resources := expandArmConsumptionBudgetStrings(v["resources"].([]interface{})); len(resources) > 0 {
+		filters.Resources = &resources
+	}
+
+	meters := make([]uuid.UUID, 0)
+	for _, raw := range v["meters"].([]interface{}) {
+		meters = append(meters, uuid.FromStringOrNil(raw.(string)))
+	}
+	if len(meters) > 0 {
+		filters.Meters = &meters
+	}
+
+	tags := make(map[string][]string)
+	for _, raw := range v["tag"].([]interface{}) {
+		tag := raw.(map[string]interface{})
+		tags[tag["name"].(string)] = expandArmConsumptionBudgetStrings(tag["values"].([]interface{}))
+	}
+	if len(tags) > 0 {
+		filters.Tags = tags
+	}
+
+	return &filters
+}
+
+func flattenArmConsumptionBudgetFilters(input *consumption.Filters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	resourceGroups := make([]interface{}, 0)
+	if input.ResourceGroups != nil {
+		for _, v := range *input.ResourceGroups {
+			resourceGroups = append(resourceGroups, v)
+		}
+	}
+
+	resources := make([]interface{}, 0)
+	if input.Resources != nil {
+		for _, v := range *input.Resources {
+			resources = append(resources, v)
+		}
+	}
+
+	meters := make([]interface{}, 0)
+	if input.Meters != nil {
+		for _, v := range *input.Meters {
+			meters = append(meters, v.String())
+		}
+	}
+
+	tags := make([]interface{}, 0)
+	for name, values := range input.Tags {
+		tagValues := make([]interface{}, 0)
+		for _, v := range values {
+			tagValues = append(tagValues, v)
+		}
+
+		tags = append(tags, map[string]interface{}{
+			"name":   name,
+			"values": tagValues,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"resource_groups": resourceGroups,
+			"resources":       resources,
+			"meters":          meters,
+			"tag":             tags,
+		},
+	}
+}
+
+func expandArmConsumptionBudgetNotifications(input []interface{}) map[string]*consumption.Notification {
+	notifications := make(map[string]*consumption.Notification)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		operator := v["operator"].(string)
+		threshold := v["threshold"].(int)
+		contactEmails := expandArmConsumptionBudgetStrings(v["contact_emails"].([]interface{}))
+		contactRoles := expandArmConsumptionBudgetStrings(v["contact_roles"].([]interface{}))
+		contactGroups := expandArmConsumptionBudgetStrings(v["contact_groups"].([]interface{}))
+
+		// the API requires each Notification to have a unique key, which isn't otherwise exposed
+		key := fmt.Sprintf("actual_%s_%d_Percent", operator, threshold)
+		notifications[key] = &consumption.Notification{
+			Enabled:       utils.Bool(v["enabled"].(bool)),
+			Operator:      consumption.OperatorType(operator),
+			Threshold:     decimalPtr(decimal.NewFromFloat(float64(threshold))),
+			ContactEmails: &contactEmails,
+			ContactRoles:  &contactRoles,
+			ContactGroups: &contactGroups,
+		}
+	}
+
+	return notifications
+}
+
+func flattenArmConsumptionBudgetNotifications(input map[string]*consumption.Notification) []interface{} {
+	results := make([]interface{}, 0)
+
+	// sort the keys so that the order of the Notifications is deterministic
+	keys := make([]string, 0)
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		notification := input[key]
+		if notification == nil {
+			continue
+		}
+
+		output := map[string]interface{}{
+			"operator":       string(notification.Operator),
+			"contact_emails": flattenArmConsumptionBudgetStrings(notification.ContactEmails),
+			"contact_roles":  flattenArmConsumptionBudgetStrings(notification.ContactRoles),
+			"contact_groups": flattenArmConsumptionBudgetStrings(notification.ContactGroups),
+		}
+
+		if notification.Enabled != nil {
+			output["enabled"] = *notification.Enabled
+		}
+
+		if notification.Threshold != nil {
+			output["threshold"] = int(notification.Threshold.IntPart())
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandArmConsumptionBudgetStrings(input []interface{}) []string {
+	results := make([]string, 0)
+	for _, v := range input {
+		results = append(results, v.(string))
+	}
+	return results
+}
+
+func flattenArmConsumptionBudgetStrings(input *[]string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, v)
+	}
+	return results
+}
+
+func decimalPtr(input decimal.Decimal) *decimal.Decimal {
+	return &input
+}
@@ -0,0 +1,256 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMConsumptionBudget_subscription(t *testing.T) {
+	resourceName := "azurerm_consumption_budget.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMConsumptionBudget_subscription(ri, testAccAzureRMConsumptionBudgetStartDate())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMConsumptionBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMConsumptionBudgetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "category", "Cost"),
+					resource.TestCheckResourceAttr(resourceName, "time_grain", "Monthly"),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMConsumptionBudget_resourceGroup(t *testing.T) {
+	resourceName := "azurerm_consumption_budget.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	startDate := testAccAzureRMConsumptionBudgetStartDate()
+	config := testAccAzureRMConsumptionBudget_resourceGroup(ri, location, startDate, 1000)
+	updatedConfig := testAccAzureRMConsumptionBudget_resourceGroup(ri, location, startDate, 2000)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMConsumptionBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMConsumptionBudgetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "amount", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tag.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "2"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMConsumptionBudgetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "amount", "2000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAzureRMConsumptionBudgetId_parse(t *testing.T) {
+	cases := []struct {
+		Input         string
+		ResourceGroup string
+		Name          string
+		Error         bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Consumption/budgets/budget1",
+			Name:  "budget1",
+		},
+		{
+			Input:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Consumption/budgets/budget1",
+			ResourceGroup: "group1",
+			Name:          "budget1",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Consumption/budgets",
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		resourceGroup, name, err := parseArmConsumptionBudgetId(tc.Input)
+		if err != nil {
+			if !tc.Error {
+				t.Fatalf("Expected no error for %q but got: %+v", tc.Input, err)
+			}
+			continue
+		}
+
+		if tc.Error {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if resourceGroup != tc.ResourceGroup {
+			t.Fatalf("Expected the Resource Group for %q to be %q but got %q", tc.Input, tc.ResourceGroup, resourceGroup)
+		}
+
+		if name != tc.Name {
+			t.Fatalf("Expected the Name for %q to be %q but got %q", tc.Input, tc.Name, name)
+		}
+	}
+}
+
+func testCheckAzureRMConsumptionBudgetExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup, name, err := parseArmConsumptionBudgetId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).consumptionBudgetsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := getArmConsumptionBudget(ctx, client, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Consumption Budget %q does not exist", name)
+			}
+
+			return fmt.Errorf("Bad: Get on consumptionBudgetsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMConsumptionBudgetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).consumptionBudgetsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_consumption_budget" {
+			continue
+		}
+
+		resourceGroup, name, err := parseArmConsumptionBudgetId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := getArmConsumptionBudget(ctx, client, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Consumption Budget still exists:\n%#v", resp.BudgetProperties)
+	}
+
+	return nil
+}
+
+// Budgets must start on the first day of a month which is no more than three months in the future
+func testAccAzureRMConsumptionBudgetStartDate() string {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).Format(time.RFC3339)
+}
+
+func testAccAzureRMConsumptionBudget_subscription(rInt int, startDate string) string {
+	return fmt.Sprintf(`
+resource "azurerm_consumption_budget" "test" {
+  name       = "acctestbudget-%d"
+  amount     = 1000
+  time_grain = "Monthly"
+
+  time_period {
+    start_date = "%s"
+  }
+
+  notification {
+    operator       = "GreaterThan"
+    threshold      = 90
+    contact_emails = ["foo@example.com"]
+  }
+}
+`, rInt, startDate)
+}
+
+func testAccAzureRMConsumptionBudget_resourceGroup(rInt int, location, startDate string, amount int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+}
+
+resource "azurerm_consumption_budget" "test" {
+  name                = "acctestbudget-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  amount              = %d
+  time_grain          = "Monthly"
+
+  time_period {
+    start_date = "%s"
+  }
+
+  filter {
+    tag {
+      name   = "environment"
+      values = ["Production", "Staging"]
+    }
+  }
+
+  notification {
+    operator       = "EqualTo"
+    threshold      = 50
+    contact_groups = ["${azurerm_monitor_action_group.test.id}"]
+  }
+
+  notification {
+    enabled        = false
+    operator       = "GreaterThan"
+    threshold      = 100
+    contact_emails = ["foo@example.com", "bar@example.com"]
+    contact_roles  = ["Owner"]
+  }
+}
+`, rInt, location, rInt, rInt, amount, startDate)
+}
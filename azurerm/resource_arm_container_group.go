@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2018-04-01/containerinstance"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -115,6 +116,51 @@ func resourceArmContainerGroup() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"diagnostics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_analytics": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"workspace_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validate.UUID,
+									},
+
+									"workspace_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"log_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"ContainerInsights",
+											"ContainerInstanceLogs",
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"container": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -212,24 +258,71 @@ func resourceArmContainerGroup() *schema.Resource {
 
 									"share_name": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
 										ForceNew: true,
 									},
 
 									"storage_account_name": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
 										ForceNew: true,
 									},
 
 									"storage_account_key": {
+										Type:      schema.TypeString,
+										Optional:  true,
+										ForceNew:  true,
+										Sensitive: true,
+									},
+
+									"empty_dir": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+
+									"secret": {
+										Type:      schema.TypeMap,
+										Optional:  true,
+										ForceNew:  true,
+										Sensitive: true,
+									},
+								},
+							},
+						},
+
+						"gpu": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 4),
+									},
+
+									"sku": {
 										Type:     schema.TypeString,
 										Required: true,
 										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"K80",
+											"P100",
+											"V100",
+										}, false),
 									},
 								},
 							},
 						},
+
+						"liveness_probe": containerProbeSchema(),
+
+						"readiness_probe": containerProbeSchema(),
 					},
 				},
 			},
@@ -237,6 +330,96 @@ func resourceArmContainerGroup() *schema.Resource {
 	}
 }
 
+func containerProbeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"exec": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.NoZeroValues,
+					},
+				},
+
+				"http_get": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"path": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+
+							"port": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(1, 65535),
+							},
+
+							"scheme": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Http",
+									"Https",
+								}, false),
+							},
+						},
+					},
+				},
+
+				"initial_delay_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"period_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"failure_threshold": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"success_threshold": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"timeout_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
 func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	ctx := meta.(*ArmClient).StopContext
 	containerGroupsClient := meta.(*ArmClient).containerGroupsClient
@@ -249,11 +432,15 @@ func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) e
 	tags := d.Get("tags").(map[string]interface{})
 	restartPolicy := d.Get("restart_policy").(string)
 
-	containers, containerGroupPorts, containerGroupVolumes := expandContainerGroupContainers(d)
+	containers, containerGroupPorts, containerGroupVolumes, err := expandContainerGroupContainers(d)
+	if err != nil {
+		return err
+	}
+
 	containerGroup := containerinstance.ContainerGroup{
 		Name:     &name,
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		ContainerGroupProperties: &containerinstance.ContainerGroupProperties{
 			Containers:    containers,
 			RestartPolicy: containerinstance.ContainerGroupRestartPolicy(restartPolicy),
@@ -271,11 +458,25 @@ func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) e
 		containerGroup.ContainerGroupProperties.IPAddress.DNSNameLabel = &dnsNameLabel
 	}
 
-	_, err := containerGroupsClient.CreateOrUpdate(ctx, resGroup, name, containerGroup)
+	// the Probes, GPU's and Diagnostics are only available in a newer API Version, so the Container Group
+	// is submitted using the `resourcemanager` client
+	payload, err := mergeContainerGroupExtensions(containerGroup, expandContainerGroupExtensions(d))
 	if err != nil {
 		return err
 	}
 
+	client := meta.(*ArmClient).containerInstanceClient
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	id := azureRMResourceID(subscriptionId, resGroup, "Microsoft.ContainerInstance", "containerGroups", name)
+	future, err := client.CreateOrUpdate(ctx, id, payload)
+	if err != nil {
+		return fmt.Errorf("Error creating Container Group %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation of Container Group %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
 	read, err := containerGroupsClient.Get(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -313,14 +514,26 @@ func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	// the Probes, GPU's and Diagnostics are only returned from a newer API Version
+	var extensions containerGroupExtensions
+	if _, err := meta.(*ArmClient).containerInstanceClient.Get(ctx, d.Id(), &extensions); err != nil {
+		return fmt.Errorf("Error retrieving Container Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
 	d.Set("name", name)
 	d.Set("resource_group_name", resourceGroup)
 	if location := resp.Location; location != nil {
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
 
+	diagnostics := flattenContainerGroupDiagnostics(d, extensions.Properties)
+	if err := d.Set("diagnostics", diagnostics); err != nil {
+		return fmt.Errorf("Error setting `diagnostics`: %+v", err)
+	}
+
 	if props := resp.ContainerGroupProperties; props != nil {
 		containerConfigs := flattenContainerGroupContainers(d, resp.Containers, props.IPAddress.Ports, props.Volumes)
+		flattenContainerGroupContainerExtensions(containerConfigs, extensions.Properties)
 		if err := d.Set("container", containerConfigs); err != nil {
 			return fmt.Errorf("Error setting `container`: %+v", err)
 		}
@@ -339,7 +552,7 @@ func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("restart_policy", string(props.RestartPolicy))
 		d.Set("os_type", string(props.OsType))
 	}
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -491,6 +704,8 @@ func flattenContainerVolumes(volumeMounts *[]containerinstance.VolumeMount, cont
 						}
 						// skip storage_account_key, is always nil
 					}
+
+					volumeConfig["empty_dir"] = cgv.EmptyDir != nil
 				}
 			}
 		}
@@ -504,6 +719,9 @@ func flattenContainerVolumes(volumeMounts *[]containerinstance.VolumeMount, cont
 				if vm.Name != nil && *vm.Name == rawName {
 					storageAccountKey := cv["storage_account_key"].(string)
 					volumeConfig["storage_account_key"] = storageAccountKey
+
+					// the values of Secret Volumes aren't returned from the API
+					volumeConfig["secret"] = cv["secret"]
 				}
 			}
 		}
@@ -514,7 +732,7 @@ func flattenContainerVolumes(volumeMounts *[]containerinstance.VolumeMount, cont
 	return volumeConfigs
 }
 
-func expandContainerGroupContainers(d *schema.ResourceData) (*[]containerinstance.Container, *[]containerinstance.Port, *[]containerinstance.Volume) {
+func expandContainerGroupContainers(d *schema.ResourceData) (*[]containerinstance.Container, *[]containerinstance.Port, *[]containerinstance.Volume, error) {
 	containersConfig := d.Get("container").([]interface{})
 	containers := make([]containerinstance.Container, 0)
 	containerGroupPorts := make([]containerinstance.Port, 0)
@@ -586,7 +804,11 @@ func expandContainerGroupContainers(d *schema.ResourceData) (*[]containerinstanc
 		}
 
 		if v, ok := data["volume"]; ok {
-			volumeMounts, containerGroupVolumesPartial := expandContainerVolumes(v)
+			volumeMounts, containerGroupVolumesPartial, err := expandContainerVolumes(v)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
 			container.VolumeMounts = volumeMounts
 			if containerGroupVolumesPartial != nil {
 				containerGroupVolumes = append(containerGroupVolumes, *containerGroupVolumesPartial...)
@@ -596,7 +818,7 @@ func expandContainerGroupContainers(d *schema.ResourceData) (*[]containerinstanc
 		containers = append(containers, container)
 	}
 
-	return &containers, &containerGroupPorts, &containerGroupVolumes
+	return &containers, &containerGroupPorts, &containerGroupVolumes, nil
 }
 
 func expandContainerEnvironmentVariables(input interface{}) *[]containerinstance.EnvironmentVariable {
@@ -666,11 +888,11 @@ func flattenContainerImageRegistryCredentials(d *schema.ResourceData, input *[]c
 	return output
 }
 
-func expandContainerVolumes(input interface{}) (*[]containerinstance.VolumeMount, *[]containerinstance.Volume) {
+func expandContainerVolumes(input interface{}) (*[]containerinstance.VolumeMount, *[]containerinstance.Volume, error) {
 	volumesRaw := input.([]interface{})
 
 	if len(volumesRaw) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	volumeMounts := make([]containerinstance.VolumeMount, 0)
@@ -685,6 +907,8 @@ func expandContainerVolumes(input interface{}) (*[]containerinstance.VolumeMount
 		shareName := volumeConfig["share_name"].(string)
 		storageAccountName := volumeConfig["storage_account_name"].(string)
 		storageAccountKey := volumeConfig["storage_account_key"].(string)
+		emptyDir := volumeConfig["empty_dir"].(bool)
+		secret := expandContainerVolumeSecret(volumeConfig["secret"].(map[string]interface{}))
 
 		vm := containerinstance.VolumeMount{
 			Name:      utils.String(name),
@@ -696,16 +920,275 @@ func expandContainerVolumes(input interface{}) (*[]containerinstance.VolumeMount
 
 		cv := containerinstance.Volume{
 			Name: utils.String(name),
-			AzureFile: &containerinstance.AzureFileVolume{
+		}
+
+		// a volume is backed by exactly one of an Azure File Share, an empty directory or a secret
+		isAzureFile := shareName != "" || storageAccountName != "" || storageAccountKey != ""
+		switch {
+		case isAzureFile && !emptyDir && secret == nil:
+			if shareName == "" || storageAccountName == "" || storageAccountKey == "" {
+				return nil, nil, fmt.Errorf("`share_name`, `storage_account_name` and `storage_account_key` must all be specified for the Azure File volume %q", name)
+			}
+
+			cv.AzureFile = &containerinstance.AzureFileVolume{
 				ShareName:          utils.String(shareName),
 				ReadOnly:           utils.Bool(readOnly),
 				StorageAccountName: utils.String(storageAccountName),
 				StorageAccountKey:  utils.String(storageAccountKey),
-			},
+			}
+
+		case emptyDir && !isAzureFile && secret == nil:
+			cv.EmptyDir = map[string]string{}
+
+		case secret != nil && !isAzureFile && !emptyDir:
+			cv.Secret = secret
+
+		default:
+			return nil, nil, fmt.Errorf("Exactly one of an Azure File Share (`share_name`, `storage_account_name` and `storage_account_key`), `empty_dir` or `secret` must be specified for volume %q", name)
 		}
 
 		containerGroupVolumes = append(containerGroupVolumes, cv)
 	}
 
-	return &volumeMounts, &containerGroupVolumes
+	return &volumeMounts, &containerGroupVolumes, nil
+}
+
+func expandContainerVolumeSecret(input map[string]interface{}) map[string]*string {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make(map[string]*string)
+	for k, v := range input {
+		output[k] = utils.String(v.(string))
+	}
+
+	return output
+}
+
+func expandContainerGroupExtensions(d *schema.ResourceData) containerGroupExtensions {
+	containers := make([]containerExtensions, 0)
+
+	for _, raw := range d.Get("container").([]interface{}) {
+		data := raw.(map[string]interface{})
+
+		properties := containerExtensionProperties{
+			LivenessProbe:  expandContainerProbe(data["liveness_probe"].([]interface{})),
+			ReadinessProbe: expandContainerProbe(data["readiness_probe"].([]interface{})),
+		}
+
+		if gpus := data["gpu"].([]interface{}); len(gpus) > 0 {
+			gpu := gpus[0].(map[string]interface{})
+			properties.Resources = &containerExtensionsResources{
+				Requests: &containerExtensionsResourceRequests{
+					Gpu: &containerGpuResource{
+						Count: int32(gpu["count"].(int)),
+						Sku:   gpu["sku"].(string),
+					},
+				},
+			}
+		}
+
+		containers = append(containers, containerExtensions{
+			Name:       data["name"].(string),
+			Properties: &properties,
+		})
+	}
+
+	output := containerGroupExtensions{
+		Properties: &containerGroupExtensionProperties{
+			Containers: containers,
+		},
+	}
+
+	if diagnostics := d.Get("diagnostics").([]interface{}); len(diagnostics) > 0 {
+		v := diagnostics[0].(map[string]interface{})
+		logAnalytics := v["log_analytics"].([]interface{})[0].(map[string]interface{})
+
+		output.Properties.Diagnostics = &containerGroupDiagnostics{
+			LogAnalytics: &containerGroupLogAnalytics{
+				WorkspaceID:  logAnalytics["workspace_id"].(string),
+				WorkspaceKey: logAnalytics["workspace_key"].(string),
+			},
+		}
+
+		if logType := logAnalytics["log_type"].(string); logType != "" {
+			output.Properties.Diagnostics.LogAnalytics.LogType = utils.String(logType)
+		}
+	}
+
+	return output
+}
+
+func expandContainerProbe(input []interface{}) *containerProbe {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	probe := containerProbe{}
+
+	if commands := v["exec"].([]interface{}); len(commands) > 0 {
+		command := make([]string, 0)
+		for _, c := range commands {
+			command = append(command, c.(string))
+		}
+
+		probe.Exec = &containerExec{
+			Command: command,
+		}
+	}
+
+	if httpGets := v["http_get"].([]interface{}); len(httpGets) > 0 {
+		httpGet := httpGets[0].(map[string]interface{})
+		probe.HTTPGet = &containerHTTPGet{
+			Port: int32(httpGet["port"].(int)),
+		}
+
+		if path := httpGet["path"].(string); path != "" {
+			probe.HTTPGet.Path = utils.String(path)
+		}
+
+		if scheme := httpGet["scheme"].(string); scheme != "" {
+			probe.HTTPGet.Scheme = utils.String(scheme)
+		}
+	}
+
+	if v := v["initial_delay_seconds"].(int); v > 0 {
+		probe.InitialDelaySeconds = utils.Int32(int32(v))
+	}
+
+	if v := v["period_seconds"].(int); v > 0 {
+		probe.PeriodSeconds = utils.Int32(int32(v))
+	}
+
+	if v := v["failure_threshold"].(int); v > 0 {
+		probe.FailureThreshold = utils.Int32(int32(v))
+	}
+
+	if v := v["success_threshold"].(int); v > 0 {
+		probe.SuccessThreshold = utils.Int32(int32(v))
+	}
+
+	if v := v["timeout_seconds"].(int); v > 0 {
+		probe.TimeoutSeconds = utils.Int32(int32(v))
+	}
+
+	return &probe
+}
+
+func flattenContainerGroupContainerExtensions(containerConfigs []interface{}, input *containerGroupExtensionProperties) {
+	for _, raw := range containerConfigs {
+		containerConfig := raw.(map[string]interface{})
+
+		gpus := make([]interface{}, 0)
+		livenessProbes := make([]interface{}, 0)
+		readinessProbes := make([]interface{}, 0)
+
+		if input != nil {
+			for _, container := range input.Containers {
+				if container.Name != containerConfig["name"] || container.Properties == nil {
+					continue
+				}
+
+				props := container.Properties
+				if resources := props.Resources; resources != nil && resources.Requests != nil && resources.Requests.Gpu != nil {
+					gpus = append(gpus, map[string]interface{}{
+						"count": int(resources.Requests.Gpu.Count),
+						"sku":   resources.Requests.Gpu.Sku,
+					})
+				}
+
+				livenessProbes = flattenContainerProbe(props.LivenessProbe)
+				readinessProbes = flattenContainerProbe(props.ReadinessProbe)
+			}
+		}
+
+		containerConfig["gpu"] = gpus
+		containerConfig["liveness_probe"] = livenessProbes
+		containerConfig["readiness_probe"] = readinessProbes
+	}
+}
+
+func flattenContainerProbe(input *containerProbe) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+
+	commands := make([]interface{}, 0)
+	if exec := input.Exec; exec != nil {
+		for _, command := range exec.Command {
+			commands = append(commands, command)
+		}
+	}
+	output["exec"] = commands
+
+	httpGets := make([]interface{}, 0)
+	if httpGet := input.HTTPGet; httpGet != nil {
+		path := ""
+		if httpGet.Path != nil {
+			path = *httpGet.Path
+		}
+
+		scheme := ""
+		if httpGet.Scheme != nil {
+			scheme = *httpGet.Scheme
+		}
+
+		httpGets = append(httpGets, map[string]interface{}{
+			"path":   path,
+			"port":   int(httpGet.Port),
+			"scheme": scheme,
+		})
+	}
+	output["http_get"] = httpGets
+
+	output["initial_delay_seconds"] = flattenContainerProbeValue(input.InitialDelaySeconds)
+	output["period_seconds"] = flattenContainerProbeValue(input.PeriodSeconds)
+	output["failure_threshold"] = flattenContainerProbeValue(input.FailureThreshold)
+	output["success_threshold"] = flattenContainerProbeValue(input.SuccessThreshold)
+	output["timeout_seconds"] = flattenContainerProbeValue(input.TimeoutSeconds)
+
+	return []interface{}{output}
+}
+
+func flattenContainerProbeValue(input *int32) int {
+	if input == nil {
+		return 0
+	}
+
+	return int(*input)
+}
+
+func flattenContainerGroupDiagnostics(d *schema.ResourceData, input *containerGroupExtensionProperties) []interface{} {
+	if input == nil || input.Diagnostics == nil || input.Diagnostics.LogAnalytics == nil {
+		return []interface{}{}
+	}
+
+	logAnalytics := input.Diagnostics.LogAnalytics
+
+	logType := ""
+	if logAnalytics.LogType != nil {
+		logType = *logAnalytics.LogType
+	}
+
+	// the Workspace Key isn't returned from the API
+	workspaceKey := ""
+	if v, ok := d.GetOk("diagnostics.0.log_analytics.0.workspace_key"); ok {
+		workspaceKey = v.(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"log_analytics": []interface{}{
+				map[string]interface{}{
+					"workspace_id":  logAnalytics.WorkspaceID,
+					"workspace_key": workspaceKey,
+					"log_type":      logType,
+				},
+			},
+		},
+	}
 }
@@ -1,10 +1,14 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2018-04-01/containerinstance"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -193,6 +197,249 @@ func TestAccAzureRMContainerGroup_linuxComplete(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMContainerGroup_linuxProbesAndDiagnostics(t *testing.T) {
+	resourceName := "azurerm_container_group.test"
+	ri := acctest.RandInt()
+
+	config := testAccAzureRMContainerGroup_linuxProbesAndDiagnostics(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "container.0.liveness_probe.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.liveness_probe.0.exec.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.liveness_probe.0.initial_delay_seconds", "10"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.readiness_probe.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.readiness_probe.0.http_get.0.port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.readiness_probe.0.http_get.0.path", "/"),
+					resource.TestCheckResourceAttr(resourceName, "diagnostics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "diagnostics.0.log_analytics.0.log_type", "ContainerInsights"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"diagnostics.0.log_analytics.0.workspace_key",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMContainerGroup_gpu(t *testing.T) {
+	resourceName := "azurerm_container_group.test"
+	ri := acctest.RandInt()
+
+	// GPU's are only available in a subset of regions
+	config := testAccAzureRMContainerGroup_gpu(ri, "eastus")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "container.0.gpu.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.gpu.0.count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.gpu.0.sku", "K80"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestMergeContainerGroupExtensions(t *testing.T) {
+	group := containerinstance.ContainerGroup{
+		Location: utils.String("westeurope"),
+		ContainerGroupProperties: &containerinstance.ContainerGroupProperties{
+			OsType: containerinstance.Linux,
+			Containers: &[]containerinstance.Container{
+				{
+					Name: utils.String("first"),
+					ContainerProperties: &containerinstance.ContainerProperties{
+						Image: utils.String("microsoft/aci-helloworld"),
+						Resources: &containerinstance.ResourceRequirements{
+							Requests: &containerinstance.ResourceRequests{
+								CPU:        utils.Float(1),
+								MemoryInGB: utils.Float(1.5),
+							},
+						},
+					},
+				},
+				{
+					Name: utils.String("second"),
+					ContainerProperties: &containerinstance.ContainerProperties{
+						Image: utils.String("microsoft/aci-tutorial-sidecar"),
+						Resources: &containerinstance.ResourceRequirements{
+							Requests: &containerinstance.ResourceRequests{
+								CPU:        utils.Float(0.5),
+								MemoryInGB: utils.Float(0.5),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	extensions := containerGroupExtensions{
+		Properties: &containerGroupExtensionProperties{
+			Containers: []containerExtensions{
+				{
+					Name: "second",
+					Properties: &containerExtensionProperties{
+						LivenessProbe: &containerProbe{
+							Exec: &containerExec{
+								Command: []string{"cat", "/tmp/healthy"},
+							},
+							PeriodSeconds: utils.Int32(5),
+						},
+						Resources: &containerExtensionsResources{
+							Requests: &containerExtensionsResourceRequests{
+								Gpu: &containerGpuResource{
+									Count: 1,
+									Sku:   "K80",
+								},
+							},
+						},
+					},
+				},
+			},
+			Diagnostics: &containerGroupDiagnostics{
+				LogAnalytics: &containerGroupLogAnalytics{
+					WorkspaceID:  "00000000-0000-0000-0000-000000000000",
+					WorkspaceKey: "key",
+				},
+			},
+		},
+	}
+
+	payload, err := mergeContainerGroupExtensions(group, extensions)
+	if err != nil {
+		t.Fatalf("Error merging the Container Group: %+v", err)
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Error serializing the Container Group: %+v", err)
+	}
+
+	var actual containerGroupExtensions
+	if err := json.Unmarshal(raw, &actual); err != nil {
+		t.Fatalf("Error deserializing the Container Group: %+v", err)
+	}
+
+	if actual.Properties.Diagnostics == nil || actual.Properties.Diagnostics.LogAnalytics.WorkspaceKey != "key" {
+		t.Fatalf("Expected the Diagnostics to be merged into the Container Group but got %s", string(raw))
+	}
+
+	first := actual.Properties.Containers[0]
+	if first.Name != "first" || first.Properties.LivenessProbe != nil || first.Properties.Resources.Requests.Gpu != nil {
+		t.Fatalf("Expected the first Container to be unchanged but got %s", string(raw))
+	}
+
+	second := actual.Properties.Containers[1]
+	if second.Properties.LivenessProbe == nil || *second.Properties.LivenessProbe.PeriodSeconds != 5 {
+		t.Fatalf("Expected the Liveness Probe to be merged into the second Container but got %s", string(raw))
+	}
+	if second.Properties.Resources.Requests.Gpu == nil || second.Properties.Resources.Requests.Gpu.Sku != "K80" {
+		t.Fatalf("Expected the GPU to be merged into the second Container but got %s", string(raw))
+	}
+
+	if !strings.Contains(string(raw), `"image":"microsoft/aci-tutorial-sidecar"`) {
+		t.Fatalf("Expected the existing Container properties to be retained but got %s", string(raw))
+	}
+}
+
+func TestExpandContainerProbe(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"exec": []interface{}{},
+			"http_get": []interface{}{
+				map[string]interface{}{
+					"path":   "/health",
+					"port":   8080,
+					"scheme": "Http",
+				},
+			},
+			"initial_delay_seconds": 10,
+			"period_seconds":        0,
+			"failure_threshold":     3,
+			"success_threshold":     0,
+			"timeout_seconds":       0,
+		},
+	}
+
+	probe := expandContainerProbe(input)
+	if probe.Exec != nil {
+		t.Fatalf("Expected no Exec but got %+v", probe.Exec)
+	}
+	if probe.HTTPGet == nil || probe.HTTPGet.Port != 8080 || *probe.HTTPGet.Path != "/health" {
+		t.Fatalf("Expected a HTTP Get on port 8080 but got %+v", probe.HTTPGet)
+	}
+	if probe.PeriodSeconds != nil {
+		t.Fatalf("Expected the unset Period to be omitted")
+	}
+
+	actual := flattenContainerProbe(probe)
+	if !reflect.DeepEqual(actual, input) {
+		t.Fatalf("Expected %+v but got %+v", input, actual)
+	}
+}
+
+func TestAccAzureRMContainerGroup_linuxVolumes(t *testing.T) {
+	resourceName := "azurerm_container_group.test"
+	ri := acctest.RandInt()
+
+	config := testAccAzureRMContainerGroup_linuxVolumes(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "container.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.volume.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.volume.0.name", "scratch"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.volume.0.empty_dir", "true"),
+					resource.TestCheckResourceAttr(resourceName, "container.1.volume.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.1.volume.0.name", "config"),
+					resource.TestCheckResourceAttr(resourceName, "container.1.volume.0.empty_dir", "false"),
+					resource.TestCheckResourceAttr(resourceName, "container.1.volume.0.secret.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"container.1.volume.0.secret",
+				},
+			},
+		},
+	})
+}
+
 func TestAccAzureRMContainerGroup_windowsBasic(t *testing.T) {
 	resourceName := "azurerm_container_group.test"
 	ri := acctest.RandInt()
@@ -479,6 +726,54 @@ resource "azurerm_container_group" "test" {
 `, ri, location, ri, ri)
 }
 
+func testAccAzureRMContainerGroup_linuxVolumes(ri int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_group" "test" {
+  name                = "acctestcontainergroup-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  ip_address_type     = "public"
+  os_type             = "linux"
+
+  container {
+    name   = "hw"
+    image  = "microsoft/aci-helloworld:latest"
+    cpu    = "0.5"
+    memory = "0.5"
+    port   = "80"
+
+    volume {
+      name       = "scratch"
+      mount_path = "/aci/scratch"
+      empty_dir  = true
+    }
+  }
+
+  container {
+    name   = "sidecar"
+    image  = "microsoft/aci-tutorial-sidecar"
+    cpu    = "0.5"
+    memory = "0.5"
+
+    volume {
+      name       = "config"
+      mount_path = "/aci/config"
+      read_only  = true
+
+      secret {
+        "settings.json" = "eyJoZWxsbyI6IndvcmxkIn0="
+      }
+    }
+  }
+}
+`, ri, location, ri)
+}
+
 func testAccAzureRMContainerGroup_linuxComplete(ri int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -546,6 +841,93 @@ resource "azurerm_container_group" "test" {
 `, ri, location, ri, ri, ri, ri)
 }
 
+func testAccAzureRMContainerGroup_linuxProbesAndDiagnostics(ri int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+	name     = "acctestRG-%d"
+	location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+	name                = "acctestLAW-%d"
+	location            = "${azurerm_resource_group.test.location}"
+	resource_group_name = "${azurerm_resource_group.test.name}"
+	sku                 = "PerGB2018"
+}
+
+resource "azurerm_container_group" "test" {
+	name                = "acctestcontainergroup-%d"
+	location            = "${azurerm_resource_group.test.location}"
+	resource_group_name = "${azurerm_resource_group.test.name}"
+	ip_address_type     = "public"
+	os_type             = "linux"
+
+	container {
+		name   = "hw"
+		image  = "microsoft/aci-helloworld:latest"
+		cpu    = "0.5"
+		memory = "0.5"
+		port   = "80"
+
+		liveness_probe {
+			exec                  = ["cat", "/tmp/healthy"]
+			initial_delay_seconds = 10
+			period_seconds        = 5
+		}
+
+		readiness_probe {
+			http_get {
+				path   = "/"
+				port   = 80
+				scheme = "Http"
+			}
+
+			failure_threshold = 3
+		}
+	}
+
+	diagnostics {
+		log_analytics {
+			workspace_id  = "${azurerm_log_analytics_workspace.test.workspace_id}"
+			workspace_key = "${azurerm_log_analytics_workspace.test.primary_shared_key}"
+			log_type      = "ContainerInsights"
+		}
+	}
+}
+`, ri, location, ri, ri)
+}
+
+func testAccAzureRMContainerGroup_gpu(ri int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+	name     = "acctestRG-%d"
+	location = "%s"
+}
+
+resource "azurerm_container_group" "test" {
+	name                = "acctestcontainergroup-%d"
+	location            = "${azurerm_resource_group.test.location}"
+	resource_group_name = "${azurerm_resource_group.test.name}"
+	ip_address_type     = "public"
+	os_type             = "linux"
+	restart_policy      = "OnFailure"
+
+	container {
+		name     = "cuda"
+		image    = "microsoft/samples-tf-mnist-demo:gpu"
+		cpu      = "1"
+		memory   = "1.5"
+		port     = "80"
+
+		gpu {
+			count = 1
+			sku   = "K80"
+		}
+	}
+}
+`, ri, location, ri)
+}
+
 func testCheckAzureRMContainerGroupExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -125,7 +125,7 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 		RegistryProperties: &containerregistry.RegistryProperties{
 			AdminUserEnabled: utils.Bool(adminUserEnabled),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	if v, ok := d.GetOk("storage_account_id"); ok {
@@ -147,7 +147,9 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error creating Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.ContainerRegistry", "registries", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error waiting for creation of Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -186,7 +188,7 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 			Name: containerregistry.SkuName(sku),
 			Tier: containerregistry.SkuTier(sku),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	if v, ok := d.GetOk("storage_account_id"); ok {
@@ -208,7 +210,7 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error updating Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for update of Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -281,7 +283,7 @@ func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{})
 		d.Set("admin_password", "")
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -305,7 +307,7 @@ func resourceArmContainerRegistryDelete(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error issuing Azure ARM delete request of Container Registry '%s': %+v", name, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		if response.WasNotFound(future.Response()) {
 			return nil
@@ -0,0 +1,570 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmContainerRegistryTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmContainerRegistryTaskCreateUpdate,
+		Read:   resourceArmContainerRegistryTaskRead,
+		Update: resourceArmContainerRegistryTaskCreateUpdate,
+		Delete: resourceArmContainerRegistryTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMContainerRegistryName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"container_registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMContainerRegistryName,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"os_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "Linux",
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Linux",
+					"Windows",
+				}, true),
+			},
+
+			"cpu": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(300, 28800),
+			},
+
+			"source_repository": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Github",
+								"VisualStudioTeamService",
+							}, false),
+						},
+
+						"repository_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						// the access token is used to register the webhook used by the commit trigger,
+						// and isn't returned from the API
+						"access_token": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"commit_trigger_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"docker_step": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branch": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "master",
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"dockerfile_path": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Dockerfile",
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"context_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "/",
+							// the context path is returned relative to the root of the Source Repository
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return strings.Trim(old, "/") == strings.Trim(new, "/")
+							},
+						},
+
+						"image_names": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+
+						"push_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"cache_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"base_image_trigger": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Runtime",
+							ValidateFunc: validation.StringInSlice([]string{
+								"All",
+								containerRegistryTaskBaseImageTriggerNone,
+								"Runtime",
+							}, false),
+						},
+
+						"build_arguments": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"timer_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"schedule": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmContainerRegistryTaskCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).containerRegistryTasksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Container Registry Task creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	registryName := d.Get("container_registry_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	status := containerRegistryTaskStatusDisabled
+	if d.Get("enabled").(bool) {
+		status = containerRegistryTaskStatusEnabled
+	}
+
+	sourceRepository := d.Get("source_repository").([]interface{})
+	dockerStep := d.Get("docker_step").([]interface{})
+
+	task := containerRegistryTask{
+		Location: utils.String(location),
+		Properties: &containerRegistryTaskProperties{
+			Status: status,
+			Platform: &containerRegistryTaskPlatform{
+				OS: d.Get("os_type").(string),
+			},
+			AgentConfiguration: &containerRegistryTaskAgentConfiguration{
+				CPU: utils.Int32(int32(d.Get("cpu").(int))),
+			},
+			Timeout: utils.Int32(int32(d.Get("timeout_in_seconds").(int))),
+			Step:    expandContainerRegistryTaskDockerStep(dockerStep, sourceRepository),
+			Trigger: &containerRegistryTaskTrigger{
+				SourceTriggers:   expandContainerRegistryTaskSourceTriggers(sourceRepository, dockerStep),
+				BaseImageTrigger: expandContainerRegistryTaskBaseImageTrigger(dockerStep),
+				TimerTriggers:    expandContainerRegistryTaskTimerTriggers(d.Get("timer_trigger").([]interface{})),
+			},
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	id := fmt.Sprintf("%s/tasks/%s", azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.ContainerRegistry", "registries", registryName), name)
+	future, err := client.CreateOrUpdate(ctx, id, task)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Container Registry Task %q (Container Registry %q / Resource Group %q): %+v", name, registryName, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Container Registry Task %q (Container Registry %q / Resource Group %q): %+v", name, registryName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmContainerRegistryTaskRead(d, meta)
+}
+
+func resourceArmContainerRegistryTaskRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).containerRegistryTasksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	registryName := id.Path["registries"]
+	name := id.Path["tasks"]
+
+	var task containerRegistryTask
+	resp, err := client.Get(ctx, d.Id(), &task)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Container Registry Task %q was not found in Container Registry %q / Resource Group %q - removing from state!", name, registryName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Container Registry Task %q (Container Registry %q / Resource Group %q): %+v", name, registryName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("container_registry_name", registryName)
+	if location := task.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := task.Properties; props != nil {
+		d.Set("enabled", props.Status == containerRegistryTaskStatusEnabled)
+		d.Set("timeout_in_seconds", props.Timeout)
+
+		if platform := props.Platform; platform != nil {
+			d.Set("os_type", platform.OS)
+		}
+
+		if agent := props.AgentConfiguration; agent != nil {
+			d.Set("cpu", agent.CPU)
+		}
+
+		trigger := props.Trigger
+		if trigger == nil {
+			trigger = &containerRegistryTaskTrigger{}
+		}
+
+		sourceRepository := flattenContainerRegistryTaskSourceRepository(trigger.SourceTriggers, d.Get("source_repository").([]interface{}))
+		if err := d.Set("source_repository", sourceRepository); err != nil {
+			return fmt.Errorf("Error setting `source_repository`: %+v", err)
+		}
+
+		if err := d.Set("docker_step", flattenContainerRegistryTaskDockerStep(props.Step, trigger.BaseImageTrigger)); err != nil {
+			return fmt.Errorf("Error setting `docker_step`: %+v", err)
+		}
+
+		if err := d.Set("timer_trigger", flattenContainerRegistryTaskTimerTriggers(trigger.TimerTriggers)); err != nil {
+			return fmt.Errorf("Error setting `timer_trigger`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, task.Tags, meta)
+
+	return nil
+}
+
+func resourceArmContainerRegistryTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).containerRegistryTasksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	registryName := id.Path["registries"]
+	name := id.Path["tasks"]
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Container Registry Task %q (Container Registry %q / Resource Group %q): %+v", name, registryName, resourceGroup, err)
+	}
+
+	if err = waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Container Registry Task %q (Container Registry %q / Resource Group %q): %+v", name, registryName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandContainerRegistryTaskSourceTriggers(sourceRepository []interface{}, dockerStep []interface{}) *[]containerRegistryTaskSourceTrigger {
+	repository := sourceRepository[0].(map[string]interface{})
+	step := dockerStep[0].(map[string]interface{})
+
+	// the access token is used to register the webhook used by the commit trigger
+	status := containerRegistryTaskStatusDisabled
+	if repository["commit_trigger_enabled"].(bool) {
+		status = containerRegistryTaskStatusEnabled
+	}
+
+	return &[]containerRegistryTaskSourceTrigger{
+		{
+			Name: utils.String(containerRegistryTaskSourceTriggerName),
+			SourceRepository: &containerRegistryTaskSourceRepository{
+				SourceControlType: repository["type"].(string),
+				RepositoryURL:     utils.String(repository["repository_url"].(string)),
+				Branch:            utils.String(step["branch"].(string)),
+				SourceControlAuthProperties: &containerRegistryTaskAuthInfo{
+					TokenType: "PAT",
+					Token:     utils.String(repository["access_token"].(string)),
+				},
+			},
+			SourceTriggerEvents: &[]string{"commit"},
+			Status:              status,
+		},
+	}
+}
+
+func flattenContainerRegistryTaskSourceRepository(input *[]containerRegistryTaskSourceTrigger, existing []interface{}) []interface{} {
+	if input == nil || len(*input) == 0 {
+		return []interface{}{}
+	}
+
+	trigger := (*input)[0]
+	output := map[string]interface{}{
+		"commit_trigger_enabled": trigger.Status == containerRegistryTaskStatusEnabled,
+	}
+
+	if repository := trigger.SourceRepository; repository != nil {
+		output["type"] = repository.SourceControlType
+
+		if v := repository.RepositoryURL; v != nil {
+			output["repository_url"] = *v
+		}
+	}
+
+	// the access token isn't returned from the API, so we use the value from the config
+	if len(existing) > 0 && existing[0] != nil {
+		output["access_token"] = existing[0].(map[string]interface{})["access_token"]
+	}
+
+	return []interface{}{output}
+}
+
+func expandContainerRegistryTaskDockerStep(dockerStep []interface{}, sourceRepository []interface{}) *containerRegistryTaskDockerStep {
+	v := dockerStep[0].(map[string]interface{})
+	repository := sourceRepository[0].(map[string]interface{})
+
+	imageNames := make([]string, 0)
+	for _, imageName := range v["image_names"].([]interface{}) {
+		imageNames = append(imageNames, imageName.(string))
+	}
+
+	arguments := make([]containerRegistryTaskArgument, 0)
+	for name, value := range v["build_arguments"].(map[string]interface{}) {
+		arguments = append(arguments, containerRegistryTaskArgument{
+			Name:     utils.String(name),
+			Value:    utils.String(value.(string)),
+			IsSecret: utils.Bool(false),
+		})
+	}
+
+	contextPath := buildContainerRegistryTaskContextPath(repository["repository_url"].(string), v["branch"].(string), v["context_path"].(string))
+
+	return &containerRegistryTaskDockerStep{
+		Type:               "Docker",
+		DockerFilePath:     utils.String(v["dockerfile_path"].(string)),
+		ContextPath:        utils.String(contextPath),
+		ContextAccessToken: utils.String(repository["access_token"].(string)),
+		ImageNames:         &imageNames,
+		IsPushEnabled:      utils.Bool(v["push_enabled"].(bool)),
+		NoCache:            utils.Bool(!v["cache_enabled"].(bool)),
+		Arguments:          &arguments,
+	}
+}
+
+func flattenContainerRegistryTaskDockerStep(step *containerRegistryTaskDockerStep, baseImageTrigger *containerRegistryTaskBaseImageTrigger) []interface{} {
+	if step == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"base_image_trigger": flattenContainerRegistryTaskBaseImageTrigger(baseImageTrigger),
+	}
+
+	if v := step.ContextPath; v != nil {
+		branch, contextPath := parseContainerRegistryTaskContextPath(*v)
+		output["branch"] = branch
+		output["context_path"] = contextPath
+	}
+	if v := step.DockerFilePath; v != nil {
+		output["dockerfile_path"] = *v
+	}
+	if v := step.IsPushEnabled; v != nil {
+		output["push_enabled"] = *v
+	}
+	if v := step.NoCache; v != nil {
+		output["cache_enabled"] = !*v
+	}
+
+	imageNames := make([]interface{}, 0)
+	if v := step.ImageNames; v != nil {
+		for _, imageName := range *v {
+			imageNames = append(imageNames, imageName)
+		}
+	}
+	output["image_names"] = imageNames
+
+	buildArguments := make(map[string]interface{})
+	if v := step.Arguments; v != nil {
+		for _, argument := range *v {
+			if argument.Name == nil || argument.Value == nil {
+				continue
+			}
+
+			buildArguments[*argument.Name] = *argument.Value
+		}
+	}
+	output["build_arguments"] = buildArguments
+
+	return []interface{}{output}
+}
+
+func expandContainerRegistryTaskBaseImageTrigger(dockerStep []interface{}) *containerRegistryTaskBaseImageTrigger {
+	v := dockerStep[0].(map[string]interface{})
+
+	triggerType := v["base_image_trigger"].(string)
+	if triggerType == containerRegistryTaskBaseImageTriggerNone {
+		return nil
+	}
+
+	return &containerRegistryTaskBaseImageTrigger{
+		Name:                 utils.String(containerRegistryTaskBaseImageTriggerName),
+		BaseImageTriggerType: triggerType,
+		Status:               containerRegistryTaskStatusEnabled,
+	}
+}
+
+func flattenContainerRegistryTaskBaseImageTrigger(input *containerRegistryTaskBaseImageTrigger) string {
+	if input == nil || input.Status != containerRegistryTaskStatusEnabled {
+		return containerRegistryTaskBaseImageTriggerNone
+	}
+
+	return input.BaseImageTriggerType
+}
+
+func expandContainerRegistryTaskTimerTriggers(input []interface{}) *[]containerRegistryTaskTimerTrigger {
+	triggers := make([]containerRegistryTaskTimerTrigger, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		status := containerRegistryTaskStatusDisabled
+		if raw["enabled"].(bool) {
+			status = containerRegistryTaskStatusEnabled
+		}
+
+		triggers = append(triggers, containerRegistryTaskTimerTrigger{
+			Name:     utils.String(raw["name"].(string)),
+			Schedule: utils.String(raw["schedule"].(string)),
+			Status:   status,
+		})
+	}
+
+	return &triggers
+}
+
+func flattenContainerRegistryTaskTimerTriggers(input *[]containerRegistryTaskTimerTrigger) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, trigger := range *input {
+		result := map[string]interface{}{
+			"enabled": trigger.Status == containerRegistryTaskStatusEnabled,
+		}
+
+		if v := trigger.Name; v != nil {
+			result["name"] = *v
+		}
+
+		if v := trigger.Schedule; v != nil {
+			result["schedule"] = *v
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,261 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMContainerRegistryTask_basic(t *testing.T) {
+	repositoryUrl, accessToken := testAccAzureRMContainerRegistryTaskSourceRepository(t)
+
+	resourceName := "azurerm_container_registry_task.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMContainerRegistryTask_basic(ri, testLocation(), repositoryUrl, accessToken)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "os_type", "Linux"),
+					resource.TestCheckResourceAttr(resourceName, "source_repository.0.commit_trigger_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "docker_step.0.base_image_trigger", "Runtime"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMContainerRegistryTask_update(t *testing.T) {
+	repositoryUrl, accessToken := testAccAzureRMContainerRegistryTaskSourceRepository(t)
+
+	resourceName := "azurerm_container_registry_task.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMContainerRegistryTask_basic(ri, location, repositoryUrl, accessToken)
+	updatedConfig := testAccAzureRMContainerRegistryTask_complete(ri, location, repositoryUrl, accessToken)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryTaskExists(resourceName),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "timeout_in_seconds", "1800"),
+					resource.TestCheckResourceAttr(resourceName, "source_repository.0.commit_trigger_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "docker_step.0.image_names.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "docker_step.0.cache_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "docker_step.0.base_image_trigger", "All"),
+					resource.TestCheckResourceAttr(resourceName, "docker_step.0.build_arguments.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timer_trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timer_trigger.0.schedule", "0 12 * * Mon-Fri"),
+				),
+			},
+		},
+	})
+}
+
+func TestContainerRegistryTaskContextPath(t *testing.T) {
+	cases := []struct {
+		RepositoryUrl string
+		Branch        string
+		ContextPath   string
+		Expected      string
+	}{
+		{
+			RepositoryUrl: "https://github.com/Azure-Samples/acr-build-helloworld-node",
+			Branch:        "master",
+			ContextPath:   "/",
+			Expected:      "https://github.com/Azure-Samples/acr-build-helloworld-node#master",
+		},
+		{
+			RepositoryUrl: "https://github.com/Azure-Samples/acr-build-helloworld-node",
+			Branch:        "feature/timers",
+			ContextPath:   "/src/app",
+			Expected:      "https://github.com/Azure-Samples/acr-build-helloworld-node#feature/timers:src/app",
+		},
+	}
+
+	for _, v := range cases {
+		actual := buildContainerRegistryTaskContextPath(v.RepositoryUrl, v.Branch, v.ContextPath)
+		if actual != v.Expected {
+			t.Fatalf("Expected the Context Path to be %q but got %q", v.Expected, actual)
+		}
+
+		branch, contextPath := parseContainerRegistryTaskContextPath(actual)
+		if branch != v.Branch {
+			t.Fatalf("Expected the Branch to be %q but got %q", v.Branch, branch)
+		}
+		if contextPath != v.ContextPath {
+			t.Fatalf("Expected the Context Path to be %q but got %q", v.ContextPath, contextPath)
+		}
+	}
+}
+
+func testAccAzureRMContainerRegistryTaskSourceRepository(t *testing.T) (string, string) {
+	repositoryUrlEnvVariable := "ARM_TEST_GITHUB_REPOSITORY_URL"
+	repositoryUrl := os.Getenv(repositoryUrlEnvVariable)
+	if repositoryUrl == "" {
+		t.Skipf("Skipping as %q is not specified", repositoryUrlEnvVariable)
+	}
+
+	accessTokenEnvVariable := "ARM_TEST_GITHUB_ACCESS_TOKEN"
+	accessToken := os.Getenv(accessTokenEnvVariable)
+	if accessToken == "" {
+		t.Skipf("Skipping as %q is not specified", accessTokenEnvVariable)
+	}
+
+	return repositoryUrl, accessToken
+}
+
+func testCheckAzureRMContainerRegistryTaskDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).containerRegistryTasksClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_container_registry_task" {
+			continue
+		}
+
+		var task containerRegistryTask
+		resp, err := client.Get(ctx, rs.Primary.ID, &task)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp) {
+				return err
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Container Registry Task %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMContainerRegistryTaskExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).containerRegistryTasksClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var task containerRegistryTask
+		resp, err := client.Get(ctx, rs.Primary.ID, &task)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Container Registry Task %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on containerRegistryTasksClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMContainerRegistryTask_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "acctestacr%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Standard"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMContainerRegistryTask_basic(rInt int, location string, repositoryUrl string, accessToken string) string {
+	template := testAccAzureRMContainerRegistryTask_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                    = "acctesttask%d"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  location                = "${azurerm_resource_group.test.location}"
+  container_registry_name = "${azurerm_container_registry.test.name}"
+
+  source_repository {
+    type           = "Github"
+    repository_url = "%s"
+    access_token   = "%s"
+  }
+
+  docker_step {
+    image_names = ["helloworld:{{.Build.ID}}"]
+  }
+}
+`, template, rInt, repositoryUrl, accessToken)
+}
+
+func testAccAzureRMContainerRegistryTask_complete(rInt int, location string, repositoryUrl string, accessToken string) string {
+	template := testAccAzureRMContainerRegistryTask_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                    = "acctesttask%d"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  location                = "${azurerm_resource_group.test.location}"
+  container_registry_name = "${azurerm_container_registry.test.name}"
+  enabled                 = false
+  timeout_in_seconds      = 1800
+
+  source_repository {
+    type                   = "Github"
+    repository_url         = "%s"
+    access_token           = "%s"
+    commit_trigger_enabled = false
+  }
+
+  docker_step {
+    image_names        = ["helloworld:{{.Build.ID}}", "helloworld:latest"]
+    cache_enabled      = false
+    base_image_trigger = "All"
+
+    build_arguments {
+      "environment" = "test"
+    }
+  }
+
+  timer_trigger {
+    name     = "weekdays"
+    schedule = "0 12 * * Mon-Fri"
+  }
+
+  tags {
+    environment = "test"
+  }
+}
+`, template, rInt, repositoryUrl, accessToken)
+}
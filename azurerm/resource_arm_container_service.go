@@ -212,7 +212,7 @@ func resourceArmContainerServiceCreate(d *schema.ResourceData, meta interface{})
 			AgentPoolProfiles:  &agentProfiles,
 			DiagnosticsProfile: &diagnosticsProfile,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	servicePrincipalProfile := expandAzureRmContainerServiceServicePrincipal(d)
@@ -300,7 +300,7 @@ func resourceArmContainerServiceRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("diagnostics_profile", diagnosticProfile)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -323,7 +323,7 @@ func resourceArmContainerServiceDelete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error issuing Azure ARM delete request of Container Service '%s': %s", name, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, containerServiceClient.Client)
+	err = waitForCompletion(ctx, &future.Future, containerServiceClient.Client)
 	if err != nil {
 		return err
 	}
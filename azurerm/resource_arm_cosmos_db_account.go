@@ -2,7 +2,6 @@ package azurerm
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -313,10 +312,10 @@ func resourceArmCosmosDBAccountCreate(d *schema.ResourceData, meta interface{})
 			Locations:                &geoLocations,
 			Capabilities:             expandAzureRmCosmosDBAccountCapabilities(d),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
-	resp, err := resourceArmCosmosDBAccountApiUpsert(client, ctx, resourceGroup, name, account)
+	resp, err := resourceArmCosmosDBAccountApiUpsert(d, meta, resourceGroup, name, account)
 	if err != nil {
 		return fmt.Errorf("Error creating CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -397,10 +396,10 @@ func resourceArmCosmosDBAccountUpdate(d *schema.ResourceData, meta interface{})
 			ConsistencyPolicy:        expandAzureRmCosmosDBAccountConsistencyPolicy(d),
 			Locations:                &oldLocations,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
-	if _, err := resourceArmCosmosDBAccountApiUpsert(client, ctx, resourceGroup, name, account); err != nil {
+	if _, err := resourceArmCosmosDBAccountApiUpsert(d, meta, resourceGroup, name, account); err != nil {
 		return fmt.Errorf("Error updating CosmosDB Account %q properties (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
@@ -437,14 +436,14 @@ func resourceArmCosmosDBAccountUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 		account.DatabaseAccountCreateUpdateProperties.Locations = &locationsUnchanged
-		if _, err := resourceArmCosmosDBAccountApiUpsert(client, ctx, resourceGroup, name, account); err != nil {
+		if _, err := resourceArmCosmosDBAccountApiUpsert(d, meta, resourceGroup, name, account); err != nil {
 			return fmt.Errorf("Error removing CosmosDB Account %q renamed locations (Resource Group %q): %+v", name, resourceGroup, err)
 		}
 	}
 
 	//add any new/renamed locations
 	account.DatabaseAccountCreateUpdateProperties.Locations = &newLocations
-	upsertResponse, err := resourceArmCosmosDBAccountApiUpsert(client, ctx, resourceGroup, name, account)
+	upsertResponse, err := resourceArmCosmosDBAccountApiUpsert(d, meta, resourceGroup, name, account)
 	if err != nil {
 		return fmt.Errorf("Error updating CosmosDB Account %q locations (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -486,7 +485,7 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
 	d.Set("resource_group_name", resourceGroup)
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	d.Set("kind", string(resp.Kind))
 	d.Set("offer_type", string(resp.DatabaseAccountOfferType))
@@ -611,13 +610,18 @@ func resourceArmCosmosDBAccountDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceArmCosmosDBAccountApiUpsert(client documentdb.DatabaseAccountsClient, ctx context.Context, resourceGroup string, name string, account documentdb.DatabaseAccountCreateUpdateParameters) (*documentdb.DatabaseAccount, error) {
+func resourceArmCosmosDBAccountApiUpsert(d *schema.ResourceData, meta interface{}, resourceGroup string, name string, account documentdb.DatabaseAccountCreateUpdateParameters) (*documentdb.DatabaseAccount, error) {
+	client := meta.(*ArmClient).cosmosDBClient
+	ctx := meta.(*ArmClient).StopContext
+
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, account)
 	if err != nil {
 		return nil, fmt.Errorf("Error creating/updating CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.DocumentDB", "databaseAccounts", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return nil, fmt.Errorf("Error waiting for the CosmosDB Account %q (Resource Group %q) to finish creating/updating: %+v", name, resourceGroup, err)
 	}
@@ -0,0 +1,209 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDashboard() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDashboardCreateUpdate,
+		Read:   resourceArmDashboardRead,
+		Update: resourceArmDashboardCreateUpdate,
+		Delete: resourceArmDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDashboardName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"dashboard_properties": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"template_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDashboardCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).portalClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Dashboard creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	dashboardProperties := renderDashboardProperties(d.Get("dashboard_properties").(string), d.Get("template_variables").(map[string]interface{}))
+	properties, err := structure.ExpandJsonFromString(dashboardProperties)
+	if err != nil {
+		return fmt.Errorf("Error parsing `dashboard_properties` for Dashboard %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	dashboard := portalDashboard{
+		Location:   utils.String(location),
+		Properties: properties,
+		Tags:       expandTags(tags, meta),
+	}
+
+	resourceId := dashboardResourceId(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+	future, err := client.CreateOrUpdate(ctx, resourceId, dashboard)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Dashboard %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, resourceId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Dashboard %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(resourceId)
+
+	return resourceArmDashboardRead(d, meta)
+}
+
+func resourceArmDashboardRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).portalClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["dashboards"]
+
+	var dashboard portalDashboard
+	resp, err := client.Get(ctx, d.Id(), &dashboard)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Dashboard %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Dashboard %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", dashboard.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := dashboard.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if v := dashboard.Properties; v != nil {
+		flattened, err := structure.FlattenJsonToString(v)
+		if err != nil {
+			return fmt.Errorf("Error flattening `dashboard_properties`: %+v", err)
+		}
+
+		// the API returns the rendered properties - so the template is only replaced if the rendered template differs
+		rendered := renderDashboardProperties(d.Get("dashboard_properties").(string), d.Get("template_variables").(map[string]interface{}))
+		if !dashboardPropertiesAreEquivalent(rendered, flattened) {
+			d.Set("dashboard_properties", flattened)
+		}
+	}
+
+	flattenAndSetTags(d, dashboard.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDashboardDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).portalClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["dashboards"]
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Dashboard %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Dashboard %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func dashboardResourceId(subscriptionId, resourceGroup, name string) string {
+	return azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Portal", "dashboards", name)
+}
+
+// renderDashboardProperties replaces each `${name}` in the Dashboard JSON with the value of the matching Template Variable,
+// which is JSON-encoded (without the surrounding quotes) so that quotes and backslashes in the value don't break the JSON
+func renderDashboardProperties(template string, variables map[string]interface{}) string {
+	for k, v := range variables {
+		// marshalling a string can't fail
+		encoded, _ := json.Marshal(v.(string))
+		value := strings.TrimSuffix(strings.TrimPrefix(string(encoded), `"`), `"`)
+		template = strings.Replace(template, fmt.Sprintf("${%s}", k), value, -1)
+	}
+
+	return template
+}
+
+func dashboardPropertiesAreEquivalent(first string, second string) bool {
+	firstNormalized, err := structure.NormalizeJsonString(first)
+	if err != nil {
+		return false
+	}
+
+	secondNormalized, err := structure.NormalizeJsonString(second)
+	if err != nil {
+		return false
+	}
+
+	return firstNormalized == secondNormalized
+}
+
+func validateDashboardName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[-a-zA-Z0-9]{1,160}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 160 characters in length and can only contain letters, numbers and hyphens", k))
+	}
+
+	return warnings, errors
+}
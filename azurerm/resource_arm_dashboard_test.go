@@ -0,0 +1,323 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateDashboardName(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "hello",
+			Errors: 0,
+		},
+		{
+			Value:  "hello-world-123",
+			Errors: 0,
+		},
+		{
+			Value:  "hello_world",
+			Errors: 1,
+		},
+		{
+			Value:  "hello world",
+			Errors: 1,
+		},
+		{
+			Value:  acctest.RandString(160),
+			Errors: 0,
+		},
+		{
+			Value:  acctest.RandString(161),
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateDashboardName(tc.Value, "name")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected validateDashboardName to trigger %d errors for %q - got %d", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestRenderDashboardProperties(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Template  string
+		Variables map[string]interface{}
+		Expected  string
+	}{
+		{
+			Name:      "No Variables",
+			Template:  `{"lenses": {"name": "${workspace}"}}`,
+			Variables: map[string]interface{}{},
+			Expected:  `{"lenses": {"name": "${workspace}"}}`,
+		},
+		{
+			Name:     "Single Variable",
+			Template: `{"lenses": {"name": "${workspace}"}}`,
+			Variables: map[string]interface{}{
+				"workspace": "production",
+			},
+			Expected: `{"lenses": {"name": "production"}}`,
+		},
+		{
+			Name:     "Repeated Variable",
+			Template: `{"first": "${workspace}", "second": "${workspace}-${region}"}`,
+			Variables: map[string]interface{}{
+				"workspace": "production",
+				"region":    "westeurope",
+			},
+			Expected: `{"first": "production", "second": "production-westeurope"}`,
+		},
+		{
+			Name:     "Quoted Variable",
+			Template: `{"query": "${query}"}`,
+			Variables: map[string]interface{}{
+				"query": `Perf | where ObjectName == "Processor" and Path == "C:\Temp"`,
+			},
+			Expected: `{"query": "Perf | where ObjectName == \"Processor\" and Path == \"C:\\Temp\""}`,
+		},
+	}
+
+	for _, tc := range cases {
+		actual := renderDashboardProperties(tc.Template, tc.Variables)
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q to render as %q but got %q", tc.Name, tc.Expected, actual)
+		}
+
+		if _, err := structure.ExpandJsonFromString(actual); err != nil {
+			t.Fatalf("Expected %q to render as valid JSON but got %q: %+v", tc.Name, actual, err)
+		}
+	}
+}
+
+func TestAccAzureRMDashboard_basic(t *testing.T) {
+	resourceName := "azurerm_dashboard.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDashboard_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDashboardExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "dashboard_properties"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDashboard_templateVariables(t *testing.T) {
+	resourceName := "azurerm_dashboard.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMDashboard_templateVariables(ri, location, "Hello")
+	postConfig := testAccAzureRMDashboard_templateVariables(ri, location, "World")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDashboardExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "template_variables.title", "Hello"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDashboardExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_variables.title", "World"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDashboardExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Dashboard: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).portalClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var dashboard portalDashboard
+		resp, err := client.Get(ctx, rs.Primary.ID, &dashboard)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Dashboard %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on Dashboard: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDashboardDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).portalClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dashboard" {
+			continue
+		}
+
+		var dashboard portalDashboard
+		resp, err := client.Get(ctx, rs.Primary.ID, &dashboard)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Dashboard still exists:\n%+v", dashboard)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDashboard_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dashboard" "test" {
+  name                 = "acctestdashboard-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  location             = "${azurerm_resource_group.test.location}"
+  dashboard_properties = <<DASH
+{
+  "lenses": {
+    "0": {
+      "order": 0,
+      "parts": {
+        "0": {
+          "position": {
+            "x": 0,
+            "y": 0,
+            "rowSpan": 2,
+            "colSpan": 3
+          },
+          "metadata": {
+            "inputs": [],
+            "type": "Extension/HubsExtension/PartType/MarkdownPart",
+            "settings": {
+              "content": {
+                "settings": {
+                  "content": "Acceptance Test",
+                  "subtitle": "",
+                  "title": ""
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  },
+  "metadata": {
+    "model": {}
+  }
+}
+DASH
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDashboard_templateVariables(rInt int, location string, title string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dashboard" "test" {
+  name                 = "acctestdashboard-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  location             = "${azurerm_resource_group.test.location}"
+  dashboard_properties = <<DASH
+{
+  "lenses": {
+    "0": {
+      "order": 0,
+      "parts": {
+        "0": {
+          "position": {
+            "x": 0,
+            "y": 0,
+            "rowSpan": 2,
+            "colSpan": 3
+          },
+          "metadata": {
+            "inputs": [],
+            "type": "Extension/HubsExtension/PartType/MarkdownPart",
+            "settings": {
+              "content": {
+                "settings": {
+                  "content": "$${title}",
+                  "subtitle": "",
+                  "title": ""
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  },
+  "metadata": {
+    "model": {}
+  }
+}
+DASH
+
+  template_variables {
+    title = "%s"
+  }
+
+  tags {
+    environment = "test"
+  }
+}
+`, rInt, location, rInt, title)
+}
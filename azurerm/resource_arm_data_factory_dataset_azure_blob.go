@@ -0,0 +1,196 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryDatasetAzureBlob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryDatasetAzureBlobCreateUpdate,
+		Read:   resourceArmDataFactoryDatasetAzureBlobRead,
+		Update: resourceArmDataFactoryDatasetAzureBlobCreateUpdate,
+		Delete: resourceArmDataFactoryDatasetAzureBlobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryChildName,
+			},
+
+			"data_factory_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"linked_service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAzureRMDataFactoryChildName,
+			},
+
+			"folder_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"file_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"parameters": dataFactoryParametersSchema(),
+
+			"annotations": dataFactoryAnnotationsSchema(),
+		},
+	}
+}
+
+func resourceArmDataFactoryDatasetAzureBlobCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryDatasetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Data Factory Dataset Azure Blob creation/update.")
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	dataset := &datafactory.AzureBlobDataset{
+		LinkedServiceName:              expandDataFactoryLinkedServiceReference(d.Get("linked_service_name").(string)),
+		Description:                    utils.String(d.Get("description").(string)),
+		Parameters:                     expandDataFactoryParameters(d.Get("parameters").(map[string]interface{})),
+		Annotations:                    expandDataFactoryAnnotations(d.Get("annotations").([]interface{})),
+		AzureBlobDatasetTypeProperties: &datafactory.AzureBlobDatasetTypeProperties{},
+		Type:                           datafactory.TypeAzureBlob,
+	}
+
+	if v, ok := d.GetOk("folder_path"); ok {
+		dataset.AzureBlobDatasetTypeProperties.FolderPath = v.(string)
+	}
+
+	if v, ok := d.GetOk("file_name"); ok {
+		dataset.AzureBlobDatasetTypeProperties.FileName = v.(string)
+	}
+
+	parameters := datafactory.DatasetResource{
+		Properties: dataset,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, parameters, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Dataset Azure Blob %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Dataset Azure Blob %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Dataset Azure Blob %q (Data Factory %q / Resource Group %q) ID", name, dataFactoryName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDataFactoryDatasetAzureBlobRead(d, meta)
+}
+
+func resourceArmDataFactoryDatasetAzureBlobRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryDatasetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["datasets"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Data Factory Dataset Azure Blob %q was not found in Data Factory %q / Resource Group %q - removing from state!", name, dataFactoryName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Dataset Azure Blob %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("data_factory_name", dataFactoryName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if resp.Properties == nil {
+		return fmt.Errorf("Error retrieving Data Factory Dataset Azure Blob %q (Data Factory %q / Resource Group %q): `properties` was nil", name, dataFactoryName, resourceGroup)
+	}
+
+	dataset, ok := resp.Properties.AsAzureBlobDataset()
+	if !ok {
+		return fmt.Errorf("Error classifying Data Factory Dataset %q (Data Factory %q / Resource Group %q): expected a Dataset of type %q", name, dataFactoryName, resourceGroup, datafactory.TypeAzureBlob)
+	}
+
+	d.Set("description", dataset.Description)
+	d.Set("linked_service_name", flattenDataFactoryLinkedServiceReference(dataset.LinkedServiceName))
+
+	if props := dataset.AzureBlobDatasetTypeProperties; props != nil {
+		if folderPath, ok := props.FolderPath.(string); ok {
+			d.Set("folder_path", folderPath)
+		}
+		if fileName, ok := props.FileName.(string); ok {
+			d.Set("file_name", fileName)
+		}
+	}
+
+	if err := d.Set("parameters", flattenDataFactoryParameters(dataset.Parameters)); err != nil {
+		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	}
+
+	if err := d.Set("annotations", flattenDataFactoryAnnotations(dataset.Annotations)); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryDatasetAzureBlobDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryDatasetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["datasets"]
+
+	resp, err := client.Delete(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Data Factory Dataset Azure Blob %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMDataFactoryDatasetAzureBlob_basic(t *testing.T) {
+	resourceName := "azurerm_data_factory_dataset_azure_blob.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDataFactoryDatasetAzureBlob_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryDatasetAzureBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryDatasetAzureBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "folder_path", "container/folder"),
+					resource.TestCheckResourceAttr(resourceName, "file_name", "data.csv"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDataFactoryDatasetAzureBlobExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Data Factory Dataset Azure Blob: %s", name)
+		}
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).dataFactoryDatasetClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on dataFactoryDatasetClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Data Factory Dataset Azure Blob %q (Data Factory %q / Resource Group %q) does not exist", name, dataFactoryName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDataFactoryDatasetAzureBlobDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).dataFactoryDatasetClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_factory_dataset_azure_blob" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Data Factory Dataset Azure Blob still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDataFactoryDatasetAzureBlob_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_linked_service_azure_storage" "test" {
+  name                = "acctestlsstorage%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+  connection_string   = "DefaultEndpointsProtocol=https;AccountName=acctestsa;AccountKey=dGVzdA==;EndpointSuffix=core.windows.net"
+}
+
+resource "azurerm_data_factory_dataset_azure_blob" "test" {
+  name                = "acctestds%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+  linked_service_name = "${azurerm_data_factory_linked_service_azure_storage.test.name}"
+  folder_path         = "container/folder"
+  file_name           = "data.csv"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -0,0 +1,180 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMDataFactoryLinkedServiceAzureStorage_basic(t *testing.T) {
+	resourceName := "azurerm_data_factory_linked_service_azure_storage.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDataFactoryLinkedServiceAzureStorage_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryLinkedServiceAzureStorageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryLinkedServiceAzureStorageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDataFactoryLinkedServiceAzureStorage_update(t *testing.T) {
+	resourceName := "azurerm_data_factory_linked_service_azure_storage.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryLinkedServiceAzureStorageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataFactoryLinkedServiceAzureStorage_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryLinkedServiceAzureStorageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMDataFactoryLinkedServiceAzureStorage_update(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryLinkedServiceAzureStorageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description 2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDataFactoryLinkedServiceAzureStorageExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Data Factory Linked Service Azure Storage: %s", name)
+		}
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).dataFactoryLinkedServiceClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on dataFactoryLinkedServiceClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Data Factory Linked Service Azure Storage %q (Data Factory %q / Resource Group %q) does not exist", name, dataFactoryName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDataFactoryLinkedServiceAzureStorageDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).dataFactoryLinkedServiceClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_factory_linked_service_azure_storage" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Data Factory Linked Service Azure Storage still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDataFactoryLinkedServiceAzureStorage_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_linked_service_azure_storage" "test" {
+  name                = "acctestlsstorage%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+  connection_string   = "DefaultEndpointsProtocol=https;AccountName=acctestsa;AccountKey=dGVzdA==;EndpointSuffix=core.windows.net"
+  description         = "test description"
+  annotations         = ["test1", "test2"]
+
+  parameters {
+    foo = "test1"
+    bar = "test2"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMDataFactoryLinkedServiceAzureStorage_update(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_linked_service_azure_storage" "test" {
+  name                = "acctestlsstorage%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+  connection_string   = "DefaultEndpointsProtocol=https;AccountName=acctestsa;AccountKey=dGVzdA==;EndpointSuffix=core.windows.net"
+  description         = "test description 2"
+  annotations         = ["test1", "test2", "test3"]
+
+  parameters {
+    foo  = "test1"
+    bar  = "test2"
+    buzz = "test3"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
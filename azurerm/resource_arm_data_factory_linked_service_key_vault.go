@@ -0,0 +1,183 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryLinkedServiceKeyVault() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryLinkedServiceKeyVaultCreateUpdate,
+		Read:   resourceArmDataFactoryLinkedServiceKeyVaultRead,
+		Update: resourceArmDataFactoryLinkedServiceKeyVaultCreateUpdate,
+		Delete: resourceArmDataFactoryLinkedServiceKeyVaultDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryChildName,
+			},
+
+			"data_factory_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"vault_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.UrlIsHttpOrHttps(),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"integration_runtime_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"parameters": dataFactoryParametersSchema(),
+
+			"annotations": dataFactoryAnnotationsSchema(),
+		},
+	}
+}
+
+func resourceArmDataFactoryLinkedServiceKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryLinkedServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Data Factory Linked Service Key Vault creation/update.")
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	linkedService := &datafactory.AzureKeyVaultLinkedService{
+		Description: utils.String(d.Get("description").(string)),
+		ConnectVia:  expandDataFactoryIntegrationRuntimeReference(d.Get("integration_runtime_name").(string)),
+		Parameters:  expandDataFactoryParameters(d.Get("parameters").(map[string]interface{})),
+		Annotations: expandDataFactoryAnnotations(d.Get("annotations").([]interface{})),
+		AzureKeyVaultLinkedServiceTypeProperties: &datafactory.AzureKeyVaultLinkedServiceTypeProperties{
+			BaseURL: d.Get("vault_uri").(string),
+		},
+		Type: datafactory.TypeAzureKeyVault,
+	}
+
+	parameters := datafactory.LinkedServiceResource{
+		Properties: linkedService,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, parameters, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Linked Service Key Vault %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Linked Service Key Vault %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Linked Service Key Vault %q (Data Factory %q / Resource Group %q) ID", name, dataFactoryName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDataFactoryLinkedServiceKeyVaultRead(d, meta)
+}
+
+func resourceArmDataFactoryLinkedServiceKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryLinkedServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["linkedservices"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Data Factory Linked Service Key Vault %q was not found in Data Factory %q / Resource Group %q - removing from state!", name, dataFactoryName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Linked Service Key Vault %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("data_factory_name", dataFactoryName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if resp.Properties == nil {
+		return fmt.Errorf("Error retrieving Data Factory Linked Service Key Vault %q (Data Factory %q / Resource Group %q): `properties` was nil", name, dataFactoryName, resourceGroup)
+	}
+
+	linkedService, ok := resp.Properties.AsAzureKeyVaultLinkedService()
+	if !ok {
+		return fmt.Errorf("Error classifying Data Factory Linked Service %q (Data Factory %q / Resource Group %q): expected a Linked Service of type %q", name, dataFactoryName, resourceGroup, datafactory.TypeAzureKeyVault)
+	}
+
+	d.Set("description", linkedService.Description)
+	d.Set("integration_runtime_name", flattenDataFactoryIntegrationRuntimeReference(linkedService.ConnectVia))
+
+	if props := linkedService.AzureKeyVaultLinkedServiceTypeProperties; props != nil {
+		if baseUrl, ok := props.BaseURL.(string); ok {
+			d.Set("vault_uri", baseUrl)
+		}
+	}
+
+	if err := d.Set("parameters", flattenDataFactoryParameters(linkedService.Parameters)); err != nil {
+		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	}
+
+	if err := d.Set("annotations", flattenDataFactoryAnnotations(linkedService.Annotations)); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryLinkedServiceKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryLinkedServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["linkedservices"]
+
+	resp, err := client.Delete(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Data Factory Linked Service Key Vault %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryLinkedServiceSQLServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryLinkedServiceSQLServerCreateUpdate,
+		Read:   resourceArmDataFactoryLinkedServiceSQLServerRead,
+		Update: resourceArmDataFactoryLinkedServiceSQLServerCreateUpdate,
+		Delete: resourceArmDataFactoryLinkedServiceSQLServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryChildName,
+			},
+
+			"data_factory_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			// the API returns this value encrypted, so it's not possible to read it back
+			"connection_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"key_vault_password": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"linked_service_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAzureRMDataFactoryChildName,
+						},
+
+						"secret_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"integration_runtime_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"parameters": dataFactoryParametersSchema(),
+
+			"annotations": dataFactoryAnnotationsSchema(),
+		},
+	}
+}
+
+func resourceArmDataFactoryLinkedServiceSQLServerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryLinkedServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Data Factory Linked Service SQL Server creation/update.")
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	linkedService := &datafactory.SQLServerLinkedService{
+		Description: utils.String(d.Get("description").(string)),
+		ConnectVia:  expandDataFactoryIntegrationRuntimeReference(d.Get("integration_runtime_name").(string)),
+		Parameters:  expandDataFactoryParameters(d.Get("parameters").(map[string]interface{})),
+		Annotations: expandDataFactoryAnnotations(d.Get("annotations").([]interface{})),
+		SQLServerLinkedServiceTypeProperties: &datafactory.SQLServerLinkedServiceTypeProperties{
+			ConnectionString: &datafactory.SecureString{
+				Value: utils.String(d.Get("connection_string").(string)),
+				Type:  datafactory.TypeSecureString,
+			},
+			Password: expandDataFactoryLinkedServiceSQLServerKeyVaultPassword(d.Get("key_vault_password").([]interface{})),
+		},
+		Type: datafactory.TypeSQLServer,
+	}
+
+	parameters := datafactory.LinkedServiceResource{
+		Properties: linkedService,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, parameters, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Linked Service SQL Server %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Linked Service SQL Server %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Linked Service SQL Server %q (Data Factory %q / Resource Group %q) ID", name, dataFactoryName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDataFactoryLinkedServiceSQLServerRead(d, meta)
+}
+
+func resourceArmDataFactoryLinkedServiceSQLServerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryLinkedServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["linkedservices"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Data Factory Linked Service SQL Server %q was not found in Data Factory %q / Resource Group %q - removing from state!", name, dataFactoryName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Linked Service SQL Server %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("data_factory_name", dataFactoryName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if resp.Properties == nil {
+		return fmt.Errorf("Error retrieving Data Factory Linked Service SQL Server %q (Data Factory %q / Resource Group %q): `properties` was nil", name, dataFactoryName, resourceGroup)
+	}
+
+	linkedService, ok := resp.Properties.AsSQLServerLinkedService()
+	if !ok {
+		return fmt.Errorf("Error classifying Data Factory Linked Service %q (Data Factory %q / Resource Group %q): expected a Linked Service of type %q", name, dataFactoryName, resourceGroup, datafactory.TypeSQLServer)
+	}
+
+	d.Set("description", linkedService.Description)
+	d.Set("integration_runtime_name", flattenDataFactoryIntegrationRuntimeReference(linkedService.ConnectVia))
+
+	if props := linkedService.SQLServerLinkedServiceTypeProperties; props != nil {
+		if err := d.Set("key_vault_password", flattenDataFactoryLinkedServiceSQLServerKeyVaultPassword(props.Password)); err != nil {
+			return fmt.Errorf("Error setting `key_vault_password`: %+v", err)
+		}
+	}
+
+	if err := d.Set("parameters", flattenDataFactoryParameters(linkedService.Parameters)); err != nil {
+		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	}
+
+	if err := d.Set("annotations", flattenDataFactoryAnnotations(linkedService.Annotations)); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryLinkedServiceSQLServerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryLinkedServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["linkedservices"]
+
+	resp, err := client.Delete(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Data Factory Linked Service SQL Server %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandDataFactoryLinkedServiceSQLServerKeyVaultPassword(input []interface{}) datafactory.BasicSecretBase {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	linkedServiceName := v["linked_service_name"].(string)
+	secretName := v["secret_name"].(string)
+
+	return &datafactory.AzureKeyVaultSecretReference{
+		Store:      expandDataFactoryLinkedServiceReference(linkedServiceName),
+		SecretName: secretName,
+		Type:       datafactory.TypeAzureKeyVaultSecret,
+	}
+}
+
+func flattenDataFactoryLinkedServiceSQLServerKeyVaultPassword(input datafactory.BasicSecretBase) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	reference, ok := input.AsAzureKeyVaultSecretReference()
+	if !ok {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	output["linked_service_name"] = flattenDataFactoryLinkedServiceReference(reference.Store)
+	if secretName, ok := reference.SecretName.(string); ok {
+		output["secret_name"] = secretName
+	}
+
+	return []interface{}{output}
+}
@@ -0,0 +1,224 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryPipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryPipelineCreateUpdate,
+		Read:   resourceArmDataFactoryPipelineRead,
+		Update: resourceArmDataFactoryPipelineCreateUpdate,
+		Delete: resourceArmDataFactoryPipelineDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryChildName,
+			},
+
+			"data_factory_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"activities_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressDataFactoryJsonDiff,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+
+			"parameters": dataFactoryParametersSchema(),
+
+			"annotations": dataFactoryAnnotationsSchema(),
+		},
+	}
+}
+
+func resourceArmDataFactoryPipelineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryPipelineClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Data Factory Pipeline creation/update.")
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	activities, err := expandDataFactoryPipelineActivities(d.Get("activities_json").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing `activities_json` for Data Factory Pipeline %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	pipeline := &datafactory.Pipeline{
+		Activities:  activities,
+		Description: utils.String(d.Get("description").(string)),
+		Parameters:  expandDataFactoryParameters(d.Get("parameters").(map[string]interface{})),
+		Annotations: expandDataFactoryAnnotations(d.Get("annotations").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("concurrency"); ok {
+		pipeline.Concurrency = utils.Int32(int32(v.(int)))
+	}
+
+	parameters := datafactory.PipelineResource{
+		Pipeline: pipeline,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, parameters, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Pipeline %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Pipeline %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Pipeline %q (Data Factory %q / Resource Group %q) ID", name, dataFactoryName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDataFactoryPipelineRead(d, meta)
+}
+
+func resourceArmDataFactoryPipelineRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryPipelineClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["pipelines"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Data Factory Pipeline %q was not found in Data Factory %q / Resource Group %q - removing from state!", name, dataFactoryName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Pipeline %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("data_factory_name", dataFactoryName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.Pipeline; props != nil {
+		d.Set("description", props.Description)
+
+		if concurrency := props.Concurrency; concurrency != nil {
+			d.Set("concurrency", int(*concurrency))
+		}
+
+		activitiesJson, err := flattenDataFactoryPipelineActivities(props.Activities)
+		if err != nil {
+			return fmt.Errorf("Error flattening `activities_json`: %+v", err)
+		}
+		d.Set("activities_json", activitiesJson)
+
+		if err := d.Set("parameters", flattenDataFactoryParameters(props.Parameters)); err != nil {
+			return fmt.Errorf("Error setting `parameters`: %+v", err)
+		}
+
+		if err := d.Set("annotations", flattenDataFactoryAnnotations(props.Annotations)); err != nil {
+			return fmt.Errorf("Error setting `annotations`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryPipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryPipelineClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["pipelines"]
+
+	resp, err := client.Delete(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Data Factory Pipeline %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// expandDataFactoryPipelineActivities parses the JSON array of Activities using the SDK's
+// discriminator logic, so that each Activity is deserialized into the correct type
+func expandDataFactoryPipelineActivities(input string) (*[]datafactory.BasicActivity, error) {
+	var activities []interface{}
+	if err := json.Unmarshal([]byte(input), &activities); err != nil {
+		return nil, fmt.Errorf("`activities_json` must be a JSON array: %+v", err)
+	}
+
+	wrapper := map[string]interface{}{
+		"activities": activities,
+	}
+	body, err := json.Marshal(wrapper)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := datafactory.Pipeline{}
+	if err := json.Unmarshal(body, &pipeline); err != nil {
+		return nil, err
+	}
+
+	return pipeline.Activities, nil
+}
+
+func flattenDataFactoryPipelineActivities(input *[]datafactory.BasicActivity) (string, error) {
+	if input == nil {
+		return "[]", nil
+	}
+
+	result, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
@@ -0,0 +1,196 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMDataFactoryPipeline_basic(t *testing.T) {
+	resourceName := "azurerm_data_factory_pipeline.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDataFactoryPipeline_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryPipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDataFactoryPipeline_update(t *testing.T) {
+	resourceName := "azurerm_data_factory_pipeline.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataFactoryPipeline_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryPipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMDataFactoryPipeline_update(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryPipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDataFactoryPipelineExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Data Factory Pipeline: %s", name)
+		}
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).dataFactoryPipelineClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on dataFactoryPipelineClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Data Factory Pipeline %q (Data Factory %q / Resource Group %q) does not exist", name, dataFactoryName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDataFactoryPipelineDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).dataFactoryPipelineClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_factory_pipeline" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Data Factory Pipeline still exists:\n%#v", resp.Pipeline)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDataFactoryPipeline_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctestpipeline%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+
+  parameters {
+    test = "testparameter"
+  }
+
+  activities_json = <<JSON
+[
+  {
+    "name": "Wait1",
+    "type": "Wait",
+    "typeProperties": {
+      "waitTimeInSeconds": 10
+    }
+  }
+]
+JSON
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMDataFactoryPipeline_update(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctestpipeline%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+  description         = "updated"
+  concurrency         = 2
+
+  parameters {
+    test  = "testparameter"
+    test2 = "testparameter2"
+  }
+
+  activities_json = <<JSON
+[
+  {
+    "name": "Wait1",
+    "type": "Wait",
+    "typeProperties": {
+      "waitTimeInSeconds": 30
+    }
+  }
+]
+JSON
+}
+`, rInt, location, rInt, rInt)
+}
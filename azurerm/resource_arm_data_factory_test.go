@@ -0,0 +1,338 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMDataFactory_basic(t *testing.T) {
+	resourceName := "azurerm_data_factory.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDataFactory_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDataFactory_tags(t *testing.T) {
+	resourceName := "azurerm_data_factory.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataFactory_tags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "production"),
+				),
+			},
+			{
+				Config: testAccAzureRMDataFactory_tagsUpdated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.updated", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDataFactory_identity(t *testing.T) {
+	resourceName := "azurerm_data_factory.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDataFactory_identity(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "SystemAssigned"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.tenant_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMDataFactoryName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "ab",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abc",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello-world",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello--world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-helloworld",
+			ErrCount: 1,
+		},
+		{
+			Value:    "helloworld-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello_world",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 64),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAzureRMDataFactoryName(tc.Value, "azurerm_data_factory")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Data Factory Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestAzureRMDataFactoryChildName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "_pipeline-1 copy",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-pipeline",
+			ErrCount: 1,
+		},
+		{
+			Value:    "pipeline.1",
+			ErrCount: 1,
+		},
+		{
+			Value:    "pipeline/1",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 261),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAzureRMDataFactoryChildName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Data Factory Child Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestAzureRMDataFactoryJsonDiff_suppress(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Old:      `[{"name":"Wait1","type":"Wait"}]`,
+			New:      `[ { "type": "Wait", "name": "Wait1" } ]`,
+			Suppress: true,
+		},
+		{
+			Old:      `[{"name":"Wait1","type":"Wait","dependsOn":[],"userProperties":[]}]`,
+			New:      `[{"name":"Wait1","type":"Wait"}]`,
+			Suppress: true,
+		},
+		{
+			Old:      `[{"name":"Wait1","type":"Wait"}]`,
+			New:      `[{"name":"Wait2","type":"Wait"}]`,
+			Suppress: false,
+		},
+		{
+			Old:      `[{"name":"Wait1"}]`,
+			New:      `not json`,
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if suppressDataFactoryJsonDiff("activities_json", tc.Old, tc.New, nil) != tc.Suppress {
+			t.Fatalf("Expected the diff between %q and %q to be suppressed: %t", tc.Old, tc.New, tc.Suppress)
+		}
+	}
+}
+
+func testCheckAzureRMDataFactoryExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Data Factory: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).dataFactoryClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on dataFactoryClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Data Factory %q (Resource Group %q) does not exist", name, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDataFactoryDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).dataFactoryClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_factory" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Data Factory still exists:\n%#v", resp.FactoryProperties)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDataFactory_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDataFactory_tags(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    environment = "production"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDataFactory_tagsUpdated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    environment = "production"
+    updated     = "true"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDataFactory_identity(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,326 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryTriggerSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryTriggerScheduleCreateUpdate,
+		Read:   resourceArmDataFactoryTriggerScheduleRead,
+		Update: resourceArmDataFactoryTriggerScheduleCreateUpdate,
+		Delete: resourceArmDataFactoryTriggerScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryChildName,
+			},
+
+			"data_factory_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"pipeline_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAzureRMDataFactoryChildName,
+			},
+
+			"pipeline_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(datafactory.Minute),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datafactory.Minute),
+					string(datafactory.Hour),
+					string(datafactory.Day),
+					string(datafactory.Week),
+					string(datafactory.Month),
+				}, false),
+			},
+
+			"interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"start_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validate.RFC3339Time,
+			},
+
+			"end_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validate.RFC3339Time,
+			},
+
+			"time_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"activated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryTriggerScheduleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryTriggerClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Data Factory Trigger Schedule creation/update.")
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	// a Trigger can only be updated once it's been stopped
+	if !d.IsNewResource() {
+		if err := stopDataFactoryTrigger(ctx, client, resourceGroup, dataFactoryName, name); err != nil {
+			return err
+		}
+	}
+
+	recurrence := &datafactory.ScheduleTriggerRecurrence{
+		Frequency: datafactory.RecurrenceFrequency(d.Get("frequency").(string)),
+		Interval:  utils.Int32(int32(d.Get("interval").(int))),
+	}
+
+	if v, ok := d.GetOk("start_time"); ok {
+		startTime, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		recurrence.StartTime = &date.Time{Time: startTime}
+	} else {
+		recurrence.StartTime = &date.Time{Time: time.Now()}
+	}
+
+	if v, ok := d.GetOk("end_time"); ok {
+		endTime, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		recurrence.EndTime = &date.Time{Time: endTime}
+	}
+
+	if v, ok := d.GetOk("time_zone"); ok {
+		recurrence.TimeZone = utils.String(v.(string))
+	}
+
+	trigger := &datafactory.ScheduleTrigger{
+		Description: utils.String(d.Get("description").(string)),
+		ScheduleTriggerTypeProperties: &datafactory.ScheduleTriggerTypeProperties{
+			Recurrence: recurrence,
+		},
+		Pipelines: &[]datafactory.TriggerPipelineReference{
+			{
+				PipelineReference: &datafactory.PipelineReference{
+					Type:          utils.String("PipelineReference"),
+					ReferenceName: utils.String(d.Get("pipeline_name").(string)),
+				},
+				Parameters: d.Get("pipeline_parameters").(map[string]interface{}),
+			},
+		},
+		Type: datafactory.TypeScheduleTrigger,
+	}
+
+	parameters := datafactory.TriggerResource{
+		Properties: trigger,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, parameters, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Trigger Schedule %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	if d.Get("activated").(bool) {
+		future, err := client.Start(ctx, resourceGroup, dataFactoryName, name)
+		if err != nil {
+			return fmt.Errorf("Error starting Data Factory Trigger Schedule %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+		}
+
+		if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Data Factory Trigger Schedule %q (Data Factory %q / Resource Group %q) to start: %+v", name, dataFactoryName, resourceGroup, err)
+		}
+	}
+
+	read, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Trigger Schedule %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Trigger Schedule %q (Data Factory %q / Resource Group %q) ID", name, dataFactoryName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDataFactoryTriggerScheduleRead(d, meta)
+}
+
+func resourceArmDataFactoryTriggerScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryTriggerClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["triggers"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Data Factory Trigger Schedule %q was not found in Data Factory %q / Resource Group %q - removing from state!", name, dataFactoryName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Trigger Schedule %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("data_factory_name", dataFactoryName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if resp.Properties == nil {
+		return fmt.Errorf("Error retrieving Data Factory Trigger Schedule %q (Data Factory %q / Resource Group %q): `properties` was nil", name, dataFactoryName, resourceGroup)
+	}
+
+	trigger, ok := resp.Properties.AsScheduleTrigger()
+	if !ok {
+		return fmt.Errorf("Error classifying Data Factory Trigger %q (Data Factory %q / Resource Group %q): expected a Trigger of type %q", name, dataFactoryName, resourceGroup, datafactory.TypeScheduleTrigger)
+	}
+
+	d.Set("description", trigger.Description)
+	d.Set("activated", trigger.RuntimeState == datafactory.TriggerRuntimeStateStarted)
+
+	if props := trigger.ScheduleTriggerTypeProperties; props != nil {
+		if recurrence := props.Recurrence; recurrence != nil {
+			d.Set("frequency", string(recurrence.Frequency))
+			if interval := recurrence.Interval; interval != nil {
+				d.Set("interval", int(*interval))
+			}
+			if startTime := recurrence.StartTime; startTime != nil {
+				d.Set("start_time", startTime.Format(time.RFC3339))
+			}
+			if endTime := recurrence.EndTime; endTime != nil {
+				d.Set("end_time", endTime.Format(time.RFC3339))
+			}
+			d.Set("time_zone", recurrence.TimeZone)
+		}
+	}
+
+	if pipelines := trigger.Pipelines; pipelines != nil && len(*pipelines) > 0 {
+		pipeline := (*pipelines)[0]
+		if reference := pipeline.PipelineReference; reference != nil {
+			d.Set("pipeline_name", reference.ReferenceName)
+		}
+
+		if err := d.Set("pipeline_parameters", pipeline.Parameters); err != nil {
+			return fmt.Errorf("Error setting `pipeline_parameters`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryTriggerScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactoryTriggerClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["triggers"]
+
+	// a Trigger can only be deleted once it's been stopped
+	if err := stopDataFactoryTrigger(ctx, client, resourceGroup, dataFactoryName, name); err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Data Factory Trigger Schedule %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func stopDataFactoryTrigger(ctx context.Context, client datafactory.TriggersClient, resourceGroup, dataFactoryName, name string) error {
+	read, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Trigger %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	if read.Properties == nil {
+		return nil
+	}
+
+	trigger, ok := read.Properties.AsScheduleTrigger()
+	if !ok || trigger.RuntimeState != datafactory.TriggerRuntimeStateStarted {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Stopping Data Factory Trigger %q (Data Factory %q / Resource Group %q)", name, dataFactoryName, resourceGroup)
+	future, err := client.Stop(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		return fmt.Errorf("Error stopping Data Factory Trigger %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Data Factory Trigger %q (Data Factory %q / Resource Group %q) to stop: %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	return nil
+}
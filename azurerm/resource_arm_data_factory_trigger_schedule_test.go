@@ -0,0 +1,206 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMDataFactoryTriggerSchedule_basic(t *testing.T) {
+	resourceName := "azurerm_data_factory_trigger_schedule.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDataFactoryTriggerSchedule_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryTriggerScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryTriggerScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "Day"),
+					resource.TestCheckResourceAttr(resourceName, "activated", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDataFactoryTriggerSchedule_update(t *testing.T) {
+	resourceName := "azurerm_data_factory_trigger_schedule.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryTriggerScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataFactoryTriggerSchedule_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryTriggerScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "Day"),
+					resource.TestCheckResourceAttr(resourceName, "activated", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMDataFactoryTriggerSchedule_update(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryTriggerScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "Hour"),
+					resource.TestCheckResourceAttr(resourceName, "interval", "4"),
+					resource.TestCheckResourceAttr(resourceName, "activated", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDataFactoryTriggerScheduleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Data Factory Trigger Schedule: %s", name)
+		}
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).dataFactoryTriggerClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on dataFactoryTriggerClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Data Factory Trigger Schedule %q (Data Factory %q / Resource Group %q) does not exist", name, dataFactoryName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDataFactoryTriggerScheduleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).dataFactoryTriggerClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_factory_trigger_schedule" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Data Factory Trigger Schedule still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDataFactoryTriggerSchedule_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctestpipeline%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+
+  activities_json = <<JSON
+[
+  {
+    "name": "Wait1",
+    "type": "Wait",
+    "typeProperties": {
+      "waitTimeInSeconds": 10
+    }
+  }
+]
+JSON
+}
+
+resource "azurerm_data_factory_trigger_schedule" "test" {
+  name                = "acctesttrigger%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+  pipeline_name       = "${azurerm_data_factory_pipeline.test.name}"
+  frequency           = "Day"
+  interval            = 1
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMDataFactoryTriggerSchedule_update(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctestpipeline%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+
+  activities_json = <<JSON
+[
+  {
+    "name": "Wait1",
+    "type": "Wait",
+    "typeProperties": {
+      "waitTimeInSeconds": 10
+    }
+  }
+]
+JSON
+}
+
+resource "azurerm_data_factory_trigger_schedule" "test" {
+  name                = "acctesttrigger%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+  pipeline_name       = "${azurerm_data_factory_pipeline.test.name}"
+  frequency           = "Hour"
+  interval            = 4
+  activated           = false
+}
+`, rInt, location, rInt, rInt, rInt)
+}
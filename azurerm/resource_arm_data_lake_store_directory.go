@@ -0,0 +1,208 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/datalake/store/2016-11-01/filesystem"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataLakeStoreDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataLakeStoreDirectoryCreate,
+		Read:   resourceArmDataLakeStoreDirectoryRead,
+		Update: resourceArmDataLakeStoreDirectoryUpdate,
+		Delete: resourceArmDataLakeStoreDirectoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"remote_directory_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFilePath(),
+			},
+
+			"permission": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDataLakeStoreDirectoryPermission,
+			},
+
+			"recursive_delete_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmDataLakeStoreDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeStoreFilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Data Lake Store Directory creation.")
+
+	accountName := d.Get("account_name").(string)
+	remoteDirectoryPath := d.Get("remote_directory_path").(string)
+
+	// creating a Directory which already exists succeeds - so we check for it to avoid silently taking it over
+	existing, err := client.GetFileStatus(ctx, accountName, remoteDirectoryPath, utils.Bool(false))
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for the presence of Data Lake Store Directory %q (Account %q): %+v", remoteDirectoryPath, accountName, err)
+		}
+	} else {
+		return fmt.Errorf("Data Lake Store Directory %q (Account %q) already exists - to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information.", remoteDirectoryPath, accountName, "azurerm_data_lake_store_directory")
+	}
+
+	var permission *int32
+	if v, ok := d.GetOk("permission"); ok {
+		// the permission is sent as the octal digits (e.g. `750`) rather than the value they represent
+		parsed, err := strconv.ParseInt(v.(string), 10, 32)
+		if err != nil {
+			return fmt.Errorf("Error parsing `permission` %q: %+v", v.(string), err)
+		}
+		permission = utils.Int32(int32(parsed))
+	}
+
+	// any missing parent Directories are also created
+	resp, err := client.Mkdirs(ctx, accountName, remoteDirectoryPath, permission)
+	if err != nil {
+		return fmt.Errorf("Error issuing create request for Data Lake Store Directory %q (Account %q): %+v", remoteDirectoryPath, accountName, err)
+	}
+	if resp.OperationResult != nil && !*resp.OperationResult {
+		return fmt.Errorf("Error creating Data Lake Store Directory %q (Account %q): the Directory wasn't created", remoteDirectoryPath, accountName)
+	}
+
+	// example.azuredatalakestore.net/test/example
+	id := fmt.Sprintf("%s.%s%s", accountName, client.AdlsFileSystemDNSSuffix, remoteDirectoryPath)
+	d.SetId(id)
+	return resourceArmDataLakeStoreDirectoryRead(d, meta)
+}
+
+func resourceArmDataLakeStoreDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeStoreFilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseDataLakeStoreFileId(d.Id(), client.AdlsFileSystemDNSSuffix)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("permission") {
+		permission := d.Get("permission").(string)
+		if _, err := client.SetPermission(ctx, id.storageAccountName, id.filePath, permission); err != nil {
+			return fmt.Errorf("Error updating Permission for Data Lake Store Directory %q (Account %q): %+v", id.filePath, id.storageAccountName, err)
+		}
+	}
+
+	return resourceArmDataLakeStoreDirectoryRead(d, meta)
+}
+
+func resourceArmDataLakeStoreDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeStoreFilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseDataLakeStoreFileId(d.Id(), client.AdlsFileSystemDNSSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetFileStatus(ctx, id.storageAccountName, id.filePath, utils.Bool(false))
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Data Lake Store Directory %q was not found (Account %q) - removing from state!", id.filePath, id.storageAccountName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Azure Data Lake Store Directory %q (Account %q): %+v", id.filePath, id.storageAccountName, err)
+	}
+
+	d.Set("account_name", id.storageAccountName)
+	d.Set("remote_directory_path", id.filePath)
+
+	if props := resp.FileStatus; props != nil {
+		if props.Type != filesystem.DIRECTORY {
+			return fmt.Errorf("Error: %q (Account %q) is a %s rather than a Directory", id.filePath, id.storageAccountName, string(props.Type))
+		}
+
+		d.Set("permission", props.Permission)
+		d.Set("owner", props.Owner)
+		d.Set("group", props.Group)
+	}
+
+	return nil
+}
+
+func resourceArmDataLakeStoreDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeStoreFilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseDataLakeStoreFileId(d.Id(), client.AdlsFileSystemDNSSuffix)
+	if err != nil {
+		return err
+	}
+
+	recursive := d.Get("recursive_delete_enabled").(bool)
+	if !recursive {
+		// to avoid deleting data which isn't managed by Terraform, the Directory can only be deleted when it's empty
+		contents, err := client.ListFileStatus(ctx, id.storageAccountName, id.filePath, utils.Int32(1), "", "", utils.Bool(false))
+		if err != nil {
+			if utils.ResponseWasNotFound(contents.Response) {
+				return nil
+			}
+
+			return fmt.Errorf("Error listing the contents of Data Lake Store Directory %q (Account %q): %+v", id.filePath, id.storageAccountName, err)
+		}
+
+		if statuses := contents.FileStatuses; statuses != nil && statuses.FileStatus != nil && len(*statuses.FileStatus) > 0 {
+			return fmt.Errorf("Error deleting Data Lake Store Directory %q (Account %q): the Directory isn't empty - set `recursive_delete_enabled` to `true` to delete it along with its contents", id.filePath, id.storageAccountName)
+		}
+	}
+
+	resp, err := client.Delete(ctx, id.storageAccountName, id.filePath, utils.Bool(recursive))
+	if err != nil {
+		if !response.WasNotFound(resp.Response.Response) {
+			return fmt.Errorf("Error issuing delete request for Data Lake Store Directory %q (Account %q): %+v", id.filePath, id.storageAccountName, err)
+		}
+	}
+
+	return nil
+}
+
+func validateDataLakeStoreDirectoryPermission(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[0-7]{3}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be the permissions for the owner, group and others in octal notation (e.g. `750`)", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,198 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDataLakeStoreDirectory_basic(t *testing.T) {
+	resourceName := "azurerm_data_lake_store_directory.test"
+
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataLakeStoreDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataLakeStoreDirectory_basic(ri, rs, testLocation(), "750"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeStoreDirectoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permission", "750"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+					resource.TestCheckResourceAttrSet(resourceName, "group"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"recursive_delete_enabled"},
+			},
+			{
+				Config: testAccAzureRMDataLakeStoreDirectory_basic(ri, rs, testLocation(), "770"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeStoreDirectoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permission", "770"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDataLakeStoreDirectory_recursiveDelete(t *testing.T) {
+	resourceName := "azurerm_data_lake_store_directory.test"
+
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataLakeStoreDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataLakeStoreDirectory_recursiveDelete(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeStoreDirectoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "recursive_delete_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateDataLakeStoreDirectoryPermission(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "750", ErrCount: 0},
+		{Value: "000", ErrCount: 0},
+		{Value: "777", ErrCount: 0},
+		{Value: "", ErrCount: 1},
+		{Value: "75", ErrCount: 1},
+		{Value: "0750", ErrCount: 1},
+		{Value: "780", ErrCount: 1},
+		{Value: "rwx", ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateDataLakeStoreDirectoryPermission(tc.Value, "permission")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMDataLakeStoreDirectoryExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		remoteDirectoryPath := rs.Primary.Attributes["remote_directory_path"]
+		accountName := rs.Primary.Attributes["account_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).dataLakeStoreFilesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := conn.GetFileStatus(ctx, accountName, remoteDirectoryPath, utils.Bool(true))
+		if err != nil {
+			return fmt.Errorf("Bad: Get on dataLakeStoreFilesClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Data Lake Store Directory %q (Account %q) does not exist", remoteDirectoryPath, accountName)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDataLakeStoreDirectoryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).dataLakeStoreFilesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_lake_store_directory" {
+			continue
+		}
+
+		remoteDirectoryPath := rs.Primary.Attributes["remote_directory_path"]
+		accountName := rs.Primary.Attributes["account_name"]
+
+		resp, err := conn.GetFileStatus(ctx, accountName, remoteDirectoryPath, utils.Bool(true))
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Data Lake Store Directory still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDataLakeStoreDirectory_basic(rInt int, rs, location, permission string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_lake_store" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "%s"
+  firewall_state      = "Disabled"
+}
+
+resource "azurerm_data_lake_store_directory" "test" {
+  account_name          = "${azurerm_data_lake_store.test.name}"
+  remote_directory_path = "/test/nested/directory"
+  permission            = "%s"
+}
+`, rInt, location, rs, location, permission)
+}
+
+func testAccAzureRMDataLakeStoreDirectory_recursiveDelete(rInt int, rs, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_lake_store" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "%s"
+  firewall_state      = "Disabled"
+}
+
+resource "azurerm_data_lake_store_directory" "test" {
+  account_name             = "${azurerm_data_lake_store.test.name}"
+  remote_directory_path    = "/test"
+  recursive_delete_enabled = true
+}
+
+resource "azurerm_data_lake_store_file" "test" {
+  account_name     = "${azurerm_data_lake_store_directory.test.account_name}"
+  remote_file_path = "${azurerm_data_lake_store_directory.test.remote_directory_path}/application_gateway_test.cer"
+  local_file_path  = "./testdata/application_gateway_test.cer"
+}
+`, rInt, location, rs, location)
+}
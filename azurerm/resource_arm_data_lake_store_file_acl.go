@@ -0,0 +1,31 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmDataLakeStoreFileACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataLakeStoreFileACLCreateUpdate,
+		Read:   resourceArmDataLakeStoreFileACLRead,
+		Update: resourceArmDataLakeStoreFileACLCreateUpdate,
+		Delete: resourceArmDataLakeStoreFileACLDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: dataLakeStoreACLSchema("remote_file_path", false),
+	}
+}
+
+func resourceArmDataLakeStoreFileACLCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceArmDataLakeStoreACLCreateUpdate(d, meta, "remote_file_path")
+}
+
+func resourceArmDataLakeStoreFileACLRead(d *schema.ResourceData, meta interface{}) error {
+	return resourceArmDataLakeStoreACLRead(d, meta, "remote_file_path")
+}
+
+func resourceArmDataLakeStoreFileACLDelete(d *schema.ResourceData, meta interface{}) error {
+	return resourceArmDataLakeStoreACLDelete(d, meta, false)
+}
@@ -0,0 +1,124 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDataLakeStoreFileACL_basic(t *testing.T) {
+	resourceName := "azurerm_data_lake_store_file_acl.test"
+
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataLakeStoreFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataLakeStoreFileACL_basic(ri, rs, testLocation(), "r--"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeStoreACLEntryExists(resourceName, "remote_file_path", "r--"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+					resource.TestCheckResourceAttrSet(resourceName, "group"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMDataLakeStoreFileACL_basic(ri, rs, testLocation(), "rw-"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeStoreACLEntryExists(resourceName, "remote_file_path", "rw-"),
+				),
+			},
+		},
+	})
+}
+
+// testCheckAzureRMDataLakeStoreACLEntryExists checks the ACL for the path contains an entry for the current Service Principal
+func testCheckAzureRMDataLakeStoreACLEntryExists(name string, pathField string, permissions string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		path := rs.Primary.Attributes[pathField]
+		accountName := rs.Primary.Attributes["account_name"]
+
+		config, ok := s.RootModule().Resources["data.azurerm_client_config.current"]
+		if !ok {
+			return fmt.Errorf("Not found: data.azurerm_client_config.current")
+		}
+		objectId := config.Primary.Attributes["service_principal_object_id"]
+
+		conn := testAccProvider.Meta().(*ArmClient).dataLakeStoreFilesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := conn.GetACLStatus(ctx, accountName, path, utils.Bool(false))
+		if err != nil {
+			return fmt.Errorf("Bad: GetACLStatus on dataLakeStoreFilesClient: %+v", err)
+		}
+
+		if resp.ACLStatus == nil || resp.ACLStatus.Entries == nil {
+			return fmt.Errorf("Bad: ACL for %q (Account %q) has no entries", path, accountName)
+		}
+
+		expected := fmt.Sprintf("user:%s:%s", objectId, permissions)
+		for _, entry := range *resp.ACLStatus.Entries {
+			if entry == expected {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Bad: ACL for %q (Account %q) doesn't contain %q", path, accountName, expected)
+	}
+}
+
+func testAccAzureRMDataLakeStoreFileACL_basic(rInt int, rs, location, permissions string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_data_lake_store_file_acl" "test" {
+  account_name     = "${azurerm_data_lake_store_file.test.account_name}"
+  remote_file_path = "${azurerm_data_lake_store_file.test.remote_file_path}"
+
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+
+  ace {
+    type        = "user"
+    id          = "${data.azurerm_client_config.current.service_principal_object_id}"
+    permissions = "%s"
+  }
+
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "mask"
+    permissions = "rwx"
+  }
+
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+}
+`, testAccAzureRMDataLakeStoreFile_basic(rInt, rs, location), permissions)
+}
@@ -0,0 +1,31 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmDataLakeStoreFolderACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataLakeStoreFolderACLCreateUpdate,
+		Read:   resourceArmDataLakeStoreFolderACLRead,
+		Update: resourceArmDataLakeStoreFolderACLCreateUpdate,
+		Delete: resourceArmDataLakeStoreFolderACLDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: dataLakeStoreACLSchema("remote_folder_path", true),
+	}
+}
+
+func resourceArmDataLakeStoreFolderACLCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceArmDataLakeStoreACLCreateUpdate(d, meta, "remote_folder_path")
+}
+
+func resourceArmDataLakeStoreFolderACLRead(d *schema.ResourceData, meta interface{}) error {
+	return resourceArmDataLakeStoreACLRead(d, meta, "remote_folder_path")
+}
+
+func resourceArmDataLakeStoreFolderACLDelete(d *schema.ResourceData, meta interface{}) error {
+	return resourceArmDataLakeStoreACLDelete(d, meta, true)
+}
@@ -0,0 +1,82 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMDataLakeStoreFolderACL_defaultScope(t *testing.T) {
+	resourceName := "azurerm_data_lake_store_folder_acl.test"
+
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataLakeStoreFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataLakeStoreFolderACL_defaultScope(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeStoreACLEntryExists(resourceName, "remote_folder_path", "r-x"),
+					resource.TestCheckResourceAttr(resourceName, "remote_folder_path", "/test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMDataLakeStoreFolderACL_defaultScope(rInt int, rs, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_data_lake_store_folder_acl" "test" {
+  account_name       = "${azurerm_data_lake_store_file.test.account_name}"
+  remote_folder_path = "/test"
+
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+
+  ace {
+    type        = "user"
+    id          = "${data.azurerm_client_config.current.service_principal_object_id}"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "mask"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+
+  ace {
+    scope       = "default"
+    type        = "user"
+    id          = "${data.azurerm_client_config.current.service_principal_object_id}"
+    permissions = "r-x"
+  }
+}
+`, testAccAzureRMDataLakeStoreFile_basic(rInt, rs, location))
+}
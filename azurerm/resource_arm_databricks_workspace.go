@@ -0,0 +1,300 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/databricks/mgmt/2018-04-01/databricks"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDatabricksWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDatabricksWorkspaceCreateUpdate,
+		Read:   resourceArmDatabricksWorkspaceRead,
+		Update: resourceArmDatabricksWorkspaceCreateUpdate,
+		Delete: resourceArmDatabricksWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDatabricksWorkspaceName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"sku": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"standard",
+					"premium",
+				}, false),
+			},
+
+			"managed_resource_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"custom_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"virtual_network_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"public_subnet_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"private_subnet_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+
+			"managed_resource_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).databricksWorkspacesClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for Databricks Workspace creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	skuName := d.Get("sku").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	managedResourceGroupName := d.Get("managed_resource_group_name").(string)
+	if managedResourceGroupName == "" {
+		// this is the default naming used by the Portal
+		managedResourceGroupName = fmt.Sprintf("databricks-rg-%s", resourceGroup)
+	}
+	managedResourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionId, managedResourceGroupName)
+
+	properties := databricks.WorkspaceProperties{
+		ManagedResourceGroupID: utils.String(managedResourceGroupId),
+	}
+
+	if customParameters := expandDatabricksWorkspaceCustomParameters(d.Get("custom_parameters").([]interface{})); customParameters != nil {
+		properties.Parameters = customParameters
+	}
+
+	parameters := databricks.Workspace{
+		Location: utils.String(location),
+		Sku: &databricks.Sku{
+			Name: utils.String(skuName),
+		},
+		WorkspaceProperties: &properties,
+		Tags:                expandTags(tags, meta),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, parameters, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Databricks Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Databricks", "workspaces", name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Databricks Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Databricks Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Databricks Workspace %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDatabricksWorkspaceRead(d, meta)
+}
+
+func resourceArmDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).databricksWorkspacesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["workspaces"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Databricks Workspace %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Databricks Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", sku.Name)
+	}
+
+	if props := resp.WorkspaceProperties; props != nil {
+		managedResourceGroupId := ""
+		managedResourceGroupName := ""
+		if props.ManagedResourceGroupID != nil {
+			managedResourceGroupId = *props.ManagedResourceGroupID
+
+			managedResourceGroup, err := parseAzureResourceID(managedResourceGroupId)
+			if err != nil {
+				return err
+			}
+			managedResourceGroupName = managedResourceGroup.ResourceGroup
+		}
+		d.Set("managed_resource_group_id", managedResourceGroupId)
+		d.Set("managed_resource_group_name", managedResourceGroupName)
+
+		if err := d.Set("custom_parameters", flattenDatabricksWorkspaceCustomParameters(props.Parameters)); err != nil {
+			return fmt.Errorf("Error setting `custom_parameters`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDatabricksWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).databricksWorkspacesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["workspaces"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Databricks Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Databricks Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandDatabricksWorkspaceCustomParameters(input []interface{}) map[string]interface{} {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	// the API expects each parameter to be wrapped in a `value` object
+	return map[string]interface{}{
+		"customVirtualNetworkId": map[string]interface{}{
+			"value": v["virtual_network_id"].(string),
+		},
+		"customPublicSubnetName": map[string]interface{}{
+			"value": v["public_subnet_name"].(string),
+		},
+		"customPrivateSubnetName": map[string]interface{}{
+			"value": v["private_subnet_name"].(string),
+		},
+	}
+}
+
+func flattenDatabricksWorkspaceCustomParameters(input interface{}) []interface{} {
+	parameters, ok := input.(map[string]interface{})
+	if !ok {
+		return []interface{}{}
+	}
+
+	getValue := func(key string) string {
+		if parameter, ok := parameters[key].(map[string]interface{}); ok {
+			if value, ok := parameter["value"].(string); ok {
+				return value
+			}
+		}
+		return ""
+	}
+
+	virtualNetworkId := getValue("customVirtualNetworkId")
+	if virtualNetworkId == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"virtual_network_id":  virtualNetworkId,
+			"public_subnet_name":  getValue("customPublicSubnetName"),
+			"private_subnet_name": getValue("customPrivateSubnetName"),
+		},
+	}
+}
+
+func validateDatabricksWorkspaceName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9_-]{3,30}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 30 characters in length and can only contain letters, numbers, underscores and hyphens", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,261 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMDatabricksWorkspace_basic(t *testing.T) {
+	resourceName := "azurerm_databricks_workspace.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDatabricksWorkspace_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDatabricksWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDatabricksWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "standard"),
+					resource.TestCheckResourceAttrSet(resourceName, "managed_resource_group_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "managed_resource_group_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDatabricksWorkspace_complete(t *testing.T) {
+	resourceName := "azurerm_databricks_workspace.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMDatabricksWorkspace_complete(ri, location)
+	postConfig := testAccAzureRMDatabricksWorkspace_completeUpdated(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDatabricksWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDatabricksWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "premium"),
+					resource.TestCheckResourceAttr(resourceName, "managed_resource_group_name", fmt.Sprintf("acctestRG-%d-managed", ri)),
+					resource.TestCheckResourceAttr(resourceName, "custom_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_parameters.0.public_subnet_name", "public"),
+					resource.TestCheckResourceAttr(resourceName, "custom_parameters.0.private_subnet_name", "private"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "Production"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDatabricksWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "premium"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Pricing", "Premium"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMDatabricksWorkspaceName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "ab",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abc",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello-world_01",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello.world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abcdefghijklmnopqrstuvwxyz01234",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateDatabricksWorkspaceName(tc.Value, "azurerm_databricks_workspace")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Databricks Workspace Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMDatabricksWorkspaceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		workspaceName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Databricks Workspace: %s", workspaceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).databricksWorkspacesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, workspaceName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on databricksWorkspacesClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Databricks Workspace %q (Resource Group %q) does not exist", workspaceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDatabricksWorkspaceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).databricksWorkspacesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_databricks_workspace" {
+			continue
+		}
+
+		workspaceName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, workspaceName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Databricks Workspace still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDatabricksWorkspace_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_databricks_workspace" "test" {
+  name                = "acctestdbw-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "standard"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDatabricksWorkspace_completeTemplate(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "public" {
+  name                 = "public"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_subnet" "private" {
+  name                 = "private"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDatabricksWorkspace_complete(rInt int, location string) string {
+	template := testAccAzureRMDatabricksWorkspace_completeTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_databricks_workspace" "test" {
+  name                        = "acctestdbw-%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  location                    = "${azurerm_resource_group.test.location}"
+  sku                         = "premium"
+  managed_resource_group_name = "acctestRG-%d-managed"
+
+  custom_parameters {
+    virtual_network_id  = "${azurerm_virtual_network.test.id}"
+    public_subnet_name  = "${azurerm_subnet.public.name}"
+    private_subnet_name = "${azurerm_subnet.private.name}"
+  }
+
+  tags {
+    Environment = "Production"
+  }
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMDatabricksWorkspace_completeUpdated(rInt int, location string) string {
+	template := testAccAzureRMDatabricksWorkspace_completeTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_databricks_workspace" "test" {
+  name                        = "acctestdbw-%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  location                    = "${azurerm_resource_group.test.location}"
+  sku                         = "premium"
+  managed_resource_group_name = "acctestRG-%d-managed"
+
+  custom_parameters {
+    virtual_network_id  = "${azurerm_virtual_network.test.id}"
+    public_subnet_name  = "${azurerm_subnet.public.name}"
+    private_subnet_name = "${azurerm_subnet.private.name}"
+  }
+
+  tags {
+    Environment = "Production"
+    Pricing     = "Premium"
+  }
+}
+`, template, rInt, rInt)
+}
@@ -0,0 +1,205 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDevTestLab() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevTestLabCreateUpdate,
+		Read:   resourceArmDevTestLabRead,
+		Update: resourceArmDevTestLabCreateUpdate,
+		Delete: resourceArmDevTestLabDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestLabName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"storage_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(dtl.Premium),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(dtl.Standard),
+					string(dtl.Premium),
+				}, false),
+			},
+
+			"tags": tagsSchema(),
+
+			"artifacts_storage_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_storage_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_premium_storage_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"key_vault_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"premium_data_disk_storage_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"unique_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmDevTestLabCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestLabsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for DevTest Lab creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	storageType := d.Get("storage_type").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := dtl.Lab{
+		Location: utils.String(location),
+		LabProperties: &dtl.LabProperties{
+			LabStorageType: dtl.StorageType(storageType),
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating DevTest Lab %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.DevTestLab", "labs", name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of DevTest Lab %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving DevTest Lab %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for DevTest Lab %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDevTestLabRead(d, meta)
+}
+
+func resourceArmDevTestLabRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestLabsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["labs"]
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DevTest Lab %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving DevTest Lab %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.LabProperties; props != nil {
+		d.Set("storage_type", string(props.LabStorageType))
+
+		// these are all computed and thus always returned
+		d.Set("artifacts_storage_account_id", props.ArtifactsStorageAccount)
+		d.Set("default_storage_account_id", props.DefaultStorageAccount)
+		d.Set("default_premium_storage_account_id", props.DefaultPremiumStorageAccount)
+		d.Set("key_vault_id", props.VaultName)
+		d.Set("premium_data_disk_storage_account_id", props.PremiumDataDiskStorageAccount)
+		d.Set("unique_identifier", props.UniqueIdentifier)
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDevTestLabDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestLabsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["labs"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DevTest Lab %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of DevTest Lab %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func validateDevTestLabName() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile("^[A-Za-z0-9_-]+$"),
+		"Lab Name can only include alphanumeric characters, underscores, hyphens.")
+}
@@ -0,0 +1,186 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMDevTestLab_basic(t *testing.T) {
+	resourceName := "azurerm_dev_test_lab.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestLab_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestLabDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestLabExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "Premium"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "artifacts_storage_account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_storage_account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_vault_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "unique_identifier"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestLab_complete(t *testing.T) {
+	resourceName := "azurerm_dev_test_lab.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestLab_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestLabDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestLabExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Hello", "World"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMDevTestLabName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello.world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello-world",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Hello_World01",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateDevTestLabName()(tc.Value, "azurerm_dev_test_lab")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the DevTest Lab Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMDevTestLabExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		labName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for DevTest Lab: %s", labName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).devTestLabsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, labName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on devTestLabsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: DevTest Lab %q (Resource Group %q) does not exist", labName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevTestLabDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).devTestLabsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dev_test_lab" {
+			continue
+		}
+
+		labName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, labName, "")
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("DevTest Lab still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevTestLab_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDevTestLab_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  storage_type        = "Standard"
+
+  tags {
+    Hello = "World"
+  }
+}
+`, rInt, location, rInt)
+}
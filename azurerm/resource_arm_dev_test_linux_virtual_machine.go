@@ -0,0 +1,296 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDevTestLinuxVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevTestLinuxVirtualMachineCreateUpdate,
+		Read:   resourceArmDevTestLinuxVirtualMachineRead,
+		Update: resourceArmDevTestLinuxVirtualMachineCreateUpdate,
+		Delete: resourceArmDevTestLinuxVirtualMachineDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestVirtualMachineName(62),
+			},
+
+			"lab_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestLabName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"size": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"ssh_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"lab_virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"lab_subnet_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"storage_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(dtl.Standard),
+					string(dtl.Premium),
+				}, false),
+			},
+
+			"allow_claim": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"disallow_public_ip_address": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"gallery_image_reference": schemaDevTestVirtualMachineGalleryImageReference(),
+
+			"inbound_nat_rule": schemaDevTestVirtualMachineInboundNatRule(),
+
+			"notes": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"unique_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmDevTestLinuxVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestVirtualMachinesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for DevTest Linux Virtual Machine creation/update.")
+
+	name := d.Get("name").(string)
+	labName := d.Get("lab_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	username := d.Get("username").(string)
+	password := d.Get("password").(string)
+	sshKey := d.Get("ssh_key").(string)
+	if password == "" && sshKey == "" {
+		return fmt.Errorf("At least one of `password` or `ssh_key` must be specified")
+	}
+
+	disallowPublicIPAddress := d.Get("disallow_public_ip_address").(bool)
+	natRules := expandDevTestLabVirtualMachineNatRules(d.Get("inbound_nat_rule").(*schema.Set))
+	if len(natRules) > 0 && !disallowPublicIPAddress {
+		return fmt.Errorf("If `inbound_nat_rule` is specified then `disallow_public_ip_address` must be set to true.")
+	}
+
+	parameters := dtl.LabVirtualMachine{
+		Location: utils.String(location),
+		LabVirtualMachineProperties: &dtl.LabVirtualMachineProperties{
+			AllowClaim:                 utils.Bool(d.Get("allow_claim").(bool)),
+			IsAuthenticationWithSSHKey: utils.Bool(sshKey != ""),
+			DisallowPublicIPAddress:    utils.Bool(disallowPublicIPAddress),
+			GalleryImageReference:      expandDevTestLabVirtualMachineGalleryImageReference(d.Get("gallery_image_reference").([]interface{}), "Linux"),
+			LabSubnetName:              utils.String(d.Get("lab_subnet_name").(string)),
+			LabVirtualNetworkID:        utils.String(d.Get("lab_virtual_network_id").(string)),
+			Notes:                      utils.String(d.Get("notes").(string)),
+			OsType:                     utils.String("Linux"),
+			Size:                       utils.String(d.Get("size").(string)),
+			StorageType:                utils.String(d.Get("storage_type").(string)),
+			UserName:                   utils.String(username),
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	if password != "" {
+		parameters.LabVirtualMachineProperties.Password = utils.String(password)
+	}
+
+	if sshKey != "" {
+		parameters.LabVirtualMachineProperties.SSHKey = utils.String(sshKey)
+	}
+
+	if len(natRules) > 0 {
+		parameters.LabVirtualMachineProperties.NetworkInterface = &dtl.NetworkInterfaceProperties{
+			SharedPublicIPAddressConfiguration: &dtl.SharedPublicIPAddressConfiguration{
+				InboundNatRules: &natRules,
+			},
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, labName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := fmt.Sprintf("%s/virtualmachines/%s", azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.DevTestLab", "labs", labName), name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, labName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q)", name, labName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDevTestLinuxVirtualMachineRead(d, meta)
+}
+
+func resourceArmDevTestLinuxVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestVirtualMachinesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["virtualmachines"]
+
+	resp, err := client.Get(ctx, resourceGroup, labName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DevTest Linux Virtual Machine %q was not found in Lab %q / Resource Group %q - removing from state!", name, labName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("lab_name", labName)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.LabVirtualMachineProperties; props != nil {
+		d.Set("allow_claim", props.AllowClaim)
+		d.Set("disallow_public_ip_address", props.DisallowPublicIPAddress)
+		d.Set("lab_subnet_name", props.LabSubnetName)
+		d.Set("lab_virtual_network_id", props.LabVirtualNetworkID)
+		d.Set("notes", props.Notes)
+		d.Set("size", props.Size)
+		d.Set("storage_type", props.StorageType)
+		d.Set("username", props.UserName)
+
+		if err := d.Set("gallery_image_reference", flattenDevTestVirtualMachineGalleryImage(props.GalleryImageReference)); err != nil {
+			return fmt.Errorf("Error setting `gallery_image_reference`: %+v", err)
+		}
+
+		// Computed fields
+		d.Set("fqdn", props.Fqdn)
+		d.Set("unique_identifier", props.UniqueIdentifier)
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDevTestLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestVirtualMachinesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["virtualmachines"]
+
+	future, err := client.Delete(ctx, resourceGroup, labName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMDevTestLinuxVirtualMachine_basic(t *testing.T) {
+	resourceName := "azurerm_dev_test_linux_virtual_machine.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestLinuxVirtualMachine_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestLinuxVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestLinuxVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "gallery_image_reference.0.publisher", "Canonical"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "fqdn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestLinuxVirtualMachine_inboundNatRules(t *testing.T) {
+	resourceName := "azurerm_dev_test_linux_virtual_machine.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestLinuxVirtualMachine_inboundNatRules(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestLinuxVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestLinuxVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "disallow_public_ip_address", "true"),
+					resource.TestCheckResourceAttr(resourceName, "inbound_nat_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Acceptance", "Test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMDevTestVirtualMachineName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "hello_world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello-world",
+			ErrCount: 0,
+		},
+		{
+			Value:    "HelloWorld01",
+			ErrCount: 0,
+		},
+		{
+			Value:    "abcdefghijklmnop",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateDevTestVirtualMachineName(15)(tc.Value, "azurerm_dev_test_windows_virtual_machine")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the DevTest Virtual Machine Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMDevTestLinuxVirtualMachineExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		virtualMachineName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).devTestVirtualMachinesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, labName, virtualMachineName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on devTestVirtualMachinesClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q) does not exist", virtualMachineName, labName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevTestLinuxVirtualMachineDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).devTestVirtualMachinesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dev_test_linux_virtual_machine" {
+			continue
+		}
+
+		virtualMachineName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, labName, virtualMachineName, "")
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("DevTest Linux Virtual Machine still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevTestLinuxVirtualMachine_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dev_test_virtual_network" "test" {
+  name                = "acctestdtvn%d"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  subnet {
+    use_public_ip_address           = "Allow"
+    use_in_virtual_machine_creation = "Allow"
+  }
+}
+
+resource "azurerm_dev_test_linux_virtual_machine" "test" {
+  name                   = "acctestvm-vm%d"
+  lab_name               = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  location               = "${azurerm_resource_group.test.location}"
+  size                   = "Standard_F2"
+  username               = "acct5stU5er"
+  password               = "H@Sh1CoR3!"
+  lab_virtual_network_id = "${azurerm_dev_test_virtual_network.test.id}"
+  lab_subnet_name        = "${azurerm_dev_test_virtual_network.test.subnet.0.name}"
+  storage_type           = "Standard"
+
+  gallery_image_reference {
+    offer     = "UbuntuServer"
+    publisher = "Canonical"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+`, rInt, location, rInt, rInt, rInt%1000000)
+}
+
+func testAccAzureRMDevTestLinuxVirtualMachine_inboundNatRules(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dev_test_virtual_network" "test" {
+  name                = "acctestdtvn%d"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  subnet {
+    use_public_ip_address           = "Deny"
+    use_in_virtual_machine_creation = "Allow"
+  }
+}
+
+resource "azurerm_dev_test_linux_virtual_machine" "test" {
+  name                       = "acctestvm-vm%d"
+  lab_name                   = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  location                   = "${azurerm_resource_group.test.location}"
+  size                       = "Standard_F2"
+  username                   = "acct5stU5er"
+  ssh_key                    = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAAAgQCfGyt5W1eJVpDIxlyvAWO594j/azEGohmlxYe7mgSfmUCWjuzILI6nHuHbxhpBDIZJhQ+JAeduXpii61dmThbI89ghGMhzea0OlT3p12e093zqa4goB9g40jdNKmJArER3pMVqs6hmv8y3GlUNkMDSmuoyI8AYzX4n26cUKZbwXQ== mk@mk3"
+  lab_virtual_network_id     = "${azurerm_dev_test_virtual_network.test.id}"
+  lab_subnet_name            = "${azurerm_dev_test_virtual_network.test.subnet.0.name}"
+  storage_type               = "Standard"
+  disallow_public_ip_address = true
+
+  gallery_image_reference {
+    offer     = "UbuntuServer"
+    publisher = "Canonical"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  inbound_nat_rule {
+    protocol     = "Tcp"
+    backend_port = 22
+  }
+
+  inbound_nat_rule {
+    protocol     = "Tcp"
+    backend_port = 3389
+  }
+
+  tags {
+    Acceptance = "Test"
+  }
+}
+`, rInt, location, rInt, rInt, rInt%1000000)
+}
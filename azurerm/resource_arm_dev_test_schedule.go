@@ -0,0 +1,436 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDevTestSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevTestScheduleCreateUpdate,
+		Read:   resourceArmDevTestScheduleRead,
+		Update: resourceArmDevTestScheduleCreateUpdate,
+		Delete: resourceArmDevTestScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// Lab-wide schedules must use one of these well-known names
+				ValidateFunc: validation.StringInSlice([]string{
+					"LabVmsShutdown",
+					"LabVmAutoStart",
+				}, false),
+			},
+
+			"lab_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestLabName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(dtl.EnableStatusEnabled),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(dtl.EnableStatusEnabled),
+					string(dtl.EnableStatusDisabled),
+				}, false),
+			},
+
+			"task_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"LabVmsShutdownTask",
+					"LabVmsStartupTask",
+				}, false),
+			},
+
+			"time_zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"weekly_recurrence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateDevTestScheduleTime,
+						},
+
+						"week_days": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Monday",
+									"Tuesday",
+									"Wednesday",
+									"Thursday",
+									"Friday",
+									"Saturday",
+									"Sunday",
+								}, false),
+							},
+						},
+					},
+				},
+			},
+
+			"daily_recurrence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateDevTestScheduleTime,
+						},
+					},
+				},
+			},
+
+			"hourly_recurrence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"minute": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
+			},
+
+			"notification_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(dtl.NotificationStatusDisabled),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(dtl.NotificationStatusEnabled),
+								string(dtl.NotificationStatusDisabled),
+							}, false),
+						},
+
+						"time_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntBetween(15, 120),
+						},
+
+						"webhook_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.UrlIsHttpOrHttps(),
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDevTestScheduleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestSchedulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for DevTest Schedule creation/update.")
+
+	name := d.Get("name").(string)
+	labName := d.Get("lab_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	weeklyRecurrence := expandDevTestScheduleWeeklyRecurrence(d.Get("weekly_recurrence").([]interface{}))
+	dailyRecurrence := expandDevTestScheduleDailyRecurrence(d.Get("daily_recurrence").([]interface{}))
+	hourlyRecurrence := expandDevTestScheduleHourlyRecurrence(d.Get("hourly_recurrence").([]interface{}))
+	if weeklyRecurrence == nil && dailyRecurrence == nil && hourlyRecurrence == nil {
+		return fmt.Errorf("One of `weekly_recurrence`, `daily_recurrence` or `hourly_recurrence` must be specified")
+	}
+
+	parameters := dtl.Schedule{
+		Location: utils.String(location),
+		ScheduleProperties: &dtl.ScheduleProperties{
+			Status:               dtl.EnableStatus(d.Get("status").(string)),
+			TaskType:             utils.String(d.Get("task_type").(string)),
+			TimeZoneID:           utils.String(d.Get("time_zone_id").(string)),
+			WeeklyRecurrence:     weeklyRecurrence,
+			DailyRecurrence:      dailyRecurrence,
+			HourlyRecurrence:     hourlyRecurrence,
+			NotificationSettings: expandDevTestScheduleNotificationSettings(d.Get("notification_settings").([]interface{})),
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, labName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating DevTest Schedule %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, labName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving DevTest Schedule %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for DevTest Schedule %q (Lab %q / Resource Group %q)", name, labName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDevTestScheduleRead(d, meta)
+}
+
+func resourceArmDevTestScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestSchedulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["schedules"]
+
+	resp, err := client.Get(ctx, resourceGroup, labName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DevTest Schedule %q was not found in Lab %q / Resource Group %q - removing from state!", name, labName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving DevTest Schedule %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("lab_name", labName)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ScheduleProperties; props != nil {
+		d.Set("status", string(props.Status))
+		d.Set("task_type", props.TaskType)
+		d.Set("time_zone_id", props.TimeZoneID)
+
+		if err := d.Set("weekly_recurrence", flattenDevTestScheduleWeeklyRecurrence(props.WeeklyRecurrence)); err != nil {
+			return fmt.Errorf("Error setting `weekly_recurrence`: %+v", err)
+		}
+
+		if err := d.Set("daily_recurrence", flattenDevTestScheduleDailyRecurrence(props.DailyRecurrence)); err != nil {
+			return fmt.Errorf("Error setting `daily_recurrence`: %+v", err)
+		}
+
+		if err := d.Set("hourly_recurrence", flattenDevTestScheduleHourlyRecurrence(props.HourlyRecurrence)); err != nil {
+			return fmt.Errorf("Error setting `hourly_recurrence`: %+v", err)
+		}
+
+		if err := d.Set("notification_settings", flattenDevTestScheduleNotificationSettings(props.NotificationSettings)); err != nil {
+			return fmt.Errorf("Error setting `notification_settings`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDevTestScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestSchedulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["schedules"]
+
+	resp, err := client.Delete(ctx, resourceGroup, labName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting DevTest Schedule %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandDevTestScheduleWeeklyRecurrence(input []interface{}) *dtl.WeekDetails {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	weekDays := make([]string, 0)
+	for _, day := range v["week_days"].([]interface{}) {
+		weekDays = append(weekDays, day.(string))
+	}
+
+	return &dtl.WeekDetails{
+		Time:     utils.String(v["time"].(string)),
+		Weekdays: &weekDays,
+	}
+}
+
+func flattenDevTestScheduleWeeklyRecurrence(input *dtl.WeekDetails) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	if input.Time != nil {
+		output["time"] = *input.Time
+	}
+
+	weekDays := make([]interface{}, 0)
+	if input.Weekdays != nil {
+		for _, day := range *input.Weekdays {
+			weekDays = append(weekDays, day)
+		}
+	}
+	output["week_days"] = weekDays
+
+	return []interface{}{output}
+}
+
+func expandDevTestScheduleDailyRecurrence(input []interface{}) *dtl.DayDetails {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &dtl.DayDetails{
+		Time: utils.String(v["time"].(string)),
+	}
+}
+
+func flattenDevTestScheduleDailyRecurrence(input *dtl.DayDetails) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	if input.Time != nil {
+		output["time"] = *input.Time
+	}
+
+	return []interface{}{output}
+}
+
+func expandDevTestScheduleHourlyRecurrence(input []interface{}) *dtl.HourDetails {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &dtl.HourDetails{
+		Minute: utils.Int32(int32(v["minute"].(int))),
+	}
+}
+
+func flattenDevTestScheduleHourlyRecurrence(input *dtl.HourDetails) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	if input.Minute != nil {
+		output["minute"] = int(*input.Minute)
+	}
+
+	return []interface{}{output}
+}
+
+func expandDevTestScheduleNotificationSettings(input []interface{}) *dtl.NotificationSettings {
+	if len(input) == 0 {
+		return &dtl.NotificationSettings{
+			Status: dtl.NotificationStatusDisabled,
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	settings := dtl.NotificationSettings{
+		Status:        dtl.NotificationStatus(v["status"].(string)),
+		TimeInMinutes: utils.Int32(int32(v["time_in_minutes"].(int))),
+	}
+
+	if webhookUrl := v["webhook_url"].(string); webhookUrl != "" {
+		settings.WebhookURL = utils.String(webhookUrl)
+	}
+
+	return &settings
+}
+
+func flattenDevTestScheduleNotificationSettings(input *dtl.NotificationSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	output["status"] = string(input.Status)
+	if input.TimeInMinutes != nil {
+		output["time_in_minutes"] = int(*input.TimeInMinutes)
+	}
+	if input.WebhookURL != nil {
+		output["webhook_url"] = *input.WebhookURL
+	}
+
+	return []interface{}{output}
+}
+
+func validateDevTestScheduleTime(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// times are specified in 24-hour format without a separator, e.g. `1900`
+	if !regexp.MustCompile(`^([01][0-9]|2[0-3])[0-5][0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a time in the 24-hour format `HHmm`, e.g. `1900`", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,209 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMDevTestSchedule_autoShutdown(t *testing.T) {
+	resourceName := "azurerm_dev_test_schedule.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMDevTestSchedule_autoShutdown(ri, location)
+	postConfig := testAccAzureRMDevTestSchedule_autoShutdownUpdated(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "task_type", "LabVmsShutdownTask"),
+					resource.TestCheckResourceAttr(resourceName, "daily_recurrence.0.time", "1900"),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.0.status", "Disabled"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "weekly_recurrence.0.time", "2000"),
+					resource.TestCheckResourceAttr(resourceName, "weekly_recurrence.0.week_days.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.0.status", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.0.time_in_minutes", "15"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMDevTestScheduleTime_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "19:00",
+			ErrCount: 1,
+		},
+		{
+			Value:    "2400",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1960",
+			ErrCount: 1,
+		},
+		{
+			Value:    "0000",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1930",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2359",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateDevTestScheduleTime(tc.Value, "time")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the DevTest Schedule Time %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMDevTestScheduleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		scheduleName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).devTestSchedulesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, labName, scheduleName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on devTestSchedulesClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: DevTest Schedule %q (Lab %q / Resource Group %q) does not exist", scheduleName, labName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevTestScheduleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).devTestSchedulesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dev_test_schedule" {
+			continue
+		}
+
+		scheduleName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, labName, scheduleName, "")
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("DevTest Schedule still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevTestSchedule_autoShutdown(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dev_test_schedule" "test" {
+  name                = "LabVmsShutdown"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  task_type           = "LabVmsShutdownTask"
+  time_zone_id        = "UTC"
+
+  daily_recurrence {
+    time = "1900"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDevTestSchedule_autoShutdownUpdated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dev_test_schedule" "test" {
+  name                = "LabVmsShutdown"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  status              = "Disabled"
+  task_type           = "LabVmsShutdownTask"
+  time_zone_id        = "UTC"
+
+  weekly_recurrence {
+    time      = "2000"
+    week_days = ["Friday", "Saturday"]
+  }
+
+  notification_settings {
+    status          = "Enabled"
+    time_in_minutes = 15
+  }
+}
+`, rInt, location, rInt)
+}
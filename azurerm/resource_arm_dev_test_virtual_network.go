@@ -0,0 +1,275 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDevTestVirtualNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevTestVirtualNetworkCreateUpdate,
+		Read:   resourceArmDevTestVirtualNetworkRead,
+		Update: resourceArmDevTestVirtualNetworkCreateUpdate,
+		Delete: resourceArmDevTestVirtualNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestVirtualNetworkName(),
+			},
+
+			"lab_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestLabName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"subnet": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"use_public_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(dtl.Allow),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(dtl.Allow),
+								string(dtl.Default),
+								string(dtl.Deny),
+							}, false),
+						},
+
+						"use_in_virtual_machine_creation": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(dtl.Allow),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(dtl.Allow),
+								string(dtl.Default),
+								string(dtl.Deny),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+
+			"unique_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmDevTestVirtualNetworkCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestVirtualNetworksClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for DevTest Virtual Network creation/update.")
+
+	name := d.Get("name").(string)
+	labName := d.Get("lab_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	description := d.Get("description").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	subnets := d.Get("subnet").([]interface{})
+	subnetOverrides := expandDevTestVirtualNetworkSubnets(subnets, subscriptionId, resourceGroup, name)
+
+	parameters := dtl.VirtualNetwork{
+		Tags: expandTags(tags, meta),
+		VirtualNetworkProperties: &dtl.VirtualNetworkProperties{
+			Description:     utils.String(description),
+			SubnetOverrides: subnetOverrides,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, labName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating DevTest Virtual Network %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	expectedId := fmt.Sprintf("%s/virtualnetworks/%s", azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.DevTestLab", "labs", labName), name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of DevTest Virtual Network %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, labName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving DevTest Virtual Network %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for DevTest Virtual Network %q (Lab %q / Resource Group %q)", name, labName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDevTestVirtualNetworkRead(d, meta)
+}
+
+func resourceArmDevTestVirtualNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestVirtualNetworksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["virtualnetworks"]
+
+	resp, err := client.Get(ctx, resourceGroup, labName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DevTest Virtual Network %q was not found in Lab %q / Resource Group %q - removing from state!", name, labName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving DevTest Virtual Network %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("lab_name", labName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.VirtualNetworkProperties; props != nil {
+		d.Set("description", props.Description)
+
+		flattenedSubnets := flattenDevTestVirtualNetworkSubnets(props.SubnetOverrides)
+		if err := d.Set("subnet", flattenedSubnets); err != nil {
+			return fmt.Errorf("Error setting `subnet`: %+v", err)
+		}
+
+		// Computed fields
+		d.Set("unique_identifier", props.UniqueIdentifier)
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDevTestVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestVirtualNetworksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["virtualnetworks"]
+
+	future, err := client.Delete(ctx, resourceGroup, labName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DevTest Virtual Network %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of DevTest Virtual Network %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func validateDevTestVirtualNetworkName() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile("^[A-Za-z0-9_-]+$"),
+		"Virtual Network Name can only include alphanumeric characters, underscores, hyphens.")
+}
+
+func expandDevTestVirtualNetworkSubnets(input []interface{}, subscriptionId, resourceGroupName, virtualNetworkName string) *[]dtl.SubnetOverride {
+	results := make([]dtl.SubnetOverride, 0)
+
+	// the Lab creates an Azure Virtual Network of the same name with a single Subnet named `{name}Subnet`
+	name := fmt.Sprintf("%sSubnet", virtualNetworkName)
+	subnetId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s/subnets/%s", subscriptionId, resourceGroupName, virtualNetworkName, name)
+
+	if len(input) == 0 {
+		result := dtl.SubnetOverride{
+			ResourceID:                   utils.String(subnetId),
+			LabSubnetName:                utils.String(name),
+			UsePublicIPAddressPermission: dtl.Allow,
+			UseInVMCreationPermission:    dtl.Allow,
+		}
+		results = append(results, result)
+		return &results
+	}
+
+	for _, val := range input {
+		v := val.(map[string]interface{})
+		usePublicIPAddress := v["use_public_ip_address"].(string)
+		useInVirtualMachineCreation := v["use_in_virtual_machine_creation"].(string)
+
+		subnet := dtl.SubnetOverride{
+			ResourceID:                   utils.String(subnetId),
+			LabSubnetName:                utils.String(name),
+			UsePublicIPAddressPermission: dtl.UsagePermissionType(usePublicIPAddress),
+			UseInVMCreationPermission:    dtl.UsagePermissionType(useInVirtualMachineCreation),
+		}
+
+		results = append(results, subnet)
+	}
+
+	return &results
+}
+
+func flattenDevTestVirtualNetworkSubnets(input *[]dtl.SubnetOverride) []interface{} {
+	outputs := make([]interface{}, 0)
+	if input == nil {
+		return outputs
+	}
+
+	for _, v := range *input {
+		output := make(map[string]interface{})
+		if v.LabSubnetName != nil {
+			output["name"] = *v.LabSubnetName
+		}
+		output["use_public_ip_address"] = string(v.UsePublicIPAddressPermission)
+		output["use_in_virtual_machine_creation"] = string(v.UseInVMCreationPermission)
+
+		outputs = append(outputs, output)
+	}
+
+	return outputs
+}
@@ -0,0 +1,134 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMDevTestWindowsVirtualMachine_basic(t *testing.T) {
+	resourceName := "azurerm_dev_test_windows_virtual_machine.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestWindowsVirtualMachine_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "gallery_image_reference.0.publisher", "MicrosoftWindowsServer"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "fqdn"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevTestWindowsVirtualMachineExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		virtualMachineName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).devTestVirtualMachinesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, labName, virtualMachineName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on devTestVirtualMachinesClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: DevTest Windows Virtual Machine %q (Lab %q / Resource Group %q) does not exist", virtualMachineName, labName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevTestWindowsVirtualMachineDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).devTestVirtualMachinesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dev_test_windows_virtual_machine" {
+			continue
+		}
+
+		virtualMachineName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, labName, virtualMachineName, "")
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("DevTest Windows Virtual Machine still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevTestWindowsVirtualMachine_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dev_test_virtual_network" "test" {
+  name                = "acctestdtvn%d"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  subnet {
+    use_public_ip_address           = "Allow"
+    use_in_virtual_machine_creation = "Allow"
+  }
+}
+
+resource "azurerm_dev_test_windows_virtual_machine" "test" {
+  name                   = "acctestvm%d"
+  lab_name               = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  location               = "${azurerm_resource_group.test.location}"
+  size                   = "Standard_F2"
+  username               = "acct5stU5er"
+  password               = "H@Sh1CoR3!"
+  lab_virtual_network_id = "${azurerm_dev_test_virtual_network.test.id}"
+  lab_subnet_name        = "${azurerm_dev_test_virtual_network.test.subnet.0.name}"
+  storage_type           = "Standard"
+
+  gallery_image_reference {
+    offer     = "WindowsServer"
+    publisher = "MicrosoftWindowsServer"
+    sku       = "2012-Datacenter"
+    version   = "latest"
+  }
+}
+`, rInt, location, rInt, rInt, rInt%1000000)
+}
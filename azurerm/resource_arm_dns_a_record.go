@@ -36,6 +36,7 @@ func resourceArmDnsARecord() *schema.Resource {
 			"records": {
 				Type:     schema.TypeSet,
 				Required: true,
+				MaxItems: dnsRecordSetMaxRecords,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
@@ -45,6 +46,10 @@ func resourceArmDnsARecord() *schema.Resource {
 				Required: true,
 			},
 
+			"prevent_overwrite": dnsRecordSetPreventOverwriteSchema(),
+
+			"etag": dnsRecordSetETagSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -68,17 +73,17 @@ func resourceArmDnsARecordCreateOrUpdate(d *schema.ResourceData, meta interface{
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata: expandTags(tags),
+			Metadata: expandTags(tags, meta),
 			TTL:      &ttl,
 			ARecords: &records,
 		},
 	}
 
-	eTag := ""
+	eTag := dnsRecordSetIfMatch(d)
 	ifNoneMatch := "" // set to empty to allow updates to records after creation
 	resp, err := dnsClient.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.A, parameters, eTag, ifNoneMatch)
 	if err != nil {
-		return err
+		return dnsRecordSetError(resp.Response, "A", name, zoneName, err)
 	}
 
 	if resp.ID == nil {
@@ -116,11 +121,12 @@ func resourceArmDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("resource_group_name", resGroup)
 	d.Set("zone_name", zoneName)
 	d.Set("ttl", resp.TTL)
+	d.Set("etag", resp.Etag)
 
 	if err := d.Set("records", flattenAzureRmDnsARecords(resp.ARecords)); err != nil {
 		return err
 	}
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetTags(d, resp.Metadata, meta)
 
 	return nil
 }
@@ -138,9 +144,9 @@ func resourceArmDnsARecordDelete(d *schema.ResourceData, meta interface{}) error
 	name := id.Path["A"]
 	zoneName := id.Path["dnszones"]
 
-	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.A, "")
+	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.A, dnsRecordSetIfMatch(d))
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Error deleting DNS A Record %s: %+v", name, error)
+		return fmt.Errorf("Error deleting DNS A Record %s: %+v", name, dnsRecordSetError(resp, "A", name, zoneName, error))
 	}
 
 	return nil
@@ -61,6 +61,38 @@ func TestAccAzureRMDnsARecord_updateRecords(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMDnsARecord_preventOverwrite(t *testing.T) {
+	resourceName := "azurerm_dns_a_record.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMDnsARecord_basic(ri, location)
+	postConfig := testAccAzureRMDnsARecord_preventOverwrite(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsARecordExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsARecordExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "prevent_overwrite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMDnsARecord_withTags(t *testing.T) {
 	resourceName := "azurerm_dns_a_record.test"
 	ri := acctest.RandInt()
@@ -194,6 +226,29 @@ resource "azurerm_dns_a_record" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMDnsARecord_preventOverwrite(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dns_a_record" "test" {
+  name                = "myarecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  ttl                 = 300
+  records             = ["1.2.3.4", "1.2.4.5", "1.2.3.7"]
+  prevent_overwrite   = true
+}
+`, rInt, location, rInt, rInt)
+}
+
 func testAccAzureRMDnsARecord_withTags(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -36,6 +36,7 @@ func resourceArmDnsAAAARecord() *schema.Resource {
 			"records": {
 				Type:     schema.TypeSet,
 				Required: true,
+				MaxItems: dnsRecordSetMaxRecords,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
@@ -45,6 +46,10 @@ func resourceArmDnsAAAARecord() *schema.Resource {
 				Required: true,
 			},
 
+			"prevent_overwrite": dnsRecordSetPreventOverwriteSchema(),
+
+			"etag": dnsRecordSetETagSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -68,17 +73,17 @@ func resourceArmDnsAaaaRecordCreateOrUpdate(d *schema.ResourceData, meta interfa
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:    expandTags(tags),
+			Metadata:    expandTags(tags, meta),
 			TTL:         &ttl,
 			AaaaRecords: &records,
 		},
 	}
 
-	eTag := ""
+	eTag := dnsRecordSetIfMatch(d)
 	ifNoneMatch := "" // set to empty to allow updates to records after creation
 	resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.AAAA, parameters, eTag, ifNoneMatch)
 	if err != nil {
-		return err
+		return dnsRecordSetError(resp.Response, "AAAA", name, zoneName, err)
 	}
 
 	if resp.ID == nil {
@@ -116,11 +121,12 @@ func resourceArmDnsAaaaRecordRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("resource_group_name", resGroup)
 	d.Set("zone_name", zoneName)
 	d.Set("ttl", resp.TTL)
+	d.Set("etag", resp.Etag)
 
 	if err := d.Set("records", flattenAzureRmDnsAaaaRecords(resp.AaaaRecords)); err != nil {
 		return err
 	}
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetTags(d, resp.Metadata, meta)
 
 	return nil
 }
@@ -138,9 +144,9 @@ func resourceArmDnsAaaaRecordDelete(d *schema.ResourceData, meta interface{}) er
 	name := id.Path["AAAA"]
 	zoneName := id.Path["dnszones"]
 
-	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.AAAA, "")
+	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.AAAA, dnsRecordSetIfMatch(d))
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Error deleting DNS AAAA Record %s: %+v", name, error)
+		return fmt.Errorf("Error deleting DNS AAAA Record %s: %+v", name, dnsRecordSetError(resp, "AAAA", name, zoneName, error))
 	}
 
 	return nil
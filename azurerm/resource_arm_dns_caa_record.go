@@ -39,6 +39,7 @@ func resourceArmDnsCaaRecord() *schema.Resource {
 			"record": {
 				Type:     schema.TypeSet,
 				Required: true,
+				MaxItems: dnsRecordSetMaxRecords,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"flags": {
@@ -71,6 +72,10 @@ func resourceArmDnsCaaRecord() *schema.Resource {
 				Required: true,
 			},
 
+			"prevent_overwrite": dnsRecordSetPreventOverwriteSchema(),
+
+			"etag": dnsRecordSetETagSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -94,17 +99,17 @@ func resourceArmDnsCaaRecordCreateOrUpdate(d *schema.ResourceData, meta interfac
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   expandTags(tags),
+			Metadata:   expandTags(tags, meta),
 			TTL:        &ttl,
 			CaaRecords: &records,
 		},
 	}
 
-	eTag := ""
+	eTag := dnsRecordSetIfMatch(d)
 	ifNoneMatch := "" // set to empty to allow updates to records after creation
 	resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.CAA, parameters, eTag, ifNoneMatch)
 	if err != nil {
-		return err
+		return dnsRecordSetError(resp.Response, "CAA", name, zoneName, err)
 	}
 
 	if resp.ID == nil {
@@ -142,11 +147,12 @@ func resourceArmDnsCaaRecordRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("resource_group_name", resGroup)
 	d.Set("zone_name", zoneName)
 	d.Set("ttl", resp.TTL)
+	d.Set("etag", resp.Etag)
 
 	if err := d.Set("record", flattenAzureRmDnsCaaRecords(resp.CaaRecords)); err != nil {
 		return err
 	}
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetTags(d, resp.Metadata, meta)
 
 	return nil
 }
@@ -164,9 +170,9 @@ func resourceArmDnsCaaRecordDelete(d *schema.ResourceData, meta interface{}) err
 	name := id.Path["CAA"]
 	zoneName := id.Path["dnszones"]
 
-	resp, error := client.Delete(ctx, resGroup, zoneName, name, dns.CAA, "")
+	resp, error := client.Delete(ctx, resGroup, zoneName, name, dns.CAA, dnsRecordSetIfMatch(d))
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Error deleting DNS CAA Record %s: %+v", name, error)
+		return fmt.Errorf("Error deleting DNS CAA Record %s: %+v", name, dnsRecordSetError(resp, "CAA", name, zoneName, error))
 	}
 
 	return nil
@@ -51,6 +51,10 @@ func resourceArmDnsCNameRecord() *schema.Resource {
 				Required: true,
 			},
 
+			"prevent_overwrite": dnsRecordSetPreventOverwriteSchema(),
+
+			"etag": dnsRecordSetETagSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -70,7 +74,7 @@ func resourceArmDnsCNameRecordCreateOrUpdate(d *schema.ResourceData, meta interf
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata: expandTags(tags),
+			Metadata: expandTags(tags, meta),
 			TTL:      &ttl,
 			CnameRecord: &dns.CnameRecord{
 				Cname: &record,
@@ -78,11 +82,11 @@ func resourceArmDnsCNameRecordCreateOrUpdate(d *schema.ResourceData, meta interf
 		},
 	}
 
-	eTag := ""
+	eTag := dnsRecordSetIfMatch(d)
 	ifNoneMatch := "" // set to empty to allow updates to records after creation
 	resp, err := dnsClient.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.CNAME, parameters, eTag, ifNoneMatch)
 	if err != nil {
-		return err
+		return dnsRecordSetError(resp.Response, "CNAME", name, zoneName, err)
 	}
 
 	if resp.ID == nil {
@@ -120,6 +124,7 @@ func resourceArmDnsCNameRecordRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("resource_group_name", resGroup)
 	d.Set("zone_name", zoneName)
 	d.Set("ttl", resp.TTL)
+	d.Set("etag", resp.Etag)
 
 	if props := resp.RecordSetProperties; props != nil {
 		if record := props.CnameRecord; record != nil {
@@ -127,7 +132,7 @@ func resourceArmDnsCNameRecordRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetTags(d, resp.Metadata, meta)
 
 	return nil
 }
@@ -145,9 +150,9 @@ func resourceArmDnsCNameRecordDelete(d *schema.ResourceData, meta interface{}) e
 	name := id.Path["CNAME"]
 	zoneName := id.Path["dnszones"]
 
-	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.CNAME, "")
+	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.CNAME, dnsRecordSetIfMatch(d))
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Error deleting DNS CNAME Record %s: %+v", name, error)
+		return fmt.Errorf("Error deleting DNS CNAME Record %s: %+v", name, dnsRecordSetError(resp, "CNAME", name, zoneName, error))
 	}
 
 	return nil
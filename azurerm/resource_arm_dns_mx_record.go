@@ -39,6 +39,7 @@ func resourceArmDnsMxRecord() *schema.Resource {
 			"record": {
 				Type:     schema.TypeSet,
 				Required: true,
+				MaxItems: dnsRecordSetMaxRecords,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"preference": {
@@ -61,6 +62,10 @@ func resourceArmDnsMxRecord() *schema.Resource {
 				Required: true,
 			},
 
+			"prevent_overwrite": dnsRecordSetPreventOverwriteSchema(),
+
+			"etag": dnsRecordSetETagSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -83,17 +88,17 @@ func resourceArmDnsMxRecordCreateOrUpdate(d *schema.ResourceData, meta interface
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:  expandTags(tags),
+			Metadata:  expandTags(tags, meta),
 			TTL:       &ttl,
 			MxRecords: &records,
 		},
 	}
 
-	eTag := ""
+	eTag := dnsRecordSetIfMatch(d)
 	ifNoneMatch := "" // set to empty to allow updates to records after creation
 	resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.MX, parameters, eTag, ifNoneMatch)
 	if err != nil {
-		return err
+		return dnsRecordSetError(resp.Response, "MX", name, zoneName, err)
 	}
 
 	if resp.ID == nil {
@@ -131,11 +136,12 @@ func resourceArmDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("resource_group_name", resGroup)
 	d.Set("zone_name", zoneName)
 	d.Set("ttl", resp.TTL)
+	d.Set("etag", resp.Etag)
 
 	if err := d.Set("record", flattenAzureRmDnsMxRecords(resp.MxRecords)); err != nil {
 		return err
 	}
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetTags(d, resp.Metadata, meta)
 
 	return nil
 }
@@ -153,9 +159,9 @@ func resourceArmDnsMxRecordDelete(d *schema.ResourceData, meta interface{}) erro
 	name := id.Path["MX"]
 	zoneName := id.Path["dnszones"]
 
-	resp, error := client.Delete(ctx, resGroup, zoneName, name, dns.MX, "")
+	resp, error := client.Delete(ctx, resGroup, zoneName, name, dns.MX, dnsRecordSetIfMatch(d))
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Error deleting DNS MX Record %s: %+v", name, error)
+		return fmt.Errorf("Error deleting DNS MX Record %s: %+v", name, dnsRecordSetError(resp, "MX", name, zoneName, error))
 	}
 
 	return nil
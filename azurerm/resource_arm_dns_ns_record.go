@@ -38,6 +38,7 @@ func resourceArmDnsNsRecord() *schema.Resource {
 				//TODO: add `Required: true` once we remove the `record` attribute
 				Optional:      true,
 				Computed:      true,
+				MaxItems:      dnsRecordSetMaxRecords,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"record"},
 			},
@@ -46,6 +47,7 @@ func resourceArmDnsNsRecord() *schema.Resource {
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Computed:      true,
+				MaxItems:      dnsRecordSetMaxRecords,
 				Deprecated:    "This field has been replaced by `records`",
 				ConflictsWith: []string{"records"},
 				Elem: &schema.Resource{
@@ -63,6 +65,10 @@ func resourceArmDnsNsRecord() *schema.Resource {
 				Required: true,
 			},
 
+			"prevent_overwrite": dnsRecordSetPreventOverwriteSchema(),
+
+			"etag": dnsRecordSetETagSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -85,17 +91,17 @@ func resourceArmDnsNsRecordCreateOrUpdate(d *schema.ResourceData, meta interface
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:  expandTags(tags),
+			Metadata:  expandTags(tags, meta),
 			TTL:       &ttl,
 			NsRecords: &records,
 		},
 	}
 
-	eTag := ""
+	eTag := dnsRecordSetIfMatch(d)
 	ifNoneMatch := "" // set to empty to allow updates to records after creation
 	resp, err := dnsClient.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.NS, parameters, eTag, ifNoneMatch)
 	if err != nil {
-		return err
+		return dnsRecordSetError(resp.Response, "NS", name, zoneName, err)
 	}
 
 	if resp.ID == nil {
@@ -133,6 +139,7 @@ func resourceArmDnsNsRecordRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("resource_group_name", resGroup)
 	d.Set("zone_name", zoneName)
 	d.Set("ttl", resp.TTL)
+	d.Set("etag", resp.Etag)
 
 	if err := d.Set("records", flattenAzureRmDnsNsRecords(resp.NsRecords)); err != nil {
 		return fmt.Errorf("Error settings `records`: %+v", err)
@@ -143,7 +150,7 @@ func resourceArmDnsNsRecordRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error settings `record`: %+v", err)
 	}
 
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetTags(d, resp.Metadata, meta)
 
 	return nil
 }
@@ -161,15 +168,15 @@ func resourceArmDnsNsRecordDelete(d *schema.ResourceData, meta interface{}) erro
 	name := id.Path["NS"]
 	zoneName := id.Path["dnszones"]
 
-	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.NS, "")
+	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.NS, dnsRecordSetIfMatch(d))
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Error deleting DNS NS Record %s: %+v", name, error)
+		return fmt.Errorf("Error deleting DNS NS Record %s: %+v", name, dnsRecordSetError(resp, "NS", name, zoneName, error))
 	}
 
 	return nil
 }
 
-//TODO: remove this once we remove the `record` attribute
+// TODO: remove this once we remove the `record` attribute
 func flattenAzureRmDnsNsRecordsSet(records *[]dns.NsRecord) []map[string]interface{} {
 	results := make([]map[string]interface{}, 0, len(*records))
 
@@ -36,6 +36,7 @@ func resourceArmDnsPtrRecord() *schema.Resource {
 			"records": {
 				Type:     schema.TypeSet,
 				Required: true,
+				MaxItems: dnsRecordSetMaxRecords,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
@@ -45,6 +46,10 @@ func resourceArmDnsPtrRecord() *schema.Resource {
 				Required: true,
 			},
 
+			"prevent_overwrite": dnsRecordSetPreventOverwriteSchema(),
+
+			"etag": dnsRecordSetETagSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -67,17 +72,17 @@ func resourceArmDnsPtrRecordCreateOrUpdate(d *schema.ResourceData, meta interfac
 
 	parameters := dns.RecordSet{
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   expandTags(tags),
+			Metadata:   expandTags(tags, meta),
 			TTL:        &ttl,
 			PtrRecords: &records,
 		},
 	}
 
-	eTag := ""
+	eTag := dnsRecordSetIfMatch(d)
 	ifNoneMatch := "" // set to empty to allow updates to records after creation
 	resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.PTR, parameters, eTag, ifNoneMatch)
 	if err != nil {
-		return err
+		return dnsRecordSetError(resp.Response, "PTR", name, zoneName, err)
 	}
 
 	if resp.ID == nil {
@@ -118,11 +123,12 @@ func resourceArmDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("zone_name", zoneName)
 	d.Set("ttl", resp.TTL)
 	d.Set("etag", resp.Etag)
+	d.Set("etag", resp.Etag)
 
 	if err := d.Set("records", flattenAzureRmDnsPtrRecords(resp.PtrRecords)); err != nil {
 		return err
 	}
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetTags(d, resp.Metadata, meta)
 
 	return nil
 }
@@ -141,13 +147,13 @@ func resourceArmDnsPtrRecordDelete(d *schema.ResourceData, meta interface{}) err
 	name := id.Path["PTR"]
 	zoneName := id.Path["dnszones"]
 
-	resp, err := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.PTR, "")
+	resp, err := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.PTR, dnsRecordSetIfMatch(d))
 	if err != nil {
 		if resp.StatusCode == http.StatusNotFound {
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting DNS PTR Record %s: %+v", name, err)
+		return fmt.Errorf("Error deleting DNS PTR Record %s: %+v", name, dnsRecordSetError(resp, "PTR", name, zoneName, err))
 	}
 
 	return nil
@@ -3,9 +3,12 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -36,11 +39,13 @@ func resourceArmDnsTxtRecord() *schema.Resource {
 			"record": {
 				Type:     schema.TypeSet,
 				Required: true,
+				MaxItems: dnsRecordSetMaxRecords,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"value": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, dnsTxtRecordMaxValueLength),
 						},
 					},
 				},
@@ -51,6 +56,10 @@ func resourceArmDnsTxtRecord() *schema.Resource {
 				Required: true,
 			},
 
+			"prevent_overwrite": dnsRecordSetPreventOverwriteSchema(),
+
+			"etag": dnsRecordSetETagSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -74,17 +83,17 @@ func resourceArmDnsTxtRecordCreateOrUpdate(d *schema.ResourceData, meta interfac
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   expandTags(tags),
+			Metadata:   expandTags(tags, meta),
 			TTL:        &ttl,
 			TxtRecords: &records,
 		},
 	}
 
-	eTag := ""
+	eTag := dnsRecordSetIfMatch(d)
 	ifNoneMatch := "" // set to empty to allow updates to records after creation
 	resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.TXT, parameters, eTag, ifNoneMatch)
 	if err != nil {
-		return err
+		return dnsRecordSetError(resp.Response, "TXT", name, zoneName, err)
 	}
 
 	if resp.ID == nil {
@@ -122,11 +131,12 @@ func resourceArmDnsTxtRecordRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("resource_group_name", resGroup)
 	d.Set("zone_name", zoneName)
 	d.Set("ttl", resp.TTL)
+	d.Set("etag", resp.Etag)
 
 	if err := d.Set("record", flattenAzureRmDnsTxtRecords(resp.TxtRecords)); err != nil {
 		return err
 	}
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetTags(d, resp.Metadata, meta)
 
 	return nil
 }
@@ -144,9 +154,9 @@ func resourceArmDnsTxtRecordDelete(d *schema.ResourceData, meta interface{}) err
 	name := id.Path["TXT"]
 	zoneName := id.Path["dnszones"]
 
-	resp, error := client.Delete(ctx, resGroup, zoneName, name, dns.TXT, "")
+	resp, error := client.Delete(ctx, resGroup, zoneName, name, dns.TXT, dnsRecordSetIfMatch(d))
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Error deleting DNS TXT Record %s: %+v", name, error)
+		return fmt.Errorf("Error deleting DNS TXT Record %s: %+v", name, dnsRecordSetError(resp, "TXT", name, zoneName, error))
 	}
 
 	return nil
@@ -160,8 +170,8 @@ func flattenAzureRmDnsTxtRecords(records *[]dns.TxtRecord) []map[string]interfac
 			txtRecord := make(map[string]interface{})
 
 			if v := record.Value; v != nil {
-				value := (*v)[0]
-				txtRecord["value"] = value
+				// values longer than 255 characters are split across multiple strings
+				txtRecord["value"] = strings.Join(*v, "")
 			}
 
 			results = append(results, txtRecord)
@@ -177,7 +187,7 @@ func expandAzureRmDnsTxtRecords(d *schema.ResourceData) ([]dns.TxtRecord, error)
 
 	for i, v := range recordStrings {
 		record := v.(map[string]interface{})
-		value := []string{record["value"].(string)}
+		value := splitDnsTxtRecordValue(record["value"].(string))
 
 		txtRecord := dns.TxtRecord{
 			Value: &value,
@@ -188,3 +198,25 @@ func expandAzureRmDnsTxtRecords(d *schema.ResourceData) ([]dns.TxtRecord, error)
 
 	return records, nil
 }
+
+// splitDnsTxtRecordValue splits the value into strings of at most 255 bytes, the maximum length of
+// each string within a TXT record (for example a DKIM public key is typically longer than this) - the
+// value is only split between characters, so that multi-byte UTF-8 characters aren't cut in half
+func splitDnsTxtRecordValue(input string) []string {
+	values := make([]string, 0)
+	for len(input) > dnsTxtRecordMaxStringLength {
+		end := dnsTxtRecordMaxStringLength
+		for end > 0 && !utf8.RuneStart(input[end]) {
+			end--
+		}
+		// the input isn't valid UTF-8, so there's no character boundary to split on
+		if end == 0 {
+			end = dnsTxtRecordMaxStringLength
+		}
+
+		values = append(values, input[:end])
+		input = input[end:]
+	}
+
+	return append(values, input)
+}
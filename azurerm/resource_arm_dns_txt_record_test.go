@@ -3,7 +3,9 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -31,6 +33,90 @@ func TestAccAzureRMDnsTxtRecord_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMDnsTxtRecord_longValue(t *testing.T) {
+	resourceName := "azurerm_dns_txt_record.test"
+	ri := acctest.RandInt()
+	value := strings.Repeat("a", 600)
+	config := testAccAzureRMDnsTxtRecord_longValue(ri, testLocation(), value)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsTxtRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsTxtRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSplitDnsTxtRecordValue(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected []int
+	}{
+		{
+			input:    "",
+			expected: []int{0},
+		},
+		{
+			input:    strings.Repeat("a", 255),
+			expected: []int{255},
+		},
+		{
+			input:    strings.Repeat("a", 256),
+			expected: []int{255, 1},
+		},
+		{
+			input:    strings.Repeat("a", 1024),
+			expected: []int{255, 255, 255, 255, 4},
+		},
+		{
+			// `é` is 2 bytes, so the 128th character would straddle the 255 byte limit
+			input:    strings.Repeat("é", 200),
+			expected: []int{254, 146},
+		},
+		{
+			// `€` is 3 bytes
+			input:    "a" + strings.Repeat("€", 100),
+			expected: []int{253, 48},
+		},
+	}
+
+	for _, v := range testData {
+		actual := splitDnsTxtRecordValue(v.input)
+		if len(actual) != len(v.expected) {
+			t.Fatalf("Expected %d strings for a value of length %d but got %d", len(v.expected), len(v.input), len(actual))
+		}
+
+		for i, length := range v.expected {
+			if len(actual[i]) != length {
+				t.Fatalf("Expected string %d to have length %d but got %d", i, length, len(actual[i]))
+			}
+		}
+
+		if strings.Join(actual, "") != v.input {
+			t.Fatalf("Expected the strings to join to the input value")
+		}
+
+		for i, value := range actual {
+			if !utf8.ValidString(value) {
+				t.Fatalf("Expected string %d to be valid UTF-8 but got %q", i, value)
+			}
+		}
+	}
+}
+
 func TestAccAzureRMDnsTxtRecord_updateRecords(t *testing.T) {
 	resourceName := "azurerm_dns_txt_record.test"
 	ri := acctest.RandInt()
@@ -177,6 +263,31 @@ resource "azurerm_dns_txt_record" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMDnsTxtRecord_longValue(rInt int, location string, value string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dns_txt_record" "test" {
+  name                = "myarecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  ttl                 = 300
+
+  record {
+    value = "%s"
+  }
+}
+`, rInt, location, rInt, rInt, value)
+}
+
 func testAccAzureRMDnsTxtRecord_updateRecords(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -65,7 +65,7 @@ func resourceArmEventGridTopicCreateUpdate(d *schema.ResourceData, meta interfac
 	properties := eventgrid.Topic{
 		Location:        &location,
 		TopicProperties: &eventgrid.TopicProperties{},
-		Tags:            expandTags(tags),
+		Tags:            expandTags(tags, meta),
 	}
 
 	log.Printf("[INFO] preparing arguments for AzureRM EventGrid Topic creation with Properties: %+v.", properties)
@@ -75,7 +75,9 @@ func resourceArmEventGridTopicCreateUpdate(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.EventGrid", "topics", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return err
 	}
@@ -133,7 +135,7 @@ func resourceArmEventGridTopicRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("primary_access_key", keys.Key1)
 	d.Set("secondary_access_key", keys.Key2)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -157,7 +159,7 @@ func resourceArmEventGridTopicDelete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error deleting Event Grid Topic %q: %+v", name, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		if response.WasNotFound(future.Response()) {
 			return nil
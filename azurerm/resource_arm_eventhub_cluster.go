@@ -0,0 +1,160 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourcemanager"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmEventHubCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmEventHubClusterCreateUpdate,
+		Read:   resourceArmEventHubClusterRead,
+		Update: resourceArmEventHubClusterCreateUpdate,
+		Delete: resourceArmEventHubClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{4,48}[a-zA-Z0-9]$"),
+					"The cluster name can contain only letters, numbers and hyphens. The cluster must start with a letter, and it must end with a letter or number and be between 6 and 50 characters long.",
+				),
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmEventHubClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).eventHubClustersClient
+	ctx := meta.(*ArmClient).StopContext
+	log.Printf("[INFO] preparing arguments for AzureRM EventHub Cluster creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := eventHubCluster{
+		Location: utils.String(location),
+		Sku: &eventHubClusterSku{
+			Name:     "Dedicated",
+			Capacity: utils.Int32(int32(d.Get("capacity").(int))),
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	id := eventHubClusterResourceID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	var future resourcemanager.Future
+	var err error
+	if d.IsNewResource() {
+		future, err = client.CreateOrUpdate(ctx, id, parameters)
+	} else {
+		// an existing Dedicated Cluster can only be updated using a PATCH
+		future, err = client.Update(ctx, id, parameters)
+	}
+	if err != nil {
+		return fmt.Errorf("Error creating/updating EventHub Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of EventHub Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmEventHubClusterRead(d, meta)
+}
+
+func resourceArmEventHubClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).eventHubClustersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["clusters"]
+
+	var cluster eventHubCluster
+	resp, err := client.Get(ctx, d.Id(), &cluster)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] EventHub Cluster %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving EventHub Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := cluster.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := cluster.Sku; sku != nil {
+		d.Set("capacity", sku.Capacity)
+	}
+
+	flattenAndSetTags(d, cluster.Tags, meta)
+
+	return nil
+}
+
+func resourceArmEventHubClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).eventHubClustersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	// a Dedicated Cluster can't be deleted until four hours after it was created, so we keep retrying until it can be
+	return resource.Retry(5*time.Hour, func() *resource.RetryError {
+		future, err := client.Delete(ctx, d.Id())
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				return nil
+			}
+
+			if resp := future.Response(); resp != nil && resp.StatusCode == http.StatusBadRequest {
+				return resource.RetryableError(fmt.Errorf("Error deleting EventHub Cluster %q: %+v", d.Id(), err))
+			}
+
+			return resource.NonRetryableError(fmt.Errorf("Error deleting EventHub Cluster %q: %+v", d.Id(), err))
+		}
+
+		if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error waiting for deletion of EventHub Cluster %q: %+v", d.Id(), err))
+		}
+
+		return nil
+	})
+}
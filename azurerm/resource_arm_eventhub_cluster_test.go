@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Dedicated EventHub Clusters are billed for a minimum of four hours and can't be deleted until four hours after
+// they've been created, as such these tests only run when the `ARM_TEST_EVENTHUB_CLUSTER` Environment Variable is set.
+func testAccAzureRMEventHubClusterPreCheck(t *testing.T) {
+	if os.Getenv("ARM_TEST_EVENTHUB_CLUSTER") == "" {
+		t.Skip("`ARM_TEST_EVENTHUB_CLUSTER` must be set to run the EventHub Cluster tests")
+	}
+}
+
+func TestExpandEventHubNamespaceWithDedicatedCluster(t *testing.T) {
+	clusterId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.EventHub/clusters/cluster1"
+	parameters := eventhub.EHNamespace{
+		Location: utils.String("westeurope"),
+		Sku: &eventhub.Sku{
+			Name: eventhub.Standard,
+			Tier: eventhub.SkuTierStandard,
+		},
+		EHNamespaceProperties: &eventhub.EHNamespaceProperties{
+			IsAutoInflateEnabled: utils.Bool(false),
+		},
+	}
+
+	output, err := expandEventHubNamespaceWithDedicatedCluster(parameters, clusterId)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if output["location"] != "westeurope" {
+		t.Fatalf("Expected the location to be retained but got %+v", output["location"])
+	}
+
+	properties := output["properties"].(map[string]interface{})
+	if properties["clusterArmId"] != clusterId {
+		t.Fatalf("Expected `clusterArmId` to be %q but got %+v", clusterId, properties["clusterArmId"])
+	}
+
+	if properties["isAutoInflateEnabled"] != false {
+		t.Fatalf("Expected the existing properties to be retained but got %+v", properties)
+	}
+}
+
+func TestAccAzureRMEventHubCluster_basic(t *testing.T) {
+	testAccAzureRMEventHubClusterPreCheck(t)
+
+	resourceName := "azurerm_eventhub_cluster.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMEventHubCluster_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMEventHubCluster_namespace(t *testing.T) {
+	testAccAzureRMEventHubClusterPreCheck(t)
+
+	resourceName := "azurerm_eventhub_namespace.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMEventHubCluster_namespace(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubClusterExists("azurerm_eventhub_cluster.test"),
+					testCheckAzureRMEventHubNamespaceExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "dedicated_cluster_id", "azurerm_eventhub_cluster.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMEventHubClusterDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).eventHubClustersClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_eventhub_cluster" {
+			continue
+		}
+
+		var cluster eventHubCluster
+		resp, err := client.Get(ctx, rs.Primary.ID, &cluster)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("EventHub Cluster still exists:\n%#v", cluster)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMEventHubClusterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).eventHubClustersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var cluster eventHubCluster
+		resp, err := client.Get(ctx, rs.Primary.ID, &cluster)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: EventHub Cluster %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on eventHubClustersClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMEventHubCluster_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_cluster" "test" {
+  name                = "acctesteventhubcluster-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMEventHubCluster_namespace(rInt int, location string) string {
+	template := testAccAzureRMEventHubCluster_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                 = "acctesteventhubnamespace-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  sku                  = "Standard"
+  dedicated_cluster_id = "${azurerm_eventhub_cluster.test.id}"
+}
+`, template, rInt)
+}
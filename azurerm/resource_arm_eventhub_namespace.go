@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -72,6 +73,14 @@ func resourceArmEventHubNamespace() *schema.Resource {
 				ValidateFunc: validation.IntBetween(1, 20),
 			},
 
+			"dedicated_cluster_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
 			"default_primary_connection_string": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -125,7 +134,7 @@ func resourceArmEventHubNamespaceCreate(d *schema.ResourceData, meta interface{}
 		EHNamespaceProperties: &eventhub.EHNamespaceProperties{
 			IsAutoInflateEnabled: utils.Bool(autoInflateEnabled),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	if v, ok := d.GetOk("maximum_throughput_units"); ok {
@@ -133,14 +142,37 @@ func resourceArmEventHubNamespaceCreate(d *schema.ResourceData, meta interface{}
 		parameters.EHNamespaceProperties.MaximumThroughputUnits = utils.Int32(int32(maximumThroughputUnits))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
-	if err != nil {
-		return err
-	}
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.EventHub", "namespaces", name)
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error creating eventhub namespace: %+v", err)
+	if clusterId := d.Get("dedicated_cluster_id").(string); clusterId != "" {
+		// placing a Namespace on a Dedicated Cluster requires a newer API Version than the Azure SDK supports
+		previewClient := meta.(*ArmClient).eventHubNamespacesPreviewClient
+
+		body, err := expandEventHubNamespaceWithDedicatedCluster(parameters, clusterId)
+		if err != nil {
+			return err
+		}
+
+		future, err := previewClient.CreateOrUpdate(ctx, expectedId, body)
+		if err != nil {
+			return err
+		}
+
+		err = waitForCreateOrUpdate(ctx, d, &future.Future, previewClient.Client, expectedId)
+		if err != nil {
+			return fmt.Errorf("Error creating eventhub namespace: %+v", err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
+		if err != nil {
+			return err
+		}
+
+		err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
+		if err != nil {
+			return fmt.Errorf("Error creating eventhub namespace: %+v", err)
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
@@ -201,7 +233,18 @@ func resourceArmEventHubNamespaceRead(d *schema.ResourceData, meta interface{})
 		d.Set("maximum_throughput_units", int(*props.MaximumThroughputUnits))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	// the ID of the Dedicated Cluster isn't returned in the version of the API used by the Azure SDK
+	var clusterProps eventHubNamespaceClusterProperties
+	if _, err := meta.(*ArmClient).eventHubNamespacesPreviewClient.Get(ctx, d.Id(), &clusterProps); err != nil {
+		return fmt.Errorf("Error retrieving Dedicated Cluster for EventHub Namespace %q: %+v", name, err)
+	}
+	clusterId := ""
+	if props := clusterProps.Properties; props != nil && props.ClusterArmID != nil {
+		clusterId = *props.ClusterArmID
+	}
+	d.Set("dedicated_cluster_id", clusterId)
+
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
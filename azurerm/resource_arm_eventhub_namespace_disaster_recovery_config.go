@@ -0,0 +1,282 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmEventHubNamespaceDisasterRecoveryConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmEventHubNamespaceDisasterRecoveryConfigCreate,
+		Read:   resourceArmEventHubNamespaceDisasterRecoveryConfigRead,
+		Delete: resourceArmEventHubNamespaceDisasterRecoveryConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateEventHubNamespaceName(),
+			},
+
+			"primary_namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateEventHubNamespaceName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"partner_namespace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"alternate_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateEventHubNamespaceName(),
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_primary_connection_string_alias": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_secondary_connection_string_alias": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmEventHubNamespaceDisasterRecoveryConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).eventHubDisasterRecoveryClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for EventHub Namespace Disaster Recovery Config creation.")
+
+	name := d.Get("name").(string)
+	namespaceName := d.Get("primary_namespace_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	parameters := eventhub.ArmDisasterRecovery{
+		ArmDisasterRecoveryProperties: &eventhub.ArmDisasterRecoveryProperties{
+			PartnerNamespace: utils.String(d.Get("partner_namespace_id").(string)),
+		},
+	}
+
+	if alternateName := d.Get("alternate_name").(string); alternateName != "" {
+		parameters.ArmDisasterRecoveryProperties.AlternateName = utils.String(alternateName)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, namespaceName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	if err := waitForEventHubNamespaceDisasterRecoveryConfigToProvision(ctx, client, resourceGroup, namespaceName, name); err != nil {
+		return err
+	}
+
+	read, err := client.Get(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q)", name, namespaceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmEventHubNamespaceDisasterRecoveryConfigRead(d, meta)
+}
+
+func resourceArmEventHubNamespaceDisasterRecoveryConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).eventHubDisasterRecoveryClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["disasterRecoveryConfigs"]
+
+	resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Disaster Recovery Config %q was not found in EventHub Namespace %q / Resource Group %q - removing from state!", name, namespaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("primary_namespace_name", namespaceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.ArmDisasterRecoveryProperties; props != nil {
+		d.Set("partner_namespace_id", props.PartnerNamespace)
+		d.Set("alternate_name", props.AlternateName)
+		d.Set("role", string(props.Role))
+	}
+
+	keys, err := client.ListKeys(ctx, resourceGroup, namespaceName, name, eventHubNamespaceDefaultAuthorizationRule)
+	if err != nil {
+		log.Printf("[WARN] Unable to List default keys for Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	} else {
+		d.Set("default_primary_connection_string_alias", keys.AliasPrimaryConnectionString)
+		d.Set("default_secondary_connection_string_alias", keys.AliasSecondaryConnectionString)
+	}
+
+	return nil
+}
+
+func resourceArmEventHubNamespaceDisasterRecoveryConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).eventHubDisasterRecoveryClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["disasterRecoveryConfigs"]
+
+	read, err := client.Get(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	// an Alias which is still paired can't be deleted, so the pairing has to be broken first
+	if props := read.ArmDisasterRecoveryProperties; props != nil && props.Role == eventhub.Primary {
+		if _, err := client.BreakPairing(ctx, resourceGroup, namespaceName, name); err != nil {
+			return fmt.Errorf("Error breaking the pairing for Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+		}
+
+		log.Printf("[DEBUG] Waiting for the pairing of Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q) to be broken", name, namespaceName, resourceGroup)
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{string(eventhub.Primary)},
+			Target:  []string{string(eventhub.PrimaryNotReplicating)},
+			Refresh: func() (interface{}, string, error) {
+				read, err := client.Get(ctx, resourceGroup, namespaceName, name)
+				if err != nil {
+					return nil, "Error", err
+				}
+
+				if props := read.ArmDisasterRecoveryProperties; props != nil && props.ProvisioningState == eventhub.Succeeded {
+					return read, string(props.Role), nil
+				}
+
+				return read, string(eventhub.Primary), nil
+			},
+			Timeout:    30 * time.Minute,
+			MinTimeout: 15 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for the pairing of Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q) to be broken: %+v", name, namespaceName, resourceGroup, err)
+		}
+	}
+
+	resp, err := client.Delete(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if response.WasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	// the Namespaces can't be deleted (or re-paired) until the Alias has been fully removed
+	log.Printf("[DEBUG] Waiting for Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q) to be deleted", name, namespaceName, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Pending"},
+		Target:  []string{"NotFound"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotFound", nil
+				}
+
+				return nil, "Error", err
+			}
+
+			return resp, "Pending", nil
+		},
+		Timeout:    30 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q) to be deleted: %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func waitForEventHubNamespaceDisasterRecoveryConfigToProvision(ctx context.Context, client eventhub.DisasterRecoveryConfigsClient, resourceGroup, namespaceName, name string) error {
+	log.Printf("[DEBUG] Waiting for Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q) to finish provisioning", name, namespaceName, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(eventhub.Accepted)},
+		Target:  []string{string(eventhub.Succeeded)},
+		Refresh: func() (interface{}, string, error) {
+			read, err := client.Get(ctx, resourceGroup, namespaceName, name)
+			if err != nil {
+				return nil, "Error", err
+			}
+
+			if props := read.ArmDisasterRecoveryProperties; props != nil {
+				if props.ProvisioningState == eventhub.Failed {
+					return read, "Failed", fmt.Errorf("Provisioning of the Disaster Recovery Config failed")
+				}
+
+				return read, string(props.ProvisioningState), nil
+			}
+
+			return read, string(eventhub.Accepted), nil
+		},
+		Timeout:    30 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q) to finish provisioning: %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	return nil
+}
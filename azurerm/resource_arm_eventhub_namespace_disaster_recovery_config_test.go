@@ -0,0 +1,123 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMEventHubNamespaceDisasterRecoveryConfig_basic(t *testing.T) {
+	resourceName := "azurerm_eventhub_namespace_disaster_recovery_config.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMEventHubNamespaceDisasterRecoveryConfig_basic(ri, testLocation(), testAltLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", "Primary"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_primary_connection_string_alias"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_secondary_connection_string_alias"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).eventHubDisasterRecoveryClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_eventhub_namespace_disaster_recovery_config" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		namespaceName := rs.Primary.Attributes["primary_namespace_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Disaster Recovery Config still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		namespaceName := rs.Primary.Attributes["primary_namespace_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Disaster Recovery Config: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).eventHubDisasterRecoveryClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on eventHubDisasterRecoveryClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q) does not exist", name, namespaceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMEventHubNamespaceDisasterRecoveryConfig_basic(rInt int, location, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "primary" {
+  name                = "acctestehnamespace-%d-primary"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+  capacity            = 1
+}
+
+resource "azurerm_eventhub_namespace" "secondary" {
+  name                = "acctestehnamespace-%d-secondary"
+  location            = "%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+  capacity            = 1
+}
+
+resource "azurerm_eventhub_namespace_disaster_recovery_config" "test" {
+  name                   = "acctest-alias-%d"
+  primary_namespace_name = "${azurerm_eventhub_namespace.primary.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  partner_namespace_id   = "${azurerm_eventhub_namespace.secondary.id}"
+}
+`, rInt, location, rInt, rInt, altLocation, rInt)
+}
@@ -117,7 +117,23 @@ func resourceArmExpressRouteCircuitCreateOrUpdate(d *schema.ResourceData, meta i
 	sku := expandExpressRouteCircuitSku(d)
 	allowRdfeOps := d.Get("allow_classic_operations").(bool)
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTags(tags, meta)
+
+	if hasOnlyTagChanges(d, "azurerm_express_route_circuit", resourceArmExpressRouteCircuit) {
+		log.Printf("[DEBUG] Only `tags` have changed - updating the Tags for ExpressRoute Circuit %q (Resource Group %q)", name, resGroup)
+		future, err := client.UpdateTags(ctx, resGroup, name, network.TagsObject{
+			Tags: expandedTags,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating Tags for ExpressRoute Circuit %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Tags for ExpressRoute Circuit %q (Resource Group %q) to be updated: %+v", name, resGroup, err)
+		}
+
+		return resourceArmExpressRouteCircuitRead(d, meta)
+	}
 
 	erc := network.ExpressRouteCircuit{
 		Name:     &name,
@@ -142,7 +158,9 @@ func resourceArmExpressRouteCircuitCreateOrUpdate(d *schema.ResourceData, meta i
 		return fmt.Errorf("Error Creating/Updating ExpressRouteCircuit %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Network", "expressRouteCircuits", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error Creating/Updating ExpressRouteCircuit %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -195,7 +213,7 @@ func resourceArmExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}
 	d.Set("service_key", resp.ServiceKey)
 	d.Set("allow_classic_operations", resp.AllowClassicOperations)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -217,7 +235,7 @@ func resourceArmExpressRouteCircuitDelete(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return err
 	}
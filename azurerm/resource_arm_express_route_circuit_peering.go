@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -84,6 +85,12 @@ func resourceArmExpressRouteCircuitPeering() *schema.Resource {
 				},
 			},
 
+			"route_filter_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
 			"azure_asn": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -141,6 +148,16 @@ func resourceArmExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, m
 		parameters.ExpressRouteCircuitPeeringPropertiesFormat.MicrosoftPeeringConfig = peeringConfig
 	}
 
+	if v, ok := d.GetOk("route_filter_id"); ok {
+		if !strings.EqualFold(peeringType, string(network.MicrosoftPeering)) {
+			return fmt.Errorf("`route_filter_id` can only be specified when `peering_type` is set to `MicrosoftPeering`")
+		}
+
+		parameters.ExpressRouteCircuitPeeringPropertiesFormat.RouteFilter = &network.RouteFilter{
+			ID: utils.String(v.(string)),
+		}
+	}
+
 	azureRMLockByName(circuitName, expressRouteCircuitResourceName)
 	defer azureRMUnlockByName(circuitName, expressRouteCircuitResourceName)
 
@@ -149,7 +166,9 @@ func resourceArmExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, m
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := fmt.Sprintf("%s/peerings/%s", azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Network", "expressRouteCircuits", circuitName), peeringType)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return err
 	}
@@ -202,6 +221,12 @@ func resourceArmExpressRouteCircuitPeeringRead(d *schema.ResourceData, meta inte
 		if err := d.Set("microsoft_peering_config", config); err != nil {
 			return fmt.Errorf("Error flattening `microsoft_peering_config`: %+v", err)
 		}
+
+		routeFilterId := ""
+		if filter := props.RouteFilter; filter != nil && filter.ID != nil {
+			routeFilterId = *filter.ID
+		}
+		d.Set("route_filter_id", routeFilterId)
 	}
 
 	return nil
@@ -231,7 +256,7 @@ func resourceArmExpressRouteCircuitPeeringDelete(d *schema.ResourceData, meta in
 		return fmt.Errorf("Error issuing delete request for Express Route Circuit Peering %q (Circuit %q / Resource Group %q): %+v", peeringType, circuitName, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		if response.WasNotFound(future.Response()) {
 			return nil
@@ -55,6 +55,28 @@ func testAccAzureRMExpressRouteCircuitPeering_microsoftPeering(t *testing.T) {
 	})
 }
 
+func testAccAzureRMExpressRouteCircuitPeering_microsoftPeeringRouteFilter(t *testing.T) {
+	resourceName := "azurerm_express_route_circuit_peering.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteCircuitPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteCircuitPeering_msPeeringRouteFilter(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitPeeringExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "peering_type", "MicrosoftPeering"),
+					resource.TestCheckResourceAttrSet(resourceName, "route_filter_id"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMExpressRouteCircuitPeeringExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -192,3 +214,54 @@ resource "azurerm_express_route_circuit_peering" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMExpressRouteCircuitPeering_msPeeringRouteFilter(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  service_provider_name = "Equinix"
+  peering_location      = "Silicon Valley"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Premium"
+    family = "MeteredData"
+  }
+}
+
+resource "azurerm_route_filter" "test" {
+  name                = "acctestrf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  rule {
+    name        = "rule1"
+    access      = "Allow"
+    rule_type   = "Community"
+    communities = ["12076:5010"]
+  }
+}
+
+resource "azurerm_express_route_circuit_peering" "test" {
+  peering_type                  = "MicrosoftPeering"
+  express_route_circuit_name    = "${azurerm_express_route_circuit.test.name}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  peer_asn                      = 100
+  primary_peer_address_prefix   = "192.168.1.0/30"
+  secondary_peer_address_prefix = "192.168.2.0/30"
+  vlan_id                       = 300
+  route_filter_id               = "${azurerm_route_filter.test.id}"
+
+  microsoft_peering_config {
+    advertised_public_prefixes = ["123.1.0.0/24"]
+  }
+}
+`, rInt, location, rInt, rInt)
+}
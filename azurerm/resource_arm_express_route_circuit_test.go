@@ -24,6 +24,7 @@ func TestAccAzureRMExpressRouteCircuit(t *testing.T) {
 			"premiumMetered":               testAccAzureRMExpressRouteCircuit_premiumMetered,
 			"premiumUnlimited":             testAccAzureRMExpressRouteCircuit_premiumUnlimited,
 			"allowClassicOperationsUpdate": testAccAzureRMExpressRouteCircuit_allowClassicOperationsUpdate,
+			"tagsUpdate":                   testAccAzureRMExpressRouteCircuit_tagsUpdate,
 		},
 		"PrivatePeering": {
 			"azurePrivatePeering":  testAccAzureRMExpressRouteCircuitPeering_azurePrivatePeering,
@@ -32,6 +33,7 @@ func TestAccAzureRMExpressRouteCircuit(t *testing.T) {
 		"MicrosoftPeering": {
 			"microsoftPeering":       testAccAzureRMExpressRouteCircuitPeering_microsoftPeering,
 			"importMicrosoftPeering": testAccAzureRMExpressRouteCircuitPeering_importMicrosoftPeering,
+			"routeFilter":            testAccAzureRMExpressRouteCircuitPeering_microsoftPeeringRouteFilter,
 		},
 		"authorization": {
 			"basic":    testAccAzureRMExpressRouteCircuitAuthorization_basic,
@@ -126,6 +128,37 @@ func testAccAzureRMExpressRouteCircuit_update(t *testing.T) {
 	})
 }
 
+func testAccAzureRMExpressRouteCircuit_tagsUpdate(t *testing.T) {
+	resourceName := "azurerm_express_route_circuit.test"
+	var erc network.ExpressRouteCircuit
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteCircuitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteCircuit_basicMeteredConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitExists(resourceName, &erc),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMExpressRouteCircuit_tagsUpdatedConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitExists(resourceName, &erc),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "staging"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.family", "MeteredData"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAzureRMExpressRouteCircuit_tierUpdate(t *testing.T) {
 	resourceName := "azurerm_express_route_circuit.test"
 	var erc network.ExpressRouteCircuit
@@ -313,6 +346,35 @@ resource "azurerm_express_route_circuit" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMExpressRouteCircuit_tagsUpdatedConfig(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  service_provider_name = "Equinix"
+  peering_location      = "Silicon Valley"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+
+  allow_classic_operations = false
+
+  tags {
+    Environment = "staging"
+  }
+}
+`, rInt, location, rInt)
+}
+
 func testAccAzureRMExpressRouteCircuit_basicUnlimitedConfig(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
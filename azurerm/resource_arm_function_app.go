@@ -244,7 +244,7 @@ func resourceArmFunctionAppCreate(d *schema.ResourceData, meta interface{}) erro
 	siteEnvelope := web.Site{
 		Kind:     &kind,
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID:          utils.String(appServicePlanID),
 			Enabled:               utils.Bool(enabled),
@@ -265,7 +265,9 @@ func resourceArmFunctionAppCreate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	err = createFuture.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Web", "sites", name)
+	err = waitForCreateOrUpdate(ctx, d, &createFuture.Future, client.Client, expectedId)
 	if err != nil {
 		return err
 	}
@@ -315,7 +317,7 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 	siteEnvelope := web.Site{
 		Kind:     &kind,
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID:          utils.String(appServicePlanID),
 			Enabled:               utils.Bool(enabled),
@@ -336,7 +338,7 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -414,7 +416,7 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	err = siteCredFuture.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &siteCredFuture.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -475,7 +477,7 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
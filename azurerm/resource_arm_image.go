@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
 
@@ -37,6 +38,7 @@ func resourceArmImage() *schema.Resource {
 			"source_virtual_machine_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				ForceNew:     true,
 				ValidateFunc: azure.ValidateResourceID,
 			},
 
@@ -166,7 +168,7 @@ func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 	name := d.Get("name").(string)
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	resGroup := d.Get("resource_group_name").(string)
-	expandedTags := expandTags(d.Get("tags").(map[string]interface{}))
+	expandedTags := expandTags(d.Get("tags").(map[string]interface{}), meta)
 
 	properties := compute.ImageProperties{}
 
@@ -204,7 +206,11 @@ func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 			StorageProfile: &storageProfile,
 		}
 	} else {
-		//creating an image from source VM
+		//creating an image from source VM, which must have been generalized
+		if err := validateImageSourceVirtualMachineIsGeneralized(*sourceVM.ID, meta); err != nil {
+			return err
+		}
+
 		properties = compute.ImageProperties{
 			SourceVirtualMachine: &sourceVM,
 		}
@@ -222,7 +228,9 @@ func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Compute", "images", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return err
 	}
@@ -283,7 +291,7 @@ func resourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -304,7 +312,7 @@ func resourceArmImageDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -440,3 +448,32 @@ func expandAzureRmImageDataDisks(d *schema.ResourceData) ([]compute.ImageDataDis
 	return dataDisks, nil
 
 }
+
+// validateImageSourceVirtualMachineIsGeneralized returns an error if the Virtual Machine hasn't been generalized,
+// since Azure otherwise returns a generic error when creating the Image
+func validateImageSourceVirtualMachineIsGeneralized(virtualMachineId string, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(virtualMachineId)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["virtualMachines"]
+
+	instanceView, err := client.InstanceView(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Instance View for Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if statuses := instanceView.Statuses; statuses != nil {
+		for _, status := range *statuses {
+			if status.Code != nil && strings.EqualFold(*status.Code, "OSState/generalized") {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("Virtual Machine %q (Resource Group %q) must be generalized before an Image can be created from it - see https://docs.microsoft.com/en-us/azure/virtual-machines/linux/capture-image for more information", name, resGroup)
+}
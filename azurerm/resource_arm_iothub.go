@@ -295,7 +295,7 @@ func resourceArmIotHubCreateAndUpdate(d *schema.ResourceData, meta interface{})
 		Name:       utils.String(name),
 		Location:   utils.String(location),
 		Sku:        &skuInfo,
-		Tags:       expandTags(tags),
+		Tags:       expandTags(tags, meta),
 		Properties: &iotHubProperties,
 	}
 
@@ -304,7 +304,9 @@ func resourceArmIotHubCreateAndUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating/updating IotHub %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Devices", "IotHubs", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error waiting for the completion of the creating/updating of IotHub %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -399,7 +401,7 @@ func resourceArmIotHubRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error flattening `sku`: %+v", err)
 	}
 	d.Set("type", hub.Type)
-	flattenAndSetTags(d, hub.Tags)
+	flattenAndSetTags(d, hub.Tags, meta)
 
 	return nil
 }
@@ -7,12 +7,13 @@ import (
 	"regexp"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2016-10-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/satori/go.uuid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -100,6 +101,7 @@ func resourceArmKeyVault() *schema.Resource {
 						"certificate_permissions": azure.SchemaKeyVaultCertificatePermissions(),
 						"key_permissions":         azure.SchemaKeyVaultKeyPermissions(),
 						"secret_permissions":      azure.SchemaKeyVaultSecretPermissions(),
+						"storage_permissions":     azure.SchemaKeyVaultStoragePermissions(),
 					},
 				},
 			},
@@ -119,6 +121,16 @@ func resourceArmKeyVault() *schema.Resource {
 				Optional: true,
 			},
 
+			"network_acls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: azure.SchemaKeyVaultNetworkAcls(),
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -144,6 +156,11 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error expanding `access_policy`: %+v", policies)
 	}
 
+	networkAcls, err := expandKeyVaultNetworkAcls(d.Get("network_acls").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `network_acls`: %+v", err)
+	}
+
 	parameters := keyvault.VaultCreateOrUpdateParameters{
 		Location: &location,
 		Properties: &keyvault.VaultProperties{
@@ -153,8 +170,9 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 			EnabledForDeployment:         &enabledForDeployment,
 			EnabledForDiskEncryption:     &enabledForDiskEncryption,
 			EnabledForTemplateDeployment: &enabledForTemplateDeployment,
+			NetworkAcls:                  networkAcls,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	// Locking this resource so we don't make modifications to it at the same time if there is a
@@ -162,11 +180,29 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockByName(name, keyVaultResourceName)
 	defer azureRMUnlockByName(name, keyVaultResourceName)
 
-	_, err = client.CreateOrUpdate(ctx, resGroup, name, parameters)
+	if d.IsNewResource() && meta.(*ArmClient).features.Enabled(features.KeyVaultRecoverSoftDeletedKeyVaults) {
+		deleted, err := client.GetDeleted(ctx, name, location)
+		if err != nil {
+			if !utils.ResponseWasNotFound(deleted.Response) {
+				return fmt.Errorf("Error checking for a soft-deleted Key Vault %q (Location %q): %+v", name, location, err)
+			}
+		} else {
+			log.Printf("[DEBUG] Found a soft-deleted Key Vault %q (Location %q) - recovering", name, location)
+			parameters.Properties.CreateMode = keyvault.CreateModeRecover
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
 	if err != nil {
 		return fmt.Errorf("Error updating Key Vault %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.KeyVault", "vaults", name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for update of Key Vault %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resGroup, err)
@@ -240,10 +276,14 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("access_policy", flattenedPolicies); err != nil {
 			return fmt.Errorf("Error flattening `access_policy` for KeyVault %s: %+v", *resp.Name, err)
 		}
+
+		if err := d.Set("network_acls", flattenKeyVaultNetworkAcls(props.NetworkAcls)); err != nil {
+			return fmt.Errorf("Error flattening `network_acls` for KeyVault %s: %+v", *resp.Name, err)
+		}
 		d.Set("vault_uri", props.VaultURI)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 	return nil
 }
 
@@ -261,9 +301,34 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 	azureRMLockByName(name, keyVaultResourceName)
 	defer azureRMUnlockByName(name, keyVaultResourceName)
 
-	_, err = client.Delete(ctx, resGroup, name)
+	read, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if _, err = client.Delete(ctx, resGroup, name); err != nil {
+		return fmt.Errorf("Error deleting Key Vault %q (Resource Group %q): %+v", name, resGroup, err)
+	}
 
-	return err
+	softDeleteEnabled := read.Properties != nil && read.Properties.EnableSoftDelete != nil && *read.Properties.EnableSoftDelete
+	if softDeleteEnabled && read.Location != nil && meta.(*ArmClient).features.Enabled(features.KeyVaultPurgeSoftDeleteOnDestroy) {
+		location := azureRMNormalizeLocation(*read.Location)
+		log.Printf("[DEBUG] Purging soft-deleted Key Vault %q (Location %q)", name, location)
+		future, err := client.PurgeDeleted(ctx, name, location)
+		if err != nil {
+			return fmt.Errorf("Error purging soft-deleted Key Vault %q (Location %q): %+v", name, location, err)
+		}
+
+		if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the purge of soft-deleted Key Vault %q (Location %q): %+v", name, location, err)
+		}
+	}
+
+	return nil
 }
 
 func expandKeyVaultSku(d *schema.ResourceData) *keyvault.Sku {
@@ -284,6 +349,22 @@ func flattenKeyVaultSku(sku *keyvault.Sku) []interface{} {
 	return []interface{}{result}
 }
 
+func expandKeyVaultNetworkAcls(input []interface{}) (*keyvault.NetworkRuleSet, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	return azure.ExpandKeyVaultNetworkAcls(input[0].(map[string]interface{}))
+}
+
+func flattenKeyVaultNetworkAcls(input *keyvault.NetworkRuleSet) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{azure.FlattenKeyVaultNetworkAcls(input)}
+}
+
 func validateKeyVaultName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if matched := regexp.MustCompile(`^[a-zA-Z0-9-]{3,24}$`).Match([]byte(value)); !matched {
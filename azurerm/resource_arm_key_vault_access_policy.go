@@ -6,10 +6,11 @@ import (
 
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2016-10-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/satori/go.uuid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -58,6 +59,18 @@ func resourceArmKeyVaultAccessPolicy() *schema.Resource {
 			"key_permissions": azure.SchemaKeyVaultKeyPermissions(),
 
 			"secret_permissions": azure.SchemaKeyVaultSecretPermissions(),
+
+			"storage_permissions": azure.SchemaKeyVaultStoragePermissions(),
+
+			// the permissions granted by an existing Access Policy (e.g. `keys/delete`) which this resource was merged
+			// into, that aren't specified in the configuration - these are retained, but aren't managed by this resource
+			"unmanaged_permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -87,6 +100,9 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 	secretPermissionsRaw := d.Get("secret_permissions").([]interface{})
 	secretPermissions := azure.ExpandSecretPermissions(secretPermissionsRaw)
 
+	storagePermissionsRaw := d.Get("storage_permissions").([]interface{})
+	storagePermissions := azure.ExpandStoragePermissions(storagePermissionsRaw)
+
 	accessPolicy := keyvault.AccessPolicyEntry{
 		ObjectID: utils.String(objectId),
 		TenantID: &tenantId,
@@ -94,6 +110,7 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 			Certificates: certPermissions,
 			Keys:         keyPermissions,
 			Secrets:      secretPermissions,
+			Storage:      storagePermissions,
 		},
 	}
 
@@ -107,6 +124,56 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 		accessPolicy.ApplicationID = &applicationId
 	}
 
+	unmanagedPermissions := make([]string, 0)
+	for _, v := range d.Get("unmanaged_permissions").([]interface{}) {
+		unmanagedPermissions = append(unmanagedPermissions, v.(string))
+	}
+
+	// Locking to prevent parallel changes causing issues
+	azureRMLockByName(vaultName, keyVaultResourceName)
+	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, vaultName)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+		}
+
+		var existingPolicies *[]keyvault.AccessPolicyEntry
+		if existing.Properties != nil {
+			existingPolicies = existing.Properties.AccessPolicies
+		}
+
+		existingPolicy, err := findKeyVaultAccessPolicy(existingPolicies, objectId, applicationIdRaw)
+		if err != nil {
+			return fmt.Errorf("Error locating Access Policy (Object ID %q / Application ID %q) in Key Vault %q (Resource Group %q): %+v", objectId, applicationIdRaw, vaultName, resGroup, err)
+		}
+
+		if existingPolicy != nil {
+			if meta.(*ArmClient).features.Enabled(features.KeyVaultRequireImportOfExistingAccessPolicies) {
+				return fmt.Errorf("An Access Policy (Object ID %q / Application ID %q) already exists in Key Vault %q (Resource Group %q) - to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information.", objectId, applicationIdRaw, vaultName, resGroup, "azurerm_key_vault_access_policy")
+			}
+
+			if existingPolicy.TenantID != nil && !uuid.Equal(*existingPolicy.TenantID, tenantId) {
+				return fmt.Errorf("An Access Policy (Object ID %q / Application ID %q) already exists in Key Vault %q (Resource Group %q) for Tenant ID %q, which conflicts with the Tenant ID %q - the permissions can't be merged into it", objectId, applicationIdRaw, vaultName, resGroup, existingPolicy.TenantID.String(), tenantIdRaw)
+			}
+
+			// the permissions granted outside of Terraform are kept in the merged Access Policy, but aren't
+			// managed by this resource - so they don't show up as drift and aren't removed when this is deleted
+			merged, additional := mergeKeyVaultAccessPolicyPermissions(existingPolicy.Permissions, accessPolicy.Permissions)
+			accessPolicy.Permissions = merged
+			unmanagedPermissions = additional
+			action = keyvault.Replace
+		}
+	} else if action == keyvault.Replace {
+		// the unmanaged permissions are retained, unless they've since been added to the configuration
+		merged, additional := mergeKeyVaultAccessPolicyPermissions(expandKeyVaultAccessPolicyUnmanagedPermissions(unmanagedPermissions), accessPolicy.Permissions)
+		accessPolicy.Permissions = merged
+		unmanagedPermissions = additional
+	}
+
+	// when removing the Access Policy only the managed permissions are sent, such that the unmanaged permissions
+	// (and the Access Policy itself, if any remain) are left in place
 	accessPolicies := []keyvault.AccessPolicyEntry{accessPolicy}
 
 	parameters := keyvault.VaultAccessPolicyParameters{
@@ -116,10 +183,6 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 		},
 	}
 
-	// Locking to prevent parallel changes causing issues
-	azureRMLockByName(vaultName, keyVaultResourceName)
-	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
-
 	_, err = client.UpdateAccessPolicy(ctx, resGroup, vaultName, action, parameters)
 	if err != nil {
 		return fmt.Errorf("Error updating Access Policy (Object ID %q / Application ID %q) for Key Vault %q (Resource Group %q): %+v", objectId, applicationIdRaw, vaultName, resGroup, err)
@@ -145,6 +208,12 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 		d.SetId(resourceId)
 	}
 
+	if action != keyvault.Remove {
+		if err := d.Set("unmanaged_permissions", unmanagedPermissions); err != nil {
+			return fmt.Errorf("Error setting `unmanaged_permissions`: %+v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -208,7 +277,18 @@ func resourceArmKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{
 		d.Set("application_id", aid.String())
 	}
 
+	unmanagedPermissions := make([]string, 0)
+	for _, v := range d.Get("unmanaged_permissions").([]interface{}) {
+		unmanagedPermissions = append(unmanagedPermissions, v.(string))
+	}
+
 	if permissions := policy.Permissions; permissions != nil {
+		// the unmanaged permissions are excluded, and any which have since been removed are no longer tracked
+		permissions, unmanagedPermissions = filterKeyVaultAccessPolicyPermissions(permissions, unmanagedPermissions)
+		if err := d.Set("unmanaged_permissions", unmanagedPermissions); err != nil {
+			return fmt.Errorf("Error setting `unmanaged_permissions`: %+v", err)
+		}
+
 		certificatePermissions := azure.FlattenCertificatePermissions(permissions.Certificates)
 		if err := d.Set("certificate_permissions", certificatePermissions); err != nil {
 			return fmt.Errorf("Error flattening `certificate_permissions`: %+v", err)
@@ -223,6 +303,11 @@ func resourceArmKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{
 		if err := d.Set("secret_permissions", secretPermissions); err != nil {
 			return fmt.Errorf("Error flattening `secret_permissions`: %+v", err)
 		}
+
+		storagePermissions := azure.FlattenStoragePermissions(permissions.Storage)
+		if err := d.Set("storage_permissions", storagePermissions); err != nil {
+			return fmt.Errorf("Error flattening `storage_permissions`: %+v", err)
+		}
 	}
 
 	return nil
@@ -250,3 +335,105 @@ func findKeyVaultAccessPolicy(policies *[]keyvault.AccessPolicyEntry, objectId s
 
 	return nil, nil
 }
+
+// mergeKeyVaultAccessPolicyPermissions returns the union of the existing and configured permissions, alongside
+// a description of each permission which is only present in the existing permissions (e.g. `keys/Delete`)
+func mergeKeyVaultAccessPolicyPermissions(existing *keyvault.Permissions, configured *keyvault.Permissions) (*keyvault.Permissions, []string) {
+	if existing == nil {
+		return configured, []string{}
+	}
+
+	additional := make([]string, 0)
+	merge := func(kind string, existing []interface{}, configured []interface{}) []interface{} {
+		output := configured
+		for _, e := range existing {
+			found := false
+			for _, c := range configured {
+				if strings.EqualFold(e.(string), c.(string)) {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				output = append(output, e)
+				additional = append(additional, fmt.Sprintf("%s/%s", kind, e.(string)))
+			}
+		}
+		return output
+	}
+
+	merged := keyvault.Permissions{
+		Certificates: azure.ExpandCertificatePermissions(merge("certificates", azure.FlattenCertificatePermissions(existing.Certificates), azure.FlattenCertificatePermissions(configured.Certificates))),
+		Keys:         azure.ExpandKeyPermissions(merge("keys", azure.FlattenKeyPermissions(existing.Keys), azure.FlattenKeyPermissions(configured.Keys))),
+		Secrets:      azure.ExpandSecretPermissions(merge("secrets", azure.FlattenSecretPermissions(existing.Secrets), azure.FlattenSecretPermissions(configured.Secrets))),
+		Storage:      azure.ExpandStoragePermissions(merge("storage", azure.FlattenStoragePermissions(existing.Storage), azure.FlattenStoragePermissions(configured.Storage))),
+	}
+
+	return &merged, additional
+}
+
+// expandKeyVaultAccessPolicyUnmanagedPermissions converts the descriptions of the unmanaged permissions
+// (e.g. `keys/delete`) into Permissions
+func expandKeyVaultAccessPolicyUnmanagedPermissions(input []string) *keyvault.Permissions {
+	permissions := map[string][]interface{}{
+		"certificates": make([]interface{}, 0),
+		"keys":         make([]interface{}, 0),
+		"secrets":      make([]interface{}, 0),
+		"storage":      make([]interface{}, 0),
+	}
+
+	for _, v := range input {
+		segments := strings.SplitN(v, "/", 2)
+		if len(segments) != 2 {
+			continue
+		}
+
+		if existing, ok := permissions[segments[0]]; ok {
+			permissions[segments[0]] = append(existing, segments[1])
+		}
+	}
+
+	return &keyvault.Permissions{
+		Certificates: azure.ExpandCertificatePermissions(permissions["certificates"]),
+		Keys:         azure.ExpandKeyPermissions(permissions["keys"]),
+		Secrets:      azure.ExpandSecretPermissions(permissions["secrets"]),
+		Storage:      azure.ExpandStoragePermissions(permissions["storage"]),
+	}
+}
+
+// filterKeyVaultAccessPolicyPermissions returns the permissions excluding the unmanaged permissions (e.g. `keys/delete`),
+// alongside the unmanaged permissions which are still present
+func filterKeyVaultAccessPolicyPermissions(input *keyvault.Permissions, unmanaged []string) (*keyvault.Permissions, []string) {
+	present := make([]string, 0)
+	filter := func(kind string, permissions []interface{}) []interface{} {
+		output := make([]interface{}, 0)
+		for _, p := range permissions {
+			description := fmt.Sprintf("%s/%s", kind, p.(string))
+
+			found := false
+			for _, u := range unmanaged {
+				if strings.EqualFold(description, u) {
+					found = true
+					break
+				}
+			}
+
+			if found {
+				present = append(present, description)
+			} else {
+				output = append(output, p)
+			}
+		}
+		return output
+	}
+
+	filtered := keyvault.Permissions{
+		Certificates: azure.ExpandCertificatePermissions(filter("certificates", azure.FlattenCertificatePermissions(input.Certificates))),
+		Keys:         azure.ExpandKeyPermissions(filter("keys", azure.FlattenKeyPermissions(input.Keys))),
+		Secrets:      azure.ExpandSecretPermissions(filter("secrets", azure.FlattenSecretPermissions(input.Secrets))),
+		Storage:      azure.ExpandStoragePermissions(filter("storage", azure.FlattenStoragePermissions(input.Storage))),
+	}
+
+	return &filtered, present
+}
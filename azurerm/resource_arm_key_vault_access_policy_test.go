@@ -2,8 +2,11 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -100,6 +103,110 @@ func TestAccAzureRMKeyVaultAccessPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVaultAccessPolicy_requiresImport(t *testing.T) {
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultAccessPolicy_requiresImport(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("needs to be imported into the State"),
+			},
+		},
+	})
+}
+
+func TestMergeKeyVaultAccessPolicyPermissions(t *testing.T) {
+	existing := &keyvault.Permissions{
+		Keys:    &[]keyvault.KeyPermissions{keyvault.KeyPermissionsGet, keyvault.KeyPermissionsDelete},
+		Secrets: &[]keyvault.SecretPermissions{keyvault.SecretPermissionsGet},
+	}
+	configured := &keyvault.Permissions{
+		Certificates: &[]keyvault.CertificatePermissions{},
+		Keys:         &[]keyvault.KeyPermissions{"Get", keyvault.KeyPermissionsList},
+		Secrets:      &[]keyvault.SecretPermissions{keyvault.SecretPermissionsGet},
+		Storage:      &[]keyvault.StoragePermissions{},
+	}
+
+	merged, additional := mergeKeyVaultAccessPolicyPermissions(existing, configured)
+
+	expectedKeys := []keyvault.KeyPermissions{"Get", keyvault.KeyPermissionsList, keyvault.KeyPermissionsDelete}
+	if !reflect.DeepEqual(*merged.Keys, expectedKeys) {
+		t.Fatalf("Expected the Key Permissions to be %+v but got %+v", expectedKeys, *merged.Keys)
+	}
+
+	expectedSecrets := []keyvault.SecretPermissions{keyvault.SecretPermissionsGet}
+	if !reflect.DeepEqual(*merged.Secrets, expectedSecrets) {
+		t.Fatalf("Expected the Secret Permissions to be %+v but got %+v", expectedSecrets, *merged.Secrets)
+	}
+
+	if len(*merged.Certificates) != 0 || len(*merged.Storage) != 0 {
+		t.Fatalf("Expected no Certificate or Storage Permissions but got %+v / %+v", *merged.Certificates, *merged.Storage)
+	}
+
+	expectedAdditional := []string{"keys/delete"}
+	if !reflect.DeepEqual(additional, expectedAdditional) {
+		t.Fatalf("Expected the additional permissions to be %+v but got %+v", expectedAdditional, additional)
+	}
+}
+
+func TestFilterKeyVaultAccessPolicyPermissions(t *testing.T) {
+	input := &keyvault.Permissions{
+		Keys:    &[]keyvault.KeyPermissions{"Get", keyvault.KeyPermissionsList, keyvault.KeyPermissionsDelete},
+		Secrets: &[]keyvault.SecretPermissions{keyvault.SecretPermissionsGet},
+	}
+
+	// `secrets/set` has been removed outside of Terraform, so is no longer present
+	filtered, present := filterKeyVaultAccessPolicyPermissions(input, []string{"keys/delete", "secrets/set"})
+
+	expectedKeys := []keyvault.KeyPermissions{"Get", keyvault.KeyPermissionsList}
+	if !reflect.DeepEqual(*filtered.Keys, expectedKeys) {
+		t.Fatalf("Expected the Key Permissions to be %+v but got %+v", expectedKeys, *filtered.Keys)
+	}
+
+	expectedSecrets := []keyvault.SecretPermissions{keyvault.SecretPermissionsGet}
+	if !reflect.DeepEqual(*filtered.Secrets, expectedSecrets) {
+		t.Fatalf("Expected the Secret Permissions to be %+v but got %+v", expectedSecrets, *filtered.Secrets)
+	}
+
+	expectedPresent := []string{"keys/delete"}
+	if !reflect.DeepEqual(present, expectedPresent) {
+		t.Fatalf("Expected the unmanaged permissions which are present to be %+v but got %+v", expectedPresent, present)
+	}
+}
+
+func TestExpandKeyVaultAccessPolicyUnmanagedPermissions(t *testing.T) {
+	unmanaged := []string{"keys/delete", "secrets/set", "storage/get", "invalid"}
+	configured := &keyvault.Permissions{
+		Keys: &[]keyvault.KeyPermissions{keyvault.KeyPermissionsGet},
+	}
+
+	// the unmanaged permissions are retained on update, unless they've since been added to the configuration
+	merged, additional := mergeKeyVaultAccessPolicyPermissions(expandKeyVaultAccessPolicyUnmanagedPermissions(unmanaged), &keyvault.Permissions{
+		Keys:    configured.Keys,
+		Secrets: &[]keyvault.SecretPermissions{keyvault.SecretPermissionsSet},
+	})
+
+	expectedKeys := []keyvault.KeyPermissions{keyvault.KeyPermissionsGet, keyvault.KeyPermissionsDelete}
+	if !reflect.DeepEqual(*merged.Keys, expectedKeys) {
+		t.Fatalf("Expected the Key Permissions to be %+v but got %+v", expectedKeys, *merged.Keys)
+	}
+
+	expectedStorage := []keyvault.StoragePermissions{keyvault.StoragePermissionsGet}
+	if !reflect.DeepEqual(*merged.Storage, expectedStorage) {
+		t.Fatalf("Expected the Storage Permissions to be %+v but got %+v", expectedStorage, *merged.Storage)
+	}
+
+	expectedAdditional := []string{"keys/delete", "storage/get"}
+	if !reflect.DeepEqual(additional, expectedAdditional) {
+		t.Fatalf("Expected the unmanaged permissions to be %+v but got %+v", expectedAdditional, additional)
+	}
+}
+
 func testCheckAzureRMKeyVaultAccessPolicyExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -235,6 +342,57 @@ resource "azurerm_key_vault_access_policy" "test" {
 `, template)
 }
 
+func testAccAzureRMKeyVaultAccessPolicy_requiresImport(rString string, location string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      require_import_of_existing_access_policies = true
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "get",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_access_policy" "test" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  key_permissions = [
+    "list",
+  ]
+
+  tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+  object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+}
+`, rString, location, rString)
+}
+
 func testAccAzureRMKeyVaultAccessPolicy_template(rString string, location string) string {
 	return fmt.Sprintf(`
 data "azurerm_client_config" "current" {}
@@ -258,7 +258,7 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 			Base64EncodedCertificate: utils.String(certificate.CertificateData),
 			Password:                 utils.String(certificate.CertificatePassword),
 			CertificatePolicy:        &policy,
-			Tags:                     expandTags(tags),
+			Tags:                     expandTags(tags, meta),
 		}
 		_, err := client.ImportCertificate(ctx, keyVaultBaseUrl, name, importParameters)
 		if err != nil {
@@ -268,7 +268,7 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 		// Generate new
 		parameters := keyvault.CertificateCreateParameters{
 			CertificatePolicy: &policy,
-			Tags:              expandTags(tags),
+			Tags:              expandTags(tags, meta),
 		}
 		_, err := client.CreateCertificate(ctx, keyVaultBaseUrl, name, parameters)
 		if err != nil {
@@ -349,7 +349,7 @@ func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}
 	if contents := cert.Cer; contents != nil {
 		d.Set("certificate_data", string(*contents))
 	}
-	flattenAndSetTags(d, cert.Tags)
+	flattenAndSetTags(d, cert.Tags, meta)
 
 	return nil
 }
@@ -0,0 +1,289 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultCertificateIssuer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultCertificateIssuerCreateUpdate,
+		Read:   resourceArmKeyVaultCertificateIssuerRead,
+		Update: resourceArmKeyVaultCertificateIssuerCreateUpdate,
+		Delete: resourceArmKeyVaultCertificateIssuerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultChildName,
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"DigiCert",
+					"GlobalSign",
+					"OneCertV2-PrivateCA",
+					"OneCertV2-PublicCA",
+					"SslAdminV2",
+				}, false),
+			},
+
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"org_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"admin": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"first_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"last_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"phone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmKeyVaultCertificateIssuerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Certificate Issuer creation.")
+
+	name := d.Get("name").(string)
+	keyVaultBaseUrl := d.Get("vault_uri").(string)
+
+	parameters := keyvault.CertificateIssuerSetParameters{
+		Provider: utils.String(d.Get("provider_name").(string)),
+		OrganizationDetails: &keyvault.OrganizationDetails{
+			AdminDetails: expandKeyVaultCertificateIssuerAdmins(d.Get("admin").([]interface{})),
+		},
+	}
+
+	if orgId := d.Get("org_id").(string); orgId != "" {
+		parameters.OrganizationDetails.ID = utils.String(orgId)
+	}
+
+	accountId := d.Get("account_id").(string)
+	password := d.Get("password").(string)
+	if accountId != "" || password != "" {
+		parameters.Credentials = &keyvault.IssuerCredentials{
+			AccountID: utils.String(accountId),
+			Password:  utils.String(password),
+		}
+	}
+
+	if _, err := client.SetCertificateIssuer(ctx, keyVaultBaseUrl, name, parameters); err != nil {
+		return fmt.Errorf("Error setting Certificate Issuer %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+	}
+
+	if d.IsNewResource() {
+		read, err := client.GetCertificateIssuer(ctx, keyVaultBaseUrl, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Certificate Issuer %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+		}
+
+		if read.ID == nil {
+			return fmt.Errorf("Cannot read ID for Certificate Issuer %q (Key Vault %q)", name, keyVaultBaseUrl)
+		}
+
+		d.SetId(*read.ID)
+	}
+
+	return resourceArmKeyVaultCertificateIssuerRead(d, meta)
+}
+
+func resourceArmKeyVaultCertificateIssuerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseKeyVaultCertificateIssuerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetCertificateIssuer(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Certificate Issuer %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Certificate Issuer %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("vault_uri", id.KeyVaultBaseUrl)
+	d.Set("provider_name", resp.Provider)
+
+	// the password isn't returned by the API, so it's intentionally not set
+	if credentials := resp.Credentials; credentials != nil {
+		d.Set("account_id", credentials.AccountID)
+	}
+
+	if org := resp.OrganizationDetails; org != nil {
+		d.Set("org_id", org.ID)
+
+		if err := d.Set("admin", flattenKeyVaultCertificateIssuerAdmins(org.AdminDetails)); err != nil {
+			return fmt.Errorf("Error flattening `admin`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmKeyVaultCertificateIssuerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseKeyVaultCertificateIssuerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteCertificateIssuer(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Certificate Issuer %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+type KeyVaultCertificateIssuerID struct {
+	KeyVaultBaseUrl string
+	Name            string
+}
+
+func parseKeyVaultCertificateIssuerID(id string) (*KeyVaultCertificateIssuerID, error) {
+	// example: https://example-keyvault.vault.azure.net/certificates/issuers/example
+	idURL, err := url.ParseRequestURI(id)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Azure KeyVault Certificate Issuer Id: %s", err)
+	}
+
+	components := strings.Split(strings.Trim(strings.TrimSpace(idURL.Path), "/"), "/")
+	if len(components) != 3 || components[0] != "certificates" || components[1] != "issuers" {
+		return nil, fmt.Errorf("Azure KeyVault Certificate Issuer Id should be in the format `{vaultUri}/certificates/issuers/{name}` but got %q", id)
+	}
+
+	return &KeyVaultCertificateIssuerID{
+		KeyVaultBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		Name:            components[2],
+	}, nil
+}
+
+func expandKeyVaultCertificateIssuerAdmins(input []interface{}) *[]keyvault.AdministratorDetails {
+	results := make([]keyvault.AdministratorDetails, 0)
+
+	for _, v := range input {
+		admin := v.(map[string]interface{})
+
+		result := keyvault.AdministratorDetails{
+			EmailAddress: utils.String(admin["email_address"].(string)),
+		}
+
+		if firstName := admin["first_name"].(string); firstName != "" {
+			result.FirstName = utils.String(firstName)
+		}
+
+		if lastName := admin["last_name"].(string); lastName != "" {
+			result.LastName = utils.String(lastName)
+		}
+
+		if phone := admin["phone"].(string); phone != "" {
+			result.Phone = utils.String(phone)
+		}
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func flattenKeyVaultCertificateIssuerAdmins(input *[]keyvault.AdministratorDetails) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, admin := range *input {
+		result := make(map[string]interface{})
+
+		if v := admin.EmailAddress; v != nil {
+			result["email_address"] = *v
+		}
+
+		if v := admin.FirstName; v != nil {
+			result["first_name"] = *v
+		}
+
+		if v := admin.LastName; v != nil {
+			result["last_name"] = *v
+		}
+
+		if v := admin.Phone; v != nil {
+			result["phone"] = *v
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,241 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAzureRMKeyVaultCertificateIssuer_parseID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    KeyVaultCertificateIssuerID
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/certificates/issuers",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/certificates/hello/world",
+			ExpectError: true,
+		},
+		{
+			Input: "https://my-keyvault.vault.azure.net/certificates/issuers/hello",
+			Expected: KeyVaultCertificateIssuerID{
+				KeyVaultBaseUrl: "https://my-keyvault.vault.azure.net/",
+				Name:            "hello",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := parseKeyVaultCertificateIssuerID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID '%s' but didn't get one", tc.Input)
+		}
+
+		if *id != tc.Expected {
+			t.Fatalf("Expected %+v for ID '%s' but got %+v", tc.Expected, tc.Input, *id)
+		}
+	}
+}
+
+func TestAccAzureRMKeyVaultCertificateIssuer_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_certificate_issuer.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultCertificateIssuer_basic(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultCertificateIssuerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateIssuerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "DigiCert"),
+					resource.TestCheckResourceAttr(resourceName, "admin.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultCertificateIssuer_complete(t *testing.T) {
+	resourceName := "azurerm_key_vault_certificate_issuer.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultCertificateIssuerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultCertificateIssuer_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateIssuerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVaultCertificateIssuer_complete(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateIssuerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "org_id", "accTestOrg"),
+					resource.TestCheckResourceAttr(resourceName, "admin.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "admin.0.email_address", "admin@contoso.com"),
+					resource.TestCheckResourceAttr(resourceName, "admin.0.first_name", "First"),
+					resource.TestCheckResourceAttr(resourceName, "admin.0.last_name", "Last"),
+					resource.TestCheckResourceAttr(resourceName, "admin.0.phone", "01234567890"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultCertificateIssuerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault_certificate_issuer" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		if _, err := client.GetCertificateIssuer(ctx, vaultBaseUrl, name); err != nil {
+			// either this or the Key Vault itself (along with the Resource Group) has been removed
+			return nil
+		}
+
+		return fmt.Errorf("Certificate Issuer %q still exists in Key Vault %q", name, vaultBaseUrl)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMKeyVaultCertificateIssuerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		name := rs.Primary.Attributes["name"]
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetCertificateIssuer(ctx, vaultBaseUrl, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Certificate Issuer %q (Key Vault %q) does not exist", name, vaultBaseUrl)
+			}
+
+			return fmt.Errorf("Bad: Get on keyVaultManagementClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMKeyVaultCertificateIssuer_template(rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    certificate_permissions = [
+      "deleteissuers",
+      "getissuers",
+      "setissuers",
+    ]
+
+    key_permissions = [
+      "get",
+    ]
+
+    secret_permissions = [
+      "get",
+    ]
+  }
+}
+`, rString, location, rString)
+}
+
+func testAccAzureRMKeyVaultCertificateIssuer_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultCertificateIssuer_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_issuer" "test" {
+  name          = "acctestci-%s"
+  vault_uri     = "${azurerm_key_vault.test.vault_uri}"
+  provider_name = "DigiCert"
+  account_id    = "test-account"
+  password      = "test-password"
+}
+`, template, rString)
+}
+
+func testAccAzureRMKeyVaultCertificateIssuer_complete(rString string, location string) string {
+	template := testAccAzureRMKeyVaultCertificateIssuer_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_issuer" "test" {
+  name          = "acctestci-%s"
+  vault_uri     = "${azurerm_key_vault.test.vault_uri}"
+  provider_name = "DigiCert"
+  account_id    = "test-account"
+  password      = "test-password"
+  org_id        = "accTestOrg"
+
+  admin {
+    email_address = "admin@contoso.com"
+    first_name    = "First"
+    last_name     = "Last"
+    phone         = "01234567890"
+  }
+}
+`, template, rString)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -44,15 +45,31 @@ func resourceArmKeyVaultKey() *schema.Resource {
 					// TODO: add `oct` back in once this is fixed
 					// https://github.com/Azure/azure-rest-api-specs/issues/1739#issuecomment-332236257
 					string(keyvault.EC),
+					string(keyvault.ECHSM),
 					string(keyvault.RSA),
 					string(keyvault.RSAHSM),
 				}, false),
 			},
 
+			// required for RSA keys
 			"key_size": {
 				Type:     schema.TypeInt,
-				Required: true,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// only applicable to EC keys, where it defaults to `P-256`
+			"curve": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(keyvault.P256),
+					string(keyvault.P384),
+					string(keyvault.P521),
+					string(keyvault.SECP256K1),
+				}, false),
 			},
 
 			"key_opts": {
@@ -73,6 +90,40 @@ func resourceArmKeyVaultKey() *schema.Resource {
 				},
 			},
 
+			// requires a `release_policy` and an HSM-backed `key_type`
+			"exportable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"release_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.ValidateJsonString,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+						},
+
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  keyVaultKeyReleasePolicyDefaultContentType,
+						},
+
+						"immutable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
 			// Computed
 			"version": {
 				Type:     schema.TypeString,
@@ -89,8 +140,50 @@ func resourceArmKeyVaultKey() *schema.Resource {
 				Computed: true,
 			},
 
+			"x": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"y": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tagsSchema(),
 		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			keyType := diff.Get("key_type").(string)
+			isRSA := keyType == string(keyvault.RSA) || keyType == string(keyvault.RSAHSM)
+
+			if _, ok := diff.GetOk("key_size"); !ok && isRSA {
+				return fmt.Errorf("`key_size` must be specified when `key_type` is %q", keyType)
+			}
+
+			if _, ok := diff.GetOk("curve"); ok && isRSA {
+				return fmt.Errorf("`curve` can only be specified when `key_type` is %q or %q", string(keyvault.EC), string(keyvault.ECHSM))
+			}
+
+			if diff.Get("exportable").(bool) {
+				if _, ok := diff.GetOk("release_policy"); !ok {
+					return fmt.Errorf("a `release_policy` must be specified when `exportable` is `true`")
+				}
+
+				if keyType != string(keyvault.ECHSM) && keyType != string(keyvault.RSAHSM) {
+					return fmt.Errorf("`exportable` can only be `true` when `key_type` is %q or %q", string(keyvault.ECHSM), string(keyvault.RSAHSM))
+				}
+			}
+
+			// a Release Policy can be updated but not removed
+			if old, new := diff.GetChange("release_policy"); len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0 {
+				if err := diff.ForceNew("release_policy"); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
 	}
 }
 
@@ -114,13 +207,36 @@ func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) erro
 		KeyAttributes: &keyvault.KeyAttributes{
 			Enabled: utils.Bool(true),
 		},
-		KeySize: utils.Int32(int32(d.Get("key_size").(int))),
-		Tags:    expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
-	_, err := client.CreateKey(ctx, keyVaultBaseUrl, name, parameters)
-	if err != nil {
-		return fmt.Errorf("Error Creating Key: %+v", err)
+	if v, ok := d.GetOk("key_size"); ok {
+		parameters.KeySize = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("curve"); ok {
+		parameters.Curve = keyvault.JSONWebKeyCurveName(v.(string))
+	}
+
+	exportable := d.Get("exportable").(bool)
+	releasePolicy := expandKeyVaultKeyReleasePolicy(d.Get("release_policy").([]interface{}))
+	if exportable || releasePolicy != nil {
+		// Exportable Keys and Release Policies aren't available in the version of the Key Vault SDK used by this Provider
+		dataPlaneClient := meta.(*ArmClient).keyVaultDataPlaneClient
+		dataPlaneClient.BaseURI = keyVaultBaseUrl
+
+		body, err := expandKeyVaultKeyWithReleasePolicy(parameters, utils.Bool(exportable), releasePolicy)
+		if err != nil {
+			return err
+		}
+
+		if _, err := dataPlaneClient.Post(ctx, fmt.Sprintf("keys/%s", name), "create", body, nil); err != nil {
+			return fmt.Errorf("Error Creating Key: %+v", err)
+		}
+	} else {
+		if _, err := client.CreateKey(ctx, keyVaultBaseUrl, name, parameters); err != nil {
+			return fmt.Errorf("Error Creating Key: %+v", err)
+		}
 	}
 
 	// "" indicates the latest version
@@ -152,12 +268,27 @@ func resourceArmKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) erro
 		KeyAttributes: &keyvault.KeyAttributes{
 			Enabled: utils.Bool(true),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
-	_, err = client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, id.Version, parameters)
-	if err != nil {
-		return err
+	if d.HasChange("release_policy") {
+		// Release Policies aren't available in the version of the Key Vault SDK used by this Provider
+		dataPlaneClient := meta.(*ArmClient).keyVaultDataPlaneClient
+		dataPlaneClient.BaseURI = id.KeyVaultBaseUrl
+
+		releasePolicy := expandKeyVaultKeyReleasePolicy(d.Get("release_policy").([]interface{}))
+		body, err := expandKeyVaultKeyWithReleasePolicy(parameters, nil, releasePolicy)
+		if err != nil {
+			return err
+		}
+
+		if _, err := dataPlaneClient.Update(ctx, fmt.Sprintf("keys/%s/%s", id.Name, id.Version), body); err != nil {
+			return fmt.Errorf("Error updating Key %q (Key Vault URI %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	} else {
+		if _, err := client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, id.Version, parameters); err != nil {
+			return err
+		}
 	}
 
 	return resourceArmKeyVaultKeyRead(d, meta)
@@ -195,12 +326,38 @@ func resourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error
 
 		d.Set("n", key.N)
 		d.Set("e", key.E)
+		d.Set("x", key.X)
+		d.Set("y", key.Y)
+		d.Set("curve", string(key.Crv))
+	}
+
+	// Exportable Keys and Release Policies aren't returned in the version of the API used by the Key Vault SDK
+	dataPlaneClient := meta.(*ArmClient).keyVaultDataPlaneClient
+	dataPlaneClient.BaseURI = id.KeyVaultBaseUrl
+
+	var bundle keyVaultKeyBundle
+	if _, err := dataPlaneClient.Get(ctx, fmt.Sprintf("keys/%s/", id.Name), &bundle); err != nil {
+		return fmt.Errorf("Error retrieving Release Policy for Key %q (Key Vault URI %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	exportable := false
+	if attributes := bundle.Attributes; attributes != nil && attributes.Exportable != nil {
+		exportable = *attributes.Exportable
+	}
+	d.Set("exportable", exportable)
+
+	releasePolicy, err := flattenKeyVaultKeyReleasePolicy(bundle.ReleasePolicy)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("release_policy", releasePolicy); err != nil {
+		return fmt.Errorf("Error setting `release_policy`: %+v", err)
 	}
 
 	// Computed
 	d.Set("version", id.Version)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -239,3 +396,46 @@ func flattenKeyVaultKeyOptions(input *[]string) []interface{} {
 
 	return results
 }
+
+func expandKeyVaultKeyReleasePolicy(input []interface{}) *keyVaultKeyReleasePolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &keyVaultKeyReleasePolicy{
+		ContentType:   utils.String(v["content_type"].(string)),
+		Immutable:     utils.Bool(v["immutable"].(bool)),
+		EncodedPolicy: utils.String(encodeKeyVaultKeyReleasePolicy(v["policy"].(string))),
+	}
+}
+
+func flattenKeyVaultKeyReleasePolicy(input *keyVaultKeyReleasePolicy) ([]interface{}, error) {
+	if input == nil || input.EncodedPolicy == nil {
+		return []interface{}{}, nil
+	}
+
+	policy, err := decodeKeyVaultKeyReleasePolicy(*input.EncodedPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := keyVaultKeyReleasePolicyDefaultContentType
+	if input.ContentType != nil {
+		contentType = *input.ContentType
+	}
+
+	immutable := false
+	if input.Immutable != nil {
+		immutable = *input.Immutable
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"policy":       policy,
+			"content_type": contentType,
+			"immutable":    immutable,
+		},
+	}, nil
+}
@@ -0,0 +1,304 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultKeyRotationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultKeyRotationPolicyCreateUpdate,
+		Read:   resourceArmKeyVaultKeyRotationPolicyRead,
+		Update: resourceArmKeyVaultKeyRotationPolicyCreateUpdate,
+		Delete: resourceArmKeyVaultKeyRotationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultKeyRotationPolicyKeyID,
+				// the version of the Key changes each time it's rotated
+				DiffSuppressFunc: suppressKeyVaultKeyVersionDiff,
+			},
+
+			"expire_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ISO8601Duration,
+			},
+
+			"notify_before_expiry": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ISO8601Duration,
+			},
+
+			"automatic": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time_after_creation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+
+						"time_before_expiry": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			_, hasExpiry := diff.GetOk("expire_after")
+
+			if _, ok := diff.GetOk("notify_before_expiry"); ok && !hasExpiry {
+				return fmt.Errorf("`expire_after` must be specified when `notify_before_expiry` is specified")
+			}
+
+			if automatic := diff.Get("automatic").([]interface{}); len(automatic) > 0 && automatic[0] != nil {
+				v := automatic[0].(map[string]interface{})
+				timeAfterCreation := v["time_after_creation"].(string)
+				timeBeforeExpiry := v["time_before_expiry"].(string)
+
+				if (timeAfterCreation == "") == (timeBeforeExpiry == "") {
+					return fmt.Errorf("exactly one of `time_after_creation` or `time_before_expiry` must be specified within the `automatic` block")
+				}
+
+				if timeBeforeExpiry != "" && !hasExpiry {
+					return fmt.Errorf("`expire_after` must be specified when `time_before_expiry` is specified")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmKeyVaultKeyRotationPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultDataPlaneClient
+	ctx := meta.(*ArmClient).StopContext
+
+	keyId, err := parseKeyVaultChildID(d.Get("key_vault_key_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// Key Rotation Policies aren't available in the version of the Key Vault SDK used by this Provider
+	client.BaseURI = keyId.KeyVaultBaseUrl
+	policyId := fmt.Sprintf("keys/%s/rotationpolicy", keyId.Name)
+
+	// a Key always has a Rotation Policy (which notifies prior to expiry by default), so this is always an update
+	policy := expandKeyVaultKeyRotationPolicy(d)
+	if _, err := client.CreateOrUpdate(ctx, policyId, policy); err != nil {
+		return fmt.Errorf("Error updating Rotation Policy for Key %q (Key Vault URI %q): %+v", keyId.Name, keyId.KeyVaultBaseUrl, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s%s", keyId.KeyVaultBaseUrl, policyId))
+
+	return resourceArmKeyVaultKeyRotationPolicyRead(d, meta)
+}
+
+func resourceArmKeyVaultKeyRotationPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultDataPlaneClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseKeyVaultKeyRotationPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client.BaseURI = id.KeyVaultBaseUrl
+
+	// the latest version of the Key is retrieved, since the Rotation Policy applies to all versions of the Key
+	var key keyVaultKeyBundle
+	resp, err := client.Get(ctx, fmt.Sprintf("keys/%s/", id.KeyName), &key)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Key %q was not found in Key Vault at URI %q - removing Rotation Policy from state", id.KeyName, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key %q (Key Vault URI %q): %+v", id.KeyName, id.KeyVaultBaseUrl, err)
+	}
+
+	var policy keyVaultKeyRotationPolicy
+	if _, err := client.Get(ctx, fmt.Sprintf("keys/%s/rotationpolicy", id.KeyName), &policy); err != nil {
+		return fmt.Errorf("Error retrieving Rotation Policy for Key %q (Key Vault URI %q): %+v", id.KeyName, id.KeyVaultBaseUrl, err)
+	}
+
+	if key.Key != nil {
+		d.Set("key_vault_key_id", key.Key.Kid)
+	}
+
+	expireAfter := ""
+	if attributes := policy.Attributes; attributes != nil && attributes.ExpiryTime != nil {
+		expireAfter = *attributes.ExpiryTime
+	}
+	d.Set("expire_after", expireAfter)
+
+	notifyBeforeExpiry, automatic := flattenKeyVaultKeyRotationPolicyLifetimeActions(policy.LifetimeActions)
+	d.Set("notify_before_expiry", notifyBeforeExpiry)
+	if err := d.Set("automatic", automatic); err != nil {
+		return fmt.Errorf("Error setting `automatic`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmKeyVaultKeyRotationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultDataPlaneClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseKeyVaultKeyRotationPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client.BaseURI = id.KeyVaultBaseUrl
+
+	resp, err := client.Get(ctx, fmt.Sprintf("keys/%s/", id.KeyName), &keyVaultKeyBundle{})
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key %q (Key Vault URI %q): %+v", id.KeyName, id.KeyVaultBaseUrl, err)
+	}
+
+	// a Rotation Policy can't be deleted, instead it's reset so that the Key is neither rotated nor expires
+	policy := keyVaultKeyRotationPolicy{
+		LifetimeActions: &[]keyVaultKeyRotationPolicyLifetimeAction{},
+	}
+	if _, err := client.CreateOrUpdate(ctx, fmt.Sprintf("keys/%s/rotationpolicy", id.KeyName), policy); err != nil {
+		return fmt.Errorf("Error resetting Rotation Policy for Key %q (Key Vault URI %q): %+v", id.KeyName, id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+func expandKeyVaultKeyRotationPolicy(d *schema.ResourceData) keyVaultKeyRotationPolicy {
+	actions := make([]keyVaultKeyRotationPolicyLifetimeAction, 0)
+
+	if v := d.Get("automatic").([]interface{}); len(v) > 0 && v[0] != nil {
+		automatic := v[0].(map[string]interface{})
+		trigger := keyVaultKeyRotationPolicyTrigger{}
+		if timeAfterCreation := automatic["time_after_creation"].(string); timeAfterCreation != "" {
+			trigger.TimeAfterCreate = utils.String(timeAfterCreation)
+		}
+		if timeBeforeExpiry := automatic["time_before_expiry"].(string); timeBeforeExpiry != "" {
+			trigger.TimeBeforeExpiry = utils.String(timeBeforeExpiry)
+		}
+
+		actions = append(actions, keyVaultKeyRotationPolicyLifetimeAction{
+			Trigger: &trigger,
+			Action: &keyVaultKeyRotationPolicyAction{
+				Type: keyVaultKeyRotationPolicyActionRotate,
+			},
+		})
+	}
+
+	if v := d.Get("notify_before_expiry").(string); v != "" {
+		actions = append(actions, keyVaultKeyRotationPolicyLifetimeAction{
+			Trigger: &keyVaultKeyRotationPolicyTrigger{
+				TimeBeforeExpiry: utils.String(v),
+			},
+			Action: &keyVaultKeyRotationPolicyAction{
+				Type: keyVaultKeyRotationPolicyActionNotify,
+			},
+		})
+	}
+
+	policy := keyVaultKeyRotationPolicy{
+		LifetimeActions: &actions,
+	}
+
+	if v := d.Get("expire_after").(string); v != "" {
+		policy.Attributes = &keyVaultKeyRotationPolicyAttributes{
+			ExpiryTime: utils.String(v),
+		}
+	}
+
+	return policy
+}
+
+func flattenKeyVaultKeyRotationPolicyLifetimeActions(input *[]keyVaultKeyRotationPolicyLifetimeAction) (string, []interface{}) {
+	notifyBeforeExpiry := ""
+	automatic := make([]interface{}, 0)
+	if input == nil {
+		return notifyBeforeExpiry, automatic
+	}
+
+	for _, action := range *input {
+		if action.Action == nil || action.Trigger == nil {
+			continue
+		}
+
+		timeAfterCreation := ""
+		if action.Trigger.TimeAfterCreate != nil {
+			timeAfterCreation = *action.Trigger.TimeAfterCreate
+		}
+
+		timeBeforeExpiry := ""
+		if action.Trigger.TimeBeforeExpiry != nil {
+			timeBeforeExpiry = *action.Trigger.TimeBeforeExpiry
+		}
+
+		// the casing of the action type isn't consistent in the API responses
+		switch {
+		case strings.EqualFold(action.Action.Type, keyVaultKeyRotationPolicyActionRotate):
+			automatic = append(automatic, map[string]interface{}{
+				"time_after_creation": timeAfterCreation,
+				"time_before_expiry":  timeBeforeExpiry,
+			})
+		case strings.EqualFold(action.Action.Type, keyVaultKeyRotationPolicyActionNotify):
+			notifyBeforeExpiry = timeBeforeExpiry
+		}
+	}
+
+	return notifyBeforeExpiry, automatic
+}
+
+func validateKeyVaultKeyRotationPolicyKeyID(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := parseKeyVaultChildID(v); err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be the ID of a Key Vault Key: %+v", k, err))
+	}
+
+	return
+}
+
+func suppressKeyVaultKeyVersionDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldId, err := parseKeyVaultChildID(old)
+	if err != nil {
+		return false
+	}
+
+	newId, err := parseKeyVaultChildID(new)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(oldId.KeyVaultBaseUrl, newId.KeyVaultBaseUrl) && oldId.Name == newId.Name
+}
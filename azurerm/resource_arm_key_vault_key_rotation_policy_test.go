@@ -0,0 +1,229 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKeyVaultKeyRotationPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_key_rotation_policy.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultKeyRotationPolicy_basic(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyRotationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyRotationPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "automatic.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "automatic.0.time_after_creation", "P30D"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultKeyRotationPolicy_update(t *testing.T) {
+	resourceName := "azurerm_key_vault_key_rotation_policy.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyRotationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultKeyRotationPolicy_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyRotationPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "expire_after", ""),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVaultKeyRotationPolicy_complete(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyRotationPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "expire_after", "P90D"),
+					resource.TestCheckResourceAttr(resourceName, "notify_before_expiry", "P29D"),
+					resource.TestCheckResourceAttr(resourceName, "automatic.0.time_after_creation", ""),
+					resource.TestCheckResourceAttr(resourceName, "automatic.0.time_before_expiry", "P30D"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultKeyRotationPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultDataPlaneClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault_key_rotation_policy" {
+			continue
+		}
+
+		id, err := parseKeyVaultKeyRotationPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client.BaseURI = id.KeyVaultBaseUrl
+
+		// the Key (and the Key Vault) may have been deleted alongside the Rotation Policy
+		var policy keyVaultKeyRotationPolicy
+		resp, err := client.Get(ctx, fmt.Sprintf("keys/%s/rotationpolicy", id.KeyName), &policy)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) || resp.Response == nil {
+				continue
+			}
+			return err
+		}
+
+		if policy.LifetimeActions == nil {
+			continue
+		}
+		for _, action := range *policy.LifetimeActions {
+			if action.Action != nil && strings.EqualFold(action.Action.Type, keyVaultKeyRotationPolicyActionRotate) {
+				return fmt.Errorf("Rotation Policy for Key %q (Key Vault URI %q) still rotates the Key", id.KeyName, id.KeyVaultBaseUrl)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMKeyVaultKeyRotationPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseKeyVaultKeyRotationPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultDataPlaneClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client.BaseURI = id.KeyVaultBaseUrl
+
+		var policy keyVaultKeyRotationPolicy
+		if _, err := client.Get(ctx, fmt.Sprintf("keys/%s/rotationpolicy", id.KeyName), &policy); err != nil {
+			return fmt.Errorf("Bad: Get Rotation Policy for Key %q (Key Vault URI %q): %+v", id.KeyName, id.KeyVaultBaseUrl, err)
+		}
+
+		if policy.LifetimeActions == nil || len(*policy.LifetimeActions) == 0 {
+			return fmt.Errorf("Bad: Rotation Policy for Key %q (Key Vault URI %q) has no Lifetime Actions", id.KeyName, id.KeyVaultBaseUrl)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMKeyVaultKeyRotationPolicy_template(rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+      "delete",
+      "get",
+      "getrotationpolicy",
+      "setrotationpolicy",
+    ]
+
+    secret_permissions = [
+      "get",
+      "delete",
+      "set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name      = "key-%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+  key_type  = "RSA"
+  key_size  = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+  ]
+}
+`, rString, location, rString, rString)
+}
+
+func testAccAzureRMKeyVaultKeyRotationPolicy_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultKeyRotationPolicy_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_key_rotation_policy" "test" {
+  key_vault_key_id = "${azurerm_key_vault_key.test.id}"
+
+  automatic {
+    time_after_creation = "P30D"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMKeyVaultKeyRotationPolicy_complete(rString string, location string) string {
+	template := testAccAzureRMKeyVaultKeyRotationPolicy_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_key_rotation_policy" "test" {
+  key_vault_key_id     = "${azurerm_key_vault_key.test.id}"
+  expire_after         = "P90D"
+  notify_before_expiry = "P29D"
+
+  automatic {
+    time_before_expiry = "P30D"
+  }
+}
+`, template)
+}
@@ -30,6 +30,30 @@ func TestAccAzureRMKeyVaultKey_basicEC(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVaultKey_curveECHSM(t *testing.T) {
+	resourceName := "azurerm_key_vault_key.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultKey_curveECHSM(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_type", "EC-HSM"),
+					resource.TestCheckResourceAttr(resourceName, "curve", "P-384"),
+					resource.TestCheckResourceAttrSet(resourceName, "x"),
+					resource.TestCheckResourceAttrSet(resourceName, "y"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKeyVaultKey_basicRSA(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
 	rs := acctest.RandString(6)
@@ -123,6 +147,42 @@ func TestAccAzureRMKeyVaultKey_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVaultKey_exportable(t *testing.T) {
+	resourceName := "azurerm_key_vault_key.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultKey_exportable(rs, location, "sevsnpvm"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exportable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "release_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "release_policy.0.immutable", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMKeyVaultKey_exportable(rs, location, "sgx"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exportable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "release_policy.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKeyVaultKey_disappears(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
 	rs := acctest.RandString(6)
@@ -301,6 +361,57 @@ resource "azurerm_key_vault_key" "test" {
 `, rString, location, rString, rString)
 }
 
+func testAccAzureRMKeyVaultKey_curveECHSM(rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+      "delete",
+      "get",
+    ]
+
+    secret_permissions = [
+      "get",
+      "delete",
+      "set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name      = "key-%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+  key_type  = "EC-HSM"
+  curve     = "P-384"
+
+  key_opts = [
+    "sign",
+    "verify",
+  ]
+}
+`, rString, location, rString, rString)
+}
+
 func testAccAzureRMKeyVaultKey_basicRSA(rString string, location string) string {
 	return fmt.Sprintf(`
 data "azurerm_client_config" "current" {}
@@ -541,3 +652,77 @@ resource "azurerm_key_vault_key" "test" {
 }
 `, rString, location, rString, rString)
 }
+
+func testAccAzureRMKeyVaultKey_exportable(rString string, location string, attestationType string) string {
+	// the `release` permission is used by the `azurerm_key_vault_key_release` Data Source
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+      "delete",
+      "get",
+      "release",
+      "update",
+    ]
+
+    secret_permissions = [
+      "get",
+      "delete",
+      "set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name       = "key-%s"
+  vault_uri  = "${azurerm_key_vault.test.vault_uri}"
+  key_type   = "RSA-HSM"
+  key_size   = 2048
+  exportable = true
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+  ]
+
+  release_policy {
+    policy = <<POLICY
+{
+  "version": "1.0.0",
+  "anyOf": [
+    {
+      "authority": "https://sharedeus.eus.attest.azure.net",
+      "allOf": [
+        {
+          "claim": "x-ms-isolation-tee.x-ms-attestation-type",
+          "equals": "%s"
+        }
+      ]
+    }
+  ]
+}
+POLICY
+  }
+}
+`, rString, location, rString, rString, attestationType)
+}
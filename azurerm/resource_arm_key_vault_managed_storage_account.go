@@ -0,0 +1,206 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultManagedStorageAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultManagedStorageAccountCreate,
+		Read:   resourceArmKeyVaultManagedStorageAccountRead,
+		Update: resourceArmKeyVaultManagedStorageAccountUpdate,
+		Delete: resourceArmKeyVaultManagedStorageAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultManagedStorageName,
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"active_key_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"key1",
+					"key2",
+				}, false),
+			},
+
+			"auto_regenerate_key": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"regeneration_period": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.ISO8601Duration,
+				ValidateFunc:     validate.ISO8601Duration,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmKeyVaultManagedStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Managed Storage Account creation.")
+
+	name := d.Get("name").(string)
+	keyVaultBaseUrl := d.Get("vault_uri").(string)
+	autoRegenerateKey := d.Get("auto_regenerate_key").(bool)
+	regenerationPeriod := d.Get("regeneration_period").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	if regenerationPeriod != "" && !autoRegenerateKey {
+		return fmt.Errorf("`regeneration_period` can only be specified when `auto_regenerate_key` is set to `true`")
+	}
+
+	parameters := keyvault.StorageAccountCreateParameters{
+		ResourceID:        utils.String(d.Get("storage_account_id").(string)),
+		ActiveKeyName:     utils.String(d.Get("active_key_name").(string)),
+		AutoRegenerateKey: utils.Bool(autoRegenerateKey),
+		Tags:              expandTags(tags, meta),
+	}
+
+	if regenerationPeriod != "" {
+		parameters.RegenerationPeriod = utils.String(regenerationPeriod)
+	}
+
+	if _, err := client.SetStorageAccount(ctx, keyVaultBaseUrl, name, parameters); err != nil {
+		return fmt.Errorf("Error creating Managed Storage Account %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+	}
+
+	read, err := client.GetStorageAccount(ctx, keyVaultBaseUrl, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Managed Storage Account %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Managed Storage Account %q (Key Vault %q)", name, keyVaultBaseUrl)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKeyVaultManagedStorageAccountRead(d, meta)
+}
+
+func resourceArmKeyVaultManagedStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Managed Storage Account update.")
+
+	id, err := parseKeyVaultManagedStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	autoRegenerateKey := d.Get("auto_regenerate_key").(bool)
+	regenerationPeriod := d.Get("regeneration_period").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	if regenerationPeriod != "" && !autoRegenerateKey {
+		return fmt.Errorf("`regeneration_period` can only be specified when `auto_regenerate_key` is set to `true`")
+	}
+
+	parameters := keyvault.StorageAccountUpdateParameters{
+		ActiveKeyName:     utils.String(d.Get("active_key_name").(string)),
+		AutoRegenerateKey: utils.Bool(autoRegenerateKey),
+		Tags:              expandTags(tags, meta),
+	}
+
+	if regenerationPeriod != "" {
+		parameters.RegenerationPeriod = utils.String(regenerationPeriod)
+	}
+
+	if _, err := client.UpdateStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+		return fmt.Errorf("Error updating Managed Storage Account %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return resourceArmKeyVaultManagedStorageAccountRead(d, meta)
+}
+
+func resourceArmKeyVaultManagedStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseKeyVaultManagedStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Managed Storage Account %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Managed Storage Account %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("vault_uri", id.KeyVaultBaseUrl)
+	d.Set("storage_account_id", resp.ResourceID)
+	d.Set("active_key_name", resp.ActiveKeyName)
+	d.Set("auto_regenerate_key", resp.AutoRegenerateKey)
+	d.Set("regeneration_period", resp.RegenerationPeriod)
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmKeyVaultManagedStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseKeyVaultManagedStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Managed Storage Account %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKeyVaultManagedStorageAccount_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_managed_storage_account.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultManagedStorageAccount_basic(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultManagedStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultManagedStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active_key_name", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_regenerate_key", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultManagedStorageAccount_update(t *testing.T) {
+	resourceName := "azurerm_key_vault_managed_storage_account.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultManagedStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultManagedStorageAccount_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultManagedStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active_key_name", "key1"),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVaultManagedStorageAccount_autoRegenerate(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultManagedStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active_key_name", "key2"),
+					resource.TestCheckResourceAttr(resourceName, "auto_regenerate_key", "true"),
+					resource.TestCheckResourceAttr(resourceName, "regeneration_period", "P30D"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultManagedStorageAccountDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault_managed_storage_account" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		if _, err := client.GetStorageAccount(ctx, vaultBaseUrl, name); err != nil {
+			// either this or the Key Vault itself (along with the Resource Group) has been removed
+			return nil
+		}
+
+		return fmt.Errorf("Managed Storage Account %q still exists in Key Vault %q", name, vaultBaseUrl)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMKeyVaultManagedStorageAccountExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		name := rs.Primary.Attributes["name"]
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetStorageAccount(ctx, vaultBaseUrl, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Managed Storage Account %q (Key Vault %q) does not exist", name, vaultBaseUrl)
+			}
+
+			return fmt.Errorf("Bad: Get on keyVaultManagementClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMKeyVaultManagedStorageAccount_template(rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+data "azurerm_azuread_service_principal" "key_vault" {
+  # this is the Application ID of the Azure Key Vault service, which is the same in every Tenant
+  application_id = "cfa8b339-82a2-471a-a3c9-0fc0be7a4093"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = "${azurerm_storage_account.test.id}"
+  role_definition_name = "Storage Account Key Operator Service Role"
+  principal_id         = "${data.azurerm_azuread_service_principal.key_vault.id}"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "get",
+    ]
+
+    secret_permissions = [
+      "get",
+    ]
+
+    storage_permissions = [
+      "delete",
+      "deletesas",
+      "get",
+      "getsas",
+      "list",
+      "listsas",
+      "set",
+      "setsas",
+      "update",
+    ]
+  }
+}
+`, rString, location, rString, rString)
+}
+
+func testAccAzureRMKeyVaultManagedStorageAccount_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultManagedStorageAccount_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_storage_account" "test" {
+  name               = "acctestmsa%s"
+  vault_uri          = "${azurerm_key_vault.test.vault_uri}"
+  storage_account_id = "${azurerm_storage_account.test.id}"
+  active_key_name    = "key1"
+
+  depends_on = ["azurerm_role_assignment.test"]
+}
+`, template, rString)
+}
+
+func testAccAzureRMKeyVaultManagedStorageAccount_autoRegenerate(rString string, location string) string {
+	template := testAccAzureRMKeyVaultManagedStorageAccount_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_storage_account" "test" {
+  name                = "acctestmsa%s"
+  vault_uri           = "${azurerm_key_vault.test.vault_uri}"
+  storage_account_id  = "${azurerm_storage_account.test.id}"
+  active_key_name     = "key2"
+  auto_regenerate_key = true
+  regeneration_period = "P30D"
+
+  depends_on = ["azurerm_role_assignment.test"]
+}
+`, template, rString)
+}
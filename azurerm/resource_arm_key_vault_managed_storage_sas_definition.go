@@ -0,0 +1,186 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultManagedStorageSasDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultManagedStorageSasDefinitionCreate,
+		Read:   resourceArmKeyVaultManagedStorageSasDefinitionRead,
+		Update: resourceArmKeyVaultManagedStorageSasDefinitionUpdate,
+		Delete: resourceArmKeyVaultManagedStorageSasDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultManagedStorageName,
+			},
+
+			"managed_storage_account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// the SAS Template, for example `sasType`, `signedServices`, `signedPermissions` and `validityPeriod`
+			"parameters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmKeyVaultManagedStorageSasDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Managed Storage SAS Definition creation.")
+
+	name := d.Get("name").(string)
+	storageAccountId, err := parseKeyVaultManagedStorageAccountID(d.Get("managed_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+	parameters := keyvault.SasDefinitionCreateParameters{
+		Parameters: expandKeyVaultManagedStorageSasDefinitionParameters(d.Get("parameters").(map[string]interface{})),
+		Tags:       expandTags(tags, meta),
+	}
+
+	if _, err := client.SetSasDefinition(ctx, storageAccountId.KeyVaultBaseUrl, storageAccountId.Name, name, parameters); err != nil {
+		return fmt.Errorf("Error creating SAS Definition %q (Managed Storage Account %q / Key Vault %q): %+v", name, storageAccountId.Name, storageAccountId.KeyVaultBaseUrl, err)
+	}
+
+	read, err := client.GetSasDefinition(ctx, storageAccountId.KeyVaultBaseUrl, storageAccountId.Name, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SAS Definition %q (Managed Storage Account %q / Key Vault %q): %+v", name, storageAccountId.Name, storageAccountId.KeyVaultBaseUrl, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for SAS Definition %q (Managed Storage Account %q / Key Vault %q)", name, storageAccountId.Name, storageAccountId.KeyVaultBaseUrl)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKeyVaultManagedStorageSasDefinitionRead(d, meta)
+}
+
+func resourceArmKeyVaultManagedStorageSasDefinitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Managed Storage SAS Definition update.")
+
+	id, err := parseKeyVaultManagedStorageAccountSasDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+	parameters := keyvault.SasDefinitionUpdateParameters{
+		Parameters: expandKeyVaultManagedStorageSasDefinitionParameters(d.Get("parameters").(map[string]interface{})),
+		Tags:       expandTags(tags, meta),
+	}
+
+	if _, err := client.UpdateSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name, parameters); err != nil {
+		return fmt.Errorf("Error updating SAS Definition %q (Managed Storage Account %q / Key Vault %q): %+v", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl, err)
+	}
+
+	return resourceArmKeyVaultManagedStorageSasDefinitionRead(d, meta)
+}
+
+func resourceArmKeyVaultManagedStorageSasDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseKeyVaultManagedStorageAccountSasDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] SAS Definition %q was not found in Managed Storage Account %q (Key Vault %q) - removing from state", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving SAS Definition %q (Managed Storage Account %q / Key Vault %q): %+v", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("managed_storage_account_id", fmt.Sprintf("%sstorage/%s", id.KeyVaultBaseUrl, id.StorageAccountName))
+	d.Set("secret_id", resp.SecretID)
+
+	if err := d.Set("parameters", flattenKeyVaultManagedStorageSasDefinitionParameters(resp.Parameters)); err != nil {
+		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmKeyVaultManagedStorageSasDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseKeyVaultManagedStorageAccountSasDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SAS Definition %q (Managed Storage Account %q / Key Vault %q): %+v", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+func expandKeyVaultManagedStorageSasDefinitionParameters(input map[string]interface{}) map[string]*string {
+	output := make(map[string]*string, len(input))
+
+	for k, v := range input {
+		output[k] = utils.String(v.(string))
+	}
+
+	return output
+}
+
+func flattenKeyVaultManagedStorageSasDefinitionParameters(input map[string]*string) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+
+	for k, v := range input {
+		if v != nil {
+			output[k] = *v
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,108 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKeyVaultManagedStorageSasDefinition_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_managed_storage_sas_definition.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultManagedStorageSasDefinition_basic(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultManagedStorageSasDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultManagedStorageSasDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.sasType", "account"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_id"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultManagedStorageSasDefinitionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault_managed_storage_sas_definition" {
+			continue
+		}
+
+		id, err := parseKeyVaultManagedStorageAccountSasDefinitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.GetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name); err != nil {
+			// either this or the Key Vault itself (along with the Resource Group) has been removed
+			return nil
+		}
+
+		return fmt.Errorf("SAS Definition %q still exists in Managed Storage Account %q (Key Vault %q)", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMKeyVaultManagedStorageSasDefinitionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := parseKeyVaultManagedStorageAccountSasDefinitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: SAS Definition %q (Managed Storage Account %q / Key Vault %q) does not exist", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl)
+			}
+
+			return fmt.Errorf("Bad: Get on keyVaultManagementClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMKeyVaultManagedStorageSasDefinition_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultManagedStorageAccount_basic(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_storage_sas_definition" "test" {
+  name                       = "acctestsas%s"
+  managed_storage_account_id = "${azurerm_key_vault_managed_storage_account.test.id}"
+
+  parameters {
+    sasType             = "account"
+    signedServices      = "b"
+    signedResourceTypes = "co"
+    signedPermissions   = "r"
+    signedVersion       = "2017-07-29"
+    validityPeriod      = "P1D"
+  }
+}
+`, template, rString)
+}
@@ -5,7 +5,7 @@ import (
 	"log"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2016-10-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -142,6 +142,9 @@ func migrateAzureRMKeyVaultStateV0toV1AccessPolicies(is *terraform.InstanceState
 			}
 		}
 
+		// Storage Permissions didn't exist in v0 - so there's nothing to migrate
+		delete(policy, "storage_permissions")
+
 		outputAccessPolicies = append(outputAccessPolicies, policy)
 	}
 
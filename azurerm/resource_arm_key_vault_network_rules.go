@@ -0,0 +1,165 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultNetworkRules() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"vault_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateKeyVaultName,
+		},
+
+		"resource_group_name": resourceGroupNameSchema(),
+	}
+
+	for k, v := range azure.SchemaKeyVaultNetworkAcls() {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		Create: resourceArmKeyVaultNetworkRulesCreateUpdate,
+		Read:   resourceArmKeyVaultNetworkRulesRead,
+		Update: resourceArmKeyVaultNetworkRulesCreateUpdate,
+		Delete: resourceArmKeyVaultNetworkRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceArmKeyVaultNetworkRulesCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Key Vault Network Rules creation/update.")
+
+	vaultName := d.Get("vault_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	networkAcls, err := azure.ExpandKeyVaultNetworkAcls(map[string]interface{}{
+		"default_action":             d.Get("default_action"),
+		"bypass":                     d.Get("bypass"),
+		"ip_rules":                   d.Get("ip_rules"),
+		"virtual_network_subnet_ids": d.Get("virtual_network_subnet_ids"),
+	})
+	if err != nil {
+		return fmt.Errorf("Error expanding Network Rules for Key Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+
+	// Locking to prevent parallel changes to the Key Vault causing issues
+	azureRMLockByName(vaultName, keyVaultResourceName)
+	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
+
+	read, err := resourceArmKeyVaultNetworkRulesUpdate(ctx, client, resourceGroup, vaultName, networkAcls)
+	if err != nil {
+		return err
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Key Vault %q (Resource Group %q)", vaultName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKeyVaultNetworkRulesRead(d, meta)
+}
+
+func resourceArmKeyVaultNetworkRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+
+	resp, err := client.Get(ctx, resourceGroup, vaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Key Vault %q was not found in Resource Group %q - removing from state!", vaultName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+
+	d.Set("vault_name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+
+	var networkAcls *keyvault.NetworkRuleSet
+	if props := resp.Properties; props != nil {
+		networkAcls = props.NetworkAcls
+	}
+
+	for k, v := range azure.FlattenKeyVaultNetworkAcls(networkAcls) {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("Error setting `%s`: %+v", k, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmKeyVaultNetworkRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+
+	azureRMLockByName(vaultName, keyVaultResourceName)
+	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
+
+	// there's no way to remove the Network Rules, so we reset them to the defaults - allowing all traffic
+	networkAcls := &keyvault.NetworkRuleSet{
+		DefaultAction:       keyvault.Allow,
+		Bypass:              keyvault.AzureServices,
+		IPRules:             &[]keyvault.IPRule{},
+		VirtualNetworkRules: &[]keyvault.VirtualNetworkRule{},
+	}
+
+	resp, err := resourceArmKeyVaultNetworkRulesUpdate(ctx, client, resourceGroup, vaultName, networkAcls)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func resourceArmKeyVaultNetworkRulesUpdate(ctx context.Context, client keyvault.VaultsClient, resourceGroup, vaultName string, networkAcls *keyvault.NetworkRuleSet) (keyvault.Vault, error) {
+	parameters := keyvault.VaultPatchParameters{
+		Properties: &keyvault.VaultPatchProperties{
+			NetworkAcls: networkAcls,
+		},
+	}
+
+	resp, err := client.Update(ctx, resourceGroup, vaultName, parameters)
+	if err != nil {
+		return resp, fmt.Errorf("Error updating Network Rules for Key Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,159 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMKeyVaultNetworkRules_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_network_rules.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMKeyVaultNetworkRules_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultNetworkRulesDefaultAction(resourceName, "Deny"),
+					resource.TestCheckResourceAttr(resourceName, "default_action", "Deny"),
+					resource.TestCheckResourceAttr(resourceName, "bypass", "AzureServices"),
+					resource.TestCheckResourceAttr(resourceName, "ip_rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultNetworkRules_update(t *testing.T) {
+	resourceName := "azurerm_key_vault_network_rules.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultNetworkRules_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ip_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "virtual_network_subnet_ids.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVaultNetworkRules_subnet(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bypass", "None"),
+					resource.TestCheckResourceAttr(resourceName, "ip_rules.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "virtual_network_subnet_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultNetworkRulesDefaultAction(resourceName, defaultAction string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		vaultName := rs.Primary.Attributes["vault_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, vaultName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on keyVaultClient: %+v", err)
+		}
+
+		if resp.Properties == nil || resp.Properties.NetworkAcls == nil {
+			return fmt.Errorf("Bad: Network Rules for Key Vault %q (Resource Group %q) were nil", vaultName, resourceGroup)
+		}
+
+		if actual := string(resp.Properties.NetworkAcls.DefaultAction); actual != defaultAction {
+			return fmt.Errorf("Bad: expected `default_action` to be %q but got %q", defaultAction, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMKeyVaultNetworkRules_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+  service_endpoints    = ["Microsoft.KeyVault"]
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMKeyVaultNetworkRules_basic(rInt int, location string) string {
+	template := testAccAzureRMKeyVaultNetworkRules_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_network_rules" "test" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  default_action      = "Deny"
+  bypass              = "AzureServices"
+  ip_rules            = ["123.0.0.102/32"]
+}
+`, template)
+}
+
+func testAccAzureRMKeyVaultNetworkRules_subnet(rInt int, location string) string {
+	template := testAccAzureRMKeyVaultNetworkRules_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_network_rules" "test" {
+  vault_name                 = "${azurerm_key_vault.test.name}"
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  default_action             = "Deny"
+  bypass                     = "None"
+  virtual_network_subnet_ids = ["${azurerm_subnet.test.id}"]
+}
+`, template)
+}
@@ -69,7 +69,7 @@ func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) e
 	parameters := keyvault.SecretSetParameters{
 		Value:       utils.String(value),
 		ContentType: utils.String(contentType),
-		Tags:        expandTags(tags),
+		Tags:        expandTags(tags, meta),
 	}
 
 	_, err := client.SetSecret(ctx, keyVaultBaseUrl, name, parameters)
@@ -110,7 +110,7 @@ func resourceArmKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) e
 		parameters := keyvault.SecretSetParameters{
 			Value:       utils.String(value),
 			ContentType: utils.String(contentType),
-			Tags:        expandTags(tags),
+			Tags:        expandTags(tags, meta),
 		}
 
 		_, err := client.SetSecret(ctx, id.KeyVaultBaseUrl, id.Name, parameters)
@@ -130,7 +130,7 @@ func resourceArmKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) e
 	} else {
 		parameters := keyvault.SecretUpdateParameters{
 			ContentType: utils.String(contentType),
-			Tags:        expandTags(tags),
+			Tags:        expandTags(tags, meta),
 		}
 
 		_, err = client.UpdateSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version, parameters)
@@ -174,7 +174,7 @@ func resourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("version", respID.Version)
 	d.Set("content_type", resp.ContentType)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 	return nil
 }
 
@@ -407,7 +407,7 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 			ServicePrincipalProfile: servicePrincipalProfile,
 			NetworkProfile:          networkProfile,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	ctx := client.StopContext
@@ -416,7 +416,9 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, kubernetesClustersClient.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.ContainerService", "managedClusters", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, kubernetesClustersClient.Client, expectedId)
 	if err != nil {
 		return err
 	}
@@ -507,7 +509,7 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error setting `kube_config`: %+v", err)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -529,7 +531,7 @@ func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error issuing AzureRM delete request of AKS Managed Cluster %q (resource Group %q): %+v", name, resGroup, err)
 	}
 
-	return future.WaitForCompletionRef(ctx, kubernetesClustersClient.Client)
+	return waitForCompletion(ctx, &future.Future, kubernetesClustersClient.Client)
 }
 
 func flattenAzureRmKubernetesClusterLinuxProfile(profile *containerservice.LinuxProfile) []interface{} {
@@ -0,0 +1,224 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKustoCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKustoClusterCreateUpdate,
+		Read:   resourceArmKustoClusterRead,
+		Update: resourceArmKustoClusterCreateUpdate,
+		Delete: resourceArmKustoClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMKustoClusterName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Dev(No SLA)_Standard_D11_v2",
+								"Standard_D11_v2",
+								"Standard_D12_v2",
+								"Standard_D13_v2",
+								"Standard_D14_v2",
+								"Standard_DS13_v2+1TB_PS",
+								"Standard_DS13_v2+2TB_PS",
+								"Standard_DS14_v2+3TB_PS",
+								"Standard_DS14_v2+4TB_PS",
+								"Standard_L4s",
+								"Standard_L8s",
+								"Standard_L16s",
+							}, false),
+						},
+
+						"capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+					},
+				},
+			},
+
+			"uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"data_ingestion_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmKustoClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kustoClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for Kusto Cluster creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	cluster := kustoCluster{
+		Location:   utils.String(location),
+		Sku:        expandKustoClusterSku(d.Get("sku").([]interface{})),
+		Properties: &kustoClusterProperties{},
+		Tags:       expandTags(tags, meta),
+	}
+
+	id := kustoClusterResourceID(subscriptionId, resourceGroup, name)
+	future, err := client.CreateOrUpdate(ctx, id, cluster)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Kusto Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Kusto Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmKustoClusterRead(d, meta)
+}
+
+func resourceArmKustoClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kustoClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["clusters"]
+
+	var cluster kustoCluster
+	resp, err := client.Get(ctx, d.Id(), &cluster)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Kusto Cluster %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Kusto Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := cluster.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if err := d.Set("sku", flattenKustoClusterSku(cluster.Sku)); err != nil {
+		return fmt.Errorf("Error setting `sku`: %+v", err)
+	}
+
+	if props := cluster.Properties; props != nil {
+		d.Set("uri", props.URI)
+		d.Set("data_ingestion_uri", props.DataIngestionURI)
+	}
+
+	flattenAndSetTags(d, cluster.Tags, meta)
+
+	return nil
+}
+
+func resourceArmKustoClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kustoClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["clusters"]
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Kusto Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Kusto Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandKustoClusterSku(input []interface{}) *kustoClusterSku {
+	v := input[0].(map[string]interface{})
+	name := v["name"].(string)
+
+	// the Dev SKU is the only SKU in the `Basic` tier
+	tier := "Standard"
+	if strings.HasPrefix(name, "Dev(No SLA)") {
+		tier = "Basic"
+	}
+
+	return &kustoClusterSku{
+		Name:     name,
+		Capacity: utils.Int32(int32(v["capacity"].(int))),
+		Tier:     tier,
+	}
+}
+
+func flattenKustoClusterSku(input *kustoClusterSku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	capacity := 0
+	if input.Capacity != nil {
+		capacity = int(*input.Capacity)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":     input.Name,
+			"capacity": capacity,
+		},
+	}
+}
@@ -0,0 +1,170 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKustoCluster_basic(t *testing.T) {
+	resourceName := "azurerm_kusto_cluster.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKustoCluster_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKustoClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKustoClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "Dev(No SLA)_Standard_D11_v2"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "uri"),
+					resource.TestCheckResourceAttrSet(resourceName, "data_ingestion_uri"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKustoCluster_update(t *testing.T) {
+	resourceName := "azurerm_kusto_cluster.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKustoClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKustoCluster_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKustoClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMKustoCluster_tags(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKustoClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKustoClusterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).kustoClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var cluster kustoCluster
+		resp, err := client.Get(ctx, rs.Primary.ID, &cluster)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Kusto Cluster %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on kustoClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKustoClusterDestroy(s *terraform.State) error {
+	return testCheckAzureRMKustoResourceDestroy(s, "azurerm_kusto_cluster")
+}
+
+// testCheckAzureRMKustoResourceDestroy confirms each Kusto resource of the given type has been removed
+func testCheckAzureRMKustoResourceDestroy(s *terraform.State, resourceType string) error {
+	client := testAccProvider.Meta().(*ArmClient).kustoClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		var result map[string]interface{}
+		resp, err := client.Get(ctx, rs.Primary.ID, &result)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("%s %q still exists", resourceType, rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMKustoCluster_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kusto_cluster" "test" {
+  name                = "acctestkc%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMKustoCluster_tags(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kusto_cluster" "test" {
+  name                = "acctestkc%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rString)
+}
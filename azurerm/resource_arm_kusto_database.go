@@ -0,0 +1,173 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKustoDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKustoDatabaseCreateUpdate,
+		Read:   resourceArmKustoDatabaseRead,
+		Update: resourceArmKustoDatabaseCreateUpdate,
+		Delete: resourceArmKustoDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMKustoEntityName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMKustoClusterName,
+			},
+
+			"soft_delete_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ISO8601Duration,
+			},
+
+			"hot_cache_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ISO8601Duration,
+			},
+
+			"size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmKustoDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kustoClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for Kusto Database creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	clusterName := d.Get("cluster_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	database := kustoDatabase{
+		Location:   utils.String(location),
+		Properties: &kustoDatabaseProperties{},
+	}
+
+	if v, ok := d.GetOk("soft_delete_period"); ok {
+		database.Properties.SoftDeletePeriod = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hot_cache_period"); ok {
+		database.Properties.HotCachePeriod = utils.String(v.(string))
+	}
+
+	id := kustoDatabaseResourceID(subscriptionId, resourceGroup, clusterName, name)
+	future, err := client.CreateOrUpdate(ctx, id, database)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Kusto Database %q (Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Kusto Database %q (Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmKustoDatabaseRead(d, meta)
+}
+
+func resourceArmKustoDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kustoClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	clusterName := id.Path["clusters"]
+	name := id.Path["databases"]
+
+	var database kustoDatabase
+	resp, err := client.Get(ctx, d.Id(), &database)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Kusto Database %q was not found in Cluster %q / Resource Group %q - removing from state!", name, clusterName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Kusto Database %q (Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("cluster_name", clusterName)
+	if location := database.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := database.Properties; props != nil {
+		d.Set("soft_delete_period", props.SoftDeletePeriod)
+		d.Set("hot_cache_period", props.HotCachePeriod)
+
+		if statistics := props.Statistics; statistics != nil {
+			d.Set("size", statistics.Size)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmKustoDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kustoClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	clusterName := id.Path["clusters"]
+	name := id.Path["databases"]
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Kusto Database %q (Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Kusto Database %q (Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKustoDatabase_basic(t *testing.T) {
+	resourceName := "azurerm_kusto_database.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKustoDatabase_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKustoDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKustoDatabaseExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKustoDatabase_periods(t *testing.T) {
+	resourceName := "azurerm_kusto_database.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKustoDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKustoDatabase_periods(ri, rs, location, "P7D", "P7D"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKustoDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_period", "P7D"),
+					resource.TestCheckResourceAttr(resourceName, "hot_cache_period", "P7D"),
+				),
+			},
+			{
+				Config: testAccAzureRMKustoDatabase_periods(ri, rs, location, "P31D", "P14D"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKustoDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_period", "P31D"),
+					resource.TestCheckResourceAttr(resourceName, "hot_cache_period", "P14D"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKustoDatabaseExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).kustoClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var database kustoDatabase
+		resp, err := client.Get(ctx, rs.Primary.ID, &database)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Kusto Database %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on kustoClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKustoDatabaseDestroy(s *terraform.State) error {
+	return testCheckAzureRMKustoResourceDestroy(s, "azurerm_kusto_database")
+}
+
+func testAccAzureRMKustoDatabase_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMKustoCluster_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_database" "test" {
+  name                = "acctestkd-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  cluster_name        = "${azurerm_kusto_cluster.test.name}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMKustoDatabase_periods(rInt int, rString string, location string, softDeletePeriod string, hotCachePeriod string) string {
+	template := testAccAzureRMKustoCluster_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_database" "test" {
+  name                = "acctestkd-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  cluster_name        = "${azurerm_kusto_cluster.test.name}"
+  soft_delete_period  = "%s"
+  hot_cache_period    = "%s"
+}
+`, template, rInt, softDeletePeriod, hotCachePeriod)
+}
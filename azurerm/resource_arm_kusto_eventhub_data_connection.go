@@ -0,0 +1,219 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKustoEventHubDataConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKustoEventHubDataConnectionCreateUpdate,
+		Read:   resourceArmKustoEventHubDataConnectionRead,
+		Update: resourceArmKustoEventHubDataConnectionCreateUpdate,
+		Delete: resourceArmKustoEventHubDataConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMKustoEntityName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMKustoClusterName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMKustoEntityName,
+			},
+
+			"eventhub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"consumer_group": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"table_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAzureRMKustoEntityName,
+			},
+
+			"mapping_rule_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAzureRMKustoEntityName,
+			},
+
+			"data_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AVRO",
+					"CSV",
+					"JSON",
+					"MULTIJSON",
+					"PSV",
+					"RAW",
+					"SCSV",
+					"SINGLEJSON",
+					"SOHSV",
+					"TSV",
+					"TXT",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceArmKustoEventHubDataConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kustoClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for Kusto Event Hub Data Connection creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	clusterName := d.Get("cluster_name").(string)
+	databaseName := d.Get("database_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	connection := kustoEventHubDataConnection{
+		Location: utils.String(location),
+		Kind:     "EventHub",
+		Properties: &kustoEventHubDataConnectionProperties{
+			EventHubResourceID: utils.String(d.Get("eventhub_id").(string)),
+			ConsumerGroup:      utils.String(d.Get("consumer_group").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("table_name"); ok {
+		connection.Properties.TableName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("mapping_rule_name"); ok {
+		connection.Properties.MappingRuleName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("data_format"); ok {
+		connection.Properties.DataFormat = utils.String(v.(string))
+	}
+
+	id := kustoDataConnectionResourceID(subscriptionId, resourceGroup, clusterName, databaseName, name)
+	future, err := client.CreateOrUpdate(ctx, id, connection)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Kusto Event Hub Data Connection %q (Database %q / Cluster %q / Resource Group %q): %+v", name, databaseName, clusterName, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Kusto Event Hub Data Connection %q (Database %q / Cluster %q / Resource Group %q): %+v", name, databaseName, clusterName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmKustoEventHubDataConnectionRead(d, meta)
+}
+
+func resourceArmKustoEventHubDataConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kustoClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	clusterName := id.Path["clusters"]
+	databaseName := id.Path["databases"]
+	name := id.Path["dataConnections"]
+
+	var connection kustoEventHubDataConnection
+	resp, err := client.Get(ctx, d.Id(), &connection)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Kusto Event Hub Data Connection %q was not found in Database %q / Cluster %q / Resource Group %q - removing from state!", name, databaseName, clusterName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Kusto Event Hub Data Connection %q (Database %q / Cluster %q / Resource Group %q): %+v", name, databaseName, clusterName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("cluster_name", clusterName)
+	d.Set("database_name", databaseName)
+	if location := connection.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := connection.Properties; props != nil {
+		d.Set("eventhub_id", props.EventHubResourceID)
+		d.Set("consumer_group", props.ConsumerGroup)
+		d.Set("table_name", props.TableName)
+		d.Set("mapping_rule_name", props.MappingRuleName)
+		d.Set("data_format", props.DataFormat)
+	}
+
+	return nil
+}
+
+func resourceArmKustoEventHubDataConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kustoClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	clusterName := id.Path["clusters"]
+	databaseName := id.Path["databases"]
+	name := id.Path["dataConnections"]
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Kusto Event Hub Data Connection %q (Database %q / Cluster %q / Resource Group %q): %+v", name, databaseName, clusterName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Kusto Event Hub Data Connection %q (Database %q / Cluster %q / Resource Group %q): %+v", name, databaseName, clusterName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
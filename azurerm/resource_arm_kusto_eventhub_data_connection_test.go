@@ -0,0 +1,108 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKustoEventHubDataConnection_basic(t *testing.T) {
+	resourceName := "azurerm_kusto_eventhub_data_connection.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKustoEventHubDataConnection_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKustoEventHubDataConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKustoEventHubDataConnectionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "eventhub_id"),
+					resource.TestCheckResourceAttr(resourceName, "data_format", "JSON"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKustoEventHubDataConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).kustoClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var connection kustoEventHubDataConnection
+		resp, err := client.Get(ctx, rs.Primary.ID, &connection)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Kusto Event Hub Data Connection %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on kustoClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKustoEventHubDataConnectionDestroy(s *terraform.State) error {
+	return testCheckAzureRMKustoResourceDestroy(s, "azurerm_kusto_eventhub_data_connection")
+}
+
+func testAccAzureRMKustoEventHubDataConnection_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMKustoDatabase_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  partition_count     = 1
+  message_retention   = 1
+}
+
+resource "azurerm_eventhub_consumer_group" "test" {
+  name                = "acctesteventhubcg-%d"
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  eventhub_name       = "${azurerm_eventhub.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_kusto_eventhub_data_connection" "test" {
+  name                = "acctestkedc-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  cluster_name        = "${azurerm_kusto_cluster.test.name}"
+  database_name       = "${azurerm_kusto_database.test.name}"
+  eventhub_id         = "${azurerm_eventhub.test.id}"
+  consumer_group      = "${azurerm_eventhub_consumer_group.test.name}"
+  data_format         = "JSON"
+}
+`, template, rInt, rInt, rInt, rInt)
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
@@ -149,19 +150,48 @@ func resourceArmLoadBalancerCreate(d *schema.ResourceData, meta interface{}) err
 		Name: network.LoadBalancerSkuName(d.Get("sku").(string)),
 	}
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTags(tags, meta)
+
+	if hasOnlyTagChanges(d, "azurerm_lb", resourceArmLoadBalancer) {
+		log.Printf("[DEBUG] Only `tags` have changed - updating the Tags for Load Balancer %q (Resource Group %q)", name, resGroup)
+		future, err := client.UpdateTags(ctx, resGroup, name, network.TagsObject{
+			Tags: expandedTags,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating Tags for Load Balancer %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Tags for Load Balancer %q (Resource Group %q) to be updated: %+v", name, resGroup, err)
+		}
+
+		meta.(*ArmClient).parentResourceCache.Invalidate(d.Id())
+
+		return resourceArmLoadBalancerRead(d, meta)
+	}
 
 	properties := network.LoadBalancerPropertiesFormat{}
 
 	if _, ok := d.GetOk("frontend_ip_configuration"); ok {
 		properties.FrontendIPConfigurations = expandAzureRmLoadBalancerFrontendIpConfigurations(d)
+
+		for _, config := range *properties.FrontendIPConfigurations {
+			ipv6, err := loadBalancerFrontendIPConfigurationIsIPv6(meta, config)
+			if err != nil {
+				return fmt.Errorf("Error determining the IP Version of Frontend IP Configuration %q: %+v", *config.Name, err)
+			}
+
+			if ipv6 && strings.EqualFold(string(sku.Name), string(network.LoadBalancerSkuNameStandard)) {
+				return fmt.Errorf("IPv6 Frontend IP Configurations (such as %q) are only supported on a `Basic` SKU Load Balancer", *config.Name)
+			}
+		}
 	}
 
 	loadBalancer := network.LoadBalancer{
-		Name:     utils.String(name),
-		Location: utils.String(location),
-		Tags:     expandedTags,
-		Sku:      &sku,
+		Name:                         utils.String(name),
+		Location:                     utils.String(location),
+		Tags:                         expandedTags,
+		Sku:                          &sku,
 		LoadBalancerPropertiesFormat: &properties,
 	}
 
@@ -170,7 +200,9 @@ func resourceArmLoadBalancerCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error Creating/Updating Load Balancer %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Network", "loadBalancers", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error Creating/Updating Load Balancer %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -197,6 +229,8 @@ func resourceArmLoadBalancerCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error waiting for Load Balancer (%q - Resource Group %q) to become available: %s", name, resGroup, err)
 	}
 
+	meta.(*ArmClient).parentResourceCache.Invalidate(d.Id())
+
 	return resourceArmLoadBalancerRead(d, meta)
 }
 
@@ -206,7 +240,7 @@ func resourceArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(d.Id(), meta)
+	loadBalancer, exists, err := retrieveCachedLoadBalancerById(d.Id(), meta)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Load Balancer by ID %q: %+v", d.Id(), err)
 	}
@@ -249,7 +283,7 @@ func resourceArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	flattenAndSetTags(d, loadBalancer.Tags)
+	flattenAndSetTags(d, loadBalancer.Tags, meta)
 
 	return nil
 }
@@ -270,11 +304,13 @@ func resourceArmLoadBalancerDelete(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error deleting Load Balancer %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for the deleting Load Balancer %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
+	meta.(*ArmClient).parentResourceCache.Invalidate(d.Id())
+
 	return nil
 }
 
@@ -309,9 +345,9 @@ func expandAzureRmLoadBalancerFrontendIpConfigurations(d *schema.ResourceData) *
 		name := data["name"].(string)
 		zones := expandZones(data["zones"].([]interface{}))
 		frontEndConfig := network.FrontendIPConfiguration{
-			Name: &name,
+			Name:                                    &name,
 			FrontendIPConfigurationPropertiesFormat: &properties,
-			Zones: zones,
+			Zones:                                   zones,
 		}
 
 		frontEndConfigs = append(frontEndConfigs, frontEndConfig)
@@ -3,13 +3,10 @@ package azurerm
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -42,7 +39,7 @@ func resourceArmLoadBalancerNatRule() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validate.ResourceID,
 			},
 
 			"protocol": {
@@ -95,87 +92,49 @@ func resourceArmLoadBalancerNatRule() *schema.Resource {
 }
 
 func resourceArmLoadBalancerNatRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).loadBalancerClient
-	ctx := meta.(*ArmClient).StopContext
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] Load Balancer %q not found. Removing from state", d.Get("name").(string))
-		return nil
-	}
-
-	newNatRule, err := expandAzureRmLoadBalancerNatRule(d, loadBalancer)
-	if err != nil {
-		return fmt.Errorf("Error Expanding NAT Rule: %+v", err)
-	}
-
-	natRules := append(*loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules, *newNatRule)
-
-	existingNatRule, existingNatRuleIndex, exists := findLoadBalancerNatRuleByName(loadBalancer, d.Get("name").(string))
-	if exists {
-		if d.Get("name").(string) == *existingNatRule.Name {
-			// this nat rule is being updated/reapplied remove old copy from the slice
-			natRules = append(natRules[:existingNatRuleIndex], natRules[existingNatRuleIndex+1:]...)
+	read, exists, err := updateLoadBalancer(meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		newNatRule, err := expandAzureRmLoadBalancerNatRule(d, loadBalancer)
+		if err != nil {
+			return fmt.Errorf("Error Expanding NAT Rule: %+v", err)
 		}
-	}
 
-	loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &natRules
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer Name and Group: %+v", err)
-	}
-
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
-	if err != nil {
-		return fmt.Errorf("Error Creating / Updating Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
+		natRules := append(*loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules, *newNatRule)
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for completion of Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
+		existingNatRule, existingNatRuleIndex, exists := findLoadBalancerNatRuleByName(loadBalancer, name)
+		if exists {
+			if name == *existingNatRule.Name {
+				// this nat rule is being updated/reapplied remove old copy from the slice
+				natRules = append(natRules[:existingNatRuleIndex], natRules[existingNatRuleIndex+1:]...)
+			}
+		}
 
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+		loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &natRules
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error retrieving Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+		return err
 	}
-
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read Load Balancer %q (Resource Group %q) ID", loadBalancerName, resGroup)
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] Load Balancer %q not found. Removing from state", name)
+		return nil
 	}
 
 	var natRuleId string
-	for _, InboundNatRule := range *(*read.LoadBalancerPropertiesFormat).InboundNatRules {
-		if *InboundNatRule.Name == d.Get("name").(string) {
-			natRuleId = *InboundNatRule.ID
+	for _, v := range *read.LoadBalancerPropertiesFormat.InboundNatRules {
+		if v.Name != nil && *v.Name == name && v.ID != nil {
+			natRuleId = *v.ID
 		}
 	}
 
-	if natRuleId != "" {
-		d.SetId(natRuleId)
-	} else {
-		return fmt.Errorf("Cannot find created Load Balancer NAT Rule ID %q", natRuleId)
+	if natRuleId == "" {
+		return fmt.Errorf("Cannot find created Load Balancer NAT Rule ID %q", name)
 	}
 
-	// TODO: is this still needed?
-	log.Printf("[DEBUG] Waiting for Load Balancer (%s) to become available", loadBalancerName)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"Accepted", "Updating"},
-		Target:  []string{"Succeeded"},
-		Refresh: loadbalancerStateRefreshFunc(ctx, client, resGroup, loadBalancerName),
-		Timeout: 10 * time.Minute,
-	}
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Load Balancer (%s) to become available: %s", loadBalancerName, err)
-	}
+	d.SetId(natRuleId)
 
 	return resourceArmLoadBalancerNatRuleRead(d, meta)
 }
@@ -187,7 +146,7 @@ func resourceArmLoadBalancerNatRuleRead(d *schema.ResourceData, meta interface{}
 	}
 	name := id.Path["inboundNatRules"]
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	loadBalancer, exists, err := retrieveCachedLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
 	if err != nil {
 		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
 	}
@@ -232,52 +191,25 @@ func resourceArmLoadBalancerNatRuleRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceArmLoadBalancerNatRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).loadBalancerClient
-	ctx := meta.(*ArmClient).StopContext
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
-	}
-	if !exists {
-		d.SetId("")
-		return nil
-	}
+	_, exists, err := updateLoadBalancer(meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		_, index, exists := findLoadBalancerNatRuleByName(loadBalancer, name)
+		if !exists {
+			return nil
+		}
 
-	_, index, exists := findLoadBalancerNatRuleByName(loadBalancer, d.Get("name").(string))
-	if !exists {
+		oldNatRules := *loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules
+		newNatRules := append(oldNatRules[:index], oldNatRules[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &newNatRules
 		return nil
-	}
-
-	oldNatRules := *loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules
-	newNatRules := append(oldNatRules[:index], oldNatRules[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &newNatRules
-
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer Name and Group: %+v", err)
-	}
-
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
-	if err != nil {
-		return fmt.Errorf("Error Creating/Updating Load Balancer %q (Resource Group %q) %+v", loadBalancerName, resGroup, err)
-	}
-
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for the completion of Load Balancer updates for %q (Resource Group %q) %+v", loadBalancerName, resGroup, err)
-	}
-
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+	})
 	if err != nil {
-		return fmt.Errorf("Error retrieving Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+		return err
 	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read Load Balancer %q (resource group %q) ID", loadBalancerName, resGroup)
+	if !exists {
+		d.SetId("")
 	}
 
 	return nil
@@ -307,7 +239,7 @@ func expandAzureRmLoadBalancerNatRule(d *schema.ResourceData, lb *network.LoadBa
 	}
 
 	natRule := network.InboundNatRule{
-		Name: utils.String(d.Get("name").(string)),
+		Name:                           utils.String(d.Get("name").(string)),
 		InboundNatRulePropertiesFormat: &properties,
 	}
 
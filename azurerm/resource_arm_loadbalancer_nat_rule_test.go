@@ -217,6 +217,35 @@ func TestAccAzureRMLoadBalancerNatRule_disableFloatingIP(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMLoadBalancerNatRule_many(t *testing.T) {
+	var lb network.LoadBalancer
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLoadBalancerNatRule_many(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
+					testCheckAzureRMLoadBalancerNatRuleExists("NatRule-0", &lb),
+					testCheckAzureRMLoadBalancerNatRuleExists("NatRule-9", &lb),
+				),
+			},
+			{
+				Config: testAccAzureRMLoadBalancerNatRule_removal(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
+					testCheckAzureRMLoadBalancerNatRuleNotExists("NatRule-0", &lb),
+					testCheckAzureRMLoadBalancerNatRuleNotExists("NatRule-9", &lb),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMLoadBalancerNatRuleExists(natRuleName string, lb *network.LoadBalancer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, _, exists := findLoadBalancerNatRuleByName(lb, natRuleName)
@@ -473,3 +502,41 @@ resource "azurerm_lb_nat_rule" "test" {
 }
 `, rInt, location, rInt, rInt, rInt, natRuleName, rInt)
 }
+
+func testAccAzureRMLoadBalancerNatRule_many(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "test-ip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "arm-test-loadbalancer-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  frontend_ip_configuration {
+    name                 = "one-%d"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+
+resource "azurerm_lb_nat_rule" "test" {
+  count                          = 10
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  loadbalancer_id                = "${azurerm_lb.test.id}"
+  name                           = "NatRule-${count.index}"
+  protocol                       = "Tcp"
+  frontend_port                  = "${3389 + count.index}"
+  backend_port                   = 3389
+  frontend_ip_configuration_name = "one-%d"
+}
+`, rInt, location, rInt, rInt, rInt, rInt)
+}
@@ -3,10 +3,8 @@ package azurerm
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -95,81 +93,45 @@ func resourceArmLoadBalancerProbe() *schema.Resource {
 }
 
 func resourceArmLoadBalancerProbeCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).loadBalancerClient
-	ctx := meta.(*ArmClient).StopContext
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
-
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] Load Balancer %q not found. Removing from state", d.Get("name").(string))
-		return nil
-	}
+	name := d.Get("name").(string)
 
-	newProbe := expandAzureRmLoadBalancerProbe(d)
-	probes := append(*loadBalancer.LoadBalancerPropertiesFormat.Probes, *newProbe)
+	read, exists, err := updateLoadBalancer(meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		newProbe := expandAzureRmLoadBalancerProbe(d)
+		probes := append(*loadBalancer.LoadBalancerPropertiesFormat.Probes, *newProbe)
 
-	existingProbe, existingProbeIndex, exists := findLoadBalancerProbeByName(loadBalancer, d.Get("name").(string))
-	if exists {
-		if d.Get("name").(string) == *existingProbe.Name {
-			// this probe is being updated/reapplied remove old copy from the slice
-			probes = append(probes[:existingProbeIndex], probes[existingProbeIndex+1:]...)
+		existingProbe, existingProbeIndex, exists := findLoadBalancerProbeByName(loadBalancer, name)
+		if exists {
+			if name == *existingProbe.Name {
+				// this probe is being updated/reapplied remove old copy from the slice
+				probes = append(probes[:existingProbeIndex], probes[existingProbeIndex+1:]...)
+			}
 		}
-	}
-
-	loadBalancer.LoadBalancerPropertiesFormat.Probes = &probes
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer Name and Group: %+v", err)
-	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
-	if err != nil {
-		return fmt.Errorf("Error Creating/Updating Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
-
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for completion of Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
-
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+		loadBalancer.LoadBalancerPropertiesFormat.Probes = &probes
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error retrieving Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+		return err
 	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read Load Balancer %q (resource group %q) ID", loadBalancerName, resGroup)
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] Load Balancer %q not found. Removing from state", name)
+		return nil
 	}
 
-	var createdProbeId string
-	for _, Probe := range *(*read.LoadBalancerPropertiesFormat).Probes {
-		if *Probe.Name == d.Get("name").(string) {
-			createdProbeId = *Probe.ID
+	var probeId string
+	for _, v := range *read.LoadBalancerPropertiesFormat.Probes {
+		if v.Name != nil && *v.Name == name && v.ID != nil {
+			probeId = *v.ID
 		}
 	}
 
-	if createdProbeId == "" {
-		return fmt.Errorf("Cannot find created Load Balancer Probe ID %q", createdProbeId)
+	if probeId == "" {
+		return fmt.Errorf("Cannot find created Load Balancer Probe ID %q", name)
 	}
 
-	d.SetId(createdProbeId)
-
-	log.Printf("[DEBUG] Waiting for Load Balancer (%s) to become available", loadBalancerName)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"Accepted", "Updating"},
-		Target:  []string{"Succeeded"},
-		Refresh: loadbalancerStateRefreshFunc(ctx, client, resGroup, loadBalancerName),
-		Timeout: 10 * time.Minute,
-	}
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Load Balancer (%q - Resource Group %q) to become available: %+v", loadBalancerName, resGroup, err)
-	}
+	d.SetId(probeId)
 
 	return resourceArmLoadBalancerProbeRead(d, meta)
 }
@@ -181,7 +143,7 @@ func resourceArmLoadBalancerProbeRead(d *schema.ResourceData, meta interface{})
 	}
 	name := id.Path["probes"]
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	loadBalancer, exists, err := retrieveCachedLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
 	if err != nil {
 		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
 	}
@@ -225,52 +187,25 @@ func resourceArmLoadBalancerProbeRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmLoadBalancerProbeDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).loadBalancerClient
-	ctx := meta.(*ArmClient).StopContext
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
-	}
-	if !exists {
-		d.SetId("")
-		return nil
-	}
+	_, exists, err := updateLoadBalancer(meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		_, index, exists := findLoadBalancerProbeByName(loadBalancer, name)
+		if !exists {
+			return nil
+		}
 
-	_, index, exists := findLoadBalancerProbeByName(loadBalancer, d.Get("name").(string))
-	if !exists {
+		oldProbes := *loadBalancer.LoadBalancerPropertiesFormat.Probes
+		newProbes := append(oldProbes[:index], oldProbes[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.Probes = &newProbes
 		return nil
-	}
-
-	oldProbes := *loadBalancer.LoadBalancerPropertiesFormat.Probes
-	newProbes := append(oldProbes[:index], oldProbes[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.Probes = &newProbes
-
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer Name and Group:: %+v", err)
-	}
-
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
-	if err != nil {
-		return fmt.Errorf("Error Creating/Updating Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
-
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	})
 	if err != nil {
-		return fmt.Errorf("Error waiting for completion of Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
-
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
-	if err != nil {
-		return fmt.Errorf("Error Getting LoadBalancer: %+v", err)
+		return err
 	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read Load Balancer %s (resource group %s) ID", loadBalancerName, resGroup)
+	if !exists {
+		d.SetId("")
 	}
 
 	return nil
@@ -293,7 +228,7 @@ func expandAzureRmLoadBalancerProbe(d *schema.ResourceData) *network.Probe {
 	}
 
 	return &network.Probe{
-		Name: utils.String(d.Get("name").(string)),
+		Name:                  utils.String(d.Get("name").(string)),
 		ProbePropertiesFormat: &properties,
 	}
 }
@@ -4,10 +4,9 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"time"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -78,13 +77,13 @@ func resourceArmLoadBalancerRule() *schema.Resource {
 			"frontend_port": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ValidateFunc: validate.PortNumber,
+				ValidateFunc: validate.PortNumberOrZero,
 			},
 
 			"backend_port": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ValidateFunc: validate.PortNumber,
+				ValidateFunc: validate.PortNumberOrZero,
 			},
 
 			"probe_id": {
@@ -116,86 +115,50 @@ func resourceArmLoadBalancerRule() *schema.Resource {
 }
 
 func resourceArmLoadBalancerRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).loadBalancerClient
-	ctx := meta.(*ArmClient).StopContext
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
-
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
-	}
-	if !exists {
-		d.SetId("")
-		log.Printf("[INFO] Load Balancer %q not found. Removing from state", d.Get("name").(string))
-		return nil
-	}
+	name := d.Get("name").(string)
 
-	newLbRule, err := expandAzureRmLoadBalancerRule(d, loadBalancer)
-	if err != nil {
-		return fmt.Errorf("Error Exanding Load Balancer Rule: %+v", err)
-	}
-
-	lbRules := append(*loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules, *newLbRule)
-
-	existingRule, existingRuleIndex, exists := findLoadBalancerRuleByName(loadBalancer, d.Get("name").(string))
-	if exists {
-		if d.Get("name").(string) == *existingRule.Name {
-			// this rule is being updated/reapplied remove old copy from the slice
-			lbRules = append(lbRules[:existingRuleIndex], lbRules[existingRuleIndex+1:]...)
+	read, exists, err := updateLoadBalancer(meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		newLbRule, err := expandAzureRmLoadBalancerRule(d, loadBalancer)
+		if err != nil {
+			return fmt.Errorf("Error Exanding Load Balancer Rule: %+v", err)
 		}
-	}
 
-	loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &lbRules
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer Name and Group:: %+v", err)
-	}
-
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
-	if err != nil {
-		return fmt.Errorf("Error Creating/Updating LoadBalancer: %+v", err)
-	}
+		lbRules := append(*loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules, *newLbRule)
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for completion for Load Balancer updates: %+v", err)
-	}
+		existingRule, existingRuleIndex, exists := findLoadBalancerRuleByName(loadBalancer, name)
+		if exists {
+			if name == *existingRule.Name {
+				// this rule is being updated/reapplied remove old copy from the slice
+				lbRules = append(lbRules[:existingRuleIndex], lbRules[existingRuleIndex+1:]...)
+			}
+		}
 
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+		loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &lbRules
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error Getting LoadBalancer: %+v", err)
+		return err
 	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read Load Balancer %s (resource group %s) ID", loadBalancerName, resGroup)
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] Load Balancer %q not found. Removing from state", name)
+		return nil
 	}
 
 	var ruleId string
-	for _, LoadBalancingRule := range *(*read.LoadBalancerPropertiesFormat).LoadBalancingRules {
-		if *LoadBalancingRule.Name == d.Get("name").(string) {
-			ruleId = *LoadBalancingRule.ID
+	for _, v := range *read.LoadBalancerPropertiesFormat.LoadBalancingRules {
+		if v.Name != nil && *v.Name == name && v.ID != nil {
+			ruleId = *v.ID
 		}
 	}
 
 	if ruleId == "" {
-		return fmt.Errorf("Cannot find created Load Balancer Rule ID %q", ruleId)
+		return fmt.Errorf("Cannot find created Load Balancer Rule ID %q", name)
 	}
 
 	d.SetId(ruleId)
 
-	log.Printf("[DEBUG] Waiting for Load Balancer (%s) to become available", loadBalancerName)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"Accepted", "Updating"},
-		Target:  []string{"Succeeded"},
-		Refresh: loadbalancerStateRefreshFunc(ctx, client, resGroup, loadBalancerName),
-		Timeout: 10 * time.Minute,
-	}
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Load Balancer (%s) to become available: %s", loadBalancerName, err)
-	}
-
 	return resourceArmLoadBalancerRuleRead(d, meta)
 }
 
@@ -206,7 +169,7 @@ func resourceArmLoadBalancerRuleRead(d *schema.ResourceData, meta interface{}) e
 	}
 	name := id.Path["loadBalancingRules"]
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	loadBalancer, exists, err := retrieveCachedLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
 	if err != nil {
 		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
 	}
@@ -266,52 +229,25 @@ func resourceArmLoadBalancerRuleRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmLoadBalancerRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).loadBalancerClient
-	ctx := meta.(*ArmClient).StopContext
-
 	loadBalancerID := d.Get("loadbalancer_id").(string)
-	armMutexKV.Lock(loadBalancerID)
-	defer armMutexKV.Unlock(loadBalancerID)
+	name := d.Get("name").(string)
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
-	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
-	}
-	if !exists {
-		d.SetId("")
-		return nil
-	}
+	_, exists, err := updateLoadBalancer(meta, loadBalancerID, func(loadBalancer *network.LoadBalancer) error {
+		_, index, exists := findLoadBalancerRuleByName(loadBalancer, name)
+		if !exists {
+			return nil
+		}
 
-	_, index, exists := findLoadBalancerRuleByName(loadBalancer, d.Get("name").(string))
-	if !exists {
+		oldLbRules := *loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules
+		newLbRules := append(oldLbRules[:index], oldLbRules[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &newLbRules
 		return nil
-	}
-
-	oldLbRules := *loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules
-	newLbRules := append(oldLbRules[:index], oldLbRules[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &newLbRules
-
-	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
-	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer Name and Group:: %+v", err)
-	}
-
-	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
-	if err != nil {
-		return fmt.Errorf("Error Creating/Updating Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
-
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for completion of Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
-	}
-
-	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+	})
 	if err != nil {
-		return fmt.Errorf("Error Getting LoadBalancer: %+v", err)
+		return err
 	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read ID of Load Balancer %q (resource group %s)", loadBalancerName, resGroup)
+	if !exists {
+		d.SetId("")
 	}
 
 	return nil
@@ -340,6 +276,10 @@ func expandAzureRmLoadBalancerRule(d *schema.ResourceData, lb *network.LoadBalan
 			return nil, fmt.Errorf("[ERROR] Cannot find FrontEnd IP Configuration with the name %s", v)
 		}
 
+		if err := validateLoadBalancerRuleHAPorts(d, lb, rule); err != nil {
+			return nil, err
+		}
+
 		properties.FrontendIPConfiguration = &network.SubResource{
 			ID: rule.ID,
 		}
@@ -358,7 +298,7 @@ func expandAzureRmLoadBalancerRule(d *schema.ResourceData, lb *network.LoadBalan
 	}
 
 	return &network.LoadBalancingRule{
-		Name: utils.String(d.Get("name").(string)),
+		Name:                              utils.String(d.Get("name").(string)),
 		LoadBalancingRulePropertiesFormat: &properties,
 	}, nil
 }
@@ -392,3 +332,30 @@ func validateArmLoadBalancerRuleName(v interface{}, k string) (ws []string, erro
 
 	return
 }
+
+// validateLoadBalancerRuleHAPorts ensures that HA Ports (a protocol of `All` with the frontend and backend
+// ports set to `0`) are only used on the internal frontend of a Standard Load Balancer
+func validateLoadBalancerRuleHAPorts(d *schema.ResourceData, lb *network.LoadBalancer, frontendIPConfig *network.FrontendIPConfiguration) error {
+	protocol := d.Get("protocol").(string)
+	frontendPort := d.Get("frontend_port").(int)
+	backendPort := d.Get("backend_port").(int)
+
+	haPorts := strings.EqualFold(protocol, string(network.TransportProtocolAll)) && frontendPort == 0 && backendPort == 0
+	if !haPorts {
+		if frontendPort == 0 || backendPort == 0 {
+			return fmt.Errorf("`frontend_port` and `backend_port` can only be set to `0` (HA Ports) when both are `0` and `protocol` is `All`")
+		}
+
+		return nil
+	}
+
+	if lb.Sku == nil || lb.Sku.Name != network.LoadBalancerSkuNameStandard {
+		return fmt.Errorf("HA Ports rules (a `protocol` of `All` with the `frontend_port` and `backend_port` set to `0`) are only supported on a `Standard` SKU Load Balancer")
+	}
+
+	if props := frontendIPConfig.FrontendIPConfigurationPropertiesFormat; props == nil || props.PublicIPAddress != nil || props.Subnet == nil {
+		return fmt.Errorf("HA Ports rules (a `protocol` of `All` with the `frontend_port` and `backend_port` set to `0`) are only supported on an internal Frontend IP Configuration")
+	}
+
+	return nil
+}
@@ -2,15 +2,93 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_lb", &resource.Sweeper{
+		Name: "azurerm_lb",
+		F:    testSweepLoadBalancers,
+		Dependencies: []string{
+			"azurerm_network_interface",
+			"azurerm_virtual_machine_scale_set",
+		},
+	})
+}
+
+func testSweepLoadBalancers(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := (*armClient).loadBalancerClient
+	ctx := (*armClient).StopContext
+
+	log.Printf("Retrieving the Load Balancers..")
+	results, err := client.ListAllComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error Listing on Load Balancers: %+v", err)
+	}
+
+	loadBalancers := make([]network.LoadBalancer, 0)
+	for results.NotDone() {
+		loadBalancers = append(loadBalancers, results.Value())
+
+		if err := results.Next(); err != nil {
+			return fmt.Errorf("Error Listing on Load Balancers: %+v", err)
+		}
+	}
+
+	for _, loadBalancer := range loadBalancers {
+		if loadBalancer.ID == nil || loadBalancer.Name == nil || loadBalancer.Location == nil {
+			continue
+		}
+
+		id, err := parseAzureResourceID(*loadBalancer.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing Azure Resource ID %q", *loadBalancer.ID)
+		}
+
+		resourceGroupName := id.ResourceGroup
+		name := *loadBalancer.Name
+
+		if !shouldSweepAcceptanceTestResource(name, *loadBalancer.Location, region) {
+			continue
+		}
+
+		log.Printf("Deleting Load Balancer %q", name)
+		future, err := client.Delete(ctx, resourceGroupName, name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return err
+		}
+
+		err = future.WaitForCompletionRef(ctx, client.Client)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestResourceAzureRMLoadBalancerPrivateIpAddressAllocation_validation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -85,6 +163,43 @@ func TestAccAzureRMLoadBalancer_standard(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMLoadBalancer_ipv6DualStack(t *testing.T) {
+	var lb network.LoadBalancer
+	resourceName := "azurerm_lb.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLoadBalancer_ipv6DualStack(ri, testLocation(), "Basic"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "frontend_ip_configuration.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLoadBalancer_ipv6Standard(t *testing.T) {
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMLoadBalancer_ipv6DualStack(ri, testLocation(), "Standard"),
+				ExpectError: regexp.MustCompile("only supported on a `Basic` SKU Load Balancer"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMLoadBalancer_frontEndConfig(t *testing.T) {
 	var lb network.LoadBalancer
 	resourceName := "azurerm_lb.test"
@@ -272,6 +387,47 @@ resource "azurerm_lb" "test" {
 }`, rInt, location, rInt)
 }
 
+func testAccAzureRMLoadBalancer_ipv6DualStack(rInt int, location, sku string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "ipv4" {
+  name                         = "acctestpip4-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+}
+
+resource "azurerm_public_ip" "ipv6" {
+  name                         = "acctestpip6-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+  ip_version                   = "IPv6"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "acctest-loadbalancer-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "%s"
+
+  frontend_ip_configuration {
+    name                 = "ipv4"
+    public_ip_address_id = "${azurerm_public_ip.ipv4.id}"
+  }
+
+  frontend_ip_configuration {
+    name                 = "ipv6"
+    public_ip_address_id = "${azurerm_public_ip.ipv6.id}"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, sku)
+}
+
 func testAccAzureRMLoadBalancer_updatedTags(rInt int, location string) string {
 	return fmt.Sprintf(`
 
@@ -0,0 +1,239 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogAnalyticsCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsClusterCreateUpdate,
+		Read:   resourceArmLogAnalyticsClusterRead,
+		Update: resourceArmLogAnalyticsClusterCreateUpdate,
+		Delete: resourceArmLogAnalyticsClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLogAnalyticsClusterName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"identity": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+							}, true),
+						},
+
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"size_gb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validateLogAnalyticsClusterSize,
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmLogAnalyticsClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for Log Analytics Cluster creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+	id := logAnalyticsClusterResourceID(subscriptionId, resourceGroup, name)
+
+	// the Customer Managed Key is configured using the `azurerm_log_analytics_cluster_customer_managed_key`
+	// resource, as such it's retained when the Cluster is updated
+	var keyVaultProperties *logAnalyticsKeyVaultProperties
+	if !d.IsNewResource() {
+		var existing logAnalyticsCluster
+		if _, err := client.Get(ctx, id, &existing); err != nil {
+			return fmt.Errorf("Error retrieving Log Analytics Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		if existing.Properties != nil {
+			keyVaultProperties = existing.Properties.KeyVaultProperties
+		}
+	}
+
+	cluster := logAnalyticsCluster{
+		Location: utils.String(location),
+		Identity: expandLogAnalyticsClusterIdentity(d.Get("identity").([]interface{})),
+		Sku: &logAnalyticsClusterSku{
+			Name:     "CapacityReservation",
+			Capacity: utils.Int64(int64(d.Get("size_gb").(int))),
+		},
+		Properties: &logAnalyticsClusterProperties{
+			KeyVaultProperties: keyVaultProperties,
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id, cluster)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Log Analytics Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Log Analytics Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmLogAnalyticsClusterRead(d, meta)
+}
+
+func resourceArmLogAnalyticsClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["clusters"]
+
+	var cluster logAnalyticsCluster
+	resp, err := client.Get(ctx, d.Id(), &cluster)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Log Analytics Cluster %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Log Analytics Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := cluster.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if err := d.Set("identity", flattenLogAnalyticsClusterIdentity(cluster.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if sku := cluster.Sku; sku != nil && sku.Capacity != nil {
+		d.Set("size_gb", int(*sku.Capacity))
+	}
+
+	if props := cluster.Properties; props != nil {
+		d.Set("cluster_id", props.ClusterID)
+	}
+
+	flattenAndSetTags(d, cluster.Tags, meta)
+
+	return nil
+}
+
+func resourceArmLogAnalyticsClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["clusters"]
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Log Analytics Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Log Analytics Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandLogAnalyticsClusterIdentity(input []interface{}) *logAnalyticsClusterIdentity {
+	v := input[0].(map[string]interface{})
+	return &logAnalyticsClusterIdentity{
+		Type: v["type"].(string),
+	}
+}
+
+func flattenLogAnalyticsClusterIdentity(input *logAnalyticsClusterIdentity) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         input.Type,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
@@ -0,0 +1,172 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogAnalyticsClusterCustomerManagedKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsClusterCustomerManagedKeyCreateUpdate,
+		Read:   resourceArmLogAnalyticsClusterCustomerManagedKeyRead,
+		Update: resourceArmLogAnalyticsClusterCustomerManagedKeyCreateUpdate,
+		Delete: resourceArmLogAnalyticsClusterCustomerManagedKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_analytics_cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateLogAnalyticsKeyVaultKeyID,
+			},
+		},
+	}
+}
+
+func resourceArmLogAnalyticsClusterCustomerManagedKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	clusterId := d.Get("log_analytics_cluster_id").(string)
+	id, err := parseAzureResourceID(clusterId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["clusters"]
+
+	keyId, err := parseKeyVaultChildID(d.Get("key_vault_key_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing `key_vault_key_id`: %+v", err)
+	}
+
+	if d.IsNewResource() {
+		var existing logAnalyticsCluster
+		if _, err := client.Get(ctx, clusterId, &existing); err != nil {
+			return fmt.Errorf("Error retrieving Log Analytics Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := existing.Properties; props != nil && props.KeyVaultProperties != nil {
+			if keyName := props.KeyVaultProperties.KeyName; keyName != nil && *keyName != "" {
+				return fmt.Errorf("A Customer Managed Key is already configured for Log Analytics Cluster %q (Resource Group %q) - to be managed via Terraform this resource needs to be imported into the State", name, resourceGroup)
+			}
+		}
+	}
+
+	patch := logAnalyticsClusterPatch{
+		Properties: &logAnalyticsClusterProperties{
+			KeyVaultProperties: &logAnalyticsKeyVaultProperties{
+				KeyVaultURI: utils.String(keyId.KeyVaultBaseUrl),
+				KeyName:     utils.String(keyId.Name),
+				KeyVersion:  utils.String(keyId.Version),
+			},
+		},
+	}
+
+	future, err := client.Update(ctx, clusterId, patch)
+	if err != nil {
+		return fmt.Errorf("Error configuring the Customer Managed Key for Log Analytics Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, clusterId); err != nil {
+		return fmt.Errorf("Error waiting for the Customer Managed Key for Log Analytics Cluster %q (Resource Group %q) to be configured: %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(clusterId)
+
+	return resourceArmLogAnalyticsClusterCustomerManagedKeyRead(d, meta)
+}
+
+func resourceArmLogAnalyticsClusterCustomerManagedKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["clusters"]
+
+	var cluster logAnalyticsCluster
+	resp, err := client.Get(ctx, d.Id(), &cluster)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Log Analytics Cluster %q was not found in Resource Group %q - removing Customer Managed Key from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Log Analytics Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var keyVaultProperties *logAnalyticsKeyVaultProperties
+	if props := cluster.Properties; props != nil {
+		keyVaultProperties = props.KeyVaultProperties
+	}
+
+	if keyVaultProperties == nil || keyVaultProperties.KeyVaultURI == nil || keyVaultProperties.KeyName == nil || *keyVaultProperties.KeyName == "" {
+		log.Printf("[DEBUG] No Customer Managed Key is configured for Log Analytics Cluster %q (Resource Group %q) - removing from state!", name, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	keyVersion := ""
+	if keyVaultProperties.KeyVersion != nil {
+		keyVersion = *keyVaultProperties.KeyVersion
+	}
+
+	keyVaultUri := strings.TrimSuffix(*keyVaultProperties.KeyVaultURI, "/")
+	d.Set("log_analytics_cluster_id", d.Id())
+	d.Set("key_vault_key_id", fmt.Sprintf("%s/keys/%s/%s", keyVaultUri, *keyVaultProperties.KeyName, keyVersion))
+
+	return nil
+}
+
+func resourceArmLogAnalyticsClusterCustomerManagedKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["clusters"]
+
+	// removing the Key Vault Properties reverts the Cluster to using Microsoft Managed Keys
+	patch := logAnalyticsClusterPatch{
+		Properties: &logAnalyticsClusterProperties{
+			KeyVaultProperties: &logAnalyticsKeyVaultProperties{
+				KeyVaultURI: utils.String(""),
+				KeyName:     utils.String(""),
+				KeyVersion:  utils.String(""),
+			},
+		},
+	}
+
+	future, err := client.Update(ctx, d.Id(), patch)
+	if err != nil {
+		return fmt.Errorf("Error removing the Customer Managed Key from Log Analytics Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Customer Managed Key to be removed from Log Analytics Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Customer Managed Keys require a Key Vault with both Soft Delete and Purge Protection enabled, which can't be
+// configured using the `azurerm_key_vault` resource at this time - as such these tests use an existing Key Vault.
+func testAccAzureRMLogAnalyticsClusterCustomerManagedKeyPreCheck(t *testing.T) (string, string) {
+	keyVaultName := os.Getenv("ARM_TEST_CMK_KEY_VAULT_NAME")
+	keyVaultResourceGroup := os.Getenv("ARM_TEST_CMK_KEY_VAULT_RESOURCE_GROUP")
+	if keyVaultName == "" || keyVaultResourceGroup == "" {
+		t.Skip("`ARM_TEST_CMK_KEY_VAULT_NAME` and `ARM_TEST_CMK_KEY_VAULT_RESOURCE_GROUP` must be set to run the Log Analytics Cluster Customer Managed Key tests")
+	}
+
+	return keyVaultName, keyVaultResourceGroup
+}
+
+func TestAccAzureRMLogAnalyticsClusterCustomerManagedKey_basic(t *testing.T) {
+	keyVaultName, keyVaultResourceGroup := testAccAzureRMLogAnalyticsClusterCustomerManagedKeyPreCheck(t)
+
+	resourceName := "azurerm_log_analytics_cluster_customer_managed_key.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsClusterCustomerManagedKey_basic(ri, testLocation(), keyVaultName, keyVaultResourceGroup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsClusterCustomerManagedKeyExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsClusterCustomerManagedKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).logAnalyticsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var cluster logAnalyticsCluster
+		resp, err := client.Get(ctx, rs.Primary.ID, &cluster)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Log Analytics Cluster %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on logAnalyticsClient: %+v", err)
+		}
+
+		props := cluster.Properties
+		if props == nil || props.KeyVaultProperties == nil || props.KeyVaultProperties.KeyName == nil || *props.KeyVaultProperties.KeyName == "" {
+			return fmt.Errorf("Bad: no Customer Managed Key is configured for Log Analytics Cluster %q", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMLogAnalyticsClusterCustomerManagedKey_basic(rInt int, location string, keyVaultName string, keyVaultResourceGroup string) string {
+	template := testAccAzureRMLogAnalyticsCluster_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault" "test" {
+  name                = "%s"
+  resource_group_name = "%s"
+}
+
+resource "azurerm_key_vault_access_policy" "test" {
+  vault_name          = "${data.azurerm_key_vault.test.name}"
+  resource_group_name = "${data.azurerm_key_vault.test.resource_group_name}"
+
+  key_permissions = [
+    "get",
+    "unwrapkey",
+    "wrapkey",
+  ]
+
+  tenant_id = "${azurerm_log_analytics_cluster.test.identity.0.tenant_id}"
+  object_id = "${azurerm_log_analytics_cluster.test.identity.0.principal_id}"
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name      = "acctestkvkey-%d"
+  vault_uri = "${data.azurerm_key_vault.test.vault_uri}"
+  key_type  = "RSA"
+  key_size  = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+
+resource "azurerm_log_analytics_cluster_customer_managed_key" "test" {
+  log_analytics_cluster_id = "${azurerm_log_analytics_cluster.test.id}"
+  key_vault_key_id         = "${azurerm_key_vault_key.test.id}"
+
+  depends_on = ["azurerm_key_vault_access_policy.test"]
+}
+`, template, keyVaultName, keyVaultResourceGroup, rInt)
+}
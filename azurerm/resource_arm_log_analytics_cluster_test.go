@@ -0,0 +1,169 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMLogAnalyticsCluster_basic(t *testing.T) {
+	resourceName := "azurerm_log_analytics_cluster.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsCluster_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size_gb", "1000"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogAnalyticsCluster_update(t *testing.T) {
+	resourceName := "azurerm_log_analytics_cluster.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogAnalyticsCluster_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size_gb", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMLogAnalyticsCluster_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size_gb", "2000"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsClusterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).logAnalyticsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var cluster logAnalyticsCluster
+		resp, err := client.Get(ctx, rs.Primary.ID, &cluster)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Log Analytics Cluster %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on logAnalyticsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMLogAnalyticsClusterDestroy(s *terraform.State) error {
+	return testCheckAzureRMLogAnalyticsResourceDestroy(s, "azurerm_log_analytics_cluster")
+}
+
+// testCheckAzureRMLogAnalyticsResourceDestroy checks that each resource of the given type managed through the
+// `logAnalyticsClient` no longer exists
+func testCheckAzureRMLogAnalyticsResourceDestroy(s *terraform.State, resourceType string) error {
+	client := testAccProvider.Meta().(*ArmClient).logAnalyticsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		var result map[string]interface{}
+		resp, err := client.Get(ctx, rs.Primary.ID, &result)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("%s %q still exists", resourceType, rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMLogAnalyticsCluster_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_cluster" "test" {
+  name                = "acctest-LA-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMLogAnalyticsCluster_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_cluster" "test" {
+  name                = "acctest-LA-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  size_gb             = 2000
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,168 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogAnalyticsLinkedService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsLinkedServiceCreate,
+		Read:   resourceArmLogAnalyticsLinkedServiceRead,
+		Delete: resourceArmLogAnalyticsLinkedServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"read_access_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  azure.ValidateResourceID,
+				ConflictsWith: []string{"write_access_id"},
+			},
+
+			"write_access_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  azure.ValidateResourceID,
+				ConflictsWith: []string{"read_access_id"},
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmLogAnalyticsLinkedServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Log Analytics Linked Service creation.")
+
+	workspaceId := d.Get("workspace_id").(string)
+	workspace, err := parseAzureResourceID(workspaceId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := workspace.ResourceGroup
+	workspaceName := workspace.Path["workspaces"]
+
+	readAccessId := d.Get("read_access_id").(string)
+	writeAccessId := d.Get("write_access_id").(string)
+
+	// the name of the Linked Service is determined by what's being linked - an Automation Account (read access)
+	// or a Log Analytics Cluster (write access)
+	var name string
+	props := logAnalyticsLinkedServiceProperties{}
+	switch {
+	case readAccessId != "":
+		name = "Automation"
+		props.ResourceID = utils.String(readAccessId)
+	case writeAccessId != "":
+		name = "Cluster"
+		props.WriteAccessResourceID = utils.String(writeAccessId)
+	default:
+		return fmt.Errorf("One of `read_access_id` or `write_access_id` must be specified")
+	}
+
+	id := fmt.Sprintf("%s/linkedServices/%s", workspaceId, name)
+	linkedService := logAnalyticsLinkedService{
+		Properties: &props,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id, linkedService)
+	if err != nil {
+		return fmt.Errorf("Error creating Log Analytics Linked Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation of Log Analytics Linked Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmLogAnalyticsLinkedServiceRead(d, meta)
+}
+
+func resourceArmLogAnalyticsLinkedServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["linkedServices"]
+
+	var linkedService logAnalyticsLinkedService
+	resp, err := client.Get(ctx, d.Id(), &linkedService)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Log Analytics Linked Service %q was not found in Workspace %q / Resource Group %q - removing from state!", name, workspaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Log Analytics Linked Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("workspace_id", azureRMResourceID(id.SubscriptionID, resourceGroup, "Microsoft.OperationalInsights", "workspaces", workspaceName))
+
+	if props := linkedService.Properties; props != nil {
+		d.Set("read_access_id", props.ResourceID)
+		d.Set("write_access_id", props.WriteAccessResourceID)
+	}
+
+	return nil
+}
+
+func resourceArmLogAnalyticsLinkedServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["linkedServices"]
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Log Analytics Linked Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Log Analytics Linked Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMLogAnalyticsLinkedService_cluster(t *testing.T) {
+	resourceName := "azurerm_log_analytics_linked_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsLinkedService_cluster(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsLinkedServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsLinkedServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "Cluster"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogAnalyticsLinkedService_automationAccount(t *testing.T) {
+	resourceName := "azurerm_log_analytics_linked_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsLinkedService_automationAccount(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsLinkedServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsLinkedServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "Automation"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsLinkedServiceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).logAnalyticsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var linkedService logAnalyticsLinkedService
+		resp, err := client.Get(ctx, rs.Primary.ID, &linkedService)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Log Analytics Linked Service %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on logAnalyticsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMLogAnalyticsLinkedServiceDestroy(s *terraform.State) error {
+	return testCheckAzureRMLogAnalyticsResourceDestroy(s, "azurerm_log_analytics_linked_service")
+}
+
+func testAccAzureRMLogAnalyticsLinkedService_cluster(rInt int, location string) string {
+	template := testAccAzureRMLogAnalyticsCluster_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_linked_service" "test" {
+  workspace_id    = "${azurerm_log_analytics_workspace.test.id}"
+  write_access_id = "${azurerm_log_analytics_cluster.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMLogAnalyticsLinkedService_automationAccount(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctestAA-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_linked_service" "test" {
+  workspace_id   = "${azurerm_log_analytics_workspace.test.id}"
+  read_access_id = "${azurerm_automation_account.test.id}"
+}
+`, rInt, location, rInt, rInt)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -73,6 +74,7 @@ func resourceArmLogAnalyticsSolution() *schema.Resource {
 				Required:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     validate.ResourceID,
 			},
 		},
 	}
@@ -102,15 +104,15 @@ func resourceArmLogAnalyticsSolutionCreateUpdate(d *schema.ResourceData, meta in
 		},
 	}
 
-	res, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
-	//Currently this is required to work around successful creation resulting in an error
-	// being returned
-	if err != nil && res.Response().StatusCode != 201 {
-		if resp := res.Response(); resp != nil {
-			if resp.StatusCode != 201 {
-				return err
-			}
-		}
+	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Log Analytics Solution %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.OperationsManagement", "solutions", name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Log Analytics Solution %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
 	solution, err := client.Get(ctx, resGroup, name)
@@ -195,7 +197,7 @@ func resourceArmLogAnalyticsSolutionDelete(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error deleting Log Analytics Solution %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		if response.WasNotFound(future.Response()) {
 			return nil
@@ -0,0 +1,161 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogAnalyticsWorkspaceTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsWorkspaceTableCreateUpdate,
+		Read:   resourceArmLogAnalyticsWorkspaceTableRead,
+		Update: resourceArmLogAnalyticsWorkspaceTableCreateUpdate,
+		Delete: resourceArmLogAnalyticsWorkspaceTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLogAnalyticsTableName,
+			},
+
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"retention_in_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(30, 730),
+			},
+		},
+	}
+}
+
+func resourceArmLogAnalyticsWorkspaceTableCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Log Analytics Workspace Table creation.")
+
+	name := d.Get("name").(string)
+	workspaceId := d.Get("workspace_id").(string)
+	workspace, err := parseAzureResourceID(workspaceId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := workspace.ResourceGroup
+	workspaceName := workspace.Path["workspaces"]
+
+	id := fmt.Sprintf("%s/tables/%s", workspaceId, name)
+
+	// Tables are created by the Workspace (or by the data sent to it) - as such this resource only manages the
+	// Retention of an existing Table, which must be present
+	if d.IsNewResource() {
+		var existing logAnalyticsTable
+		if _, err := client.Get(ctx, id, &existing); err != nil {
+			return fmt.Errorf("Error retrieving Table %q (Log Analytics Workspace %q / Resource Group %q): %+v", name, workspaceName, resourceGroup, err)
+		}
+	}
+
+	table := logAnalyticsTable{
+		Properties: &logAnalyticsTableProperties{
+			RetentionInDays: utils.Int32(int32(d.Get("retention_in_days").(int))),
+		},
+	}
+
+	future, err := client.Update(ctx, id, table)
+	if err != nil {
+		return fmt.Errorf("Error updating the Retention of Table %q (Log Analytics Workspace %q / Resource Group %q): %+v", name, workspaceName, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for the Retention of Table %q (Log Analytics Workspace %q / Resource Group %q) to be updated: %+v", name, workspaceName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmLogAnalyticsWorkspaceTableRead(d, meta)
+}
+
+func resourceArmLogAnalyticsWorkspaceTableRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["tables"]
+
+	var table logAnalyticsTable
+	resp, err := client.Get(ctx, d.Id(), &table)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Table %q was not found in Log Analytics Workspace %q / Resource Group %q - removing from state!", name, workspaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Table %q (Log Analytics Workspace %q / Resource Group %q): %+v", name, workspaceName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("workspace_id", azureRMResourceID(id.SubscriptionID, resourceGroup, "Microsoft.OperationalInsights", "workspaces", workspaceName))
+
+	if props := table.Properties; props != nil && props.RetentionInDays != nil {
+		d.Set("retention_in_days", int(*props.RetentionInDays))
+	}
+
+	return nil
+}
+
+func resourceArmLogAnalyticsWorkspaceTableDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["tables"]
+
+	// Tables can't be deleted, instead the Retention is reset to the Retention of the Workspace
+	table := logAnalyticsTable{
+		Properties: &logAnalyticsTableProperties{
+			RetentionInDays: utils.Int32(-1),
+		},
+	}
+
+	future, err := client.Update(ctx, d.Id(), table)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error resetting the Retention of Table %q (Log Analytics Workspace %q / Resource Group %q): %+v", name, workspaceName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Retention of Table %q (Log Analytics Workspace %q / Resource Group %q) to be reset: %+v", name, workspaceName, resourceGroup, err)
+	}
+
+	return nil
+}
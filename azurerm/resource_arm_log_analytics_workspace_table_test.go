@@ -0,0 +1,95 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMLogAnalyticsWorkspaceTable_update(t *testing.T) {
+	resourceName := "azurerm_log_analytics_workspace_table.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// Tables can't be deleted, as such the Workspace being removed is checked instead
+		CheckDestroy: testCheckAzureRMLogAnalyticsWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogAnalyticsWorkspaceTable_basic(ri, location, 730),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsWorkspaceTableRetention(resourceName, 730),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMLogAnalyticsWorkspaceTable_basic(ri, location, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsWorkspaceTableRetention(resourceName, 60),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsWorkspaceTableRetention(resourceName string, retentionInDays int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).logAnalyticsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var table logAnalyticsTable
+		resp, err := client.Get(ctx, rs.Primary.ID, &table)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Log Analytics Workspace Table %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on logAnalyticsClient: %+v", err)
+		}
+
+		if props := table.Properties; props == nil || props.RetentionInDays == nil || int(*props.RetentionInDays) != retentionInDays {
+			return fmt.Errorf("Bad: expected the Retention of Log Analytics Workspace Table %q to be %d days", rs.Primary.ID, retentionInDays)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMLogAnalyticsWorkspaceTable_basic(rInt int, location string, retentionInDays int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_log_analytics_workspace_table" "test" {
+  name              = "Perf"
+  workspace_id      = "${azurerm_log_analytics_workspace.test.id}"
+  retention_in_days = %d
+}
+`, rInt, location, rInt, retentionInDays)
+}
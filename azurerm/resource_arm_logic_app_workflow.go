@@ -88,7 +88,7 @@ func resourceArmLogicAppWorkflowCreate(d *schema.ResourceData, meta interface{})
 			},
 			Parameters: parameters,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	_, err := client.CreateOrUpdate(ctx, resourceGroup, name, properties)
@@ -149,7 +149,7 @@ func resourceArmLogicAppWorkflowUpdate(d *schema.ResourceData, meta interface{})
 			Definition: read.WorkflowProperties.Definition,
 			Parameters: parameters,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	_, err = client.CreateOrUpdate(ctx, resourceGroup, name, properties)
@@ -206,7 +206,7 @@ func resourceArmLogicAppWorkflowRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -0,0 +1,356 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/managedapplications"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmManagedApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagedApplicationCreateUpdate,
+		Read:   resourceArmManagedApplicationRead,
+		Update: resourceArmManagedApplicationCreateUpdate,
+		Delete: resourceArmManagedApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateManagedApplicationName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"kind": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"MarketPlace",
+					"ServiceCatalog",
+				}, false),
+			},
+
+			"managed_resource_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmResourceGroupName,
+			},
+
+			"application_definition_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"plan": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"product": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"publisher": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"promotion_code": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmManagedApplicationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managedApplicationsClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for Managed Application creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	kind := d.Get("kind").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	managedResourceGroupName := d.Get("managed_resource_group_name").(string)
+	managedResourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionId, managedResourceGroupName)
+
+	properties := managedapplications.ApplicationProperties{
+		ManagedResourceGroupID: utils.String(managedResourceGroupId),
+		Parameters:             expandManagedApplicationParameters(d.Get("parameters").(map[string]interface{})),
+	}
+
+	applicationDefinitionId := d.Get("application_definition_id").(string)
+	if applicationDefinitionId != "" {
+		properties.ApplicationDefinitionID = utils.String(applicationDefinitionId)
+	}
+
+	plan := expandManagedApplicationPlan(d.Get("plan").([]interface{}))
+
+	if kind == "ServiceCatalog" && applicationDefinitionId == "" {
+		return fmt.Errorf("`application_definition_id` must be specified when `kind` is `ServiceCatalog`")
+	}
+	if kind == "MarketPlace" && plan == nil {
+		return fmt.Errorf("`plan` must be specified when `kind` is `MarketPlace`")
+	}
+
+	parameters := managedapplications.Application{
+		Location:              utils.String(location),
+		Kind:                  utils.String(kind),
+		Plan:                  plan,
+		ApplicationProperties: &properties,
+		Tags:                  expandTags(tags, meta),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Managed Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Solutions", "applications", name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Managed Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Managed Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Managed Application %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmManagedApplicationRead(d, meta)
+}
+
+func resourceArmManagedApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managedApplicationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["applications"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Managed Application %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Managed Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("kind", resp.Kind)
+
+	if err := d.Set("plan", flattenManagedApplicationPlan(resp.Plan)); err != nil {
+		return fmt.Errorf("Error setting `plan`: %+v", err)
+	}
+
+	if props := resp.ApplicationProperties; props != nil {
+		managedResourceGroupName := ""
+		if props.ManagedResourceGroupID != nil {
+			managedResourceGroup, err := parseAzureResourceID(*props.ManagedResourceGroupID)
+			if err != nil {
+				return err
+			}
+			managedResourceGroupName = managedResourceGroup.ResourceGroup
+		}
+		d.Set("managed_resource_group_name", managedResourceGroupName)
+		d.Set("application_definition_id", props.ApplicationDefinitionID)
+
+		if err := d.Set("parameters", flattenManagedApplicationParametersOrOutputs(props.Parameters)); err != nil {
+			return fmt.Errorf("Error setting `parameters`: %+v", err)
+		}
+
+		if err := d.Set("outputs", flattenManagedApplicationParametersOrOutputs(props.Outputs)); err != nil {
+			return fmt.Errorf("Error setting `outputs`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmManagedApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managedApplicationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["applications"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Managed Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Managed Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandManagedApplicationParameters(input map[string]interface{}) map[string]interface{} {
+	// the API expects each parameter to be wrapped in a `value` object
+	output := make(map[string]interface{})
+
+	for k, v := range input {
+		output[k] = map[string]interface{}{
+			"value": v,
+		}
+	}
+
+	return output
+}
+
+func flattenManagedApplicationParametersOrOutputs(input interface{}) map[string]interface{} {
+	results := make(map[string]interface{})
+
+	attrs, ok := input.(map[string]interface{})
+	if !ok {
+		return results
+	}
+
+	for k, val := range attrs {
+		if v, ok := val.(map[string]interface{}); ok {
+			if value, ok := v["value"]; ok && value != nil {
+				results[k] = fmt.Sprintf("%v", value)
+			}
+		}
+	}
+
+	return results
+}
+
+func expandManagedApplicationPlan(input []interface{}) *managedapplications.Plan {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	plan := managedapplications.Plan{
+		Name:      utils.String(v["name"].(string)),
+		Product:   utils.String(v["product"].(string)),
+		Publisher: utils.String(v["publisher"].(string)),
+		Version:   utils.String(v["version"].(string)),
+	}
+
+	if promotionCode := v["promotion_code"].(string); promotionCode != "" {
+		plan.PromotionCode = utils.String(promotionCode)
+	}
+
+	return &plan
+}
+
+func flattenManagedApplicationPlan(input *managedapplications.Plan) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	output := make(map[string]interface{})
+	if input.Name != nil {
+		output["name"] = *input.Name
+	}
+	if input.Product != nil {
+		output["product"] = *input.Product
+	}
+	if input.Publisher != nil {
+		output["publisher"] = *input.Publisher
+	}
+	if input.Version != nil {
+		output["version"] = *input.Version
+	}
+	if input.PromotionCode != nil {
+		output["promotion_code"] = *input.PromotionCode
+	}
+
+	results = append(results, output)
+	return results
+}
+
+func validateManagedApplicationName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[-\w.()]{3,64}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 64 characters in length and can only contain letters, numbers, underscores, periods, parentheses and hyphens", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,335 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/managedapplications"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmManagedApplicationDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagedApplicationDefinitionCreateUpdate,
+		Read:   resourceArmManagedApplicationDefinitionRead,
+		Update: resourceArmManagedApplicationDefinitionCreateUpdate,
+		Delete: resourceArmManagedApplicationDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateManagedApplicationDefinitionName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(4, 60),
+			},
+
+			"lock_level": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(managedapplications.CanNotDelete),
+					string(managedapplications.None),
+					string(managedapplications.ReadOnly),
+				}, false),
+			},
+
+			"authorization": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_principal_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"role_definition_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+
+			"package_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"package_file_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"create_ui_definition": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"main_template": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmManagedApplicationDefinitionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managedApplicationDefinitionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Managed Application Definition creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	packageFileUri := d.Get("package_file_uri").(string)
+	createUiDefinition := d.Get("create_ui_definition").(string)
+	mainTemplate := d.Get("main_template").(string)
+
+	if packageFileUri == "" && (createUiDefinition == "" || mainTemplate == "") {
+		return fmt.Errorf("Either `package_file_uri` or both `create_ui_definition` and `main_template` must be specified")
+	}
+
+	properties := managedapplications.ApplicationDefinitionProperties{
+		Authorizations: expandManagedApplicationDefinitionAuthorizations(d.Get("authorization").(*schema.Set)),
+		Description:    utils.String(d.Get("description").(string)),
+		DisplayName:    utils.String(d.Get("display_name").(string)),
+		// the API expects this as a string
+		IsEnabled: utils.String(strconv.FormatBool(d.Get("package_enabled").(bool))),
+		LockLevel: managedapplications.ApplicationLockLevel(d.Get("lock_level").(string)),
+	}
+
+	if packageFileUri != "" {
+		properties.PackageFileURI = utils.String(packageFileUri)
+	}
+
+	if createUiDefinition != "" {
+		expanded, err := structure.ExpandJsonFromString(createUiDefinition)
+		if err != nil {
+			return fmt.Errorf("Error parsing `create_ui_definition`: %+v", err)
+		}
+		properties.CreateUIDefinition = expanded
+	}
+
+	if mainTemplate != "" {
+		expanded, err := structure.ExpandJsonFromString(mainTemplate)
+		if err != nil {
+			return fmt.Errorf("Error parsing `main_template`: %+v", err)
+		}
+		properties.MainTemplate = expanded
+	}
+
+	parameters := managedapplications.ApplicationDefinition{
+		Location:                        utils.String(location),
+		ApplicationDefinitionProperties: &properties,
+		Tags:                            expandTags(tags, meta),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Managed Application Definition %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Solutions", "applicationDefinitions", name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Managed Application Definition %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Managed Application Definition %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Managed Application Definition %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmManagedApplicationDefinitionRead(d, meta)
+}
+
+func resourceArmManagedApplicationDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managedApplicationDefinitionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["applicationDefinitions"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Managed Application Definition %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Managed Application Definition %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ApplicationDefinitionProperties; props != nil {
+		d.Set("description", props.Description)
+		d.Set("display_name", props.DisplayName)
+		d.Set("lock_level", string(props.LockLevel))
+		d.Set("package_file_uri", props.PackageFileURI)
+
+		if isEnabled := props.IsEnabled; isEnabled != nil {
+			enabled, err := strconv.ParseBool(*isEnabled)
+			if err != nil {
+				return fmt.Errorf("Error parsing `is_enabled` value %q: %+v", *isEnabled, err)
+			}
+			d.Set("package_enabled", enabled)
+		}
+
+		if err := d.Set("authorization", flattenManagedApplicationDefinitionAuthorizations(props.Authorizations)); err != nil {
+			return fmt.Errorf("Error setting `authorization`: %+v", err)
+		}
+
+		// the API doesn't return `create_ui_definition` or `main_template` when a package is used
+		if v, ok := props.CreateUIDefinition.(map[string]interface{}); ok {
+			flattened, err := structure.FlattenJsonToString(v)
+			if err != nil {
+				return fmt.Errorf("Error flattening `create_ui_definition`: %+v", err)
+			}
+			d.Set("create_ui_definition", flattened)
+		}
+
+		if v, ok := props.MainTemplate.(map[string]interface{}); ok {
+			flattened, err := structure.FlattenJsonToString(v)
+			if err != nil {
+				return fmt.Errorf("Error flattening `main_template`: %+v", err)
+			}
+			d.Set("main_template", flattened)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmManagedApplicationDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managedApplicationDefinitionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["applicationDefinitions"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Managed Application Definition %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Managed Application Definition %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandManagedApplicationDefinitionAuthorizations(input *schema.Set) *[]managedapplications.ApplicationProviderAuthorization {
+	results := make([]managedapplications.ApplicationProviderAuthorization, 0)
+
+	for _, raw := range input.List() {
+		v := raw.(map[string]interface{})
+
+		results = append(results, managedapplications.ApplicationProviderAuthorization{
+			PrincipalID:      utils.String(v["service_principal_id"].(string)),
+			RoleDefinitionID: utils.String(v["role_definition_id"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenManagedApplicationDefinitionAuthorizations(input *[]managedapplications.ApplicationProviderAuthorization) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, authorization := range *input {
+		output := make(map[string]interface{})
+
+		if authorization.PrincipalID != nil {
+			output["service_principal_id"] = *authorization.PrincipalID
+		}
+
+		if authorization.RoleDefinitionID != nil {
+			output["role_definition_id"] = *authorization.RoleDefinitionID
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func validateManagedApplicationDefinitionName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[-\w.]{3,64}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 64 characters in length and can only contain letters, numbers, underscores, periods and hyphens", k))
+	}
+
+	return warnings, errors
+}
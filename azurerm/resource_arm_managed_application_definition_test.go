@@ -0,0 +1,275 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMManagedApplicationDefinition_basic(t *testing.T) {
+	resourceName := "azurerm_managed_application_definition.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMManagedApplicationDefinition_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedApplicationDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedApplicationDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lock_level", "ReadOnly"),
+					resource.TestCheckResourceAttr(resourceName, "package_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_ui_definition"),
+					resource.TestCheckResourceAttrSet(resourceName, "main_template"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedApplicationDefinition_complete(t *testing.T) {
+	resourceName := "azurerm_managed_application_definition.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMManagedApplicationDefinition_complete(ri, location)
+	postConfig := testAccAzureRMManagedApplicationDefinition_completeUpdated(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedApplicationDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedApplicationDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lock_level", "CanNotDelete"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test Managed Application Definition"),
+					resource.TestCheckResourceAttr(resourceName, "authorization.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "package_file_uri"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "Production"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedApplicationDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated Managed Application Definition"),
+					resource.TestCheckResourceAttr(resourceName, "package_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Cost", "Center"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMManagedApplicationDefinitionName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "ab",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abc",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello-world_01.def",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello world",
+			ErrCount: 1,
+		},
+		{
+			Value:    acctest.RandString(65),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateManagedApplicationDefinitionName(tc.Value, "azurerm_managed_application_definition")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Managed Application Definition Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMManagedApplicationDefinitionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		definitionName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Managed Application Definition: %s", definitionName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).managedApplicationDefinitionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, definitionName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on managedApplicationDefinitionsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Managed Application Definition %q (Resource Group %q) does not exist", definitionName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMManagedApplicationDefinitionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).managedApplicationDefinitionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_managed_application_definition" {
+			continue
+		}
+
+		definitionName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, definitionName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Managed Application Definition still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMManagedApplicationDefinition_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_managed_application_definition" "test" {
+  name                = "acctestappdef%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  lock_level          = "ReadOnly"
+  display_name        = "TestManagedApplicationDefinition"
+
+  create_ui_definition = <<CREATEUIDEFINITION
+    {
+      "$schema": "https://schema.management.azure.com/schemas/0.1.2-preview/CreateUIDefinition.MultiVm.json#",
+      "handler": "Microsoft.Compute.MultiVm",
+      "version": "0.1.2-preview",
+      "parameters": {
+        "basics": [],
+        "steps": [],
+        "outputs": {}
+      }
+    }
+  CREATEUIDEFINITION
+
+  main_template = <<MAINTEMPLATE
+    {
+      "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+      "contentVersion": "1.0.0.0",
+      "parameters": {},
+      "variables": {},
+      "resources": [],
+      "outputs": {}
+    }
+  MAINTEMPLATE
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMManagedApplicationDefinition_completeTemplate(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+data "azurerm_builtin_role_definition" "builtin" {
+  name = "Contributor"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMManagedApplicationDefinition_complete(rInt int, location string) string {
+	template := testAccAzureRMManagedApplicationDefinition_completeTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_application_definition" "test" {
+  name                = "acctestappdef%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  lock_level          = "CanNotDelete"
+  display_name        = "TestManagedApplicationDefinition"
+  description         = "Test Managed Application Definition"
+  package_file_uri    = "https://github.com/Azure/azure-managedapp-samples/raw/master/Managed%%20Application%%20Sample%%20Packages/201-managed-storage-account/managedstorage.zip"
+
+  authorization {
+    service_principal_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+    role_definition_id   = "${basename(data.azurerm_builtin_role_definition.builtin.id)}"
+  }
+
+  tags {
+    Environment = "Production"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMManagedApplicationDefinition_completeUpdated(rInt int, location string) string {
+	template := testAccAzureRMManagedApplicationDefinition_completeTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_application_definition" "test" {
+  name                = "acctestappdef%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  lock_level          = "CanNotDelete"
+  display_name        = "TestManagedApplicationDefinition"
+  description         = "Updated Managed Application Definition"
+  package_enabled     = false
+  package_file_uri    = "https://github.com/Azure/azure-managedapp-samples/raw/master/Managed%%20Application%%20Sample%%20Packages/201-managed-storage-account/managedstorage.zip"
+
+  authorization {
+    service_principal_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+    role_definition_id   = "${basename(data.azurerm_builtin_role_definition.builtin.id)}"
+  }
+
+  tags {
+    Environment = "Production"
+    Cost        = "Center"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,288 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMManagedApplication_basic(t *testing.T) {
+	resourceName := "azurerm_managed_application.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMManagedApplication_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kind", "ServiceCatalog"),
+					resource.TestCheckResourceAttr(resourceName, "managed_resource_group_name", fmt.Sprintf("acctestRG-%d-managed", ri)),
+					resource.TestCheckResourceAttrSet(resourceName, "application_definition_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedApplication_parameters(t *testing.T) {
+	resourceName := "azurerm_managed_application.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMManagedApplication_parameters(ri, location)
+	postConfig := testAccAzureRMManagedApplication_parametersUpdated(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.environment", "Staging"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.environment", "Staging"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMManagedApplicationName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "ab",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abc",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello-world_(01).app",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello/world",
+			ErrCount: 1,
+		},
+		{
+			Value:    acctest.RandString(65),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateManagedApplicationName(tc.Value, "azurerm_managed_application")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Managed Application Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMManagedApplicationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		applicationName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Managed Application: %s", applicationName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).managedApplicationsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, applicationName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on managedApplicationsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Managed Application %q (Resource Group %q) does not exist", applicationName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMManagedApplicationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).managedApplicationsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_managed_application" {
+			continue
+		}
+
+		applicationName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, applicationName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Managed Application still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMManagedApplication_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+data "azurerm_builtin_role_definition" "builtin" {
+  name = "Contributor"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_managed_application_definition" "test" {
+  name                = "acctestappdef%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  lock_level          = "ReadOnly"
+  display_name        = "TestManagedApplicationDefinition"
+
+  authorization {
+    service_principal_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+    role_definition_id   = "${basename(data.azurerm_builtin_role_definition.builtin.id)}"
+  }
+
+  create_ui_definition = <<CREATEUIDEFINITION
+    {
+      "$schema": "https://schema.management.azure.com/schemas/0.1.2-preview/CreateUIDefinition.MultiVm.json#",
+      "handler": "Microsoft.Compute.MultiVm",
+      "version": "0.1.2-preview",
+      "parameters": {
+        "basics": [],
+        "steps": [],
+        "outputs": {}
+      }
+    }
+  CREATEUIDEFINITION
+
+  main_template = <<MAINTEMPLATE
+    {
+      "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+      "contentVersion": "1.0.0.0",
+      "parameters": {
+        "environment": {
+          "type": "string",
+          "defaultValue": "Development"
+        }
+      },
+      "variables": {},
+      "resources": [],
+      "outputs": {
+        "environment": {
+          "type": "string",
+          "value": "[parameters('environment')]"
+        }
+      }
+    }
+  MAINTEMPLATE
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMManagedApplication_basic(rInt int, location string) string {
+	template := testAccAzureRMManagedApplication_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_application" "test" {
+  name                        = "acctestmanagedapp%d"
+  location                    = "${azurerm_resource_group.test.location}"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  kind                        = "ServiceCatalog"
+  managed_resource_group_name = "acctestRG-%d-managed"
+  application_definition_id   = "${azurerm_managed_application_definition.test.id}"
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMManagedApplication_parameters(rInt int, location string) string {
+	template := testAccAzureRMManagedApplication_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_application" "test" {
+  name                        = "acctestmanagedapp%d"
+  location                    = "${azurerm_resource_group.test.location}"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  kind                        = "ServiceCatalog"
+  managed_resource_group_name = "acctestRG-%d-managed"
+  application_definition_id   = "${azurerm_managed_application_definition.test.id}"
+
+  parameters {
+    environment = "Production"
+  }
+
+  tags {
+    Environment = "Production"
+  }
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMManagedApplication_parametersUpdated(rInt int, location string) string {
+	template := testAccAzureRMManagedApplication_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_application" "test" {
+  name                        = "acctestmanagedapp%d"
+  location                    = "${azurerm_resource_group.test.location}"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  kind                        = "ServiceCatalog"
+  managed_resource_group_name = "acctestRG-%d-managed"
+  application_definition_id   = "${azurerm_managed_application_definition.test.id}"
+
+  parameters {
+    environment = "Staging"
+  }
+
+  tags {
+    Environment = "Staging"
+    Cost        = "Center"
+  }
+}
+`, template, rInt, rInt)
+}
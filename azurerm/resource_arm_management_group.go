@@ -92,7 +92,8 @@ func resourceArmManagementGroupCreateUpdate(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("Error creating Management Group %q: %+v", groupId, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	expectedId := fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s", groupId)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error waiting for creation of Management Group %q: %+v", groupId, err)
 	}
@@ -235,7 +236,7 @@ func resourceArmManagementGroupDelete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error deleting Management Group %q: %+v", id.groupId, err)
 	}
 
-	err = resp.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &resp.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for the deletion of Management Group %q: %+v", id.groupId, err)
 	}
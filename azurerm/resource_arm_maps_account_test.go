@@ -0,0 +1,223 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAzureRMMapsAccountName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "example",
+			ErrCount: 0,
+		},
+		{
+			Value:    "example.maps_account-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-example",
+			ErrCount: 1,
+		},
+		{
+			Value:    "example!",
+			ErrCount: 1,
+		},
+		{
+			Value:    acctest.RandString(99),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateMapsAccountName(tc.Value, "azurerm_maps_account")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Maps Account Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestAccAzureRMMapsAccount_basic(t *testing.T) {
+	resourceName := "azurerm_maps_account.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMapsAccount_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMapsAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMapsAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku_name", "S0"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_access_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMapsAccount_tags(t *testing.T) {
+	resourceName := "azurerm_maps_account.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMapsAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMapsAccount_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMapsAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMMapsAccount_tags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMapsAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "testing"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMapsAccount_sku(t *testing.T) {
+	resourceName := "azurerm_maps_account.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMapsAccount_sku(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMapsAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMapsAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku_name", "S1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMapsAccountExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).mapsAccountsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Maps Account %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on mapsAccountsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMapsAccountDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).mapsAccountsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_maps_account" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Maps Account still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMapsAccount_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_maps_account" "test" {
+  name                = "accMapsAccount-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku_name            = "S0"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMapsAccount_sku(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_maps_account" "test" {
+  name                = "accMapsAccount-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku_name            = "S1"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMapsAccount_tags(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_maps_account" "test" {
+  name                = "accMapsAccount-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku_name            = "S0"
+
+  tags {
+    environment = "testing"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,254 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/mediaservices/mgmt/2015-10-01/media"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMediaServicesAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMediaServicesAccountCreate,
+		Read:   resourceArmMediaServicesAccountRead,
+		Update: resourceArmMediaServicesAccountUpdate,
+		Delete: resourceArmMediaServicesAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateMediaServicesAccountName,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"storage_account": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"is_primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMediaServicesAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mediaServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Media Services Account creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	storageAccounts, err := expandMediaServicesAccountStorageAccounts(d.Get("storage_account").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	parameters := media.Service{
+		Location: utils.String(location),
+		ServiceProperties: &media.ServiceProperties{
+			StorageAccounts: storageAccounts,
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	if _, err := client.Create(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating Media Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Media Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Media Services Account %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMediaServicesAccountRead(d, meta)
+}
+
+func resourceArmMediaServicesAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mediaServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["mediaservices"]
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	storageAccounts, err := expandMediaServicesAccountStorageAccounts(d.Get("storage_account").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	parameters := media.Service{
+		ServiceProperties: &media.ServiceProperties{
+			StorageAccounts: storageAccounts,
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error updating Media Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmMediaServicesAccountRead(d, meta)
+}
+
+func resourceArmMediaServicesAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mediaServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["mediaservices"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Media Services Account %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Media Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ServiceProperties; props != nil {
+		if err := d.Set("storage_account", flattenMediaServicesAccountStorageAccounts(props.StorageAccounts)); err != nil {
+			return fmt.Errorf("Error setting `storage_account`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmMediaServicesAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mediaServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["mediaservices"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Media Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandMediaServicesAccountStorageAccounts(input *schema.Set) (*[]media.StorageAccount, error) {
+	results := make([]media.StorageAccount, 0)
+
+	primaryCount := 0
+	for _, raw := range input.List() {
+		v := raw.(map[string]interface{})
+
+		id := v["id"].(string)
+		isPrimary := v["is_primary"].(bool)
+		if isPrimary {
+			primaryCount++
+		}
+
+		results = append(results, media.StorageAccount{
+			ID:        utils.String(id),
+			IsPrimary: utils.Bool(isPrimary),
+		})
+	}
+
+	if primaryCount != 1 {
+		return nil, fmt.Errorf("Exactly one `storage_account` must be marked as primary (`is_primary = true`) but %d were", primaryCount)
+	}
+
+	return &results, nil
+}
+
+func flattenMediaServicesAccountStorageAccounts(input *[]media.StorageAccount) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, storageAccount := range *input {
+		output := make(map[string]interface{})
+
+		if storageAccount.ID != nil {
+			output["id"] = *storageAccount.ID
+		}
+
+		if storageAccount.IsPrimary != nil {
+			output["is_primary"] = *storageAccount.IsPrimary
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func validateMediaServicesAccountName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-z0-9]{3,24}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 24 characters in length and can only contain lowercase letters and numbers", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,229 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAzureRMMediaServicesAccountName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "hello",
+			ErrCount: 0,
+		},
+		{
+			Value:    "media123",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ab",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Hello",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello-world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abcdefghijklmnopqrstuvwxy",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateMediaServicesAccountName(tc.Value, "azurerm_media_services_account")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Media Services Account Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestAccAzureRMMediaServicesAccount_basic(t *testing.T) {
+	resourceName := "azurerm_media_services_account.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(5)
+	config := testAccAzureRMMediaServicesAccount_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMediaServicesAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMediaServicesAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_account.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMediaServicesAccount_multipleStorageAccounts(t *testing.T) {
+	resourceName := "azurerm_media_services_account.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMediaServicesAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMediaServicesAccount_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMediaServicesAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_account.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMMediaServicesAccount_multipleStorageAccounts(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMediaServicesAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_account.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMediaServicesAccountExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).mediaServicesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Media Services Account %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on mediaServicesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMediaServicesAccountDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).mediaServicesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_media_services_account" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Media Services Account still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMediaServicesAccount_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "first" {
+  name                     = "acctestsa1%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "GRS"
+}
+
+resource "azurerm_media_services_account" "test" {
+  name                = "acctestmsa%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  storage_account {
+    id         = "${azurerm_storage_account.first.id}"
+    is_primary = true
+  }
+}
+`, rInt, location, rString, rString)
+}
+
+func testAccAzureRMMediaServicesAccount_multipleStorageAccounts(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "first" {
+  name                     = "acctestsa1%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "GRS"
+}
+
+resource "azurerm_storage_account" "second" {
+  name                     = "acctestsa2%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "GRS"
+}
+
+resource "azurerm_media_services_account" "test" {
+  name                = "acctestmsa%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  storage_account {
+    id         = "${azurerm_storage_account.first.id}"
+    is_primary = true
+  }
+
+  storage_account {
+    id         = "${azurerm_storage_account.second.id}"
+    is_primary = false
+  }
+
+  tags {
+    environment = "staging"
+  }
+}
+`, rInt, location, rString, rString, rString)
+}
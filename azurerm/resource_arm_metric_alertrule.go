@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -15,7 +18,7 @@ func resourceArmMetricAlertRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmMetricAlertRuleCreateOrUpdate,
 		Read:   resourceArmMetricAlertRuleRead,
-		Update: resourceArmMetricAlertRuleCreateOrUpdate,
+		Update: resourceArmMetricAlertRuleUpdate,
 		Delete: resourceArmMetricAlertRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -45,8 +48,9 @@ func resourceArmMetricAlertRule() *schema.Resource {
 			},
 
 			"resource_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ResourceID,
 			},
 
 			"metric_name": {
@@ -72,9 +76,10 @@ func resourceArmMetricAlertRule() *schema.Resource {
 			},
 
 			"period": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateIso8601Duration(),
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.ISO8601Duration,
+				ValidateFunc:     validate.ISO8601Duration,
 			},
 
 			"aggregation": {
@@ -145,6 +150,11 @@ func resourceArmMetricAlertRule() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validateMetricAlertRuleTags,
 			},
+
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -168,7 +178,7 @@ func resourceArmMetricAlertRuleCreateOrUpdate(d *schema.ResourceData, meta inter
 	alertRuleResource := insights.AlertRuleResource{
 		Name:      &name,
 		Location:  &location,
-		Tags:      expandTags(tags),
+		Tags:      expandTags(tags, meta),
 		AlertRule: alertRule,
 	}
 
@@ -190,6 +200,44 @@ func resourceArmMetricAlertRuleCreateOrUpdate(d *schema.ResourceData, meta inter
 	return resourceArmMetricAlertRuleRead(d, meta)
 }
 
+func resourceArmMetricAlertRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if !d.HasChange("enabled") || d.HasChange("description") || d.HasChange("resource_id") || d.HasChange("metric_name") ||
+		d.HasChange("operator") || d.HasChange("threshold") || d.HasChange("period") || d.HasChange("aggregation") ||
+		d.HasChange("email_action") || d.HasChange("webhook_action") || d.HasChange("tags") || d.HasChange("tags_all") {
+		return resourceArmMetricAlertRuleCreateOrUpdate(d, meta)
+	}
+
+	client := meta.(*ArmClient).monitorAlertRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup, name, err := resourceGroupAndAlertRuleNameFromId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	alertRule, err := expandAzureRmMetricThresholdAlertRule(d)
+	if err != nil {
+		return err
+	}
+
+	// only `enabled` has changed - so rather than re-sending the whole Alert Rule, the Actions are omitted
+	// from the patch (the Name and Condition are required by the API)
+	patch := insights.AlertRuleResourcePatch{
+		AlertRule: &insights.AlertRule{
+			Name:      alertRule.Name,
+			Condition: alertRule.Condition,
+			IsEnabled: alertRule.IsEnabled,
+		},
+	}
+
+	log.Printf("[DEBUG] Toggling the state of Metric Alert Rule %q (resource group %q)", name, resourceGroup)
+	if _, err := client.Update(ctx, resourceGroup, name, patch); err != nil {
+		return fmt.Errorf("Error updating Metric Alert Rule %q (resource group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmMetricAlertRuleRead(d, meta)
+}
+
 func resourceArmMetricAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorAlertRulesClient
 	ctx := meta.(*ArmClient).StopContext
@@ -220,6 +268,10 @@ func resourceArmMetricAlertRuleRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("description", alertRule.Description)
 		d.Set("enabled", alertRule.IsEnabled)
 
+		if lastUpdated := alertRule.LastUpdatedTime; lastUpdated != nil {
+			d.Set("last_updated_time", lastUpdated.Format(time.RFC3339))
+		}
+
 		ruleCondition := alertRule.Condition
 
 		if ruleCondition != nil {
@@ -240,44 +292,7 @@ func resourceArmMetricAlertRuleRead(d *schema.ResourceData, meta interface{}) er
 			}
 		}
 
-		email_actions := make([]interface{}, 0)
-		webhook_actions := make([]interface{}, 0)
-
-		for _, ruleAction := range *alertRule.Actions {
-			if emailAction, ok := ruleAction.AsRuleEmailAction(); ok && emailAction != nil {
-				email_action := make(map[string]interface{}, 1)
-
-				if sendToOwners := emailAction.SendToServiceOwners; sendToOwners != nil {
-					email_action["send_to_service_owners"] = *sendToOwners
-				}
-
-				custom_emails := []string{}
-				for _, custom_email := range *emailAction.CustomEmails {
-					custom_emails = append(custom_emails, custom_email)
-				}
-
-				email_action["custom_emails"] = custom_emails
-
-				email_actions = append(email_actions, email_action)
-			} else if webhookAction, ok := ruleAction.AsRuleWebhookAction(); ok && webhookAction != nil {
-				webhook_action := make(map[string]interface{}, 1)
-
-				webhook_action["service_uri"] = *webhookAction.ServiceURI
-
-				properties := make(map[string]string, 0)
-				if props := webhookAction.Properties; props != nil {
-					for k, v := range props {
-						if k != "$type" {
-							properties[k] = *v
-						}
-					}
-				}
-				webhook_action["properties"] = properties
-
-				webhook_actions = append(webhook_actions, webhook_action)
-			}
-		}
-
+		email_actions, webhook_actions := flattenAzureRmMetricAlertRuleActions(alertRule.Actions)
 		d.Set("email_action", email_actions)
 		d.Set("webhook_action", webhook_actions)
 	}
@@ -285,7 +300,7 @@ func resourceArmMetricAlertRuleRead(d *schema.ResourceData, meta interface{}) er
 	// Return a new tag map filtered by the specified tag names.
 	tagMap := filterTags(resp.Tags, "$type")
 
-	flattenAndSetTags(d, tagMap)
+	flattenAndSetTags(d, tagMap, meta)
 
 	return nil
 }
@@ -410,6 +425,52 @@ func expandAzureRmMetricThresholdAlertRule(d *schema.ResourceData) (*insights.Al
 	return &alertRule, nil
 }
 
+func flattenAzureRmMetricAlertRuleActions(input *[]insights.BasicRuleAction) ([]interface{}, []interface{}) {
+	email_actions := make([]interface{}, 0)
+	webhook_actions := make([]interface{}, 0)
+
+	if input == nil {
+		return email_actions, webhook_actions
+	}
+
+	for _, ruleAction := range *input {
+		if emailAction, ok := ruleAction.AsRuleEmailAction(); ok && emailAction != nil {
+			email_action := make(map[string]interface{}, 1)
+
+			if sendToOwners := emailAction.SendToServiceOwners; sendToOwners != nil {
+				email_action["send_to_service_owners"] = *sendToOwners
+			}
+
+			custom_emails := []string{}
+			for _, custom_email := range *emailAction.CustomEmails {
+				custom_emails = append(custom_emails, custom_email)
+			}
+
+			email_action["custom_emails"] = custom_emails
+
+			email_actions = append(email_actions, email_action)
+		} else if webhookAction, ok := ruleAction.AsRuleWebhookAction(); ok && webhookAction != nil {
+			webhook_action := make(map[string]interface{}, 1)
+
+			webhook_action["service_uri"] = *webhookAction.ServiceURI
+
+			properties := make(map[string]string, 0)
+			if props := webhookAction.Properties; props != nil {
+				for k, v := range props {
+					if k != "$type" {
+						properties[k] = *v
+					}
+				}
+			}
+			webhook_action["properties"] = properties
+
+			webhook_actions = append(webhook_actions, webhook_action)
+		}
+	}
+
+	return email_actions, webhook_actions
+}
+
 func resourceGroupAndAlertRuleNameFromId(alertRuleId string) (string, string, error) {
 	id, err := parseAzureResourceID(alertRuleId)
 	if err != nil {
@@ -82,6 +82,7 @@ func TestAccAzureRMMetricAlertRule_virtualMachineCpu(t *testing.T) {
 					testCheckAzureRMMetricAlertRuleExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
 					resource.TestCheckNoResourceAttr(resourceName, "tags.$type"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_time"),
 				),
 			},
 			{
@@ -90,6 +91,9 @@ func TestAccAzureRMMetricAlertRule_virtualMachineCpu(t *testing.T) {
 					testCheckAzureRMMetricAlertRuleExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
 					resource.TestCheckNoResourceAttr(resourceName, "tags.$type"),
+					resource.TestCheckResourceAttr(resourceName, "email_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "webhook_action.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_time"),
 				),
 			},
 		},
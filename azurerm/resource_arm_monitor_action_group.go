@@ -124,7 +124,7 @@ func resourceArmMonitorActionGroupCreateOrUpdate(d *schema.ResourceData, meta in
 	webhookReceiversRaw := d.Get("webhook_receiver").([]interface{})
 
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTags(tags, meta)
 
 	parameters := insights.ActionGroupResource{
 		Location: utils.String(azureRMNormalizeLocation("Global")),
@@ -196,7 +196,7 @@ func resourceArmMonitorActionGroupRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -0,0 +1,138 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorActionRuleActionGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorActionRuleActionGroupCreateUpdate,
+		Read:   resourceArmMonitorActionRuleActionGroupRead,
+		Update: resourceArmMonitorActionRuleActionGroupCreateUpdate,
+		Delete: resourceArmMonitorActionRuleActionGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"action_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"scope": monitorActionRuleScopeSchema(),
+
+			"condition": monitorActionRuleConditionSchema(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMonitorActionRuleActionGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := monitorActionRuleResourceID(subscriptionId, resourceGroup, name)
+
+	rule := monitorActionRule{
+		Location: utils.String("Global"),
+		Properties: &monitorActionRuleProperties{
+			Type:          monitorActionRuleTypeActionGroup,
+			ActionGroupID: utils.String(d.Get("action_group_id").(string)),
+			Scope:         expandMonitorActionRuleScope(d.Get("scope").([]interface{})),
+			Conditions:    expandMonitorActionRuleConditions(d.Get("condition").([]interface{})),
+			Description:   utils.String(d.Get("description").(string)),
+			Status:        monitorActionRuleStatus(d.Get("enabled").(bool)),
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{}), meta),
+	}
+
+	if err := createOrUpdateMonitorActionRule(d, meta, id, rule); err != nil {
+		return fmt.Errorf("Error creating/updating Monitor Action Rule %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmMonitorActionRuleActionGroupRead(d, meta)
+}
+
+func resourceArmMonitorActionRuleActionGroupRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["actionRules"]
+
+	rule, err := retrieveMonitorActionRule(meta, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving Monitor Action Rule %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if rule == nil {
+		log.Printf("[DEBUG] Monitor Action Rule %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := rule.Properties; props != nil {
+		if props.Type != monitorActionRuleTypeActionGroup {
+			return fmt.Errorf("Monitor Action Rule %q (Resource Group %q) is of type %q rather than %q", name, resourceGroup, props.Type, monitorActionRuleTypeActionGroup)
+		}
+
+		d.Set("action_group_id", props.ActionGroupID)
+		d.Set("description", props.Description)
+		d.Set("enabled", props.Status == "Enabled")
+
+		if err := d.Set("scope", flattenMonitorActionRuleScope(props.Scope)); err != nil {
+			return fmt.Errorf("Error setting `scope`: %+v", err)
+		}
+
+		if err := d.Set("condition", flattenMonitorActionRuleConditions(props.Conditions)); err != nil {
+			return fmt.Errorf("Error setting `condition`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, rule.Tags, meta)
+
+	return nil
+}
+
+func resourceArmMonitorActionRuleActionGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := deleteMonitorActionRule(meta, d.Id()); err != nil {
+		return fmt.Errorf("Error deleting Monitor Action Rule %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
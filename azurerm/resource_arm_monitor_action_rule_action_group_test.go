@@ -0,0 +1,140 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMMonitorActionRuleActionGroup_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_action_rule_action_group.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorActionRuleActionGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "action_group_id", "azurerm_monitor_action_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActionRuleActionGroup_complete(t *testing.T) {
+	resourceName := "azurerm_monitor_action_rule_action_group.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorActionRuleActionGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionRuleExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorActionRuleActionGroup_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "route critical alerts"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.type", "ResourceGroup"),
+					resource.TestCheckResourceAttr(resourceName, "condition.0.severity.0.operator", "Equals"),
+					resource.TestCheckResourceAttr(resourceName, "condition.0.monitor.0.operator", "NotEquals"),
+					resource.TestCheckResourceAttr(resourceName, "condition.0.description.0.operator", "Contains"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMMonitorActionRuleActionGroup_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorActionRuleActionGroup_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_rule_action_group" "test" {
+  name                = "acctest-ar-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  action_group_id     = "${azurerm_monitor_action_group.test.id}"
+}
+`, testAccAzureRMMonitorActionRuleActionGroup_template(rInt, location), rInt)
+}
+
+func testAccAzureRMMonitorActionRuleActionGroup_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_rule_action_group" "test" {
+  name                = "acctest-ar-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  action_group_id     = "${azurerm_monitor_action_group.test.id}"
+  enabled             = false
+  description         = "route critical alerts"
+
+  scope {
+    type         = "ResourceGroup"
+    resource_ids = ["${azurerm_resource_group.test.id}"]
+  }
+
+  condition {
+    severity {
+      operator = "Equals"
+      values   = ["Sev0"]
+    }
+
+    monitor {
+      operator = "NotEquals"
+      values   = ["Resolved"]
+    }
+
+    description {
+      operator = "Contains"
+      values   = ["critical"]
+    }
+  }
+
+  tags {
+    environment = "test"
+  }
+}
+`, testAccAzureRMMonitorActionRuleActionGroup_template(rInt, location), rInt)
+}
@@ -0,0 +1,205 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorActionRuleSuppression() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorActionRuleSuppressionCreateUpdate,
+		Read:   resourceArmMonitorActionRuleSuppressionRead,
+		Update: resourceArmMonitorActionRuleSuppressionCreateUpdate,
+		Delete: resourceArmMonitorActionRuleSuppressionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"suppression": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recurrence_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Always",
+								"Once",
+								"Daily",
+								"Weekly",
+								"Monthly",
+							}, false),
+						},
+
+						"schedule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_date_utc": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.RFC3339Time,
+									},
+
+									"end_date_utc": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.RFC3339Time,
+									},
+
+									"recurrence_weekly": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(monitorActionRuleWeekDays, false),
+										},
+										Set: schema.HashString,
+									},
+
+									"recurrence_monthly": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeInt,
+											ValidateFunc: validation.IntBetween(1, 31),
+										},
+										Set: set.HashInt,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"scope": monitorActionRuleScopeSchema(),
+
+			"condition": monitorActionRuleConditionSchema(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMonitorActionRuleSuppressionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := monitorActionRuleResourceID(subscriptionId, resourceGroup, name)
+
+	suppressionConfig, err := expandMonitorActionRuleSuppressionConfig(d.Get("suppression").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `suppression`: %+v", err)
+	}
+
+	rule := monitorActionRule{
+		Location: utils.String("Global"),
+		Properties: &monitorActionRuleProperties{
+			Type:              monitorActionRuleTypeSuppression,
+			Scope:             expandMonitorActionRuleScope(d.Get("scope").([]interface{})),
+			Conditions:        expandMonitorActionRuleConditions(d.Get("condition").([]interface{})),
+			Description:       utils.String(d.Get("description").(string)),
+			Status:            monitorActionRuleStatus(d.Get("enabled").(bool)),
+			SuppressionConfig: suppressionConfig,
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{}), meta),
+	}
+
+	if err := createOrUpdateMonitorActionRule(d, meta, id, rule); err != nil {
+		return fmt.Errorf("Error creating/updating Monitor Action Rule %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmMonitorActionRuleSuppressionRead(d, meta)
+}
+
+func resourceArmMonitorActionRuleSuppressionRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["actionRules"]
+
+	rule, err := retrieveMonitorActionRule(meta, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving Monitor Action Rule %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if rule == nil {
+		log.Printf("[DEBUG] Monitor Action Rule %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := rule.Properties; props != nil {
+		if props.Type != monitorActionRuleTypeSuppression {
+			return fmt.Errorf("Monitor Action Rule %q (Resource Group %q) is of type %q rather than %q", name, resourceGroup, props.Type, monitorActionRuleTypeSuppression)
+		}
+
+		d.Set("description", props.Description)
+		d.Set("enabled", props.Status == "Enabled")
+
+		if err := d.Set("scope", flattenMonitorActionRuleScope(props.Scope)); err != nil {
+			return fmt.Errorf("Error setting `scope`: %+v", err)
+		}
+
+		if err := d.Set("condition", flattenMonitorActionRuleConditions(props.Conditions)); err != nil {
+			return fmt.Errorf("Error setting `condition`: %+v", err)
+		}
+
+		if err := d.Set("suppression", flattenMonitorActionRuleSuppressionConfig(props.SuppressionConfig)); err != nil {
+			return fmt.Errorf("Error setting `suppression`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, rule.Tags, meta)
+
+	return nil
+}
+
+func resourceArmMonitorActionRuleSuppressionDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := deleteMonitorActionRule(meta, d.Id()); err != nil {
+		return fmt.Errorf("Error deleting Monitor Action Rule %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
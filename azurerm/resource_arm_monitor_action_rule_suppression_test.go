@@ -0,0 +1,224 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMMonitorActionRuleSuppression_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_action_rule_suppression.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorActionRuleSuppression_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "suppression.0.recurrence_type", "Always"),
+					resource.TestCheckResourceAttr(resourceName, "suppression.0.schedule.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActionRuleSuppression_complete(t *testing.T) {
+	resourceName := "azurerm_monitor_action_rule_suppression.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	startDate := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	endDate := time.Now().UTC().Add(48 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorActionRuleSuppression_complete(ri, location, startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "maintenance window"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.type", "ResourceGroup"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.resource_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "condition.0.severity.0.operator", "Equals"),
+					resource.TestCheckResourceAttr(resourceName, "condition.0.severity.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "condition.0.monitor_service.0.values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "suppression.0.recurrence_type", "Weekly"),
+					resource.TestCheckResourceAttr(resourceName, "suppression.0.schedule.0.start_date_utc", startDate),
+					resource.TestCheckResourceAttr(resourceName, "suppression.0.schedule.0.end_date_utc", endDate),
+					resource.TestCheckResourceAttr(resourceName, "suppression.0.schedule.0.recurrence_weekly.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActionRuleSuppression_update(t *testing.T) {
+	resourceName := "azurerm_monitor_action_rule_suppression.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	startDate := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	endDate := time.Now().UTC().Add(48 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorActionRuleSuppression_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionRuleExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorActionRuleSuppression_complete(ri, location, startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "suppression.0.recurrence_type", "Weekly"),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorActionRuleSuppression_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "suppression.0.recurrence_type", "Always"),
+					resource.TestCheckResourceAttr(resourceName, "condition.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorActionRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		rule, err := retrieveMonitorActionRule(testAccProvider.Meta(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on actionRulesClient: %+v", err)
+		}
+
+		if rule == nil {
+			return fmt.Errorf("Bad: Monitor Action Rule %q does not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMonitorActionRuleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_action_rule_suppression" && rs.Type != "azurerm_monitor_action_rule_action_group" {
+			continue
+		}
+
+		rule, err := retrieveMonitorActionRule(testAccProvider.Meta(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if rule != nil {
+			return fmt.Errorf("Monitor Action Rule %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMMonitorActionRuleSuppression_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_rule_suppression" "test" {
+  name                = "acctest-ar-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  suppression {
+    recurrence_type = "Always"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorActionRuleSuppression_complete(rInt int, location, startDate, endDate string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_rule_suppression" "test" {
+  name                = "acctest-ar-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  enabled             = false
+  description         = "maintenance window"
+
+  scope {
+    type         = "ResourceGroup"
+    resource_ids = ["${azurerm_resource_group.test.id}"]
+  }
+
+  condition {
+    severity {
+      operator = "Equals"
+      values   = ["Sev0", "Sev1"]
+    }
+
+    monitor_service {
+      operator = "Equals"
+      values   = ["Platform"]
+    }
+
+    target_resource_type {
+      operator = "Equals"
+      values   = ["Microsoft.Compute/VirtualMachines"]
+    }
+  }
+
+  suppression {
+    recurrence_type = "Weekly"
+
+    schedule {
+      start_date_utc    = "%s"
+      end_date_utc      = "%s"
+      recurrence_weekly = ["Sunday", "Monday"]
+    }
+  }
+
+  tags {
+    environment = "test"
+  }
+}
+`, rInt, location, rInt, startDate, endDate)
+}
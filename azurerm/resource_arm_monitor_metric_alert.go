@@ -0,0 +1,503 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorMetricAlert() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorMetricAlertCreateUpdate,
+		Read:   resourceArmMonitorMetricAlertRead,
+		Update: resourceArmMonitorMetricAlertCreateUpdate,
+		Delete: resourceArmMonitorMetricAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"scopes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateMonitorMetricAlertScope,
+				},
+				Set: schema.HashString,
+			},
+
+			// these are required when the Metric Alert is scoped to multiple resources, a Resource Group or a Subscription
+			"target_resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"target_resource_location": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				StateFunc:        azureRMNormalizeLocation,
+				DiffSuppressFunc: azureRMSuppressLocationDiff,
+			},
+
+			"criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_namespace": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"metric_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"aggregation": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Average",
+								"Count",
+								"Minimum",
+								"Maximum",
+								"Total",
+							}, false),
+						},
+
+						"operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Equals",
+								"NotEquals",
+								"GreaterThan",
+								"GreaterThanOrEqual",
+								"LessThan",
+								"LessThanOrEqual",
+							}, false),
+						},
+
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+
+						"dimension": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Include",
+										}, false),
+									},
+
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"action": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_group_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"webhook_properties": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"auto_mitigate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "PT1M",
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT1M",
+					"PT5M",
+					"PT15M",
+					"PT30M",
+					"PT1H",
+				}, false),
+			},
+
+			"severity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(0, 4),
+			},
+
+			"window_size": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "PT5M",
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT1M",
+					"PT5M",
+					"PT15M",
+					"PT30M",
+					"PT1H",
+					"PT6H",
+					"PT12H",
+					"P1D",
+				}, false),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMonitorMetricAlertCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorMetricAlertsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Metric Alert creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	scopes := make([]string, 0)
+	for _, v := range d.Get("scopes").(*schema.Set).List() {
+		scopes = append(scopes, v.(string))
+	}
+
+	properties := monitorMetricAlertProperties{
+		Enabled:             utils.Bool(d.Get("enabled").(bool)),
+		AutoMitigate:        utils.Bool(d.Get("auto_mitigate").(bool)),
+		Description:         utils.String(d.Get("description").(string)),
+		Severity:            utils.Int32(int32(d.Get("severity").(int))),
+		EvaluationFrequency: utils.String(d.Get("frequency").(string)),
+		WindowSize:          utils.String(d.Get("window_size").(string)),
+		Scopes:              scopes,
+		Actions:             expandMonitorMetricAlertAction(d.Get("action").(*schema.Set).List()),
+	}
+
+	singleResource := monitorMetricAlertIsSingleResource(scopes)
+	if !singleResource {
+		targetResourceType := d.Get("target_resource_type").(string)
+		targetResourceLocation := d.Get("target_resource_location").(string)
+		if targetResourceType == "" || targetResourceLocation == "" {
+			return fmt.Errorf("`target_resource_type` and `target_resource_location` must be specified when a Metric Alert is scoped to multiple resources, a Resource Group or a Subscription")
+		}
+
+		properties.TargetResourceType = utils.String(targetResourceType)
+		properties.TargetResourceRegion = utils.String(azureRMNormalizeLocation(targetResourceLocation))
+	}
+	properties.Criteria = expandMonitorMetricAlertCriteria(d.Get("criteria").([]interface{}), singleResource)
+
+	parameters := monitorMetricAlert{
+		Location:   utils.String(azureRMNormalizeLocation("Global")),
+		Properties: &properties,
+		Tags:       expandTags(tags, meta),
+	}
+
+	id := monitorMetricAlertResourceID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+	future, err := client.CreateOrUpdate(ctx, id, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Metric Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Metric Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read monitorMetricAlert
+	if _, err := client.Get(ctx, id, &read); err != nil {
+		return fmt.Errorf("Error retrieving Metric Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Metric Alert %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorMetricAlertRead(d, meta)
+}
+
+func resourceArmMonitorMetricAlertRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorMetricAlertsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["metricAlerts"]
+
+	var alert monitorMetricAlert
+	resp, err := client.Get(ctx, d.Id(), &alert)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Metric Alert %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Metric Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := alert.Properties; props != nil {
+		d.Set("auto_mitigate", props.AutoMitigate)
+		d.Set("description", props.Description)
+		d.Set("enabled", props.Enabled)
+		d.Set("frequency", props.EvaluationFrequency)
+		d.Set("severity", props.Severity)
+		d.Set("window_size", props.WindowSize)
+		d.Set("target_resource_type", props.TargetResourceType)
+		if region := props.TargetResourceRegion; region != nil {
+			d.Set("target_resource_location", azureRMNormalizeLocation(*region))
+		}
+
+		scopes := make([]interface{}, 0)
+		for _, scope := range props.Scopes {
+			scopes = append(scopes, scope)
+		}
+		if err := d.Set("scopes", schema.NewSet(schema.HashString, scopes)); err != nil {
+			return fmt.Errorf("Error setting `scopes`: %+v", err)
+		}
+
+		if err := d.Set("criteria", flattenMonitorMetricAlertCriteria(props.Criteria)); err != nil {
+			return fmt.Errorf("Error setting `criteria`: %+v", err)
+		}
+
+		if err := d.Set("action", flattenMonitorMetricAlertAction(props.Actions)); err != nil {
+			return fmt.Errorf("Error setting `action`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, alert.Tags, meta)
+
+	return nil
+}
+
+func resourceArmMonitorMetricAlertDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorMetricAlertsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Metric Alert %q: %+v", d.Id(), err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Metric Alert %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func validateMonitorMetricAlertScope(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// a Subscription ID doesn't contain a Resource Group, so can't be parsed as a Resource ID
+	segments := strings.Split(strings.TrimPrefix(v, "/"), "/")
+	if len(segments) == 2 && strings.EqualFold(segments[0], "subscriptions") {
+		return validate.UUID(segments[1], k)
+	}
+
+	return azure.ValidateResourceID(i, k)
+}
+
+func expandMonitorMetricAlertCriteria(input []interface{}, singleResource bool) *monitorMetricAlertCriteria {
+	criteria := make([]monitorMetricAlertCriterion, 0)
+	for i, item := range input {
+		v := item.(map[string]interface{})
+
+		dimensions := make([]monitorMetricAlertDimension, 0)
+		for _, dimension := range v["dimension"].([]interface{}) {
+			dv := dimension.(map[string]interface{})
+
+			values := make([]string, 0)
+			for _, value := range dv["values"].([]interface{}) {
+				values = append(values, value.(string))
+			}
+
+			dimensions = append(dimensions, monitorMetricAlertDimension{
+				Name:     dv["name"].(string),
+				Operator: dv["operator"].(string),
+				Values:   values,
+			})
+		}
+
+		criteria = append(criteria, monitorMetricAlertCriterion{
+			CriterionType: monitorMetricAlertStaticThresholdCriterionType,
+			// the name is required by the API but isn't surfaced anywhere, so we generate one
+			Name:            fmt.Sprintf("Metric%d", i+1),
+			MetricNamespace: v["metric_namespace"].(string),
+			MetricName:      v["metric_name"].(string),
+			TimeAggregation: v["aggregation"].(string),
+			Operator:        v["operator"].(string),
+			Threshold:       v["threshold"].(float64),
+			Dimensions:      dimensions,
+		})
+	}
+
+	odataType := monitorMetricAlertMultipleResourceCriteriaType
+	if singleResource {
+		odataType = monitorMetricAlertSingleResourceCriteriaType
+	}
+
+	return &monitorMetricAlertCriteria{
+		OdataType: odataType,
+		AllOf:     criteria,
+	}
+}
+
+func expandMonitorMetricAlertAction(input []interface{}) []monitorMetricAlertAction {
+	actions := make([]monitorMetricAlertAction, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		action := monitorMetricAlertAction{
+			ActionGroupID:     v["action_group_id"].(string),
+			WebhookProperties: make(map[string]string),
+		}
+
+		for key, value := range v["webhook_properties"].(map[string]interface{}) {
+			action.WebhookProperties[key] = value.(string)
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions
+}
+
+func flattenMonitorMetricAlertCriteria(input *monitorMetricAlertCriteria) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, metric := range input.AllOf {
+		dimensions := make([]interface{}, 0)
+		for _, dimension := range metric.Dimensions {
+			values := make([]interface{}, 0)
+			for _, value := range dimension.Values {
+				values = append(values, value)
+			}
+
+			dimensions = append(dimensions, map[string]interface{}{
+				"name":     dimension.Name,
+				"operator": dimension.Operator,
+				"values":   values,
+			})
+		}
+
+		results = append(results, map[string]interface{}{
+			"metric_namespace": metric.MetricNamespace,
+			"metric_name":      metric.MetricName,
+			"aggregation":      metric.TimeAggregation,
+			"operator":         metric.Operator,
+			"threshold":        metric.Threshold,
+			"dimension":        dimensions,
+		})
+	}
+
+	return results
+}
+
+func flattenMonitorMetricAlertAction(input []monitorMetricAlertAction) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, action := range input {
+		webhookProperties := make(map[string]interface{})
+		for key, value := range action.WebhookProperties {
+			webhookProperties[key] = value
+		}
+
+		results = append(results, map[string]interface{}{
+			"action_group_id":    action.ActionGroupID,
+			"webhook_properties": webhookProperties,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,342 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMMonitorMetricAlert_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_metric_alert.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccAzureRMMonitorMetricAlert_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorMetricAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "3"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorMetricAlert_dimension(t *testing.T) {
+	resourceName := "azurerm_monitor_metric_alert.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorMetricAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorMetricAlert_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorMetricAlert_dimension(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.0.name", "ApiName"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorMetricAlert_resourceGroupScope(t *testing.T) {
+	resourceName := "azurerm_monitor_metric_alert.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorMetricAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorMetricAlert_resourceGroupScope(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_resource_type", "Microsoft.Compute/virtualMachines"),
+					resource.TestCheckResourceAttr(resourceName, "target_resource_location", azureRMNormalizeLocation(location)),
+				),
+			},
+		},
+	})
+}
+
+func TestMonitorMetricAlertIsSingleResource(t *testing.T) {
+	cases := []struct {
+		Scopes   []string
+		Expected bool
+	}{
+		{
+			Scopes:   []string{"/subscriptions/00000000-0000-0000-0000-000000000000"},
+			Expected: false,
+		},
+		{
+			Scopes:   []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"},
+			Expected: false,
+		},
+		{
+			Scopes:   []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1"},
+			Expected: true,
+		},
+		{
+			Scopes: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm2",
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range cases {
+		if actual := monitorMetricAlertIsSingleResource(v.Scopes); actual != v.Expected {
+			t.Fatalf("Expected %t for %+v but got %t", v.Expected, v.Scopes, actual)
+		}
+
+		criteria := expandMonitorMetricAlertCriteria([]interface{}{}, v.Expected)
+		expectedType := monitorMetricAlertMultipleResourceCriteriaType
+		if v.Expected {
+			expectedType = monitorMetricAlertSingleResourceCriteriaType
+		}
+		if criteria.OdataType != expectedType {
+			t.Fatalf("Expected the criteria type for %+v to be %q but got %q", v.Scopes, expectedType, criteria.OdataType)
+		}
+	}
+}
+
+func TestValidateMonitorMetricAlertScope(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000",
+			ErrCount: 0,
+		},
+		{
+			Value:    "/subscriptions/not-a-uuid",
+			ErrCount: 1,
+		},
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "group1",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateMonitorMetricAlertScope(tc.Value, "scopes")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMMonitorMetricAlertDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorMetricAlertsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_metric_alert" {
+			continue
+		}
+
+		var alert monitorMetricAlert
+		resp, err := client.Get(ctx, rs.Primary.ID, &alert)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Metric Alert still exists:\n%#v", alert)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMMonitorMetricAlertExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).monitorMetricAlertsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var alert monitorMetricAlert
+		resp, err := client.Get(ctx, rs.Primary.ID, &alert)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Metric Alert %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on monitorMetricAlertsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMMonitorMetricAlert_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+}
+`, rInt, location, rString, rInt)
+}
+
+func testAccAzureRMMonitorMetricAlert_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMMonitorMetricAlert_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_metric_alert" "test" {
+  name                = "acctestMetricAlert-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  scopes              = ["${azurerm_storage_account.test.id}"]
+
+  criteria {
+    metric_namespace = "Microsoft.Storage/storageAccounts"
+    metric_name      = "UsedCapacity"
+    aggregation      = "Average"
+    operator         = "GreaterThan"
+    threshold        = 55.5
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMonitorMetricAlert_dimension(rInt int, rString string, location string) string {
+	template := testAccAzureRMMonitorMetricAlert_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_metric_alert" "test" {
+  name                = "acctestMetricAlert-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  scopes              = ["${azurerm_storage_account.test.id}"]
+  description         = "Alert when transactions for specific APIs are too high"
+  frequency           = "PT5M"
+  window_size         = "PT15M"
+  severity            = 2
+
+  criteria {
+    metric_namespace = "Microsoft.Storage/storageAccounts"
+    metric_name      = "Transactions"
+    aggregation      = "Total"
+    operator         = "GreaterThan"
+    threshold        = 50
+
+    dimension {
+      name     = "ApiName"
+      operator = "Include"
+      values   = ["GetBlob", "PutBlob"]
+    }
+
+    dimension {
+      name     = "GeoType"
+      operator = "Include"
+      values   = ["*"]
+    }
+  }
+
+  criteria {
+    metric_namespace = "Microsoft.Storage/storageAccounts"
+    metric_name      = "UsedCapacity"
+    aggregation      = "Average"
+    operator         = "GreaterThan"
+    threshold        = 55.5
+  }
+
+  action {
+    action_group_id = "${azurerm_monitor_action_group.test.id}"
+
+    webhook_properties {
+      environment = "test"
+    }
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMonitorMetricAlert_resourceGroupScope(rInt int, rString string, location string) string {
+	template := testAccAzureRMMonitorMetricAlert_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_metric_alert" "test" {
+  name                     = "acctestMetricAlert-%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  scopes                   = ["${azurerm_resource_group.test.id}"]
+  target_resource_type     = "Microsoft.Compute/virtualMachines"
+  target_resource_location = "${azurerm_resource_group.test.location}"
+
+  criteria {
+    metric_namespace = "Microsoft.Compute/virtualMachines"
+    metric_name      = "Percentage CPU"
+    aggregation      = "Average"
+    operator         = "GreaterThan"
+    threshold        = 90
+  }
+}
+`, template, rInt)
+}
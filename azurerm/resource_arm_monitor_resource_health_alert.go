@@ -0,0 +1,353 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Resource Health Alerts are Activity Log Alerts scoped to the `ResourceHealth` category, the fields
+// below are the names of the Activity Log fields which each argument is compared against
+const (
+	monitorResourceHealthAlertCategory = "ResourceHealth"
+
+	monitorResourceHealthAlertFieldCategory             = "category"
+	monitorResourceHealthAlertFieldResourceType         = "resourceType"
+	monitorResourceHealthAlertFieldCurrentHealthStatus  = "properties.currentHealthStatus"
+	monitorResourceHealthAlertFieldPreviousHealthStatus = "properties.previousHealthStatus"
+	monitorResourceHealthAlertFieldCause                = "properties.cause"
+)
+
+func resourceArmMonitorResourceHealthAlert() *schema.Resource {
+	healthStatuses := []string{
+		"Available",
+		"Degraded",
+		"Unavailable",
+		"Unknown",
+	}
+
+	return &schema.Resource{
+		Create: resourceArmMonitorResourceHealthAlertCreateUpdate,
+		Read:   resourceArmMonitorResourceHealthAlertRead,
+		Update: resourceArmMonitorResourceHealthAlertCreateUpdate,
+		Delete: resourceArmMonitorResourceHealthAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"scopes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			// the conditions of an Activity Log Alert must all be met, as such each of
+			// these can only be compared against a single value
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"current_health_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(healthStatuses, false),
+			},
+
+			"previous_health_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(healthStatuses, false),
+			},
+
+			"cause": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"PlatformInitiated",
+					"UserInitiated",
+				}, false),
+			},
+
+			"action": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_group_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"webhook_properties": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMonitorResourceHealthAlertCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorActivityLogAlertsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Resource Health Alert creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	scopes := make([]string, 0)
+	for _, v := range d.Get("scopes").(*schema.Set).List() {
+		scopes = append(scopes, v.(string))
+	}
+
+	parameters := insights.ActivityLogAlertResource{
+		Location: utils.String(azureRMNormalizeLocation("Global")),
+		ActivityLogAlert: &insights.ActivityLogAlert{
+			Enabled:     utils.Bool(d.Get("enabled").(bool)),
+			Description: utils.String(d.Get("description").(string)),
+			Scopes:      &scopes,
+			Condition:   expandMonitorResourceHealthAlertCondition(d),
+			Actions:     expandMonitorResourceHealthAlertAction(d.Get("action").(*schema.Set).List()),
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Resource Health Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Resource Health Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Resource Health Alert %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorResourceHealthAlertRead(d, meta)
+}
+
+func resourceArmMonitorResourceHealthAlertRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorActivityLogAlertsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["activityLogAlerts"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Resource Health Alert %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Resource Health Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.ActivityLogAlert; props != nil {
+		d.Set("description", props.Description)
+		d.Set("enabled", props.Enabled)
+
+		scopes := make([]interface{}, 0)
+		if props.Scopes != nil {
+			for _, scope := range *props.Scopes {
+				scopes = append(scopes, scope)
+			}
+		}
+		if err := d.Set("scopes", schema.NewSet(schema.HashString, scopes)); err != nil {
+			return fmt.Errorf("Error setting `scopes`: %+v", err)
+		}
+
+		conditions := flattenMonitorResourceHealthAlertCondition(props.Condition)
+		d.Set("resource_type", conditions[monitorResourceHealthAlertFieldResourceType])
+		d.Set("current_health_status", conditions[monitorResourceHealthAlertFieldCurrentHealthStatus])
+		d.Set("previous_health_status", conditions[monitorResourceHealthAlertFieldPreviousHealthStatus])
+		d.Set("cause", conditions[monitorResourceHealthAlertFieldCause])
+
+		if err := d.Set("action", flattenMonitorResourceHealthAlertAction(props.Actions)); err != nil {
+			return fmt.Errorf("Error setting `action`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmMonitorResourceHealthAlertDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorActivityLogAlertsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["activityLogAlerts"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Resource Health Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandMonitorResourceHealthAlertCondition(d *schema.ResourceData) *insights.ActivityLogAlertAllOfCondition {
+	conditions := []insights.ActivityLogAlertLeafCondition{
+		{
+			Field:  utils.String(monitorResourceHealthAlertFieldCategory),
+			Equals: utils.String(monitorResourceHealthAlertCategory),
+		},
+	}
+
+	fields := map[string]string{
+		"resource_type":          monitorResourceHealthAlertFieldResourceType,
+		"current_health_status":  monitorResourceHealthAlertFieldCurrentHealthStatus,
+		"previous_health_status": monitorResourceHealthAlertFieldPreviousHealthStatus,
+		"cause":                  monitorResourceHealthAlertFieldCause,
+	}
+	for _, key := range []string{"resource_type", "current_health_status", "previous_health_status", "cause"} {
+		if v := d.Get(key).(string); v != "" {
+			conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+				Field:  utils.String(fields[key]),
+				Equals: utils.String(v),
+			})
+		}
+	}
+
+	return &insights.ActivityLogAlertAllOfCondition{
+		AllOf: &conditions,
+	}
+}
+
+func flattenMonitorResourceHealthAlertCondition(input *insights.ActivityLogAlertAllOfCondition) map[string]string {
+	results := make(map[string]string)
+	if input == nil || input.AllOf == nil {
+		return results
+	}
+
+	for _, condition := range *input.AllOf {
+		if condition.Field == nil || condition.Equals == nil {
+			continue
+		}
+
+		// field names are case-insensitive, so may not be returned with the casing they were sent with
+		for _, field := range []string{
+			monitorResourceHealthAlertFieldResourceType,
+			monitorResourceHealthAlertFieldCurrentHealthStatus,
+			monitorResourceHealthAlertFieldPreviousHealthStatus,
+			monitorResourceHealthAlertFieldCause,
+		} {
+			if strings.EqualFold(*condition.Field, field) {
+				results[field] = *condition.Equals
+			}
+		}
+	}
+
+	return results
+}
+
+func expandMonitorResourceHealthAlertAction(input []interface{}) *insights.ActivityLogAlertActionList {
+	actions := make([]insights.ActivityLogAlertActionGroup, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		action := insights.ActivityLogAlertActionGroup{
+			ActionGroupID:     utils.String(v["action_group_id"].(string)),
+			WebhookProperties: make(map[string]*string),
+		}
+
+		for key, value := range v["webhook_properties"].(map[string]interface{}) {
+			action.WebhookProperties[key] = utils.String(value.(string))
+		}
+
+		actions = append(actions, action)
+	}
+
+	return &insights.ActivityLogAlertActionList{
+		ActionGroups: &actions,
+	}
+}
+
+func flattenMonitorResourceHealthAlertAction(input *insights.ActivityLogAlertActionList) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.ActionGroups == nil {
+		return results
+	}
+
+	for _, action := range *input.ActionGroups {
+		output := make(map[string]interface{})
+
+		if action.ActionGroupID != nil {
+			output["action_group_id"] = *action.ActionGroupID
+		}
+
+		webhookProperties := make(map[string]interface{})
+		for key, value := range action.WebhookProperties {
+			if value != nil {
+				webhookProperties[key] = *value
+			}
+		}
+		output["webhook_properties"] = webhookProperties
+
+		results = append(results, output)
+	}
+
+	return results
+}
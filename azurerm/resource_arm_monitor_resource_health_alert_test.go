@@ -0,0 +1,172 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMMonitorResourceHealthAlert_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_resource_health_alert.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorResourceHealthAlert_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorResourceHealthAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorResourceHealthAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "current_health_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorResourceHealthAlert_complete(t *testing.T) {
+	resourceName := "azurerm_monitor_resource_health_alert.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorResourceHealthAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorResourceHealthAlert_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorResourceHealthAlertExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorResourceHealthAlert_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorResourceHealthAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "Microsoft.Storage/storageAccounts"),
+					resource.TestCheckResourceAttr(resourceName, "current_health_status", "Unavailable"),
+					resource.TestCheckResourceAttr(resourceName, "previous_health_status", "Available"),
+					resource.TestCheckResourceAttr(resourceName, "cause", "PlatformInitiated"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorResourceHealthAlertDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorActivityLogAlertsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_resource_health_alert" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Resource Health Alert still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMMonitorResourceHealthAlertExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Resource Health Alert: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).monitorActivityLogAlertsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on monitorActivityLogAlertsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Resource Health Alert %q (Resource Group %q) does not exist", name, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMMonitorResourceHealthAlert_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_resource_health_alert" "test" {
+  name                = "acctestResourceHealthAlert-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  scopes              = ["${azurerm_resource_group.test.id}"]
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorResourceHealthAlert_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_resource_health_alert" "test" {
+  name                   = "acctestResourceHealthAlert-%d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  scopes                 = ["${azurerm_resource_group.test.id}"]
+  description            = "Alert when a Storage Account becomes unavailable due to the platform"
+  enabled                = false
+  resource_type          = "Microsoft.Storage/storageAccounts"
+  current_health_status  = "Unavailable"
+  previous_health_status = "Available"
+  cause                  = "PlatformInitiated"
+
+  action {
+    action_group_id = "${azurerm_monitor_action_group.test.id}"
+
+    webhook_properties {
+      from = "terraform"
+    }
+  }
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,486 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorScheduledQueryRulesAlert() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorScheduledQueryRulesAlertCreateUpdate,
+		Read:   resourceArmMonitorScheduledQueryRulesAlertRead,
+		Update: resourceArmMonitorScheduledQueryRulesAlertCreateUpdate,
+		Delete: resourceArmMonitorScheduledQueryRulesAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"data_source_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"authorized_resource_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"query": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"frequency": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(5, 1440),
+			},
+
+			"time_window": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(5, 2880),
+			},
+
+			"severity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(0, 4),
+			},
+
+			"throttling": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 10000),
+			},
+
+			"action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_group": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+							Set: schema.HashString,
+						},
+
+						"email_subject": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"custom_webhook_payload": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.ValidateJsonString,
+						},
+					},
+				},
+			},
+
+			"trigger": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operator": schemaMonitorScheduledQueryRulesOperator(),
+
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+
+						"metric_trigger": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": schemaMonitorScheduledQueryRulesOperator(),
+
+									"threshold": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+
+									"metric_trigger_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(insights.MetricTriggerTypeConsecutive),
+											string(insights.MetricTriggerTypeTotal),
+										}, false),
+									},
+
+									"metric_column": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func schemaMonitorScheduledQueryRulesOperator() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(insights.ConditionalOperatorGreaterThan),
+			string(insights.ConditionalOperatorLessThan),
+			string(insights.ConditionalOperatorEqual),
+		}, false),
+	}
+}
+
+func resourceArmMonitorScheduledQueryRulesAlertCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorScheduledQueryRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Scheduled Query Rules Alert creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	frequency := d.Get("frequency").(int)
+	timeWindow := d.Get("time_window").(int)
+	if timeWindow < frequency {
+		return fmt.Errorf("`time_window` must be greater than or equal to `frequency`")
+	}
+
+	enabled := insights.True
+	if !d.Get("enabled").(bool) {
+		enabled = insights.False
+	}
+
+	authorizedResourceIds := make([]string, 0)
+	for _, v := range d.Get("authorized_resource_ids").(*schema.Set).List() {
+		authorizedResourceIds = append(authorizedResourceIds, v.(string))
+	}
+
+	action := insights.AlertingAction{
+		Severity:   insights.AlertSeverity(strconv.Itoa(d.Get("severity").(int))),
+		AznsAction: expandMonitorScheduledQueryRulesAlertAction(d.Get("action").([]interface{})),
+		Trigger:    expandMonitorScheduledQueryRulesAlertTrigger(d.Get("trigger").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("throttling"); ok {
+		action.ThrottlingInMin = utils.Int32(int32(v.(int)))
+	}
+
+	parameters := insights.LogSearchRuleResource{
+		Location: utils.String(location),
+		LogSearchRule: &insights.LogSearchRule{
+			Description: utils.String(d.Get("description").(string)),
+			Enabled:     enabled,
+			Source: &insights.Source{
+				Query:               utils.String(d.Get("query").(string)),
+				AuthorizedResources: &authorizedResourceIds,
+				DataSourceID:        utils.String(d.Get("data_source_id").(string)),
+				QueryType:           insights.ResultCount,
+			},
+			Schedule: &insights.Schedule{
+				FrequencyInMinutes:  utils.Int32(int32(frequency)),
+				TimeWindowInMinutes: utils.Int32(int32(timeWindow)),
+			},
+			Action: action,
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Scheduled Query Rules Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Scheduled Query Rules Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Scheduled Query Rules Alert %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorScheduledQueryRulesAlertRead(d, meta)
+}
+
+func resourceArmMonitorScheduledQueryRulesAlertRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorScheduledQueryRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["scheduledqueryrules"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Scheduled Query Rules Alert %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Scheduled Query Rules Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.LogSearchRule; props != nil {
+		d.Set("description", props.Description)
+		d.Set("enabled", props.Enabled == insights.True)
+
+		if source := props.Source; source != nil {
+			d.Set("query", source.Query)
+			d.Set("data_source_id", source.DataSourceID)
+
+			authorizedResourceIds := make([]interface{}, 0)
+			if source.AuthorizedResources != nil {
+				for _, v := range *source.AuthorizedResources {
+					authorizedResourceIds = append(authorizedResourceIds, v)
+				}
+			}
+			if err := d.Set("authorized_resource_ids", schema.NewSet(schema.HashString, authorizedResourceIds)); err != nil {
+				return fmt.Errorf("Error setting `authorized_resource_ids`: %+v", err)
+			}
+		}
+
+		if schedule := props.Schedule; schedule != nil {
+			if schedule.FrequencyInMinutes != nil {
+				d.Set("frequency", int(*schedule.FrequencyInMinutes))
+			}
+			if schedule.TimeWindowInMinutes != nil {
+				d.Set("time_window", int(*schedule.TimeWindowInMinutes))
+			}
+		}
+
+		if props.Action != nil {
+			action, ok := props.Action.AsAlertingAction()
+			if !ok {
+				return fmt.Errorf("Scheduled Query Rule %q (Resource Group %q) is not an Alert", name, resourceGroup)
+			}
+
+			if severity, err := strconv.Atoi(string(action.Severity)); err == nil {
+				d.Set("severity", severity)
+			}
+
+			throttling := 0
+			if action.ThrottlingInMin != nil {
+				throttling = int(*action.ThrottlingInMin)
+			}
+			d.Set("throttling", throttling)
+
+			if err := d.Set("action", flattenMonitorScheduledQueryRulesAlertAction(action.AznsAction)); err != nil {
+				return fmt.Errorf("Error setting `action`: %+v", err)
+			}
+
+			if err := d.Set("trigger", flattenMonitorScheduledQueryRulesAlertTrigger(action.Trigger)); err != nil {
+				return fmt.Errorf("Error setting `trigger`: %+v", err)
+			}
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmMonitorScheduledQueryRulesAlertDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorScheduledQueryRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["scheduledqueryrules"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Scheduled Query Rules Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandMonitorScheduledQueryRulesAlertAction(input []interface{}) *insights.AzNsActionGroup {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	actionGroups := make([]string, 0)
+	for _, id := range v["action_group"].(*schema.Set).List() {
+		actionGroups = append(actionGroups, id.(string))
+	}
+
+	result := insights.AzNsActionGroup{
+		ActionGroup: &actionGroups,
+	}
+
+	if subject := v["email_subject"].(string); subject != "" {
+		result.EmailSubject = utils.String(subject)
+	}
+
+	if payload := v["custom_webhook_payload"].(string); payload != "" {
+		result.CustomWebhookPayload = utils.String(payload)
+	}
+
+	return &result
+}
+
+func expandMonitorScheduledQueryRulesAlertTrigger(input []interface{}) *insights.TriggerCondition {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	result := insights.TriggerCondition{
+		ThresholdOperator: insights.ConditionalOperator(v["operator"].(string)),
+		Threshold:         utils.Float(v["threshold"].(float64)),
+	}
+
+	if metricTriggers := v["metric_trigger"].([]interface{}); len(metricTriggers) > 0 {
+		metricTrigger := metricTriggers[0].(map[string]interface{})
+		result.MetricTrigger = &insights.LogMetricTrigger{
+			ThresholdOperator: insights.ConditionalOperator(metricTrigger["operator"].(string)),
+			Threshold:         utils.Float(metricTrigger["threshold"].(float64)),
+			MetricTriggerType: insights.MetricTriggerType(metricTrigger["metric_trigger_type"].(string)),
+			MetricColumn:      utils.String(metricTrigger["metric_column"].(string)),
+		}
+	}
+
+	return &result
+}
+
+func flattenMonitorScheduledQueryRulesAlertAction(input *insights.AzNsActionGroup) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	actionGroups := make([]interface{}, 0)
+	if input.ActionGroup != nil {
+		for _, id := range *input.ActionGroup {
+			actionGroups = append(actionGroups, id)
+		}
+	}
+
+	result := map[string]interface{}{
+		"action_group": schema.NewSet(schema.HashString, actionGroups),
+	}
+
+	if input.EmailSubject != nil {
+		result["email_subject"] = *input.EmailSubject
+	}
+
+	if input.CustomWebhookPayload != nil {
+		result["custom_webhook_payload"] = *input.CustomWebhookPayload
+	}
+
+	return []interface{}{result}
+}
+
+func flattenMonitorScheduledQueryRulesAlertTrigger(input *insights.TriggerCondition) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"operator": string(input.ThresholdOperator),
+	}
+
+	if input.Threshold != nil {
+		result["threshold"] = *input.Threshold
+	}
+
+	metricTriggers := make([]interface{}, 0)
+	if metricTrigger := input.MetricTrigger; metricTrigger != nil {
+		output := map[string]interface{}{
+			"operator":            string(metricTrigger.ThresholdOperator),
+			"metric_trigger_type": string(metricTrigger.MetricTriggerType),
+		}
+
+		if metricTrigger.Threshold != nil {
+			output["threshold"] = *metricTrigger.Threshold
+		}
+
+		if metricTrigger.MetricColumn != nil {
+			output["metric_column"] = *metricTrigger.MetricColumn
+		}
+
+		metricTriggers = append(metricTriggers, output)
+	}
+	result["metric_trigger"] = metricTriggers
+
+	return []interface{}{result}
+}
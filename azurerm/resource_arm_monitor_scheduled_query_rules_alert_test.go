@@ -0,0 +1,213 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMMonitorScheduledQueryRulesAlert_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_scheduled_query_rules_alert.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorScheduledQueryRulesAlert_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorScheduledQueryRulesAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorScheduledQueryRulesAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "3"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.operator", "GreaterThan"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.action_group.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorScheduledQueryRulesAlert_complete(t *testing.T) {
+	resourceName := "azurerm_monitor_scheduled_query_rules_alert.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorScheduledQueryRulesAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorScheduledQueryRulesAlert_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorScheduledQueryRulesAlertExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorScheduledQueryRulesAlert_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorScheduledQueryRulesAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "throttling", "30"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.email_subject", "Alert from Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.metric_trigger.0.metric_trigger_type", "Total"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorScheduledQueryRulesAlertDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorScheduledQueryRulesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_scheduled_query_rules_alert" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Scheduled Query Rules Alert still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMMonitorScheduledQueryRulesAlertExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Scheduled Query Rules Alert: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).monitorScheduledQueryRulesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on monitorScheduledQueryRulesClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Scheduled Query Rules Alert %q (Resource Group %q) does not exist", name, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMMonitorScheduledQueryRulesAlert_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMMonitorScheduledQueryRulesAlert_basic(rInt int, location string) string {
+	template := testAccAzureRMMonitorScheduledQueryRulesAlert_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_scheduled_query_rules_alert" "test" {
+  name                = "acctestsqr-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_source_id      = "${azurerm_log_analytics_workspace.test.id}"
+  query               = "Heartbeat | where TimeGenerated > ago(5m)"
+  frequency           = 5
+  time_window         = 5
+
+  action {
+    action_group = ["${azurerm_monitor_action_group.test.id}"]
+  }
+
+  trigger {
+    operator  = "GreaterThan"
+    threshold = 5000
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMonitorScheduledQueryRulesAlert_complete(rInt int, location string) string {
+	template := testAccAzureRMMonitorScheduledQueryRulesAlert_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_scheduled_query_rules_alert" "test" {
+  name                = "acctestsqr-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_source_id      = "${azurerm_log_analytics_workspace.test.id}"
+  description         = "Alert when the total heartbeats of any computer drops too low"
+  enabled             = false
+  query               = "Heartbeat | summarize AggregatedValue = count() by bin(TimeGenerated, 5m), Computer"
+  frequency           = 60
+  time_window         = 60
+  severity            = 1
+  throttling          = 30
+
+  action {
+    action_group           = ["${azurerm_monitor_action_group.test.id}"]
+    email_subject          = "Alert from Terraform"
+    custom_webhook_payload = "{}"
+  }
+
+  trigger {
+    operator  = "GreaterThan"
+    threshold = 3
+
+    metric_trigger {
+      operator            = "LessThan"
+      threshold           = 10
+      metric_trigger_type = "Total"
+      metric_column       = "Computer"
+    }
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
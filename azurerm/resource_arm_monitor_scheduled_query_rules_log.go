@@ -0,0 +1,283 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorScheduledQueryRulesLog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorScheduledQueryRulesLogCreateUpdate,
+		Read:   resourceArmMonitorScheduledQueryRulesLogRead,
+		Update: resourceArmMonitorScheduledQueryRulesLogCreateUpdate,
+		Delete: resourceArmMonitorScheduledQueryRulesLogDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"data_source_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"dimension": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"operator": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "Include",
+										ValidateFunc: validation.StringInSlice([]string{"Include"}, false),
+									},
+
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMonitorScheduledQueryRulesLogCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorScheduledQueryRulesLogClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Scheduled Query Rules Log creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	enabled := "true"
+	if !d.Get("enabled").(bool) {
+		enabled = "false"
+	}
+
+	parameters := monitorScheduledQueryRule{
+		Location: utils.String(location),
+		Properties: &monitorScheduledQueryRuleProperties{
+			Description: utils.String(d.Get("description").(string)),
+			Enabled:     enabled,
+			Source: &monitorScheduledQueryRuleSource{
+				DataSourceID: utils.String(d.Get("data_source_id").(string)),
+			},
+			Action: &monitorScheduledQueryRuleAction{
+				OdataType: monitorScheduledQueryRulesLogToMetricActionType,
+				Criteria:  expandMonitorScheduledQueryRulesLogCriteria(d.Get("criteria").([]interface{})),
+			},
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	id := monitorScheduledQueryRuleResourceID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+	future, err := client.CreateOrUpdate(ctx, id, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Scheduled Query Rules Log %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Scheduled Query Rules Log %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmMonitorScheduledQueryRulesLogRead(d, meta)
+}
+
+func resourceArmMonitorScheduledQueryRulesLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorScheduledQueryRulesLogClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["scheduledqueryrules"]
+
+	var rule monitorScheduledQueryRule
+	resp, err := client.Get(ctx, d.Id(), &rule)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Scheduled Query Rules Log %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Scheduled Query Rules Log %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := rule.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := rule.Properties; props != nil {
+		if action := props.Action; action != nil && action.OdataType != monitorScheduledQueryRulesLogToMetricActionType {
+			return fmt.Errorf("Scheduled Query Rule %q (Resource Group %q) is not a Log to Metric rule", name, resourceGroup)
+		}
+
+		d.Set("description", props.Description)
+		d.Set("enabled", props.Enabled == "true")
+
+		if source := props.Source; source != nil {
+			d.Set("data_source_id", source.DataSourceID)
+		}
+
+		if action := props.Action; action != nil {
+			if err := d.Set("criteria", flattenMonitorScheduledQueryRulesLogCriteria(action.Criteria)); err != nil {
+				return fmt.Errorf("Error setting `criteria`: %+v", err)
+			}
+		}
+	}
+
+	flattenAndSetTags(d, rule.Tags, meta)
+
+	return nil
+}
+
+func resourceArmMonitorScheduledQueryRulesLogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorScheduledQueryRulesLogClient
+	ctx := meta.(*ArmClient).StopContext
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Scheduled Query Rules Log %q: %+v", d.Id(), err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Scheduled Query Rules Log %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMonitorScheduledQueryRulesLogCriteria(input []interface{}) []monitorScheduledQueryRuleCriteria {
+	criteria := make([]monitorScheduledQueryRuleCriteria, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		dimensions := make([]monitorScheduledQueryRuleDimension, 0)
+		for _, dimensionRaw := range v["dimension"].([]interface{}) {
+			dimension := dimensionRaw.(map[string]interface{})
+
+			values := make([]string, 0)
+			for _, value := range dimension["values"].([]interface{}) {
+				values = append(values, value.(string))
+			}
+
+			dimensions = append(dimensions, monitorScheduledQueryRuleDimension{
+				Name:     dimension["name"].(string),
+				Operator: dimension["operator"].(string),
+				Values:   values,
+			})
+		}
+
+		criteria = append(criteria, monitorScheduledQueryRuleCriteria{
+			MetricName: v["metric_name"].(string),
+			Dimensions: dimensions,
+		})
+	}
+
+	return criteria
+}
+
+func flattenMonitorScheduledQueryRulesLogCriteria(input []monitorScheduledQueryRuleCriteria) []interface{} {
+	result := make([]interface{}, 0)
+
+	for _, criteria := range input {
+		dimensions := make([]interface{}, 0)
+		for _, dimension := range criteria.Dimensions {
+			values := make([]interface{}, 0)
+			for _, value := range dimension.Values {
+				values = append(values, value)
+			}
+
+			dimensions = append(dimensions, map[string]interface{}{
+				"name":     dimension.Name,
+				"operator": dimension.Operator,
+				"values":   values,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"metric_name": criteria.MetricName,
+			"dimension":   dimensions,
+		})
+	}
+
+	return result
+}
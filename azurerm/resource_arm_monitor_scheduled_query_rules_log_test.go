@@ -0,0 +1,213 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandMonitorScheduledQueryRulesLogCriteria(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"metric_name": "Average_% Idle Time",
+			"dimension": []interface{}{
+				map[string]interface{}{
+					"name":     "Computer",
+					"operator": "Include",
+					"values":   []interface{}{"vm1", "vm2"},
+				},
+			},
+		},
+	}
+
+	expected := []monitorScheduledQueryRuleCriteria{
+		{
+			MetricName: "Average_% Idle Time",
+			Dimensions: []monitorScheduledQueryRuleDimension{
+				{
+					Name:     "Computer",
+					Operator: "Include",
+					Values:   []string{"vm1", "vm2"},
+				},
+			},
+		},
+	}
+
+	actual := expandMonitorScheduledQueryRulesLogCriteria(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	if flattened := flattenMonitorScheduledQueryRulesLogCriteria(actual); !reflect.DeepEqual(flattened, input) {
+		t.Fatalf("Expected the flattened criteria to be %+v but got %+v", input, flattened)
+	}
+}
+
+func TestAccAzureRMMonitorScheduledQueryRulesLog_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_scheduled_query_rules_log.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorScheduledQueryRulesLog_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorScheduledQueryRulesLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorScheduledQueryRulesLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.metric_name", "Average_% Idle Time"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.0.operator", "Include"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorScheduledQueryRulesLog_complete(t *testing.T) {
+	resourceName := "azurerm_monitor_scheduled_query_rules_log.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorScheduledQueryRulesLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorScheduledQueryRulesLog_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorScheduledQueryRulesLogExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorScheduledQueryRulesLog_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorScheduledQueryRulesLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test log to metric action"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorScheduledQueryRulesLogDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorScheduledQueryRulesLogClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_scheduled_query_rules_log" {
+			continue
+		}
+
+		var rule monitorScheduledQueryRule
+		resp, err := client.Get(ctx, rs.Primary.ID, &rule)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Scheduled Query Rules Log still exists:\n%#v", rule)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMMonitorScheduledQueryRulesLogExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).monitorScheduledQueryRulesLogClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var rule monitorScheduledQueryRule
+		resp, err := client.Get(ctx, rs.Primary.ID, &rule)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Scheduled Query Rules Log %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on monitorScheduledQueryRulesLogClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMMonitorScheduledQueryRulesLog_basic(rInt int, location string) string {
+	template := testAccAzureRMMonitorScheduledQueryRulesAlert_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_scheduled_query_rules_log" "test" {
+  name                = "acctestsqr-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_source_id      = "${azurerm_log_analytics_workspace.test.id}"
+
+  criteria {
+    metric_name = "Average_%% Idle Time"
+
+    dimension {
+      name   = "Computer"
+      values = ["targetVM"]
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMonitorScheduledQueryRulesLog_complete(rInt int, location string) string {
+	template := testAccAzureRMMonitorScheduledQueryRulesAlert_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_scheduled_query_rules_log" "test" {
+  name                = "acctestsqr-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_source_id      = "${azurerm_log_analytics_workspace.test.id}"
+  description         = "test log to metric action"
+  enabled             = false
+
+  criteria {
+    metric_name = "Average_%% Idle Time"
+
+    dimension {
+      name     = "Computer"
+      operator = "Include"
+      values   = ["targetVM", "otherVM"]
+    }
+  }
+
+  tags {
+    environment = "test"
+  }
+}
+`, template, rInt)
+}
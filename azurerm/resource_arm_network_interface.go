@@ -14,6 +14,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var networkInterfaceResourceName = "azurerm_network_interface"
+
 func resourceArmNetworkInterface() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmNetworkInterfaceCreateUpdate,
@@ -69,9 +71,9 @@ func resourceArmNetworkInterface() *schema.Resource {
 
 						"subnet_id": {
 							Type:             schema.TypeString,
-							Required:         true,
+							Optional:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc:     azure.ValidateResourceID,
+							ValidateFunc:     azure.ValidateResourceIDOrEmpty,
 						},
 
 						"private_ip_address": {
@@ -79,6 +81,16 @@ func resourceArmNetworkInterface() *schema.Resource {
 							Optional: true,
 						},
 
+						"private_ip_address_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.IPv4),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.IPv4),
+								string(network.IPv6),
+							}, false),
+						},
+
 						"private_ip_address_allocation": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -236,6 +248,9 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 	enableAcceleratedNetworking := d.Get("enable_accelerated_networking").(bool)
 	tags := d.Get("tags").(map[string]interface{})
 
+	azureRMLockByName(name, networkInterfaceResourceName)
+	defer azureRMUnlockByName(name, networkInterfaceResourceName)
+
 	properties := network.InterfacePropertiesFormat{
 		EnableIPForwarding:          &enableIpForwarding,
 		EnableAcceleratedNetworking: &enableAcceleratedNetworking,
@@ -298,7 +313,7 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 		Name:                      &name,
 		Location:                  &location,
 		InterfacePropertiesFormat: &properties,
-		Tags: expandTags(tags),
+		Tags:                      expandTags(tags, meta),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, iface)
@@ -306,7 +321,9 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Network", "networkInterfaces", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return err
 	}
@@ -414,7 +431,7 @@ func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("enable_ip_forwarding", resp.EnableIPForwarding)
 	d.Set("enable_accelerated_networking", resp.EnableAcceleratedNetworking)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -430,6 +447,9 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 	resGroup := id.ResourceGroup
 	name := id.Path["networkInterfaces"]
 
+	azureRMLockByName(name, networkInterfaceResourceName)
+	defer azureRMUnlockByName(name, networkInterfaceResourceName)
+
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		networkSecurityGroupId := v.(string)
 		networkSecurityGroupName, err := parseNetworkSecurityGroupName(networkSecurityGroupId)
@@ -449,6 +469,10 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 		data := configRaw.(map[string]interface{})
 
 		subnet_id := data["subnet_id"].(string)
+		if subnet_id == "" {
+			continue
+		}
+
 		subnetId, err := parseAzureResourceID(subnet_id)
 		if err != nil {
 			return err
@@ -475,7 +499,7 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error deleting Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for the deletion of Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -491,8 +515,11 @@ func flattenNetworkInterfaceIPConfigurations(ipConfigs *[]network.InterfaceIPCon
 		props := ipConfig.InterfaceIPConfigurationPropertiesFormat
 
 		niIPConfig["name"] = *ipConfig.Name
-		niIPConfig["subnet_id"] = *props.Subnet.ID
+		if props.Subnet != nil && props.Subnet.ID != nil {
+			niIPConfig["subnet_id"] = *props.Subnet.ID
+		}
 		niIPConfig["private_ip_address_allocation"] = strings.ToLower(string(props.PrivateIPAllocationMethod))
+		niIPConfig["private_ip_address_version"] = string(props.PrivateIPAddressVersion)
 
 		if props.PrivateIPAllocationMethod == network.Static {
 			niIPConfig["private_ip_address"] = *props.PrivateIPAddress
@@ -552,31 +579,49 @@ func expandAzureRmNetworkInterfaceIpConfigurations(d *schema.ResourceData) ([]ne
 	for _, configRaw := range configs {
 		data := configRaw.(map[string]interface{})
 
+		name := data["name"].(string)
 		subnet_id := data["subnet_id"].(string)
 		private_ip_allocation_method := data["private_ip_address_allocation"].(string)
+		private_ip_address_version := network.IPVersion(data["private_ip_address_version"].(string))
 
 		allocationMethod := network.IPAllocationMethod(private_ip_allocation_method)
 		properties := network.InterfaceIPConfigurationPropertiesFormat{
-			Subnet: &network.Subnet{
-				ID: &subnet_id,
-			},
 			PrivateIPAllocationMethod: allocationMethod,
+			PrivateIPAddressVersion:   private_ip_address_version,
 		}
 
-		subnetId, err := parseAzureResourceID(subnet_id)
-		if err != nil {
-			return []network.InterfaceIPConfiguration{}, nil, nil, err
-		}
+		if private_ip_address_version == network.IPv6 {
+			if subnet_id != "" {
+				return nil, nil, nil, fmt.Errorf("`subnet_id` cannot be specified for the IPv6 `ip_configuration` %q", name)
+			}
 
-		subnetName := subnetId.Path["subnets"]
-		virtualNetworkName := subnetId.Path["virtualNetworks"]
+			if !strings.EqualFold(private_ip_allocation_method, string(network.Dynamic)) {
+				return nil, nil, nil, fmt.Errorf("`private_ip_address_allocation` must be `Dynamic` for the IPv6 `ip_configuration` %q", name)
+			}
+		} else {
+			if subnet_id == "" {
+				return nil, nil, nil, fmt.Errorf("`subnet_id` must be specified for the IPv4 `ip_configuration` %q", name)
+			}
 
-		if !sliceContainsValue(subnetNamesToLock, subnetName) {
-			subnetNamesToLock = append(subnetNamesToLock, subnetName)
-		}
+			properties.Subnet = &network.Subnet{
+				ID: &subnet_id,
+			}
 
-		if !sliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-			virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
+			subnetId, err := parseAzureResourceID(subnet_id)
+			if err != nil {
+				return []network.InterfaceIPConfiguration{}, nil, nil, err
+			}
+
+			subnetName := subnetId.Path["subnets"]
+			virtualNetworkName := subnetId.Path["virtualNetworks"]
+
+			if !sliceContainsValue(subnetNamesToLock, subnetName) {
+				subnetNamesToLock = append(subnetNamesToLock, subnetName)
+			}
+
+			if !sliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
+				virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
+			}
 		}
 
 		if v := data["private_ip_address"].(string); v != "" {
@@ -654,9 +699,8 @@ func expandAzureRmNetworkInterfaceIpConfigurations(d *schema.ResourceData) ([]ne
 			properties.ApplicationSecurityGroups = &securityGroups
 		}
 
-		name := data["name"].(string)
 		ipConfig := network.InterfaceIPConfiguration{
-			Name: &name,
+			Name:                                     &name,
 			InterfaceIPConfigurationPropertiesFormat: &properties,
 		}
 
@@ -678,6 +722,14 @@ func expandAzureRmNetworkInterfaceIpConfigurations(d *schema.ResourceData) ([]ne
 		}
 	}
 
+	// the primary IP Configuration must be IPv4, IPv6 addresses can only be assigned as secondary IP Configurations
+	for _, config := range ipConfigs {
+		isPrimary := len(ipConfigs) == 1 || (config.Primary != nil && *config.Primary)
+		if isPrimary && config.PrivateIPAddressVersion == network.IPv6 {
+			return nil, nil, nil, fmt.Errorf("The primary `ip_configuration` %q must use a `private_ip_address_version` of `IPv4`.", *config.Name)
+		}
+	}
+
 	return ipConfigs, &subnetNamesToLock, &virtualNetworkNamesToLock, nil
 }
 
@@ -0,0 +1,278 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationCreate,
+		Read:   resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationRead,
+		Delete: resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"ip_configuration_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"backend_address_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Network Interface <-> Application Gateway Backend Address Pool Association creation.")
+
+	networkInterfaceId := d.Get("network_interface_id").(string)
+	ipConfigurationName := d.Get("ip_configuration_name").(string)
+	backendAddressPoolId := d.Get("backend_address_pool_id").(string)
+
+	id, err := parseAzureResourceID(networkInterfaceId)
+	if err != nil {
+		return err
+	}
+
+	networkInterfaceName := id.Path["networkInterfaces"]
+	resourceGroup := id.ResourceGroup
+
+	azureRMLockByName(networkInterfaceName, networkInterfaceResourceName)
+	defer azureRMUnlockByName(networkInterfaceName, networkInterfaceResourceName)
+
+	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", networkInterfaceName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	props := read.InterfacePropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+	}
+
+	config := findNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationName)
+	if config == nil {
+		return fmt.Errorf("IP Configuration %q was not found on Network Interface %q (Resource Group %q)", ipConfigurationName, networkInterfaceName, resourceGroup)
+	}
+
+	p := config.InterfaceIPConfigurationPropertiesFormat
+	if p == nil {
+		return fmt.Errorf("Error: `IP Configuration.properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+	}
+
+	pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+
+	// first double-check it doesn't exist
+	if p.ApplicationGatewayBackendAddressPools != nil {
+		for _, existingPool := range *p.ApplicationGatewayBackendAddressPools {
+			if existingPool.ID != nil && strings.EqualFold(*existingPool.ID, backendAddressPoolId) {
+				return fmt.Errorf("A Network Interface <-> Application Gateway Backend Address Pool Association already exists for %q - please import it into the state with `terraform import`", backendAddressPoolId)
+			}
+
+			pools = append(pools, existingPool)
+		}
+	}
+
+	pools = append(pools, network.ApplicationGatewayBackendAddressPool{
+		ID: utils.String(backendAddressPoolId),
+	})
+	p.ApplicationGatewayBackendAddressPools = &pools
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+	if err != nil {
+		return fmt.Errorf("Error updating Application Gateway Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	resourceId := fmt.Sprintf("%s/ipConfigurations/%s|%s", networkInterfaceId, ipConfigurationName, backendAddressPoolId)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, resourceId); err != nil {
+		return fmt.Errorf("Error waiting for completion of Application Gateway Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	d.SetId(resourceId)
+
+	return resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationRead(d, meta)
+}
+
+func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {networkInterfaceId}/ipConfigurations/{ipConfigurationName}|{backendAddressPoolId} but got %q", d.Id())
+	}
+
+	nicID, err := parseAzureResourceID(splitId[0])
+	if err != nil {
+		return err
+	}
+
+	ipConfigurationName := nicID.Path["ipConfigurations"]
+	networkInterfaceName := nicID.Path["networkInterfaces"]
+	resourceGroup := nicID.ResourceGroup
+	backendAddressPoolId := splitId[1]
+
+	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("Network Interface %q (Resource Group %q) was not found - removing from state!", networkInterfaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	props := read.InterfacePropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+	}
+
+	config := findNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationName)
+	if config == nil {
+		log.Printf("IP Configuration %q was not found in Network Interface %q (Resource Group %q) - removing from state!", ipConfigurationName, networkInterfaceName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	found := false
+	if p := config.InterfaceIPConfigurationPropertiesFormat; p != nil && p.ApplicationGatewayBackendAddressPools != nil {
+		for _, pool := range *p.ApplicationGatewayBackendAddressPools {
+			if pool.ID != nil && strings.EqualFold(*pool.ID, backendAddressPoolId) {
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] Association between Network Interface %q (Resource Group %q) and Application Gateway Backend Address Pool %q was not found - removing from state!", networkInterfaceName, resourceGroup, backendAddressPoolId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend_address_pool_id", backendAddressPoolId)
+	d.Set("ip_configuration_name", ipConfigurationName)
+	if id := read.ID; id != nil {
+		d.Set("network_interface_id", *id)
+	}
+
+	return nil
+}
+
+func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {networkInterfaceId}/ipConfigurations/{ipConfigurationName}|{backendAddressPoolId} but got %q", d.Id())
+	}
+
+	nicID, err := parseAzureResourceID(splitId[0])
+	if err != nil {
+		return err
+	}
+
+	ipConfigurationName := nicID.Path["ipConfigurations"]
+	networkInterfaceName := nicID.Path["networkInterfaces"]
+	resourceGroup := nicID.ResourceGroup
+	backendAddressPoolId := splitId[1]
+
+	azureRMLockByName(networkInterfaceName, networkInterfaceResourceName)
+	defer azureRMUnlockByName(networkInterfaceName, networkInterfaceResourceName)
+
+	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	props := read.InterfacePropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+	}
+
+	config := findNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationName)
+	if config == nil {
+		return nil
+	}
+
+	p := config.InterfaceIPConfigurationPropertiesFormat
+	if p == nil || p.ApplicationGatewayBackendAddressPools == nil {
+		return nil
+	}
+
+	pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+	for _, pool := range *p.ApplicationGatewayBackendAddressPools {
+		if pool.ID != nil && strings.EqualFold(*pool.ID, backendAddressPoolId) {
+			continue
+		}
+
+		pools = append(pools, pool)
+	}
+	p.ApplicationGatewayBackendAddressPools = &pools
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+	if err != nil {
+		return fmt.Errorf("Error removing Application Gateway Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Application Gateway Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func findNetworkInterfaceIPConfiguration(input *[]network.InterfaceIPConfiguration, name string) *network.InterfaceIPConfiguration {
+	if input == nil {
+		return nil
+	}
+
+	for i, v := range *input {
+		if v.Name == nil {
+			continue
+		}
+
+		if *v.Name == name {
+			return &(*input)[i]
+		}
+	}
+
+	return nil
+}
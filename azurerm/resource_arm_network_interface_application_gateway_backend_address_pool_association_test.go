@@ -0,0 +1,286 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_network_interface_application_gateway_backend_address_pool_association.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional as this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration_name", "secondary"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation_deleted(t *testing.T) {
+	resourceName := "azurerm_network_interface_application_gateway_backend_address_pool_association.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional as this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationExists(resourceName),
+					testCheckAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		nicID, err := parseAzureResourceID(rs.Primary.Attributes["network_interface_id"])
+		if err != nil {
+			return err
+		}
+
+		nicName := nicID.Path["networkInterfaces"]
+		resourceGroup := nicID.ResourceGroup
+		backendAddressPoolId := rs.Primary.Attributes["backend_address_pool_id"]
+		ipConfigurationName := rs.Primary.Attributes["ip_configuration_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).ifaceClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		read, err := client.Get(ctx, resourceGroup, nicName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(read.Response) {
+				return fmt.Errorf("Bad: Network Interface %q (Resource Group %q) does not exist", nicName, resourceGroup)
+			}
+
+			return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
+		}
+
+		c := findNetworkInterfaceIPConfiguration(read.InterfacePropertiesFormat.IPConfigurations, ipConfigurationName)
+		if c == nil {
+			return fmt.Errorf("IP Configuration %q wasn't found for Network Interface %q (Resource Group %q)", ipConfigurationName, nicName, resourceGroup)
+		}
+
+		found := false
+		if pools := c.InterfaceIPConfigurationPropertiesFormat.ApplicationGatewayBackendAddressPools; pools != nil {
+			for _, pool := range *pools {
+				if pool.ID != nil && strings.EqualFold(*pool.ID, backendAddressPoolId) {
+					found = true
+					break
+				}
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("Association between NIC %q and Application Gateway Backend Address Pool %q was not found", nicName, backendAddressPoolId)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationDisappears(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		nicID, err := parseAzureResourceID(rs.Primary.Attributes["network_interface_id"])
+		if err != nil {
+			return err
+		}
+
+		nicName := nicID.Path["networkInterfaces"]
+		resourceGroup := nicID.ResourceGroup
+		backendAddressPoolId := rs.Primary.Attributes["backend_address_pool_id"]
+		ipConfigurationName := rs.Primary.Attributes["ip_configuration_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).ifaceClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		read, err := client.Get(ctx, resourceGroup, nicName, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
+		}
+
+		c := findNetworkInterfaceIPConfiguration(read.InterfacePropertiesFormat.IPConfigurations, ipConfigurationName)
+		if c == nil {
+			return fmt.Errorf("IP Configuration %q wasn't found for Network Interface %q (Resource Group %q)", ipConfigurationName, nicName, resourceGroup)
+		}
+
+		updatedPools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+		for _, pool := range *c.InterfaceIPConfigurationPropertiesFormat.ApplicationGatewayBackendAddressPools {
+			if pool.ID != nil && strings.EqualFold(*pool.ID, backendAddressPoolId) {
+				continue
+			}
+
+			updatedPools = append(updatedPools, pool)
+		}
+		c.InterfaceIPConfigurationPropertiesFormat.ApplicationGatewayBackendAddressPools = &updatedPools
+
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, nicName, read)
+		if err != nil {
+			return fmt.Errorf("Error removing Application Gateway Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for removal of Application Gateway Backend Address Pool Association for NIC %q (Resource Group %q): %+v", nicName, resourceGroup, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.254.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_subnet" "gateway" {
+  name                 = "subnet-gateway-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.254.0.0/24"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "subnet-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.254.1.0/24"
+}
+
+resource "azurerm_public_ip" "gateway" {
+  name                         = "acctest-gwpip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctest-pip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestgw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "Standard_Medium"
+    tier     = "Standard"
+    capacity = 1
+  }
+
+  gateway_ip_configuration {
+    name      = "gw-ip-config1"
+    subnet_id = "${azurerm_subnet.gateway.id}"
+  }
+
+  frontend_port {
+    name = "port-8080"
+    port = 8080
+  }
+
+  frontend_ip_configuration {
+    name                 = "ip-config-public"
+    public_ip_address_id = "${azurerm_public_ip.gateway.id}"
+  }
+
+  backend_address_pool {
+    name = "pool-1"
+  }
+
+  backend_http_settings {
+    name                  = "backend-http-1"
+    port                  = 8080
+    protocol              = "Http"
+    cookie_based_affinity = "Enabled"
+    request_timeout       = 30
+  }
+
+  http_listener {
+    name                           = "listener-1"
+    frontend_ip_configuration_name = "ip-config-public"
+    frontend_port_name             = "port-8080"
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = "rule-basic-1"
+    rule_type                  = "Basic"
+    http_listener_name         = "listener-1"
+    backend_address_pool_name  = "pool-1"
+    backend_http_settings_name = "backend-http-1"
+  }
+}
+
+resource "azurerm_network_interface" "test" {
+  name                 = "acctestnic-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  enable_ip_forwarding = true
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+    primary                       = true
+  }
+
+  ip_configuration {
+    name                          = "secondary"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+    public_ip_address_id          = "${azurerm_public_ip.test.id}"
+  }
+}
+
+resource "azurerm_network_interface_application_gateway_backend_address_pool_association" "test" {
+  network_interface_id    = "${azurerm_network_interface.test.id}"
+  ip_configuration_name   = "secondary"
+  backend_address_pool_id = "${azurerm_application_gateway.test.backend_address_pool.0.id}"
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt, rInt)
+}
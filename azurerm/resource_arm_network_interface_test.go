@@ -193,6 +193,30 @@ func TestAccAzureRMNetworkInterface_multipleSubnets(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMNetworkInterface_ipv6DualStack(t *testing.T) {
+	resourceName := "azurerm_network_interface.test"
+	rInt := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMNetworkInterface_ipv6DualStack(rInt, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.0.private_ip_address_version", "IPv4"),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.1.private_ip_address_version", "IPv6"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMNetworkInterface_multipleSubnetsPrimary(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
 	rInt := acctest.RandInt()
@@ -624,6 +648,116 @@ resource "azurerm_network_interface" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMNetworkInterface_ipv6DualStack(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-rg-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "testsubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_public_ip" "ipv4" {
+  name                         = "acctestpip4-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+}
+
+resource "azurerm_public_ip" "ipv6" {
+  name                         = "acctestpip6-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+  ip_version                   = "IPv6"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "acctestlb-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  frontend_ip_configuration {
+    name                 = "ipv4"
+    public_ip_address_id = "${azurerm_public_ip.ipv4.id}"
+  }
+
+  frontend_ip_configuration {
+    name                 = "ipv6"
+    public_ip_address_id = "${azurerm_public_ip.ipv6.id}"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "ipv4" {
+  name                = "ipv4"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  loadbalancer_id     = "${azurerm_lb.test.id}"
+}
+
+resource "azurerm_lb_backend_address_pool" "ipv6" {
+  name                = "ipv6"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  loadbalancer_id     = "${azurerm_lb.test.id}"
+}
+
+resource "azurerm_lb_rule" "ipv4" {
+  name                           = "ipv4"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  loadbalancer_id                = "${azurerm_lb.test.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 80
+  backend_port                   = 80
+  frontend_ip_configuration_name = "ipv4"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.ipv4.id}"
+}
+
+resource "azurerm_lb_rule" "ipv6" {
+  name                           = "ipv6"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  loadbalancer_id                = "${azurerm_lb.test.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 80
+  backend_port                   = 80
+  frontend_ip_configuration_name = "ipv6"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.ipv6.id}"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                                    = "ipv4"
+    subnet_id                               = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation           = "dynamic"
+    private_ip_address_version              = "IPv4"
+    load_balancer_backend_address_pools_ids = ["${azurerm_lb_backend_address_pool.ipv4.id}"]
+    primary                                 = true
+  }
+
+  ip_configuration {
+    name                                    = "ipv6"
+    private_ip_address_allocation           = "dynamic"
+    private_ip_address_version              = "IPv6"
+    load_balancer_backend_address_pools_ids = ["${azurerm_lb_backend_address_pool.ipv6.id}"]
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt)
+}
+
 func testAccAzureRMNetworkInterface_multipleSubnetsUpdatedPrimary(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -7,6 +7,8 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -86,8 +88,9 @@ func resourceArmNetworkSecurityGroup() *schema.Resource {
 						},
 
 						"source_address_prefix": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NetworkSecurityRuleAddressPrefix,
 						},
 
 						"source_address_prefixes": {
@@ -98,8 +101,9 @@ func resourceArmNetworkSecurityGroup() *schema.Resource {
 						},
 
 						"destination_address_prefix": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NetworkSecurityRuleAddressPrefix,
 						},
 
 						"destination_address_prefixes": {
@@ -112,15 +116,21 @@ func resourceArmNetworkSecurityGroup() *schema.Resource {
 						"destination_application_security_group_ids": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+							Set: schema.HashString,
 						},
 
 						"source_application_security_group_ids": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+							Set: schema.HashString,
 						},
 
 						"access": {
@@ -180,7 +190,7 @@ func resourceArmNetworkSecurityGroupCreate(d *schema.ResourceData, meta interfac
 		SecurityGroupPropertiesFormat: &network.SecurityGroupPropertiesFormat{
 			SecurityRules: &sgRules,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, sg)
@@ -188,7 +198,9 @@ func resourceArmNetworkSecurityGroupCreate(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error creating/updating NSG %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Network", "networkSecurityGroups", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error waiting for the completion of NSG %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -202,6 +214,7 @@ func resourceArmNetworkSecurityGroupCreate(d *schema.ResourceData, meta interfac
 	}
 
 	d.SetId(*read.ID)
+	meta.(*ArmClient).parentResourceCache.Invalidate(d.Id())
 
 	return resourceArmNetworkSecurityGroupRead(d, meta)
 }
@@ -239,7 +252,7 @@ func resourceArmNetworkSecurityGroupRead(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -260,14 +273,52 @@ func resourceArmNetworkSecurityGroupDelete(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error deleting Network Security Group %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error deleting Network Security Group %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
+	meta.(*ArmClient).parentResourceCache.Invalidate(d.Id())
+
 	return err
 }
 
+// retrieveCachedNetworkSecurityGroup returns the Network Security Group from the cache shared with its Security Rules,
+// retrieving it if it's not cached. The returned Network Security Group is shared and as such must not be modified.
+func retrieveCachedNetworkSecurityGroup(meta interface{}, resGroup string, name string) (*network.SecurityGroup, bool, error) {
+	client := meta.(*ArmClient).secGroupClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id := networkSecurityGroupResourceID(meta.(*ArmClient).subscriptionId, resGroup, name)
+	value, err := meta.(*ArmClient).parentResourceCache.Get(id, func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		etag := ""
+		if resp.Etag != nil {
+			etag = *resp.Etag
+		}
+		return &resp, etag, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if value == nil {
+		return nil, false, nil
+	}
+
+	return value.(*network.SecurityGroup), true, nil
+}
+
+func networkSecurityGroupResourceID(subscriptionId, resGroup, name string) string {
+	return azureRMResourceID(subscriptionId, resGroup, "Microsoft.Network", "networkSecurityGroups", name)
+}
+
 func expandAzureRmSecurityRules(d *schema.ResourceData) ([]network.SecurityRule, error) {
 	sgRules := d.Get("security_rule").(*schema.Set).List()
 	rules := make([]network.SecurityRule, 0)
@@ -363,7 +414,7 @@ func expandAzureRmSecurityRules(d *schema.ResourceData) ([]network.SecurityRule,
 		}
 
 		rules = append(rules, network.SecurityRule{
-			Name: &name,
+			Name:                         &name,
 			SecurityRulePropertiesFormat: &properties,
 		})
 	}
@@ -2,8 +2,10 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -11,6 +13,81 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_network_security_group", &resource.Sweeper{
+		Name: "azurerm_network_security_group",
+		F:    testSweepNetworkSecurityGroups,
+		Dependencies: []string{
+			"azurerm_network_interface",
+			"azurerm_virtual_network",
+		},
+	})
+}
+
+func testSweepNetworkSecurityGroups(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := (*armClient).secGroupClient
+	ctx := (*armClient).StopContext
+
+	log.Printf("Retrieving the Network Security Groups..")
+	results, err := client.ListAllComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error Listing on Network Security Groups: %+v", err)
+	}
+
+	securityGroups := make([]network.SecurityGroup, 0)
+	for results.NotDone() {
+		securityGroups = append(securityGroups, results.Value())
+
+		if err := results.Next(); err != nil {
+			return fmt.Errorf("Error Listing on Network Security Groups: %+v", err)
+		}
+	}
+
+	for _, securityGroup := range securityGroups {
+		if securityGroup.ID == nil || securityGroup.Name == nil || securityGroup.Location == nil {
+			continue
+		}
+
+		id, err := parseAzureResourceID(*securityGroup.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing Azure Resource ID %q", *securityGroup.ID)
+		}
+
+		resourceGroupName := id.ResourceGroup
+		name := *securityGroup.Name
+
+		if !shouldSweepAcceptanceTestResource(name, *securityGroup.Location, region) {
+			continue
+		}
+
+		log.Printf("Deleting Network Security Group %q", name)
+		future, err := client.Delete(ctx, resourceGroupName, name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return err
+		}
+
+		err = future.WaitForCompletionRef(ctx, client.Client)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccAzureRMNetworkSecurityGroup_basic(t *testing.T) {
 	resourceName := "azurerm_network_security_group.test"
 	rInt := acctest.RandInt()
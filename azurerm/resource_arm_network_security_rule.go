@@ -2,10 +2,14 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -82,6 +86,7 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 			"source_address_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				ValidateFunc:  validate.NetworkSecurityRuleAddressPrefix,
 				ConflictsWith: []string{"source_address_prefixes"},
 			},
 
@@ -96,6 +101,7 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 			"destination_address_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				ValidateFunc:  validate.NetworkSecurityRuleAddressPrefix,
 				ConflictsWith: []string{"destination_address_prefixes"},
 			},
 
@@ -111,16 +117,22 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 				Type:     schema.TypeSet,
 				MaxItems: 1,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
 			},
 
 			"destination_application_security_group_ids": {
 				Type:     schema.TypeSet,
 				MaxItems: 1,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
 			},
 
 			"access": {
@@ -258,11 +270,15 @@ func resourceArmNetworkSecurityRuleCreate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error Creating/Updating Network Security Rule %q (NSG %q / Resource Group %q): %+v", name, nsgName, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := fmt.Sprintf("%s/securityRules/%s", azureRMResourceID(subscriptionId, resGroup, "Microsoft.Network", "networkSecurityGroups", nsgName), name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error waiting for completion of Network Security Rule %q (NSG %q / Resource Group %q): %+v", name, nsgName, resGroup, err)
 	}
 
+	meta.(*ArmClient).parentResourceCache.Invalidate(networkSecurityGroupResourceID(meta.(*ArmClient).subscriptionId, resGroup, nsgName))
+
 	read, err := client.Get(ctx, resGroup, nsgName, name)
 	if err != nil {
 		return fmt.Errorf("Error making Read request on Network Security Rule %q (NSG %q / Resource Group %q): %+v", name, nsgName, resGroup, err)
@@ -277,9 +293,6 @@ func resourceArmNetworkSecurityRuleCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceArmNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).secRuleClient
-	ctx := meta.(*ArmClient).StopContext
-
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
@@ -288,14 +301,23 @@ func resourceArmNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}
 	networkSGName := id.Path["networkSecurityGroups"]
 	sgRuleName := id.Path["securityRules"]
 
-	resp, err := client.Get(ctx, resGroup, networkSGName, sgRuleName)
+	// the Security Rules are read from the Network Security Group, which is shared by each of its Security Rules
+	nsg, exists, err := retrieveCachedNetworkSecurityGroup(meta, resGroup, networkSGName)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			d.SetId("")
-			return nil
-		}
 		return fmt.Errorf("Error making Read request on Network Security Rule %q (NSG %q / Resource Group %q): %+v", sgRuleName, networkSGName, resGroup, err)
 	}
+	if !exists {
+		log.Printf("[INFO] Network Security Group %q (Resource Group %q) was not found - removing Network Security Rule %q from state", networkSGName, resGroup, sgRuleName)
+		d.SetId("")
+		return nil
+	}
+
+	resp, exists := findNetworkSecurityRuleByName(nsg, sgRuleName)
+	if !exists {
+		log.Printf("[INFO] Network Security Rule %q was not found in NSG %q (Resource Group %q) - removing from state", sgRuleName, networkSGName, resGroup)
+		d.SetId("")
+		return nil
+	}
 
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resGroup)
@@ -340,10 +362,26 @@ func resourceArmNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error Deleting Network Security Rule %q (NSG %q / Resource Group %q): %+v", sgRuleName, nsgName, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for the deletion of Network Security Rule %q (NSG %q / Resource Group %q): %+v", sgRuleName, nsgName, resGroup, err)
 	}
 
+	meta.(*ArmClient).parentResourceCache.Invalidate(networkSecurityGroupResourceID(meta.(*ArmClient).subscriptionId, resGroup, nsgName))
+
 	return nil
 }
+
+func findNetworkSecurityRuleByName(nsg *network.SecurityGroup, name string) (*network.SecurityRule, bool) {
+	if nsg == nil || nsg.SecurityGroupPropertiesFormat == nil || nsg.SecurityGroupPropertiesFormat.SecurityRules == nil {
+		return nil, false
+	}
+
+	for _, rule := range *nsg.SecurityGroupPropertiesFormat.SecurityRules {
+		if rule.Name != nil && strings.EqualFold(*rule.Name, name) {
+			return &rule, true
+		}
+	}
+
+	return nil, false
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -109,6 +110,42 @@ func TestAccAzureRMNetworkSecurityRule_applicationSecurityGroups(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMNetworkSecurityRule_serviceTag(t *testing.T) {
+	resourceName := "azurerm_network_security_rule.test"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkSecurityRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkSecurityRule_serviceTag(rInt, testLocation(), "Storage.WestEurope"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkSecurityRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_address_prefix", "Storage.WestEurope"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNetworkSecurityRule_invalidServiceTag(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkSecurityRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMNetworkSecurityRule_serviceTag(rInt, testLocation(), "Storages"),
+				ExpectError: regexp.MustCompile("must be `\\*`, an IP Address, a CIDR or a supported Service Tag"),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMNetworkSecurityRuleExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -365,3 +402,32 @@ resource "azurerm_network_security_rule" "test1" {
 }
 `, rInt, location, rInt, rInt, rInt)
 }
+
+func testAccAzureRMNetworkSecurityRule_serviceTag(rInt int, location string, serviceTag string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acceptanceTestSecurityGroup1"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_network_security_rule" "test" {
+  name                        = "test123"
+  priority                    = 100
+  direction                   = "Outbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "443"
+  source_address_prefix       = "VirtualNetwork"
+  destination_address_prefix  = "%s"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  network_security_group_name = "${azurerm_network_security_group.test.name}"
+}
+`, rInt, location, serviceTag)
+}
@@ -46,7 +46,7 @@ func resourceArmNetworkWatcherCreateUpdate(d *schema.ResourceData, meta interfac
 
 	watcher := network.Watcher{
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 	}
 	_, err := client.CreateOrUpdate(ctx, resourceGroup, name, watcher)
 	if err != nil {
@@ -92,7 +92,7 @@ func resourceArmNetworkWatcherRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -115,7 +115,7 @@ func resourceArmNetworkWatcherDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for the deletion of Network Watcher %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
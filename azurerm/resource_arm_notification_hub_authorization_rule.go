@@ -18,7 +18,6 @@ func resourceArmNotificationHubAuthorizationRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		// TODO: customizeDiff for send+listen when manage selected
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -68,7 +67,21 @@ func resourceArmNotificationHubAuthorizationRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
+
+		CustomizeDiff: notificationHubAuthorizationRuleCustomizeDiff,
 	}
 }
 
@@ -152,6 +165,8 @@ func resourceArmNotificationHubAuthorizationRuleRead(d *schema.ResourceData, met
 
 	d.Set("primary_access_key", keysResp.PrimaryKey)
 	d.Set("secondary_access_key", keysResp.SecondaryKey)
+	d.Set("primary_connection_string", keysResp.PrimaryConnectionString)
+	d.Set("secondary_connection_string", keysResp.SecondaryConnectionString)
 
 	return nil
 }
@@ -179,6 +194,14 @@ func resourceArmNotificationHubAuthorizationRuleDelete(d *schema.ResourceData, m
 	return nil
 }
 
+func notificationHubAuthorizationRuleCustomizeDiff(d *schema.ResourceDiff, v interface{}) error {
+	if d.Get("manage").(bool) && (!d.Get("send").(bool) || !d.Get("listen").(bool)) {
+		return fmt.Errorf("`send` and `listen` must both be set to `true` when `manage` is set to `true`")
+	}
+
+	return nil
+}
+
 func expandNotificationHubAuthorizationRuleRights(manage bool, send bool, listen bool) *[]notificationhubs.AccessRights {
 	rights := make([]notificationhubs.AccessRights, 0)
 
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -30,6 +31,8 @@ func TestAccAzureRMNotificationHubAuthorizationRule_listen(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
 					resource.TestCheckResourceAttrSet(resourceName, "primary_access_key"),
 					resource.TestCheckResourceAttrSet(resourceName, "secondary_access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_connection_string"),
 				),
 			},
 		},
@@ -56,6 +59,8 @@ func TestAccAzureRMNotificationHubAuthorizationRule_manage(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
 					resource.TestCheckResourceAttrSet(resourceName, "primary_access_key"),
 					resource.TestCheckResourceAttrSet(resourceName, "secondary_access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_connection_string"),
 				),
 			},
 		},
@@ -82,6 +87,8 @@ func TestAccAzureRMNotificationHubAuthorizationRule_send(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
 					resource.TestCheckResourceAttrSet(resourceName, "primary_access_key"),
 					resource.TestCheckResourceAttrSet(resourceName, "secondary_access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_connection_string"),
 				),
 			},
 		},
@@ -108,6 +115,8 @@ func TestAccAzureRMNotificationHubAuthorizationRule_updated(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
 					resource.TestCheckResourceAttrSet(resourceName, "primary_access_key"),
 					resource.TestCheckResourceAttrSet(resourceName, "secondary_access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_connection_string"),
 				),
 			},
 			{
@@ -119,12 +128,31 @@ func TestAccAzureRMNotificationHubAuthorizationRule_updated(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
 					resource.TestCheckResourceAttrSet(resourceName, "primary_access_key"),
 					resource.TestCheckResourceAttrSet(resourceName, "secondary_access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_connection_string"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccAzureRMNotificationHubAuthorizationRule_manageRequiresSendAndListen(t *testing.T) {
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNotificationHubAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAzureRMNotificationHubAuthorizationRule_manageOnly(ri, location),
+				ExpectError: regexp.MustCompile("`send` and `listen` must both be set to `true` when `manage` is set to `true`"),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMNotificationHubAuthorizationRuleExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -228,6 +256,21 @@ resource "azurerm_notification_hub_authorization_rule" "test" {
 `, template, ri)
 }
 
+func testAzureRMNotificationHubAuthorizationRule_manageOnly(ri int, location string) string {
+	template := testAzureRMNotificationHubAuthorizationRule_template(ri, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_notification_hub_authorization_rule" "test" {
+  name                  = "acctestrule-%d"
+  notification_hub_name = "${azurerm_notification_hub.test.name}"
+  namespace_name        = "${azurerm_notification_hub_namespace.test.name}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  manage                = true
+}
+`, template, ri)
+}
+
 func testAzureRMNotificationHubAuthorizationRule_template(ri int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -15,6 +18,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var notificationHubNamespaceDefaultAuthorizationRule = "RootManageSharedAccessKey"
+
 func resourceArmNotificationHubNamespace() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmNotificationHubNamespaceCreateUpdate,
@@ -80,6 +85,30 @@ func resourceArmNotificationHubNamespace() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"default_primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_primary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_secondary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
@@ -163,6 +192,16 @@ func resourceArmNotificationHubNamespaceRead(d *schema.ResourceData, meta interf
 		d.Set("servicebus_endpoint", props.ServiceBusEndpoint)
 	}
 
+	keys, err := listNotificationHubNamespaceKeys(ctx, client, resourceGroup, name, notificationHubNamespaceDefaultAuthorizationRule)
+	if err != nil {
+		log.Printf("[WARN] Unable to List default keys for Notification Hub Namespace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	} else {
+		d.Set("default_primary_connection_string", keys.PrimaryConnectionString)
+		d.Set("default_secondary_connection_string", keys.SecondaryConnectionString)
+		d.Set("default_primary_key", keys.PrimaryKey)
+		d.Set("default_secondary_key", keys.SecondaryKey)
+	}
+
 	return nil
 }
 
@@ -240,3 +279,28 @@ func notificationHubNamespaceStateRefreshFunc(ctx context.Context, client notifi
 		return res, strconv.Itoa(res.StatusCode), nil
 	}
 }
+
+// listNotificationHubNamespaceKeys lists the keys for an Authorization Rule within a Notification Hub Namespace.
+// The SDK deserializes this response into a SharedAccessAuthorizationRuleListResult, however the API returns
+// the keys themselves - as such we send the request using the SDK and parse the response ourselves.
+func listNotificationHubNamespaceKeys(ctx context.Context, client notificationhubs.NamespacesClient, resourceGroup, namespaceName, ruleName string) (result notificationhubs.ResourceListKeys, err error) {
+	req, err := client.ListKeysPreparer(ctx, resourceGroup, namespaceName, ruleName)
+	if err != nil {
+		return result, fmt.Errorf("Error preparing the request to List Keys: %+v", err)
+	}
+
+	resp, err := client.ListKeysSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, fmt.Errorf("Error sending the request to List Keys: %+v", err)
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return result, err
+}
@@ -0,0 +1,185 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNotificationHubNamespaceAuthorizationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNotificationHubNamespaceAuthorizationRuleCreateUpdate,
+		Read:   resourceArmNotificationHubNamespaceAuthorizationRuleRead,
+		Update: resourceArmNotificationHubNamespaceAuthorizationRuleCreateUpdate,
+		Delete: resourceArmNotificationHubNamespaceAuthorizationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"manage": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"send": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"listen": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"primary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+
+		CustomizeDiff: notificationHubAuthorizationRuleCustomizeDiff,
+	}
+}
+
+func resourceArmNotificationHubNamespaceAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).notificationNamespacesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	namespaceName := d.Get("namespace_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	manage := d.Get("manage").(bool)
+	send := d.Get("send").(bool)
+	listen := d.Get("listen").(bool)
+	parameters := notificationhubs.SharedAccessAuthorizationRuleCreateOrUpdateParameters{
+		Properties: &notificationhubs.SharedAccessAuthorizationRuleProperties{
+			Rights: expandNotificationHubAuthorizationRuleRights(manage, send, listen),
+		},
+	}
+
+	if _, err := client.CreateOrUpdateAuthorizationRule(ctx, resourceGroup, namespaceName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating Authorization Rule %q (Notification Hub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	read, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Authorization Rule %q (Notification Hub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Authorization Rule %q (Notification Hub Namespace %q / Resource Group %q) ID", name, namespaceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmNotificationHubNamespaceAuthorizationRuleRead(d, meta)
+}
+
+func resourceArmNotificationHubNamespaceAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).notificationNamespacesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["AuthorizationRules"]
+
+	resp, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Authorization Rule %q was not found in Notification Hub Namespace %q / Resource Group %q - removing from state!", name, namespaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Authorization Rule %q (Notification Hub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	keysResp, err := listNotificationHubNamespaceKeys(ctx, client, resourceGroup, namespaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error Listing Access Keys for Authorization Rule %q (Notification Hub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("namespace_name", namespaceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.SharedAccessAuthorizationRuleProperties; props != nil {
+		manage, send, listen := flattenNotificationHubAuthorizationRuleRights(props.Rights)
+		d.Set("manage", manage)
+		d.Set("send", send)
+		d.Set("listen", listen)
+	}
+
+	d.Set("primary_access_key", keysResp.PrimaryKey)
+	d.Set("secondary_access_key", keysResp.SecondaryKey)
+	d.Set("primary_connection_string", keysResp.PrimaryConnectionString)
+	d.Set("secondary_connection_string", keysResp.SecondaryConnectionString)
+
+	return nil
+}
+
+func resourceArmNotificationHubNamespaceAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).notificationNamespacesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["AuthorizationRules"]
+
+	resp, err := client.DeleteAuthorizationRule(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Authorization Rule %q (Notification Hub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
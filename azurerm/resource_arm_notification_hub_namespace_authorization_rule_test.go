@@ -0,0 +1,161 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMNotificationHubNamespaceAuthorizationRule_listen(t *testing.T) {
+	resourceName := "azurerm_notification_hub_namespace_authorization_rule.test"
+
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNotificationHubNamespaceAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureRMNotificationHubNamespaceAuthorizationRule_basic(ri, location, false, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNotificationHubNamespaceAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "manage", "false"),
+					resource.TestCheckResourceAttr(resourceName, "send", "false"),
+					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_connection_string"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNotificationHubNamespaceAuthorizationRule_updated(t *testing.T) {
+	resourceName := "azurerm_notification_hub_namespace_authorization_rule.test"
+
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNotificationHubNamespaceAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureRMNotificationHubNamespaceAuthorizationRule_basic(ri, location, false, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNotificationHubNamespaceAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "manage", "false"),
+					resource.TestCheckResourceAttr(resourceName, "send", "true"),
+					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
+				),
+			},
+			{
+				Config: testAzureRMNotificationHubNamespaceAuthorizationRule_basic(ri, location, true, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNotificationHubNamespaceAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "manage", "true"),
+					resource.TestCheckResourceAttr(resourceName, "send", "true"),
+					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNotificationHubNamespaceAuthorizationRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).notificationNamespacesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		name := rs.Primary.Attributes["name"]
+
+		resp, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Authorization Rule %q (Notification Hub Namespace %q / Resource Group %q) does not exist", name, namespaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on notificationNamespacesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNotificationHubNamespaceAuthorizationRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).notificationNamespacesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_notification_hub_namespace_authorization_rule" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		name := rs.Primary.Attributes["name"]
+
+		resp, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Notification Hub Namespace Authorization Rule still exists: %q", name)
+	}
+
+	return nil
+}
+
+func testAzureRMNotificationHubNamespaceAuthorizationRule_basic(ri int, location string, manage, send, listen bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_notification_hub_namespace" "test" {
+  name                = "acctestnhn-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  namespace_type      = "NotificationHub"
+
+  sku {
+    name = "Free"
+  }
+}
+
+resource "azurerm_notification_hub_namespace_authorization_rule" "test" {
+  name                = "acctestrule-%d"
+  namespace_name      = "${azurerm_notification_hub_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  manage              = %t
+  send                = %t
+  listen              = %t
+}
+`, ri, location, ri, ri, manage, send, listen)
+}
@@ -1,10 +1,14 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -25,12 +29,70 @@ func TestAccAzureRMNotificationHubNamespace_free(t *testing.T) {
 				Config: testAzureRMNotificationHubNamespace_free(ri, location),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMNotificationHubNamespaceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "default_primary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_secondary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_primary_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_secondary_key"),
 				),
 			},
 		},
 	})
 }
 
+func TestListNotificationHubNamespaceKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/namespaces/namespace1/AuthorizationRules/RootManageSharedAccessKey/listKeys") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "primaryConnectionString": "Endpoint=sb://namespace1.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=primary",
+  "secondaryConnectionString": "Endpoint=sb://namespace1.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=secondary",
+  "primaryKey": "primary",
+  "secondaryKey": "secondary",
+  "keyName": "RootManageSharedAccessKey"
+}`)
+	}))
+	defer server.Close()
+
+	client := notificationhubs.NewNamespacesClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	keys, err := listNotificationHubNamespaceKeys(context.TODO(), client, "group1", "namespace1", "RootManageSharedAccessKey")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if keys.PrimaryKey == nil || *keys.PrimaryKey != "primary" {
+		t.Fatalf("Expected the Primary Key to be %q but got %v", "primary", keys.PrimaryKey)
+	}
+	if keys.SecondaryKey == nil || *keys.SecondaryKey != "secondary" {
+		t.Fatalf("Expected the Secondary Key to be %q but got %v", "secondary", keys.SecondaryKey)
+	}
+	if keys.PrimaryConnectionString == nil || !strings.HasSuffix(*keys.PrimaryConnectionString, "SharedAccessKey=primary") {
+		t.Fatalf("Expected the Primary Connection String to be parsed but got %v", keys.PrimaryConnectionString)
+	}
+	if keys.SecondaryConnectionString == nil || !strings.HasSuffix(*keys.SecondaryConnectionString, "SharedAccessKey=secondary") {
+		t.Fatalf("Expected the Secondary Connection String to be parsed but got %v", keys.SecondaryConnectionString)
+	}
+}
+
+func TestListNotificationHubNamespaceKeys_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := notificationhubs.NewNamespacesClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	keys, err := listNotificationHubNamespaceKeys(context.TODO(), client, "group1", "namespace1", "rule1")
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+	if keys.Response.Response == nil || keys.Response.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected the response to be a 404")
+	}
+}
+
 func testCheckAzureRMNotificationHubNamespaceExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -0,0 +1,125 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmProximityPlacementGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmProximityPlacementGroupCreateUpdate,
+		Read:   resourceArmProximityPlacementGroupRead,
+		Update: resourceArmProximityPlacementGroupCreateUpdate,
+		Delete: resourceArmProximityPlacementGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmProximityPlacementGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).proximityPlacementGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+	log.Printf("[INFO] preparing arguments for AzureRM Proximity Placement Group creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := proximityPlacementGroup{
+		Location: utils.String(location),
+		Properties: &proximityPlacementGroupProperties{
+			ProximityPlacementGroupType: utils.String("Standard"),
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	id := proximityPlacementGroupResourceID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+	future, err := client.CreateOrUpdate(ctx, id, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Proximity Placement Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Proximity Placement Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmProximityPlacementGroupRead(d, meta)
+}
+
+func resourceArmProximityPlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).proximityPlacementGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["proximityPlacementGroups"]
+
+	var group proximityPlacementGroup
+	resp, err := client.Get(ctx, d.Id(), &group)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Proximity Placement Group %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Proximity Placement Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := group.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	flattenAndSetTags(d, group.Tags, meta)
+
+	return nil
+}
+
+func resourceArmProximityPlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).proximityPlacementGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Proximity Placement Group %q: %+v", d.Id(), err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Proximity Placement Group %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
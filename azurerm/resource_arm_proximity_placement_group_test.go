@@ -0,0 +1,201 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandComputeResourceWithProximityPlacementGroup(t *testing.T) {
+	proximityPlacementGroupId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/proximityPlacementGroups/group1"
+	parameters := compute.AvailabilitySet{
+		Location: utils.String("westeurope"),
+		AvailabilitySetProperties: &compute.AvailabilitySetProperties{
+			PlatformFaultDomainCount:  utils.Int32(2),
+			PlatformUpdateDomainCount: utils.Int32(5),
+		},
+	}
+
+	output, err := expandComputeResourceWithProximityPlacementGroup(parameters, proximityPlacementGroupId)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if output["location"] != "westeurope" {
+		t.Fatalf("Expected the location to be retained but got %+v", output["location"])
+	}
+
+	properties := output["properties"].(map[string]interface{})
+	proximityPlacementGroup := properties["proximityPlacementGroup"].(map[string]interface{})
+	if proximityPlacementGroup["id"] != proximityPlacementGroupId {
+		t.Fatalf("Expected `proximityPlacementGroup` to be %q but got %+v", proximityPlacementGroupId, proximityPlacementGroup)
+	}
+
+	if properties["platformFaultDomainCount"] != float64(2) {
+		t.Fatalf("Expected the existing properties to be retained but got %+v", properties)
+	}
+}
+
+func TestAccAzureRMProximityPlacementGroup_basic(t *testing.T) {
+	resourceName := "azurerm_proximity_placement_group.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMProximityPlacementGroup_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMProximityPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMProximityPlacementGroupExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMProximityPlacementGroup_withTags(t *testing.T) {
+	resourceName := "azurerm_proximity_placement_group.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMProximityPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMProximityPlacementGroup_withTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMProximityPlacementGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.cost_center", "MSFT"),
+				),
+			},
+			{
+				Config: testAccAzureRMProximityPlacementGroup_withUpdatedTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMProximityPlacementGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "staging"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMProximityPlacementGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).proximityPlacementGroupsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_proximity_placement_group" {
+			continue
+		}
+
+		var group proximityPlacementGroup
+		resp, err := client.Get(ctx, rs.Primary.ID, &group)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Proximity Placement Group still exists:\n%#v", group)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMProximityPlacementGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).proximityPlacementGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var group proximityPlacementGroup
+		resp, err := client.Get(ctx, rs.Primary.ID, &group)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Proximity Placement Group %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on proximityPlacementGroupsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMProximityPlacementGroup_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_proximity_placement_group" "test" {
+  name                = "acctestPPG-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMProximityPlacementGroup_withTags(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_proximity_placement_group" "test" {
+  name                = "acctestPPG-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    environment = "Production"
+    cost_center = "MSFT"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMProximityPlacementGroup_withUpdatedTags(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_proximity_placement_group" "test" {
+  name                = "acctestPPG-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    environment = "staging"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -70,6 +70,18 @@ func resourceArmPublicIp() *schema.Resource {
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
+			"ip_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(network.IPv4),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IPv4),
+					string(network.IPv6),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
 			"idle_timeout_in_minutes": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -125,8 +137,21 @@ func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	ipVersion := network.IPVersion(d.Get("ip_version").(string))
+
+	if strings.EqualFold(string(ipVersion), string(network.IPv6)) {
+		if strings.EqualFold(string(sku.Name), string(network.PublicIPAddressSkuNameStandard)) {
+			return fmt.Errorf("IPv6 public IP addresses are only supported with the Basic SKU.")
+		}
+
+		if !strings.EqualFold(string(ipAllocationMethod), string(network.Dynamic)) {
+			return fmt.Errorf("Dynamic IP allocation must be used when creating IPv6 public IP addresses.")
+		}
+	}
+
 	properties := network.PublicIPAddressPropertiesFormat{
 		PublicIPAllocationMethod: ipAllocationMethod,
+		PublicIPAddressVersion:   ipVersion,
 	}
 
 	dnl, dnlOk := d.GetOk("domain_name_label")
@@ -153,12 +178,12 @@ func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	publicIp := network.PublicIPAddress{
-		Name:     &name,
-		Location: &location,
-		Sku:      &sku,
+		Name:                            &name,
+		Location:                        &location,
+		Sku:                             &sku,
 		PublicIPAddressPropertiesFormat: &properties,
-		Tags:  expandTags(tags),
-		Zones: zones,
+		Tags:                            expandTags(tags, meta),
+		Zones:                           zones,
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, publicIp)
@@ -166,7 +191,9 @@ func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error Creating/Updating Public IP %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Network", "publicIPAddresses", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error waiting for completion of Public IP %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -220,6 +247,7 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 	if props := resp.PublicIPAddressPropertiesFormat; props != nil {
 		d.Set("public_ip_address_allocation", strings.ToLower(string(props.PublicIPAllocationMethod)))
+		d.Set("ip_version", string(props.PublicIPAddressVersion))
 
 		if settings := props.DNSSettings; settings != nil {
 			if fqdn := settings.Fqdn; fqdn != nil {
@@ -238,7 +266,7 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -259,7 +287,7 @@ func resourceArmPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error deleting Public IP %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for deletion of Public IP %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -2,14 +2,93 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_public_ip", &resource.Sweeper{
+		Name: "azurerm_public_ip",
+		F:    testSweepPublicIPs,
+		Dependencies: []string{
+			"azurerm_application_gateway",
+			"azurerm_lb",
+			"azurerm_network_interface",
+		},
+	})
+}
+
+func testSweepPublicIPs(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := (*armClient).publicIPClient
+	ctx := (*armClient).StopContext
+
+	log.Printf("Retrieving the Public IP Addresses..")
+	results, err := client.ListAllComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error Listing on Public IP Addresses: %+v", err)
+	}
+
+	publicIPs := make([]network.PublicIPAddress, 0)
+	for results.NotDone() {
+		publicIPs = append(publicIPs, results.Value())
+
+		if err := results.Next(); err != nil {
+			return fmt.Errorf("Error Listing on Public IP Addresses: %+v", err)
+		}
+	}
+
+	for _, publicIP := range publicIPs {
+		if publicIP.ID == nil || publicIP.Name == nil || publicIP.Location == nil {
+			continue
+		}
+
+		id, err := parseAzureResourceID(*publicIP.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing Azure Resource ID %q", *publicIP.ID)
+		}
+
+		resourceGroupName := id.ResourceGroup
+		name := *publicIP.Name
+
+		if !shouldSweepAcceptanceTestResource(name, *publicIP.Location, region) {
+			continue
+		}
+
+		log.Printf("Deleting Public IP Address %q", name)
+		future, err := client.Delete(ctx, resourceGroupName, name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return err
+		}
+
+		err = future.WaitForCompletionRef(ctx, client.Client)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestResourceAzureRMPublicIpDomainNameLabel_validation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -231,6 +310,27 @@ func TestAccAzureRMPublicIpDynamic_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPublicIpDynamic_ipv6(t *testing.T) {
+	resourceName := "azurerm_public_ip.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMPublicIPDynamic_ipv6(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPublicIpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIpExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_version", "IPv6"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMPublicIpExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -437,6 +537,23 @@ resource "azurerm_public_ip" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMPublicIPDynamic_ipv6(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctestpublicip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+  ip_version                   = "IPv6"
+}
+`, rInt, location, rInt)
+}
+
 func testAccAzureRMPublicIPStatic_withTags(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
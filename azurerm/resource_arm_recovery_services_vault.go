@@ -67,7 +67,7 @@ func resourceArmRecoveryServicesVaultCreateUpdate(d *schema.ResourceData, meta i
 	//build vault struct
 	vault := recoveryservices.Vault{
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		Sku: &recoveryservices.Sku{
 			Name: recoveryservices.SkuName(d.Get("sku").(string)),
 		},
@@ -119,7 +119,7 @@ func resourceArmRecoveryServicesVaultRead(d *schema.ResourceData, meta interface
 		d.Set("sku", string(sku.Name))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -0,0 +1,158 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRelayHybridConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRelayHybridConnectionCreateUpdate,
+		Read:   resourceArmRelayHybridConnectionRead,
+		Update: resourceArmRelayHybridConnectionCreateUpdate,
+		Delete: resourceArmRelayHybridConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateRelayHybridConnectionName(),
+			},
+
+			"namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateRelayNamespaceName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			// this can't be changed once the Hybrid Connection has been created
+			"requires_client_authorization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"user_metadata": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"listener_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmRelayHybridConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).relayHybridConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+	log.Printf("[INFO] preparing arguments for Relay Hybrid Connection creation/update.")
+
+	name := d.Get("name").(string)
+	namespaceName := d.Get("namespace_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	parameters := relay.HybridConnection{
+		HybridConnectionProperties: &relay.HybridConnectionProperties{
+			RequiresClientAuthorization: utils.Bool(d.Get("requires_client_authorization").(bool)),
+			UserMetadata:                utils.String(d.Get("user_metadata").(string)),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, namespaceName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Relay Hybrid Connection %q (Namespace %q / Resource Group %q)", name, namespaceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmRelayHybridConnectionRead(d, meta)
+}
+
+func resourceArmRelayHybridConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).relayHybridConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["hybridConnections"]
+
+	resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Relay Hybrid Connection %q was not found in Namespace %q / Resource Group %q - removing from state!", name, namespaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("namespace_name", namespaceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.HybridConnectionProperties; props != nil {
+		d.Set("requires_client_authorization", props.RequiresClientAuthorization)
+		d.Set("user_metadata", props.UserMetadata)
+
+		listenerCount := 0
+		if props.ListenerCount != nil {
+			listenerCount = int(*props.ListenerCount)
+		}
+		d.Set("listener_count", listenerCount)
+	}
+
+	return nil
+}
+
+func resourceArmRelayHybridConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).relayHybridConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["hybridConnections"]
+
+	resp, err := client.Delete(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	return nil
+}
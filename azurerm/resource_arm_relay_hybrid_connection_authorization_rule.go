@@ -0,0 +1,161 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRelayHybridConnectionAuthorizationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRelayHybridConnectionAuthorizationRuleCreateUpdate,
+		Read:   resourceArmRelayHybridConnectionAuthorizationRuleRead,
+		Update: resourceArmRelayHybridConnectionAuthorizationRuleCreateUpdate,
+		Delete: resourceArmRelayHybridConnectionAuthorizationRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: azure.RelayAuthorizationRuleSchemaFrom(map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateRelayAuthorizationRuleName(),
+			},
+
+			"namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateRelayNamespaceName(),
+			},
+
+			"hybrid_connection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateRelayHybridConnectionName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+		}),
+
+		CustomizeDiff: azure.RelayAuthorizationRuleCustomizeDiff,
+	}
+}
+
+func resourceArmRelayHybridConnectionAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).relayHybridConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Relay Hybrid Connection Authorization Rule creation/update.")
+
+	name := d.Get("name").(string)
+	namespaceName := d.Get("namespace_name").(string)
+	hybridConnectionName := d.Get("hybrid_connection_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	parameters := relay.AuthorizationRule{
+		Name: utils.String(name),
+		AuthorizationRuleProperties: &relay.AuthorizationRuleProperties{
+			Rights: azure.ExpandRelayAuthorizationRuleRights(d),
+		},
+	}
+
+	if _, err := client.CreateOrUpdateAuthorizationRule(ctx, resourceGroup, namespaceName, hybridConnectionName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Relay Hybrid Connection Authorization Rule %q (Hybrid Connection %q / Namespace %q / Resource Group %q): %+v", name, hybridConnectionName, namespaceName, resourceGroup, err)
+	}
+
+	read, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, hybridConnectionName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Relay Hybrid Connection Authorization Rule %q (Hybrid Connection %q / Namespace %q / Resource Group %q): %+v", name, hybridConnectionName, namespaceName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Relay Hybrid Connection Authorization Rule %q (Hybrid Connection %q / Namespace %q / Resource Group %q)", name, hybridConnectionName, namespaceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmRelayHybridConnectionAuthorizationRuleRead(d, meta)
+}
+
+func resourceArmRelayHybridConnectionAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).relayHybridConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	hybridConnectionName := id.Path["hybridConnections"]
+	name := id.Path["authorizationRules"]
+
+	resp, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, hybridConnectionName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Relay Hybrid Connection Authorization Rule %q was not found in Hybrid Connection %q / Namespace %q / Resource Group %q - removing from state!", name, hybridConnectionName, namespaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Relay Hybrid Connection Authorization Rule %q (Hybrid Connection %q / Namespace %q / Resource Group %q): %+v", name, hybridConnectionName, namespaceName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("namespace_name", namespaceName)
+	d.Set("hybrid_connection_name", hybridConnectionName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.AuthorizationRuleProperties; props != nil {
+		listen, send, manage := azure.FlattenRelayAuthorizationRuleRights(props.Rights)
+		d.Set("listen", listen)
+		d.Set("send", send)
+		d.Set("manage", manage)
+	}
+
+	keysResp, err := client.ListKeys(ctx, resourceGroup, namespaceName, hybridConnectionName, name)
+	if err != nil {
+		return fmt.Errorf("Error listing keys for Relay Hybrid Connection Authorization Rule %q (Hybrid Connection %q / Namespace %q / Resource Group %q): %+v", name, hybridConnectionName, namespaceName, resourceGroup, err)
+	}
+
+	d.Set("primary_key", keysResp.PrimaryKey)
+	d.Set("primary_connection_string", keysResp.PrimaryConnectionString)
+	d.Set("secondary_key", keysResp.SecondaryKey)
+	d.Set("secondary_connection_string", keysResp.SecondaryConnectionString)
+
+	return nil
+}
+
+func resourceArmRelayHybridConnectionAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).relayHybridConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	hybridConnectionName := id.Path["hybridConnections"]
+	name := id.Path["authorizationRules"]
+
+	resp, err := client.DeleteAuthorizationRule(ctx, resourceGroup, namespaceName, hybridConnectionName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Relay Hybrid Connection Authorization Rule %q (Hybrid Connection %q / Namespace %q / Resource Group %q): %+v", name, hybridConnectionName, namespaceName, resourceGroup, err)
+	}
+
+	return nil
+}
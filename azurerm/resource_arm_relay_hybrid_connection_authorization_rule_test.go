@@ -0,0 +1,182 @@
+package azurerm
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRelayHybridConnectionAuthorizationRule_listen(t *testing.T) {
+	testAccAzureRMRelayHybridConnectionAuthorizationRule(t, true, false, false)
+}
+
+func TestAccAzureRMRelayHybridConnectionAuthorizationRule_send(t *testing.T) {
+	testAccAzureRMRelayHybridConnectionAuthorizationRule(t, false, true, false)
+}
+
+func TestAccAzureRMRelayHybridConnectionAuthorizationRule_listensend(t *testing.T) {
+	testAccAzureRMRelayHybridConnectionAuthorizationRule(t, true, true, false)
+}
+
+func TestAccAzureRMRelayHybridConnectionAuthorizationRule_manage(t *testing.T) {
+	testAccAzureRMRelayHybridConnectionAuthorizationRule(t, true, true, true)
+}
+
+func testAccAzureRMRelayHybridConnectionAuthorizationRule(t *testing.T, listen, send, manage bool) {
+	resourceName := "azurerm_relay_hybrid_connection_authorization_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayHybridConnectionAuthorizationRule_base(acctest.RandInt(), testLocation(), listen, send, manage),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "namespace_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "hybrid_connection_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_connection_string"),
+					resource.TestCheckResourceAttr(resourceName, "listen", strconv.FormatBool(listen)),
+					resource.TestCheckResourceAttr(resourceName, "send", strconv.FormatBool(send)),
+					resource.TestCheckResourceAttr(resourceName, "manage", strconv.FormatBool(manage)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRelayHybridConnectionAuthorizationRule_rightsUpdate(t *testing.T) {
+	resourceName := "azurerm_relay_hybrid_connection_authorization_rule.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayHybridConnectionAuthorizationRule_base(ri, location, true, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
+					resource.TestCheckResourceAttr(resourceName, "send", "false"),
+					resource.TestCheckResourceAttr(resourceName, "manage", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMRelayHybridConnectionAuthorizationRule_base(ri, location, true, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
+					resource.TestCheckResourceAttr(resourceName, "send", "true"),
+					resource.TestCheckResourceAttr(resourceName, "manage", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRelayHybridConnectionAuthorizationRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).relayHybridConnectionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_relay_hybrid_connection_authorization_rule" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		hybridConnectionName := rs.Primary.Attributes["hybrid_connection_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, hybridConnectionName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMRelayHybridConnectionAuthorizationRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		hybridConnectionName := rs.Primary.Attributes["hybrid_connection_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).relayHybridConnectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, hybridConnectionName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Relay Hybrid Connection Authorization Rule %q (Hybrid Connection %q / Namespace %q / Resource Group: %q) does not exist", name, hybridConnectionName, namespaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on relayHybridConnectionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMRelayHybridConnectionAuthorizationRule_base(rInt int, location string, listen, send, manage bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Standard"
+  }
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                = "acctestrnhc-%[1]d"
+  namespace_name      = "${azurerm_relay_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_relay_hybrid_connection_authorization_rule" "test" {
+  name                   = "acctest-%[1]d"
+  namespace_name         = "${azurerm_relay_namespace.test.name}"
+  hybrid_connection_name = "${azurerm_relay_hybrid_connection.test.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+
+  listen = %[3]t
+  send   = %[4]t
+  manage = %[5]t
+}
+`, rInt, location, listen, send, manage)
+}
@@ -0,0 +1,215 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRelayHybridConnection_basic(t *testing.T) {
+	resourceName := "azurerm_relay_hybrid_connection.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMRelayHybridConnection_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "requires_client_authorization", "true"),
+					resource.TestCheckResourceAttr(resourceName, "user_metadata", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRelayHybridConnection_update(t *testing.T) {
+	resourceName := "azurerm_relay_hybrid_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayHybridConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_metadata", ""),
+				),
+			},
+			{
+				Config: testAccAzureRMRelayHybridConnection_userMetadata(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_metadata", "some-metadata"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRelayHybridConnection_withoutClientAuthorization(t *testing.T) {
+	resourceName := "azurerm_relay_hybrid_connection.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMRelayHybridConnection_withoutClientAuthorization(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "requires_client_authorization", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRelayHybridConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).relayHybridConnectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Relay Hybrid Connection %q (Namespace %q / Resource Group: %q) does not exist", name, namespaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on relayHybridConnectionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMRelayHybridConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).relayHybridConnectionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_relay_hybrid_connection" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Relay Hybrid Connection still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMRelayHybridConnection_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Standard"
+  }
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                = "acctestrnhc-%d"
+  namespace_name      = "${azurerm_relay_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMRelayHybridConnection_userMetadata(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Standard"
+  }
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                = "acctestrnhc-%d"
+  namespace_name      = "${azurerm_relay_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  user_metadata       = "some-metadata"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMRelayHybridConnection_withoutClientAuthorization(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Standard"
+  }
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                          = "acctestrnhc-%d"
+  namespace_name                = "${azurerm_relay_namespace.test.name}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  requires_client_authorization = false
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,172 @@
+package azurerm
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRelayNamespaceAuthorizationRule_listen(t *testing.T) {
+	testAccAzureRMRelayNamespaceAuthorizationRule(t, true, false, false)
+}
+
+func TestAccAzureRMRelayNamespaceAuthorizationRule_send(t *testing.T) {
+	testAccAzureRMRelayNamespaceAuthorizationRule(t, false, true, false)
+}
+
+func TestAccAzureRMRelayNamespaceAuthorizationRule_listensend(t *testing.T) {
+	testAccAzureRMRelayNamespaceAuthorizationRule(t, true, true, false)
+}
+
+func TestAccAzureRMRelayNamespaceAuthorizationRule_manage(t *testing.T) {
+	testAccAzureRMRelayNamespaceAuthorizationRule(t, true, true, true)
+}
+
+func testAccAzureRMRelayNamespaceAuthorizationRule(t *testing.T, listen, send, manage bool) {
+	resourceName := "azurerm_relay_namespace_authorization_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayNamespaceAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayNamespaceAuthorizationRule_base(acctest.RandInt(), testLocation(), listen, send, manage),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayNamespaceAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "namespace_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_connection_string"),
+					resource.TestCheckResourceAttr(resourceName, "listen", strconv.FormatBool(listen)),
+					resource.TestCheckResourceAttr(resourceName, "send", strconv.FormatBool(send)),
+					resource.TestCheckResourceAttr(resourceName, "manage", strconv.FormatBool(manage)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRelayNamespaceAuthorizationRule_rightsUpdate(t *testing.T) {
+	resourceName := "azurerm_relay_namespace_authorization_rule.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayNamespaceAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayNamespaceAuthorizationRule_base(ri, location, true, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayNamespaceAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
+					resource.TestCheckResourceAttr(resourceName, "send", "false"),
+					resource.TestCheckResourceAttr(resourceName, "manage", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMRelayNamespaceAuthorizationRule_base(ri, location, true, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayNamespaceAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "listen", "true"),
+					resource.TestCheckResourceAttr(resourceName, "send", "true"),
+					resource.TestCheckResourceAttr(resourceName, "manage", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRelayNamespaceAuthorizationRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).relayNamespacesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_relay_namespace_authorization_rule" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMRelayNamespaceAuthorizationRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).relayNamespacesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Relay Namespace Authorization Rule %q (Namespace %q / Resource Group: %q) does not exist", name, namespaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on relayNamespacesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMRelayNamespaceAuthorizationRule_base(rInt int, location string, listen, send, manage bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Standard"
+  }
+}
+
+resource "azurerm_relay_namespace_authorization_rule" "test" {
+  name                = "acctest-%[1]d"
+  namespace_name      = "${azurerm_relay_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  listen = %[3]t
+  send   = %[4]t
+  manage = %[5]t
+}
+`, rInt, location, listen, send, manage)
+}
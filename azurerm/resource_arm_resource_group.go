@@ -1,10 +1,12 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -18,7 +20,7 @@ func resourceArmResourceGroup() *schema.Resource {
 		Exists: resourceArmResourceGroupExists,
 		Delete: resourceArmResourceGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceArmResourceGroupImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -27,6 +29,12 @@ func resourceArmResourceGroup() *schema.Resource {
 			"location": locationSchema(),
 
 			"tags": tagsSchema(),
+
+			"prevent_deletion_if_contains_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -40,7 +48,7 @@ func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface
 	tags := d.Get("tags").(map[string]interface{})
 	parameters := resources.Group{
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 	}
 	_, err := client.CreateOrUpdate(ctx, name, parameters)
 	if err != nil {
@@ -83,7 +91,7 @@ func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 	if location := resp.Location; location != nil {
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -122,6 +130,22 @@ func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	name := id.ResourceGroup
 
+	if d.Get("prevent_deletion_if_contains_resources").(bool) {
+		resourceIds, err := listResourceIdsWithinResourceGroup(ctx, meta.(*ArmClient).resourcesClient, name)
+		if err != nil {
+			return err
+		}
+
+		if len(resourceIds) > 0 {
+			return fmt.Errorf(`Resource Group %q still contains %d Resource(s) and %q is enabled:
+
+%s
+
+Either remove these Resources (or manage them using Terraform), or set %q to false to delete
+the Resource Group and all of the Resources within it.`, name, len(resourceIds), "prevent_deletion_if_contains_resources", strings.Join(resourceIds, "\n"), "prevent_deletion_if_contains_resources")
+		}
+	}
+
 	deleteFuture, err := client.Delete(ctx, name)
 	if err != nil {
 		if response.WasNotFound(deleteFuture.Response()) {
@@ -131,7 +155,7 @@ func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error deleting Resource Group %q: %+v", name, err)
 	}
 
-	err = deleteFuture.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &deleteFuture.Future, client.Client)
 	if err != nil {
 		if response.WasNotFound(deleteFuture.Response()) {
 			return nil
@@ -142,3 +166,31 @@ func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+func resourceArmResourceGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// this field only exists in Terraform, so set the default value when importing
+	d.Set("prevent_deletion_if_contains_resources", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+// listResourceIdsWithinResourceGroup returns the ID of each Resource within the specified Resource Group
+func listResourceIdsWithinResourceGroup(ctx context.Context, client resources.Client, resourceGroup string) ([]string, error) {
+	resourceIds := make([]string, 0)
+
+	iterator, err := client.ListByResourceGroupComplete(ctx, resourceGroup, "", "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Resources within Resource Group %q: %+v", resourceGroup, err)
+	}
+
+	for iterator.NotDone() {
+		if v := iterator.Value(); v.ID != nil {
+			resourceIds = append(resourceIds, *v.ID)
+		}
+
+		if err := iterator.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing Resources within Resource Group %q: %+v", resourceGroup, err)
+		}
+	}
+
+	return resourceIds, nil
+}
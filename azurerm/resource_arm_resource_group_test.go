@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -29,12 +32,25 @@ func testSweepResourceGroups(region string) error {
 	ctx := (*armClient).StopContext
 
 	log.Printf("Retrieving the Resource Groups..")
-	results, err := client.List(ctx, "", utils.Int32(int32(1000)))
+	results, err := client.ListComplete(ctx, "", utils.Int32(int32(1000)))
 	if err != nil {
 		return fmt.Errorf("Error Listing on Resource Groups: %+v", err)
 	}
 
-	for _, resourceGroup := range results.Values() {
+	resourceGroups := make([]resources.Group, 0)
+	for results.NotDone() {
+		resourceGroups = append(resourceGroups, results.Value())
+
+		if err := results.Next(); err != nil {
+			return fmt.Errorf("Error Listing on Resource Groups: %+v", err)
+		}
+	}
+
+	for _, resourceGroup := range resourceGroups {
+		if resourceGroup.Name == nil || resourceGroup.Location == nil {
+			continue
+		}
+
 		if !shouldSweepAcceptanceTestResource(*resourceGroup.Name, *resourceGroup.Location, region) {
 			continue
 		}
@@ -129,6 +145,67 @@ func TestAccAzureRMResourceGroup_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMResourceGroup_withDefaultTags(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMResourceGroup_withDefaultTags(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.owner", "acctest"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "staging"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.cost_center", "MSFT"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.owner", "acctest"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.environment", "staging"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResourceGroup_preventDeletionIfContainsResources(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroup_preventDeletionIfContainsResources(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupExists(resourceName),
+					testCheckAzureRMResourceGroupCreateNestedResource(resourceName, fmt.Sprintf("acctestavset-%d", ri)),
+				),
+			},
+			{
+				Config:      testAccAzureRMResourceGroup_preventDeletionIfContainsResources(ri, location, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("still contains 1 Resource"),
+			},
+			{
+				// disable the check so that the Resource Group (and the Availability Set) can be deleted
+				Config: testAccAzureRMResourceGroup_preventDeletionIfContainsResources(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "prevent_deletion_if_contains_resources", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMResourceGroupExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -184,6 +261,32 @@ func testCheckAzureRMResourceGroupDisappears(name string) resource.TestCheckFunc
 	}
 }
 
+// testCheckAzureRMResourceGroupCreateNestedResource creates an Availability Set within the Resource Group
+// outside of Terraform, to simulate a Resource being manually created within a shared Resource Group
+func testCheckAzureRMResourceGroupCreateNestedResource(name string, availabilitySetName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["name"]
+		location := rs.Primary.Attributes["location"]
+
+		client := testAccProvider.Meta().(*ArmClient).availSetClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		availabilitySet := compute.AvailabilitySet{
+			Location: utils.String(location),
+		}
+		if _, err := client.CreateOrUpdate(ctx, resourceGroup, availabilitySetName, availabilitySet); err != nil {
+			return fmt.Errorf("Failed creating Availability Set %q (Resource Group %q): %+v", availabilitySetName, resourceGroup, err)
+		}
+
+		return nil
+	}
+}
+
 func testCheckAzureRMResourceGroupDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -243,3 +346,34 @@ resource "azurerm_resource_group" "test" {
 }
 `, rInt, location)
 }
+
+func testAccAzureRMResourceGroup_withDefaultTags(rInt int, location string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  default_tags {
+    cost_center = "MSFT"
+    environment = "Production"
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  tags {
+    environment = "staging"
+    owner       = "acctest"
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMResourceGroup_preventDeletionIfContainsResources(rInt int, location string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name                                   = "acctestRG-%d"
+  location                               = "%s"
+  prevent_deletion_if_contains_resources = %t
+}
+`, rInt, location, enabled)
+}
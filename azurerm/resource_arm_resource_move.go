@@ -0,0 +1,186 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmResourceMove() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmResourceMoveCreate,
+		Read:   resourceArmResourceMoveRead,
+		Delete: resourceArmResourceMoveDelete,
+
+		Schema: map[string]*schema.Schema{
+			"resource_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"target_resource_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"moved_resource_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmResourceMoveCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for Resource Move.")
+
+	resourceIds := make([]string, 0)
+	for _, v := range d.Get("resource_ids").([]interface{}) {
+		resourceIds = append(resourceIds, v.(string))
+	}
+	targetResourceGroupId := d.Get("target_resource_group_id").(string)
+
+	sourceResourceGroup, err := getResourceMoveSourceResourceGroup(resourceIds, subscriptionId)
+	if err != nil {
+		return err
+	}
+
+	targetId, err := parseAzureResourceID(targetResourceGroupId)
+	if err != nil {
+		return err
+	}
+	if targetId.ResourceGroup == "" || len(targetId.Path) > 0 || targetId.Provider != "" {
+		return fmt.Errorf("`target_resource_group_id` must be the ID of a Resource Group but got %q", targetResourceGroupId)
+	}
+
+	parameters := resources.MoveInfo{
+		ResourcesProperty:   &resourceIds,
+		TargetResourceGroup: utils.String(targetResourceGroupId),
+	}
+
+	validateFuture, err := client.ValidateMoveResources(ctx, sourceResourceGroup, parameters)
+	if err != nil {
+		return fmt.Errorf("Error validating the move of Resources from Resource Group %q to %q: %+v", sourceResourceGroup, targetResourceGroupId, err)
+	}
+
+	if err := waitForCompletion(ctx, &validateFuture.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for validation of the move of Resources from Resource Group %q to %q: %+v", sourceResourceGroup, targetResourceGroupId, err)
+	}
+
+	future, err := client.MoveResources(ctx, sourceResourceGroup, parameters)
+	if err != nil {
+		return fmt.Errorf("Error moving Resources from Resource Group %q to %q: %+v", sourceResourceGroup, targetResourceGroupId, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the move of Resources from Resource Group %q to %q: %+v", sourceResourceGroup, targetResourceGroupId, err)
+	}
+
+	sourceResourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionId, sourceResourceGroup)
+	movedResourceIds := make([]string, 0)
+	for _, id := range resourceIds {
+		movedResourceIds = append(movedResourceIds, retargetResourceID(id, sourceResourceGroupId, targetResourceGroupId))
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", sourceResourceGroupId, targetResourceGroupId))
+	if err := d.Set("moved_resource_ids", movedResourceIds); err != nil {
+		return fmt.Errorf("Error setting `moved_resource_ids`: %+v", err)
+	}
+
+	return resourceArmResourceMoveRead(d, meta)
+}
+
+func resourceArmResourceMoveRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourceGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	targetResourceGroupId := d.Get("target_resource_group_id").(string)
+	targetId, err := parseAzureResourceID(targetResourceGroupId)
+	if err != nil {
+		return err
+	}
+
+	// the Resources themselves are managed elsewhere - so the best we can do is to check the Target Resource Group exists
+	if targetId.SubscriptionID == meta.(*ArmClient).subscriptionId {
+		resp, err := client.Get(ctx, targetId.ResourceGroup)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] Target Resource Group %q for the Resource Move was not found - removing from state!", targetId.ResourceGroup)
+				d.SetId("")
+				return nil
+			}
+
+			return fmt.Errorf("Error retrieving Target Resource Group %q for the Resource Move: %+v", targetId.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmResourceMoveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Moving Resources is a one-off action - so there's nothing to undo here
+	log.Printf("[DEBUG] Removing the Resource Move %q from the state - the Resources will not be moved back", d.Id())
+	return nil
+}
+
+// getResourceMoveSourceResourceGroup returns the name of the Resource Group containing each of the specified Resources,
+// since the Move API requires all of the Resources being moved to be in the same Resource Group & Subscription.
+func getResourceMoveSourceResourceGroup(resourceIds []string, subscriptionId string) (string, error) {
+	sourceResourceGroup := ""
+
+	for _, v := range resourceIds {
+		id, err := parseAzureResourceID(v)
+		if err != nil {
+			return "", err
+		}
+
+		if !strings.EqualFold(id.SubscriptionID, subscriptionId) {
+			return "", fmt.Errorf("Resource %q must be in the Subscription %q used by the Provider", v, subscriptionId)
+		}
+
+		if sourceResourceGroup == "" {
+			sourceResourceGroup = id.ResourceGroup
+			continue
+		}
+
+		if !strings.EqualFold(id.ResourceGroup, sourceResourceGroup) {
+			return "", fmt.Errorf("All of the Resources being moved must be in the same Resource Group - got %q and %q", sourceResourceGroup, id.ResourceGroup)
+		}
+	}
+
+	if sourceResourceGroup == "" {
+		return "", fmt.Errorf("At least one Resource ID must be specified")
+	}
+
+	return sourceResourceGroup, nil
+}
+
+// retargetResourceID returns the ID of the Resource once it's been moved from the Source Resource Group into
+// the Target Resource Group - which is the same ID with the Subscription / Resource Group segments replaced.
+func retargetResourceID(resourceId, sourceResourceGroupId, targetResourceGroupId string) string {
+	if !strings.HasPrefix(strings.ToLower(resourceId), strings.ToLower(sourceResourceGroupId)+"/") {
+		return resourceId
+	}
+
+	return strings.TrimSuffix(targetResourceGroupId, "/") + resourceId[len(sourceResourceGroupId):]
+}
@@ -0,0 +1,165 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAzureRMResourceMove_sourceResourceGroup(t *testing.T) {
+	subscriptionId := "00000000-0000-0000-0000-000000000000"
+
+	cases := []struct {
+		ResourceIds []string
+		Expected    string
+		Error       bool
+	}{
+		{
+			ResourceIds: []string{},
+			Error:       true,
+		},
+		{
+			ResourceIds: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/Group1/providers/Microsoft.Network/publicIPAddresses/ip2",
+			},
+			Expected: "group1",
+		},
+		{
+			ResourceIds: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/publicIPAddresses/ip2",
+			},
+			Error: true,
+		},
+		{
+			ResourceIds: []string{
+				"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1",
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range cases {
+		actual, err := getResourceMoveSourceResourceGroup(v.ResourceIds, subscriptionId)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("Expected an error for %+v but didn't get one", v.ResourceIds)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for %+v but got: %+v", v.ResourceIds, err)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected the Source Resource Group to be %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestAzureRMResourceMove_retargetResourceID(t *testing.T) {
+	source := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+	target := "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group2"
+
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1",
+			Expected: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group2/providers/Microsoft.Network/publicIPAddresses/ip1",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/GROUP1/providers/Microsoft.Network/publicIPAddresses/ip1",
+			Expected: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group2/providers/Microsoft.Network/publicIPAddresses/ip1",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group10/providers/Microsoft.Network/publicIPAddresses/ip1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group10/providers/Microsoft.Network/publicIPAddresses/ip1",
+		},
+	}
+
+	for _, v := range cases {
+		actual := retargetResourceID(v.Input, source, target)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestAccAzureRMResourceMove_basic(t *testing.T) {
+	resourceName := "azurerm_resource_move.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMResourceMove_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "moved_resource_ids.#", "1"),
+					testCheckAzureRMResourceMoveCompleted(resourceName),
+				),
+				// the Public IP has been moved out from under the `azurerm_public_ip` resource
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMResourceMoveCompleted(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		movedId, err := parseAzureResourceID(rs.Primary.Attributes["moved_resource_ids.0"])
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).publicIPClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		publicIPName := movedId.Path["publicIPAddresses"]
+		if _, err := client.Get(ctx, movedId.ResourceGroup, publicIPName, ""); err != nil {
+			return fmt.Errorf("Bad: Get on publicIPClient for the moved Public IP %q (Resource Group %q): %+v", publicIPName, movedId.ResourceGroup, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMResourceMove_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "source" {
+  name     = "acctestRG-source-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group" "target" {
+  name     = "acctestRG-target-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctestpip-%d"
+  location                     = "${azurerm_resource_group.source.location}"
+  resource_group_name          = "${azurerm_resource_group.source.name}"
+  public_ip_address_allocation = "static"
+}
+
+resource "azurerm_resource_move" "test" {
+  resource_ids             = ["${azurerm_public_ip.test.id}"]
+  target_resource_group_id = "${azurerm_resource_group.target.id}"
+}
+`, rInt, location, rInt, location, rInt)
+}
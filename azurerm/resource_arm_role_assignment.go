@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azureerr"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -189,10 +191,8 @@ func retryRoleAssignmentsClient(scope string, name string, properties authorizat
 
 		_, err := roleAssignmentsClient.Create(ctx, scope, name, properties)
 
-		if err != nil {
-			return resource.RetryableError(err)
-		}
-		return nil
+		// newly created Principals can take a few minutes to replicate to Azure Resource Manager
+		return response.RetryError(err, azureerr.IsPrincipalNotFound)
 
 	}
 }
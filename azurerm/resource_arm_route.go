@@ -2,6 +2,8 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -96,7 +98,9 @@ func resourceArmRouteCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error Creating/Updating Route %q (Route Table %q / Resource Group %q): %+v", name, rtName, resGroup, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := fmt.Sprintf("%s/routes/%s", routeTableResourceID(subscriptionId, resGroup, rtName), name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
 		return fmt.Errorf("Error waiting for completion for Route %q (Route Table %q / Resource Group %q): %+v", name, rtName, resGroup, err)
 	}
 
@@ -108,14 +112,12 @@ func resourceArmRouteCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Cannot read Route %q/%q (resource group %q) ID", rtName, name, resGroup)
 	}
 	d.SetId(*read.ID)
+	meta.(*ArmClient).parentResourceCache.Invalidate(routeTableResourceID(meta.(*ArmClient).subscriptionId, resGroup, rtName))
 
 	return resourceArmRouteRead(d, meta)
 }
 
 func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).routesClient
-	ctx := meta.(*ArmClient).StopContext
-
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
@@ -124,14 +126,23 @@ func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
 	rtName := id.Path["routeTables"]
 	routeName := id.Path["routes"]
 
-	resp, err := client.Get(ctx, resGroup, rtName, routeName)
+	// the Routes are read from the Route Table, which is shared by each of its Routes
+	routeTable, exists, err := retrieveCachedRouteTable(meta, resGroup, rtName)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			d.SetId("")
-			return nil
-		}
 		return fmt.Errorf("Error making Read request on Azure Route %q: %+v", routeName, err)
 	}
+	if !exists {
+		log.Printf("[INFO] Route Table %q (Resource Group %q) was not found - removing Route %q from state", rtName, resGroup, routeName)
+		d.SetId("")
+		return nil
+	}
+
+	resp, exists := findRouteByName(routeTable, routeName)
+	if !exists {
+		log.Printf("[INFO] Route %q was not found in Route Table %q (Resource Group %q) - removing from state", routeName, rtName, resGroup)
+		d.SetId("")
+		return nil
+	}
 
 	d.Set("name", routeName)
 	d.Set("resource_group_name", resGroup)
@@ -169,10 +180,26 @@ func resourceArmRouteDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error deleting Route %q (Route Table %q / Resource Group %q): %+v", routeName, rtName, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for deletion of Route %q (Route Table %q / Resource Group %q): %+v", routeName, rtName, resGroup, err)
 	}
 
+	meta.(*ArmClient).parentResourceCache.Invalidate(routeTableResourceID(meta.(*ArmClient).subscriptionId, resGroup, rtName))
+
 	return nil
 }
+
+func findRouteByName(routeTable *network.RouteTable, name string) (*network.Route, bool) {
+	if routeTable == nil || routeTable.RouteTablePropertiesFormat == nil || routeTable.RouteTablePropertiesFormat.Routes == nil {
+		return nil, false
+	}
+
+	for _, route := range *routeTable.RouteTablePropertiesFormat.Routes {
+		if route.Name != nil && strings.EqualFold(*route.Name, name) {
+			return &route, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,257 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRouteFilter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRouteFilterCreateUpdate,
+		Read:   resourceArmRouteFilterRead,
+		Update: resourceArmRouteFilterCreateUpdate,
+		Delete: resourceArmRouteFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			// Azure only supports a single Rule per Route Filter
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"access": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Allow),
+							}, false),
+						},
+
+						"rule_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Community",
+							}, false),
+						},
+
+						"communities": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmRouteFilterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).routeFiltersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Route Filter creation/update.")
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	filter := network.RouteFilter{
+		Location: utils.String(location),
+		RouteFilterPropertiesFormat: &network.RouteFilterPropertiesFormat{
+			Rules: expandRouteFilterRules(d.Get("rule").([]interface{}), location),
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, filter)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Route Filter %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Network", "routeFilters", name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for completion of Route Filter %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Route Filter %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Route Filter %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmRouteFilterRead(d, meta)
+}
+
+func resourceArmRouteFilterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).routeFiltersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["routeFilters"]
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Route Filter %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Route Filter %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.RouteFilterPropertiesFormat; props != nil {
+		if err := d.Set("rule", flattenRouteFilterRules(props.Rules)); err != nil {
+			return fmt.Errorf("Error setting `rule`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmRouteFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).routeFiltersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["routeFilters"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Route Filter %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Route Filter %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandRouteFilterRules(input []interface{}, location string) *[]network.RouteFilterRule {
+	rules := make([]network.RouteFilterRule, 0)
+
+	for _, raw := range input {
+		data := raw.(map[string]interface{})
+
+		communities := make([]string, 0)
+		for _, v := range data["communities"].([]interface{}) {
+			communities = append(communities, v.(string))
+		}
+
+		rule := network.RouteFilterRule{
+			Name:     utils.String(data["name"].(string)),
+			Location: utils.String(location),
+			RouteFilterRulePropertiesFormat: &network.RouteFilterRulePropertiesFormat{
+				Access:              network.Access(data["access"].(string)),
+				RouteFilterRuleType: utils.String(data["rule_type"].(string)),
+				Communities:         &communities,
+			},
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return &rules
+}
+
+func flattenRouteFilterRules(input *[]network.RouteFilterRule) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		r := make(map[string]interface{})
+
+		if rule.Name != nil {
+			r["name"] = *rule.Name
+		}
+
+		if props := rule.RouteFilterRulePropertiesFormat; props != nil {
+			r["access"] = string(props.Access)
+
+			if props.RouteFilterRuleType != nil {
+				r["rule_type"] = *props.RouteFilterRuleType
+			}
+
+			communities := make([]interface{}, 0)
+			if props.Communities != nil {
+				for _, v := range *props.Communities {
+					communities = append(communities, v)
+				}
+			}
+			r["communities"] = communities
+		}
+
+		results = append(results, r)
+	}
+
+	return results
+}
@@ -0,0 +1,161 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRouteFilter_basic(t *testing.T) {
+	resourceName := "azurerm_route_filter.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMRouteFilter_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRouteFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRouteFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRouteFilter_rule(t *testing.T) {
+	resourceName := "azurerm_route_filter.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRouteFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRouteFilter_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRouteFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMRouteFilter_rule(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRouteFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.access", "Allow"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule_type", "Community"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.communities.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRouteFilterExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		filterName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Route Filter: %q", filterName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).routeFiltersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, filterName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Route Filter %q (Resource Group %q) does not exist", filterName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on routeFiltersClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMRouteFilterDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).routeFiltersClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_route_filter" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Route Filter still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMRouteFilter_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_route_filter" "test" {
+  name                = "acctestrf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMRouteFilter_rule(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_route_filter" "test" {
+  name                = "acctestrf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  rule {
+    name        = "rule1"
+    access      = "Allow"
+    rule_type   = "Community"
+    communities = ["12076:5010", "12076:5020"]
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -114,7 +114,7 @@ func resourceArmRouteTableCreateUpdate(d *schema.ResourceData, meta interface{})
 			Routes: expandRouteTableRoutes(d),
 			DisableBgpRoutePropagation: utils.Bool(d.Get("disable_bgp_route_propagation").(bool)),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, routeSet)
@@ -122,7 +122,9 @@ func resourceArmRouteTableCreateUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error Creating/Updating Route Table %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := routeTableResourceID(subscriptionId, resGroup, name)
+	if err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
 		return fmt.Errorf("Error waiting for completion of Route Table %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
@@ -135,6 +137,7 @@ func resourceArmRouteTableCreateUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(*read.ID)
+	meta.(*ArmClient).parentResourceCache.Invalidate(d.Id())
 
 	return resourceArmRouteTableRead(d, meta)
 }
@@ -176,7 +179,7 @@ func resourceArmRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -199,13 +202,51 @@ func resourceArmRouteTableDelete(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
 		return fmt.Errorf("Error waiting for deletion of Route Table %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
+	meta.(*ArmClient).parentResourceCache.Invalidate(d.Id())
+
 	return nil
 }
 
+// retrieveCachedRouteTable returns the Route Table from the cache shared with its Routes, retrieving it if
+// it's not cached. The returned Route Table is shared and as such must not be modified.
+func retrieveCachedRouteTable(meta interface{}, resGroup string, name string) (*network.RouteTable, bool, error) {
+	client := meta.(*ArmClient).routeTablesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id := routeTableResourceID(meta.(*ArmClient).subscriptionId, resGroup, name)
+	value, err := meta.(*ArmClient).parentResourceCache.Get(id, func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		etag := ""
+		if resp.Etag != nil {
+			etag = *resp.Etag
+		}
+		return &resp, etag, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if value == nil {
+		return nil, false, nil
+	}
+
+	return value.(*network.RouteTable), true, nil
+}
+
+func routeTableResourceID(subscriptionId, resGroup, name string) string {
+	return azureRMResourceID(subscriptionId, resGroup, "Microsoft.Network", "routeTables", name)
+}
+
 func expandRouteTableRoutes(d *schema.ResourceData) *[]network.Route {
 	configs := d.Get("route").([]interface{})
 	routes := make([]network.Route, 0, len(configs))
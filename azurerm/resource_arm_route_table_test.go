@@ -2,8 +2,10 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -11,6 +13,80 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_route_table", &resource.Sweeper{
+		Name: "azurerm_route_table",
+		F:    testSweepRouteTables,
+		Dependencies: []string{
+			"azurerm_virtual_network",
+		},
+	})
+}
+
+func testSweepRouteTables(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := (*armClient).routeTablesClient
+	ctx := (*armClient).StopContext
+
+	log.Printf("Retrieving the Route Tables..")
+	results, err := client.ListAllComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error Listing on Route Tables: %+v", err)
+	}
+
+	routeTables := make([]network.RouteTable, 0)
+	for results.NotDone() {
+		routeTables = append(routeTables, results.Value())
+
+		if err := results.Next(); err != nil {
+			return fmt.Errorf("Error Listing on Route Tables: %+v", err)
+		}
+	}
+
+	for _, routeTable := range routeTables {
+		if routeTable.ID == nil || routeTable.Name == nil || routeTable.Location == nil {
+			continue
+		}
+
+		id, err := parseAzureResourceID(*routeTable.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing Azure Resource ID %q", *routeTable.ID)
+		}
+
+		resourceGroupName := id.ResourceGroup
+		name := *routeTable.Name
+
+		if !shouldSweepAcceptanceTestResource(name, *routeTable.Location, region) {
+			continue
+		}
+
+		log.Printf("Deleting Route Table %q", name)
+		future, err := client.Delete(ctx, resourceGroupName, name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return err
+		}
+
+		err = future.WaitForCompletionRef(ctx, client.Client)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccAzureRMRouteTable_basic(t *testing.T) {
 	resourceName := "azurerm_route_table.test"
 	ri := acctest.RandInt()
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/scheduler/mgmt/2016-03-01/scheduler"
 
@@ -66,6 +67,14 @@ func resourceArmSchedulerJobCollection() *schema.Resource {
 				}, true),
 			},
 
+			// Azure suspends a Job Collection when (for example) its quota is exceeded - when set the
+			// Job Collection is explicitly re-enabled (or disabled) if it's not in the configured state
+			"force_state": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"quota": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -129,7 +138,7 @@ func resourceArmSchedulerJobCollectionCreateUpdate(d *schema.ResourceData, meta
 
 	collection := scheduler.JobCollectionDefinition{
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		Properties: &scheduler.JobCollectionProperties{
 			Sku: &scheduler.Sku{
 				Name: scheduler.SkuDefinition(d.Get("sku").(string)),
@@ -156,9 +165,53 @@ func resourceArmSchedulerJobCollectionCreateUpdate(d *schema.ResourceData, meta
 
 	d.SetId(*collection.ID)
 
+	if d.Get("force_state").(bool) {
+		if err := forceAzureArmSchedulerJobCollectionState(d, meta, collection); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmSchedulerJobCollectionRead(d, meta)
 }
 
+func forceAzureArmSchedulerJobCollectionState(d *schema.ResourceData, meta interface{}, collection scheduler.JobCollectionDefinition) error {
+	client := meta.(*ArmClient).schedulerJobCollectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	state := d.Get("state").(string)
+
+	if properties := collection.Properties; properties != nil && strings.EqualFold(string(properties.State), state) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Forcing the state of Scheduler Job Collection %q (resource group %q) to %q", name, resourceGroup, state)
+
+	// there's no API to suspend a Job Collection, so `Suspended` is only requested through the update
+	switch {
+	case strings.EqualFold(state, string(scheduler.Enabled)):
+		future, err := client.Enable(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error enabling Scheduler Job Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		if err = waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Scheduler Job Collection %q (Resource Group %q) to be enabled: %+v", name, resourceGroup, err)
+		}
+
+	case strings.EqualFold(state, string(scheduler.Disabled)):
+		future, err := client.Disable(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error disabling Scheduler Job Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		if err = waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Scheduler Job Collection %q (Resource Group %q) to be disabled: %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceArmSchedulerJobCollectionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).schedulerJobCollectionsClient
 	ctx := meta.(*ArmClient).StopContext
@@ -189,7 +242,7 @@ func resourceArmSchedulerJobCollectionRead(d *schema.ResourceData, meta interfac
 	if location := collection.Location; location != nil {
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
-	flattenAndSetTags(d, collection.Tags)
+	flattenAndSetTags(d, collection.Tags, meta)
 
 	//resource specific
 	if properties := collection.Properties; properties != nil {
@@ -227,7 +280,7 @@ func resourceArmSchedulerJobCollectionDelete(d *schema.ResourceData, meta interf
 		}
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		if !response.WasNotFound(future.Response()) {
 			return fmt.Errorf("Error waiting for deletion of Scheduler Job Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -265,8 +318,9 @@ func expandAzureArmSchedulerJobCollectionQuota(d *schema.ResourceData) *schedule
 
 func flattenAzureArmSchedulerJobCollectionQuota(quota *scheduler.JobCollectionQuota) []interface{} {
 
-	if quota == nil {
-		return nil
+	// when the quota is removed the API returns either no quota, or a quota without any values
+	if quota == nil || (quota.MaxJobCount == nil && (quota.MaxRecurrence == nil || (quota.MaxRecurrence.Frequency == "" && quota.MaxRecurrence.Interval == nil))) {
+		return []interface{}{}
 	}
 
 	quotaBlock := make(map[string]interface{})
@@ -60,6 +60,99 @@ func TestAccAzureRMSchedulerJobCollection_complete(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSchedulerJobCollection_stateDrift(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "azurerm_scheduler_job_collection.test"
+	config := testAccAzureRMSchedulerJobCollection_basic(ri, testLocation(), `
+  force_state = true
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSchedulerJobCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					checkAccAzureRMSchedulerJobCollection_basic(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "force_state", "true"),
+					testCheckAzureRMSchedulerJobCollectionDisable(resourceName),
+				),
+				// the collection's been disabled outside of Terraform
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  checkAccAzureRMSchedulerJobCollection_basic(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSchedulerJobCollection_quotaRemoved(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "azurerm_scheduler_job_collection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSchedulerJobCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSchedulerJobCollection_complete(ri, testLocation()),
+				Check:  checkAccAzureRMSchedulerJobCollection_complete(resourceName),
+			},
+			{
+				Config: testAccAzureRMSchedulerJobCollection_basic(ri, testLocation(), ""),
+				Check: resource.ComposeTestCheckFunc(
+					checkAccAzureRMSchedulerJobCollection_basic(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "quota.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenAzureArmSchedulerJobCollectionQuota(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *scheduler.JobCollectionQuota
+		Expected int
+	}{
+		{
+			Name:     "No Quota",
+			Input:    nil,
+			Expected: 0,
+		},
+		{
+			Name: "Empty Quota",
+			Input: &scheduler.JobCollectionQuota{
+				MaxRecurrence: &scheduler.JobMaxRecurrence{},
+			},
+			Expected: 0,
+		},
+		{
+			Name: "Quota",
+			Input: &scheduler.JobCollectionQuota{
+				MaxJobCount: utils.Int32(10),
+				MaxRecurrence: &scheduler.JobMaxRecurrence{
+					Frequency: scheduler.Hour,
+					Interval:  utils.Int32(10),
+				},
+			},
+			Expected: 1,
+		},
+	}
+
+	for _, v := range cases {
+		actual := flattenAzureArmSchedulerJobCollectionQuota(v.Input)
+		if len(actual) != v.Expected {
+			t.Fatalf("Expected %d quota block(s) for %q but got %d", v.Expected, v.Name, len(actual))
+		}
+	}
+}
+
 func testCheckAzureRMSchedulerJobCollectionDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_scheduler_job_collection" {
@@ -118,6 +211,28 @@ func testCheckAzureRMSchedulerJobCollectionExists(name string) resource.TestChec
 	}
 }
 
+func testCheckAzureRMSchedulerJobCollectionDisable(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).schedulerJobCollectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		future, err := client.Disable(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Disable on schedulerJobCollectionsClient: %+v", err)
+		}
+
+		return future.WaitForCompletionRef(ctx, client.Client)
+	}
+}
+
 func testAccAzureRMSchedulerJobCollection_basic(rInt int, location string, additional string) string {
 	return fmt.Sprintf(` 
 resource "azurerm_resource_group" "test" { 
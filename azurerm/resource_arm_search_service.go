@@ -58,6 +58,37 @@ func resourceArmSearchService() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"primary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"query_keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
 			"tags": tagsForceNewSchema(),
 		},
 	}
@@ -79,7 +110,7 @@ func resourceArmSearchServiceCreateUpdate(d *schema.ResourceData, meta interface
 			Name: search.SkuName(skuName),
 		},
 		ServiceProperties: &search.ServiceProperties{},
-		Tags:              expandTags(tags),
+		Tags:              expandTags(tags, meta),
 	}
 
 	if v, ok := d.GetOk("replica_count"); ok {
@@ -149,7 +180,26 @@ func resourceArmSearchServiceRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	adminKeysClient := meta.(*ArmClient).searchAdminKeysClient
+	adminKeys, err := adminKeysClient.Get(ctx, resourceGroup, name, nil)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Admin Keys for Search Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("primary_key", adminKeys.PrimaryKey)
+	d.Set("secondary_key", adminKeys.SecondaryKey)
+
+	queryKeysClient := meta.(*ArmClient).searchQueryKeysClient
+	queryKeys, err := queryKeysClient.ListBySearchService(ctx, resourceGroup, name, nil)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Query Keys for Search Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := d.Set("query_keys", flattenSearchQueryKeys(queryKeys.Value)); err != nil {
+		return fmt.Errorf("Error setting `query_keys`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -177,3 +227,26 @@ func resourceArmSearchServiceDelete(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+func flattenSearchQueryKeys(input *[]search.QueryKey) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := make(map[string]interface{})
+
+		if v.Name != nil {
+			output["name"] = *v.Name
+		}
+
+		if v.Key != nil {
+			output["key"] = *v.Key
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
@@ -0,0 +1,124 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSecurityCenterContact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSecurityCenterContactCreateUpdate,
+		Read:   resourceArmSecurityCenterContactRead,
+		Update: resourceArmSecurityCenterContactCreateUpdate,
+		Delete: resourceArmSecurityCenterContactDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"phone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"alert_notifications": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"alerts_to_admins": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenterClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	id := securityCenterResourceID(subscriptionId, "securityContacts", securityCenterContactName)
+	contact := securityCenterContact{
+		Properties: &securityCenterContactProperties{
+			Email:              utils.String(d.Get("email").(string)),
+			AlertNotifications: securityCenterFlag(d.Get("alert_notifications").(bool)),
+			AlertsToAdmins:     securityCenterFlag(d.Get("alerts_to_admins").(bool)),
+		},
+	}
+
+	if v, ok := d.GetOk("phone"); ok {
+		contact.Properties.Phone = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id, contact)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating the Security Center Contact for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for the Security Center Contact for Subscription %q to be created/updated: %+v", subscriptionId, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmSecurityCenterContactRead(d, meta)
+}
+
+func resourceArmSecurityCenterContactRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenterClient
+	ctx := meta.(*ArmClient).StopContext
+
+	var contact securityCenterContact
+	resp, err := client.Get(ctx, d.Id(), &contact)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Security Center Contact %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Security Center Contact %q: %+v", d.Id(), err)
+	}
+
+	if props := contact.Properties; props != nil {
+		d.Set("email", props.Email)
+		d.Set("phone", props.Phone)
+		d.Set("alert_notifications", props.AlertNotifications == "On")
+		d.Set("alerts_to_admins", props.AlertsToAdmins == "On")
+	}
+
+	return nil
+}
+
+func resourceArmSecurityCenterContactDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenterClient
+	ctx := meta.(*ArmClient).StopContext
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Security Center Contact %q: %+v", d.Id(), err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Security Center Contact %q to be deleted: %+v", d.Id(), err)
+	}
+
+	return nil
+}
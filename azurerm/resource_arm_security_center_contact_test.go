@@ -0,0 +1,141 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSecurityCenterContact_basic(t *testing.T) {
+	_, exists := os.LookupEnv("TF_ACC_SUBSCRIPTION_PARALLEL_LOCK")
+	if !exists {
+		t.Skip("`TF_ACC_SUBSCRIPTION_PARALLEL_LOCK` isn't specified - skipping since this test can't be run in Parallel")
+	}
+
+	resourceName := "azurerm_security_center_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSecurityCenterContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSecurityCenterContact_template("basic@example.com", "", true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSecurityCenterContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "email", "basic@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "phone", ""),
+					resource.TestCheckResourceAttr(resourceName, "alert_notifications", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alerts_to_admins", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSecurityCenterContact_update(t *testing.T) {
+	_, exists := os.LookupEnv("TF_ACC_SUBSCRIPTION_PARALLEL_LOCK")
+	if !exists {
+		t.Skip("`TF_ACC_SUBSCRIPTION_PARALLEL_LOCK` isn't specified - skipping since this test can't be run in Parallel")
+	}
+
+	resourceName := "azurerm_security_center_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSecurityCenterContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSecurityCenterContact_template("basic@example.com", "+1-555-555-5555", true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSecurityCenterContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "email", "basic@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "phone", "+1-555-555-5555"),
+					resource.TestCheckResourceAttr(resourceName, "alert_notifications", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alerts_to_admins", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMSecurityCenterContact_template("updated@example.com", "+1-555-678-6789", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSecurityCenterContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "email", "updated@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "phone", "+1-555-678-6789"),
+					resource.TestCheckResourceAttr(resourceName, "alert_notifications", "false"),
+					resource.TestCheckResourceAttr(resourceName, "alerts_to_admins", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSecurityCenterContactExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).securityCenterClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var contact securityCenterContact
+		resp, err := client.Get(ctx, rs.Primary.ID, &contact)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Security Center Contact %q was not found", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on securityCenterClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSecurityCenterContactDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).securityCenterClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_security_center_contact" {
+			continue
+		}
+
+		var contact securityCenterContact
+		resp, err := client.Get(ctx, rs.Primary.ID, &contact)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Security Center Contact %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSecurityCenterContact_template(email, phone string, notifications, adminAlerts bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_security_center_contact" "test" {
+  email = "%s"
+  phone = "%s"
+
+  alert_notifications = %t
+  alerts_to_admins    = %t
+}
+`, email, phone, notifications, adminAlerts)
+}
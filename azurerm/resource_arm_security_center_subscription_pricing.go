@@ -0,0 +1,88 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSecurityCenterSubscriptionPricing() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSecurityCenterSubscriptionPricingCreateUpdate,
+		Read:   resourceArmSecurityCenterSubscriptionPricingRead,
+		Update: resourceArmSecurityCenterSubscriptionPricingCreateUpdate,
+		Delete: resourceArmSecurityCenterSubscriptionPricingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Free",
+					"Standard",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceArmSecurityCenterSubscriptionPricingCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenterClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	id := securityCenterResourceID(subscriptionId, "pricings", securityCenterPricingName)
+	pricing := securityCenterPricing{
+		Properties: &securityCenterPricingProperties{
+			PricingTier: d.Get("tier").(string),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id, pricing)
+	if err != nil {
+		return fmt.Errorf("Error setting the Security Center Pricing for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for the Security Center Pricing for Subscription %q to be set: %+v", subscriptionId, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmSecurityCenterSubscriptionPricingRead(d, meta)
+}
+
+func resourceArmSecurityCenterSubscriptionPricingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenterClient
+	ctx := meta.(*ArmClient).StopContext
+
+	var pricing securityCenterPricing
+	resp, err := client.Get(ctx, d.Id(), &pricing)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Security Center Pricing %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Security Center Pricing %q: %+v", d.Id(), err)
+	}
+
+	if props := pricing.Properties; props != nil {
+		d.Set("tier", props.PricingTier)
+	}
+
+	return nil
+}
+
+func resourceArmSecurityCenterSubscriptionPricingDelete(_ *schema.ResourceData, _ interface{}) error {
+	// the Pricing can't be deleted - only changed between the `Free` and `Standard` tiers
+	log.Printf("[DEBUG] Security Center Subscription Pricing deletion invoked - removing from state only")
+	return nil
+}
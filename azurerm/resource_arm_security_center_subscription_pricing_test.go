@@ -0,0 +1,78 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSecurityCenterSubscriptionPricing_update(t *testing.T) {
+	_, exists := os.LookupEnv("TF_ACC_SUBSCRIPTION_PARALLEL_LOCK")
+	if !exists {
+		t.Skip("`TF_ACC_SUBSCRIPTION_PARALLEL_LOCK` isn't specified - skipping since this test can't be run in Parallel")
+	}
+
+	resourceName := "azurerm_security_center_subscription_pricing.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSecurityCenterSubscriptionPricing_tier("Standard"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSecurityCenterSubscriptionPricingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Standard"),
+				),
+			},
+			{
+				Config: testAccAzureRMSecurityCenterSubscriptionPricing_tier("Free"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSecurityCenterSubscriptionPricingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Free"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSecurityCenterSubscriptionPricingExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).securityCenterClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var pricing securityCenterPricing
+		resp, err := client.Get(ctx, rs.Primary.ID, &pricing)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Security Center Subscription Pricing %q was not found", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on securityCenterClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMSecurityCenterSubscriptionPricing_tier(tier string) string {
+	return fmt.Sprintf(`
+resource "azurerm_security_center_subscription_pricing" "test" {
+  tier = "%s"
+}
+`, tier)
+}
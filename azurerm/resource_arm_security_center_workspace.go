@@ -0,0 +1,153 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSecurityCenterWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSecurityCenterWorkspaceCreateUpdate,
+		Read:   resourceArmSecurityCenterWorkspaceRead,
+		Update: resourceArmSecurityCenterWorkspaceCreateUpdate,
+		Delete: resourceArmSecurityCenterWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenterClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	// a Workspace can only be configured when the Subscription is using the `Standard` tier
+	var pricing securityCenterPricing
+	pricingId := securityCenterResourceID(subscriptionId, "pricings", securityCenterPricingName)
+	if _, err := client.Get(ctx, pricingId, &pricing); err != nil {
+		return fmt.Errorf("Error retrieving the Security Center Pricing for Subscription %q: %+v", subscriptionId, err)
+	}
+	if pricing.Properties == nil || !strings.EqualFold(pricing.Properties.PricingTier, "Standard") {
+		return fmt.Errorf("Security Center Subscription Workspace can only be configured when the Subscription is using the `Standard` tier")
+	}
+
+	id := securityCenterResourceID(subscriptionId, "workspaceSettings", securityCenterWorkspaceName)
+	setting := securityCenterWorkspaceSetting{
+		Properties: &securityCenterWorkspaceSettingProperties{
+			Scope:       utils.String(d.Get("scope").(string)),
+			WorkspaceID: utils.String(d.Get("workspace_id").(string)),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id, setting)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating the Security Center Workspace for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for the Security Center Workspace for Subscription %q to be created/updated: %+v", subscriptionId, err)
+	}
+
+	// the Workspace Setting isn't returned immediately after it's been created
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"404"},
+		Target:     []string{"200"},
+		Refresh:    securityCenterWorkspaceRefreshFunc(meta, id),
+		Timeout:    30 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the Security Center Workspace for Subscription %q to become available: %+v", subscriptionId, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmSecurityCenterWorkspaceRead(d, meta)
+}
+
+func resourceArmSecurityCenterWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenterClient
+	ctx := meta.(*ArmClient).StopContext
+
+	var setting securityCenterWorkspaceSetting
+	resp, err := client.Get(ctx, d.Id(), &setting)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Security Center Workspace %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Security Center Workspace %q: %+v", d.Id(), err)
+	}
+
+	if props := setting.Properties; props != nil {
+		d.Set("scope", props.Scope)
+		d.Set("workspace_id", props.WorkspaceID)
+	}
+
+	return nil
+}
+
+func resourceArmSecurityCenterWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenterClient
+	ctx := meta.(*ArmClient).StopContext
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Security Center Workspace %q: %+v", d.Id(), err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Security Center Workspace %q to be deleted: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func securityCenterWorkspaceRefreshFunc(meta interface{}, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client := meta.(*ArmClient).securityCenterClient
+		ctx := meta.(*ArmClient).StopContext
+
+		var setting securityCenterWorkspaceSetting
+		resp, err := client.Get(ctx, id, &setting)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return resp, "404", nil
+			}
+
+			return nil, "", fmt.Errorf("Error retrieving Security Center Workspace %q: %+v", id, err)
+		}
+
+		return setting, "200", nil
+	}
+}
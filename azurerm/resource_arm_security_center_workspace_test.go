@@ -0,0 +1,136 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSecurityCenterWorkspace_basic(t *testing.T) {
+	_, exists := os.LookupEnv("TF_ACC_SUBSCRIPTION_PARALLEL_LOCK")
+	if !exists {
+		t.Skip("`TF_ACC_SUBSCRIPTION_PARALLEL_LOCK` isn't specified - skipping since this test can't be run in Parallel")
+	}
+
+	resourceName := "azurerm_security_center_workspace.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSecurityCenterWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSecurityCenterWorkspace_basic(ri, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSecurityCenterWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "scope"),
+					resource.TestCheckResourceAttrSet(resourceName, "workspace_id"),
+				),
+			},
+			{
+				Config: testAccAzureRMSecurityCenterWorkspace_basic(ri, location, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSecurityCenterWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", "azurerm_log_analytics_workspace.test2", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSecurityCenterWorkspaceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).securityCenterClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var setting securityCenterWorkspaceSetting
+		resp, err := client.Get(ctx, rs.Primary.ID, &setting)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Security Center Workspace %q was not found", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on securityCenterClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSecurityCenterWorkspaceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).securityCenterClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_security_center_workspace" {
+			continue
+		}
+
+		var setting securityCenterWorkspaceSetting
+		resp, err := client.Get(ctx, rs.Primary.ID, &setting)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Security Center Workspace %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSecurityCenterWorkspace_basic(rInt int, location string, workspace int) string {
+	return fmt.Sprintf(`
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_security_center_subscription_pricing" "test" {
+  tier = "Standard"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test1" {
+  name                = "acctest-%d-1"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_workspace" "test2" {
+  name                = "acctest-%d-2"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_security_center_workspace" "test" {
+  scope        = "${data.azurerm_subscription.current.id}"
+  workspace_id = "${azurerm_log_analytics_workspace.test%d.id}"
+
+  depends_on = ["azurerm_security_center_subscription_pricing.test"]
+}
+`, rInt, location, rInt, rInt, workspace)
+}
@@ -0,0 +1,263 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSharedImage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSharedImageCreateUpdate,
+		Read:   resourceArmSharedImageRead,
+		Update: resourceArmSharedImageCreateUpdate,
+		Delete: resourceArmSharedImageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMSharedImageName,
+			},
+
+			"gallery_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMSharedImageGalleryName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Linux",
+					"Windows",
+				}, false),
+			},
+
+			"identifier": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"publisher": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"offer": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"sku": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"eula": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"privacy_statement_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"release_note_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSharedImageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sharedImageClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for Shared Image creation/update.")
+
+	name := d.Get("name").(string)
+	galleryName := d.Get("gallery_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	image := sharedImage{
+		Location: utils.String(location),
+		Tags:     expandTags(tags, meta),
+		Properties: &sharedImageProperties{
+			Description: utils.String(d.Get("description").(string)),
+			OsType:      d.Get("os_type").(string),
+			// only Generalized images can be shared in the API Version used
+			OsState:    "Generalized",
+			Identifier: expandArmSharedImageIdentifier(d.Get("identifier").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("eula"); ok {
+		image.Properties.Eula = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("privacy_statement_uri"); ok {
+		image.Properties.PrivacyStatementURI = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("release_note_uri"); ok {
+		image.Properties.ReleaseNoteURI = utils.String(v.(string))
+	}
+
+	id := sharedImageResourceID(subscriptionId, resourceGroup, galleryName, name)
+	future, err := client.CreateOrUpdate(ctx, id, image)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Shared Image %q (Gallery %q / Resource Group %q): %+v", name, galleryName, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Shared Image %q (Gallery %q / Resource Group %q): %+v", name, galleryName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmSharedImageRead(d, meta)
+}
+
+func resourceArmSharedImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sharedImageClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	galleryName := id.Path["galleries"]
+	name := id.Path["images"]
+
+	var image sharedImage
+	resp, err := client.Get(ctx, d.Id(), &image)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Shared Image %q was not found in Gallery %q / Resource Group %q - removing from state!", name, galleryName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Shared Image %q (Gallery %q / Resource Group %q): %+v", name, galleryName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("gallery_name", galleryName)
+	d.Set("resource_group_name", resourceGroup)
+	if location := image.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := image.Properties; props != nil {
+		d.Set("description", props.Description)
+		d.Set("eula", props.Eula)
+		d.Set("os_type", props.OsType)
+		d.Set("privacy_statement_uri", props.PrivacyStatementURI)
+		d.Set("release_note_uri", props.ReleaseNoteURI)
+
+		if err := d.Set("identifier", flattenArmSharedImageIdentifier(props.Identifier)); err != nil {
+			return fmt.Errorf("Error flattening `identifier`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, image.Tags, meta)
+
+	return nil
+}
+
+func resourceArmSharedImageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sharedImageClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	galleryName := id.Path["galleries"]
+	name := id.Path["images"]
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Shared Image %q (Gallery %q / Resource Group %q): %+v", name, galleryName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Shared Image %q (Gallery %q / Resource Group %q): %+v", name, galleryName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmSharedImageIdentifier(input []interface{}) *sharedImageIdentifier {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &sharedImageIdentifier{
+		Publisher: v["publisher"].(string),
+		Offer:     v["offer"].(string),
+		Sku:       v["sku"].(string),
+	}
+}
+
+func flattenArmSharedImageIdentifier(input *sharedImageIdentifier) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"publisher": input.Publisher,
+			"offer":     input.Offer,
+			"sku":       input.Sku,
+		},
+	}
+}
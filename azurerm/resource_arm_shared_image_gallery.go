@@ -0,0 +1,153 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSharedImageGallery() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSharedImageGalleryCreateUpdate,
+		Read:   resourceArmSharedImageGalleryRead,
+		Update: resourceArmSharedImageGalleryCreateUpdate,
+		Delete: resourceArmSharedImageGalleryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMSharedImageGalleryName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"unique_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSharedImageGalleryCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sharedImageClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for Shared Image Gallery creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	gallery := sharedImageGallery{
+		Location: utils.String(location),
+		Tags:     expandTags(tags, meta),
+		Properties: &sharedImageGalleryProperties{
+			Description: utils.String(d.Get("description").(string)),
+		},
+	}
+
+	id := sharedImageGalleryResourceID(subscriptionId, resourceGroup, name)
+	future, err := client.CreateOrUpdate(ctx, id, gallery)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Shared Image Gallery %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Shared Image Gallery %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmSharedImageGalleryRead(d, meta)
+}
+
+func resourceArmSharedImageGalleryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sharedImageClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["galleries"]
+
+	var gallery sharedImageGallery
+	resp, err := client.Get(ctx, d.Id(), &gallery)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Shared Image Gallery %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Shared Image Gallery %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := gallery.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := gallery.Properties; props != nil {
+		d.Set("description", props.Description)
+
+		if identifier := props.Identifier; identifier != nil {
+			d.Set("unique_name", identifier.UniqueName)
+		}
+	}
+
+	flattenAndSetTags(d, gallery.Tags, meta)
+
+	return nil
+}
+
+func resourceArmSharedImageGalleryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sharedImageClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["galleries"]
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Shared Image Gallery %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Shared Image Gallery %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
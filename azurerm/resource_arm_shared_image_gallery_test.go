@@ -0,0 +1,196 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSharedImageGallery_basic(t *testing.T) {
+	resourceName := "azurerm_shared_image_gallery.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSharedImageGallery_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSharedImageGalleryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSharedImageResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "unique_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSharedImageGallery_complete(t *testing.T) {
+	resourceName := "azurerm_shared_image_gallery.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSharedImageGalleryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSharedImageGallery_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSharedImageResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMSharedImageGallery_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSharedImageResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Shared images and things."),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Hello", "There"),
+				),
+			},
+		},
+	})
+}
+
+// testCheckAzureRMSharedImageResourceExists confirms the Shared Image Gallery resource exists,
+// which is used for the Gallery, Images and Image Versions
+func testCheckAzureRMSharedImageResourceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).sharedImageClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var result map[string]interface{}
+		resp, err := client.Get(ctx, rs.Primary.ID, &result)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on sharedImageClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSharedImageGalleryDestroy(s *terraform.State) error {
+	return testCheckAzureRMSharedImageResourceDestroy(s, "azurerm_shared_image_gallery")
+}
+
+// testCheckAzureRMSharedImageResourceDestroy confirms each Shared Image Gallery resource of the given type has been removed
+func testCheckAzureRMSharedImageResourceDestroy(s *terraform.State, resourceType string) error {
+	client := testAccProvider.Meta().(*ArmClient).sharedImageClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		var result map[string]interface{}
+		resp, err := client.Get(ctx, rs.Primary.ID, &result)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("%s %q still exists", resourceType, rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func TestValidateAzureRMSharedImageGalleryName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "gallery",
+			ErrCount: 0,
+		},
+		{
+			Value:    "my_image.gallery1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "my-gallery",
+			ErrCount: 1,
+		},
+		{
+			Value:    "_gallery",
+			ErrCount: 1,
+		},
+		{
+			Value:    "gallery.",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAzureRMSharedImageGalleryName(tc.Value, "name")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func testAccAzureRMSharedImageGallery_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_shared_image_gallery" "test" {
+  name                = "acctestsig%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMSharedImageGallery_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_shared_image_gallery" "test" {
+  name                = "acctestsig%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  description         = "Shared images and things."
+
+  tags {
+    Hello = "There"
+    World = "Example"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,116 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMSharedImage_basic(t *testing.T) {
+	resourceName := "azurerm_shared_image.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSharedImage_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSharedImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSharedImageResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "os_type", "Linux"),
+					resource.TestCheckResourceAttr(resourceName, "identifier.0.publisher", fmt.Sprintf("AccTesPublisher%d", ri)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSharedImage_complete(t *testing.T) {
+	resourceName := "azurerm_shared_image.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSharedImage_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSharedImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSharedImageResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Wubba lubba dub dub"),
+					resource.TestCheckResourceAttr(resourceName, "eula", "Do you agree there's infinite Rick's and Infinite Morty's?"),
+					resource.TestCheckResourceAttr(resourceName, "privacy_statement_uri", "https://council.of.ricks/privacy-statement"),
+					resource.TestCheckResourceAttr(resourceName, "release_note_uri", "https://council.of.ricks/changelog.md"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSharedImageDestroy(s *terraform.State) error {
+	return testCheckAzureRMSharedImageResourceDestroy(s, "azurerm_shared_image")
+}
+
+func testAccAzureRMSharedImage_basic(rInt int, location string) string {
+	template := testAccAzureRMSharedImageGallery_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image" "test" {
+  name                = "acctestimg%d"
+  gallery_name        = "${azurerm_shared_image_gallery.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  os_type             = "Linux"
+
+  identifier {
+    publisher = "AccTesPublisher%d"
+    offer     = "AccTesOffer%d"
+    sku       = "AccTesSku%d"
+  }
+}
+`, template, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMSharedImage_complete(rInt int, location string) string {
+	template := testAccAzureRMSharedImageGallery_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image" "test" {
+  name                  = "acctestimg%d"
+  gallery_name          = "${azurerm_shared_image_gallery.test.name}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  location              = "${azurerm_resource_group.test.location}"
+  os_type               = "Linux"
+  description           = "Wubba lubba dub dub"
+  eula                  = "Do you agree there's infinite Rick's and Infinite Morty's?"
+  privacy_statement_uri = "https://council.of.ricks/privacy-statement"
+  release_note_uri      = "https://council.of.ricks/changelog.md"
+
+  identifier {
+    publisher = "AccTesPublisher%d"
+    offer     = "AccTesOffer%d"
+    sku       = "AccTesSku%d"
+  }
+}
+`, template, rInt, rInt, rInt, rInt)
+}
@@ -0,0 +1,264 @@
+package azurerm
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSharedImageVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSharedImageVersionCreateUpdate,
+		Read:   resourceArmSharedImageVersionRead,
+		Update: resourceArmSharedImageVersionCreateUpdate,
+		Delete: resourceArmSharedImageVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMSharedImageVersionName,
+			},
+
+			"gallery_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMSharedImageGalleryName,
+			},
+
+			"image_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMSharedImageName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"managed_image_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"target_region": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        azureRMNormalizeLocation,
+							DiffSuppressFunc: azureRMSuppressLocationDiff,
+						},
+
+						"regional_replica_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+					},
+				},
+				Set: resourceArmSharedImageVersionTargetRegionHash,
+			},
+
+			"exclude_from_latest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSharedImageVersionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sharedImageClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for Shared Image Version creation/update.")
+
+	name := d.Get("name").(string)
+	imageName := d.Get("image_name").(string)
+	galleryName := d.Get("gallery_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	version := sharedImageVersion{
+		Location: utils.String(location),
+		Tags:     expandTags(tags, meta),
+		Properties: &sharedImageVersionProperties{
+			PublishingProfile: &sharedImageVersionPublishingProfile{
+				Source: &sharedImageVersionSource{
+					ManagedImage: &sharedImageVersionManagedImage{
+						ID: d.Get("managed_image_id").(string),
+					},
+				},
+				TargetRegions:     expandArmSharedImageVersionTargetRegions(d.Get("target_region").(*schema.Set).List()),
+				ExcludeFromLatest: utils.Bool(d.Get("exclude_from_latest").(bool)),
+			},
+		},
+	}
+
+	id := sharedImageVersionResourceID(subscriptionId, resourceGroup, galleryName, imageName, name)
+	future, err := client.CreateOrUpdate(ctx, id, version)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", name, imageName, galleryName, resourceGroup, err)
+	}
+
+	// replicating the image to each of the Target Regions can take some time
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", name, imageName, galleryName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmSharedImageVersionRead(d, meta)
+}
+
+func resourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sharedImageClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	galleryName := id.Path["galleries"]
+	imageName := id.Path["images"]
+	name := id.Path["versions"]
+
+	var version sharedImageVersion
+	resp, err := client.Get(ctx, d.Id(), &version)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Shared Image Version %q was not found in Image %q / Gallery %q / Resource Group %q - removing from state!", name, imageName, galleryName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", name, imageName, galleryName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("image_name", imageName)
+	d.Set("gallery_name", galleryName)
+	d.Set("resource_group_name", resourceGroup)
+	if location := version.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := version.Properties; props != nil {
+		if profile := props.PublishingProfile; profile != nil {
+			if source := profile.Source; source != nil && source.ManagedImage != nil {
+				d.Set("managed_image_id", source.ManagedImage.ID)
+			}
+
+			d.Set("exclude_from_latest", profile.ExcludeFromLatest)
+
+			targetRegions := flattenArmSharedImageVersionTargetRegions(profile.TargetRegions)
+			if err := d.Set("target_region", targetRegions); err != nil {
+				return fmt.Errorf("Error flattening `target_region`: %+v", err)
+			}
+		}
+	}
+
+	flattenAndSetTags(d, version.Tags, meta)
+
+	return nil
+}
+
+func resourceArmSharedImageVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sharedImageClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	galleryName := id.Path["galleries"]
+	imageName := id.Path["images"]
+	name := id.Path["versions"]
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", name, imageName, galleryName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", name, imageName, galleryName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmSharedImageVersionTargetRegions(input []interface{}) []sharedImageTargetRegion {
+	results := make([]sharedImageTargetRegion, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		results = append(results, sharedImageTargetRegion{
+			Name:                 azureRMNormalizeLocation(v["name"].(string)),
+			RegionalReplicaCount: utils.Int32(int32(v["regional_replica_count"].(int))),
+		})
+	}
+
+	return results
+}
+
+func flattenArmSharedImageVersionTargetRegions(input []sharedImageTargetRegion) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, region := range input {
+		replicaCount := 0
+		if region.RegionalReplicaCount != nil {
+			replicaCount = int(*region.RegionalReplicaCount)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                   azureRMNormalizeLocation(region.Name),
+			"regional_replica_count": replicaCount,
+		})
+	}
+
+	return results
+}
+
+func resourceArmSharedImageVersionTargetRegionHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", azureRMNormalizeLocation(m["name"].(string))))
+		buf.WriteString(fmt.Sprintf("%d-", m["regional_replica_count"].(int)))
+	}
+
+	return hashcode.String(buf.String())
+}
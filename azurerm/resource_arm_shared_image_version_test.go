@@ -0,0 +1,212 @@
+package azurerm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSharedImageVersion_basic(t *testing.T) {
+	resourceName := "azurerm_shared_image_version.test"
+	ri := acctest.RandInt()
+	resourceGroup := fmt.Sprintf("acctestRG-%d", ri)
+	userName := "testadmin"
+	password := "Password1234!"
+	hostName := fmt.Sprintf("tftestcustomimagesrc%d", ri)
+	sshPort := "22"
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSharedImageVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				// need to create a vm and then reference it in the image creation
+				Config:  testAccAzureRMImage_standaloneImage_setup(ri, userName, password, hostName, location),
+				Destroy: false,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureVMExists("azurerm_virtual_machine.testsource", true),
+					testGeneralizeVMImage(resourceGroup, "testsource", userName, password, hostName, sshPort, location),
+				),
+			},
+			{
+				Config: testAccAzureRMSharedImageVersion_basic(ri, userName, password, hostName, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSharedImageResourceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "managed_image_id"),
+					resource.TestCheckResourceAttr(resourceName, "target_region.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMSharedImageVersion_multipleRegions(ri, userName, password, hostName, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSharedImageResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_region.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSharedImageVersionDestroy(s *terraform.State) error {
+	return testCheckAzureRMSharedImageResourceDestroy(s, "azurerm_shared_image_version")
+}
+
+func TestExpandArmSharedImageVersionTargetRegions(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":                   "West Europe",
+			"regional_replica_count": 5,
+		},
+		map[string]interface{}{
+			"name":                   "northeurope",
+			"regional_replica_count": 2,
+		},
+	}
+
+	expected := []sharedImageTargetRegion{
+		{
+			Name:                 "westeurope",
+			RegionalReplicaCount: utils.Int32(5),
+		},
+		{
+			Name:                 "northeurope",
+			RegionalReplicaCount: utils.Int32(2),
+		},
+	}
+
+	actual := expandArmSharedImageVersionTargetRegions(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	flattened := flattenArmSharedImageVersionTargetRegions(actual)
+	if len(flattened) != 2 {
+		t.Fatalf("Expected 2 Target Regions but got %d", len(flattened))
+	}
+
+	if region := flattened[0].(map[string]interface{}); region["name"] != "westeurope" || region["regional_replica_count"] != 5 {
+		t.Fatalf("Expected the first Target Region to be `westeurope` with 5 replicas but got %+v", region)
+	}
+}
+
+func TestValidateAzureRMSharedImageVersionName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "0.0.1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1.22.333",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1.0",
+			ErrCount: 1,
+		},
+		{
+			Value:    "latest",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1.0.0-beta",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAzureRMSharedImageVersionName(tc.Value, "name")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func testAccAzureRMSharedImageVersion_template(rInt int, userName string, password string, hostName string, location string) string {
+	template := testAccAzureRMImage_standaloneImage_provision(rInt, userName, password, hostName, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_gallery" "test" {
+  name                = "acctestsig%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_shared_image" "test" {
+  name                = "acctestimg%d"
+  gallery_name        = "${azurerm_shared_image_gallery.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  os_type             = "Linux"
+
+  identifier {
+    publisher = "AccTesPublisher%d"
+    offer     = "AccTesOffer%d"
+    sku       = "AccTesSku%d"
+  }
+}
+`, template, rInt, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMSharedImageVersion_basic(rInt int, userName string, password string, hostName string, location string) string {
+	template := testAccAzureRMSharedImageVersion_template(rInt, userName, password, hostName, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version" "test" {
+  name                = "0.0.1"
+  gallery_name        = "${azurerm_shared_image_gallery.test.name}"
+  image_name          = "${azurerm_shared_image.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  managed_image_id    = "${azurerm_image.test.id}"
+
+  target_region {
+    name                   = "${azurerm_resource_group.test.location}"
+    regional_replica_count = 1
+  }
+}
+`, template)
+}
+
+func testAccAzureRMSharedImageVersion_multipleRegions(rInt int, userName string, password string, hostName string, location string, altLocation string) string {
+	template := testAccAzureRMSharedImageVersion_template(rInt, userName, password, hostName, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version" "test" {
+  name                = "0.0.1"
+  gallery_name        = "${azurerm_shared_image_gallery.test.name}"
+  image_name          = "${azurerm_shared_image.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  managed_image_id    = "${azurerm_image.test.id}"
+
+  target_region {
+    name                   = "${azurerm_resource_group.test.location}"
+    regional_replica_count = 1
+  }
+
+  target_region {
+    name                   = "%s"
+    regional_replica_count = 2
+  }
+}
+`, template, altLocation)
+}
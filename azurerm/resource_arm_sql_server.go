@@ -81,7 +81,7 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 	version := d.Get("version").(string)
 
 	tags := d.Get("tags").(map[string]interface{})
-	metadata := expandTags(tags)
+	metadata := expandTags(tags, meta)
 
 	parameters := sql.Server{
 		Location: utils.String(location),
@@ -98,7 +98,9 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Sql", "servers", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 
 		if response.WasConflict(future.Response()) {
@@ -153,7 +155,7 @@ func resourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("fully_qualified_domain_name", serverProperties.FullyQualifiedDomainName)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -175,7 +177,7 @@ func resourceArmSqlServerDelete(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error deleting SQL Server %s: %+v", name, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return err
 	}
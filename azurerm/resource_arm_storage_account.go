@@ -347,7 +347,7 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		Sku: &storage.Sku{
 			Name: storage.SkuName(storageType),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 		Kind: storage.Kind(accountKind),
 		AccountPropertiesCreateParameters: &storage.AccountPropertiesCreateParameters{
 			Encryption: &storage.Encryption{
@@ -399,7 +399,9 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error creating Azure Storage Account %q: %+v", storageAccountName, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroupName, "Microsoft.Storage", "storageAccounts", storageAccountName)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error waiting for Azure Storage Account %q to be created: %+v", storageAccountName, err)
 	}
@@ -478,11 +480,11 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("access_tier")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 		tags := d.Get("tags").(map[string]interface{})
 
 		opts := storage.AccountUpdateParameters{
-			Tags: expandTags(tags),
+			Tags: expandTags(tags, meta),
 		}
 		_, err := client.Update(ctx, resourceGroupName, storageAccountName, opts)
 		if err != nil {
@@ -716,7 +718,7 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"regexp"
 	"testing"
@@ -9,8 +10,70 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_storage_account", &resource.Sweeper{
+		Name: "azurerm_storage_account",
+		F:    testSweepStorageAccounts,
+		Dependencies: []string{
+			"azurerm_virtual_machine",
+			"azurerm_virtual_machine_scale_set",
+		},
+	})
+}
+
+func testSweepStorageAccounts(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := (*armClient).storageServiceClient
+	ctx := (*armClient).StopContext
+
+	log.Printf("Retrieving the Storage Accounts..")
+	results, err := client.List(ctx)
+	if err != nil {
+		return fmt.Errorf("Error Listing on Storage Accounts: %+v", err)
+	}
+
+	if results.Value == nil {
+		return nil
+	}
+
+	for _, account := range *results.Value {
+		if account.ID == nil || account.Name == nil || account.Location == nil {
+			continue
+		}
+
+		id, err := parseAzureResourceID(*account.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing Azure Resource ID %q", *account.ID)
+		}
+
+		resourceGroupName := id.ResourceGroup
+		name := *account.Name
+
+		if !shouldSweepAcceptanceTestResource(name, *account.Location, region) {
+			continue
+		}
+
+		log.Printf("Deleting Storage Account %q", name)
+		resp, err := client.Delete(ctx, resourceGroupName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				continue
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestValidateArmStorageAccountType(t *testing.T) {
 	testCases := []struct {
 		input       string
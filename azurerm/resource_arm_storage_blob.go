@@ -2,8 +2,10 @@ package azurerm
 
 import (
 	"bytes"
+	"crypto/md5"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
@@ -12,10 +14,13 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
 func resourceArmStorageBlob() *schema.Resource {
@@ -29,6 +34,7 @@ func resourceArmStorageBlob() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceArmStorageBlobCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -66,6 +72,24 @@ func resourceArmStorageBlob() *schema.Resource {
 				Default:       "application/octet-stream",
 				ConflictsWith: []string{"source_uri"},
 			},
+			"content_encoding": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source_uri"},
+			},
+			"cache_control": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source_uri"},
+			},
+			"content_md5": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_uri"},
+				ValidateFunc:  validateArmStorageBlobContentMD5,
+			},
 			"source": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -77,6 +101,7 @@ func resourceArmStorageBlob() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"source"},
+				ValidateFunc:  validate.URLIsHTTPOrHTTPS,
 			},
 			"url": {
 				Type:     schema.TypeString,
@@ -130,6 +155,16 @@ func validateArmStorageBlobSize(v interface{}, k string) (ws []string, errors []
 	return
 }
 
+func validateArmStorageBlobContentMD5(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if decoded, err := hex.DecodeString(value); err != nil || len(decoded) != md5.Size {
+		errors = append(errors, fmt.Errorf("%q must be a hex-encoded MD5 hash (such as the result of the `md5` function), got %q", k, value))
+	}
+
+	return
+}
+
 func validateArmStorageBlobType(v interface{}, k string) (ws []string, errors []error) {
 	value := strings.ToLower(v.(string))
 	validTypes := map[string]struct{}{
@@ -163,51 +198,50 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	blobType := d.Get("type").(string)
 	containerName := d.Get("storage_container_name").(string)
 	sourceUri := d.Get("source_uri").(string)
-	contentType := d.Get("content_type").(string)
+	source := d.Get("source").(string)
+	properties := expandArmStorageBlobProperties(d)
+	expectedMD5 := d.Get("content_md5").(string)
 
 	log.Printf("[INFO] Creating blob %q in container %q within storage account %q", name, containerName, storageAccountName)
 	container := blobClient.GetContainerReference(containerName)
 	blob := container.GetBlobReference(name)
 
 	if sourceUri != "" {
-		options := &storage.CopyOptions{}
-		err := blob.Copy(sourceUri, options)
-		if err != nil {
+		if err := resourceArmStorageBlobCopyFromSourceUri(blob, sourceUri); err != nil {
 			return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 		}
 	} else {
 		switch strings.ToLower(blobType) {
 		case "block":
-			options := &storage.PutBlobOptions{}
-			err := blob.CreateBlockBlob(options)
-			if err != nil {
-				return fmt.Errorf("Error creating storage blob on Azure: %s", err)
-			}
-
-			source := d.Get("source").(string)
 			if source != "" {
 				parallelism := d.Get("parallelism").(int)
 				attempts := d.Get("attempts").(int)
 
-				if err := resourceArmStorageBlobBlockUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
+				if err := resourceArmStorageBlobBlockUploadFromSource(containerName, name, source, expectedMD5, properties, blobClient, parallelism, attempts); err != nil {
+					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+				}
+			} else {
+				options := &storage.PutBlobOptions{}
+				blob.Properties = properties
+				err := blob.CreateBlockBlob(options)
+				if err != nil {
 					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 				}
 			}
 		case "page":
-			source := d.Get("source").(string)
 			if source != "" {
 				parallelism := d.Get("parallelism").(int)
 				attempts := d.Get("attempts").(int)
 
-				if err := resourceArmStorageBlobPageUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
+				if err := resourceArmStorageBlobPageUploadFromSource(containerName, name, source, expectedMD5, properties, blobClient, parallelism, attempts); err != nil {
 					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 				}
 			} else {
 				size := int64(d.Get("size").(int))
 				options := &storage.PutBlobOptions{}
 
+				blob.Properties = properties
 				blob.Properties.ContentLength = size
-				blob.Properties.ContentType = contentType
 				err := blob.PutPageBlob(options)
 				if err != nil {
 					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
@@ -227,7 +261,7 @@ type resourceArmStorageBlobPage struct {
 	section *io.SectionReader
 }
 
-func resourceArmStorageBlobPageUploadFromSource(container, name, source, contentType string, client *storage.BlobStorageClient, parallelism, attempts int) error {
+func resourceArmStorageBlobPageUploadFromSource(container, name, source, expectedMD5 string, properties storage.BlobProperties, client *storage.BlobStorageClient, parallelism, attempts int) error {
 	workerCount := parallelism * runtime.NumCPU()
 
 	file, err := os.Open(source)
@@ -236,16 +270,23 @@ func resourceArmStorageBlobPageUploadFromSource(container, name, source, content
 	}
 	defer file.Close()
 
-	blobSize, pageList, err := resourceArmStorageBlobPageSplit(file)
+	blobSize, pageList, contentMD5, err := resourceArmStorageBlobPageSplit(file)
 	if err != nil {
 		return fmt.Errorf("Error splitting source file %q into pages: %s", source, err)
 	}
 
+	// the whole file is read when splitting it into pages, so it's checked before anything is uploaded
+	if err := resourceArmStorageBlobCheckContentMD5(source, expectedMD5, contentMD5); err != nil {
+		return err
+	}
+	properties.ContentMD5, _ = hexToBase64(contentMD5)
+
 	options := &storage.PutBlobOptions{}
 	containerRef := client.GetContainerReference(container)
 	blob := containerRef.GetBlobReference(name)
+	blob.Properties = properties
+	blob.Properties.ContentMD5 = ""
 	blob.Properties.ContentLength = blobSize
-	blob.Properties.ContentType = contentType
 	err = blob.PutPageBlob(options)
 	if err != nil {
 		return fmt.Errorf("Error creating storage blob on Azure: %s", err)
@@ -283,10 +324,18 @@ func resourceArmStorageBlobPageUploadFromSource(container, name, source, content
 		return fmt.Errorf("Error while uploading source file %q: %s", source, <-errors)
 	}
 
+	// the MD5 hash is set once the pages have been written, since it'd otherwise be checked against the empty blob
+	blob.Properties.ContentMD5 = properties.ContentMD5
+	if err := blob.SetProperties(&storage.SetBlobPropertiesOptions{}); err != nil {
+		return fmt.Errorf("Error setting the MD5 hash for source file %q: %s", source, err)
+	}
+
 	return nil
 }
 
-func resourceArmStorageBlobPageSplit(file *os.File) (int64, []resourceArmStorageBlobPage, error) {
+// resourceArmStorageBlobPageSplit splits the file into the ranges of pages which contain data (since empty pages don't
+// need to be uploaded) - returning the size of the file, the pages and the hex-encoded MD5 hash of the file
+func resourceArmStorageBlobPageSplit(file *os.File) (int64, []resourceArmStorageBlobPage, string, error) {
 	const (
 		minPageSize int64 = 4 * 1024
 		maxPageSize int64 = 4 * 1024 * 1024
@@ -294,7 +343,7 @@ func resourceArmStorageBlobPageSplit(file *os.File) (int64, []resourceArmStorage
 
 	info, err := file.Stat()
 	if err != nil {
-		return int64(0), nil, fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+		return int64(0), nil, "", fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
 	}
 
 	blobSize := info.Size()
@@ -303,6 +352,7 @@ func resourceArmStorageBlobPageSplit(file *os.File) (int64, []resourceArmStorage
 	}
 
 	emptyPage := make([]byte, minPageSize)
+	hash := md5.New()
 
 	type byteRange struct {
 		offset int64
@@ -313,10 +363,11 @@ func resourceArmStorageBlobPageSplit(file *os.File) (int64, []resourceArmStorage
 	var currentRange byteRange
 	for i := int64(0); i < blobSize; i += minPageSize {
 		pageBuf := make([]byte, minPageSize)
-		_, err = file.ReadAt(pageBuf, i)
+		n, err := file.ReadAt(pageBuf, i)
 		if err != nil && err != io.EOF {
-			return int64(0), nil, fmt.Errorf("Could not read chunk at %d: %s", i, err)
+			return int64(0), nil, "", fmt.Errorf("Could not read chunk at %d: %s", i, err)
 		}
+		hash.Write(pageBuf[:n])
 
 		if bytes.Equal(pageBuf, emptyPage) {
 			if currentRange.length != 0 {
@@ -344,7 +395,7 @@ func resourceArmStorageBlobPageSplit(file *os.File) (int64, []resourceArmStorage
 		})
 	}
 
-	return info.Size(), pages, nil
+	return info.Size(), pages, hex.EncodeToString(hash.Sum(nil)), nil
 }
 
 type resourceArmStorageBlobPageUploadContext struct {
@@ -369,8 +420,8 @@ func resourceArmStorageBlobPageUploadWorker(ctx resourceArmStorageBlobPageUpload
 		size := end - start + 1
 
 		chunk := make([]byte, size)
-		_, err := page.section.Read(chunk)
-		if err != nil && err != io.EOF {
+		_, err := io.ReadFull(page.section, chunk)
+		if err != nil {
 			ctx.errors <- fmt.Errorf("Error reading source file %q at offset %d: %s", ctx.source, page.offset, err)
 			ctx.wg.Done()
 			continue
@@ -401,11 +452,18 @@ func resourceArmStorageBlobPageUploadWorker(ctx resourceArmStorageBlobPageUpload
 }
 
 type resourceArmStorageBlobBlock struct {
-	section *io.SectionReader
-	id      string
+	id   string
+	data []byte
 }
 
-func resourceArmStorageBlobBlockUploadFromSource(container, name, source, contentType string, client *storage.BlobStorageClient, parallelism, attempts int) error {
+// resourceArmStorageBlobBlockUploadFromSource streams the file into a block blob - the file is read sequentially (so
+// that the MD5 hash is computed as it's read) with the blocks uploaded concurrently, such that only the blocks being
+// uploaded are held in memory. The blocks are only committed if the MD5 hash matches the expected hash (if specified).
+func resourceArmStorageBlobBlockUploadFromSource(container, name, source, expectedMD5 string, properties storage.BlobProperties, client *storage.BlobStorageClient, parallelism, attempts int) error {
+	const (
+		idSize          = 64
+		blockSize int64 = 4 * 1024 * 1024
+	)
 	workerCount := parallelism * runtime.NumCPU()
 
 	file, err := os.Open(source)
@@ -414,21 +472,15 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 	}
 	defer file.Close()
 
-	blockList, parts, err := resourceArmStorageBlobBlockSplit(file)
-	if err != nil {
-		return fmt.Errorf("Error reading and splitting source file for upload %q: %s", source, err)
-	}
+	hash := md5.New()
+	reader := io.TeeReader(file, hash)
 
 	wg := &sync.WaitGroup{}
-	blocks := make(chan resourceArmStorageBlobBlock, len(parts))
-	errors := make(chan error, len(parts))
-
-	wg.Add(len(parts))
-	for _, p := range parts {
-		blocks <- p
-	}
-	close(blocks)
+	blocks := make(chan resourceArmStorageBlobBlock)
+	errors := make(chan error, 1)
+	done := make(chan struct{})
 
+	wg.Add(workerCount)
 	for i := 0; i < workerCount; i++ {
 		go resourceArmStorageBlobBlockUploadWorker(resourceArmStorageBlobBlockUploadContext{
 			client:    client,
@@ -437,20 +489,73 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 			name:      name,
 			blocks:    blocks,
 			errors:    errors,
+			done:      done,
 			wg:        wg,
 			attempts:  attempts,
 		})
 	}
 
+	var blockList []storage.Block
+	var readErr error
+read:
+	for {
+		buffer := make([]byte, blockSize)
+		n, err := io.ReadFull(reader, buffer)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			readErr = fmt.Errorf("Error reading source file %q: %s", source, err)
+			break
+		}
+
+		entropy := make([]byte, idSize)
+		if _, err := rand.Read(entropy); err != nil {
+			readErr = fmt.Errorf("Error generating a random block ID for source file %q: %s", source, err)
+			break
+		}
+
+		block := resourceArmStorageBlobBlock{
+			id:   base64.StdEncoding.EncodeToString(entropy),
+			data: buffer[:n],
+		}
+		blockList = append(blockList, storage.Block{
+			ID:     block.id,
+			Status: storage.BlockStatusUncommitted,
+		})
+
+		// once a block has failed to upload there's no point reading the rest of the file
+		select {
+		case blocks <- block:
+		case <-done:
+			break read
+		}
+
+		if err == io.ErrUnexpectedEOF {
+			break
+		}
+	}
+	close(blocks)
 	wg.Wait()
 
+	if readErr != nil {
+		return readErr
+	}
+
 	if len(errors) > 0 {
 		return fmt.Errorf("Error while uploading source file %q: %s", source, <-errors)
 	}
 
+	// the uploaded blocks aren't committed (and are garbage collected) if the contents don't match the expected hash
+	contentMD5 := hex.EncodeToString(hash.Sum(nil))
+	if err := resourceArmStorageBlobCheckContentMD5(source, expectedMD5, contentMD5); err != nil {
+		return err
+	}
+	properties.ContentMD5, _ = hexToBase64(contentMD5)
+
 	containerReference := client.GetContainerReference(container)
 	blobReference := containerReference.GetBlobReference(name)
-	blobReference.Properties.ContentType = contentType
+	blobReference.Properties = properties
 	options := &storage.PutBlockListOptions{}
 	err = blobReference.PutBlockList(blockList, options)
 	if err != nil {
@@ -460,48 +565,6 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 	return nil
 }
 
-func resourceArmStorageBlobBlockSplit(file *os.File) ([]storage.Block, []resourceArmStorageBlobBlock, error) {
-	const (
-		idSize          = 64
-		blockSize int64 = 4 * 1024 * 1024
-	)
-	var parts []resourceArmStorageBlobBlock
-	var blockList []storage.Block
-
-	info, err := file.Stat()
-	if err != nil {
-		return nil, nil, fmt.Errorf("Error stating source file %q: %s", file.Name(), err)
-	}
-
-	for i := int64(0); i < info.Size(); i = i + blockSize {
-		entropy := make([]byte, idSize)
-		_, err = rand.Read(entropy)
-		if err != nil {
-			return nil, nil, fmt.Errorf("Error generating a random block ID for source file %q: %s", file.Name(), err)
-		}
-
-		sectionSize := blockSize
-		remainder := info.Size() - i
-		if remainder < blockSize {
-			sectionSize = remainder
-		}
-
-		block := storage.Block{
-			ID:     base64.StdEncoding.EncodeToString(entropy),
-			Status: storage.BlockStatusUncommitted,
-		}
-
-		blockList = append(blockList, block)
-
-		parts = append(parts, resourceArmStorageBlobBlock{
-			id:      block.ID,
-			section: io.NewSectionReader(file, i, sectionSize),
-		})
-	}
-
-	return blockList, parts, nil
-}
-
 type resourceArmStorageBlobBlockUploadContext struct {
 	client    *storage.BlobStorageClient
 	container string
@@ -510,36 +573,34 @@ type resourceArmStorageBlobBlockUploadContext struct {
 	attempts  int
 	blocks    chan resourceArmStorageBlobBlock
 	errors    chan error
+	done      chan struct{}
 	wg        *sync.WaitGroup
 }
 
 func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUploadContext) {
-	for block := range ctx.blocks {
-		buffer := make([]byte, block.section.Size())
-
-		_, err := block.section.Read(buffer)
-		if err != nil {
-			ctx.errors <- fmt.Errorf("Error reading source file %q: %s", ctx.source, err)
-			ctx.wg.Done()
-			continue
-		}
+	defer ctx.wg.Done()
 
+	for block := range ctx.blocks {
+		var err error
 		for i := 0; i < ctx.attempts; i++ {
 			container := ctx.client.GetContainerReference(ctx.container)
 			blob := container.GetBlobReference(ctx.name)
 			options := &storage.PutBlockOptions{}
-			err = blob.PutBlock(block.id, buffer, options)
+			err = blob.PutBlock(block.id, block.data, options)
 			if err == nil {
 				break
 			}
 		}
 		if err != nil {
-			ctx.errors <- fmt.Errorf("Error uploading block %q for source file %q: %s", block.id, ctx.source, err)
-			ctx.wg.Done()
-			continue
+			// only the first error is kept (and signals that no further blocks should be read) - since the
+			// channel has a capacity of one, `done` is only closed once
+			select {
+			case ctx.errors <- fmt.Errorf("Error uploading block %q for source file %q: %s", block.id, ctx.source, err):
+				close(ctx.done)
+			default:
+			}
+			return
 		}
-
-		ctx.wg.Done()
 	}
 }
 
@@ -572,8 +633,17 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 	container := blobClient.GetContainerReference(id.containerName)
 	blob := container.GetBlobReference(id.blobName)
 
-	if d.HasChange("content_type") {
-		blob.Properties.ContentType = d.Get("content_type").(string)
+	// any properties which aren't specified when setting the properties are cleared (including the MD5 hash) - so the
+	// existing properties are retrieved and only those managed by Terraform are replaced
+	if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+		return fmt.Errorf("Error getting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+	}
+
+	if d.HasChange("content_type") || d.HasChange("content_encoding") || d.HasChange("cache_control") {
+		expanded := expandArmStorageBlobProperties(d)
+		blob.Properties.ContentType = expanded.ContentType
+		blob.Properties.ContentEncoding = expanded.ContentEncoding
+		blob.Properties.CacheControl = expanded.CacheControl
 	}
 
 	options := &storage.SetBlobPropertiesOptions{}
@@ -582,7 +652,7 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
 	}
 
-	return nil
+	return resourceArmStorageBlobRead(d, meta)
 }
 
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
@@ -639,6 +709,15 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("resource_group_name", resourceGroup)
 
 	d.Set("content_type", blob.Properties.ContentType)
+	d.Set("content_encoding", blob.Properties.ContentEncoding)
+	d.Set("cache_control", blob.Properties.CacheControl)
+
+	// the MD5 hash is returned base64-encoded, but exposed hex-encoded to match the `md5` interpolation function
+	contentMD5, err := base64ToHex(blob.Properties.ContentMD5)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to decode the Content MD5 %q of blob %q: %+v", blob.Properties.ContentMD5, id.blobName, err)
+	}
+	d.Set("content_md5", contentMD5)
 
 	d.Set("source_uri", blob.Properties.CopySource)
 
@@ -693,6 +772,110 @@ func resourceArmStorageBlobDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// resourceArmStorageBlobCustomizeDiff ensures `content_md5` is only specified alongside `source`, since it's the
+// hash of the file which is uploaded - the `source` file itself isn't read here, since it can be many GB in size
+func resourceArmStorageBlobCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("content_md5") {
+		return nil
+	}
+
+	contentMD5, ok := diff.GetOk("content_md5")
+	if !ok || contentMD5.(string) == "" {
+		return nil
+	}
+
+	if diff.Get("source").(string) == "" {
+		return fmt.Errorf("`content_md5` can only be specified when `source` is specified")
+	}
+
+	return nil
+}
+
+func expandArmStorageBlobProperties(d *schema.ResourceData) storage.BlobProperties {
+	return storage.BlobProperties{
+		ContentType:     d.Get("content_type").(string),
+		ContentEncoding: d.Get("content_encoding").(string),
+		CacheControl:    d.Get("cache_control").(string),
+	}
+}
+
+// resourceArmStorageBlobCopyFromSourceUri copies the blob (or file) from the Source URI server-side, waiting for the
+// copy to complete - should it fail the (partially copied) blob is removed, so that it isn't left behind
+func resourceArmStorageBlobCopyFromSourceUri(blob *storage.Blob, sourceUri string) error {
+	copyId, err := blob.StartCopy(sourceUri, &storage.CopyOptions{})
+	if err != nil {
+		return fmt.Errorf("Error starting copy from %q: %s", sourceUri, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"success"},
+		Refresh:    resourceArmStorageBlobCopyStateRefreshFunc(blob, copyId),
+		Timeout:    60 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		if abortErr := blob.AbortCopy(copyId, &storage.AbortCopyOptions{}); abortErr != nil {
+			log.Printf("[DEBUG] Unable to abort copy %q from %q (it may have already completed): %s", copyId, sourceUri, abortErr)
+		}
+
+		if _, deleteErr := blob.DeleteIfExists(&storage.DeleteBlobOptions{}); deleteErr != nil {
+			log.Printf("[DEBUG] Unable to delete blob %q following the failed copy from %q: %s", blob.Name, sourceUri, deleteErr)
+		}
+
+		return fmt.Errorf("Error waiting for copy from %q to complete: %s", sourceUri, err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageBlobCopyStateRefreshFunc(blob *storage.Blob, copyId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+			return nil, "", fmt.Errorf("Error retrieving the status of copy %q: %s", copyId, err)
+		}
+
+		if blob.Properties.CopyID != copyId {
+			return nil, "", fmt.Errorf("Expected the copy ID to be %q but got %q - another copy may have been started", copyId, blob.Properties.CopyID)
+		}
+
+		status := blob.Properties.CopyStatus
+		switch status {
+		case "pending", "success":
+			return blob, status, nil
+		}
+
+		return nil, "", fmt.Errorf("Copy %q has status %q: %s", copyId, status, blob.Properties.CopyStatusDescription)
+	}
+}
+
+// resourceArmStorageBlobCheckContentMD5 returns an error when the expected hash is specified and doesn't match
+func resourceArmStorageBlobCheckContentMD5(source, expectedMD5, contentMD5 string) error {
+	if expectedMD5 == "" || strings.EqualFold(expectedMD5, contentMD5) {
+		return nil
+	}
+
+	return fmt.Errorf("the MD5 hash of the source file %q is %q, which doesn't match the `content_md5` %q", source, contentMD5, expectedMD5)
+}
+
+func hexToBase64(input string) (string, error) {
+	decoded, err := hex.DecodeString(input)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(decoded), nil
+}
+
+func base64ToHex(input string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(decoded), nil
+}
+
 type storageBlobId struct {
 	storageAccountName string
 	containerName      string
@@ -1,10 +1,20 @@
 package azurerm
 
 import (
+	"bytes"
+	"crypto/md5"
 	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"sync"
 	"testing"
 
 	"strings"
@@ -143,6 +153,260 @@ func TestResourceAzureRMStorageBlobAttempts_validation(t *testing.T) {
 	}
 }
 
+func TestResourceAzureRMStorageBlobContentMD5_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "5d41402abc4b2a76b9719d911017c592",
+			ErrCount: 0,
+		},
+		{
+			Value:    "5D41402ABC4B2A76B9719D911017C592",
+			ErrCount: 0,
+		},
+		{
+			Value:    "XUFAKrxLKna5cZ2REBfFkg==",
+			ErrCount: 1,
+		},
+		{
+			Value:    "5d41402a",
+			ErrCount: 1,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmStorageBlobContentMD5(tc.Value, "content_md5")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestResourceAzureRMStorageBlobPageSplit(t *testing.T) {
+	source, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+	defer os.Remove(source.Name())
+
+	// an empty page, followed by a page containing `hello`
+	if _, err := source.Write(make([]byte, 4*1024)); err != nil {
+		t.Fatalf("Failed to write to source blob file")
+	}
+	if _, err := source.WriteString("hello"); err != nil {
+		t.Fatalf("Failed to write to source blob file")
+	}
+
+	size, pages, contentMD5, err := resourceArmStorageBlobPageSplit(source)
+	if err != nil {
+		t.Fatalf("Error splitting source blob file: %+v", err)
+	}
+	source.Close()
+
+	if size != 4*1024+5 {
+		t.Fatalf("Expected the size to be %d but got %d", 4*1024+5, size)
+	}
+
+	if len(pages) != 1 || pages[0].offset != 4*1024 {
+		t.Fatalf("Expected a single page at offset %d but got %+v", 4*1024, pages)
+	}
+
+	// the hash is of the contents of the file, rather than the (zero-padded) pages
+	expected := "095bf22d13a5567d82ead67f3c0a133c"
+	if contentMD5 != expected {
+		t.Fatalf("Expected the MD5 hash to be %q but got %q", expected, contentMD5)
+	}
+}
+
+func TestResourceAzureRMStorageBlobContentMD5(t *testing.T) {
+	// the API returns the hash base64-encoded
+	encoded, err := hexToBase64("5d41402abc4b2a76b9719d911017c592")
+	if err != nil {
+		t.Fatalf("Error encoding MD5 hash: %+v", err)
+	}
+	if encoded != "XUFAKrxLKna5cZ2REBfFkg==" {
+		t.Fatalf("Expected the encoded MD5 hash to be %q but got %q", "XUFAKrxLKna5cZ2REBfFkg==", encoded)
+	}
+
+	decoded, err := base64ToHex(encoded)
+	if err != nil {
+		t.Fatalf("Error decoding MD5 hash: %+v", err)
+	}
+	if decoded != "5d41402abc4b2a76b9719d911017c592" {
+		t.Fatalf("Expected the decoded MD5 hash to be %q but got %q", "5d41402abc4b2a76b9719d911017c592", decoded)
+	}
+
+	if err := resourceArmStorageBlobCheckContentMD5("example.txt", "", decoded); err != nil {
+		t.Fatalf("Expected no error when `content_md5` isn't specified but got: %+v", err)
+	}
+	if err := resourceArmStorageBlobCheckContentMD5("example.txt", "5D41402ABC4B2A76B9719D911017C592", decoded); err != nil {
+		t.Fatalf("Expected no error when `content_md5` matches but got: %+v", err)
+	}
+	if err := resourceArmStorageBlobCheckContentMD5("example.txt", "d41d8cd98f00b204e9800998ecf8427e", decoded); err == nil {
+		t.Fatalf("Expected an error when `content_md5` doesn't match")
+	}
+}
+
+type testStorageBlobBlockServer struct {
+	mutex         sync.Mutex
+	blocks        map[string][]byte
+	committed     []string
+	contentMD5    string
+	failBlocks    bool
+	putBlockLists int
+}
+
+func (s *testStorageBlobBlockServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch r.URL.Query().Get("comp") {
+	case "block":
+		if s.failBlocks {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		s.blocks[r.URL.Query().Get("blockid")] = body
+	case "blocklist":
+		var blockList struct {
+			Uncommitted []string `xml:"Uncommitted"`
+		}
+		if err := xml.Unmarshal(body, &blockList); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.committed = blockList.Uncommitted
+		s.contentMD5 = r.Header.Get("x-ms-blob-content-md5")
+		s.putBlockLists++
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+type testStorageBlobRedirectTransport struct {
+	host string
+}
+
+func (t testStorageBlobRedirectTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r.URL.Scheme = "http"
+	r.URL.Host = t.host
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestResourceAzureRMStorageBlobBlockUploadFromSource(t *testing.T) {
+	sourceBlob, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+	defer os.Remove(sourceBlob.Name())
+
+	// larger than a block, but not a multiple of the block size
+	contents := make([]byte, 9*1024*1024+123)
+	if _, err := rand.Read(contents); err != nil {
+		t.Fatalf("Failed to generate the source blob contents")
+	}
+	if _, err := sourceBlob.Write(contents); err != nil {
+		t.Fatalf("Failed to write to source blob file")
+	}
+	sourceBlob.Close()
+
+	hash := md5.Sum(contents)
+	expectedMD5 := hex.EncodeToString(hash[:])
+
+	newClient := func() (*testStorageBlobBlockServer, *storage.BlobStorageClient) {
+		handler := &testStorageBlobBlockServer{
+			blocks: make(map[string][]byte),
+		}
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+
+		client, err := storage.NewEmulatorClient()
+		if err != nil {
+			t.Fatalf("Error building storage client: %+v", err)
+		}
+		client.HTTPClient = &http.Client{
+			Transport: testStorageBlobRedirectTransport{
+				host: strings.TrimPrefix(server.URL, "http://"),
+			},
+		}
+		blobClient := client.GetBlobService()
+		return handler, &blobClient
+	}
+
+	testCases := []struct {
+		name        string
+		expectedMD5 string
+		failBlocks  bool
+		expectError bool
+	}{
+		{
+			name: "without content_md5",
+		},
+		{
+			name:        "matching content_md5",
+			expectedMD5: strings.ToUpper(expectedMD5),
+		},
+		{
+			name:        "mismatched content_md5",
+			expectedMD5: "d41d8cd98f00b204e9800998ecf8427e",
+			expectError: true,
+		},
+		{
+			name:        "failed blocks",
+			failBlocks:  true,
+			expectError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			server, client := newClient()
+			server.failBlocks = test.failBlocks
+
+			err := resourceArmStorageBlobBlockUploadFromSource("container", "blob", sourceBlob.Name(), test.expectedMD5, storage.BlobProperties{}, client, 2, 1)
+
+			server.mutex.Lock()
+			defer server.mutex.Unlock()
+
+			if test.expectError {
+				if err == nil {
+					t.Fatalf("Expected an error")
+				}
+
+				// nothing should be committed when the upload fails
+				if server.putBlockLists != 0 {
+					t.Fatalf("Expected the block list not to be committed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			uploaded := make([]byte, 0)
+			for _, id := range server.committed {
+				uploaded = append(uploaded, server.blocks[id]...)
+			}
+			if !bytes.Equal(uploaded, contents) {
+				t.Fatalf("Expected the committed blocks to match the source file")
+			}
+
+			if expected := base64.StdEncoding.EncodeToString(hash[:]); server.contentMD5 != expected {
+				t.Fatalf("Expected the Content MD5 to be %q but got %q", expected, server.contentMD5)
+			}
+		})
+	}
+}
+
 func TestAccAzureRMStorageBlob_basic(t *testing.T) {
 	resourceName := "azurerm_storage_blob.test"
 	ri := acctest.RandInt()
@@ -375,6 +639,97 @@ func TestAccAzureRMStorageBlobBlock_blockContentType(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlobBlock_contentProperties(t *testing.T) {
+	resourceName := "azurerm_storage_blob.source"
+	ri := acctest.RandInt()
+	rs1 := strings.ToLower(acctest.RandString(11))
+	sourceBlob, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	writeSourceBlob := func(size int64) {
+		file, err := os.Create(sourceBlob.Name())
+		if err != nil {
+			t.Fatalf("Failed to open source blob")
+		}
+
+		if _, err := io.CopyN(file, rand.Reader, size); err != nil {
+			t.Fatalf("Failed to write random test to source blob")
+		}
+
+		if err := file.Close(); err != nil {
+			t.Fatalf("Failed to close source blob")
+		}
+	}
+	writeSourceBlob(10 * 1024 * 1024)
+
+	config := testAccAzureRMStorageBlobBlock_contentProperties(ri, rs1, testLocation(), sourceBlob.Name())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypeBlock, sourceBlob.Name()),
+					testCheckAzureRMStorageBlobContentMD5MatchesFile(resourceName, sourceBlob.Name()),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+					resource.TestCheckResourceAttr(resourceName, "content_encoding", "gzip"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "max-age=3600"),
+				),
+			},
+			{
+				// changing the contents of the source file changes the `content_md5`, which replaces the blob
+				PreConfig: func() { writeSourceBlob(5 * 1024 * 1024) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypeBlock, sourceBlob.Name()),
+					testCheckAzureRMStorageBlobContentMD5MatchesFile(resourceName, sourceBlob.Name()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobBlock_contentMD5WithoutSource(t *testing.T) {
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMStorageBlobBlock_contentMD5WithoutSource(ri, rs, testLocation()),
+				ExpectError: regexp.MustCompile("`content_md5` can only be specified when `source` is specified"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageBlobContentMD5MatchesFile(name string, filePath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		file, err := os.Open(filePath)
+		if err != nil {
+			return fmt.Errorf("Error opening %q: %+v", filePath, err)
+		}
+		defer file.Close()
+
+		hash := md5.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			return fmt.Errorf("Error computing the MD5 hash of %q: %+v", filePath, err)
+		}
+
+		return resource.TestCheckResourceAttr(name, "content_md5", hex.EncodeToString(hash.Sum(nil)))(s)
+	}
+}
+
 func testCheckAzureRMStorageBlobExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -770,3 +1125,77 @@ resource "azurerm_storage_blob" "source" {
 }
 `, rInt, location, rString, sourceBlobName, contentType)
 }
+
+func testAccAzureRMStorageBlobBlock_contentProperties(rInt int, rString, location string, sourceBlobName string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "source" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "source" {
+  name                  = "source"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.source.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "source" {
+  name                   = "source.json"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.source.name}"
+  storage_container_name = "${azurerm_storage_container.source.name}"
+
+  type             = "block"
+  source           = "%s"
+  content_type     = "application/json"
+  content_encoding = "gzip"
+  cache_control    = "max-age=3600"
+  content_md5      = "${md5(file("%s"))}"
+  parallelism      = 4
+  attempts         = 2
+}
+`, rInt, location, rString, sourceBlobName, sourceBlobName)
+}
+
+func testAccAzureRMStorageBlobBlock_contentMD5WithoutSource(rInt int, rString, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+
+  type        = "block"
+  content_md5 = "5d41402abc4b2a76b9719d911017c592"
+}
+`, rInt, location, rString)
+}
@@ -0,0 +1,117 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func resourceArmSubscriptionTags() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubscriptionTagsCreateUpdate,
+		Read:   resourceArmSubscriptionTagsRead,
+		Update: resourceArmSubscriptionTagsCreateUpdate,
+		Delete: resourceArmSubscriptionTagsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"tags": {
+				Type:         schema.TypeMap,
+				Required:     true,
+				ValidateFunc: validateAzureRMTags,
+			},
+		},
+	}
+}
+
+func resourceArmSubscriptionTagsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subscriptionTagsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	subscriptionId := d.Get("subscription_id").(string)
+	if subscriptionId == "" {
+		subscriptionId = meta.(*ArmClient).subscriptionId
+	}
+
+	log.Printf("[INFO] preparing arguments for AzureRM Subscription Tags creation/update.")
+
+	id := subscriptionTagsID(subscriptionId)
+	// the Tags assigned to the Subscription are fully managed by this resource, so the `default_tags` configured
+	// on the Provider (which are intended for the resources within the Subscription) aren't merged in here
+	tags := d.Get("tags").(map[string]interface{})
+	parameters := subscriptionTagsResource{
+		Properties: &subscriptionTagsProperties{
+			Tags: expandTags(tags, nil),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Tags for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Tags for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmSubscriptionTagsRead(d, meta)
+}
+
+func resourceArmSubscriptionTagsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subscriptionTagsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	subscriptionId, err := parseSubscriptionTagsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var resp subscriptionTagsResource
+	if _, err := client.Get(ctx, d.Id(), &resp); err != nil {
+		return fmt.Errorf("Error retrieving Tags for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	d.Set("subscription_id", subscriptionId)
+
+	if props := resp.Properties; props != nil {
+		flattenAndSetTags(d, props.Tags, nil)
+	}
+
+	return nil
+}
+
+func resourceArmSubscriptionTagsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subscriptionTagsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	subscriptionId, err := parseSubscriptionTagsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// deleting the Tags resource removes all of the Tags assigned to the Subscription
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error deleting Tags for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Tags for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Subscription Tags replace all of the Tags assigned to the Subscription (and are removed entirely on destroy), as
+// such these tests are opt-in via `ARM_TEST_SUBSCRIPTION_TAGS` to avoid removing Tags from a shared Subscription.
+func testAccAzureRMSubscriptionTagsPreCheck(t *testing.T) {
+	if os.Getenv("ARM_TEST_SUBSCRIPTION_TAGS") == "" {
+		t.Skip("`ARM_TEST_SUBSCRIPTION_TAGS` must be set to run the Subscription Tags tests")
+	}
+}
+
+func TestAccAzureRMSubscriptionTags_basic(t *testing.T) {
+	testAccAzureRMSubscriptionTagsPreCheck(t)
+
+	resourceName := "azurerm_subscription_tags.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubscriptionTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubscriptionTags_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+			{
+				Config: testAccAzureRMSubscriptionTags_updated,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Staging"),
+					resource.TestCheckResourceAttr(resourceName, "tags.cost_center", "MSFT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAzureRMSubscriptionTags_parseId(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/tags/other",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Resources/tags/default",
+			Error: true,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/tags/default",
+			Expected: "00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	for _, tc := range cases {
+		subscriptionId, err := parseSubscriptionTagsID(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Input, err)
+		}
+
+		if tc.Error {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if subscriptionId != tc.Expected {
+			t.Fatalf("Expected the Subscription ID for %q to be %q but got %q", tc.Input, tc.Expected, subscriptionId)
+		}
+	}
+}
+
+func testCheckAzureRMSubscriptionTagsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).subscriptionTagsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var tags subscriptionTagsResource
+		if _, err := client.Get(ctx, rs.Primary.ID, &tags); err != nil {
+			return fmt.Errorf("Bad: Get on subscriptionTagsClient: %+v", err)
+		}
+
+		if tags.Properties == nil || len(tags.Properties.Tags) == 0 {
+			return fmt.Errorf("Bad: no Tags are assigned to Subscription %q", rs.Primary.Attributes["subscription_id"])
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSubscriptionTagsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).subscriptionTagsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_subscription_tags" {
+			continue
+		}
+
+		var tags subscriptionTagsResource
+		if _, err := client.Get(ctx, rs.Primary.ID, &tags); err != nil {
+			return err
+		}
+
+		if tags.Properties != nil && len(tags.Properties.Tags) > 0 {
+			return fmt.Errorf("Tags are still assigned to Subscription %q", rs.Primary.Attributes["subscription_id"])
+		}
+	}
+
+	return nil
+}
+
+const testAccAzureRMSubscriptionTags_basic = `
+resource "azurerm_subscription_tags" "test" {
+  tags {
+    environment = "Production"
+  }
+}
+`
+
+const testAccAzureRMSubscriptionTags_updated = `
+resource "azurerm_subscription_tags" "test" {
+  tags {
+    environment = "Staging"
+    cost_center = "MSFT"
+  }
+}
+`
@@ -0,0 +1,282 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSubscriptionTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubscriptionTemplateDeploymentCreate,
+		Read:   resourceArmSubscriptionTemplateDeploymentRead,
+		Update: resourceArmSubscriptionTemplateDeploymentUpdate,
+		Delete: resourceArmSubscriptionTemplateDeploymentDelete,
+
+		CustomizeDiff: resourceArmSubscriptionTemplateDeploymentCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"template_body": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				StateFunc: normalizeJson,
+			},
+
+			"parameters": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameters_body"},
+			},
+
+			"parameters_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				StateFunc:     normalizeJson,
+				ConflictsWith: []string{"parameters"},
+			},
+
+			"validate_during_plan": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmSubscriptionTemplateDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deployClient := client.deploymentsClient
+	ctx := client.StopContext
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	log.Printf("[INFO] preparing arguments for AzureRM Subscription Template Deployment creation.")
+	// deployments at the Subscription scope only support the Incremental mode
+	properties, err := expandTemplateDeploymentProperties(string(resources.Incremental), d.Get("template_body").(string), d.Get("parameters").(map[string]interface{}), d.Get("parameters_body").(string))
+	if err != nil {
+		return err
+	}
+
+	deployment := resources.Deployment{
+		Location:   utils.String(location),
+		Properties: properties,
+	}
+
+	future, err := deployClient.CreateOrUpdateAtSubscriptionScope(ctx, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error creating Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Resources/deployments/%s", subscriptionId, name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, deployClient.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation of Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	read, err := deployClient.GetAtSubscriptionScope(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Subscription Template Deployment %q", name)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSubscriptionTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmSubscriptionTemplateDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	// toggling `validate_during_plan` only affects the plan, so there's no need to redeploy the template
+	if !d.HasChange("template_body") && !d.HasChange("parameters") && !d.HasChange("parameters_body") {
+		return resourceArmSubscriptionTemplateDeploymentRead(d, meta)
+	}
+
+	return resourceArmSubscriptionTemplateDeploymentCreate(d, meta)
+}
+
+func resourceArmSubscriptionTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deployClient := client.deploymentsClient
+	ctx := client.StopContext
+
+	name, err := parseSubscriptionTemplateDeploymentNameFromId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := deployClient.GetAtSubscriptionScope(ctx, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Subscription Template Deployment %q was not found - removing from state!", name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		outputs, err := flattenTemplateDeploymentOutputs(props.Outputs)
+		if err != nil {
+			return err
+		}
+
+		if err := d.Set("outputs", outputs); err != nil {
+			return fmt.Errorf("Error setting `outputs`: %+v", err)
+		}
+
+		outputsJson, err := flattenTemplateDeploymentOutputsJson(props.Outputs)
+		if err != nil {
+			return err
+		}
+		d.Set("outputs_json", outputsJson)
+	}
+
+	return nil
+}
+
+func resourceArmSubscriptionTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deployClient := client.deploymentsClient
+	ctx := client.StopContext
+
+	name, err := parseSubscriptionTemplateDeploymentNameFromId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := deployClient.DeleteAtSubscriptionScope(ctx, name); err != nil {
+		return fmt.Errorf("Error deleting Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	return waitForSubscriptionTemplateDeploymentToBeDeleted(ctx, deployClient, name)
+}
+
+// resourceArmSubscriptionTemplateDeploymentCustomizeDiff validates the template against the API during the plan,
+// in the same way as resourceArmTemplateDeploymentCustomizeDiff does for deployments to a Resource Group
+func resourceArmSubscriptionTemplateDeploymentCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.Get("validate_during_plan").(bool) {
+		return nil
+	}
+
+	client := v.(*ArmClient)
+	deployClient := client.deploymentsClient
+	ctx := client.StopContext
+
+	name := diff.Get("name").(string)
+	location := diff.Get("location").(string)
+
+	// values which aren't known until apply are returned as empty strings, in which case there's nothing to validate
+	if name == "" || location == "" {
+		return nil
+	}
+
+	if diff.Id() != "" && !templateDeploymentDiffHasChanges(diff) {
+		return nil
+	}
+
+	properties, err := expandTemplateDeploymentPropertiesFromDiff(diff, string(resources.Incremental))
+	if err != nil {
+		return err
+	}
+	if properties == nil {
+		return nil
+	}
+
+	deployment := resources.Deployment{
+		Location:   utils.String(azureRMNormalizeLocation(location)),
+		Properties: properties,
+	}
+
+	log.Printf("[DEBUG] Validating Subscription Template Deployment %q", name)
+	resp, err := deployClient.ValidateAtSubscriptionScope(ctx, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error validating Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	if resp.Error != nil {
+		return fmt.Errorf("Error validating Subscription Template Deployment %q: %s", name, flattenTemplateDeploymentValidationError(resp.Error))
+	}
+
+	return nil
+}
+
+func parseSubscriptionTemplateDeploymentNameFromId(id string) (string, error) {
+	// /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/deployments/deployment1
+	components := strings.Split(id, "/")
+
+	if len(components) != 7 || !strings.EqualFold(components[5], "deployments") {
+		return "", fmt.Errorf("Subscription Template Deployment ID should be in the format `/subscriptions/{subscriptionId}/providers/Microsoft.Resources/deployments/{name}` but got %q", id)
+	}
+
+	return components[6], nil
+}
+
+func waitForSubscriptionTemplateDeploymentToBeDeleted(ctx context.Context, client resources.DeploymentsClient, name string) error {
+	// we can't use the Waiter here since the API returns a 200 once it's deleted which is considered a polling status code..
+	log.Printf("[DEBUG] Waiting for Subscription Template Deployment %q to be deleted", name)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"200"},
+		Target:  []string{"404"},
+		Refresh: subscriptionTemplateDeploymentStateStatusCodeRefreshFunc(ctx, client, name),
+		Timeout: 40 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Subscription Template Deployment %q to be deleted: %+v", name, err)
+	}
+
+	return nil
+}
+
+func subscriptionTemplateDeploymentStateStatusCodeRefreshFunc(ctx context.Context, client resources.DeploymentsClient, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.GetAtSubscriptionScope(ctx, name)
+
+		log.Printf("Retrieving Subscription Template Deployment %q returned Status %d", name, res.StatusCode)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(res.Response) {
+				return res, strconv.Itoa(res.StatusCode), nil
+			}
+			return nil, "", fmt.Errorf("Error polling for the status of the Subscription Template Deployment %q: %+v", name, err)
+		}
+
+		return res, strconv.Itoa(res.StatusCode), nil
+	}
+}
@@ -0,0 +1,212 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMSubscriptionTemplateDeployment_basic(t *testing.T) {
+	resourceName := "azurerm_subscription_template_deployment.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSubscriptionTemplateDeployment_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubscriptionTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.testOutput", "Output Value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubscriptionTemplateDeployment_withParams(t *testing.T) {
+	resourceName := "azurerm_subscription_template_deployment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSubscriptionTemplateDeployment_withParams(ri, location, "first")
+	postConfig := testAccAzureRMSubscriptionTemplateDeployment_withParams(ri, location, "second")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubscriptionTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.valueOutput", "first"),
+					resource.TestCheckResourceAttr(resourceName, "outputs_json", `{"valueOutput":"first"}`),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.valueOutput", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMSubscriptionTemplateDeployment_parseId(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Resources/deployments/deployment1",
+			Error: true,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/deployments/deployment1",
+			Expected: "deployment1",
+		},
+	}
+
+	for _, tc := range cases {
+		name, err := parseSubscriptionTemplateDeploymentNameFromId(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Input, err)
+		}
+
+		if tc.Error {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if name != tc.Expected {
+			t.Fatalf("Expected the name for %q to be %q but got %q", tc.Input, tc.Expected, name)
+		}
+	}
+}
+
+func testCheckAzureRMSubscriptionTemplateDeploymentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		deploymentName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).deploymentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetAtSubscriptionScope(ctx, deploymentName)
+		if err != nil {
+			return fmt.Errorf("Bad: GetAtSubscriptionScope on deploymentsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Subscription Template Deployment %q does not exist", deploymentName)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSubscriptionTemplateDeploymentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).deploymentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_subscription_template_deployment" {
+			continue
+		}
+
+		deploymentName := rs.Primary.Attributes["name"]
+
+		resp, err := client.GetAtSubscriptionScope(ctx, deploymentName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Subscription Template Deployment still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMSubscriptionTemplateDeployment_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_subscription_template_deployment" "test" {
+  name     = "acctesttemplate-%d"
+  location = "%s"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [],
+  "outputs": {
+    "testOutput": {
+      "type": "string",
+      "value": "Output Value"
+    }
+  }
+}
+DEPLOY
+}
+`, rInt, location)
+}
+
+func testAccAzureRMSubscriptionTemplateDeployment_withParams(rInt int, location string, value string) string {
+	return fmt.Sprintf(`
+resource "azurerm_subscription_template_deployment" "test" {
+  name     = "acctesttemplate-%d"
+  location = "%s"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "value": {
+      "type": "string"
+    }
+  },
+  "variables": {},
+  "resources": [],
+  "outputs": {
+    "valueOutput": {
+      "type": "string",
+      "value": "[parameters('value')]"
+    }
+  }
+}
+DEPLOY
+
+  parameters {
+    value = "%s"
+  }
+}
+`, rInt, location, value)
+}
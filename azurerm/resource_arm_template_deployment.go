@@ -9,7 +9,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -20,9 +20,11 @@ func resourceArmTemplateDeployment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmTemplateDeploymentCreate,
 		Read:   resourceArmTemplateDeploymentRead,
-		Update: resourceArmTemplateDeploymentCreate,
+		Update: resourceArmTemplateDeploymentUpdate,
 		Delete: resourceArmTemplateDeploymentDelete,
 
+		CustomizeDiff: resourceArmTemplateDeploymentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -62,10 +64,21 @@ func resourceArmTemplateDeployment() *schema.Resource {
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
+			"validate_during_plan": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"outputs": {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -80,45 +93,13 @@ func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{
 	deploymentMode := d.Get("deployment_mode").(string)
 
 	log.Printf("[INFO] preparing arguments for AzureRM Template Deployment creation.")
-	properties := resources.DeploymentProperties{
-		Mode: resources.DeploymentMode(deploymentMode),
-	}
-
-	if v, ok := d.GetOk("parameters"); ok {
-		params := v.(map[string]interface{})
-
-		newParams := make(map[string]interface{}, len(params))
-		for key, val := range params {
-			newParams[key] = struct {
-				Value interface{}
-			}{
-				Value: val,
-			}
-		}
-
-		properties.Parameters = &newParams
-	}
-
-	if v, ok := d.GetOk("parameters_body"); ok {
-		params, err := expandParametersBody(v.(string))
-		if err != nil {
-			return err
-		}
-
-		properties.Parameters = &params
-	}
-
-	if v, ok := d.GetOk("template_body"); ok {
-		template, err := expandTemplateBody(v.(string))
-		if err != nil {
-			return err
-		}
-
-		properties.Template = &template
+	properties, err := expandTemplateDeploymentProperties(deploymentMode, d.Get("template_body").(string), d.Get("parameters").(map[string]interface{}), d.Get("parameters_body").(string))
+	if err != nil {
+		return err
 	}
 
 	deployment := resources.Deployment{
-		Properties: &properties,
+		Properties: properties,
 	}
 
 	future, err := deployClient.CreateOrUpdate(ctx, resourceGroup, name, deployment)
@@ -126,7 +107,9 @@ func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error creating deployment: %+v", err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, deployClient.Client)
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Resources", "deployments", name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, deployClient.Client, expectedId)
 	if err != nil {
 		return fmt.Errorf("Error creating deployment: %+v", err)
 	}
@@ -144,6 +127,15 @@ func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{
 	return resourceArmTemplateDeploymentRead(d, meta)
 }
 
+func resourceArmTemplateDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	// toggling `validate_during_plan` only affects the plan, so there's no need to redeploy the template
+	if !d.HasChange("template_body") && !d.HasChange("parameters") && !d.HasChange("parameters_body") && !d.HasChange("deployment_mode") {
+		return resourceArmTemplateDeploymentRead(d, meta)
+	}
+
+	return resourceArmTemplateDeploymentCreate(d, meta)
+}
+
 func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	deployClient := client.deploymentsClient
@@ -168,45 +160,17 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error making Read request on Azure RM Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	outputs := make(map[string]string, 0)
-	if outs := resp.Properties.Outputs; outs != nil {
-		outsVal := outs.(map[string]interface{})
-		if len(outsVal) > 0 {
-			for key, output := range outsVal {
-				log.Printf("[DEBUG] Processing deployment output %s", key)
-				outputMap := output.(map[string]interface{})
-				outputValue, ok := outputMap["value"]
-				if !ok {
-					log.Printf("[DEBUG] No value - skipping")
-					continue
-				}
-				outputType, ok := outputMap["type"]
-				if !ok {
-					log.Printf("[DEBUG] No type - skipping")
-					continue
-				}
-
-				var outputValueString string
-				switch strings.ToLower(outputType.(string)) {
-				case "bool":
-					outputValueString = strconv.FormatBool(outputValue.(bool))
-
-				case "string":
-					outputValueString = outputValue.(string)
-
-				case "int":
-					outputValueString = fmt.Sprint(outputValue)
-
-				default:
-					log.Printf("[WARN] Ignoring output %s: Outputs of type %s are not currently supported in azurerm_template_deployment.",
-						key, outputType)
-					continue
-				}
-				outputs[key] = outputValueString
-			}
-		}
+	outputs, err := flattenTemplateDeploymentOutputs(resp.Properties.Outputs)
+	if err != nil {
+		return err
+	}
+
+	outputsJson, err := flattenTemplateDeploymentOutputsJson(resp.Properties.Outputs)
+	if err != nil {
+		return err
 	}
 
+	d.Set("outputs_json", outputsJson)
 	return d.Set("outputs", outputs)
 }
 
@@ -233,6 +197,170 @@ func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{
 	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name)
 }
 
+// resourceArmTemplateDeploymentCustomizeDiff validates the template against the API during the plan when
+// `validate_during_plan` is enabled, so that an invalid template fails quickly rather than at the end of a
+// long-running deployment
+func resourceArmTemplateDeploymentCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	// validating the template requires an API call, which isn't made unless it's been opted into
+	if !diff.Get("validate_during_plan").(bool) {
+		return nil
+	}
+
+	client := v.(*ArmClient)
+	deployClient := client.deploymentsClient
+	ctx := client.StopContext
+
+	name := diff.Get("name").(string)
+	resourceGroup := diff.Get("resource_group_name").(string)
+	deploymentMode := diff.Get("deployment_mode").(string)
+
+	// values which aren't known until apply are returned as empty strings, in which case there's nothing to validate
+	if name == "" || resourceGroup == "" || deploymentMode == "" {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChange("deployment_mode") && !templateDeploymentDiffHasChanges(diff) {
+		return nil
+	}
+
+	properties, err := expandTemplateDeploymentPropertiesFromDiff(diff, deploymentMode)
+	if err != nil {
+		return err
+	}
+	if properties == nil {
+		return nil
+	}
+
+	deployment := resources.Deployment{
+		Properties: properties,
+	}
+
+	return validateTemplateDeployment(ctx, deployClient, resourceGroup, name, deployment)
+}
+
+func templateDeploymentDiffHasChanges(diff *schema.ResourceDiff) bool {
+	if diff.HasChange("template_body") || diff.HasChange("parameters_body") || diff.HasChange("parameters.%") {
+		return true
+	}
+
+	// reading a map containing a value which isn't known yet from the diff panics, so only compare known parameters
+	if count, ok := diff.Get("parameters.%").(string); ok && count != "" && count != "0" {
+		return diff.HasChange("parameters")
+	}
+
+	return false
+}
+
+// expandTemplateDeploymentPropertiesFromDiff returns the properties for the deployment during the plan - or nil when
+// the template or its parameters aren't known until apply, in which case the deployment can't be validated yet
+func expandTemplateDeploymentPropertiesFromDiff(diff *schema.ResourceDiff, deploymentMode string) (*resources.DeploymentProperties, error) {
+	templateBody := diff.Get("template_body").(string)
+	parametersBody := diff.Get("parameters_body").(string)
+
+	if templateBody == "" {
+		return nil, nil
+	}
+
+	// a map containing a value which isn't known yet can't be read safely here - however the element count is
+	// unset in that case, which is indistinguishable from no parameters, so we can only validate when either
+	// the parameters are known or the template doesn't require any
+	parameters := make(map[string]interface{})
+	if count, ok := diff.Get("parameters.%").(string); ok && count != "" && count != "0" {
+		parameters = diff.Get("parameters").(map[string]interface{})
+	} else if parametersBody == "" {
+		template, err := expandTemplateBody(templateBody)
+		if err != nil {
+			return nil, err
+		}
+
+		if templateDeploymentRequiresParameters(template) {
+			return nil, nil
+		}
+	}
+
+	return expandTemplateDeploymentProperties(deploymentMode, templateBody, parameters, parametersBody)
+}
+
+func validateTemplateDeployment(ctx context.Context, client resources.DeploymentsClient, resourceGroup, name string, deployment resources.Deployment) error {
+	log.Printf("[DEBUG] Validating Template Deployment %q (Resource Group %q)", name, resourceGroup)
+
+	resp, err := client.Validate(ctx, resourceGroup, name, deployment)
+	if err != nil {
+		// the Resource Group may not exist yet, in which case it'll be validated when it's deployed
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Resource Group %q was not found - skipping validation of Template Deployment %q", resourceGroup, name)
+			return nil
+		}
+
+		return fmt.Errorf("Error validating Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.Error != nil {
+		return fmt.Errorf("Error validating Template Deployment %q (Resource Group %q): %s", name, resourceGroup, flattenTemplateDeploymentValidationError(resp.Error))
+	}
+
+	return nil
+}
+
+func templateDeploymentRequiresParameters(template map[string]interface{}) bool {
+	parameters, ok := template["parameters"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, parameter := range parameters {
+		v, ok := parameter.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if _, hasDefault := v["defaultValue"]; !hasDefault {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandTemplateDeploymentProperties(deploymentMode, templateBody string, parameters map[string]interface{}, parametersBody string) (*resources.DeploymentProperties, error) {
+	properties := resources.DeploymentProperties{
+		Mode: resources.DeploymentMode(deploymentMode),
+	}
+
+	if len(parameters) > 0 {
+		newParams := make(map[string]interface{}, len(parameters))
+		for key, val := range parameters {
+			newParams[key] = struct {
+				Value interface{}
+			}{
+				Value: val,
+			}
+		}
+
+		properties.Parameters = &newParams
+	}
+
+	if parametersBody != "" {
+		params, err := expandParametersBody(parametersBody)
+		if err != nil {
+			return nil, err
+		}
+
+		properties.Parameters = &params
+	}
+
+	if templateBody != "" {
+		template, err := expandTemplateBody(templateBody)
+		if err != nil {
+			return nil, err
+		}
+
+		properties.Template = &template
+	}
+
+	return &properties, nil
+}
+
 // TODO: move this out into the new `helpers` structure
 func expandParametersBody(body string) (map[string]interface{}, error) {
 	var parametersBody map[string]interface{}
@@ -240,6 +368,17 @@ func expandParametersBody(body string) (map[string]interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("Error Expanding the parameters_body for Azure RM Template Deployment")
 	}
+
+	// a complete Parameters File wraps the parameters in a `parameters` block alongside the `$schema`
+	// and `contentVersion` - whereas the API only accepts the contents of that block
+	if params, ok := parametersBody["parameters"].(map[string]interface{}); ok {
+		_, hasSchema := parametersBody["$schema"]
+		_, hasContentVersion := parametersBody["contentVersion"]
+		if hasSchema || hasContentVersion {
+			return params, nil
+		}
+	}
+
 	return parametersBody, nil
 }
 
@@ -252,6 +391,108 @@ func expandTemplateBody(template string) (map[string]interface{}, error) {
 	return templateBody, nil
 }
 
+func flattenTemplateDeploymentOutputs(input interface{}) (map[string]string, error) {
+	outputs := make(map[string]string, 0)
+
+	outsVal, ok := input.(map[string]interface{})
+	if !ok {
+		return outputs, nil
+	}
+
+	for key, output := range outsVal {
+		log.Printf("[DEBUG] Processing deployment output %s", key)
+		outputMap := output.(map[string]interface{})
+		outputValue, ok := outputMap["value"]
+		if !ok {
+			log.Printf("[DEBUG] No value - skipping")
+			continue
+		}
+		outputType, ok := outputMap["type"]
+		if !ok {
+			log.Printf("[DEBUG] No type - skipping")
+			continue
+		}
+
+		var outputValueString string
+		switch strings.ToLower(outputType.(string)) {
+		case "bool":
+			outputValueString = strconv.FormatBool(outputValue.(bool))
+
+		case "string":
+			outputValueString = outputValue.(string)
+
+		case "int":
+			outputValueString = fmt.Sprint(outputValue)
+
+		case "array", "object":
+			// these can't be represented in a map of strings, so are exposed as JSON
+			b, err := json.Marshal(outputValue)
+			if err != nil {
+				return nil, fmt.Errorf("Error serializing output %q to JSON: %+v", key, err)
+			}
+			outputValueString = string(b)
+
+		default:
+			log.Printf("[WARN] Ignoring output %s: Outputs of type %s are not currently supported.", key, outputType)
+			continue
+		}
+		outputs[key] = outputValueString
+	}
+
+	return outputs, nil
+}
+
+func flattenTemplateDeploymentOutputsJson(input interface{}) (string, error) {
+	outputs, ok := input.(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+
+	results := make(map[string]interface{}, len(outputs))
+	for key, output := range outputs {
+		outputMap, ok := output.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		outputValue, ok := outputMap["value"]
+		if !ok {
+			continue
+		}
+
+		results[key] = outputValue
+	}
+
+	b, err := json.Marshal(results)
+	if err != nil {
+		return "", fmt.Errorf("Error serializing the outputs of the Template Deployment to JSON: %+v", err)
+	}
+
+	return string(b), nil
+}
+
+func flattenTemplateDeploymentValidationError(input *resources.ManagementErrorWithDetails) string {
+	if input == nil {
+		return ""
+	}
+
+	message := ""
+	if input.Code != nil {
+		message = fmt.Sprintf("Code=%q", *input.Code)
+	}
+	if input.Message != nil {
+		message = fmt.Sprintf("%s Message=%q", message, *input.Message)
+	}
+
+	if details := input.Details; details != nil {
+		for _, detail := range *details {
+			message = fmt.Sprintf("%s\n - %s", message, flattenTemplateDeploymentValidationError(&detail))
+		}
+	}
+
+	return strings.TrimSpace(message)
+}
+
 func normalizeJson(jsonString interface{}) string {
 	if jsonString == nil || jsonString == "" {
 		return ""
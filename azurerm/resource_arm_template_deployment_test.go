@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -132,6 +133,121 @@ func TestAccAzureRMTemplateDeployment_withError(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMTemplateDeployment_withParametersFile(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMTemplateDeployment_withParametersFile(ri, testLocation())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists("azurerm_template_deployment.test"),
+					resource.TestCheckResourceAttr("azurerm_template_deployment.test", "outputs.stringOutput", "Standard_GRS"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTemplateDeployment_withComplexOutputs(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMTemplateDeployment_withComplexOutputs(ri, testLocation())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists("azurerm_template_deployment.test"),
+					resource.TestCheckResourceAttr("azurerm_template_deployment.test", "outputs.arrayOutput", `["first","second"]`),
+					resource.TestCheckResourceAttr("azurerm_template_deployment.test", "outputs.objectOutput", `{"enabled":true,"name":"example"}`),
+					resource.TestCheckResourceAttr("azurerm_template_deployment.test", "outputs_json", `{"arrayOutput":["first","second"],"intOutput":42,"objectOutput":{"enabled":true,"name":"example"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTemplateDeployment_validationErrorDuringPlan(t *testing.T) {
+	ri := acctest.RandInt()
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the Resource Group needs to exist for the template to be validated during the plan
+				Config: testAccAzureRMTemplateDeployment_resourceGroupOnly(ri, location),
+			},
+			{
+				Config:             testAccAzureRMTemplateDeployment_invalidTemplate(ri, location),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ExpectError:        regexp.MustCompile("Error validating Template Deployment"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTemplateDeployment_noValidationDuringPlanByDefault(t *testing.T) {
+	ri := acctest.RandInt()
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_resourceGroupOnly(ri, location),
+			},
+			{
+				// without `validate_during_plan` the invalid template is only caught during the apply
+				Config:             testAccAzureRMTemplateDeployment_invalidTemplateWithoutValidation(ri, location),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAzureRMTemplateDeployment_expandParametersBody(t *testing.T) {
+	cases := []struct {
+		Body     string
+		Expected string
+	}{
+		{
+			Body:     `{"storageAccountType": {"value": "Standard_GRS"}}`,
+			Expected: "Standard_GRS",
+		},
+		{
+			Body:     `{"$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentParameters.json#", "contentVersion": "1.0.0.0", "parameters": {"storageAccountType": {"value": "Standard_LRS"}}}`,
+			Expected: "Standard_LRS",
+		},
+	}
+
+	for _, tc := range cases {
+		params, err := expandParametersBody(tc.Body)
+		if err != nil {
+			t.Fatalf("Error expanding parameters body %q: %+v", tc.Body, err)
+		}
+
+		parameter, ok := params["storageAccountType"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("Expected `storageAccountType` to be present in the expanded parameters for %q", tc.Body)
+		}
+
+		if parameter["value"] != tc.Expected {
+			t.Fatalf("Expected the value of `storageAccountType` to be %q but got %q", tc.Expected, parameter["value"])
+		}
+	}
+}
+
 func testCheckAzureRMTemplateDeploymentExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -743,3 +859,135 @@ DEPLOY
   }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMTemplateDeployment_withParametersFile(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "storageAccountType": {
+      "type": "string"
+    }
+  },
+  "variables": {},
+  "resources": [],
+  "outputs": {
+    "stringOutput": {
+      "type": "string",
+      "value": "[parameters('storageAccountType')]"
+    }
+  }
+}
+DEPLOY
+
+  parameters_body = <<PARAMETERS
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentParameters.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "storageAccountType": {
+      "value": "Standard_GRS"
+    }
+  }
+}
+PARAMETERS
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMTemplateDeployment_withComplexOutputs(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [],
+  "outputs": {
+    "arrayOutput": {
+      "type": "array",
+      "value": ["first", "second"]
+    },
+    "intOutput": {
+      "type": "int",
+      "value": 42
+    },
+    "objectOutput": {
+      "type": "object",
+      "value": {
+        "enabled": true,
+        "name": "example"
+      }
+    }
+  }
+}
+DEPLOY
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMTemplateDeployment_resourceGroupOnly(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMTemplateDeployment_invalidTemplate(rInt int, location string) string {
+	template := testAccAzureRMTemplateDeployment_resourceGroupOnly(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_deployment" "test" {
+  name                 = "acctesttemplate-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  deployment_mode      = "Incremental"
+  validate_during_plan = true
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [],
+  "outputs": {
+    "invalidOutput": {
+      "type": "string",
+      "value": "[parameters('doesNotExist')]"
+    }
+  }
+}
+DEPLOY
+}
+`, template, rInt)
+}
+
+func testAccAzureRMTemplateDeployment_invalidTemplateWithoutValidation(rInt int, location string) string {
+	return strings.Replace(testAccAzureRMTemplateDeployment_invalidTemplate(rInt, location), "validate_during_plan = true", "validate_during_plan = false", 1)
+}
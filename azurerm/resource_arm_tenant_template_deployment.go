@@ -0,0 +1,287 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourcemanager"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Deployments at the Tenant scope aren't available in the version of the Azure SDK used by this Provider, as such
+// they're managed using the `resourcemanager` client - however the request and response payloads are the same as
+// for deployments at the Subscription scope, so the models from the Azure SDK are reused here.
+func resourceArmTenantTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmTenantTemplateDeploymentCreate,
+		Read:   resourceArmTenantTemplateDeploymentRead,
+		Update: resourceArmTenantTemplateDeploymentUpdate,
+		Delete: resourceArmTenantTemplateDeploymentDelete,
+
+		CustomizeDiff: resourceArmTenantTemplateDeploymentCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"template_body": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				StateFunc: normalizeJson,
+			},
+
+			"parameters": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameters_body"},
+			},
+
+			"parameters_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				StateFunc:     normalizeJson,
+				ConflictsWith: []string{"parameters"},
+			},
+
+			"validate_during_plan": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmTenantTemplateDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tenantDeploymentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	log.Printf("[INFO] preparing arguments for AzureRM Tenant Template Deployment creation.")
+	// deployments at the Tenant scope only support the Incremental mode
+	properties, err := expandTemplateDeploymentProperties(string(resources.Incremental), d.Get("template_body").(string), d.Get("parameters").(map[string]interface{}), d.Get("parameters_body").(string))
+	if err != nil {
+		return err
+	}
+
+	deployment := resources.Deployment{
+		Location:   utils.String(location),
+		Properties: properties,
+	}
+
+	id := tenantTemplateDeploymentID(name)
+	future, err := client.CreateOrUpdate(ctx, id, deployment)
+	if err != nil {
+		return fmt.Errorf("Error creating Tenant Template Deployment %q: %+v", name, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation of Tenant Template Deployment %q: %+v", name, err)
+	}
+
+	var read resources.DeploymentExtended
+	if _, err := client.Get(ctx, id, &read); err != nil {
+		return fmt.Errorf("Error retrieving Tenant Template Deployment %q: %+v", name, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Tenant Template Deployment %q", name)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmTenantTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmTenantTemplateDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	// toggling `validate_during_plan` only affects the plan, so there's no need to redeploy the template
+	if !d.HasChange("template_body") && !d.HasChange("parameters") && !d.HasChange("parameters_body") {
+		return resourceArmTenantTemplateDeploymentRead(d, meta)
+	}
+
+	return resourceArmTenantTemplateDeploymentCreate(d, meta)
+}
+
+func resourceArmTenantTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tenantDeploymentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name, err := parseTenantTemplateDeploymentNameFromId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var resp resources.DeploymentExtended
+	read, err := client.Get(ctx, tenantTemplateDeploymentID(name), &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(read) {
+			log.Printf("[DEBUG] Tenant Template Deployment %q was not found - removing from state!", name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Tenant Template Deployment %q: %+v", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		outputs, err := flattenTemplateDeploymentOutputs(props.Outputs)
+		if err != nil {
+			return err
+		}
+
+		if err := d.Set("outputs", outputs); err != nil {
+			return fmt.Errorf("Error setting `outputs`: %+v", err)
+		}
+
+		outputsJson, err := flattenTemplateDeploymentOutputsJson(props.Outputs)
+		if err != nil {
+			return err
+		}
+		d.Set("outputs_json", outputsJson)
+	}
+
+	return nil
+}
+
+func resourceArmTenantTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tenantDeploymentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name, err := parseTenantTemplateDeploymentNameFromId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, tenantTemplateDeploymentID(name)); err != nil {
+		return fmt.Errorf("Error deleting Tenant Template Deployment %q: %+v", name, err)
+	}
+
+	return waitForTenantTemplateDeploymentToBeDeleted(ctx, client, name)
+}
+
+// resourceArmTenantTemplateDeploymentCustomizeDiff validates the template against the API during the plan,
+// in the same way as resourceArmSubscriptionTemplateDeploymentCustomizeDiff does for the Subscription scope
+func resourceArmTenantTemplateDeploymentCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.Get("validate_during_plan").(bool) {
+		return nil
+	}
+
+	client := v.(*ArmClient).tenantDeploymentsClient
+	ctx := v.(*ArmClient).StopContext
+
+	name := diff.Get("name").(string)
+	location := diff.Get("location").(string)
+
+	// values which aren't known until apply are returned as empty strings, in which case there's nothing to validate
+	if name == "" || location == "" {
+		return nil
+	}
+
+	if diff.Id() != "" && !templateDeploymentDiffHasChanges(diff) {
+		return nil
+	}
+
+	properties, err := expandTemplateDeploymentPropertiesFromDiff(diff, string(resources.Incremental))
+	if err != nil {
+		return err
+	}
+	if properties == nil {
+		return nil
+	}
+
+	deployment := resources.Deployment{
+		Location:   utils.String(azureRMNormalizeLocation(location)),
+		Properties: properties,
+	}
+
+	log.Printf("[DEBUG] Validating Tenant Template Deployment %q", name)
+	var resp resources.DeploymentValidateResult
+	if _, err := client.Post(ctx, tenantTemplateDeploymentID(name), "validate", deployment, &resp); err != nil {
+		return fmt.Errorf("Error validating Tenant Template Deployment %q: %+v", name, err)
+	}
+
+	if resp.Error != nil {
+		return fmt.Errorf("Error validating Tenant Template Deployment %q: %s", name, flattenTemplateDeploymentValidationError(resp.Error))
+	}
+
+	return nil
+}
+
+func tenantTemplateDeploymentID(name string) string {
+	return fmt.Sprintf("/providers/Microsoft.Resources/deployments/%s", name)
+}
+
+func parseTenantTemplateDeploymentNameFromId(id string) (string, error) {
+	// /providers/Microsoft.Resources/deployments/deployment1
+	components := strings.Split(id, "/")
+
+	if len(components) != 5 || components[0] != "" || !strings.EqualFold(components[1], "providers") || !strings.EqualFold(components[3], "deployments") || components[4] == "" {
+		return "", fmt.Errorf("Tenant Template Deployment ID should be in the format `/providers/Microsoft.Resources/deployments/{name}` but got %q", id)
+	}
+
+	return components[4], nil
+}
+
+func waitForTenantTemplateDeploymentToBeDeleted(ctx context.Context, client resourcemanager.Client, name string) error {
+	// we can't use the Waiter here since the API returns a 200 once it's deleted which is considered a polling status code..
+	log.Printf("[DEBUG] Waiting for Tenant Template Deployment %q to be deleted", name)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"200"},
+		Target:  []string{"404"},
+		Refresh: tenantTemplateDeploymentStateStatusCodeRefreshFunc(ctx, client, name),
+		Timeout: 40 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Tenant Template Deployment %q to be deleted: %+v", name, err)
+	}
+
+	return nil
+}
+
+func tenantTemplateDeploymentStateStatusCodeRefreshFunc(ctx context.Context, client resourcemanager.Client, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var deployment resources.DeploymentExtended
+		res, err := client.Get(ctx, tenantTemplateDeploymentID(name), &deployment)
+
+		log.Printf("Retrieving Tenant Template Deployment %q returned Status %d", name, res.StatusCode)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(res) {
+				return res, strconv.Itoa(res.StatusCode), nil
+			}
+			return nil, "", fmt.Errorf("Error polling for the status of the Tenant Template Deployment %q: %+v", name, err)
+		}
+
+		return res, strconv.Itoa(res.StatusCode), nil
+	}
+}
@@ -0,0 +1,234 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Deploying at the Tenant scope requires permissions at the root of the Tenant, which the Service Principal used
+// to run the acceptance tests doesn't have by default - as such these tests are opt-in via `ARM_TEST_TENANT_DEPLOYMENTS`.
+func testAccAzureRMTenantTemplateDeploymentPreCheck(t *testing.T) {
+	if os.Getenv("ARM_TEST_TENANT_DEPLOYMENTS") == "" {
+		t.Skip("`ARM_TEST_TENANT_DEPLOYMENTS` must be set to run the Tenant Template Deployment tests")
+	}
+}
+
+func TestAccAzureRMTenantTemplateDeployment_basic(t *testing.T) {
+	testAccAzureRMTenantTemplateDeploymentPreCheck(t)
+
+	resourceName := "azurerm_tenant_template_deployment.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMTenantTemplateDeployment_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTenantTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTenantTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.testOutput", "Output Value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTenantTemplateDeployment_withParams(t *testing.T) {
+	testAccAzureRMTenantTemplateDeploymentPreCheck(t)
+
+	resourceName := "azurerm_tenant_template_deployment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMTenantTemplateDeployment_withParams(ri, location, "first")
+	postConfig := testAccAzureRMTenantTemplateDeployment_withParams(ri, location, "second")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTenantTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTenantTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.valueOutput", "first"),
+					resource.TestCheckResourceAttr(resourceName, "outputs_json", `{"valueOutput":"first"}`),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTenantTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.valueOutput", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMTenantTemplateDeployment_parseId(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/deployments/deployment1",
+			Error: true,
+		},
+		{
+			Input: "/providers/Microsoft.Resources/deployments/",
+			Error: true,
+		},
+		{
+			Input:    "/providers/Microsoft.Resources/deployments/deployment1",
+			Expected: "deployment1",
+		},
+	}
+
+	for _, tc := range cases {
+		name, err := parseTenantTemplateDeploymentNameFromId(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Input, err)
+		}
+
+		if tc.Error {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if name != tc.Expected {
+			t.Fatalf("Expected the name for %q to be %q but got %q", tc.Input, tc.Expected, name)
+		}
+	}
+}
+
+func testCheckAzureRMTenantTemplateDeploymentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		deploymentName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).tenantDeploymentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var deployment resources.DeploymentExtended
+		resp, err := client.Get(ctx, tenantTemplateDeploymentID(deploymentName), &deployment)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Tenant Template Deployment %q does not exist", deploymentName)
+			}
+
+			return fmt.Errorf("Bad: Get on tenantDeploymentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMTenantTemplateDeploymentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).tenantDeploymentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_tenant_template_deployment" {
+			continue
+		}
+
+		deploymentName := rs.Primary.Attributes["name"]
+
+		var deployment resources.DeploymentExtended
+		resp, err := client.Get(ctx, tenantTemplateDeploymentID(deploymentName), &deployment)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Tenant Template Deployment still exists:\n%#v", deployment)
+	}
+
+	return nil
+}
+
+func testAccAzureRMTenantTemplateDeployment_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_tenant_template_deployment" "test" {
+  name     = "acctesttemplate-%d"
+  location = "%s"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-08-01/tenantDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [],
+  "outputs": {
+    "testOutput": {
+      "type": "string",
+      "value": "Output Value"
+    }
+  }
+}
+DEPLOY
+}
+`, rInt, location)
+}
+
+func testAccAzureRMTenantTemplateDeployment_withParams(rInt int, location string, value string) string {
+	return fmt.Sprintf(`
+resource "azurerm_tenant_template_deployment" "test" {
+  name     = "acctesttemplate-%d"
+  location = "%s"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-08-01/tenantDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "value": {
+      "type": "string"
+    }
+  },
+  "variables": {},
+  "resources": [],
+  "outputs": {
+    "valueOutput": {
+      "type": "string",
+      "value": "[parameters('value')]"
+    }
+  }
+}
+DEPLOY
+
+  parameters {
+    value = "%s"
+  }
+}
+`, rInt, location, value)
+}
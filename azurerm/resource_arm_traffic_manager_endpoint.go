@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,6 +9,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2017-05-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -104,6 +106,30 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 				Optional: true,
 			},
 
+			"subnet": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+						"last": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+						"scope": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 32),
+						},
+					},
+				},
+			},
+
 			"endpoint_monitor_status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -129,12 +155,37 @@ func resourceArmTrafficManagerEndpointCreate(d *schema.ResourceData, meta interf
 		EndpointProperties: getArmTrafficManagerEndpointProperties(d),
 	}
 
-	ctx := meta.(*ArmClient).StopContext
-	_, err := client.CreateOrUpdate(ctx, resourceGroup, profileName, endpointType, name, params)
+	subnets, err := expandArmTrafficManagerEndpointSubnets(d.Get("subnet").([]interface{}))
 	if err != nil {
 		return err
 	}
 
+	ctx := meta.(*ArmClient).StopContext
+	if len(subnets) > 0 {
+		// the `subnets` mappings aren't available in the version of the Azure SDK used by this Provider
+		rmClient := meta.(*ArmClient).trafficManagerClient
+
+		body, err := expandTrafficManagerEndpointWithSubnets(params, subnets)
+		if err != nil {
+			return err
+		}
+
+		profileId := azureRMResourceID(meta.(*ArmClient).subscriptionId, resourceGroup, "Microsoft.Network", "trafficManagerProfiles", profileName)
+		endpointId := fmt.Sprintf("%s/%s/%s", profileId, endpointType, name)
+		future, err := rmClient.CreateOrUpdate(ctx, endpointId, body)
+		if err != nil {
+			return fmt.Errorf("Error creating/updating Traffic Manager Endpoint %q (Profile %q / Resource Group %q): %+v", name, profileName, resourceGroup, err)
+		}
+
+		if err := waitForCompletion(ctx, &future.Future, rmClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for creation/update of Traffic Manager Endpoint %q (Profile %q / Resource Group %q): %+v", name, profileName, resourceGroup, err)
+		}
+	} else {
+		if _, err := client.CreateOrUpdate(ctx, resourceGroup, profileName, endpointType, name, params); err != nil {
+			return err
+		}
+	}
+
 	read, err := client.Get(ctx, resourceGroup, profileName, endpointType, name)
 	if err != nil {
 		return err
@@ -195,6 +246,16 @@ func resourceArmTrafficManagerEndpointRead(d *schema.ResourceData, meta interfac
 		d.Set("geo_mappings", props.GeoMapping)
 	}
 
+	var subnets trafficManagerEndpointSubnets
+	if _, err := meta.(*ArmClient).trafficManagerClient.Get(ctx, d.Id(), &subnets); err != nil {
+		return fmt.Errorf("Error retrieving Subnets for TrafficManager Endpoint %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if props := subnets.Properties; props != nil {
+		if err := d.Set("subnet", flattenArmTrafficManagerEndpointSubnets(props.Subnets)); err != nil {
+			return fmt.Errorf("Error setting `subnet`: %+v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -269,3 +330,85 @@ func getArmTrafficManagerEndpointProperties(d *schema.ResourceData) *trafficmana
 
 	return &endpointProps
 }
+
+func expandArmTrafficManagerEndpointSubnets(input []interface{}) ([]trafficManagerEndpointSubnet, error) {
+	subnets := make([]trafficManagerEndpointSubnet, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		first := raw["first"].(string)
+		last := raw["last"].(string)
+		scope := raw["scope"].(int)
+
+		subnet := trafficManagerEndpointSubnet{
+			First: utils.String(first),
+		}
+
+		// a range is either specified using the `last` address or a `scope` (the CIDR prefix length)
+		if last != "" && scope != 0 {
+			return nil, fmt.Errorf("Only one of `last` and `scope` can be specified for the `subnet` starting at %q", first)
+		}
+
+		if last != "" {
+			subnet.Last = utils.String(last)
+		}
+
+		if scope != 0 {
+			subnet.Scope = utils.Int32(int32(scope))
+		}
+
+		subnets = append(subnets, subnet)
+	}
+
+	return subnets, nil
+}
+
+// expandTrafficManagerEndpointWithSubnets adds the `subnets` mappings (which aren't available in the Azure SDK)
+// to the payload for a Traffic Manager Endpoint
+func expandTrafficManagerEndpointWithSubnets(endpoint trafficmanager.Endpoint, subnets []trafficManagerEndpointSubnet) (map[string]interface{}, error) {
+	serialized, err := json.Marshal(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing Traffic Manager Endpoint: %+v", err)
+	}
+
+	output := make(map[string]interface{})
+	if err := json.Unmarshal(serialized, &output); err != nil {
+		return nil, fmt.Errorf("Error deserializing Traffic Manager Endpoint: %+v", err)
+	}
+
+	properties, ok := output["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["subnets"] = subnets
+	output["properties"] = properties
+
+	return output, nil
+}
+
+func flattenArmTrafficManagerEndpointSubnets(input *[]trafficManagerEndpointSubnet) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, subnet := range *input {
+		result := make(map[string]interface{})
+
+		if subnet.First != nil {
+			result["first"] = *subnet.First
+		}
+
+		if subnet.Last != nil {
+			result["last"] = *subnet.Last
+		}
+
+		if subnet.Scope != nil {
+			result["scope"] = int(*subnet.Scope)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -251,6 +251,86 @@ func TestAccAzureRMTrafficManagerEndpoint_withGeoMappings(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMTrafficManagerEndpoint_withSubnets(t *testing.T) {
+	resourceName := "azurerm_traffic_manager_endpoint.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	first := testAccAzureRMTrafficManagerEndpoint_subnets(ri, location)
+	second := testAccAzureRMTrafficManagerEndpoint_subnetsUpdated(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficManagerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: first,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficManagerEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subnet.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.first", "1.2.3.0"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.scope", "24"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.1.first", "11.12.13.14"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.1.last", "11.12.13.18"),
+				),
+			},
+			{
+				Config: second,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficManagerEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subnet.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.first", "12.34.56.78"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.last", "12.34.56.78"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestExpandArmTrafficManagerEndpointSubnets(t *testing.T) {
+	subnets, err := expandArmTrafficManagerEndpointSubnets([]interface{}{
+		map[string]interface{}{
+			"first": "1.2.3.0",
+			"last":  "",
+			"scope": 24,
+		},
+		map[string]interface{}{
+			"first": "11.12.13.14",
+			"last":  "11.12.13.18",
+			"scope": 0,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if len(subnets) != 2 {
+		t.Fatalf("Expected 2 subnets but got %d", len(subnets))
+	}
+	if subnets[0].Last != nil || subnets[0].Scope == nil || *subnets[0].Scope != 24 {
+		t.Fatalf("Expected the first subnet to only have a `scope` of 24 but got %+v", subnets[0])
+	}
+	if subnets[1].Scope != nil || subnets[1].Last == nil || *subnets[1].Last != "11.12.13.18" {
+		t.Fatalf("Expected the second subnet to only have a `last` of 11.12.13.18 but got %+v", subnets[1])
+	}
+
+	_, err = expandArmTrafficManagerEndpointSubnets([]interface{}{
+		map[string]interface{}{
+			"first": "1.2.3.0",
+			"last":  "1.2.3.255",
+			"scope": 24,
+		},
+	})
+	if err == nil {
+		t.Fatalf("Expected an error when both `last` and `scope` are specified but didn't get one")
+	}
+}
+
 func testCheckAzureRMTrafficManagerEndpointExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -828,3 +908,86 @@ resource "azurerm_traffic_manager_endpoint" "test" {
 }
 `, rInt, location, rInt, rInt)
 }
+
+func testAccAzureRMTrafficManagerEndpoint_subnets(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctesttmp%d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  traffic_routing_method = "Subnet"
+
+  dns_config {
+    relative_name = "acctesttmp%d"
+    ttl           = 100
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+
+resource "azurerm_traffic_manager_endpoint" "test" {
+  name                = "acctestend-external%d"
+  type                = "externalEndpoints"
+  target              = "terraform.io"
+  profile_name        = "${azurerm_traffic_manager_profile.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  subnet {
+    first = "1.2.3.0"
+    scope = 24
+  }
+
+  subnet {
+    first = "11.12.13.14"
+    last  = "11.12.13.18"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMTrafficManagerEndpoint_subnetsUpdated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctesttmp%d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  traffic_routing_method = "Subnet"
+
+  dns_config {
+    relative_name = "acctesttmp%d"
+    ttl           = 100
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+
+resource "azurerm_traffic_manager_endpoint" "test" {
+  name                = "acctestend-external%d"
+  type                = "externalEndpoints"
+  target              = "terraform.io"
+  profile_name        = "${azurerm_traffic_manager_profile.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  subnet {
+    first = "12.34.56.78"
+    last  = "12.34.56.78"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -51,12 +52,21 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 					string(trafficmanager.Weighted),
 					string(trafficmanager.Performance),
 					string(trafficmanager.Priority),
+					trafficManagerTrafficRoutingMethodMultiValue,
+					trafficManagerTrafficRoutingMethodSubnet,
 				}, false),
 			},
 
+			"max_return": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 8),
+			},
+
 			"dns_config": {
 				Type:     schema.TypeSet,
 				Required: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"relative_name": {
@@ -115,9 +125,10 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 }
 
 func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerProfilesClient
+	client := meta.(*ArmClient).trafficManagerClient
+	ctx := meta.(*ArmClient).StopContext
 
-	log.Printf("[INFO] preparing arguments for Azure ARM virtual network creation.")
+	log.Printf("[INFO] preparing arguments for Azure ARM Traffic Manager Profile creation.")
 
 	name := d.Get("name").(string)
 	// must be provided in request
@@ -125,34 +136,35 @@ func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interfa
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
-	profile := trafficmanager.Profile{
-		Name:              &name,
-		Location:          &location,
-		ProfileProperties: getArmTrafficManagerProfileProperties(d),
-		Tags:              expandTags(tags),
-	}
-
-	ctx := meta.(*ArmClient).StopContext
-	_, err := client.CreateOrUpdate(ctx, resGroup, name, profile)
+	props, err := getArmTrafficManagerProfileProperties(d)
 	if err != nil {
 		return err
 	}
 
-	read, err := client.Get(ctx, resGroup, name)
+	profile := trafficManagerProfile{
+		Name:       &name,
+		Location:   &location,
+		Properties: props,
+		Tags:       expandTags(tags, meta),
+	}
+
+	id := azureRMResourceID(meta.(*ArmClient).subscriptionId, resGroup, "Microsoft.Network", "trafficManagerProfiles", name)
+	future, err := client.CreateOrUpdate(ctx, id, profile)
 	if err != nil {
-		return err
+		return fmt.Errorf("Error creating/updating Traffic Manager Profile %q (Resource Group %q): %+v", name, resGroup, err)
 	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read TrafficManager profile %s (resource group %s) ID", name, resGroup)
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Traffic Manager Profile %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	d.SetId(*read.ID)
+	d.SetId(id)
 
 	return resourceArmTrafficManagerProfileRead(d, meta)
 }
 
 func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerProfilesClient
+	client := meta.(*ArmClient).trafficManagerClient
 	ctx := meta.(*ArmClient).StopContext
 
 	id, err := parseAzureResourceID(d.Id())
@@ -162,16 +174,20 @@ func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface
 	resGroup := id.ResourceGroup
 	name := id.Path["trafficManagerProfiles"]
 
-	resp, err := client.Get(ctx, resGroup, name)
+	var resp trafficManagerProfile
+	read, err := client.Get(ctx, d.Id(), &resp)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if utils.ResponseWasNotFound(read) {
 			d.SetId("")
 			return nil
 		}
 		return fmt.Errorf("Error making Read request on Traffic Manager Profile %s: %+v", name, err)
 	}
 
-	profile := *resp.ProfileProperties
+	if resp.Properties == nil {
+		return fmt.Errorf("Error making Read request on Traffic Manager Profile %s: `properties` was nil", name)
+	}
+	profile := *resp.Properties
 
 	// update appropriate values
 	d.Set("resource_group_name", resGroup)
@@ -179,22 +195,29 @@ func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface
 	d.Set("profile_status", profile.ProfileStatus)
 	d.Set("traffic_routing_method", profile.TrafficRoutingMethod)
 
+	if profile.MaxReturn != nil {
+		d.Set("max_return", int(*profile.MaxReturn))
+	}
+
 	dnsFlat := flattenAzureRMTrafficManagerProfileDNSConfig(profile.DNSConfig)
 	d.Set("dns_config", schema.NewSet(resourceAzureRMTrafficManagerDNSConfigHash, dnsFlat))
 
 	// fqdn is actually inside DNSConfig, inlined for simpler reference
-	d.Set("fqdn", profile.DNSConfig.Fqdn)
+	if profile.DNSConfig != nil {
+		d.Set("fqdn", profile.DNSConfig.Fqdn)
+	}
 
 	monitorFlat := flattenAzureRMTrafficManagerProfileMonitorConfig(profile.MonitorConfig)
 	d.Set("monitor_config", schema.NewSet(resourceAzureRMTrafficManagerMonitorConfigHash, monitorFlat))
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmTrafficManagerProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerProfilesClient
+	client := meta.(*ArmClient).trafficManagerClient
+	ctx := meta.(*ArmClient).StopContext
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -203,34 +226,52 @@ func resourceArmTrafficManagerProfileDelete(d *schema.ResourceData, meta interfa
 	resGroup := id.ResourceGroup
 	name := id.Path["trafficManagerProfiles"]
 
-	ctx := meta.(*ArmClient).StopContext
-	resp, err := client.Delete(ctx, resGroup, name)
+	future, err := client.Delete(ctx, d.Id())
 	if err != nil {
-		if !utils.ResponseWasNotFound(resp.Response) {
-			return err
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Traffic Manager Profile %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Traffic Manager Profile %q (Resource Group %q): %+v", name, resGroup, err)
 		}
 	}
 
 	return nil
 }
 
-func getArmTrafficManagerProfileProperties(d *schema.ResourceData) *trafficmanager.ProfileProperties {
+func getArmTrafficManagerProfileProperties(d *schema.ResourceData) (*trafficManagerProfileProperties, error) {
 	routingMethod := d.Get("traffic_routing_method").(string)
-	props := &trafficmanager.ProfileProperties{
-		TrafficRoutingMethod: trafficmanager.TrafficRoutingMethod(routingMethod),
+	props := &trafficManagerProfileProperties{
+		TrafficRoutingMethod: routingMethod,
 		DNSConfig:            expandArmTrafficManagerDNSConfig(d),
 		MonitorConfig:        expandArmTrafficManagerMonitorConfig(d),
 	}
 
 	if status, ok := d.GetOk("profile_status"); ok {
-		s := status.(string)
-		props.ProfileStatus = trafficmanager.ProfileStatus(s)
+		props.ProfileStatus = status.(string)
+	}
+
+	// `max_return` is required when using the `MultiValue` Traffic Routing Method - and can't be used otherwise
+	maxReturn := d.Get("max_return").(int)
+	if routingMethod == trafficManagerTrafficRoutingMethodMultiValue {
+		if maxReturn == 0 {
+			return nil, fmt.Errorf("`max_return` must be specified when `traffic_routing_method` is set to %q", trafficManagerTrafficRoutingMethodMultiValue)
+		}
+
+		props.MaxReturn = utils.Int64(int64(maxReturn))
+	} else if maxReturn != 0 {
+		return nil, fmt.Errorf("`max_return` can only be specified when `traffic_routing_method` is set to %q", trafficManagerTrafficRoutingMethodMultiValue)
 	}
 
-	return props
+	return props, nil
 }
 
-func expandArmTrafficManagerMonitorConfig(d *schema.ResourceData) *trafficmanager.MonitorConfig {
+func expandArmTrafficManagerMonitorConfig(d *schema.ResourceData) *trafficManagerMonitorConfig {
 	monitorSets := d.Get("monitor_config").(*schema.Set).List()
 	monitor := monitorSets[0].(map[string]interface{})
 
@@ -238,40 +279,54 @@ func expandArmTrafficManagerMonitorConfig(d *schema.ResourceData) *trafficmanage
 	port := int64(monitor["port"].(int))
 	path := monitor["path"].(string)
 
-	return &trafficmanager.MonitorConfig{
-		Protocol: trafficmanager.MonitorProtocol(proto),
+	return &trafficManagerMonitorConfig{
+		Protocol: proto,
 		Port:     &port,
 		Path:     &path,
 	}
 }
 
-func expandArmTrafficManagerDNSConfig(d *schema.ResourceData) *trafficmanager.DNSConfig {
+func expandArmTrafficManagerDNSConfig(d *schema.ResourceData) *trafficManagerDNSConfig {
 	dnsSets := d.Get("dns_config").(*schema.Set).List()
 	dns := dnsSets[0].(map[string]interface{})
 
 	name := dns["relative_name"].(string)
 	ttl := int64(dns["ttl"].(int))
 
-	return &trafficmanager.DNSConfig{
+	return &trafficManagerDNSConfig{
 		RelativeName: &name,
 		TTL:          &ttl,
 	}
 }
 
-func flattenAzureRMTrafficManagerProfileDNSConfig(dns *trafficmanager.DNSConfig) []interface{} {
+func flattenAzureRMTrafficManagerProfileDNSConfig(dns *trafficManagerDNSConfig) []interface{} {
+	if dns == nil {
+		return []interface{}{}
+	}
+
 	result := make(map[string]interface{})
 
-	result["relative_name"] = *dns.RelativeName
-	result["ttl"] = int(*dns.TTL)
+	if dns.RelativeName != nil {
+		result["relative_name"] = *dns.RelativeName
+	}
+	if dns.TTL != nil {
+		result["ttl"] = int(*dns.TTL)
+	}
 
 	return []interface{}{result}
 }
 
-func flattenAzureRMTrafficManagerProfileMonitorConfig(cfg *trafficmanager.MonitorConfig) []interface{} {
+func flattenAzureRMTrafficManagerProfileMonitorConfig(cfg *trafficManagerMonitorConfig) []interface{} {
+	if cfg == nil {
+		return []interface{}{}
+	}
+
 	result := make(map[string]interface{})
 
-	result["protocol"] = string(cfg.Protocol)
-	result["port"] = int(*cfg.Port)
+	result["protocol"] = cfg.Protocol
+	if cfg.Port != nil {
+		result["port"] = int(*cfg.Port)
+	}
 
 	if cfg.Path != nil {
 		result["path"] = *cfg.Path
@@ -283,9 +338,11 @@ func flattenAzureRMTrafficManagerProfileMonitorConfig(cfg *trafficmanager.Monito
 func resourceAzureRMTrafficManagerDNSConfigHash(v interface{}) int {
 	var buf bytes.Buffer
 
+	// the `ttl` is intentionally excluded from the hash, so that changing it updates the existing
+	// `dns_config` in-place rather than replacing it - which, since `relative_name` is ForceNew,
+	// would otherwise recreate the Traffic Manager Profile
 	if m, ok := v.(map[string]interface{}); ok {
 		buf.WriteString(fmt.Sprintf("%s-", m["relative_name"].(string)))
-		buf.WriteString(fmt.Sprintf("%d-", m["ttl"].(int)))
 	}
 
 	return hashcode.String(buf.String())
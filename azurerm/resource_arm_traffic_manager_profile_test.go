@@ -3,12 +3,13 @@ package azurerm
 import (
 	"fmt"
 	"log"
-	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func getTrafficManagerFQDN(hostname string) (string, error) {
@@ -20,6 +21,102 @@ func getTrafficManagerFQDN(hostname string) (string, error) {
 	return fmt.Sprintf("%s.%s", hostname, dnsSuffix), nil
 }
 
+func TestGetArmTrafficManagerProfileProperties_maxReturn(t *testing.T) {
+	cases := []struct {
+		RoutingMethod     string
+		MaxReturn         int
+		ExpectedMaxReturn *int64
+		ShouldError       bool
+	}{
+		{RoutingMethod: "MultiValue", MaxReturn: 2, ExpectedMaxReturn: utils.Int64(2)},
+		{RoutingMethod: "MultiValue", ShouldError: true},
+		{RoutingMethod: "Weighted"},
+		{RoutingMethod: "Weighted", MaxReturn: 2, ShouldError: true},
+	}
+
+	for _, tc := range cases {
+		raw := map[string]interface{}{
+			"name":                   "profile1",
+			"resource_group_name":    "group1",
+			"traffic_routing_method": tc.RoutingMethod,
+			"dns_config": []interface{}{
+				map[string]interface{}{
+					"relative_name": "profile1",
+					"ttl":           30,
+				},
+			},
+			"monitor_config": []interface{}{
+				map[string]interface{}{
+					"protocol": "HTTPS",
+					"port":     443,
+					"path":     "/",
+				},
+			},
+		}
+		if tc.MaxReturn != 0 {
+			raw["max_return"] = tc.MaxReturn
+		}
+
+		d := schema.TestResourceDataRaw(t, resourceArmTrafficManagerProfile().Schema, raw)
+		props, err := getArmTrafficManagerProfileProperties(d)
+		if tc.ShouldError {
+			if err == nil {
+				t.Fatalf("Expected an error for Traffic Routing Method %q with `max_return` %d but got none", tc.RoutingMethod, tc.MaxReturn)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Error expanding the properties for Traffic Routing Method %q: %+v", tc.RoutingMethod, err)
+		}
+
+		if tc.ExpectedMaxReturn == nil {
+			if props.MaxReturn != nil {
+				t.Fatalf("Expected no `maxReturn` for Traffic Routing Method %q but got %d", tc.RoutingMethod, *props.MaxReturn)
+			}
+			continue
+		}
+
+		if props.MaxReturn == nil || *props.MaxReturn != *tc.ExpectedMaxReturn {
+			t.Fatalf("Expected `maxReturn` to be %d for Traffic Routing Method %q but got %+v", *tc.ExpectedMaxReturn, tc.RoutingMethod, props.MaxReturn)
+		}
+	}
+}
+
+func TestAccAzureRMTrafficManagerProfile_multiValue(t *testing.T) {
+	resourceName := "azurerm_traffic_manager_profile.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficManagerProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficManagerProfile_multiValue(ri, location, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficManagerProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_method", "MultiValue"),
+					resource.TestCheckResourceAttr(resourceName, "max_return", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMTrafficManagerProfile_multiValue(ri, location, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficManagerProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_return", "4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMTrafficManagerProfile_geographic(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
 	ri := acctest.RandInt()
@@ -217,6 +314,62 @@ func TestAccAzureRMTrafficManagerProfile_performanceToGeographic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMTrafficManagerProfile_updateTTL(t *testing.T) {
+	resourceName := "azurerm_traffic_manager_profile.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	var profileId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficManagerProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficManagerProfile_ttl(ri, location, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficManagerProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dns_config.#", "1"),
+					func(s *terraform.State) error {
+						profileId = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccAzureRMTrafficManagerProfile_ttl(ri, location, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficManagerProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dns_config.#", "1"),
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &profileId),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAzureRMTrafficManagerDNSConfigHash(t *testing.T) {
+	first := resourceAzureRMTrafficManagerDNSConfigHash(map[string]interface{}{
+		"relative_name": "example",
+		"ttl":           30,
+	})
+	second := resourceAzureRMTrafficManagerDNSConfigHash(map[string]interface{}{
+		"relative_name": "example",
+		"ttl":           300,
+	})
+	if first != second {
+		t.Fatalf("Expected changing the `ttl` not to change the hash but got %d and %d", first, second)
+	}
+
+	other := resourceAzureRMTrafficManagerDNSConfigHash(map[string]interface{}{
+		"relative_name": "other",
+		"ttl":           30,
+	})
+	if first == other {
+		t.Fatalf("Expected changing the `relative_name` to change the hash")
+	}
+}
+
 func TestAccAzureRMTrafficManagerProfile_priorityToWeighted(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
 	ri := acctest.RandInt()
@@ -268,15 +421,17 @@ func testCheckAzureRMTrafficManagerProfileExists(name string) resource.TestCheck
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		conn := testAccProvider.Meta().(*ArmClient).trafficManagerProfilesClient
+		conn := testAccProvider.Meta().(*ArmClient).trafficManagerClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
-		resp, err := conn.Get(ctx, resourceGroup, name)
+
+		var profile trafficManagerProfile
+		resp, err := conn.Get(ctx, rs.Primary.ID, &profile)
 		if err != nil {
-			return fmt.Errorf("Bad: Get on trafficManagerProfilesClient: %+v", err)
-		}
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Traffic Manager %q (resource group: %q) does not exist", name, resourceGroup)
+			}
 
-		if resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("Bad: Traffic Manager %q (resource group: %q) does not exist", name, resourceGroup)
+			return fmt.Errorf("Bad: Get on trafficManagerClient: %+v", err)
 		}
 
 		return nil
@@ -284,7 +439,7 @@ func testCheckAzureRMTrafficManagerProfileExists(name string) resource.TestCheck
 }
 
 func testCheckAzureRMTrafficManagerProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).trafficManagerProfilesClient
+	conn := testAccProvider.Meta().(*ArmClient).trafficManagerClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_traffic_manager_profile" {
@@ -293,22 +448,51 @@ func testCheckAzureRMTrafficManagerProfileDestroy(s *terraform.State) error {
 
 		log.Printf("[TRACE] test_profile %#v", rs)
 
-		name := rs.Primary.Attributes["name"]
-		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
-		resp, err := conn.Get(ctx, resourceGroup, name)
+
+		var profile trafficManagerProfile
+		resp, err := conn.Get(ctx, rs.Primary.ID, &profile)
 		if err != nil {
-			return nil
-		}
+			if utils.ResponseWasNotFound(resp) {
+				return nil
+			}
 
-		if resp.StatusCode != http.StatusNotFound {
-			return fmt.Errorf("Traffic Manager profile sitll exists:\n%#v", resp.ProfileProperties)
+			return err
 		}
+
+		return fmt.Errorf("Traffic Manager profile still exists:\n%#v", profile.Properties)
 	}
 
 	return nil
 }
 
+func testAccAzureRMTrafficManagerProfile_multiValue(rInt int, location string, maxReturn int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctesttmp%d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  traffic_routing_method = "MultiValue"
+  max_return             = %d
+
+  dns_config {
+    relative_name = "acctesttmp%d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+`, rInt, location, rInt, maxReturn, rInt)
+}
+
 func testAccAzureRMTrafficManagerProfile_geographic(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -335,6 +519,32 @@ resource "azurerm_traffic_manager_profile" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMTrafficManagerProfile_ttl(rInt int, location string, ttl int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctesttmp%d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  traffic_routing_method = "Geographic"
+
+  dns_config {
+    relative_name = "acctesttmp%d"
+    ttl           = %d
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+`, rInt, location, rInt, rInt, ttl)
+}
+
 func testAccAzureRMTrafficManagerProfile_weighted(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -55,7 +55,7 @@ func resourceArmUserAssignedIdentityCreateUpdate(d *schema.ResourceData, meta in
 	identity := msi.Identity{
 		Name:     &name,
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 	}
 
 	_, err := client.CreateOrUpdate(ctx, resGroup, name, identity)
@@ -107,7 +107,7 @@ func resourceArmUserAssignedIdentityRead(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -0,0 +1,193 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var virtualHubResourceName = "azurerm_virtual_hub"
+
+func resourceArmVirtualHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualHubCreateUpdate,
+		Read:   resourceArmVirtualHubRead,
+		Update: resourceArmVirtualHubCreateUpdate,
+		Delete: resourceArmVirtualHubDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"virtual_wan_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"address_prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.CIDRNetwork(0, 32),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVirtualHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Virtual Hub creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	virtualWanId := d.Get("virtual_wan_id").(string)
+	addressPrefix := d.Get("address_prefix").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	azureRMLockByName(name, virtualHubResourceName)
+	defer azureRMUnlockByName(name, virtualHubResourceName)
+
+	hub := network.VirtualHub{
+		Location: utils.String(location),
+		Tags:     expandTags(tags, meta),
+		VirtualHubProperties: &network.VirtualHubProperties{
+			VirtualWan: &network.SubResource{
+				ID: utils.String(virtualWanId),
+			},
+			AddressPrefix: utils.String(addressPrefix),
+		},
+	}
+
+	// Virtual Network Connections are managed by the `azurerm_virtual_hub_connection` resource,
+	// so any which already exist need to be sent back to avoid removing them
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := existing.VirtualHubProperties; props != nil {
+			hub.VirtualHubProperties.HubVirtualNetworkConnections = props.HubVirtualNetworkConnections
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, hub)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Network", "virtualHubs", name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Virtual Hub %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualHubRead(d, meta)
+}
+
+func resourceArmVirtualHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["virtualHubs"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Hub %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.VirtualHubProperties; props != nil {
+		d.Set("address_prefix", props.AddressPrefix)
+
+		if wan := props.VirtualWan; wan != nil {
+			d.Set("virtual_wan_id", wan.ID)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmVirtualHubDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["virtualHubs"]
+
+	azureRMLockByName(name, virtualHubResourceName)
+	defer azureRMUnlockByName(name, virtualHubResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualHubConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualHubConnectionCreateUpdate,
+		Read:   resourceArmVirtualHubConnectionRead,
+		Update: resourceArmVirtualHubConnectionCreateUpdate,
+		Delete: resourceArmVirtualHubConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"remote_virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"hub_to_virtual_network_traffic_allowed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"virtual_network_to_hub_gateways_traffic_allowed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualHubConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Virtual Hub Connection creation/update.")
+
+	name := d.Get("name").(string)
+
+	hubId, err := parseAzureResourceID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := hubId.ResourceGroup
+	hubName := hubId.Path["virtualHubs"]
+
+	// the Connections are a property of the Virtual Hub, so changes to either need to be serialised
+	azureRMLockByName(hubName, virtualHubResourceName)
+	defer azureRMUnlockByName(hubName, virtualHubResourceName)
+
+	hub, err := client.Get(ctx, resourceGroup, hubName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", hubName, resourceGroup, err)
+	}
+
+	if hub.VirtualHubProperties == nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): `properties` was nil", hubName, resourceGroup)
+	}
+
+	connection := network.HubVirtualNetworkConnection{
+		Name: utils.String(name),
+		HubVirtualNetworkConnectionProperties: &network.HubVirtualNetworkConnectionProperties{
+			RemoteVirtualNetwork: &network.SubResource{
+				ID: utils.String(d.Get("remote_virtual_network_id").(string)),
+			},
+			AllowHubToRemoteVnetTransit:         utils.Bool(d.Get("hub_to_virtual_network_traffic_allowed").(bool)),
+			AllowRemoteVnetToUseHubVnetGateways: utils.Bool(d.Get("virtual_network_to_hub_gateways_traffic_allowed").(bool)),
+		},
+	}
+
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	found := false
+	if existing := hub.VirtualHubProperties.HubVirtualNetworkConnections; existing != nil {
+		for _, v := range *existing {
+			if v.Name != nil && strings.EqualFold(*v.Name, name) {
+				if d.IsNewResource() {
+					return fmt.Errorf("A Connection named %q already exists in Virtual Hub %q (Resource Group %q) - please import it into the State using `terraform import`", name, hubName, resourceGroup)
+				}
+
+				connections = append(connections, connection)
+				found = true
+				continue
+			}
+
+			connections = append(connections, v)
+		}
+	}
+
+	if !found {
+		connections = append(connections, connection)
+	}
+	hub.VirtualHubProperties.HubVirtualNetworkConnections = &connections
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, hubName, hub)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Connection %q (Virtual Hub %q / Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := fmt.Sprintf("%s/hubVirtualNetworkConnections/%s", azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Network", "virtualHubs", hubName), name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Connection %q (Virtual Hub %q / Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	read, err := meta.(*ArmClient).hubVnetConnectionsClient.Get(ctx, resourceGroup, hubName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Connection %q (Virtual Hub %q / Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Connection %q (Virtual Hub %q / Resource Group %q)", name, hubName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualHubConnectionRead(d, meta)
+}
+
+func resourceArmVirtualHubConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).hubVnetConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	hubName := id.Path["virtualHubs"]
+	name := id.Path["hubVirtualNetworkConnections"]
+
+	resp, err := client.Get(ctx, resourceGroup, hubName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Connection %q was not found in Virtual Hub %q / Resource Group %q - removing from state!", name, hubName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Connection %q (Virtual Hub %q / Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("virtual_hub_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s", id.SubscriptionID, resourceGroup, hubName))
+
+	if props := resp.HubVirtualNetworkConnectionProperties; props != nil {
+		d.Set("hub_to_virtual_network_traffic_allowed", props.AllowHubToRemoteVnetTransit)
+		d.Set("virtual_network_to_hub_gateways_traffic_allowed", props.AllowRemoteVnetToUseHubVnetGateways)
+
+		if remote := props.RemoteVirtualNetwork; remote != nil {
+			d.Set("remote_virtual_network_id", remote.ID)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmVirtualHubConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	hubName := id.Path["virtualHubs"]
+	name := id.Path["hubVirtualNetworkConnections"]
+
+	azureRMLockByName(hubName, virtualHubResourceName)
+	defer azureRMUnlockByName(hubName, virtualHubResourceName)
+
+	hub, err := client.Get(ctx, resourceGroup, hubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", hubName, resourceGroup, err)
+	}
+
+	props := hub.VirtualHubProperties
+	if props == nil || props.HubVirtualNetworkConnections == nil {
+		return nil
+	}
+
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	for _, v := range *props.HubVirtualNetworkConnections {
+		if v.Name != nil && strings.EqualFold(*v.Name, name) {
+			continue
+		}
+
+		connections = append(connections, v)
+	}
+	props.HubVirtualNetworkConnections = &connections
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, hubName, hub)
+	if err != nil {
+		return fmt.Errorf("Error removing Connection %q from Virtual Hub %q (Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Connection %q from Virtual Hub %q (Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	return nil
+}
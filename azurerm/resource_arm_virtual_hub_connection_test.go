@@ -0,0 +1,168 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualHubConnection_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualHubConnection_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hub_to_virtual_network_traffic_allowed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "virtual_network_to_hub_gateways_traffic_allowed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHubConnection_update(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hub_to_virtual_network_traffic_allowed", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualHubConnection_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hub_to_virtual_network_traffic_allowed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "virtual_network_to_hub_gateways_traffic_allowed", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualHubConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		parentName := id.Path["virtualHubs"]
+		name := id.Path["hubVirtualNetworkConnections"]
+
+		client := testAccProvider.Meta().(*ArmClient).hubVnetConnectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, parentName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Connection %q (Virtual Hub %q / Resource Group %q) does not exist", name, parentName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on hubVnetConnectionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualHubConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).hubVnetConnectionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_hub_connection" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		parentName := id.Path["virtualHubs"]
+		name := id.Path["hubVirtualNetworkConnections"]
+
+		resp, err := client.Get(ctx, resourceGroup, parentName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Connection still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualHubConnection_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.2.0/24"]
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctestvhubconn-%d"
+  virtual_hub_id            = "${azurerm_virtual_hub.test.id}"
+  remote_virtual_network_id = "${azurerm_virtual_network.test.id}"
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMVirtualHubConnection_complete(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.2.0/24"]
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                                            = "acctestvhubconn-%d"
+  virtual_hub_id                                  = "${azurerm_virtual_hub.test.id}"
+  remote_virtual_network_id                       = "${azurerm_virtual_network.test.id}"
+  hub_to_virtual_network_traffic_allowed          = true
+  virtual_network_to_hub_gateways_traffic_allowed = true
+}
+`, template, rInt, rInt)
+}
@@ -0,0 +1,153 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualHub_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_hub.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualHub_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_prefix", "10.0.1.0/24"),
+					resource.TestCheckResourceAttrSet(resourceName, "virtual_wan_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHub_update(t *testing.T) {
+	resourceName := "azurerm_virtual_hub.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHub_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualHub_tags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualHubExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Virtual Hub: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).virtualHubsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Hub %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on virtualHubsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualHubDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).virtualHubsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_hub" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual Hub still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualHub_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualWan_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctestvhub-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  address_prefix      = "10.0.1.0/24"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualHub_tags(rInt int, location string) string {
+	template := testAccAzureRMVirtualWan_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctestvhub-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  address_prefix      = "10.0.1.0/24"
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
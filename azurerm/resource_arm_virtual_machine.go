@@ -13,6 +13,10 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"golang.org/x/net/context"
 )
@@ -76,6 +80,14 @@ func resourceArmVirtualMachine() *schema.Resource {
 				},
 			},
 
+			"proximity_placement_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
 			"identity": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -329,6 +341,12 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Default:  false,
 			},
 
+			"delete_network_interfaces_on_termination": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"boot_diagnostics": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -340,9 +358,20 @@ func resourceArmVirtualMachine() *schema.Resource {
 							Required: true,
 						},
 
+						// when omitted the Boot Diagnostics are stored in a Managed Storage Account
 						"storage_uri": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
+						},
+
+						"console_screenshot_blob_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"serial_console_log_blob_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
 						},
 					},
 				},
@@ -559,9 +588,25 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTags(tags, meta)
 	zones := expandZones(d.Get("zones").([]interface{}))
 
+	if hasOnlyTagChanges(d, "azurerm_virtual_machine", resourceArmVirtualMachine) {
+		log.Printf("[DEBUG] Only `tags` have changed - updating the Tags for Virtual Machine %q (Resource Group %q)", name, resGroup)
+		future, err := client.Update(ctx, resGroup, name, compute.VirtualMachineUpdate{
+			Tags: expandedTags,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating Tags for Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Tags for Virtual Machine %q (Resource Group %q) to be updated: %+v", name, resGroup, err)
+		}
+
+		return resourceArmVirtualMachineRead(d, meta)
+	}
+
 	osDisk, err := expandAzureRmVirtualMachineOsDisk(d)
 	if err != nil {
 		return err
@@ -629,8 +674,8 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 		Name:                     &name,
 		Location:                 &location,
 		VirtualMachineProperties: &properties,
-		Tags:  expandedTags,
-		Zones: zones,
+		Tags:                     expandedTags,
+		Zones:                    zones,
 	}
 
 	if _, ok := d.GetOk("identity"); ok {
@@ -650,14 +695,35 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockByName(name, virtualMachineResourceName)
 	defer azureRMUnlockByName(name, virtualMachineResourceName)
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, vm)
-	if err != nil {
-		return err
-	}
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Compute", "virtualMachines", name)
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return err
+	if proximityPlacementGroupId := d.Get("proximity_placement_group_id").(string); proximityPlacementGroupId != "" {
+		// placing a Virtual Machine within a Proximity Placement Group requires a newer API Version than the Azure SDK supports
+		placementClient := meta.(*ArmClient).computeProximityPlacementClient
+
+		body, err := expandComputeResourceWithProximityPlacementGroup(vm, proximityPlacementGroupId)
+		if err != nil {
+			return err
+		}
+
+		future, err := placementClient.CreateOrUpdate(ctx, expectedId, body)
+		if err != nil {
+			return err
+		}
+
+		if err := waitForCreateOrUpdate(ctx, d, &future.Future, placementClient.Client, expectedId); err != nil {
+			return err
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, vm)
+		if err != nil {
+			return err
+		}
+
+		if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+			return err
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name, "")
@@ -702,7 +768,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 	resGroup := id.ResourceGroup
 	name := id.Path["virtualMachines"]
 
-	resp, err := vmClient.Get(ctx, resGroup, name, "")
+	resp, err := vmClient.Get(ctx, resGroup, name, compute.InstanceView)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			d.SetId("")
@@ -730,6 +796,12 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("availability_set_id", strings.ToLower(*resp.VirtualMachineProperties.AvailabilitySet.ID))
 	}
 
+	proximityPlacementGroupId, err := retrieveProximityPlacementGroupId(ctx, meta.(*ArmClient).computeProximityPlacementClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving Proximity Placement Group for Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	d.Set("proximity_placement_group_id", proximityPlacementGroupId)
+
 	d.Set("vm_size", resp.VirtualMachineProperties.HardwareProfile.VMSize)
 
 	if resp.VirtualMachineProperties.StorageProfile.ImageReference != nil {
@@ -787,7 +859,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if resp.VirtualMachineProperties.DiagnosticsProfile != nil && resp.VirtualMachineProperties.DiagnosticsProfile.BootDiagnostics != nil {
-		if err := d.Set("boot_diagnostics", flattenAzureRmVirtualMachineDiagnosticsProfile(resp.VirtualMachineProperties.DiagnosticsProfile.BootDiagnostics)); err != nil {
+		if err := d.Set("boot_diagnostics", flattenAzureRmVirtualMachineDiagnosticsProfile(resp.VirtualMachineProperties.DiagnosticsProfile.BootDiagnostics, resp.VirtualMachineProperties.InstanceView)); err != nil {
 			return fmt.Errorf("[DEBUG] Error setting Virtual Machine Diagnostics Profile: %#v", err)
 		}
 	}
@@ -809,7 +881,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -828,30 +900,46 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 	azureRMLockByName(name, virtualMachineResourceName)
 	defer azureRMUnlockByName(name, virtualMachineResourceName)
 
+	// retrieve the Virtual Machine prior to deleting it, since the IDs of the Disks attached to it may not be
+	// in the configuration (e.g. Managed Disks created from an Image) - only the Disks and Network Interfaces
+	// defined on this resource are deleted, so that those attached outside of it are left alone
+	virtualMachine, err := client.Get(ctx, resGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(virtualMachine.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return err
 	}
 
+	props := virtualMachine.VirtualMachineProperties
+	if props == nil {
+		return nil
+	}
+
 	// delete OS Disk if opted in
-	if deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool); deleteOsDisk {
+	deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool) || meta.(*ArmClient).features.Enabled(features.VirtualMachineDeleteOSDiskOnDeletion)
+	if deleteOsDisk && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
 		log.Printf("[INFO] delete_os_disk_on_termination is enabled, deleting disk from %s", name)
 
-		osDisk, err := expandAzureRmVirtualMachineOsDisk(d)
-		if err != nil {
-			return fmt.Errorf("Error expanding OS Disk: %s", err)
-		}
-
-		if osDisk.Vhd != nil {
+		osDisk := props.StorageProfile.OsDisk
+		if !virtualMachineDiskIsDefined(d.Get("storage_os_disk").([]interface{}), osDisk.Name, osDisk.Vhd, osDisk.ManagedDisk) {
+			log.Printf("[DEBUG] OS Disk attached to Virtual Machine %q isn't defined in `storage_os_disk` - skipping deletion", name)
+		} else if osDisk.Vhd != nil && osDisk.Vhd.URI != nil {
 			if err = resourceArmVirtualMachineDeleteVhd(*osDisk.Vhd.URI, meta); err != nil {
 				return fmt.Errorf("Error deleting OS Disk VHD: %+v", err)
 			}
-		} else if osDisk.ManagedDisk != nil {
+		} else if osDisk.ManagedDisk != nil && osDisk.ManagedDisk.ID != nil {
 			if err = resourceArmVirtualMachineDeleteManagedDisk(*osDisk.ManagedDisk.ID, meta); err != nil {
 				return fmt.Errorf("Error deleting OS Managed Disk: %+v", err)
 			}
@@ -861,20 +949,22 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 	}
 
 	// delete Data disks if opted in
-	if deleteDataDisks := d.Get("delete_data_disks_on_termination").(bool); deleteDataDisks {
+	deleteDataDisks := d.Get("delete_data_disks_on_termination").(bool)
+	if deleteDataDisks && props.StorageProfile != nil && props.StorageProfile.DataDisks != nil {
 		log.Printf("[INFO] delete_data_disks_on_termination is enabled, deleting each data disk from %s", name)
 
-		disks, err := expandAzureRmVirtualMachineDataDisk(d)
-		if err != nil {
-			return fmt.Errorf("Error expanding Data Disks: %s", err)
-		}
+		dataDisks := d.Get("storage_data_disk").([]interface{})
+		for _, disk := range *props.StorageProfile.DataDisks {
+			if !virtualMachineDiskIsDefined(dataDisks, disk.Name, disk.Vhd, disk.ManagedDisk) {
+				log.Printf("[DEBUG] Data Disk attached to Virtual Machine %q isn't defined in `storage_data_disk` - skipping deletion", name)
+				continue
+			}
 
-		for _, disk := range disks {
-			if disk.Vhd != nil {
+			if disk.Vhd != nil && disk.Vhd.URI != nil {
 				if err = resourceArmVirtualMachineDeleteVhd(*disk.Vhd.URI, meta); err != nil {
 					return fmt.Errorf("Error deleting Data Disk VHD: %+v", err)
 				}
-			} else if disk.ManagedDisk != nil {
+			} else if disk.ManagedDisk != nil && disk.ManagedDisk.ID != nil {
 				if err = resourceArmVirtualMachineDeleteManagedDisk(*disk.ManagedDisk.ID, meta); err != nil {
 					return fmt.Errorf("Error deleting Data Managed Disk: %+v", err)
 				}
@@ -884,9 +974,66 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	// delete Network Interfaces if opted in
+	deleteNetworkInterfaces := d.Get("delete_network_interfaces_on_termination").(bool)
+	if deleteNetworkInterfaces && props.NetworkProfile != nil && props.NetworkProfile.NetworkInterfaces != nil {
+		log.Printf("[INFO] delete_network_interfaces_on_termination is enabled, deleting each network interface from %s", name)
+
+		networkInterfaceIds := d.Get("network_interface_ids").([]interface{})
+		for _, nic := range *props.NetworkProfile.NetworkInterfaces {
+			if nic.ID == nil {
+				continue
+			}
+
+			if !virtualMachineNetworkInterfaceIsDefined(networkInterfaceIds, *nic.ID) {
+				log.Printf("[DEBUG] Network Interface %q attached to Virtual Machine %q isn't defined in `network_interface_ids` - skipping deletion", *nic.ID, name)
+				continue
+			}
+
+			if err = resourceArmVirtualMachineDeleteNetworkInterface(*nic.ID, meta); err != nil {
+				return fmt.Errorf("Error deleting Network Interface: %+v", err)
+			}
+		}
+	}
+
 	return nil
 }
 
+// virtualMachineDiskIsDefined returns whether the Disk attached to the Virtual Machine is defined within the
+// `storage_os_disk` / `storage_data_disk` blocks - matching on either the Name, VHD URI or Managed Disk ID
+func virtualMachineDiskIsDefined(disks []interface{}, name *string, vhd *compute.VirtualHardDisk, managedDisk *compute.ManagedDiskParameters) bool {
+	for _, v := range disks {
+		disk, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name != nil && strings.EqualFold(disk["name"].(string), *name) {
+			return true
+		}
+
+		if uri, ok := disk["vhd_uri"].(string); ok && uri != "" && vhd != nil && vhd.URI != nil && strings.EqualFold(uri, *vhd.URI) {
+			return true
+		}
+
+		if id, ok := disk["managed_disk_id"].(string); ok && id != "" && managedDisk != nil && managedDisk.ID != nil && strings.EqualFold(id, *managedDisk.ID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func virtualMachineNetworkInterfaceIsDefined(networkInterfaceIds []interface{}, id string) bool {
+	for _, v := range networkInterfaceIds {
+		if strings.EqualFold(v.(string), id) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	ctx := armClient.StopContext
@@ -951,7 +1098,7 @@ func resourceArmVirtualMachineDeleteManagedDisk(managedDiskID string, meta inter
 		return fmt.Errorf("Error deleting Managed Disk (%s %s) %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error deleting Managed Disk (%s %s) %+v", name, resGroup, err)
 	}
@@ -959,6 +1106,37 @@ func resourceArmVirtualMachineDeleteManagedDisk(managedDiskID string, meta inter
 	return nil
 }
 
+func resourceArmVirtualMachineDeleteNetworkInterface(networkInterfaceID string, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(networkInterfaceID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["networkInterfaces"]
+
+	azureRMLockByName(name, networkInterfaceResourceName)
+	defer azureRMUnlockByName(name, networkInterfaceResourceName)
+
+	future, err := client.Delete(ctx, resGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Network Interface (%s %s) %+v", name, resGroup, err)
+	}
+
+	err = waitForCompletion(ctx, &future.Future, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error deleting Network Interface (%s %s) %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
 func flattenAzureRmVirtualMachinePlan(plan *compute.Plan) []interface{} {
 	result := make(map[string]interface{})
 	result["name"] = *plan.Name
@@ -1011,7 +1189,7 @@ func flattenAzureRmVirtualMachineIdentity(identity *compute.VirtualMachineIdenti
 	return []interface{}{result}
 }
 
-func flattenAzureRmVirtualMachineDiagnosticsProfile(profile *compute.BootDiagnostics) []interface{} {
+func flattenAzureRmVirtualMachineDiagnosticsProfile(profile *compute.BootDiagnostics, instanceView *compute.VirtualMachineInstanceView) []interface{} {
 	result := make(map[string]interface{})
 
 	result["enabled"] = *profile.Enabled
@@ -1019,6 +1197,15 @@ func flattenAzureRmVirtualMachineDiagnosticsProfile(profile *compute.BootDiagnos
 		result["storage_uri"] = *profile.StorageURI
 	}
 
+	if instanceView != nil && instanceView.BootDiagnostics != nil {
+		if v := instanceView.BootDiagnostics.ConsoleScreenshotBlobURI; v != nil {
+			result["console_screenshot_blob_uri"] = *v
+		}
+		if v := instanceView.BootDiagnostics.SerialConsoleLogBlobURI; v != nil {
+			result["serial_console_log_blob_uri"] = *v
+		}
+	}
+
 	return []interface{}{result}
 }
 
@@ -1533,8 +1720,11 @@ func expandAzureRmVirtualMachineDiagnosticsProfile(d *schema.ResourceData) *comp
 		bootDiagnostic := bootDiagnostics[0].(map[string]interface{})
 
 		diagnostic := &compute.BootDiagnostics{
-			Enabled:    utils.Bool(bootDiagnostic["enabled"].(bool)),
-			StorageURI: utils.String(bootDiagnostic["storage_uri"].(string)),
+			Enabled: utils.Bool(bootDiagnostic["enabled"].(bool)),
+		}
+
+		if storageUri := bootDiagnostic["storage_uri"].(string); storageUri != "" {
+			diagnostic.StorageURI = utils.String(storageUri)
 		}
 
 		diagnosticsProfile.BootDiagnostics = diagnostic
@@ -159,12 +159,13 @@ func resourceArmVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceD
 		return fmt.Errorf("Error updating Virtual Machine %q (Resource Group %q) with Disk %q: %+v", virtualMachineName, resourceGroup, name, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	resourceId := fmt.Sprintf("%s/dataDisks/%s", virtualMachineId, name)
+	err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, resourceId)
 	if err != nil {
 		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to finish updating Disk %q: %+v", virtualMachineName, resourceGroup, name, err)
 	}
 
-	d.SetId(fmt.Sprintf("%s/dataDisks/%s", virtualMachineId, name))
+	d.SetId(resourceId)
 
 	return resourceArmVirtualMachineDataDiskAttachmentRead(d, meta)
 }
@@ -266,7 +267,7 @@ func resourceArmVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, m
 		return fmt.Errorf("Error removing Disk %q from Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineName, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for Disk %q to be removed from Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineName, resourceGroup, err)
 	}
@@ -0,0 +1,392 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	diskEncryptionExtensionPublisher = "Microsoft.Azure.Security"
+
+	diskEncryptionExtensionLinuxType    = "AzureDiskEncryptionForLinux"
+	diskEncryptionExtensionLinuxVersion = "1.1"
+
+	diskEncryptionExtensionWindowsType    = "AzureDiskEncryption"
+	diskEncryptionExtensionWindowsVersion = "2.2"
+)
+
+func resourceArmVirtualMachineDiskEncryption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineDiskEncryptionCreateUpdate,
+		Read:   resourceArmVirtualMachineDiskEncryptionRead,
+		Update: resourceArmVirtualMachineDiskEncryptionCreateUpdate,
+		Delete: resourceArmVirtualMachineDiskEncryptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"key_encryption_key_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.KeyVaultChildID,
+			},
+
+			"key_encryption_key_vault_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"key_encryption_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "RSA-OAEP",
+				ValidateFunc: validation.StringInSlice([]string{
+					"RSA-OAEP",
+					"RSA-OAEP-256",
+					"RSA1_5",
+				}, false),
+			},
+
+			"volume_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "All",
+				ValidateFunc: validation.StringInSlice([]string{
+					"All",
+					"Data",
+					"OS",
+				}, false),
+			},
+
+			"os_disk_encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineDiskEncryptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	vmClient := meta.(*ArmClient).vmClient
+	client := meta.(*ArmClient).vmExtensionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	virtualMachineId := d.Get("virtual_machine_id").(string)
+	id, err := parseAzureResourceID(virtualMachineId)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vmName := id.Path["virtualMachines"]
+
+	azureRMLockByName(vmName, virtualMachineResourceName)
+	defer azureRMUnlockByName(vmName, virtualMachineResourceName)
+
+	vm, err := vmClient.Get(ctx, resGroup, vmName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	osType, err := virtualMachineDiskEncryptionOSType(vm)
+	if err != nil {
+		return err
+	}
+	name, version := virtualMachineDiskEncryptionExtension(osType)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, vmName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for the presence of an existing Disk Encryption Extension on Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return fmt.Errorf("A Disk Encryption Extension already exists on Virtual Machine %q (Resource Group %q) - please import it into the state with `terraform import`", vmName, resGroup)
+		}
+	}
+
+	keyVaultId := d.Get("key_vault_id").(string)
+	keyVaultUrl, err := virtualMachineDiskEncryptionKeyVaultUrl(keyVaultId, meta)
+	if err != nil {
+		return err
+	}
+
+	// a new sequence version is required for the extension to re-run when the settings are updated
+	sequenceVersion, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("Error generating the Sequence Version: %+v", err)
+	}
+
+	settings := map[string]interface{}{
+		"EncryptionOperation":    "EnableEncryption",
+		"KeyVaultURL":            keyVaultUrl,
+		"KeyVaultResourceId":     keyVaultId,
+		"KeyEncryptionAlgorithm": d.Get("key_encryption_algorithm").(string),
+		"VolumeType":             d.Get("volume_type").(string),
+		"SequenceVersion":        sequenceVersion,
+	}
+
+	if v := d.Get("key_encryption_key_url").(string); v != "" {
+		kekVaultId := keyVaultId
+		if kekVault := d.Get("key_encryption_key_vault_id").(string); kekVault != "" {
+			kekVaultId = kekVault
+		}
+
+		settings["KeyEncryptionKeyURL"] = v
+		settings["KekVaultResourceId"] = kekVaultId
+	}
+
+	extension := compute.VirtualMachineExtension{
+		Location: vm.Location,
+		VirtualMachineExtensionProperties: &compute.VirtualMachineExtensionProperties{
+			Publisher:               utils.String(diskEncryptionExtensionPublisher),
+			Type:                    utils.String(name),
+			TypeHandlerVersion:      utils.String(version),
+			AutoUpgradeMinorVersion: utils.Bool(true),
+			Settings:                &settings,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, vmName, name, extension)
+	if err != nil {
+		return fmt.Errorf("Error enabling Disk Encryption on Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := fmt.Sprintf("%s/extensions/%s", azureRMResourceID(subscriptionId, resGroup, "Microsoft.Compute", "virtualMachines", vmName), name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for Disk Encryption to be enabled on Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, vmName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Disk Encryption Extension for Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Disk Encryption Extension for Virtual Machine %q (Resource Group %q)", vmName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualMachineDiskEncryptionRead(d, meta)
+}
+
+func resourceArmVirtualMachineDiskEncryptionRead(d *schema.ResourceData, meta interface{}) error {
+	vmClient := meta.(*ArmClient).vmClient
+	client := meta.(*ArmClient).vmExtensionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vmName := id.Path["virtualMachines"]
+	name := id.Path["extensions"]
+
+	resp, err := client.Get(ctx, resGroup, vmName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Disk Encryption Extension %q was not found on Virtual Machine %q (Resource Group %q) - removing from state", name, vmName, resGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Disk Encryption Extension for Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	d.Set("virtual_machine_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s", id.SubscriptionID, resGroup, vmName))
+
+	if props := resp.VirtualMachineExtensionProperties; props != nil && props.Settings != nil {
+		if settings, ok := props.Settings.(map[string]interface{}); ok {
+			d.Set("key_vault_id", settings["KeyVaultResourceId"])
+			d.Set("key_encryption_key_url", settings["KeyEncryptionKeyURL"])
+			d.Set("key_encryption_key_vault_id", settings["KekVaultResourceId"])
+
+			if v, ok := settings["KeyEncryptionAlgorithm"]; ok {
+				d.Set("key_encryption_algorithm", v)
+			}
+
+			if v, ok := settings["VolumeType"]; ok {
+				d.Set("volume_type", v)
+			}
+		}
+	}
+
+	vm, err := vmClient.Get(ctx, resGroup, vmName, compute.InstanceView)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	d.Set("os_disk_encrypted", virtualMachineDiskEncryptionOSDiskEncrypted(vm))
+
+	return nil
+}
+
+func resourceArmVirtualMachineDiskEncryptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmExtensionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vmName := id.Path["virtualMachines"]
+	name := id.Path["extensions"]
+
+	azureRMLockByName(vmName, virtualMachineResourceName)
+	defer azureRMUnlockByName(vmName, virtualMachineResourceName)
+
+	existing, err := client.Get(ctx, resGroup, vmName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Disk Encryption Extension for Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	// the OS Disk of a Linux Virtual Machine can't be decrypted, as such only the Data Disks are decrypted
+	volumeType := d.Get("volume_type").(string)
+	decrypt := true
+	if strings.EqualFold(name, diskEncryptionExtensionLinuxType) {
+		decrypt = volumeType != "OS"
+		volumeType = "Data"
+	}
+
+	if decrypt {
+		sequenceVersion, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("Error generating the Sequence Version: %+v", err)
+		}
+
+		existing.VirtualMachineExtensionProperties.Settings = &map[string]interface{}{
+			"EncryptionOperation": "DisableEncryption",
+			"VolumeType":          volumeType,
+			"SequenceVersion":     sequenceVersion,
+		}
+
+		log.Printf("[DEBUG] Disabling Disk Encryption for the %q volumes on Virtual Machine %q (Resource Group %q)", volumeType, vmName, resGroup)
+		future, err := client.CreateOrUpdate(ctx, resGroup, vmName, name, existing)
+		if err != nil {
+			return fmt.Errorf("Error disabling Disk Encryption on Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+		}
+
+		if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Disk Encryption to be disabled on Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+		}
+	}
+
+	future, err := client.Delete(ctx, resGroup, vmName, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Disk Encryption Extension for Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Disk Encryption Extension for Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	return nil
+}
+
+func virtualMachineDiskEncryptionOSType(vm compute.VirtualMachine) (compute.OperatingSystemTypes, error) {
+	if props := vm.VirtualMachineProperties; props != nil {
+		if profile := props.StorageProfile; profile != nil && profile.OsDisk != nil {
+			return profile.OsDisk.OsType, nil
+		}
+	}
+
+	return "", fmt.Errorf("Unable to determine the OS Type of Virtual Machine %q", *vm.Name)
+}
+
+// virtualMachineDiskEncryptionExtension returns the type (which is also used as the name) and version
+// of the Azure Disk Encryption extension for the specified OS Type
+func virtualMachineDiskEncryptionExtension(osType compute.OperatingSystemTypes) (string, string) {
+	if osType == compute.Windows {
+		return diskEncryptionExtensionWindowsType, diskEncryptionExtensionWindowsVersion
+	}
+
+	return diskEncryptionExtensionLinuxType, diskEncryptionExtensionLinuxVersion
+}
+
+func virtualMachineDiskEncryptionKeyVaultUrl(keyVaultId string, meta interface{}) (string, error) {
+	client := meta.(*ArmClient).keyVaultClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(keyVaultId)
+	if err != nil {
+		return "", err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["vaults"]
+
+	resp, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	props := resp.Properties
+	if props == nil || props.VaultURI == nil {
+		return "", fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): `properties.vaultUri` was nil", name, resGroup)
+	}
+
+	if props.EnabledForDiskEncryption == nil || !*props.EnabledForDiskEncryption {
+		return "", fmt.Errorf("Key Vault %q (Resource Group %q) must have `enabled_for_disk_encryption` set to `true` to be used for Disk Encryption", name, resGroup)
+	}
+
+	return *props.VaultURI, nil
+}
+
+func virtualMachineDiskEncryptionOSDiskEncrypted(vm compute.VirtualMachine) bool {
+	props := vm.VirtualMachineProperties
+	if props == nil || props.StorageProfile == nil || props.StorageProfile.OsDisk == nil || props.StorageProfile.OsDisk.Name == nil {
+		return false
+	}
+	osDiskName := *props.StorageProfile.OsDisk.Name
+
+	if props.InstanceView == nil || props.InstanceView.Disks == nil {
+		return false
+	}
+
+	for _, disk := range *props.InstanceView.Disks {
+		if disk.Name == nil || !strings.EqualFold(*disk.Name, osDiskName) || disk.EncryptionSettings == nil {
+			continue
+		}
+
+		for _, setting := range *disk.EncryptionSettings {
+			if setting.Enabled != nil && *setting.Enabled {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,259 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualMachineDiskEncryption_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_disk_encryption.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	config := testAccAzureRMVirtualMachineDiskEncryption_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDiskEncryptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDiskEncryptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "All"),
+					resource.TestCheckResourceAttr(resourceName, "key_encryption_algorithm", "RSA-OAEP"),
+					resource.TestCheckResourceAttr(resourceName, "os_disk_encrypted", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineDiskEncryption_keyEncryptionKey(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_disk_encryption.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	config := testAccAzureRMVirtualMachineDiskEncryption_keyEncryptionKey(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDiskEncryptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDiskEncryptionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "key_encryption_key_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_encryption_key_vault_id"),
+					resource.TestCheckResourceAttr(resourceName, "os_disk_encrypted", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineDiskEncryptionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vmName := id.Path["virtualMachines"]
+		extensionName := id.Path["extensions"]
+
+		client := testAccProvider.Meta().(*ArmClient).vmExtensionClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, vmName, extensionName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q) does not exist", extensionName, vmName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vmExtensionClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualMachineDiskEncryptionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vmExtensionClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_machine_disk_encryption" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vmName := id.Path["virtualMachines"]
+		extensionName := id.Path["extensions"]
+
+		resp, err := client.Get(ctx, resourceGroup, vmName, extensionName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Disk Encryption Extension %q still exists on Virtual Machine %q (Resource Group %q)", extensionName, vmName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualMachineDiskEncryption_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                        = "acctestkv%s"
+  location                    = "${azurerm_resource_group.test.location}"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  tenant_id                   = "${data.azurerm_client_config.current.tenant_id}"
+  enabled_for_disk_encryption = true
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+      "delete",
+      "get",
+    ]
+
+    secret_permissions = [
+      "delete",
+      "get",
+      "set",
+    ]
+  }
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctvm-%s"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_network_interface.test.id}"]
+  vm_size               = "Standard_D2s_v3"
+
+  delete_os_disk_on_termination = true
+
+  storage_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "acctvm-%s"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_windows_config {}
+}
+`, rInt, location, rString, rInt, rInt, rInt, rString, rInt, rString)
+}
+
+func testAccAzureRMVirtualMachineDiskEncryption_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMVirtualMachineDiskEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_disk_encryption" "test" {
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  key_vault_id       = "${azurerm_key_vault.test.id}"
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineDiskEncryption_keyEncryptionKey(rInt int, rString string, location string) string {
+	template := testAccAzureRMVirtualMachineDiskEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name      = "acctestkek-%d"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+  key_type  = "RSA"
+  key_size  = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "unwrapKey",
+    "wrapKey",
+  ]
+}
+
+resource "azurerm_virtual_machine_disk_encryption" "test" {
+  virtual_machine_id     = "${azurerm_virtual_machine.test.id}"
+  key_vault_id           = "${azurerm_key_vault.test.id}"
+  key_encryption_key_url = "${azurerm_key_vault_key.test.id}"
+}
+`, template, rInt)
+}
@@ -0,0 +1,222 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Run Commands aren't persisted by Azure - instead the command is invoked when this resource is created, and
+// the output from that invocation is stored in the state. Changing any of the arguments invokes the command again.
+func resourceArmVirtualMachineRunCommand() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineRunCommandCreate,
+		Read:   resourceArmVirtualMachineRunCommandRead,
+		Delete: resourceArmVirtualMachineRunCommandDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"virtual_machine_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"command_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "RunShellScript",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"script": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"stdout": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"stderr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineRunCommandCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	virtualMachineId := d.Get("virtual_machine_id").(string)
+	id, err := parseAzureResourceID(virtualMachineId)
+	if err != nil {
+		return fmt.Errorf("Error parsing Virtual Machine ID %q: %+v", virtualMachineId, err)
+	}
+	resourceGroup := id.ResourceGroup
+	virtualMachineName := id.Path["virtualMachines"]
+
+	input := compute.RunCommandInput{
+		CommandID:  utils.String(d.Get("command_id").(string)),
+		Parameters: expandVirtualMachineRunCommandParameters(d.Get("parameters").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("script"); ok {
+		script := make([]string, 0)
+		for _, line := range v.([]interface{}) {
+			script = append(script, line.(string))
+		}
+		input.Script = &script
+	}
+
+	log.Printf("[DEBUG] Invoking Run Command %q on Virtual Machine %q (Resource Group %q)..", name, virtualMachineName, resourceGroup)
+	future, err := client.RunCommand(ctx, resourceGroup, virtualMachineName, input)
+	if err != nil {
+		return fmt.Errorf("Error invoking Run Command %q on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineName, resourceGroup, err)
+	}
+
+	if err = waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Run Command %q on Virtual Machine %q (Resource Group %q) to complete: %+v", name, virtualMachineName, resourceGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the result of Run Command %q on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineName, resourceGroup, err)
+	}
+
+	if apiErr := result.Error; apiErr != nil && apiErr.Message != nil {
+		return fmt.Errorf("Error running Run Command %q on Virtual Machine %q (Resource Group %q): %s", name, virtualMachineName, resourceGroup, *apiErr.Message)
+	}
+
+	stdout, stderr, err := flattenVirtualMachineRunCommandOutput(result.RunCommandResultProperties)
+	if err != nil {
+		return fmt.Errorf("Error parsing the output of Run Command %q on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineName, resourceGroup, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/runCommands/%s", virtualMachineId, name))
+	d.Set("stdout", stdout)
+	d.Set("stderr", stderr)
+
+	return resourceArmVirtualMachineRunCommandRead(d, meta)
+}
+
+func resourceArmVirtualMachineRunCommandRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	virtualMachineName := id.Path["virtualMachines"]
+	name := id.Path["runCommands"]
+
+	// the output of the command is only available when it's invoked, so all we can check is the Virtual Machine exists
+	resp, err := client.Get(ctx, resourceGroup, virtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Machine %q (Resource Group %q) was not found - removing Run Command %q from state", virtualMachineName, resourceGroup, name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", virtualMachineName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+
+	return nil
+}
+
+func resourceArmVirtualMachineRunCommandDelete(d *schema.ResourceData, meta interface{}) error {
+	// Run Commands can't be undone - so there's nothing to delete
+	return nil
+}
+
+func expandVirtualMachineRunCommandParameters(input map[string]interface{}) *[]compute.RunCommandInputParameter {
+	parameters := make([]compute.RunCommandInputParameter, 0)
+
+	// parameters are passed to shell scripts as positional arguments, so they're sorted by name
+	names := make([]string, 0)
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		parameters = append(parameters, compute.RunCommandInputParameter{
+			Name:  utils.String(name),
+			Value: utils.String(input[name].(string)),
+		})
+	}
+
+	return &parameters
+}
+
+// flattenVirtualMachineRunCommandOutput returns the stdout and stderr from the output of a Run Command,
+// which is returned as a list of Instance View Statuses
+func flattenVirtualMachineRunCommandOutput(input *compute.RunCommandResultProperties) (string, string, error) {
+	if input == nil || input.Output == nil {
+		return "", "", nil
+	}
+
+	raw, err := json.Marshal(input.Output)
+	if err != nil {
+		return "", "", err
+	}
+
+	var output struct {
+		Value []compute.InstanceViewStatus `json:"value"`
+	}
+	if err := json.Unmarshal(raw, &output); err != nil {
+		return "", "", err
+	}
+
+	var stdout, stderr string
+	for _, status := range output.Value {
+		if status.Code == nil || status.Message == nil {
+			continue
+		}
+
+		// e.g. `ComponentStatus/StdOut/succeeded`
+		code := strings.ToLower(*status.Code)
+		if strings.Contains(code, "/stdout/") {
+			stdout = *status.Message
+		} else if strings.Contains(code, "/stderr/") {
+			stderr = *status.Message
+		}
+	}
+
+	return stdout, stderr, nil
+}
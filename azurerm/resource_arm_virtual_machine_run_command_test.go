@@ -0,0 +1,100 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestFlattenVirtualMachineRunCommandOutput(t *testing.T) {
+	cases := []struct {
+		Name           string
+		Input          *compute.RunCommandResultProperties
+		ExpectedStdOut string
+		ExpectedStdErr string
+	}{
+		{
+			Name:  "No Output",
+			Input: nil,
+		},
+		{
+			Name: "StdOut and StdErr",
+			Input: &compute.RunCommandResultProperties{
+				Output: map[string]interface{}{
+					"value": []interface{}{
+						map[string]interface{}{
+							"code":    "ComponentStatus/StdOut/succeeded",
+							"level":   "Info",
+							"message": "hello",
+						},
+						map[string]interface{}{
+							"code":    "ComponentStatus/StdErr/succeeded",
+							"level":   "Info",
+							"message": "world",
+						},
+					},
+				},
+			},
+			ExpectedStdOut: "hello",
+			ExpectedStdErr: "world",
+		},
+	}
+
+	for _, v := range cases {
+		stdout, stderr, err := flattenVirtualMachineRunCommandOutput(v.Input)
+		if err != nil {
+			t.Fatalf("Error flattening %q: %+v", v.Name, err)
+		}
+
+		if stdout != v.ExpectedStdOut {
+			t.Fatalf("Expected the stdout for %q to be %q but got %q", v.Name, v.ExpectedStdOut, stdout)
+		}
+
+		if stderr != v.ExpectedStdErr {
+			t.Fatalf("Expected the stderr for %q to be %q but got %q", v.Name, v.ExpectedStdErr, stderr)
+		}
+	}
+}
+
+func TestAccAzureRMVirtualMachineRunCommand_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_run_command.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualMachineRunCommand_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "virtual_machine_id"),
+					resource.TestMatchResourceAttr(resourceName, "stdout", regexp.MustCompile("hello world")),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMVirtualMachineRunCommand_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineDataDiskAttachment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestrc-%d"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  command_id         = "RunShellScript"
+  script             = ["echo $1 $2"]
+
+  parameters {
+    "arg1" = "hello"
+    "arg2" = "world"
+  }
+}
+`, template, rInt)
+}
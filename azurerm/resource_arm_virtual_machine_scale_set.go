@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -126,6 +127,14 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				Default:  true,
 			},
 
+			"proximity_placement_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
 			"single_placement_group": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -442,9 +451,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 							Default:  true,
 						},
 
+						// when omitted the Boot Diagnostics are stored in a Managed Storage Account
 						"storage_uri": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 						},
 					},
 				},
@@ -671,6 +681,22 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 	tags := d.Get("tags").(map[string]interface{})
 	zones := expandZones(d.Get("zones").([]interface{}))
 
+	if hasOnlyTagChanges(d, "azurerm_virtual_machine_scale_set", resourceArmVirtualMachineScaleSet) {
+		log.Printf("[DEBUG] Only `tags` have changed - updating the Tags for Virtual Machine Scale Set %q (Resource Group %q)", name, resGroup)
+		future, err := client.Update(ctx, resGroup, name, compute.VirtualMachineScaleSetUpdate{
+			Tags: expandTags(tags, meta),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating Tags for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Tags for Virtual Machine Scale Set %q (Resource Group %q) to be updated: %+v", name, resGroup, err)
+		}
+
+		return resourceArmVirtualMachineScaleSetRead(d, meta)
+	}
+
 	sku, err := expandVirtualMachineScaleSetSku(d)
 	if err != nil {
 		return err
@@ -735,12 +761,12 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 	}
 
 	properties := compute.VirtualMachineScaleSet{
-		Name:     &name,
-		Location: &location,
-		Tags:     expandTags(tags),
-		Sku:      sku,
+		Name:                             &name,
+		Location:                         &location,
+		Tags:                             expandTags(tags, meta),
+		Sku:                              sku,
 		VirtualMachineScaleSetProperties: &scaleSetProps,
-		Zones: zones,
+		Zones:                            zones,
 	}
 
 	if _, ok := d.GetOk("identity"); ok {
@@ -760,13 +786,50 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 		properties.Plan = plan
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, properties)
-	if err != nil {
-		return err
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Compute", "virtualMachineScaleSets", name)
+
+	if proximityPlacementGroupId := d.Get("proximity_placement_group_id").(string); proximityPlacementGroupId != "" {
+		// placing a Virtual Machine Scale Set within a Proximity Placement Group requires a newer API Version than the Azure SDK supports
+		placementClient := meta.(*ArmClient).computeProximityPlacementClient
+
+		body, err := expandComputeResourceWithProximityPlacementGroup(properties, proximityPlacementGroupId)
+		if err != nil {
+			return err
+		}
+
+		future, err := placementClient.CreateOrUpdate(ctx, expectedId, body)
+		if err != nil {
+			return err
+		}
+
+		if err := waitForCreateOrUpdate(ctx, d, &future.Future, placementClient.Client, expectedId); err != nil {
+			return err
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, properties)
+		if err != nil {
+			return err
+		}
+
+		if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+			return err
+		}
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return err
+	if !d.IsNewResource() && compute.UpgradeMode(updatePolicy) == compute.Manual && meta.(*ArmClient).features.Enabled(features.VirtualMachineScaleSetRollInstancesWhenRequired) {
+		log.Printf("[DEBUG] Upgrading the instances within Virtual Machine Scale Set %q (Resource Group %q) to the latest model", name, resGroup)
+		instanceIds := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+			InstanceIds: &[]string{"*"},
+		}
+		upgradeFuture, err := client.UpdateInstances(ctx, resGroup, name, instanceIds)
+		if err != nil {
+			return fmt.Errorf("Error upgrading the instances within Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err := waitForCompletion(ctx, &upgradeFuture.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the instances within Virtual Machine Scale Set %q (Resource Group %q) to be upgraded: %+v", name, resGroup, err)
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
@@ -810,6 +873,12 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 	}
 	d.Set("zones", resp.Zones)
 
+	proximityPlacementGroupId, err := retrieveProximityPlacementGroupId(ctx, meta.(*ArmClient).computeProximityPlacementClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving Proximity Placement Group for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	d.Set("proximity_placement_group_id", proximityPlacementGroupId)
+
 	if err := d.Set("sku", flattenAzureRmVirtualMachineScaleSetSku(resp.Sku)); err != nil {
 		return fmt.Errorf("[DEBUG] Error setting `sku`: %#v", err)
 	}
@@ -919,7 +988,7 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -940,7 +1009,7 @@ func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
 		return err
 	}
 
@@ -1070,8 +1139,11 @@ func flattenAzureRmVirtualMachineScaleSetOsProfileSecrets(secrets *[]compute.Vau
 
 func flattenAzureRmVirtualMachineScaleSetBootDiagnostics(bootDiagnostic *compute.BootDiagnostics) []interface{} {
 	b := map[string]interface{}{
-		"enabled":     *bootDiagnostic.Enabled,
-		"storage_uri": *bootDiagnostic.StorageURI,
+		"enabled": *bootDiagnostic.Enabled,
+	}
+
+	if bootDiagnostic.StorageURI != nil {
+		b["storage_uri"] = *bootDiagnostic.StorageURI
 	}
 
 	return []interface{}{b}
@@ -1634,8 +1706,11 @@ func expandAzureRMVirtualMachineScaleSetsDiagnosticProfile(d *schema.ResourceDat
 	storageURI := bootDiagnosticConfig["storage_uri"].(string)
 
 	bootDiagnostic := &compute.BootDiagnostics{
-		Enabled:    &enabled,
-		StorageURI: &storageURI,
+		Enabled: &enabled,
+	}
+
+	if storageURI != "" {
+		bootDiagnostic.StorageURI = &storageURI
 	}
 
 	diagnosticsProfile := compute.DiagnosticsProfile{
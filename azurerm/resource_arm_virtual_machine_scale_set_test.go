@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"regexp"
 	"testing"
@@ -10,8 +11,80 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_virtual_machine_scale_set", &resource.Sweeper{
+		Name: "azurerm_virtual_machine_scale_set",
+		F:    testSweepVirtualMachineScaleSets,
+	})
+}
+
+func testSweepVirtualMachineScaleSets(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := (*armClient).vmScaleSetClient
+	ctx := (*armClient).StopContext
+
+	log.Printf("Retrieving the Virtual Machine Scale Sets..")
+	results, err := client.ListAllComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error Listing on Virtual Machine Scale Sets: %+v", err)
+	}
+
+	scaleSets := make([]compute.VirtualMachineScaleSet, 0)
+	for results.NotDone() {
+		scaleSets = append(scaleSets, results.Value())
+
+		if err := results.Next(); err != nil {
+			return fmt.Errorf("Error Listing on Virtual Machine Scale Sets: %+v", err)
+		}
+	}
+
+	for _, scaleSet := range scaleSets {
+		if scaleSet.ID == nil || scaleSet.Name == nil || scaleSet.Location == nil {
+			continue
+		}
+
+		id, err := parseAzureResourceID(*scaleSet.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing Azure Resource ID %q", *scaleSet.ID)
+		}
+
+		resourceGroupName := id.ResourceGroup
+		name := *scaleSet.Name
+
+		if !shouldSweepAcceptanceTestResource(name, *scaleSet.Location, region) {
+			continue
+		}
+
+		log.Printf("Deleting Virtual Machine Scale Set %q", name)
+		future, err := client.Delete(ctx, resourceGroupName, name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return err
+		}
+
+		err = future.WaitForCompletionRef(ctx, client.Client)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccAzureRMVirtualMachineScaleSet_basic(t *testing.T) {
 	resourceName := "azurerm_virtual_machine_scale_set.test"
 	ri := acctest.RandInt()
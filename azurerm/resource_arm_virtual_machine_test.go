@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"regexp"
 	"testing"
@@ -10,8 +11,81 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_virtual_machine", &resource.Sweeper{
+		Name: "azurerm_virtual_machine",
+		F:    testSweepVirtualMachines,
+	})
+}
+
+func testSweepVirtualMachines(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+
+	client := (*armClient).vmClient
+	ctx := (*armClient).StopContext
+
+	log.Printf("Retrieving the Virtual Machines..")
+	results, err := client.ListAllComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error Listing on Virtual Machines: %+v", err)
+	}
+
+	virtualMachines := make([]compute.VirtualMachine, 0)
+	for results.NotDone() {
+		virtualMachines = append(virtualMachines, results.Value())
+
+		if err := results.Next(); err != nil {
+			return fmt.Errorf("Error Listing on Virtual Machines: %+v", err)
+		}
+	}
+
+	for _, virtualMachine := range virtualMachines {
+		if virtualMachine.ID == nil || virtualMachine.Name == nil || virtualMachine.Location == nil {
+			continue
+		}
+
+		id, err := parseAzureResourceID(*virtualMachine.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing Azure Resource ID %q", *virtualMachine.ID)
+		}
+
+		resourceGroupName := id.ResourceGroup
+		name := *virtualMachine.Name
+
+		if !shouldSweepAcceptanceTestResource(name, *virtualMachine.Location, region) {
+			continue
+		}
+
+		log.Printf("Deleting Virtual Machine %q", name)
+		future, err := client.Delete(ctx, resourceGroupName, name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return err
+		}
+
+		err = future.WaitForCompletionRef(ctx, client.Client)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccAzureRMVirtualMachine_winTimeZone(t *testing.T) {
 	resourceName := "azurerm_virtual_machine.test"
 	var vm compute.VirtualMachine
@@ -80,6 +154,65 @@ func TestAccAzureRMVirtualMachine_UserAssignedIdentity(t *testing.T) {
 	})
 }
 
+func TestVirtualMachineDiskIsDefined(t *testing.T) {
+	disks := []interface{}{
+		map[string]interface{}{
+			"name":            "osdisk1",
+			"vhd_uri":         "",
+			"managed_disk_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/disks/osdisk1",
+		},
+		map[string]interface{}{
+			"name":            "datadisk1",
+			"vhd_uri":         "https://account1.blob.core.windows.net/vhds/datadisk1.vhd",
+			"managed_disk_id": "",
+		},
+	}
+
+	cases := []struct {
+		Name        string
+		DiskName    *string
+		Vhd         *compute.VirtualHardDisk
+		ManagedDisk *compute.ManagedDiskParameters
+		Expected    bool
+	}{
+		{
+			Name:     "Matching Name",
+			DiskName: utils.String("OSDisk1"),
+			Expected: true,
+		},
+		{
+			Name:     "Matching VHD URI",
+			DiskName: utils.String("renamed"),
+			Vhd: &compute.VirtualHardDisk{
+				URI: utils.String("https://account1.blob.core.windows.net/vhds/datadisk1.vhd"),
+			},
+			Expected: true,
+		},
+		{
+			Name:     "Matching Managed Disk ID",
+			DiskName: utils.String("renamed"),
+			ManagedDisk: &compute.ManagedDiskParameters{
+				ID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/disks/osdisk1"),
+			},
+			Expected: true,
+		},
+		{
+			Name:     "Attached outside of the resource",
+			DiskName: utils.String("datadisk2"),
+			ManagedDisk: &compute.ManagedDiskParameters{
+				ID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/disks/datadisk2"),
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range cases {
+		if actual := virtualMachineDiskIsDefined(disks, v.DiskName, v.Vhd, v.ManagedDisk); actual != v.Expected {
+			t.Fatalf("Expected %q to be %t but got %t", v.Name, v.Expected, actual)
+		}
+	}
+}
+
 func testCheckAzureRMVirtualMachineExists(name string, vm *compute.VirtualMachine) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -141,6 +141,33 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 								Type: schema.TypeString,
 							},
 						},
+						"aad_tenant": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ConflictsWith: []string{
+								"vpn_client_configuration.0.radius_server_address",
+								"vpn_client_configuration.0.radius_server_secret",
+							},
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"aad_audience": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ConflictsWith: []string{
+								"vpn_client_configuration.0.radius_server_address",
+								"vpn_client_configuration.0.radius_server_secret",
+							},
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"aad_issuer": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ConflictsWith: []string{
+								"vpn_client_configuration.0.radius_server_address",
+								"vpn_client_configuration.0.radius_server_secret",
+							},
+							ValidateFunc: validation.NoZeroValues,
+						},
 						"root_certificate": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -188,6 +215,9 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							ConflictsWith: []string{
+								"vpn_client_configuration.0.aad_tenant",
+								"vpn_client_configuration.0.aad_audience",
+								"vpn_client_configuration.0.aad_issuer",
 								"vpn_client_configuration.0.root_certificate",
 								"vpn_client_configuration.0.revoked_certificate",
 							},
@@ -197,6 +227,9 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							ConflictsWith: []string{
+								"vpn_client_configuration.0.aad_tenant",
+								"vpn_client_configuration.0.aad_audience",
+								"vpn_client_configuration.0.aad_issuer",
 								"vpn_client_configuration.0.root_certificate",
 								"vpn_client_configuration.0.revoked_certificate",
 							},
@@ -209,6 +242,7 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 								ValidateFunc: validation.StringInSlice([]string{
 									string(network.IkeV2),
 									string(network.SSTP),
+									virtualNetworkGatewayOpenVPNProtocol,
 								}, true),
 							},
 						},
@@ -234,8 +268,9 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 							ForceNew: true,
 						},
 						"peer_weight": {
-							Type:     schema.TypeInt,
-							Optional: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
 						},
 					},
 				},
@@ -262,26 +297,65 @@ func resourceArmVirtualNetworkGatewayCreateUpdate(d *schema.ResourceData, meta i
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
+	if hasOnlyTagChanges(d, "azurerm_virtual_network_gateway", resourceArmVirtualNetworkGateway) {
+		log.Printf("[DEBUG] Only `tags` have changed - updating the Tags for Virtual Network Gateway %q (Resource Group %q)", name, resGroup)
+		future, err := client.UpdateTags(ctx, resGroup, name, network.TagsObject{
+			Tags: expandTags(tags, meta),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating Tags for Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Tags for Virtual Network Gateway %q (Resource Group %q) to be updated: %+v", name, resGroup, err)
+		}
+
+		return resourceArmVirtualNetworkGatewayRead(d, meta)
+	}
+
 	properties, err := getArmVirtualNetworkGatewayProperties(d)
 	if err != nil {
 		return err
 	}
 
 	gateway := network.VirtualNetworkGateway{
-		Name:     &name,
-		Location: &location,
-		Tags:     expandTags(tags),
+		Name:                                  &name,
+		Location:                              &location,
+		Tags:                                  expandTags(tags, meta),
 		VirtualNetworkGatewayPropertiesFormat: properties,
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, gateway)
-	if err != nil {
-		return fmt.Errorf("Error Creating/Updating AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
-	}
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resGroup, "Microsoft.Network", "virtualNetworkGateways", name)
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for completion of AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+	aad := expandArmVirtualNetworkGatewayVpnClientAad(d)
+	if virtualNetworkGatewayRequiresAadApi(properties, aad) {
+		aadClient := meta.(*ArmClient).vnetGatewayAadClient
+
+		body, err := expandVirtualNetworkGatewayWithAad(gateway, aad)
+		if err != nil {
+			return err
+		}
+
+		future, err := aadClient.CreateOrUpdate(ctx, expectedId, body)
+		if err != nil {
+			return fmt.Errorf("Error Creating/Updating AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		err = waitForCreateOrUpdate(ctx, d, &future.Future, aadClient.Client, expectedId)
+		if err != nil {
+			return fmt.Errorf("Error waiting for completion of AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, gateway)
+		if err != nil {
+			return fmt.Errorf("Error Creating/Updating AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		err = waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId)
+		if err != nil {
+			return fmt.Errorf("Error waiting for completion of AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
@@ -343,6 +417,16 @@ func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface
 		}
 
 		vpnConfigFlat := flattenArmVirtualNetworkGatewayVpnClientConfig(gw.VpnClientConfiguration)
+		if len(vpnConfigFlat) > 0 {
+			// the Azure Active Directory properties aren't returned in the version of the API used by the Azure SDK
+			var aadProps virtualNetworkGatewayAadProperties
+			if _, err := meta.(*ArmClient).vnetGatewayAadClient.Get(ctx, d.Id(), &aadProps); err != nil {
+				return fmt.Errorf("Error retrieving Azure Active Directory configuration for AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+			if props := aadProps.Properties; props != nil {
+				flattenArmVirtualNetworkGatewayVpnClientAad(vpnConfigFlat[0].(map[string]interface{}), props.VpnClientConfiguration)
+			}
+		}
 		if err := d.Set("vpn_client_configuration", vpnConfigFlat); err != nil {
 			return fmt.Errorf("Error setting `vpn_client_configuration`: %+v", err)
 		}
@@ -355,7 +439,7 @@ func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -374,7 +458,7 @@ func resourceArmVirtualNetworkGatewayDelete(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("Error deleting Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = waitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for deletion of Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -552,6 +636,28 @@ func expandArmVirtualNetworkGatewayVpnClientConfig(d *schema.ResourceData) *netw
 	}
 }
 
+func expandArmVirtualNetworkGatewayVpnClientAad(d *schema.ResourceData) virtualNetworkGatewayAadConfiguration {
+	aad := virtualNetworkGatewayAadConfiguration{}
+
+	configSets := d.Get("vpn_client_configuration").([]interface{})
+	if len(configSets) == 0 || configSets[0] == nil {
+		return aad
+	}
+	conf := configSets[0].(map[string]interface{})
+
+	if v := conf["aad_tenant"].(string); v != "" {
+		aad.AadTenant = utils.String(v)
+	}
+	if v := conf["aad_audience"].(string); v != "" {
+		aad.AadAudience = utils.String(v)
+	}
+	if v := conf["aad_issuer"].(string); v != "" {
+		aad.AadIssuer = utils.String(v)
+	}
+
+	return aad
+}
+
 func expandArmVirtualNetworkGatewaySku(d *schema.ResourceData) *network.VirtualNetworkGatewaySku {
 	sku := d.Get("sku").(string)
 
@@ -675,6 +781,28 @@ func flattenArmVirtualNetworkGatewayVpnClientConfig(cfg *network.VpnClientConfig
 	return []interface{}{flat}
 }
 
+func flattenArmVirtualNetworkGatewayVpnClientAad(flat map[string]interface{}, aad *virtualNetworkGatewayAadConfiguration) {
+	aadTenant := ""
+	aadAudience := ""
+	aadIssuer := ""
+
+	if aad != nil {
+		if aad.AadTenant != nil {
+			aadTenant = *aad.AadTenant
+		}
+		if aad.AadAudience != nil {
+			aadAudience = *aad.AadAudience
+		}
+		if aad.AadIssuer != nil {
+			aadIssuer = *aad.AadIssuer
+		}
+	}
+
+	flat["aad_tenant"] = aadTenant
+	flat["aad_audience"] = aadAudience
+	flat["aad_issuer"] = aadIssuer
+}
+
 func hashVirtualNetworkGatewayRootCert(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -759,6 +887,28 @@ func validateArmVirtualNetworkGatewayExpressRouteSku() schema.SchemaValidateFunc
 
 func resourceArmVirtualNetworkGatewayCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
 
+	if gatewayType := diff.Get("type").(string); strings.EqualFold(gatewayType, string(network.VirtualNetworkGatewayTypeVpn)) {
+		activeActive := diff.Get("active_active").(bool)
+		ipConfigurations := len(diff.Get("ip_configuration").([]interface{}))
+
+		if activeActive {
+			if vpnType := diff.Get("vpn_type").(string); !strings.EqualFold(vpnType, string(network.RouteBased)) {
+				return fmt.Errorf("an active-active Virtual Network Gateway requires `vpn_type` to be `RouteBased`")
+			}
+
+			sku := diff.Get("sku").(string)
+			if strings.EqualFold(sku, string(network.VirtualNetworkGatewaySkuTierBasic)) || strings.EqualFold(sku, string(network.VirtualNetworkGatewaySkuTierStandard)) {
+				return fmt.Errorf("an active-active Virtual Network Gateway isn't supported by the %q sku", sku)
+			}
+
+			if ipConfigurations != 2 {
+				return fmt.Errorf("an active-active Virtual Network Gateway requires exactly two `ip_configuration` blocks")
+			}
+		} else if ipConfigurations != 1 {
+			return fmt.Errorf("an active-standby Virtual Network Gateway requires exactly one `ip_configuration` block")
+		}
+	}
+
 	if vpnClient, ok := diff.GetOk("vpn_client_configuration"); ok {
 		if vpnClientConfig, ok := vpnClient.([]interface{})[0].(map[string]interface{}); ok {
 			hasRadiusAddress := vpnClientConfig["radius_server_address"] != ""
@@ -770,6 +920,28 @@ func resourceArmVirtualNetworkGatewayCustomizeDiff(diff *schema.ResourceDiff, v
 			if !hasRadiusAddress && hasRadiusSecret {
 				return fmt.Errorf("if radius_server_secret is set radius_server_address must also be set")
 			}
+
+			hasAadTenant := vpnClientConfig["aad_tenant"] != ""
+			hasAadAudience := vpnClientConfig["aad_audience"] != ""
+			hasAadIssuer := vpnClientConfig["aad_issuer"] != ""
+
+			if hasAadTenant || hasAadAudience || hasAadIssuer {
+				if !hasAadTenant || !hasAadAudience || !hasAadIssuer {
+					return fmt.Errorf("`aad_tenant`, `aad_audience` and `aad_issuer` must all be set to use Azure Active Directory authentication")
+				}
+
+				hasOpenVPN := false
+				if protocols, ok := vpnClientConfig["vpn_client_protocols"].(*schema.Set); ok {
+					for _, protocol := range protocols.List() {
+						if strings.EqualFold(protocol.(string), virtualNetworkGatewayOpenVPNProtocol) {
+							hasOpenVPN = true
+						}
+					}
+				}
+				if !hasOpenVPN {
+					return fmt.Errorf("Azure Active Directory authentication requires `vpn_client_protocols` to contain `OpenVPN`")
+				}
+			}
 		}
 	}
 	return nil
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -72,6 +73,7 @@ func TestAccAzureRMVirtualNetworkGateway_vpnGw1(t *testing.T) {
 }
 
 func TestAccAzureRMVirtualNetworkGateway_activeActive(t *testing.T) {
+	resourceName := "azurerm_virtual_network_gateway.test"
 	ri := acctest.RandInt()
 	config := testAccAzureRMVirtualNetworkGateway_activeActive(ri, testLocation())
 
@@ -83,7 +85,12 @@ func TestAccAzureRMVirtualNetworkGateway_activeActive(t *testing.T) {
 			{
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
-					testCheckAzureRMVirtualNetworkGatewayExists("azurerm_virtual_network_gateway.test"),
+					testCheckAzureRMVirtualNetworkGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active_active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_settings.0.asn", "65010"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_settings.0.peer_weight", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "bgp_settings.0.peering_address"),
 				),
 			},
 		},
@@ -175,6 +182,75 @@ func TestAccAzureRMVirtualNetworkGateway_vpnClientConfig(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMVirtualNetworkGateway_vpnClientConfigAzureAD(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "azurerm_virtual_network_gateway.test"
+	config := testAccAzureRMVirtualNetworkGateway_vpnClientConfigAzureAD(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpn_client_configuration.0.vpn_client_protocols.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpn_client_configuration.0.aad_audience", "41b23e61-6c1e-4545-b367-cd054e0ed4b4"),
+					resource.TestCheckResourceAttrSet(resourceName, "vpn_client_configuration.0.aad_tenant"),
+					resource.TestCheckResourceAttrSet(resourceName, "vpn_client_configuration.0.aad_issuer"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandVirtualNetworkGatewayWithAad(t *testing.T) {
+	protocols := []network.VpnClientProtocol{network.VpnClientProtocol(virtualNetworkGatewayOpenVPNProtocol)}
+	gateway := network.VirtualNetworkGateway{
+		Location: utils.String("westeurope"),
+		VirtualNetworkGatewayPropertiesFormat: &network.VirtualNetworkGatewayPropertiesFormat{
+			GatewayType: network.VirtualNetworkGatewayTypeVpn,
+			VpnClientConfiguration: &network.VpnClientConfiguration{
+				VpnClientProtocols: &protocols,
+			},
+		},
+	}
+	aad := virtualNetworkGatewayAadConfiguration{
+		AadTenant:   utils.String("https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000"),
+		AadAudience: utils.String("41b23e61-6c1e-4545-b367-cd054e0ed4b4"),
+		AadIssuer:   utils.String("https://sts.windows.net/00000000-0000-0000-0000-000000000000/"),
+	}
+
+	if !virtualNetworkGatewayRequiresAadApi(gateway.VirtualNetworkGatewayPropertiesFormat, virtualNetworkGatewayAadConfiguration{}) {
+		t.Fatalf("Expected a Virtual Network Gateway using OpenVPN to require the newer API")
+	}
+
+	if virtualNetworkGatewayRequiresAadApi(&network.VirtualNetworkGatewayPropertiesFormat{}, virtualNetworkGatewayAadConfiguration{}) {
+		t.Fatalf("Expected a Virtual Network Gateway without OpenVPN or Azure Active Directory to use the Azure SDK")
+	}
+
+	output, err := expandVirtualNetworkGatewayWithAad(gateway, aad)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	properties := output["properties"].(map[string]interface{})
+	if properties["gatewayType"] != "Vpn" {
+		t.Fatalf("Expected the existing properties to be retained but got %+v", properties)
+	}
+
+	vpnClientConfiguration := properties["vpnClientConfiguration"].(map[string]interface{})
+	if vpnClientConfiguration["aadTenant"] != *aad.AadTenant || vpnClientConfiguration["aadAudience"] != *aad.AadAudience || vpnClientConfiguration["aadIssuer"] != *aad.AadIssuer {
+		t.Fatalf("Expected the Azure Active Directory properties to be set but got %+v", vpnClientConfiguration)
+	}
+
+	if protocols := vpnClientConfiguration["vpnClientProtocols"].([]interface{}); len(protocols) != 1 || protocols[0] != virtualNetworkGatewayOpenVPNProtocol {
+		t.Fatalf("Expected the VPN Client Protocols to be retained but got %+v", protocols)
+	}
+}
+
 func testCheckAzureRMVirtualNetworkGatewayExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		name, resourceGroup, err := getArmResourceNameAndGroup(s, name)
@@ -431,6 +507,7 @@ resource "azurerm_virtual_network_gateway" "test" {
 
   bgp_settings {
     asn = "65010"
+    peer_weight = 10
   }
 }
 `, rInt, location, rInt, rInt, rInt, rInt)
@@ -492,6 +569,64 @@ resource "azurerm_virtual_network_gateway" "test" {
 `, rInt, location, rInt, rInt, rInt)
 }
 
+func testAccAzureRMVirtualNetworkGateway_vpnClientConfigAzureAD(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctestpip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  depends_on          = ["azurerm_public_ip.test"]
+  name                = "acctestvng-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  type     = "Vpn"
+  vpn_type = "RouteBased"
+  sku      = "VpnGw1"
+
+  ip_configuration {
+    public_ip_address_id          = "${azurerm_public_ip.test.id}"
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+  }
+
+  vpn_client_configuration {
+    address_space        = ["10.2.0.0/24"]
+    vpn_client_protocols = ["OpenVPN"]
+
+    aad_tenant   = "https://login.microsoftonline.com/${data.azurerm_client_config.current.tenant_id}"
+    aad_audience = "41b23e61-6c1e-4545-b367-cd054e0ed4b4"
+    aad_issuer   = "https://sts.windows.net/${data.azurerm_client_config.current.tenant_id}/"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
 func testAccAzureRMVirtualNetworkGateway_sku(rInt int, location string, sku string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
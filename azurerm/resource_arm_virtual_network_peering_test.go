@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -102,6 +103,50 @@ func TestAccAzureRMVirtualNetworkPeering_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMVirtualNetworkPeering_globalPeering(t *testing.T) {
+	firstResourceName := "azurerm_virtual_network_peering.test1"
+	secondResourceName := "azurerm_virtual_network_peering.test2"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualNetworkPeering_globalPeering(ri, testLocation(), testAltLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkPeeringExists(firstResourceName),
+					testCheckAzureRMVirtualNetworkPeeringExists(secondResourceName),
+					resource.TestCheckResourceAttr(firstResourceName, "allow_forwarded_traffic", "true"),
+					resource.TestCheckResourceAttr(secondResourceName, "allow_forwarded_traffic", "true"),
+					resource.TestCheckResourceAttr(firstResourceName, "allow_gateway_transit", "false"),
+					resource.TestCheckResourceAttr(secondResourceName, "use_remote_gateways", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualNetworkPeering_gatewayTransitAndRemoteGateways(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualNetworkPeering_gatewayTransitAndRemoteGateways(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("`allow_gateway_transit` and `use_remote_gateways` cannot both be set to `true`"),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMVirtualNetworkPeeringExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -272,3 +317,78 @@ resource "azurerm_virtual_network_peering" "test2" {
 }
 `, rInt, location, rInt, rInt, rInt, rInt)
 }
+
+func testAccAzureRMVirtualNetworkPeering_globalPeering(rInt int, location, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test1" {
+  name                = "acctestvirtnet-1-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.1.0/24"]
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_virtual_network" "test2" {
+  name                = "acctestvirtnet-2-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.2.0/24"]
+  location            = "%s"
+}
+
+resource "azurerm_virtual_network_peering" "test1" {
+  name                         = "acctestpeer-1-%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  virtual_network_name         = "${azurerm_virtual_network.test1.name}"
+  remote_virtual_network_id    = "${azurerm_virtual_network.test2.id}"
+  allow_virtual_network_access = true
+  allow_forwarded_traffic      = true
+  allow_gateway_transit        = false
+}
+
+resource "azurerm_virtual_network_peering" "test2" {
+  name                         = "acctestpeer-2-%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  virtual_network_name         = "${azurerm_virtual_network.test2.name}"
+  remote_virtual_network_id    = "${azurerm_virtual_network.test1.id}"
+  allow_virtual_network_access = true
+  allow_forwarded_traffic      = true
+  use_remote_gateways          = false
+}
+`, rInt, location, rInt, rInt, altLocation, rInt, rInt)
+}
+
+func testAccAzureRMVirtualNetworkPeering_gatewayTransitAndRemoteGateways(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test1" {
+  name                = "acctestvirtnet-1-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.1.0/24"]
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_virtual_network" "test2" {
+  name                = "acctestvirtnet-2-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.2.0/24"]
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_virtual_network_peering" "test1" {
+  name                      = "acctestpeer-1-%d"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  virtual_network_name      = "${azurerm_virtual_network.test1.name}"
+  remote_virtual_network_id = "${azurerm_virtual_network.test2.id}"
+  allow_gateway_transit     = true
+  use_remote_gateways       = true
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -0,0 +1,272 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var vpnGatewayResourceName = "azurerm_vpn_gateway"
+
+func resourceArmVpnGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVpnGatewayCreateUpdate,
+		Read:   resourceArmVpnGatewayRead,
+		Update: resourceArmVpnGatewayCreateUpdate,
+		Delete: resourceArmVpnGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"bgp_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"peer_weight": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+
+						"bgp_peering_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"allow_branch_to_branch_traffic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"allow_vnet_to_vnet_traffic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVpnGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnGatewaysClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for VPN Gateway creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	azureRMLockByName(name, vpnGatewayResourceName)
+	defer azureRMUnlockByName(name, vpnGatewayResourceName)
+
+	gateway := network.VpnGateway{
+		Location: utils.String(location),
+		Tags:     expandTags(tags, meta),
+		VpnGatewayProperties: &network.VpnGatewayProperties{
+			VirtualHub: &network.SubResource{
+				ID: utils.String(d.Get("virtual_hub_id").(string)),
+			},
+			BgpSettings: expandArmVpnGatewayBgpSettings(d.Get("bgp_settings").([]interface{})),
+			Policies: &network.Policies{
+				AllowBranchToBranchTraffic: utils.Bool(d.Get("allow_branch_to_branch_traffic").(bool)),
+				AllowVnetToVnetTraffic:     utils.Bool(d.Get("allow_vnet_to_vnet_traffic").(bool)),
+			},
+		},
+	}
+
+	// VPN Connections are managed by the `azurerm_vpn_gateway_connection` resource,
+	// so any which already exist need to be sent back to avoid removing them
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := existing.VpnGatewayProperties; props != nil {
+			gateway.VpnGatewayProperties.Connections = props.Connections
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, gateway)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Network", "vpnGateways", name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for VPN Gateway %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVpnGatewayRead(d, meta)
+}
+
+func resourceArmVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnGatewaysClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["vpnGateways"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] VPN Gateway %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.VpnGatewayProperties; props != nil {
+		if hub := props.VirtualHub; hub != nil {
+			d.Set("virtual_hub_id", hub.ID)
+		}
+
+		if err := d.Set("bgp_settings", flattenArmVpnGatewayBgpSettings(props.BgpSettings)); err != nil {
+			return fmt.Errorf("Error setting `bgp_settings`: %+v", err)
+		}
+
+		if policies := props.Policies; policies != nil {
+			d.Set("allow_branch_to_branch_traffic", policies.AllowBranchToBranchTraffic)
+			d.Set("allow_vnet_to_vnet_traffic", policies.AllowVnetToVnetTraffic)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnGatewaysClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["vpnGateways"]
+
+	azureRMLockByName(name, vpnGatewayResourceName)
+	defer azureRMUnlockByName(name, vpnGatewayResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmVpnGatewayBgpSettings(input []interface{}) *network.BgpSettings {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	settings := network.BgpSettings{
+		Asn: utils.Int64(int64(v["asn"].(int))),
+	}
+
+	if peerWeight := v["peer_weight"].(int); peerWeight != 0 {
+		settings.PeerWeight = utils.Int32(int32(peerWeight))
+	}
+
+	return &settings
+}
+
+func flattenArmVpnGatewayBgpSettings(input *network.BgpSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+
+	if input.Asn != nil {
+		output["asn"] = int(*input.Asn)
+	}
+
+	if input.PeerWeight != nil {
+		output["peer_weight"] = int(*input.PeerWeight)
+	}
+
+	if input.BgpPeeringAddress != nil {
+		output["bgp_peering_address"] = *input.BgpPeeringAddress
+	}
+
+	return []interface{}{output}
+}
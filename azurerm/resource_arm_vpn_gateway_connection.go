@@ -0,0 +1,217 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVpnGatewayConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVpnGatewayConnectionCreateUpdate,
+		Read:   resourceArmVpnGatewayConnectionRead,
+		Update: resourceArmVpnGatewayConnectionCreateUpdate,
+		Delete: resourceArmVpnGatewayConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"vpn_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"remote_vpn_site_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"shared_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"enable_bgp": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"routing_weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"connection_bandwidth_in_mbps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceArmVpnGatewayConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for VPN Gateway Connection creation/update.")
+
+	name := d.Get("name").(string)
+
+	gatewayId, err := parseAzureResourceID(d.Get("vpn_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := gatewayId.ResourceGroup
+	gatewayName := gatewayId.Path["vpnGateways"]
+
+	// the Connections are also returned as a property of the VPN Gateway, so changes to either need to be serialised
+	azureRMLockByName(gatewayName, vpnGatewayResourceName)
+	defer azureRMUnlockByName(gatewayName, vpnGatewayResourceName)
+
+	connection := network.VpnConnection{
+		Name: utils.String(name),
+		VpnConnectionProperties: &network.VpnConnectionProperties{
+			RemoteVpnSite: &network.SubResource{
+				ID: utils.String(d.Get("remote_vpn_site_id").(string)),
+			},
+			EnableBgp: utils.Bool(d.Get("enable_bgp").(bool)),
+		},
+	}
+
+	if v, ok := d.GetOk("shared_key"); ok {
+		connection.VpnConnectionProperties.SharedKey = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("routing_weight"); ok {
+		connection.VpnConnectionProperties.RoutingWeight = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("connection_bandwidth_in_mbps"); ok {
+		connection.VpnConnectionProperties.ConnectionBandwidthInMbps = utils.Int32(int32(v.(int)))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, gatewayName, name, connection)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := fmt.Sprintf("%s/vpnConnections/%s", azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Network", "vpnGateways", gatewayName), name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, gatewayName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Connection %q (VPN Gateway %q / Resource Group %q)", name, gatewayName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVpnGatewayConnectionRead(d, meta)
+}
+
+func resourceArmVpnGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["vpnGateways"]
+	name := id.Path["vpnConnections"]
+
+	resp, err := client.Get(ctx, resourceGroup, gatewayName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Connection %q was not found in VPN Gateway %q / Resource Group %q - removing from state!", name, gatewayName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("vpn_gateway_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/vpnGateways/%s", id.SubscriptionID, resourceGroup, gatewayName))
+
+	if props := resp.VpnConnectionProperties; props != nil {
+		if site := props.RemoteVpnSite; site != nil {
+			d.Set("remote_vpn_site_id", site.ID)
+		}
+
+		d.Set("enable_bgp", props.EnableBgp)
+
+		if props.RoutingWeight != nil {
+			d.Set("routing_weight", int(*props.RoutingWeight))
+		}
+
+		if props.ConnectionBandwidthInMbps != nil {
+			d.Set("connection_bandwidth_in_mbps", int(*props.ConnectionBandwidthInMbps))
+		}
+	}
+
+	return nil
+}
+
+func resourceArmVpnGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["vpnGateways"]
+	name := id.Path["vpnConnections"]
+
+	azureRMLockByName(gatewayName, vpnGatewayResourceName)
+	defer azureRMUnlockByName(gatewayName, vpnGatewayResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, gatewayName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVpnGatewayConnection_basic(t *testing.T) {
+	resourceName := "azurerm_vpn_gateway_connection.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVpnGatewayConnection_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayConnectionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "remote_vpn_site_id"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVpnGatewayConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		parentName := id.Path["vpnGateways"]
+		name := id.Path["vpnConnections"]
+
+		client := testAccProvider.Meta().(*ArmClient).vpnConnectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, parentName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Connection %q (VPN Gateway %q / Resource Group %q) does not exist", name, parentName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vpnConnectionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVpnGatewayConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vpnConnectionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_vpn_gateway_connection" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		parentName := id.Path["vpnGateways"]
+		name := id.Path["vpnConnections"]
+
+		resp, err := client.Get(ctx, resourceGroup, parentName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Connection still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVpnGatewayConnection_basic(rInt int, location string) string {
+	template := testAccAzureRMVpnGateway_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestvpnsite-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  ip_address          = "203.0.113.10"
+  address_prefixes    = ["10.10.0.0/24"]
+}
+
+resource "azurerm_vpn_gateway_connection" "test" {
+  name               = "acctestvpnconn-%d"
+  vpn_gateway_id     = "${azurerm_vpn_gateway.test.id}"
+  remote_vpn_site_id = "${azurerm_vpn_site.test.id}"
+  shared_key         = "4v3ry53cr37k3y"
+}
+`, template, rInt, rInt)
+}
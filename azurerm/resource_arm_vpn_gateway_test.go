@@ -0,0 +1,158 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVpnGateway_basic(t *testing.T) {
+	resourceName := "azurerm_vpn_gateway.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVpnGateway_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "virtual_hub_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnGateway_update(t *testing.T) {
+	resourceName := "azurerm_vpn_gateway.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_branch_to_branch_traffic", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMVpnGateway_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_branch_to_branch_traffic", "false"),
+					resource.TestCheckResourceAttr(resourceName, "allow_vnet_to_vnet_traffic", "true"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_settings.0.asn", "65515"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVpnGatewayExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for VPN Gateway: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).vpnGatewaysClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: VPN Gateway %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vpnGatewaysClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVpnGatewayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vpnGatewaysClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_vpn_gateway" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("VPN Gateway still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVpnGateway_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway" "test" {
+  name                = "acctestvpngw-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_hub_id      = "${azurerm_virtual_hub.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnGateway_complete(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway" "test" {
+  name                           = "acctestvpngw-%d"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  location                       = "${azurerm_resource_group.test.location}"
+  virtual_hub_id                 = "${azurerm_virtual_hub.test.id}"
+  allow_branch_to_branch_traffic = false
+  allow_vnet_to_vnet_traffic     = true
+
+  bgp_settings {
+    asn         = 65515
+    peer_weight = 0
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
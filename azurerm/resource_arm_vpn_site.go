@@ -0,0 +1,306 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVpnSite() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVpnSiteCreateUpdate,
+		Read:   resourceArmVpnSiteRead,
+		Update: resourceArmVpnSiteCreateUpdate,
+		Delete: resourceArmVpnSiteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"virtual_wan_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.IPv4Address,
+			},
+
+			"address_prefixes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.CIDRNetwork(0, 32),
+				},
+			},
+
+			"site_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"device_vendor": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"device_model": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"link_speed_in_mbps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"bgp_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"bgp_peering_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+
+						"peer_weight": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVpnSiteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnSitesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for VPN Site creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	addressPrefixes := make([]string, 0)
+	for _, v := range d.Get("address_prefixes").([]interface{}) {
+		addressPrefixes = append(addressPrefixes, v.(string))
+	}
+
+	site := network.VpnSite{
+		Location: utils.String(location),
+		Tags:     expandTags(tags, meta),
+		VpnSiteProperties: &network.VpnSiteProperties{
+			VirtualWAN: &network.SubResource{
+				ID: utils.String(d.Get("virtual_wan_id").(string)),
+			},
+			IPAddress: utils.String(d.Get("ip_address").(string)),
+			AddressSpace: &network.AddressSpace{
+				AddressPrefixes: &addressPrefixes,
+			},
+			DeviceProperties: &network.DeviceProperties{
+				DeviceVendor:    utils.String(d.Get("device_vendor").(string)),
+				DeviceModel:     utils.String(d.Get("device_model").(string)),
+				LinkSpeedInMbps: utils.Int32(int32(d.Get("link_speed_in_mbps").(int))),
+			},
+			BgpProperties: expandArmVpnSiteBgpSettings(d.Get("bgp_settings").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("site_key"); ok {
+		site.VpnSiteProperties.SiteKey = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, site)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	expectedId := azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Network", "vpnSites", name)
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, expectedId); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for VPN Site %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVpnSiteRead(d, meta)
+}
+
+func resourceArmVpnSiteRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnSitesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["vpnSites"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] VPN Site %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.VpnSiteProperties; props != nil {
+		d.Set("ip_address", props.IPAddress)
+
+		if wan := props.VirtualWAN; wan != nil {
+			d.Set("virtual_wan_id", wan.ID)
+		}
+
+		addressPrefixes := make([]string, 0)
+		if space := props.AddressSpace; space != nil && space.AddressPrefixes != nil {
+			addressPrefixes = *space.AddressPrefixes
+		}
+		if err := d.Set("address_prefixes", addressPrefixes); err != nil {
+			return fmt.Errorf("Error setting `address_prefixes`: %+v", err)
+		}
+
+		if device := props.DeviceProperties; device != nil {
+			d.Set("device_vendor", device.DeviceVendor)
+			d.Set("device_model", device.DeviceModel)
+			if device.LinkSpeedInMbps != nil {
+				d.Set("link_speed_in_mbps", int(*device.LinkSpeedInMbps))
+			}
+		}
+
+		if err := d.Set("bgp_settings", flattenArmVpnSiteBgpSettings(props.BgpProperties)); err != nil {
+			return fmt.Errorf("Error setting `bgp_settings`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmVpnSiteDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnSitesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["vpnSites"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmVpnSiteBgpSettings(input []interface{}) *network.BgpSettings {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	settings := network.BgpSettings{
+		Asn:               utils.Int64(int64(v["asn"].(int))),
+		BgpPeeringAddress: utils.String(v["bgp_peering_address"].(string)),
+	}
+
+	if peerWeight := v["peer_weight"].(int); peerWeight != 0 {
+		settings.PeerWeight = utils.Int32(int32(peerWeight))
+	}
+
+	return &settings
+}
+
+func flattenArmVpnSiteBgpSettings(input *network.BgpSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+
+	if input.Asn != nil {
+		output["asn"] = int(*input.Asn)
+	}
+
+	if input.BgpPeeringAddress != nil {
+		output["bgp_peering_address"] = *input.BgpPeeringAddress
+	}
+
+	if input.PeerWeight != nil {
+		output["peer_weight"] = int(*input.PeerWeight)
+	}
+
+	return []interface{}{output}
+}
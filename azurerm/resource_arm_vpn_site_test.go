@@ -0,0 +1,157 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVpnSite_basic(t *testing.T) {
+	resourceName := "azurerm_vpn_site.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVpnSite_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_prefixes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnSite_complete(t *testing.T) {
+	resourceName := "azurerm_vpn_site.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVpnSite_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_prefixes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "device_vendor", "Cisco"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_settings.0.asn", "65010"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVpnSiteExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for VPN Site: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).vpnSitesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: VPN Site %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vpnSitesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVpnSiteDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vpnSitesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_vpn_site" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("VPN Site still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVpnSite_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualWan_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestvpnsite-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  ip_address          = "203.0.113.10"
+  address_prefixes    = ["10.10.0.0/24"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnSite_complete(rInt int, location string) string {
+	template := testAccAzureRMVirtualWan_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestvpnsite-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  ip_address          = "203.0.113.10"
+  address_prefixes    = ["10.10.0.0/24", "10.20.0.0/24"]
+  device_vendor       = "Cisco"
+  device_model        = "ASR1000"
+  link_speed_in_mbps  = 50
+
+  bgp_settings {
+    asn                 = 65010
+    bgp_peering_address = "10.10.0.1"
+    peer_weight         = 10
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,687 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmWebApplicationFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmWebApplicationFirewallPolicyCreateUpdate,
+		Read:   resourceArmWebApplicationFirewallPolicyRead,
+		Update: resourceArmWebApplicationFirewallPolicyCreateUpdate,
+		Delete: resourceArmWebApplicationFirewallPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"policy_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Prevention",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Detection",
+								"Prevention",
+							}, false),
+						},
+
+						"request_body_check": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"file_upload_limit_in_mb": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(1, 750),
+						},
+
+						"max_request_body_size_in_kb": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      128,
+							ValidateFunc: validation.IntBetween(8, 128),
+						},
+					},
+				},
+			},
+
+			"managed_rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclusion": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_variable": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"RequestArgNames",
+											"RequestCookieNames",
+											"RequestHeaderNames",
+										}, false),
+									},
+
+									"selector": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"selector_match_operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Contains",
+											"EndsWith",
+											"Equals",
+											"EqualsAny",
+											"StartsWith",
+										}, false),
+									},
+								},
+							},
+						},
+
+						"managed_rule_set": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "OWASP",
+										ValidateFunc: validation.StringInSlice([]string{
+											"OWASP",
+										}, false),
+									},
+
+									"version": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"2.2.9",
+											"3.0",
+											"3.1",
+										}, false),
+									},
+
+									"rule_group_override": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"rule_group_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.NoZeroValues,
+												},
+
+												"disabled_rules": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.NoZeroValues,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"custom_rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+
+						"priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"rule_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"MatchRule",
+							}, false),
+						},
+
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Allow",
+								"Block",
+								"Log",
+							}, false),
+						},
+
+						"match_conditions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_variables": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"variable_name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														"PostArgs",
+														"QueryString",
+														"RemoteAddr",
+														"RequestBody",
+														"RequestCookies",
+														"RequestHeaders",
+														"RequestMethod",
+														"RequestUri",
+													}, false),
+												},
+
+												"selector": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"BeginsWith",
+											"Contains",
+											"EndsWith",
+											"Equal",
+											"GeoMatch",
+											"GreaterThan",
+											"GreaterThanOrEqual",
+											"IPMatch",
+											"LessThan",
+											"LessThanOrEqual",
+											"Regex",
+										}, false),
+									},
+
+									"negation_condition": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+
+									"match_values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"transforms": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"HtmlEntityDecode",
+												"Lowercase",
+												"RemoveNulls",
+												"Trim",
+												"UrlDecode",
+												"UrlEncode",
+											}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"http_listener_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"path_based_rule_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmWebApplicationFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).webApplicationFirewallPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Web Application Firewall Policy creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := webApplicationFirewallPolicy{
+		Location: utils.String(location),
+		Properties: &webApplicationFirewallPolicyProperties{
+			PolicySettings: expandArmWebApplicationFirewallPolicySettings(d.Get("policy_settings").([]interface{})),
+			CustomRules:    expandArmWebApplicationFirewallPolicyCustomRules(d.Get("custom_rules").([]interface{})),
+			ManagedRules:   expandArmWebApplicationFirewallPolicyManagedRules(d.Get("managed_rules").([]interface{})),
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	id := webApplicationFirewallPolicyResourceID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+	future, err := client.CreateOrUpdate(ctx, id, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := waitForCreateOrUpdate(ctx, d, &future.Future, client.Client, id); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmWebApplicationFirewallPolicyRead(d, meta)
+}
+
+func resourceArmWebApplicationFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).webApplicationFirewallPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["ApplicationGatewayWebApplicationFirewallPolicies"]
+
+	var policy webApplicationFirewallPolicy
+	resp, err := client.Get(ctx, d.Id(), &policy)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Web Application Firewall Policy %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := policy.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := policy.Properties; props != nil {
+		if err := d.Set("policy_settings", flattenArmWebApplicationFirewallPolicySettings(props.PolicySettings)); err != nil {
+			return fmt.Errorf("Error setting `policy_settings`: %+v", err)
+		}
+
+		if err := d.Set("custom_rules", flattenArmWebApplicationFirewallPolicyCustomRules(props.CustomRules)); err != nil {
+			return fmt.Errorf("Error setting `custom_rules`: %+v", err)
+		}
+
+		if err := d.Set("managed_rules", flattenArmWebApplicationFirewallPolicyManagedRules(props.ManagedRules)); err != nil {
+			return fmt.Errorf("Error setting `managed_rules`: %+v", err)
+		}
+
+		if err := d.Set("http_listener_ids", flattenArmWebApplicationFirewallPolicySubResources(props.HTTPListeners)); err != nil {
+			return fmt.Errorf("Error setting `http_listener_ids`: %+v", err)
+		}
+
+		if err := d.Set("path_based_rule_ids", flattenArmWebApplicationFirewallPolicySubResources(props.PathBasedRules)); err != nil {
+			return fmt.Errorf("Error setting `path_based_rule_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, policy.Tags, meta)
+
+	return nil
+}
+
+func resourceArmWebApplicationFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).webApplicationFirewallPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	future, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Web Application Firewall Policy %q: %+v", d.Id(), err)
+	}
+
+	if err := waitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Web Application Firewall Policy %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandArmWebApplicationFirewallPolicySettings(input []interface{}) *webApplicationFirewallPolicySettings {
+	settings := webApplicationFirewallPolicySettings{
+		State:                  "Enabled",
+		Mode:                   "Prevention",
+		RequestBodyCheck:       true,
+		FileUploadLimitInMb:    100,
+		MaxRequestBodySizeInKb: 128,
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &settings
+	}
+	v := input[0].(map[string]interface{})
+
+	if !v["enabled"].(bool) {
+		settings.State = "Disabled"
+	}
+	settings.Mode = v["mode"].(string)
+	settings.RequestBodyCheck = v["request_body_check"].(bool)
+	settings.FileUploadLimitInMb = int32(v["file_upload_limit_in_mb"].(int))
+	settings.MaxRequestBodySizeInKb = int32(v["max_request_body_size_in_kb"].(int))
+
+	return &settings
+}
+
+func expandArmWebApplicationFirewallPolicyCustomRules(input []interface{}) []webApplicationFirewallCustomRule {
+	rules := make([]webApplicationFirewallCustomRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		conditions := make([]webApplicationFirewallMatchCondition, 0)
+		for _, conditionRaw := range v["match_conditions"].([]interface{}) {
+			condition := conditionRaw.(map[string]interface{})
+
+			variables := make([]webApplicationFirewallMatchVariable, 0)
+			for _, variableRaw := range condition["match_variables"].([]interface{}) {
+				variable := variableRaw.(map[string]interface{})
+
+				matchVariable := webApplicationFirewallMatchVariable{
+					VariableName: variable["variable_name"].(string),
+				}
+				if selector := variable["selector"].(string); selector != "" {
+					matchVariable.Selector = utils.String(selector)
+				}
+
+				variables = append(variables, matchVariable)
+			}
+
+			values := make([]string, 0)
+			for _, value := range condition["match_values"].([]interface{}) {
+				values = append(values, value.(string))
+			}
+
+			transforms := make([]string, 0)
+			for _, transform := range condition["transforms"].(*schema.Set).List() {
+				transforms = append(transforms, transform.(string))
+			}
+
+			conditions = append(conditions, webApplicationFirewallMatchCondition{
+				MatchVariables:   variables,
+				Operator:         condition["operator"].(string),
+				NegationConditon: condition["negation_condition"].(bool),
+				MatchValues:      values,
+				Transforms:       transforms,
+			})
+		}
+
+		rules = append(rules, webApplicationFirewallCustomRule{
+			Name:            v["name"].(string),
+			Priority:        int32(v["priority"].(int)),
+			RuleType:        v["rule_type"].(string),
+			Action:          v["action"].(string),
+			MatchConditions: conditions,
+		})
+	}
+
+	return rules
+}
+
+func expandArmWebApplicationFirewallPolicyManagedRules(input []interface{}) *webApplicationFirewallManagedRules {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	exclusions := make([]webApplicationFirewallExclusion, 0)
+	for _, exclusionRaw := range v["exclusion"].([]interface{}) {
+		exclusion := exclusionRaw.(map[string]interface{})
+
+		exclusions = append(exclusions, webApplicationFirewallExclusion{
+			MatchVariable:         exclusion["match_variable"].(string),
+			Selector:              exclusion["selector"].(string),
+			SelectorMatchOperator: exclusion["selector_match_operator"].(string),
+		})
+	}
+
+	ruleSets := make([]webApplicationFirewallManagedRuleSet, 0)
+	for _, ruleSetRaw := range v["managed_rule_set"].([]interface{}) {
+		ruleSet := ruleSetRaw.(map[string]interface{})
+
+		overrides := make([]webApplicationFirewallRuleGroupOverride, 0)
+		for _, overrideRaw := range ruleSet["rule_group_override"].([]interface{}) {
+			override := overrideRaw.(map[string]interface{})
+
+			rules := make([]webApplicationFirewallRuleOverride, 0)
+			for _, ruleId := range override["disabled_rules"].([]interface{}) {
+				rules = append(rules, webApplicationFirewallRuleOverride{
+					RuleID: ruleId.(string),
+					State:  "Disabled",
+				})
+			}
+
+			overrides = append(overrides, webApplicationFirewallRuleGroupOverride{
+				RuleGroupName: override["rule_group_name"].(string),
+				Rules:         rules,
+			})
+		}
+
+		ruleSets = append(ruleSets, webApplicationFirewallManagedRuleSet{
+			RuleSetType:        ruleSet["type"].(string),
+			RuleSetVersion:     ruleSet["version"].(string),
+			RuleGroupOverrides: overrides,
+		})
+	}
+
+	return &webApplicationFirewallManagedRules{
+		Exclusions:      exclusions,
+		ManagedRuleSets: ruleSets,
+	}
+}
+
+func flattenArmWebApplicationFirewallPolicySettings(input *webApplicationFirewallPolicySettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":                     input.State == "Enabled",
+			"mode":                        input.Mode,
+			"request_body_check":          input.RequestBodyCheck,
+			"file_upload_limit_in_mb":     int(input.FileUploadLimitInMb),
+			"max_request_body_size_in_kb": int(input.MaxRequestBodySizeInKb),
+		},
+	}
+}
+
+func flattenArmWebApplicationFirewallPolicyCustomRules(input []webApplicationFirewallCustomRule) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, rule := range input {
+		conditions := make([]interface{}, 0)
+		for _, condition := range rule.MatchConditions {
+			variables := make([]interface{}, 0)
+			for _, variable := range condition.MatchVariables {
+				selector := ""
+				if variable.Selector != nil {
+					selector = *variable.Selector
+				}
+
+				variables = append(variables, map[string]interface{}{
+					"variable_name": variable.VariableName,
+					"selector":      selector,
+				})
+			}
+
+			values := make([]interface{}, 0)
+			for _, value := range condition.MatchValues {
+				values = append(values, value)
+			}
+
+			transforms := make([]interface{}, 0)
+			for _, transform := range condition.Transforms {
+				transforms = append(transforms, transform)
+			}
+
+			conditions = append(conditions, map[string]interface{}{
+				"match_variables":    variables,
+				"operator":           condition.Operator,
+				"negation_condition": condition.NegationConditon,
+				"match_values":       values,
+				"transforms":         schema.NewSet(schema.HashString, transforms),
+			})
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":             rule.Name,
+			"priority":         int(rule.Priority),
+			"rule_type":        rule.RuleType,
+			"action":           rule.Action,
+			"match_conditions": conditions,
+		})
+	}
+
+	return results
+}
+
+func flattenArmWebApplicationFirewallPolicyManagedRules(input *webApplicationFirewallManagedRules) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	exclusions := make([]interface{}, 0)
+	for _, exclusion := range input.Exclusions {
+		exclusions = append(exclusions, map[string]interface{}{
+			"match_variable":          exclusion.MatchVariable,
+			"selector":                exclusion.Selector,
+			"selector_match_operator": exclusion.SelectorMatchOperator,
+		})
+	}
+
+	ruleSets := make([]interface{}, 0)
+	for _, ruleSet := range input.ManagedRuleSets {
+		overrides := make([]interface{}, 0)
+		for _, override := range ruleSet.RuleGroupOverrides {
+			disabledRules := make([]interface{}, 0)
+			for _, rule := range override.Rules {
+				disabledRules = append(disabledRules, rule.RuleID)
+			}
+
+			overrides = append(overrides, map[string]interface{}{
+				"rule_group_name": override.RuleGroupName,
+				"disabled_rules":  disabledRules,
+			})
+		}
+
+		ruleSets = append(ruleSets, map[string]interface{}{
+			"type":                ruleSet.RuleSetType,
+			"version":             ruleSet.RuleSetVersion,
+			"rule_group_override": overrides,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"exclusion":        exclusions,
+			"managed_rule_set": ruleSets,
+		},
+	}
+}
+
+func flattenArmWebApplicationFirewallPolicySubResources(input []webApplicationFirewallPolicySubResource) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		if item.ID != nil {
+			results = append(results, *item.ID)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,303 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandArmWebApplicationFirewallPolicyManagedRules(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"exclusion": []interface{}{
+				map[string]interface{}{
+					"match_variable":          "RequestHeaderNames",
+					"selector":                "x-company-secret-header",
+					"selector_match_operator": "Equals",
+				},
+			},
+			"managed_rule_set": []interface{}{
+				map[string]interface{}{
+					"type":    "OWASP",
+					"version": "3.1",
+					"rule_group_override": []interface{}{
+						map[string]interface{}{
+							"rule_group_name": "REQUEST-920-PROTOCOL-ENFORCEMENT",
+							"disabled_rules":  []interface{}{"920300", "920440"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expanded := expandArmWebApplicationFirewallPolicyManagedRules(input)
+	if len(expanded.ManagedRuleSets) != 1 || len(expanded.ManagedRuleSets[0].RuleGroupOverrides[0].Rules) != 2 {
+		t.Fatalf("Expected one Managed Rule Set with two disabled rules but got %+v", expanded.ManagedRuleSets)
+	}
+
+	if state := expanded.ManagedRuleSets[0].RuleGroupOverrides[0].Rules[0].State; state != "Disabled" {
+		t.Fatalf("Expected the overridden rules to be Disabled but got %q", state)
+	}
+
+	if flattened := flattenArmWebApplicationFirewallPolicyManagedRules(expanded); !reflect.DeepEqual(flattened, input) {
+		t.Fatalf("Expected the flattened Managed Rules to be %+v but got %+v", input, flattened)
+	}
+}
+
+func TestExpandArmWebApplicationFirewallPolicyCustomRules(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":      "Rule1",
+			"priority":  1,
+			"rule_type": "MatchRule",
+			"action":    "Block",
+			"match_conditions": []interface{}{
+				map[string]interface{}{
+					"match_variables": []interface{}{
+						map[string]interface{}{
+							"variable_name": "RequestHeaders",
+							"selector":      "UserAgent",
+						},
+					},
+					"operator":           "Contains",
+					"negation_condition": false,
+					"match_values":       []interface{}{"Windows"},
+					"transforms":         schema.NewSet(schema.HashString, []interface{}{"Lowercase"}),
+				},
+			},
+		},
+	}
+
+	expanded := expandArmWebApplicationFirewallPolicyCustomRules(input)
+	if len(expanded) != 1 || expanded[0].MatchConditions[0].MatchVariables[0].Selector == nil {
+		t.Fatalf("Expected one Custom Rule with a selector but got %+v", expanded)
+	}
+
+	flattened := flattenArmWebApplicationFirewallPolicyCustomRules(expanded)
+	condition := flattened[0].(map[string]interface{})["match_conditions"].([]interface{})[0].(map[string]interface{})
+	if transforms := condition["transforms"].(*schema.Set); transforms.Len() != 1 || !transforms.Contains("Lowercase") {
+		t.Fatalf("Expected the transforms to be retained but got %+v", transforms.List())
+	}
+}
+
+func TestAccAzureRMWebApplicationFirewallPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_web_application_firewall_policy.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMWebApplicationFirewallPolicy_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebApplicationFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_settings.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_settings.0.mode", "Prevention"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rules.0.managed_rule_set.0.version", "3.1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWebApplicationFirewallPolicy_complete(t *testing.T) {
+	resourceName := "azurerm_web_application_firewall_policy.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebApplicationFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_settings.0.mode", "Detection"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rules.0.match_conditions.0.match_values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rules.0.exclusion.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rules.0.managed_rule_set.0.rule_group_override.0.disabled_rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMWebApplicationFirewallPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).webApplicationFirewallPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_web_application_firewall_policy" {
+			continue
+		}
+
+		var policy webApplicationFirewallPolicy
+		resp, err := client.Get(ctx, rs.Primary.ID, &policy)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Web Application Firewall Policy still exists:\n%#v", policy)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).webApplicationFirewallPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var policy webApplicationFirewallPolicy
+		resp, err := client.Get(ctx, rs.Primary.ID, &policy)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Web Application Firewall Policy %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on webApplicationFirewallPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMWebApplicationFirewallPolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_web_application_firewall_policy" "test" {
+  name                = "acctestwafpolicy-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  managed_rules {
+    managed_rule_set {
+      version = "3.1"
+    }
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMWebApplicationFirewallPolicy_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_web_application_firewall_policy" "test" {
+  name                = "acctestwafpolicy-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  policy_settings {
+    enabled                     = true
+    mode                        = "Detection"
+    request_body_check          = true
+    file_upload_limit_in_mb     = 100
+    max_request_body_size_in_kb = 128
+  }
+
+  custom_rules {
+    name      = "Rule1"
+    priority  = 1
+    rule_type = "MatchRule"
+    action    = "Block"
+
+    match_conditions {
+      match_variables {
+        variable_name = "RemoteAddr"
+      }
+
+      operator           = "IPMatch"
+      negation_condition = false
+      match_values       = ["192.168.1.0/24", "10.0.0.0/24"]
+    }
+  }
+
+  custom_rules {
+    name      = "Rule2"
+    priority  = 2
+    rule_type = "MatchRule"
+    action    = "Block"
+
+    match_conditions {
+      match_variables {
+        variable_name = "RequestHeaders"
+        selector      = "UserAgent"
+      }
+
+      operator           = "Contains"
+      negation_condition = false
+      match_values       = ["Windows"]
+      transforms         = ["Lowercase"]
+    }
+  }
+
+  managed_rules {
+    exclusion {
+      match_variable          = "RequestHeaderNames"
+      selector                = "x-company-secret-header"
+      selector_match_operator = "Equals"
+    }
+
+    managed_rule_set {
+      type    = "OWASP"
+      version = "3.1"
+
+      rule_group_override {
+        rule_group_name = "REQUEST-920-PROTOCOL-ENFORCEMENT"
+        disabled_rules  = ["920300", "920440"]
+      }
+    }
+  }
+
+  tags {
+    environment = "test"
+  }
+}
+`, rInt, location, rInt)
+}
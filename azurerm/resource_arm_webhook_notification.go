@@ -0,0 +1,165 @@
+package azurerm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// Webhook Notifications aren't an Azure resource - instead a JSON summary of the specified resources is sent
+// to the URL on each apply, which (through its dependencies) happens once those resources have been provisioned.
+func resourceArmWebhookNotification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmWebhookNotificationCreateUpdate,
+		Read:   resourceArmWebhookNotificationRead,
+		Update: resourceArmWebhookNotificationCreateUpdate,
+		Delete: resourceArmWebhookNotificationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.UrlIsHttpOrHttps(),
+			},
+
+			"resource_ids": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"headers": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 300),
+			},
+
+			"status_code": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"sent_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		// the notification is sent on every apply (rather than only when the arguments change), as such `sent_at`
+		// is always recomputed - which triggers an update for an existing notification
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if diff.Id() == "" {
+				return nil
+			}
+
+			return diff.SetNewComputed("sent_at")
+		},
+	}
+}
+
+type webhookNotificationPayload struct {
+	Name        string                 `json:"name"`
+	ResourceIDs map[string]interface{} `json:"resourceIds"`
+	Properties  map[string]interface{} `json:"properties"`
+	Timestamp   string                 `json:"timestamp"`
+}
+
+func resourceArmWebhookNotificationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	url := d.Get("url").(string)
+
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	payload := webhookNotificationPayload{
+		Name:        name,
+		ResourceIDs: d.Get("resource_ids").(map[string]interface{}),
+		Properties:  d.Get("properties").(map[string]interface{}),
+		Timestamp:   timestamp,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("Error serializing the payload for Webhook Notification %q: %+v", name, err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("Error building the request for Webhook Notification %q: %+v", name, err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range d.Get("headers").(map[string]interface{}) {
+		req.Header.Set(k, v.(string))
+	}
+
+	client := &http.Client{
+		Timeout: time.Duration(d.Get("timeout_in_seconds").(int)) * time.Second,
+	}
+
+	log.Printf("[DEBUG] Sending Webhook Notification %q..", name)
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Error sending Webhook Notification %q: %+v", name, err)
+	}
+	defer resp.Body.Close()
+
+	// the response body isn't used, but is drained so that the connection can be re-used
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("Error sending Webhook Notification %q: expected a 2xx response but got %d", name, resp.StatusCode)
+	}
+
+	if d.Id() == "" {
+		id, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("Error generating an ID for Webhook Notification %q: %+v", name, err)
+		}
+
+		d.SetId(id)
+	}
+
+	d.Set("status_code", resp.StatusCode)
+	d.Set("sent_at", timestamp)
+
+	return resourceArmWebhookNotificationRead(d, meta)
+}
+
+func resourceArmWebhookNotificationRead(d *schema.ResourceData, meta interface{}) error {
+	// the notification only exists in the state, so there's nothing to refresh
+	return nil
+}
+
+func resourceArmWebhookNotificationDelete(d *schema.ResourceData, meta interface{}) error {
+	// notifications can't be unsent - so there's nothing to delete
+	return nil
+}
@@ -0,0 +1,133 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceArmWebhookNotificationCreate(t *testing.T) {
+	var received webhookNotificationPayload
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Fatalf("Error decoding the payload: %+v", err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceArmWebhookNotification().Schema, map[string]interface{}{
+		"name": "deployment",
+		"url":  server.URL,
+		"resource_ids": map[string]interface{}{
+			"resource_group": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+		},
+		"headers": map[string]interface{}{
+			"Authorization": "Bearer abc123",
+		},
+	})
+	meta := &ArmClient{
+		StopContext: context.Background(),
+	}
+
+	if err := resourceArmWebhookNotificationCreateUpdate(d, meta); err != nil {
+		t.Fatalf("Error sending the notification: %+v", err)
+	}
+
+	if d.Id() == "" {
+		t.Fatalf("Expected an ID to be set")
+	}
+
+	if v := d.Get("status_code").(int); v != http.StatusAccepted {
+		t.Fatalf("Expected the status code to be %d but got %d", http.StatusAccepted, v)
+	}
+
+	if received.Name != "deployment" || received.ResourceIDs["resource_group"] == nil {
+		t.Fatalf("Expected the payload to contain the name and resource IDs but got %+v", received)
+	}
+
+	if authorization != "Bearer abc123" {
+		t.Fatalf("Expected the Authorization header to be sent but got %q", authorization)
+	}
+}
+
+func TestResourceArmWebhookNotificationCreate_errorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceArmWebhookNotification().Schema, map[string]interface{}{
+		"name": "deployment",
+		"url":  server.URL,
+	})
+	meta := &ArmClient{
+		StopContext: context.Background(),
+	}
+
+	if err := resourceArmWebhookNotificationCreateUpdate(d, meta); err == nil {
+		t.Fatalf("Expected an error for a non-2xx response")
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("Expected no ID to be set when the notification fails")
+	}
+}
+
+func TestResourceArmWebhookNotification_sentOnEveryApply(t *testing.T) {
+	notifications := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		notifications++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	r := resourceArmWebhookNotification()
+	meta := &ArmClient{
+		StopContext: context.Background(),
+	}
+
+	raw := map[string]interface{}{
+		"name": "deployment",
+		"url":  server.URL,
+	}
+	c := terraform.NewResourceConfig(nil)
+	c.Raw = raw
+	c.Config = raw
+
+	var state *terraform.InstanceState
+	for i := 1; i <= 2; i++ {
+		diff, err := r.Diff(state, c, meta)
+		if err != nil {
+			t.Fatalf("Error planning apply %d: %+v", i, err)
+		}
+
+		if diff == nil || diff.Empty() {
+			t.Fatalf("Expected a diff for apply %d, but the configuration was unchanged", i)
+		}
+
+		if diff.RequiresNew() && state != nil {
+			t.Fatalf("Expected apply %d to update the existing notification rather than replace it", i)
+		}
+
+		state, err = r.Apply(state, diff, meta)
+		if err != nil {
+			t.Fatalf("Error during apply %d: %+v", i, err)
+		}
+
+		if notifications != i {
+			t.Fatalf("Expected %d notifications to have been sent after apply %d but got %d", i, i, notifications)
+		}
+
+		if state.Attributes["sent_at"] == "" {
+			t.Fatalf("Expected `sent_at` to be set after apply %d", i)
+		}
+	}
+}
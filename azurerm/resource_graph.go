@@ -0,0 +1,55 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The Resource Graph isn't available in the version of the Azure SDK used by this Provider, as such queries are
+// sent using the `resourcemanager` client - these are the models for the API Version `2019-04-01`.
+
+const resourceGraphResourcesID = "/providers/Microsoft.ResourceGraph"
+
+type resourceGraphQueryRequest struct {
+	Subscriptions []string                          `json:"subscriptions"`
+	Query         string                            `json:"query"`
+	Options       *resourceGraphQueryRequestOptions `json:"options,omitempty"`
+}
+
+type resourceGraphQueryRequestOptions struct {
+	ResultFormat string  `json:"resultFormat,omitempty"`
+	SkipToken    *string `json:"$skipToken,omitempty"`
+}
+
+type resourceGraphQueryResponse struct {
+	TotalRecords *int64                   `json:"totalRecords,omitempty"`
+	Count        *int64                   `json:"count,omitempty"`
+	SkipToken    *string                  `json:"$skipToken,omitempty"`
+	Data         []map[string]interface{} `json:"data"`
+}
+
+// flattenResourceGraphRow returns the columns of a row as strings - where columns containing anything other
+// than a string (for example a number, or the `properties` of a resource) are JSON-encoded
+func flattenResourceGraphRow(input map[string]interface{}) (map[string]interface{}, error) {
+	output := make(map[string]interface{}, len(input))
+
+	for column, value := range input {
+		if value == nil {
+			output[column] = ""
+			continue
+		}
+
+		if v, ok := value.(string); ok {
+			output[column] = v
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("Error serializing column %q: %+v", column, err)
+		}
+		output[column] = string(encoded)
+	}
+
+	return output, nil
+}
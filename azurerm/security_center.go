@@ -0,0 +1,60 @@
+package azurerm
+
+import "fmt"
+
+// The Security Center Resource Provider isn't available in the version of the Azure SDK used by this Provider,
+// as such these resources are managed using the `resourcemanager` client - these are the models for the API
+// Version `2017-08-01-preview`.
+
+const (
+	securityCenterPricingName   = "default"
+	securityCenterContactName   = "default1"
+	securityCenterWorkspaceName = "default"
+)
+
+type securityCenterPricing struct {
+	ID         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *securityCenterPricingProperties `json:"properties,omitempty"`
+}
+
+type securityCenterPricingProperties struct {
+	PricingTier string `json:"pricingTier"`
+}
+
+type securityCenterContact struct {
+	ID         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *securityCenterContactProperties `json:"properties,omitempty"`
+}
+
+type securityCenterContactProperties struct {
+	Email              *string `json:"email,omitempty"`
+	Phone              *string `json:"phone,omitempty"`
+	AlertNotifications string  `json:"alertNotifications"`
+	AlertsToAdmins     string  `json:"alertsToAdmins"`
+}
+
+type securityCenterWorkspaceSetting struct {
+	ID         *string                                   `json:"id,omitempty"`
+	Name       *string                                   `json:"name,omitempty"`
+	Properties *securityCenterWorkspaceSettingProperties `json:"properties,omitempty"`
+}
+
+type securityCenterWorkspaceSettingProperties struct {
+	WorkspaceID *string `json:"workspaceId,omitempty"`
+	Scope       *string `json:"scope,omitempty"`
+}
+
+func securityCenterResourceID(subscriptionId, resourceType, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Security/%s/%s", subscriptionId, resourceType, name)
+}
+
+// securityCenterFlag converts a boolean into the `On`/`Off` values used by the Security Center API
+func securityCenterFlag(input bool) string {
+	if input {
+		return "On"
+	}
+
+	return "Off"
+}
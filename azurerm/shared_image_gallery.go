@@ -0,0 +1,135 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Shared Image Galleries aren't available in the version of the Azure SDK used by this Provider (which only
+// includes the Compute API Version `2017-12-01`), as such these are managed using the `resourcemanager`
+// client - these are the models for the API Version `2018-06-01`.
+
+type sharedImageGallery struct {
+	ID         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Location   *string                       `json:"location,omitempty"`
+	Tags       map[string]*string            `json:"tags,omitempty"`
+	Properties *sharedImageGalleryProperties `json:"properties,omitempty"`
+}
+
+type sharedImageGalleryProperties struct {
+	Description       *string                       `json:"description,omitempty"`
+	Identifier        *sharedImageGalleryIdentifier `json:"identifier,omitempty"`
+	ProvisioningState *string                       `json:"provisioningState,omitempty"`
+}
+
+type sharedImageGalleryIdentifier struct {
+	UniqueName *string `json:"uniqueName,omitempty"`
+}
+
+type sharedImage struct {
+	ID         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Location   *string                `json:"location,omitempty"`
+	Tags       map[string]*string     `json:"tags,omitempty"`
+	Properties *sharedImageProperties `json:"properties,omitempty"`
+}
+
+type sharedImageProperties struct {
+	Description         *string                `json:"description,omitempty"`
+	Eula                *string                `json:"eula,omitempty"`
+	PrivacyStatementURI *string                `json:"privacyStatementUri,omitempty"`
+	ReleaseNoteURI      *string                `json:"releaseNoteUri,omitempty"`
+	OsType              string                 `json:"osType"`
+	OsState             string                 `json:"osState"`
+	Identifier          *sharedImageIdentifier `json:"identifier,omitempty"`
+	ProvisioningState   *string                `json:"provisioningState,omitempty"`
+}
+
+type sharedImageIdentifier struct {
+	Publisher string `json:"publisher"`
+	Offer     string `json:"offer"`
+	Sku       string `json:"sku"`
+}
+
+type sharedImageVersion struct {
+	ID         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Location   *string                       `json:"location,omitempty"`
+	Tags       map[string]*string            `json:"tags,omitempty"`
+	Properties *sharedImageVersionProperties `json:"properties,omitempty"`
+}
+
+type sharedImageVersionProperties struct {
+	PublishingProfile *sharedImageVersionPublishingProfile `json:"publishingProfile,omitempty"`
+	ProvisioningState *string                              `json:"provisioningState,omitempty"`
+}
+
+type sharedImageVersionPublishingProfile struct {
+	Source            *sharedImageVersionSource `json:"source,omitempty"`
+	TargetRegions     []sharedImageTargetRegion `json:"targetRegions,omitempty"`
+	ExcludeFromLatest *bool                     `json:"excludeFromLatest,omitempty"`
+}
+
+type sharedImageVersionSource struct {
+	ManagedImage *sharedImageVersionManagedImage `json:"managedImage,omitempty"`
+}
+
+type sharedImageVersionManagedImage struct {
+	ID string `json:"id"`
+}
+
+type sharedImageTargetRegion struct {
+	Name                 string `json:"name"`
+	RegionalReplicaCount *int32 `json:"regionalReplicaCount,omitempty"`
+}
+
+func sharedImageGalleryResourceID(subscriptionId, resourceGroup, galleryName string) string {
+	return azureRMResourceID(subscriptionId, resourceGroup, "Microsoft.Compute", "galleries", galleryName)
+}
+
+func sharedImageResourceID(subscriptionId, resourceGroup, galleryName, imageName string) string {
+	return fmt.Sprintf("%s/images/%s", sharedImageGalleryResourceID(subscriptionId, resourceGroup, galleryName), imageName)
+}
+
+func sharedImageVersionResourceID(subscriptionId, resourceGroup, galleryName, imageName, version string) string {
+	return fmt.Sprintf("%s/versions/%s", sharedImageResourceID(subscriptionId, resourceGroup, galleryName, imageName), version)
+}
+
+func validateAzureRMSharedImageGalleryName(v interface{}, k string) (warnings []string, errors []error) {
+	name := v.(string)
+
+	if !regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.]*[A-Za-z0-9]$`).MatchString(name) {
+		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters, underscores and periods, and must begin and end with an alphanumeric character: %q", k, name))
+	}
+
+	if len(name) > 80 {
+		errors = append(errors, fmt.Errorf("%q must be no longer than 80 characters: %q", k, name))
+	}
+
+	return
+}
+
+func validateAzureRMSharedImageName(v interface{}, k string) (warnings []string, errors []error) {
+	name := v.(string)
+
+	if !regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*[A-Za-z0-9]$`).MatchString(name) {
+		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters, underscores, hyphens and periods, and must begin and end with an alphanumeric character: %q", k, name))
+	}
+
+	if len(name) > 80 {
+		errors = append(errors, fmt.Errorf("%q must be no longer than 80 characters: %q", k, name))
+	}
+
+	return
+}
+
+func validateAzureRMSharedImageVersionName(v interface{}, k string) (warnings []string, errors []error) {
+	name := v.(string)
+
+	if !regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`).MatchString(name) {
+		errors = append(errors, fmt.Errorf("%q must be in the format `Major.Minor.Patch` (for example `1.0.0`): %q", k, name))
+	}
+
+	return
+}
@@ -0,0 +1,35 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Tags at the Subscription scope aren't available in the version of the Azure SDK used by this Provider, as such
+// they're managed using the `resourcemanager` client - these are the models for the API Version `2019-10-01`.
+
+type subscriptionTagsResource struct {
+	ID         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Type       *string                     `json:"type,omitempty"`
+	Properties *subscriptionTagsProperties `json:"properties,omitempty"`
+}
+
+type subscriptionTagsProperties struct {
+	Tags map[string]*string `json:"tags"`
+}
+
+func subscriptionTagsID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Resources/tags/default", subscriptionId)
+}
+
+func parseSubscriptionTagsID(id string) (string, error) {
+	// /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/tags/default
+	components := strings.Split(id, "/")
+
+	if len(components) != 7 || !strings.EqualFold(components[1], "subscriptions") || components[2] == "" || !strings.EqualFold(components[5], "tags") || components[6] != "default" {
+		return "", fmt.Errorf("Subscription Tags ID should be in the format `/subscriptions/{subscriptionId}/providers/Microsoft.Resources/tags/default` but got %q", id)
+	}
+
+	return components[2], nil
+}
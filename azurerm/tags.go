@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -68,7 +69,13 @@ func validateAzureRMTags(v interface{}, _ string) (ws []string, es []error) {
 	return ws, es
 }
 
-func expandTags(tagsMap map[string]interface{}) map[string]*string {
+// expandTags returns the `tags` defined on a resource merged with the `default_tags` configured on the Provider, where
+// the tags defined on the resource take precedence over the Default Tags.
+func expandTags(tagsMap map[string]interface{}, meta interface{}) map[string]*string {
+	if client, ok := meta.(*ArmClient); ok && len(client.defaultTags) > 0 {
+		tagsMap = mergeDefaultTags(client.defaultTags, tagsMap)
+	}
+
 	output := make(map[string]*string, len(tagsMap))
 
 	for i, v := range tagsMap {
@@ -104,7 +111,50 @@ func filterTags(tagsMap map[string]*string, tagNames ...string) map[string]*stri
 	return tagsRet
 }
 
-func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
+// hasOnlyTagChanges returns whether `tags` (or `tags_all`, when the Provider's `default_tags` change) are the only fields
+// of an existing resource which have changed - in which case the resource can be updated using the Update Tags (PATCH) API,
+// rather than a full CreateOrUpdate which can take a considerable amount of time for larger resources
+func hasOnlyTagChanges(d *schema.ResourceData, resourceType string, resource func() *schema.Resource) bool {
+	if d.IsNewResource() || (!d.HasChange("tags") && !d.HasChange("tags_all")) {
+		return false
+	}
+
+	for _, k := range nonTagSchemaKeys(resourceType, resource) {
+		if d.HasChange(k) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// nonTagSchemaKeysCache contains the schema keys (other than the tags) for each resource type using hasOnlyTagChanges,
+// such that the schema is only built once rather than during each update
+var nonTagSchemaKeysCache sync.Map
+
+func nonTagSchemaKeys(resourceType string, resource func() *schema.Resource) []string {
+	if keys, ok := nonTagSchemaKeysCache.Load(resourceType); ok {
+		return keys.([]string)
+	}
+
+	keys := make([]string, 0)
+	for k := range resource().Schema {
+		if k == "tags" || k == "tags_all" {
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	nonTagSchemaKeysCache.Store(resourceType, keys)
+	return keys
+}
+
+// flattenAndSetTags sets the tags returned from Azure into the state. For resources any tags matching the `ignore_tags`
+// configured on the Provider are removed and the remaining tags are set into `tags_all` - with the keys from the
+// `default_tags` removed from `tags` unless they're also defined on the resource, so that these don't show as a diff.
+// Data Sources pass a nil `meta`, since these expose each of the tags returned from Azure.
+func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string, meta interface{}) {
 
 	// If tagsMap is nil, len(tagsMap) will be 0.
 	output := make(map[string]interface{}, len(tagMap))
@@ -113,5 +163,15 @@ func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
 		output[i] = *v
 	}
 
-	d.Set("tags", output)
+	client, ok := meta.(*ArmClient)
+	if !ok {
+		d.Set("tags", output)
+		return
+	}
+
+	output = filterIgnoredTags(output, client.ignoreTagKeys, client.ignoreTagKeyPrefixes)
+	d.Set("tags_all", output)
+
+	configured, _ := d.Get("tags").(map[string]interface{})
+	d.Set("tags", removeDefaultTags(output, client.defaultTags, configured))
 }
@@ -2,8 +2,12 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
@@ -74,7 +78,7 @@ func TestExpandARMTags(t *testing.T) {
 	testData["key2"] = 21
 	testData["key3"] = "value3"
 
-	expanded := expandTags(testData)
+	expanded := expandTags(testData, nil)
 
 	if len(expanded) != 3 {
 		t.Fatalf("Expected 3 results in expanded tag map, got %d", len(expanded))
@@ -95,6 +99,189 @@ func TestExpandARMTags(t *testing.T) {
 	}
 }
 
+func TestExpandARMTags_defaultTags(t *testing.T) {
+	meta := &ArmClient{
+		defaultTags: map[string]interface{}{
+			"cost_center": "MSFT",
+			"environment": "Production",
+		},
+	}
+	tags := map[string]interface{}{
+		"environment": "staging",
+	}
+
+	expanded := expandTags(tags, meta)
+
+	if len(expanded) != 2 {
+		t.Fatalf("Expected 2 results in expanded tag map, got %d", len(expanded))
+	}
+
+	if v := expanded["cost_center"]; v == nil || *v != "MSFT" {
+		t.Fatalf("Expected the Default Tag `cost_center` to be merged in but got %+v", expanded)
+	}
+
+	if v := expanded["environment"]; v == nil || *v != "staging" {
+		t.Fatalf("Expected the tags defined on the resource to take precedence but got %+v", expanded)
+	}
+}
+
+func TestFlattenAndSetTags(t *testing.T) {
+	cases := []struct {
+		Name            string
+		Meta            interface{}
+		Configured      map[string]interface{}
+		Expected        map[string]interface{}
+		ExpectedTagsAll map[string]interface{}
+	}{
+		{
+			Name: "Data Source",
+			Meta: nil,
+			Expected: map[string]interface{}{
+				"cost_center": "MSFT",
+				"environment": "staging",
+				"CreatedBy":   "Azure Policy",
+			},
+			ExpectedTagsAll: map[string]interface{}{},
+		},
+		{
+			Name: "Default Tag not Configured",
+			Meta: &ArmClient{
+				defaultTags: map[string]interface{}{
+					"cost_center": "MSFT",
+				},
+				ignoreTagKeys: []string{"createdby"},
+			},
+			Configured: map[string]interface{}{
+				"environment": "staging",
+			},
+			Expected: map[string]interface{}{
+				"environment": "staging",
+			},
+			ExpectedTagsAll: map[string]interface{}{
+				"cost_center": "MSFT",
+				"environment": "staging",
+			},
+		},
+		{
+			Name: "Default Tag also Configured",
+			Meta: &ArmClient{
+				defaultTags: map[string]interface{}{
+					"cost_center": "MSFT",
+					"environment": "Production",
+				},
+				ignoreTagKeys: []string{"createdby"},
+			},
+			Configured: map[string]interface{}{
+				"environment": "staging",
+			},
+			Expected: map[string]interface{}{
+				"environment": "staging",
+			},
+			ExpectedTagsAll: map[string]interface{}{
+				"cost_center": "MSFT",
+				"environment": "staging",
+			},
+		},
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+
+	for _, v := range cases {
+		d := resource.TestResourceData()
+		if err := d.Set("tags", v.Configured); err != nil {
+			t.Fatalf("Error setting `tags` for %q: %+v", v.Name, err)
+		}
+
+		flattenAndSetTags(d, map[string]*string{
+			"cost_center": utils.String("MSFT"),
+			"environment": utils.String("staging"),
+			"CreatedBy":   utils.String("Azure Policy"),
+		}, v.Meta)
+
+		if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected `tags` for %q to be %+v but got %+v", v.Name, v.Expected, actual)
+		}
+
+		if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, v.ExpectedTagsAll) {
+			t.Fatalf("Expected `tags_all` for %q to be %+v but got %+v", v.Name, v.ExpectedTagsAll, actual)
+		}
+	}
+}
+
+func TestHasOnlyTagChanges(t *testing.T) {
+	resource := func() *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"tags": tagsSchema(),
+				"tags_all": {
+					Type:     schema.TypeMap,
+					Optional: true,
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Raw      map[string]interface{}
+		Expected bool
+	}{
+		{
+			Name:     "No Changes",
+			Raw:      map[string]interface{}{},
+			Expected: false,
+		},
+		{
+			Name: "Tags Changed",
+			Raw: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"environment": "staging",
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Default Tags Changed",
+			Raw: map[string]interface{}{
+				"tags_all": map[string]interface{}{
+					"cost_center": "MSFT",
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Tags and another Field Changed",
+			Raw: map[string]interface{}{
+				"name": "example",
+				"tags": map[string]interface{}{
+					"environment": "staging",
+				},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range cases {
+		d := schema.TestResourceDataRaw(t, resource().Schema, v.Raw)
+
+		if actual := hasOnlyTagChanges(d, "azurerm_test_has_only_tag_changes", resource); actual != v.Expected {
+			t.Fatalf("Expected hasOnlyTagChanges for %q to be %t but got %t", v.Name, v.Expected, actual)
+		}
+	}
+}
+
 func TestFilterARMTags(t *testing.T) {
 	testData := make(map[string]*string)
 	valueData := [3]string{"value1", "value2", "value3"}
@@ -0,0 +1,53 @@
+package azurerm
+
+// The `MultiValue` and `Subnet` Traffic Routing Methods (and `maxReturn`) aren't available in the version of the
+// Azure SDK used by this Provider, as such Traffic Manager Profiles are managed using the `resourcemanager` client -
+// these are the models for the API Version `2018-08-01`.
+
+const (
+	trafficManagerTrafficRoutingMethodMultiValue = "MultiValue"
+	trafficManagerTrafficRoutingMethodSubnet     = "Subnet"
+)
+
+type trafficManagerProfile struct {
+	ID         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Location   *string                          `json:"location,omitempty"`
+	Tags       map[string]*string               `json:"tags"`
+	Properties *trafficManagerProfileProperties `json:"properties,omitempty"`
+}
+
+type trafficManagerProfileProperties struct {
+	ProfileStatus        string                       `json:"profileStatus,omitempty"`
+	TrafficRoutingMethod string                       `json:"trafficRoutingMethod,omitempty"`
+	DNSConfig            *trafficManagerDNSConfig     `json:"dnsConfig,omitempty"`
+	MonitorConfig        *trafficManagerMonitorConfig `json:"monitorConfig,omitempty"`
+	MaxReturn            *int64                       `json:"maxReturn,omitempty"`
+}
+
+type trafficManagerDNSConfig struct {
+	RelativeName *string `json:"relativeName,omitempty"`
+	Fqdn         *string `json:"fqdn,omitempty"`
+	TTL          *int64  `json:"ttl,omitempty"`
+}
+
+type trafficManagerMonitorConfig struct {
+	Protocol string  `json:"protocol,omitempty"`
+	Port     *int64  `json:"port,omitempty"`
+	Path     *string `json:"path,omitempty"`
+}
+
+// The `subnets` mappings for an Endpoint (used by the `Subnet` Traffic Routing Method) also aren't available in the
+// version of the Azure SDK used by this Provider, as such these are sent using the `resourcemanager` client.
+
+type trafficManagerEndpointSubnet struct {
+	First *string `json:"first,omitempty"`
+	Last  *string `json:"last,omitempty"`
+	Scope *int32  `json:"scope,omitempty"`
+}
+
+type trafficManagerEndpointSubnets struct {
+	Properties *struct {
+		Subnets *[]trafficManagerEndpointSubnet `json:"subnets,omitempty"`
+	} `json:"properties,omitempty"`
+}
@@ -5,24 +5,12 @@ import (
 	"math"
 	"regexp"
 	"strings"
-	"time"
 
-	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/satori/uuid"
 )
 
-func validateRFC3339Date(v interface{}, k string) (ws []string, errors []error) {
-	dateString := v.(string)
-
-	if _, err := date.ParseTime(time.RFC3339, dateString); err != nil {
-		errors = append(errors, fmt.Errorf("%q is an invalid RFC3339 date: %+v", k, err))
-	}
-
-	return
-}
-
 // validateIntInSlice returns a SchemaValidateFunc which tests if the provided value
 // is of type int and matches the value of an element in the valid slice
 func validateIntInSlice(valid []int) schema.SchemaValidateFunc {
@@ -61,23 +49,6 @@ func evaluateSchemaValidateFunc(i interface{}, k string, validateFunc schema.Sch
 	return true, nil
 }
 
-func validateIso8601Duration() schema.SchemaValidateFunc {
-	return func(i interface{}, k string) (s []string, es []error) {
-		v, ok := i.(string)
-		if !ok {
-			es = append(es, fmt.Errorf("expected type of %s to be string", k))
-			return
-		}
-
-		matched, _ := regexp.MatchString(`^P([0-9]+Y)?([0-9]+M)?([0-9]+W)?([0-9]+D)?(T([0-9]+H)?([0-9]+M)?([0-9]+(\.?[0-9]+)?S)?)?$`, v)
-
-		if !matched {
-			es = append(es, fmt.Errorf("expected %s to be in ISO 8601 duration format, got %s", k, v))
-		}
-		return
-	}
-}
-
 func validateAzureVirtualMachineTimeZone() schema.SchemaValidateFunc {
 	// Candidates are listed here: http://jackstromberg.com/2017/01/list-of-time-zones-consumed-by-azure/
 	candidates := []string{
@@ -0,0 +1,85 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+)
+
+// Azure Active Directory authentication (and the OpenVPN protocol it requires) for Point-to-Site clients of a
+// Virtual Network Gateway isn't available in the version of the Azure SDK used by this Provider, as such these are
+// managed using the `resourcemanager` client - these are the models for the API Version `2019-08-01`.
+
+const virtualNetworkGatewayOpenVPNProtocol = "OpenVPN"
+
+type virtualNetworkGatewayAadProperties struct {
+	Properties *struct {
+		VpnClientConfiguration *virtualNetworkGatewayAadConfiguration `json:"vpnClientConfiguration,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+type virtualNetworkGatewayAadConfiguration struct {
+	AadTenant   *string `json:"aadTenant,omitempty"`
+	AadAudience *string `json:"aadAudience,omitempty"`
+	AadIssuer   *string `json:"aadIssuer,omitempty"`
+}
+
+// expandVirtualNetworkGatewayWithAad adds the Azure Active Directory properties (which aren't available in the
+// Azure SDK) to the `vpnClientConfiguration` of the Virtual Network Gateway payload
+func expandVirtualNetworkGatewayWithAad(gateway network.VirtualNetworkGateway, aad virtualNetworkGatewayAadConfiguration) (map[string]interface{}, error) {
+	serialized, err := json.Marshal(gateway)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing Virtual Network Gateway: %+v", err)
+	}
+
+	output := make(map[string]interface{})
+	if err := json.Unmarshal(serialized, &output); err != nil {
+		return nil, fmt.Errorf("Error deserializing Virtual Network Gateway: %+v", err)
+	}
+
+	properties, ok := output["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+
+	vpnClientConfiguration, ok := properties["vpnClientConfiguration"].(map[string]interface{})
+	if !ok {
+		vpnClientConfiguration = make(map[string]interface{})
+	}
+
+	if aad.AadTenant != nil {
+		vpnClientConfiguration["aadTenant"] = *aad.AadTenant
+	}
+	if aad.AadAudience != nil {
+		vpnClientConfiguration["aadAudience"] = *aad.AadAudience
+	}
+	if aad.AadIssuer != nil {
+		vpnClientConfiguration["aadIssuer"] = *aad.AadIssuer
+	}
+
+	properties["vpnClientConfiguration"] = vpnClientConfiguration
+	output["properties"] = properties
+
+	return output, nil
+}
+
+// virtualNetworkGatewayRequiresAadApi returns whether the Virtual Network Gateway uses features which are only
+// available in the newer API Version, and so must be created/updated using the `resourcemanager` client
+func virtualNetworkGatewayRequiresAadApi(props *network.VirtualNetworkGatewayPropertiesFormat, aad virtualNetworkGatewayAadConfiguration) bool {
+	if aad.AadTenant != nil || aad.AadAudience != nil || aad.AadIssuer != nil {
+		return true
+	}
+
+	if props == nil || props.VpnClientConfiguration == nil || props.VpnClientConfiguration.VpnClientProtocols == nil {
+		return false
+	}
+
+	for _, protocol := range *props.VpnClientConfiguration.VpnClientProtocols {
+		if string(protocol) == virtualNetworkGatewayOpenVPNProtocol {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,90 @@
+package azurerm
+
+import (
+	"fmt"
+)
+
+// Web Application Firewall Policies aren't available in the version of the Azure SDK used by this Provider, as such
+// they're managed using the `resourcemanager` client - these are the models for the API Version `2019-09-01`.
+
+type webApplicationFirewallPolicy struct {
+	ID         *string                                 `json:"id,omitempty"`
+	Name       *string                                 `json:"name,omitempty"`
+	Location   *string                                 `json:"location,omitempty"`
+	Tags       map[string]*string                      `json:"tags,omitempty"`
+	Properties *webApplicationFirewallPolicyProperties `json:"properties,omitempty"`
+}
+
+type webApplicationFirewallPolicyProperties struct {
+	PolicySettings      *webApplicationFirewallPolicySettings     `json:"policySettings,omitempty"`
+	CustomRules         []webApplicationFirewallCustomRule        `json:"customRules"`
+	ManagedRules        *webApplicationFirewallManagedRules       `json:"managedRules,omitempty"`
+	ApplicationGateways []webApplicationFirewallPolicySubResource `json:"applicationGateways,omitempty"`
+	HTTPListeners       []webApplicationFirewallPolicySubResource `json:"httpListeners,omitempty"`
+	PathBasedRules      []webApplicationFirewallPolicySubResource `json:"pathBasedRules,omitempty"`
+}
+
+type webApplicationFirewallPolicySettings struct {
+	State                  string `json:"state"`
+	Mode                   string `json:"mode"`
+	RequestBodyCheck       bool   `json:"requestBodyCheck"`
+	FileUploadLimitInMb    int32  `json:"fileUploadLimitInMb"`
+	MaxRequestBodySizeInKb int32  `json:"maxRequestBodySizeInKb"`
+}
+
+type webApplicationFirewallCustomRule struct {
+	Name            string                                 `json:"name,omitempty"`
+	Priority        int32                                  `json:"priority"`
+	RuleType        string                                 `json:"ruleType"`
+	MatchConditions []webApplicationFirewallMatchCondition `json:"matchConditions"`
+	Action          string                                 `json:"action"`
+}
+
+type webApplicationFirewallMatchCondition struct {
+	MatchVariables []webApplicationFirewallMatchVariable `json:"matchVariables"`
+	Operator       string                                `json:"operator"`
+	// NOTE: the misspelling of `negationConditon` matches the API
+	NegationConditon bool     `json:"negationConditon"`
+	MatchValues      []string `json:"matchValues"`
+	Transforms       []string `json:"transforms,omitempty"`
+}
+
+type webApplicationFirewallMatchVariable struct {
+	VariableName string  `json:"variableName"`
+	Selector     *string `json:"selector,omitempty"`
+}
+
+type webApplicationFirewallManagedRules struct {
+	Exclusions      []webApplicationFirewallExclusion      `json:"exclusions"`
+	ManagedRuleSets []webApplicationFirewallManagedRuleSet `json:"managedRuleSets"`
+}
+
+type webApplicationFirewallExclusion struct {
+	MatchVariable         string `json:"matchVariable"`
+	SelectorMatchOperator string `json:"selectorMatchOperator"`
+	Selector              string `json:"selector"`
+}
+
+type webApplicationFirewallManagedRuleSet struct {
+	RuleSetType        string                                    `json:"ruleSetType"`
+	RuleSetVersion     string                                    `json:"ruleSetVersion"`
+	RuleGroupOverrides []webApplicationFirewallRuleGroupOverride `json:"ruleGroupOverrides,omitempty"`
+}
+
+type webApplicationFirewallRuleGroupOverride struct {
+	RuleGroupName string                               `json:"ruleGroupName"`
+	Rules         []webApplicationFirewallRuleOverride `json:"rules,omitempty"`
+}
+
+type webApplicationFirewallRuleOverride struct {
+	RuleID string `json:"ruleId"`
+	State  string `json:"state,omitempty"`
+}
+
+type webApplicationFirewallPolicySubResource struct {
+	ID *string `json:"id,omitempty"`
+}
+
+func webApplicationFirewallPolicyResourceID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/ApplicationGatewayWebApplicationFirewallPolicies/%s", subscriptionId, resourceGroup, name)
+}